@@ -0,0 +1,165 @@
+package work
+
+import (
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	defaultAutoscaleLatency = time.Second
+	defaultAutoscaleIdle    = time.Minute
+	autoscalePeriod         = time.Second
+)
+
+// autoscaler resizes a pool with WorkerPoolOptions.AutoscaleMax between min and max workers. Every period, it adds
+// workers if they're all busy while one of the pool's queues has a job that has waited latency or more, and retires
+// the workers that have been idle for idle or more.
+type autoscaler struct {
+	wp      *WorkerPool
+	min     uint
+	max     uint
+	latency time.Duration
+	idle    time.Duration
+	period  time.Duration
+	logger  Logger
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func newAutoscaler(wp *WorkerPool, min, max uint, latency, idle time.Duration) *autoscaler {
+	if latency <= 0 {
+		latency = defaultAutoscaleLatency
+	}
+	if idle <= 0 {
+		idle = defaultAutoscaleIdle
+	}
+
+	return &autoscaler{
+		wp:      wp,
+		min:     min,
+		max:     max,
+		latency: latency,
+		idle:    idle,
+		period:  autoscalePeriod,
+		logger:  withFields(defaultLogger, "namespace", wp.namespace, "pool_id", wp.workerPoolID),
+
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+func (a *autoscaler) start() {
+	go a.loop()
+}
+
+func (a *autoscaler) stop() {
+	a.stopChan <- struct{}{}
+	<-a.doneStoppingChan
+}
+
+func (a *autoscaler) loop() {
+	ticker := time.NewTicker(a.period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.stopChan:
+			a.doneStoppingChan <- struct{}{}
+			return
+		case <-ticker.C:
+			if err := a.scale(); err != nil {
+				a.logger.Error("autoscaler.scale", err)
+			}
+		}
+	}
+}
+
+// scale resizes the pool once, if it needs to.
+func (a *autoscaler) scale() error {
+	latency, err := a.queueLatency()
+	if err != nil {
+		return err
+	}
+
+	wp := a.wp
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
+	if !wp.started {
+		return nil
+	}
+
+	n := uint(len(wp.workers))
+	now := time.Now()
+	var busy, idle uint
+	for _, w := range wp.workers {
+		if since := w.idleSince(); since.IsZero() {
+			busy++
+		} else if now.Sub(since) >= a.idle {
+			idle++
+		}
+	}
+
+	if busy == n && latency >= a.latency && n < a.max {
+		// Grow by half, so that a large backlog doesn't take many periods to catch up with
+		grow := n / 2
+		if grow == 0 {
+			grow = 1
+		}
+		if n+grow > a.max {
+			grow = a.max - n
+		}
+		wp.resize(n + grow)
+	} else if idle > 0 && n > a.min {
+		if n-idle < a.min {
+			idle = n - a.min
+		}
+		wp.resize(n - idle)
+	}
+
+	return nil
+}
+
+// queueLatency returns how long the oldest job of the pool's queues has been waiting.
+func (a *autoscaler) queueLatency() (time.Duration, error) {
+	a.wp.mtx.Lock()
+	jobNames := a.wp.jobNames()
+	a.wp.mtx.Unlock()
+
+	conn := a.wp.pool.Get()
+	defer conn.Close()
+
+	var numQueues int
+	for _, jobName := range jobNames {
+		for _, queue := range a.wp.queues {
+			conn.Send("LINDEX", redisKeyJobsQueue(a.wp.namespace, jobName, queue.Name), -1)
+			numQueues++
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return 0, err
+	}
+
+	now := nowEpochSeconds()
+	var latency int64
+	for i := 0; i < numQueues; i++ {
+		rawJSON, err := redis.Bytes(conn.Receive())
+		if err == redis.ErrNil {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+
+		job, err := newJob(rawJSON, nil, nil)
+		if err != nil {
+			return 0, err
+		}
+		if now-job.EnqueuedAt > latency {
+			latency = now - job.EnqueuedAt
+		}
+	}
+
+	return time.Duration(latency) * time.Second, nil
+}
@@ -0,0 +1,84 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoscaler(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	a := newAutoscaler(wp, 1, 3, time.Second, 50*time.Millisecond)
+
+	// Jobs that have been waiting for a while
+	conn := pool.Get()
+	defer conn.Close()
+	pushOldJobs := func(n int) {
+		for i := 0; i < n; i++ {
+			rawJSON, err := (&Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: nowEpochSeconds() - 10}).serialize()
+			assert.NoError(t, err)
+			_, err = conn.Do("LPUSH", redisKeyJobs(ns, "wat"), rawJSON)
+			assert.NoError(t, err)
+		}
+	}
+
+	// No latency: nothing to do
+	assert.NoError(t, a.scale())
+	assert.EqualValues(t, 1, wp.Concurrency())
+
+	// All the workers are busy and jobs wait: grow
+	pushOldJobs(3)
+	<-started
+	assert.NoError(t, a.scale())
+	assert.EqualValues(t, 2, wp.Concurrency())
+	<-started
+	assert.NoError(t, a.scale())
+	assert.EqualValues(t, 3, wp.Concurrency())
+	<-started
+
+	// Never beyond the max
+	pushOldJobs(1)
+	assert.NoError(t, a.scale())
+	assert.EqualValues(t, 3, wp.Concurrency())
+
+	// Idle workers are retired, down to the min
+	close(release)
+	assert.Eventually(t, func() bool {
+		assert.NoError(t, a.scale())
+		return wp.Concurrency() == 1
+	}, 2*time.Second, 20*time.Millisecond)
+	h := readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))
+	assert.Equal(t, "1", h["concurrency"])
+}
+
+func TestWorkerPoolAutoscaleOptions(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 10, ns, pool, WorkerPoolOptions{AutoscaleMin: 1, AutoscaleMax: 4})
+	assert.EqualValues(t, 4, wp.Concurrency())
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	assert.NotNil(t, wp.autoscaler)
+	wp.Stop()
+	assert.Nil(t, wp.autoscaler)
+
+	assert.Panics(t, func() {
+		NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{AutoscaleMin: 5, AutoscaleMax: 4})
+	})
+}
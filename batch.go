@@ -0,0 +1,307 @@
+package work
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ErrBatchNotFound is returned by Client.Batch when there is no batch with the given ID, or it expired.
+var ErrBatchNotFound = fmt.Errorf("batch not found")
+
+// batchRetentionSeconds is how long a batch is kept once all of its jobs either succeeded or died.
+const batchRetentionSeconds = 7 * 24 * 60 * 60
+
+// BatchOptions can be passed to NewBatch.
+type BatchOptions struct {
+	Description string     // Shown in the web UI
+	OnSuccess   *BatchItem // Enqueued once all of the batch's jobs succeeded
+	OnComplete  *BatchItem // Enqueued once all of the batch's jobs succeeded or died, after OnSuccess
+}
+
+// Batch is a group of jobs that is tracked as a whole: once all of them succeeded the OnSuccess callback job is
+// enqueued, and once all of them finished, whether they succeeded or died, the OnComplete callback job is enqueued.
+// The callback jobs get the batch ID in their "batch_id" argument.
+//
+// Build it with Enqueuer.NewBatch and Add, then enqueue all of its jobs at once with Enqueue:
+//
+//	b := enqueuer.NewBatch(work.BatchOptions{
+//		Description: "import users",
+//		OnComplete:  &work.BatchItem{Name: "import_done"},
+//	})
+//	for _, u := range users {
+//		b.Add("import_user", work.Q{"id": u.ID})
+//	}
+//	err := b.Enqueue()
+type Batch struct {
+	ID string
+
+	enqueuer    *Enqueuer
+	traceParent string
+	opts        BatchOptions
+	jobs        []*Job
+}
+
+// NewBatch creates a new, empty batch.
+func (e *Enqueuer) NewBatch(opts BatchOptions) *Batch {
+	return e.NewBatchContext(context.Background(), opts)
+}
+
+// NewBatchContext is like NewBatch, but the jobs of the batch, callbacks included, carry the trace of the span of ctx,
+// if any, see EnqueueContext.
+func (e *Enqueuer) NewBatchContext(ctx context.Context, opts BatchOptions) *Batch {
+	return &Batch{
+		ID:          makeIdentifier(),
+		enqueuer:    e,
+		traceParent: traceParent(ctx),
+		opts:        opts,
+	}
+}
+
+// Add adds a job to the batch. The returned job is the one that will be enqueued. Nothing is enqueued until Enqueue is
+// called.
+func (b *Batch) Add(jobName string, args map[string]interface{}) *Job {
+	job := &Job{
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: b.traceParent,
+		Args:        args,
+		BatchID:     b.ID,
+		Queue:       b.enqueuer.queueOf(jobName),
+	}
+	b.jobs = append(b.jobs, job)
+	return job
+}
+
+// Enqueue atomically stores the batch and enqueues all of its jobs.
+func (b *Batch) Enqueue() error {
+	if len(b.jobs) == 0 {
+		return fmt.Errorf("batch: no jobs")
+	}
+
+	e := b.enqueuer
+	batchKey := redisKeyBatch(e.Namespace, b.ID)
+	now := nowEpochSeconds()
+
+	rawJSONs := make([][]byte, 0, len(b.jobs))
+	for _, job := range b.jobs {
+		rawJSON, err := job.serialize()
+		if err != nil {
+			return err
+		}
+		rawJSONs = append(rawJSONs, rawJSON)
+	}
+
+	hashArgs := []interface{}{
+		batchKey,
+		"description", b.opts.Description,
+		"created_at", now,
+		"total", len(b.jobs),
+		"pending", len(b.jobs),
+		"succeeded", 0,
+		"failed", 0,
+		"dead", 0,
+	}
+	jobNames := make([]string, 0, len(b.jobs)+2)
+	for _, job := range b.jobs {
+		jobNames = append(jobNames, job.Name)
+	}
+	callbacks := []struct {
+		field string
+		item  *BatchItem
+	}{{"on_success", b.opts.OnSuccess}, {"on_complete", b.opts.OnComplete}}
+	for _, callback := range callbacks {
+		if callback.item == nil {
+			continue
+		}
+		rawJSON, err := b.callbackJob(callback.item).serialize()
+		if err != nil {
+			return err
+		}
+		hashArgs = append(hashArgs, callback.field, rawJSON)
+		jobNames = append(jobNames, callback.item.Name)
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("HMSET", hashArgs...)
+	conn.Send("ZADD", redisKeyBatches(e.Namespace), now, b.ID)
+	for _, cmd := range e.queueCmds(b.jobs, rawJSONs) {
+		conn.Send(cmd.name, cmd.args...)
+	}
+	unknownJobNames := e.unknownJobNames(jobNames...)
+	for _, jobName := range unknownJobNames {
+		e.sendAddToKnownJobs(conn, jobName)
+	}
+	if _, err := conn.Do("EXEC"); err != nil {
+		return err
+	}
+
+	e.markKnownJobs(unknownJobNames...)
+
+	return nil
+}
+
+func (b *Batch) callbackJob(callback *BatchItem) *Job {
+	args := make(map[string]interface{}, len(callback.Args)+1)
+	for k, v := range callback.Args {
+		args[k] = v
+	}
+	args["batch_id"] = b.ID
+
+	return &Job{
+		Name:        callback.Name,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: b.traceParent,
+		Args:        args,
+		Queue:       b.enqueuer.itemQueue(*callback),
+	}
+}
+
+// BatchStatus is the state of a batch, as returned by Client.Batch.
+type BatchStatus struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	CreatedAt   int64  `json:"created_at"`
+	CompletedAt int64  `json:"completed_at,omitempty"` // Set once no job is pending anymore
+	Total       int64  `json:"total"`
+	Pending     int64  `json:"pending"`   // Jobs that didn't succeed or die yet
+	Succeeded   int64  `json:"succeeded"` // Jobs that succeeded, possibly after being retried
+	Failed      int64  `json:"failed"`    // Jobs that failed at least once. They may have succeeded since.
+	Dead        int64  `json:"dead"`      // Jobs that died
+}
+
+// Batch returns the state of the batch with the specified ID, or ErrBatchNotFound.
+// Batches are kept for a week after their last job finished.
+func (c *Client) Batch(batchID string) (*BatchStatus, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	values, err := redis.Values(conn.Do("HMGET", batchStatusArgs(c.namespace, batchID)...))
+	if err != nil {
+		c.logger.Error("client.batch.hmget", err, "batch_id", batchID)
+		return nil, err
+	}
+
+	return c.newBatchStatus(batchID, values)
+}
+
+// Batches returns a list of BatchStatus'es, newest first. The page param is 1-based; each page is 20 items. The total
+// number of items (not pages) in the list of batches is also returned.
+func (c *Client) Batches(page uint) ([]*BatchStatus, int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if page == 0 {
+		page = 1
+	}
+
+	batchesKey := redisKeyBatches(c.namespace)
+	start := (page - 1) * 20
+	batchIDs, err := redis.Strings(conn.Do("ZREVRANGE", batchesKey, start, start+19))
+	if err != nil {
+		c.logger.Error("client.batches.zrevrange", err)
+		return nil, 0, err
+	}
+
+	for _, batchID := range batchIDs {
+		conn.Send("HMGET", batchStatusArgs(c.namespace, batchID)...)
+	}
+	if err := conn.Flush(); err != nil {
+		c.logger.Error("client.batches.flush", err)
+		return nil, 0, err
+	}
+
+	batches := make([]*BatchStatus, 0, len(batchIDs))
+	var expired []interface{}
+	for _, batchID := range batchIDs {
+		values, err := redis.Values(conn.Receive())
+		if err != nil {
+			c.logger.Error("client.batches.receive", err)
+			return nil, 0, err
+		}
+
+		batch, err := c.newBatchStatus(batchID, values)
+		if err == ErrBatchNotFound {
+			expired = append(expired, batchID)
+			continue
+		} else if err != nil {
+			return nil, 0, err
+		}
+		batches = append(batches, batch)
+	}
+
+	// Expired batches are only dropped from the list of batches when we come across them
+	if len(expired) > 0 {
+		if _, err := conn.Do("ZREM", append([]interface{}{batchesKey}, expired...)...); err != nil {
+			c.logger.Error("client.batches.zrem", err)
+			return nil, 0, err
+		}
+	}
+
+	count, err := redis.Int64(conn.Do("ZCARD", batchesKey))
+	if err != nil {
+		c.logger.Error("client.batches.zcard", err)
+		return nil, 0, err
+	}
+
+	return batches, count, nil
+}
+
+// batchStatusArgs returns the HMGET arguments for the fields of a BatchStatus.
+func batchStatusArgs(namespace, batchID string) []interface{} {
+	return []interface{}{redisKeyBatch(namespace, batchID), "description", "created_at", "completed_at", "total", "pending", "succeeded", "failed", "dead"}
+}
+
+func (c *Client) newBatchStatus(batchID string, values []interface{}) (*BatchStatus, error) {
+	var description string
+	var createdAt, completedAt, total, pending, succeeded, failed, dead int64
+	if _, err := redis.Scan(values, &description, &createdAt, &completedAt, &total, &pending, &succeeded, &failed, &dead); err != nil {
+		c.logger.Error("client.batch.scan", err, "batch_id", batchID)
+		return nil, err
+	}
+	if createdAt == 0 {
+		return nil, ErrBatchNotFound
+	}
+
+	return &BatchStatus{
+		ID:          batchID,
+		Description: description,
+		CreatedAt:   createdAt,
+		CompletedAt: completedAt,
+		Total:       total,
+		Pending:     pending,
+		Succeeded:   succeeded,
+		Failed:      failed,
+		Dead:        dead,
+	}, nil
+}
+
+// countBatchJob queues the update of the batch of job on conn, once the job ran.
+func (w *worker) countBatchJob(conn redis.Conn, job *Job, outcome jobOutcome) {
+	var batchOutcome string
+	switch outcome {
+	case jobSucceeded:
+		batchOutcome = "succeeded"
+	case jobRetried:
+		batchOutcome = "failed"
+	default:
+		batchOutcome = "dead"
+	}
+
+	w.batchScript.Send(conn,
+		redisKeyBatch(w.namespace, job.BatchID),         // KEYS[1]
+		redisKeyBatchFinished(w.namespace, job.BatchID), // KEYS[2]
+		redisKeyBatchFailed(w.namespace, job.BatchID),   // KEYS[3]
+		job.ID,                          // ARGV[1]
+		batchOutcome,                    // ARGV[2]
+		redisKeyJobsPrefix(w.namespace), // ARGV[3]
+		nowEpochSeconds(),               // ARGV[4]
+		batchRetentionSeconds,           // ARGV[5]
+	)
+}
@@ -0,0 +1,188 @@
+package work
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchCallbacksOnSuccess(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	b := enqueuer.NewBatch(BatchOptions{
+		Description: "wats",
+		OnSuccess:   &BatchItem{Name: "succeeded", Args: Q{"a": 1}},
+		OnComplete:  &BatchItem{Name: "completed"},
+	})
+	for i := 0; i < 3; i++ {
+		job := b.Add("wat", Q{"i": i})
+		assert.Equal(t, b.ID, job.BatchID)
+	}
+	assert.NoError(t, b.Enqueue())
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.ElementsMatch(t, []string{"wat", "succeeded", "completed"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	client := NewClient(ns, pool)
+	status, err := client.Batch(b.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "wats", status.Description)
+	assert.EqualValues(t, 3, status.Total)
+	assert.EqualValues(t, 3, status.Pending)
+	assert.EqualValues(t, 0, status.CompletedAt)
+
+	var mtx sync.Mutex
+	var callbacks []*Job
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.Job("wat", func(job *Job) error { return nil })
+	callback := func(job *Job) error {
+		mtx.Lock()
+		callbacks = append(callbacks, job)
+		mtx.Unlock()
+		return nil
+	}
+	wp.Job("succeeded", callback)
+	wp.Job("completed", callback)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	if assert.Len(t, callbacks, 2) {
+		names := []string{callbacks[0].Name, callbacks[1].Name}
+		assert.ElementsMatch(t, []string{"succeeded", "completed"}, names)
+		for _, job := range callbacks {
+			assert.Equal(t, b.ID, job.ArgString("batch_id"))
+			assert.Empty(t, job.BatchID)
+		}
+	}
+
+	status, err = client.Batch(b.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, status.Pending)
+	assert.EqualValues(t, 3, status.Succeeded)
+	assert.EqualValues(t, 0, status.Failed)
+	assert.EqualValues(t, 0, status.Dead)
+	assert.True(t, status.CompletedAt > 0)
+	assert.True(t, ttl(pool, redisKeyBatch(ns, b.ID)) > 0)
+
+	_, err = client.Batch("nope")
+	assert.Equal(t, ErrBatchNotFound, err)
+}
+
+func TestBatchCallbacksOnFailure(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	b := enqueuer.NewBatch(BatchOptions{
+		OnSuccess:  &BatchItem{Name: "succeeded"},
+		OnComplete: &BatchItem{Name: "completed"},
+	})
+	b.Add("wat", Q{"fail": false})
+	b.Add("wat", Q{"fail": true})
+	b.Add("retried", nil)
+	assert.NoError(t, b.Enqueue())
+
+	var mtx sync.Mutex
+	var callbacks []string
+	var retries int
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.JobWithOptions("wat", JobOptions{MaxFails: 1}, func(job *Job) error {
+		if job.ArgBool("fail") {
+			return fmt.Errorf("ohno")
+		}
+		return nil
+	})
+	wp.JobWithOptions("retried", JobOptions{MaxFails: 3}, func(job *Job) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		retries++
+		return fmt.Errorf("ohno")
+	})
+	callback := func(job *Job) error {
+		mtx.Lock()
+		callbacks = append(callbacks, job.Name)
+		mtx.Unlock()
+		return nil
+	}
+	wp.Job("succeeded", callback)
+	wp.Job("completed", callback)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// The retried job is waiting in the retry queue, so the batch isn't complete yet
+	assert.Equal(t, 1, retries)
+	assert.Empty(t, callbacks)
+
+	client := NewClient(ns, pool)
+	status, err := client.Batch(b.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, status.Pending)
+	assert.EqualValues(t, 1, status.Succeeded)
+	assert.EqualValues(t, 2, status.Failed)
+	assert.EqualValues(t, 1, status.Dead)
+
+	// Let the retried job die
+	retryJobs, _, err := client.RetryJobs(1)
+	assert.NoError(t, err)
+	if assert.Len(t, retryJobs, 1) {
+		assert.NoError(t, client.DeleteRetryJob(retryJobs[0].RetryAt, retryJobs[0].ID))
+		job := retryJobs[0].Job
+		job.Fails = 3
+		w := newWorker(ns, "1", pool, tstCtxType, nil, nil, nil)
+		conn := pool.Get()
+		conn.Send("MULTI")
+		w.countBatchJob(conn, job, jobDied)
+		_, err := conn.Do("EXEC")
+		conn.Close()
+		assert.NoError(t, err)
+	}
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "succeeded")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "completed")))
+
+	status, err = client.Batch(b.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, status.Pending)
+	assert.EqualValues(t, 1, status.Succeeded)
+	assert.EqualValues(t, 2, status.Failed)
+	assert.EqualValues(t, 2, status.Dead)
+	assert.True(t, status.CompletedAt > 0)
+}
+
+func TestBatches(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		b := enqueuer.NewBatch(BatchOptions{Description: fmt.Sprint(i)})
+		b.Add("wat", nil)
+		assert.NoError(t, b.Enqueue())
+		ids = append(ids, b.ID)
+	}
+	assert.Error(t, enqueuer.NewBatch(BatchOptions{}).Enqueue())
+
+	// Pretend one of them expired
+	conn := pool.Get()
+	_, err := conn.Do("DEL", redisKeyBatch(ns, ids[1]))
+	conn.Close()
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	batches, count, err := client.Batches(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	if assert.Len(t, batches, 2) {
+		assert.ElementsMatch(t, []string{ids[0], ids[2]}, []string{batches[0].ID, batches[1].ID})
+		assert.EqualValues(t, 1, batches[0].Total)
+	}
+}
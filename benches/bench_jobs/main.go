@@ -107,7 +107,7 @@ func cleanKeyspace() {
 	conn := pool.Get()
 	defer conn.Close()
 
-	keys, err := redis.Strings(conn.Do("KEYS", namespace+"*"))
+	keys, err := redis.Strings(conn.Do("KEYS", "{"+namespace+"}:*"))
 	if err != nil {
 		panic("could not get keys: " + err.Error())
 	}
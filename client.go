@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
@@ -21,6 +22,8 @@ var ErrNotRetried = fmt.Errorf("nothing retried")
 type Client struct {
 	namespace string
 	pool      *redis.Pool
+	wakeup    bool // see SetWakeup
+	logger    Logger
 }
 
 // NewClient creates a new Client with the specified redis namespace and connection pool.
@@ -28,9 +31,22 @@ func NewClient(namespace string, pool *redis.Pool) *Client {
 	return &Client{
 		namespace: namespace,
 		pool:      pool,
+		logger:    withFields(defaultLogger, "namespace", namespace),
 	}
 }
 
+// SetLogger makes the client log its errors with logger, instead of slog.Default().
+func (c *Client) SetLogger(logger Logger) {
+	c.logger = withFields(logger, "namespace", c.namespace)
+}
+
+// SetWakeup makes the client publish a wakeup on Redis when it makes jobs runnable, like when it retries dead jobs,
+// unpauses a job or raises its limits, so that worker pools with WorkerPoolOptions.BlockingFetch notice right away
+// rather than on their next BlockingFetchPoll. It's off by default, see Enqueuer.SetWakeup.
+func (c *Client) SetWakeup(wakeup bool) {
+	c.wakeup = wakeup
+}
+
 // WorkerPoolHeartbeat represents the heartbeat from a worker pool. WorkerPool's write a heartbeat every 5 seconds so we know they're alive and includes config information.
 type WorkerPoolHeartbeat struct {
 	WorkerPoolID string   `json:"worker_pool_id"`
@@ -62,7 +78,7 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("worker_pool_statuses.flush", err)
+		c.logger.Error("worker_pool_statuses.flush", err)
 		return nil, err
 	}
 
@@ -71,7 +87,7 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	for _, wpid := range workerPoolIDs {
 		vals, err := redis.Strings(conn.Receive())
 		if err != nil {
-			logError("worker_pool_statuses.receive", err)
+			c.logger.Error("worker_pool_statuses.receive", err)
 			return nil, err
 		}
 
@@ -106,7 +122,7 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 				sort.Strings(heartbeat.WorkerIDs)
 			}
 			if err != nil {
-				logError("worker_pool_statuses.parse", err)
+				c.logger.Error("worker_pool_statuses.parse", err)
 				return nil, err
 			}
 		}
@@ -138,7 +154,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 
 	hbs, err := c.WorkerPoolHeartbeats()
 	if err != nil {
-		logError("worker_observations.worker_pool_heartbeats", err)
+		c.logger.Error("worker_observations.worker_pool_heartbeats", err)
 		return nil, err
 	}
 
@@ -153,7 +169,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("worker_observations.flush", err)
+		c.logger.Error("worker_observations.flush", err)
 		return nil, err
 	}
 
@@ -162,7 +178,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 	for _, wid := range workerIDs {
 		vals, err := redis.Strings(conn.Receive())
 		if err != nil {
-			logError("worker_observations.receive", err)
+			c.logger.Error("worker_observations.receive", err)
 			return nil, err
 		}
 
@@ -191,7 +207,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 				ob.CheckinAt, err = strconv.ParseInt(value, 10, 64)
 			}
 			if err != nil {
-				logError("worker_observations.parse", err)
+				c.logger.Error("worker_observations.parse", err)
 				return nil, err
 			}
 		}
@@ -203,10 +219,17 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 }
 
 // Queue represents a queue that holds jobs with the same name. It indicates their name, count, and latency (in seconds). Latency is a measurement of how long ago the next job to be processed was enqueued.
+// Paused and MaxConcurrency reflect the controls set with Client.PauseJob and Client.SetMaxConcurrency.
+// Count and Latency cover the jobs on all named queues, and NamedQueues breaks down the count of those that aren't on DefaultQueue.
 type Queue struct {
-	JobName string `json:"job_name"`
-	Count   int64  `json:"count"`
-	Latency int64  `json:"latency"`
+	JobName                  string           `json:"job_name"`
+	Count                    int64            `json:"count"`
+	Latency                  int64            `json:"latency"`
+	Paused                   bool             `json:"paused"`
+	PausedUntil              int64            `json:"paused_until,omitempty"`   // When a pause set with PauseJobFor ends, in epoch seconds
+	MaxConcurrency           int64            `json:"max_concurrency"`          // 0 means no limit
+	MaxConcurrencyOverridden bool             `json:"max_concurrency_override"` // MaxConcurrency was set with SetMaxConcurrency
+	NamedQueues              map[string]int64 `json:"named_queues,omitempty"`   // Number of jobs on each named queue but DefaultQueue that has any
 }
 
 // Queues returns the Queue's it finds.
@@ -221,12 +244,23 @@ func (c *Client) Queues() ([]*Queue, error) {
 	}
 	sort.Strings(jobNames)
 
+	namedQueues, err := c.namedQueues(conn)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, jobName := range jobNames {
 		conn.Send("LLEN", redisKeyJobs(c.namespace, jobName))
+		for _, queue := range namedQueues {
+			conn.Send("LLEN", redisKeyJobsQueue(c.namespace, jobName, queue))
+		}
+		conn.Send("PTTL", redisKeyJobsPaused(c.namespace, jobName))
+		conn.Send("GET", redisKeyJobsConcurrency(c.namespace, jobName))
+		conn.Send("GET", redisKeyJobsConcurrencyOverride(c.namespace, jobName))
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("client.queues.flush", err)
+		c.logger.Error("client.queues.flush", err)
 		return nil, err
 	}
 
@@ -235,43 +269,98 @@ func (c *Client) Queues() ([]*Queue, error) {
 	for _, jobName := range jobNames {
 		count, err := redis.Int64(conn.Receive())
 		if err != nil {
-			logError("client.queues.receive", err)
+			c.logger.Error("client.queues.receive", err)
+			return nil, err
+		}
+		var namedCounts map[string]int64
+		for _, queueName := range namedQueues {
+			n, err := redis.Int64(conn.Receive())
+			if err != nil {
+				c.logger.Error("client.queues.receive_named", err)
+				return nil, err
+			}
+			if n > 0 {
+				if namedCounts == nil {
+					namedCounts = make(map[string]int64)
+				}
+				namedCounts[queueName] = n
+				count += n
+			}
+		}
+		// -2 if the job isn't paused, -1 if it's paused until it's unpaused
+		pausedTTL, err := redis.Int64(conn.Receive())
+		if err != nil {
+			c.logger.Error("client.queues.receive_paused", err)
+			return nil, err
+		}
+		maxConcurrency, err := receiveOptionalInt64(conn)
+		if err != nil {
+			c.logger.Error("client.queues.receive_max_concurrency", err)
+			return nil, err
+		}
+		maxConcurrencyOverride, err := receiveOptionalInt64(conn)
+		if err != nil {
+			c.logger.Error("client.queues.receive_max_concurrency_override", err)
 			return nil, err
 		}
 
 		queue := &Queue{
-			JobName: jobName,
-			Count:   count,
+			JobName:     jobName,
+			Count:       count,
+			Paused:      pausedTTL != -2,
+			NamedQueues: namedCounts,
+		}
+		if maxConcurrency > 0 {
+			queue.MaxConcurrency = maxConcurrency
+		}
+		if pausedTTL >= 0 {
+			queue.PausedUntil = time.Now().Add(time.Duration(pausedTTL) * time.Millisecond).Unix()
+		}
+		if maxConcurrencyOverride >= 0 {
+			queue.MaxConcurrency = maxConcurrencyOverride
+			queue.MaxConcurrencyOverridden = true
 		}
 
 		queues = append(queues, queue)
 	}
 
+	// The next job of each list that has any
+	var nextJobs []*Queue
 	for _, s := range queues {
-		if s.Count > 0 {
+		if s.Count > s.namedCount() {
 			conn.Send("LINDEX", redisKeyJobs(c.namespace, s.JobName), -1)
+			nextJobs = append(nextJobs, s)
+		}
+		for _, queueName := range namedQueues {
+			if s.NamedQueues[queueName] > 0 {
+				conn.Send("LINDEX", redisKeyJobsQueue(c.namespace, s.JobName, queueName), -1)
+				nextJobs = append(nextJobs, s)
+			}
 		}
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("client.queues.flush2", err)
+		c.logger.Error("client.queues.flush2", err)
 		return nil, err
 	}
 
 	now := nowEpochSeconds()
 
-	for _, s := range queues {
-		if s.Count > 0 {
-			b, err := redis.Bytes(conn.Receive())
-			if err != nil {
-				logError("client.queues.receive2", err)
-				return nil, err
-			}
+	for _, s := range nextJobs {
+		b, err := redis.Bytes(conn.Receive())
+		if err == redis.ErrNil {
+			continue // drained since we counted it
+		} else if err != nil {
+			c.logger.Error("client.queues.receive2", err)
+			return nil, err
+		}
 
-			job, err := newJob(b, nil, nil)
-			if err != nil {
-				logError("client.queues.new_job", err)
-			}
+		job, err := newJob(b, nil, nil)
+		if err != nil {
+			c.logger.Error("client.queues.new_job", err)
+			continue
+		}
+		if now-job.EnqueuedAt > s.Latency {
 			s.Latency = now - job.EnqueuedAt
 		}
 	}
@@ -279,6 +368,66 @@ func (c *Client) Queues() ([]*Queue, error) {
 	return queues, nil
 }
 
+func (q *Queue) namedCount() int64 {
+	var n int64
+	for _, count := range q.NamedQueues {
+		n += count
+	}
+	return n
+}
+
+// namedQueues returns the named queues but DefaultQueue that worker pools consume, see WorkerPoolOptions.Queues.
+func (c *Client) namedQueues(conn redis.Conn) ([]string, error) {
+	queues, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownQueues(c.namespace)))
+	if err != nil {
+		c.logger.Error("client.named_queues", err)
+		return nil, err
+	}
+	sort.Strings(queues)
+	return queues, nil
+}
+
+// SetSizes is the number of jobs in the retry, scheduled and dead sets, as returned by Client.SetSizes.
+type SetSizes struct {
+	Retry     int64 `json:"retry"`
+	Scheduled int64 `json:"scheduled"`
+	Dead      int64 `json:"dead"`
+}
+
+// SetSizes returns the number of jobs in the retry, scheduled and dead sets.
+func (c *Client) SetSizes() (*SetSizes, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Send("ZCARD", redisKeyRetry(c.namespace))
+	conn.Send("ZCARD", redisKeyScheduled(c.namespace))
+	conn.Send("ZCARD", redisKeyDead(c.namespace))
+	if err := conn.Flush(); err != nil {
+		c.logger.Error("client.set_sizes.flush", err)
+		return nil, err
+	}
+
+	sizes := &SetSizes{}
+	for _, size := range []*int64{&sizes.Retry, &sizes.Scheduled, &sizes.Dead} {
+		var err error
+		if *size, err = redis.Int64(conn.Receive()); err != nil {
+			c.logger.Error("client.set_sizes.receive", err)
+			return nil, err
+		}
+	}
+
+	return sizes, nil
+}
+
+// receiveOptionalInt64 receives the reply to a GET of an integer, or -1 if the key doesn't exist.
+func receiveOptionalInt64(conn redis.Conn) (int64, error) {
+	v, err := redis.Int64(conn.Receive())
+	if err == redis.ErrNil {
+		return -1, nil
+	}
+	return v, err
+}
+
 // RetryJob represents a job in the retry queue.
 type RetryJob struct {
 	RetryAt int64 `json:"retry_at"`
@@ -299,56 +448,17 @@ type DeadJob struct {
 
 // ScheduledJobs returns a list of ScheduledJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of scheduled jobs is also returned.
 func (c *Client) ScheduledJobs(page uint) ([]*ScheduledJob, int64, error) {
-	key := redisKeyScheduled(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
-	if err != nil {
-		logError("client.scheduled_jobs.get_zset_page", err)
-		return nil, 0, err
-	}
-
-	jobs := make([]*ScheduledJob, 0, len(jobsWithScores))
-
-	for _, jws := range jobsWithScores {
-		jobs = append(jobs, &ScheduledJob{RunAt: jws.Score, Job: jws.job})
-	}
-
-	return jobs, count, nil
+	return c.ScheduledJobsMatching(JobFilter{}, page)
 }
 
 // RetryJobs returns a list of RetryJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of retry jobs is also returned.
 func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
-	key := redisKeyRetry(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
-	if err != nil {
-		logError("client.retry_jobs.get_zset_page", err)
-		return nil, 0, err
-	}
-
-	jobs := make([]*RetryJob, 0, len(jobsWithScores))
-
-	for _, jws := range jobsWithScores {
-		jobs = append(jobs, &RetryJob{RetryAt: jws.Score, Job: jws.job})
-	}
-
-	return jobs, count, nil
+	return c.RetryJobsMatching(JobFilter{}, page)
 }
 
 // DeadJobs returns a list of DeadJob's. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of dead jobs is also returned.
 func (c *Client) DeadJobs(page uint) ([]*DeadJob, int64, error) {
-	key := redisKeyDead(c.namespace)
-	jobsWithScores, count, err := c.getZsetPage(key, page)
-	if err != nil {
-		logError("client.dead_jobs.get_zset_page", err)
-		return nil, 0, err
-	}
-
-	jobs := make([]*DeadJob, 0, len(jobsWithScores))
-
-	for _, jws := range jobsWithScores {
-		jobs = append(jobs, &DeadJob{DiedAt: jws.Score, Job: jws.job})
-	}
-
-	return jobs, count, nil
+	return c.DeadJobsMatching(JobFilter{}, page)
 }
 
 // DeleteDeadJob deletes a dead job from Redis.
@@ -368,7 +478,7 @@ func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 	// Get queues for job names
 	queues, err := c.Queues()
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
+		c.logger.Error("client.retry_all_dead_jobs.queues", err)
 		return err
 	}
 
@@ -395,7 +505,7 @@ func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 
 	cnt, err := redis.Int64(script.Do(conn, args...))
 	if err != nil {
-		logError("client.retry_dead_job.do", err)
+		c.logger.Error("client.retry_dead_job.do", err, "job_id", jobID)
 		return err
 	}
 
@@ -403,6 +513,11 @@ func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 		return ErrNotRetried
 	}
 
+	if err := c.publishWakeup(conn, ""); err != nil {
+		c.logger.Error("client.retry_dead_job.publish", err, "job_id", jobID)
+		return err
+	}
+
 	return nil
 }
 
@@ -411,7 +526,7 @@ func (c *Client) RetryAllDeadJobs() error {
 	// Get queues for job names
 	queues, err := c.Queues()
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
+		c.logger.Error("client.retry_all_dead_jobs.queues", err)
 		return err
 	}
 
@@ -440,7 +555,7 @@ func (c *Client) RetryAllDeadJobs() error {
 	for i := 0; i < 1000; i++ {
 		res, err := redis.Int64(script.Do(conn, args...))
 		if err != nil {
-			logError("client.retry_all_dead_jobs.do", err)
+			c.logger.Error("client.retry_all_dead_jobs.do", err)
 			return err
 		}
 
@@ -449,6 +564,11 @@ func (c *Client) RetryAllDeadJobs() error {
 		}
 	}
 
+	if err := c.publishWakeup(conn, ""); err != nil {
+		c.logger.Error("client.retry_all_dead_jobs.publish", err)
+		return err
+	}
+
 	return nil
 }
 
@@ -458,7 +578,7 @@ func (c *Client) DeleteAllDeadJobs() error {
 	defer conn.Close()
 	_, err := conn.Do("DEL", redisKeyDead(c.namespace))
 	if err != nil {
-		logError("client.delete_all_dead_jobs", err)
+		c.logger.Error("client.delete_all_dead_jobs", err)
 		return err
 	}
 
@@ -476,14 +596,14 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 	if len(jobBytes) > 0 {
 		job, err := newJob(jobBytes, nil, nil)
 		if err != nil {
-			logError("client.delete_scheduled_job.new_job", err)
+			c.logger.Error("client.delete_scheduled_job.new_job", err, "job_id", jobID)
 			return err
 		}
 
 		if job.Unique {
 			uniqueKey, err := redisKeyUniqueJob(c.namespace, job.Name, job.Args)
 			if err != nil {
-				logError("client.delete_scheduled_job.redis_key_unique_job", err)
+				c.logger.Error("client.delete_scheduled_job.redis_key_unique_job", err, jobFields(job)...)
 				return err
 			}
 			conn := c.pool.Get()
@@ -491,7 +611,7 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 
 			_, err = conn.Do("DEL", uniqueKey)
 			if err != nil {
-				logError("worker.delete_unique_job.del", err)
+				c.logger.Error("worker.delete_unique_job.del", err, jobFields(job)...)
 				return err
 			}
 		}
@@ -515,7 +635,103 @@ func (c *Client) DeleteRetryJob(retryAt int64, jobID string) error {
 	return nil
 }
 
-// deleteZsetJob deletes the job in the specified zset (dead, retry, or scheduled queue). zsetKey is like "work:dead" or "work:scheduled". The function deletes all jobs with the given jobID with the specified zscore (there should only be one, but in theory there could be bad data). It will return if at least one job is deleted and if
+// SetRateLimit overrides the RateLimit of jobName jobs, as set in their JobOptions, across all worker pools. It's kept
+// until ResetRateLimit is called, even when worker pools are restarted. A zero RateLimit lifts the limit.
+func (c *Client) SetRateLimit(jobName string, limit RateLimit) error {
+	if limit.Count > 0 && limit.Period < time.Millisecond {
+		return fmt.Errorf("rate limit period must be at least a millisecond")
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if err := doAndWakeup(conn, c.wakeup, c.namespace, jobName, "HMSET", redisKeyJobsRateLimit(c.namespace, jobName), "override_count", limit.Count, "override_period", limit.Period.Milliseconds()); err != nil {
+		c.logger.Error("client.set_rate_limit", err, "job_name", jobName)
+		return err
+	}
+
+	return nil
+}
+
+// ResetRateLimit removes the override set by SetRateLimit, so that the RateLimit from the JobOptions applies again.
+func (c *Client) ResetRateLimit(jobName string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if err := doAndWakeup(conn, c.wakeup, c.namespace, jobName, "HDEL", redisKeyJobsRateLimit(c.namespace, jobName), "override_count", "override_period"); err != nil {
+		c.logger.Error("client.reset_rate_limit", err, "job_name", jobName)
+		return err
+	}
+
+	return nil
+}
+
+// PauseJob stops all worker pools from starting jobName jobs until UnpauseJob is called. Running jobs aren't affected.
+func (c *Client) PauseJob(jobName string) error {
+	return c.PauseJobFor(jobName, 0)
+}
+
+// PauseJobFor is like PauseJob, but the pause is lifted automatically after d, rounded up to the millisecond. A d of 0
+// means until UnpauseJob is called.
+func (c *Client) PauseJobFor(jobName string, d time.Duration) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	args := []interface{}{redisKeyJobsPaused(c.namespace, jobName), "1"}
+	if d > 0 {
+		// Redis rejects an expiry of 0
+		args = append(args, "PX", int64((d+time.Millisecond-1)/time.Millisecond))
+	}
+	if _, err := conn.Do("SET", args...); err != nil {
+		c.logger.Error("client.pause_job", err, "job_name", jobName)
+		return err
+	}
+
+	return nil
+}
+
+// UnpauseJob lets worker pools start jobName jobs again.
+func (c *Client) UnpauseJob(jobName string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if err := doAndWakeup(conn, c.wakeup, c.namespace, jobName, "DEL", redisKeyJobsPaused(c.namespace, jobName)); err != nil {
+		c.logger.Error("client.unpause_job", err, "job_name", jobName)
+		return err
+	}
+
+	return nil
+}
+
+// SetMaxConcurrency overrides the MaxConcurrency of jobName jobs, as set in their JobOptions, across all worker pools.
+// It's kept until ResetMaxConcurrency is called, even when worker pools are restarted. A max of 0 means no limit.
+func (c *Client) SetMaxConcurrency(jobName string, max uint) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if err := doAndWakeup(conn, c.wakeup, c.namespace, jobName, "SET", redisKeyJobsConcurrencyOverride(c.namespace, jobName), max); err != nil {
+		c.logger.Error("client.set_max_concurrency", err, "job_name", jobName)
+		return err
+	}
+
+	return nil
+}
+
+// ResetMaxConcurrency removes the override set by SetMaxConcurrency, so that the MaxConcurrency from the JobOptions
+// applies again.
+func (c *Client) ResetMaxConcurrency(jobName string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if err := doAndWakeup(conn, c.wakeup, c.namespace, jobName, "DEL", redisKeyJobsConcurrencyOverride(c.namespace, jobName)); err != nil {
+		c.logger.Error("client.reset_max_concurrency", err, "job_name", jobName)
+		return err
+	}
+
+	return nil
+}
+
+// deleteZsetJob deletes the job in the specified zset (dead, retry, or scheduled queue). zsetKey is like "{work}:dead" or "{work}:scheduled". The function deletes all jobs with the given jobID with the specified zscore (there should only be one, but in theory there could be bad data). It will return if at least one job is deleted and if
 func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool, []byte, error) {
 	script := redis.NewScript(1, redisLuaDeleteSingleCmd)
 
@@ -534,7 +750,7 @@ func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool
 	cnt, err := redis.Int64(values[0], err)
 	jobBytes, err := redis.Bytes(values[1], err)
 	if err != nil {
-		logError("client.delete_zset_job.do", err)
+		c.logger.Error("client.delete_zset_job.do", err, "job_id", jobID)
 		return false, nil, err
 	}
 
@@ -546,43 +762,3 @@ type jobScore struct {
 	Score    int64
 	job      *Job
 }
-
-func (c *Client) getZsetPage(key string, page uint) ([]jobScore, int64, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
-
-	if page == 0 {
-		page = 1
-	}
-
-	values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", "+inf", "WITHSCORES", "LIMIT", (page-1)*20, 20))
-	if err != nil {
-		logError("client.get_zset_page.values", err)
-		return nil, 0, err
-	}
-
-	var jobsWithScores []jobScore
-
-	if err := redis.ScanSlice(values, &jobsWithScores); err != nil {
-		logError("client.get_zset_page.scan_slice", err)
-		return nil, 0, err
-	}
-
-	for i, jws := range jobsWithScores {
-		job, err := newJob(jws.JobBytes, nil, nil)
-		if err != nil {
-			logError("client.get_zset_page.new_job", err)
-			return nil, 0, err
-		}
-
-		jobsWithScores[i].job = job
-	}
-
-	count, err := redis.Int64(conn.Do("ZCARD", key))
-	if err != nil {
-		logError("client.get_zset_page.int64", err)
-		return nil, 0, err
-	}
-
-	return jobsWithScores, count, nil
-}
@@ -183,6 +183,140 @@ func TestClientQueues(t *testing.T) {
 	assert.EqualValues(t, 0, queues[2].Latency)
 }
 
+func TestClientQueuesNamedQueues(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{
+		Queues: []QueueSubscription{{Name: "critical"}, {Name: DefaultQueue}},
+	})
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.writeKnownJobsToRedis(wp.jobTypes)
+	wp.writeKnownQueuesToRedis()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	setNowEpochSecondsMock(1425263409)
+	defer resetNowEpochSecondsMock()
+	_, err := enqueuer.EnqueueOnQueue("critical", "wat", nil)
+	assert.NoError(t, err)
+	setNowEpochSecondsMock(1425263509)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueOnQueue("critical", "wat", nil)
+	assert.NoError(t, err)
+
+	setNowEpochSecondsMock(1425263709)
+	client := NewClient(ns, pool)
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.EqualValues(t, 3, queues[0].Count)
+		assert.EqualValues(t, 300, queues[0].Latency)
+		assert.Equal(t, map[string]int64{"critical": 2}, queues[0].NamedQueues)
+	}
+}
+
+func TestClientPauseJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+	wp := setupTestWorkerPool(pool, ns, job1, 3, JobOptions{Priority: 1})
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"sleep": 0})
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.PauseJob(job1))
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.True(t, queues[0].Paused)
+		assert.EqualValues(t, 0, queues[0].PausedUntil)
+	}
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+
+	// A timed pause ends by itself
+	assert.NoError(t, client.PauseJobFor(job1, time.Hour))
+	queues, err = client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.True(t, queues[0].Paused)
+		assert.InDelta(t, time.Now().Add(time.Hour).Unix(), queues[0].PausedUntil, 2)
+	}
+	assert.True(t, ttl(pool, redisKeyJobsPaused(ns, job1)) > 0)
+
+	// A pause under a millisecond lasts a millisecond
+	assert.NoError(t, client.PauseJobFor(job1, time.Microsecond))
+
+	assert.NoError(t, client.UnpauseJob(job1))
+	queues, err = client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.False(t, queues[0].Paused)
+	}
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+}
+
+func TestClientSetMaxConcurrency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+	wp := setupTestWorkerPool(pool, ns, job1, 3, JobOptions{Priority: 1, MaxConcurrency: 1})
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"sleep": 0})
+	assert.NoError(t, err)
+
+	// Pretend another worker pool runs a job1 job
+	conn := pool.Get()
+	_, err = conn.Do("SET", redisKeyJobsLock(ns, job1), 1)
+	conn.Close()
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+
+	client := NewClient(ns, pool)
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.EqualValues(t, 1, queues[0].MaxConcurrency)
+		assert.False(t, queues[0].MaxConcurrencyOverridden)
+	}
+
+	// The override outlives restarts
+	assert.NoError(t, client.SetMaxConcurrency(job1, 2))
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+
+	queues, err = client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.EqualValues(t, 2, queues[0].MaxConcurrency)
+		assert.True(t, queues[0].MaxConcurrencyOverridden)
+	}
+
+	assert.NoError(t, client.ResetMaxConcurrency(job1))
+	queues, err = client.Queues()
+	assert.NoError(t, err)
+	if assert.Len(t, queues, 1) {
+		assert.EqualValues(t, 1, queues[0].MaxConcurrency)
+		assert.False(t, queues[0].MaxConcurrencyOverridden)
+	}
+}
+
 func TestClientScheduledJobs(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -82,7 +82,7 @@ func cleanKeyspace(pool *redis.Pool, namespace string) {
 	conn := pool.Get()
 	defer conn.Close()
 
-	keys, err := redis.Strings(conn.Do("KEYS", namespace+"*"))
+	keys, err := redis.Strings(conn.Do("KEYS", "{"+namespace+"}:*"))
 	if err != nil {
 		panic("could not get keys: " + err.Error())
 	}
@@ -0,0 +1,96 @@
+package work
+
+import (
+	"encoding/json"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// concurrencyKey returns the concurrency key of a job's args, made of the values of keys in order.
+func concurrencyKey(keys []string, args map[string]interface{}) (string, error) {
+	values := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, args[key])
+	}
+
+	key, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return string(key), nil
+}
+
+// acquireConcurrencyKey takes the concurrency key of job, the fetched job with the args of its unique job if it has one.
+// If too many jobs with the same key are running, the fetched job is moved from the in progress queue to the key's
+// waiting list, its rate limit token is given back, and false is returned. The job is put back on the job queue once
+// one of them is done.
+func (w *worker) acquireConcurrencyKey(fetched, job *Job, jt *jobType) bool {
+	key, err := concurrencyKey(jt.ConcurrencyKeys, job.Args)
+	if err != nil {
+		w.logger.Error("worker.acquire_concurrency_key.key", err, jobFields(job)...)
+		return true
+	}
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	acquired, err := redis.Bool(w.acquireKeyScript.Do(conn,
+		redisKeyJobsKeyLocks(w.namespace, job.Name),              // KEYS[1]
+		redisKeyJobsKeyLockInfo(w.namespace, job.Name, w.poolID), // KEYS[2]
+		redisKeyJobsKeyWaitingPrefix(w.namespace, job.Name)+key,  // KEYS[3]
+		fetched.inProgQueue,                           // KEYS[4]
+		redisKeyJobsLock(w.namespace, job.Name),       // KEYS[5]
+		redisKeyJobsLockInfo(w.namespace, job.Name),   // KEYS[6]
+		redisKeyJobsRateWindow(w.namespace, job.Name), // KEYS[7]
+		key,                     // ARGV[1]
+		jt.MaxConcurrencyPerKey, // ARGV[2]
+		fetched.rawJSON,         // ARGV[3]
+		w.poolID,                // ARGV[4]
+	))
+	if err != nil {
+		// Better to run the job than to leave it stuck in the in progress queue
+		w.logger.Error("worker.acquire_concurrency_key.script", err, jobFields(job)...)
+		return true
+	}
+	if acquired {
+		job.concurrencyKey = key
+	}
+
+	return acquired
+}
+
+// releaseConcurrencyKey queues the release of the concurrency key of job on conn, once the job ran.
+func (w *worker) releaseConcurrencyKey(conn redis.Conn, job *Job) {
+	w.releaseKeyScript.Send(conn,
+		redisKeyJobsKeyLocks(w.namespace, job.Name),                            // KEYS[1]
+		redisKeyJobsKeyLockInfo(w.namespace, job.Name, w.poolID),               // KEYS[2]
+		redisKeyJobsKeyWaitingPrefix(w.namespace, job.Name)+job.concurrencyKey, // KEYS[3]
+		redisKeyJobs(w.namespace, job.Name),                                    // KEYS[4]
+		job.concurrencyKey,                                                     // ARGV[1]
+		redisKeyJobsPrefix(w.namespace),                                        // ARGV[2]
+	)
+}
+
+// reapStaleKeyLocks releases the concurrency keys held by poolID's jobs, putting a waiting job back on its job queue
+// for each of them.
+func reapStaleKeyLocks(namespace string, pool *redis.Pool, poolID string, jobTypes []string) error {
+	script := redis.NewScript(3, redisLuaReapStaleKeyLocks)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	for _, jobType := range jobTypes {
+		if _, err := script.Do(conn,
+			redisKeyJobsKeyLocks(namespace, jobType),
+			redisKeyJobsKeyLockInfo(namespace, jobType, poolID),
+			redisKeyJobs(namespace, jobType),
+			redisKeyJobsKeyWaitingPrefix(namespace, jobType),
+			redisKeyJobsPrefix(namespace),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
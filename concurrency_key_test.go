@@ -0,0 +1,194 @@
+package work
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrencyKey(t *testing.T) {
+	key, err := concurrencyKey([]string{"account_id", "currency"}, Q{"account_id": 42.0, "currency": "EUR", "amount": 3})
+	assert.NoError(t, err)
+	assert.Equal(t, `[42,"EUR"]`, key)
+
+	key, err = concurrencyKey([]string{"account_id"}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `[null]`, key)
+}
+
+func TestWorkerPoolConcurrencyKeys(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "settle_card"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	running := map[float64]int{}
+	maxRunning := map[float64]int{}
+	total, maxTotal, ran := 0, 0, 0
+
+	wp := NewWorkerPool(TestContext{}, 6, ns, pool)
+	wp.JobWithOptions(job1, JobOptions{ConcurrencyKeys: []string{"account_id"}}, func(job *Job) error {
+		account := job.Args["account_id"].(float64)
+		mtx.Lock()
+		running[account]++
+		total++
+		if running[account] > maxRunning[account] {
+			maxRunning[account] = running[account]
+		}
+		if total > maxTotal {
+			maxTotal = total
+		}
+		mtx.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mtx.Lock()
+		running[account]--
+		total--
+		ran++
+		mtx.Unlock()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 4; i++ {
+		for _, account := range []int{1, 2, 3} {
+			_, err := enqueuer.Enqueue(job1, Q{"account_id": account})
+			assert.NoError(t, err)
+		}
+	}
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// Every job ran, one at a time per account, while the accounts ran in parallel
+	assert.Equal(t, 12, ran)
+	assert.Equal(t, map[float64]int{1: 1, 2: 1, 3: 1}, maxRunning)
+	assert.True(t, maxTotal > 1)
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsKeyWaitingPrefix(ns, job1)+"[1]"))
+	assert.EqualValues(t, 0, hashSize(pool, redisKeyJobsKeyLocks(ns, job1)))
+	assert.EqualValues(t, 0, hashSize(pool, redisKeyJobsKeyLockInfo(ns, job1, wp.workerPoolID)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+}
+
+func TestWorkerParksJobsWithTakenConcurrencyKey(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "settle_card"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"account_id": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"account_id": 1})
+	assert.NoError(t, err)
+
+	jobTypes := map[string]*jobType{
+		job1: {
+			Name:       job1,
+			JobOptions: applyDefaultsAndValidate(JobOptions{ConcurrencyKeys: []string{"account_id"}}),
+			IsGeneric:  true,
+			GenericHandler: func(job *Job) error {
+				return nil
+			},
+		},
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	waitingKey := redisKeyJobsKeyWaitingPrefix(ns, job1) + "[1]"
+
+	first, err := w.fetchJob()
+	assert.NoError(t, err)
+	assert.True(t, w.acquireConcurrencyKey(first, first, jobTypes[job1]))
+	assert.Equal(t, "[1]", first.concurrencyKey)
+
+	// The second job with the same key is parked
+	second, err := w.fetchJob()
+	assert.NoError(t, err)
+	w.processJob(second)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 1, listSize(pool, waitingKey))
+	assert.EqualValues(t, 1, getInt64(pool, redisKeyJobsLock(ns, job1)))
+
+	// Once the first job is done, the parked one is back on the queue
+	w.removeJobFromInProgress(first, terminateOnly, jobSucceeded)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, listSize(pool, waitingKey))
+	assert.EqualValues(t, 0, hashSize(pool, redisKeyJobsKeyLocks(ns, job1)))
+
+	job, err := w.fetchJob()
+	assert.NoError(t, err)
+	assert.Equal(t, second.ID, job.ID)
+	w.processJob(job)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, hashSize(pool, redisKeyJobsKeyLocks(ns, job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+}
+
+func TestWorkerConcurrencyKeyOfUniqueJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "settle_card"
+	cleanKeyspace(ns, pool)
+
+	var accounts []float64
+	jobTypes := map[string]*jobType{
+		job1: {
+			Name:       job1,
+			JobOptions: applyDefaultsAndValidate(JobOptions{ConcurrencyKeys: []string{"account_id"}}),
+			IsGeneric:  true,
+			GenericHandler: func(job *Job) error {
+				accounts = append(accounts, job.ArgFloat64("account_id"))
+				return nil
+			},
+		},
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	conn := pool.Get()
+	_, err := conn.Do("HMSET", redisKeyJobsRateLimit(ns, job1), "count", 10, "period", time.Hour.Milliseconds())
+	conn.Close()
+	assert.NoError(t, err)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err = enqueuer.Enqueue(job1, Q{"account_id": 1})
+	assert.NoError(t, err)
+	unique, err := enqueuer.EnqueueUniqueByKey(job1, Q{"account_id": 1}, Q{"card": 7})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUniqueByKey(job1, Q{"account_id": 2}, Q{"card": 7})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"account_id": 1})
+	assert.NoError(t, err)
+
+	first, err := w.fetchJob()
+	assert.NoError(t, err)
+	assert.True(t, w.acquireConcurrencyKey(first, first, jobTypes[job1]))
+
+	// The unique job takes the key of the args it was last enqueued with
+	job, err := w.fetchJob()
+	assert.NoError(t, err)
+	assert.Equal(t, unique.ID, job.ID)
+	w.processJob(job)
+	assert.Equal(t, []float64{2}, accounts)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsKeyWaitingPrefix(ns, job1)+"[2]"))
+	conn = pool.Get()
+	uniqueLeft, err := redis.Bool(conn.Do("EXISTS", unique.UniqueKey))
+	conn.Close()
+	assert.NoError(t, err)
+	assert.False(t, uniqueLeft)
+
+	// A parked job gives back its rate limit token
+	job, err = w.fetchJob()
+	assert.NoError(t, err)
+	w.processJob(job)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsKeyWaitingPrefix(ns, job1)+"[1]"))
+	assert.EqualValues(t, 2, getInt64(pool, redisKeyJobsRateWindow(ns, job1)))
+}
@@ -0,0 +1,232 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	prunePeriod     = time.Minute
+	pruneJitterSecs = 10
+	pruneChunkSize  = 1000
+)
+
+// DeadJobArchiver is given the dead jobs a worker pool prunes, see WorkerPoolOptions.DeadJobArchiver, before they're
+// deleted. If it returns an error the jobs aren't deleted, and it's given them again at the next prune. Dead entries
+// that can't be decoded are logged and deleted without it.
+type DeadJobArchiver interface {
+	ArchiveDeadJobs(jobs []*DeadJob) error
+}
+
+// DeadJobArchiverFunc is a function that is a DeadJobArchiver.
+type DeadJobArchiverFunc func(jobs []*DeadJob) error
+
+// ArchiveDeadJobs calls f.
+func (f DeadJobArchiverFunc) ArchiveDeadJobs(jobs []*DeadJob) error {
+	return f(jobs)
+}
+
+type jsonLinesArchiver struct {
+	mtx sync.Mutex
+	w   io.Writer
+}
+
+// NewJSONLinesArchiver returns a DeadJobArchiver that writes the dead jobs to w as JSON, one per line, like the web UI
+// shows them. w is typically a file opened with os.O_APPEND.
+func NewJSONLinesArchiver(w io.Writer) DeadJobArchiver {
+	return &jsonLinesArchiver{w: w}
+}
+
+func (a *jsonLinesArchiver) ArchiveDeadJobs(jobs []*DeadJob) error {
+	var lines []byte
+	for _, job := range jobs {
+		line, err := json.Marshal(job)
+		if err != nil {
+			return err
+		}
+		lines = append(append(lines, line...), '\n')
+	}
+
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	_, err := a.w.Write(lines)
+	return err
+}
+
+// deadJobPruner deletes the dead jobs that died more than maxAge ago, then the oldest ones beyond maxCount.
+type deadJobPruner struct {
+	namespace    string
+	pool         *redis.Pool
+	workerPoolID string
+	maxAge       time.Duration
+	maxCount     uint
+	archiver     DeadJobArchiver
+	prunePeriod  time.Duration
+	logger       Logger
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+func newDeadJobPruner(namespace string, pool *redis.Pool, workerPoolID string, maxAge time.Duration, maxCount uint, archiver DeadJobArchiver) *deadJobPruner {
+	return &deadJobPruner{
+		namespace:        namespace,
+		pool:             pool,
+		workerPoolID:     workerPoolID,
+		maxAge:           maxAge,
+		maxCount:         maxCount,
+		archiver:         archiver,
+		prunePeriod:      prunePeriod,
+		logger:           withFields(defaultLogger, "namespace", namespace, "pool_id", workerPoolID),
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+func (p *deadJobPruner) start() {
+	go p.loop()
+}
+
+func (p *deadJobPruner) stop() {
+	p.stopChan <- struct{}{}
+	<-p.doneStoppingChan
+}
+
+func (p *deadJobPruner) loop() {
+	timer := time.NewTimer(time.Duration(rand.Intn(pruneJitterSecs)) * time.Second)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-p.stopChan:
+			p.doneStoppingChan <- struct{}{}
+			return
+		case <-timer.C:
+			timer.Reset(p.prunePeriod + time.Duration(rand.Intn(pruneJitterSecs))*time.Second)
+
+			if err := p.prune(); err != nil {
+				p.logger.Error("dead_job_pruner.prune", err)
+			}
+		}
+	}
+}
+
+// prune prunes the dead queue, unless another worker pool did in the last prune period.
+func (p *deadJobPruner) prune() error {
+	conn := p.pool.Get()
+	defer conn.Close()
+
+	_, err := redis.String(conn.Do("SET", redisKeyDeadPrunerLock(p.namespace), p.workerPoolID, "NX", "PX", p.prunePeriod.Milliseconds()))
+	if err == redis.ErrNil {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if p.maxAge > 0 {
+		if err := p.pruneByAge(conn); err != nil {
+			return err
+		}
+	}
+	if p.maxCount > 0 {
+		if err := p.pruneByCount(conn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneByAge deletes the jobs that died more than maxAge ago.
+func (p *deadJobPruner) pruneByAge(conn redis.Conn) error {
+	key := redisKeyDead(p.namespace)
+	diedBefore := fmt.Sprintf("(%d", nowEpochSeconds()-int64(p.maxAge/time.Second))
+	if p.archiver == nil {
+		_, err := conn.Do("ZREMRANGEBYSCORE", key, "-inf", diedBefore)
+		return err
+	}
+
+	for {
+		values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", diedBefore, "WITHSCORES", "LIMIT", 0, pruneChunkSize))
+		if err != nil {
+			return err
+		}
+		n, err := p.archiveAndDelete(conn, values)
+		if err != nil || n < pruneChunkSize {
+			return err
+		}
+	}
+}
+
+// pruneByCount deletes the oldest dead jobs beyond maxCount.
+func (p *deadJobPruner) pruneByCount(conn redis.Conn) error {
+	key := redisKeyDead(p.namespace)
+	count, err := redis.Int64(conn.Do("ZCARD", key))
+	if err != nil {
+		return err
+	}
+	excess := count - int64(p.maxCount)
+	if excess <= 0 {
+		return nil
+	}
+	if p.archiver == nil {
+		_, err := conn.Do("ZREMRANGEBYRANK", key, 0, excess-1)
+		return err
+	}
+
+	for excess > 0 {
+		chunk := excess
+		if chunk > pruneChunkSize {
+			chunk = pruneChunkSize
+		}
+		values, err := redis.Values(conn.Do("ZRANGE", key, 0, chunk-1, "WITHSCORES"))
+		if err != nil {
+			return err
+		}
+		n, err := p.archiveAndDelete(conn, values)
+		if err != nil || n == 0 {
+			return err
+		}
+		excess -= int64(n)
+	}
+	return nil
+}
+
+// archiveAndDelete archives the dead jobs of a ZRANGE WITHSCORES reply, then deletes them, and returns how many there
+// were. Jobs that can't be decoded can't be archived: they're logged and deleted, so that they don't hold up pruning.
+func (p *deadJobPruner) archiveAndDelete(conn redis.Conn, values []interface{}) (int, error) {
+	var jobsWithScores []jobScore
+	if err := redis.ScanSlice(values, &jobsWithScores); err != nil {
+		return 0, err
+	}
+	if len(jobsWithScores) == 0 {
+		return 0, nil
+	}
+
+	jobs := make([]*DeadJob, 0, len(jobsWithScores))
+	args := make([]interface{}, 0, len(jobsWithScores)+1)
+	args = append(args, redisKeyDead(p.namespace))
+	for _, jws := range jobsWithScores {
+		args = append(args, jws.JobBytes)
+		job, err := newJob(jws.JobBytes, nil, nil)
+		if err != nil {
+			p.logger.Error("dead_job_pruner.new_job", err, "died_at", jws.Score, "job", string(jws.JobBytes))
+			continue
+		}
+		jobs = append(jobs, &DeadJob{DiedAt: jws.Score, Job: job})
+	}
+
+	if len(jobs) > 0 {
+		if err := p.archiver.ArchiveDeadJobs(jobs); err != nil {
+			return 0, err
+		}
+	}
+	_, err := conn.Do("ZREM", args...)
+	return len(jobsWithScores), err
+}
@@ -0,0 +1,142 @@
+package work
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeadJobPrunerMaxAge(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	now := nowEpochSeconds()
+	for i, age := range []int64{7200, 3601, 60, 0} {
+		rawJSON, err := json.Marshal(&Job{Name: "wat", ID: fmt.Sprint(i)})
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", redisKeyDead(ns), now-age, rawJSON)
+		assert.NoError(t, err)
+	}
+
+	pruner := newDeadJobPruner(ns, pool, "1", time.Hour, 0, nil)
+	assert.NoError(t, pruner.prune())
+
+	jobs, count, err := NewClient(ns, pool).DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	if assert.Len(t, jobs, 2) {
+		assert.Equal(t, "2", jobs[0].ID)
+		assert.Equal(t, "3", jobs[1].ID)
+	}
+
+	// The pools take turns: another one doesn't prune again in the same period
+	_, err = conn.Do("ZADD", redisKeyDead(ns), now-7200, `{"name":"wat","id":"4"}`)
+	assert.NoError(t, err)
+	assert.NoError(t, newDeadJobPruner(ns, pool, "2", time.Hour, 0, nil).prune())
+	assert.EqualValues(t, 3, zsetSize(pool, redisKeyDead(ns)))
+
+	// A pool with retention settings starts and stops its pruner
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{DeadJobMaxAge: time.Hour})
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	assert.NotNil(t, wp.deadJobPruner)
+	wp.Stop()
+	assert.Nil(t, wp.deadJobPruner)
+}
+
+func TestDeadJobPrunerMaxCountArchive(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var jobs []*Job
+	for i := 0; i < 2500; i++ {
+		jobs = append(jobs, &Job{Name: "wat", ID: fmt.Sprint(i)})
+	}
+	addZsetJobs(t, pool, redisKeyDead(ns), jobs...)
+
+	var archived []*DeadJob
+	fail := true
+	archiver := DeadJobArchiverFunc(func(jobs []*DeadJob) error {
+		if fail {
+			return fmt.Errorf("disk full")
+		}
+		archived = append(archived, jobs...)
+		return nil
+	})
+
+	// Jobs that couldn't be archived are kept
+	pruner := newDeadJobPruner(ns, pool, "1", 0, 100, archiver)
+	assert.EqualError(t, pruner.prune(), "disk full")
+	assert.EqualValues(t, 2500, zsetSize(pool, redisKeyDead(ns)))
+
+	cleanKeyspace(ns, pool)
+	addZsetJobs(t, pool, redisKeyDead(ns), jobs...)
+	fail = false
+	assert.NoError(t, pruner.prune())
+	assert.EqualValues(t, 100, zsetSize(pool, redisKeyDead(ns)))
+	if assert.Len(t, archived, 2400) {
+		for i, job := range archived {
+			assert.Equal(t, fmt.Sprint(i), job.ID)
+			assert.EqualValues(t, 1000+i, job.DiedAt)
+		}
+	}
+}
+
+func TestDeadJobPrunerUndecodableJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("ZADD", redisKeyDead(ns), 1000, "not json")
+	assert.NoError(t, err)
+	addZsetJobs(t, pool, redisKeyDead(ns), &Job{Name: "wat", ID: "a"}, &Job{Name: "wat", ID: "b"})
+
+	var archived []*DeadJob
+	archiver := DeadJobArchiverFunc(func(jobs []*DeadJob) error {
+		archived = append(archived, jobs...)
+		return nil
+	})
+
+	// A job that can't be decoded is logged and deleted, and the others are still pruned
+	logger := &testLogger{}
+	pruner := newDeadJobPruner(ns, pool, "1", 0, 1, archiver)
+	pruner.logger = logger
+	assert.NoError(t, pruner.prune())
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	if assert.Len(t, archived, 1) {
+		assert.Equal(t, "a", archived[0].ID)
+	}
+	if entry := logger.find("dead_job_pruner.new_job"); assert.NotNil(t, entry) {
+		assert.Equal(t, "not json", entry.fields["job"])
+	}
+}
+
+func TestJSONLinesArchiver(t *testing.T) {
+	var buf bytes.Buffer
+	archiver := NewJSONLinesArchiver(&buf)
+	err := archiver.ArchiveDeadJobs([]*DeadJob{
+		{DiedAt: 1425263409, Job: &Job{Name: "wat", ID: "a", LastErr: "ohno"}},
+		{DiedAt: 1425263410, Job: &Job{Name: "wat", ID: "b"}},
+	})
+	assert.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if assert.Len(t, lines, 2) {
+		var job DeadJob
+		assert.NoError(t, json.Unmarshal([]byte(lines[0]), &job))
+		assert.EqualValues(t, 1425263409, job.DiedAt)
+		assert.Equal(t, "a", job.ID)
+		assert.Equal(t, "ohno", job.LastErr)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -17,10 +18,15 @@ const (
 )
 
 type deadPoolReaper struct {
-	namespace   string
-	pool        *redis.Pool
-	deadTime    time.Duration
-	reapPeriod  time.Duration
+	namespace  string
+	pool       *redis.Pool
+	deadTime   time.Duration
+	reapPeriod time.Duration
+	logger     Logger
+	wakeup     bool // publish a wakeup once jobs were requeued, set with WorkerPoolOptions.BlockingFetch
+
+	// mtx guards curJobTypes, which changes when job types are added or removed
+	mtx         sync.Mutex
 	curJobTypes []string
 
 	stopChan         chan struct{}
@@ -34,11 +40,19 @@ func newDeadPoolReaper(namespace string, pool *redis.Pool, curJobTypes []string)
 		deadTime:         deadTime,
 		reapPeriod:       reapPeriod,
 		curJobTypes:      curJobTypes,
+		logger:           withFields(defaultLogger, "namespace", namespace),
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 	}
 }
 
+// updateJobTypes sets the job types whose locks are cleaned up for dead pools without a heartbeat.
+func (r *deadPoolReaper) updateJobTypes(curJobTypes []string) {
+	r.mtx.Lock()
+	r.curJobTypes = curJobTypes
+	r.mtx.Unlock()
+}
+
 func (r *deadPoolReaper) start() {
 	go r.loop()
 }
@@ -64,7 +78,7 @@ func (r *deadPoolReaper) loop() {
 
 			// Reap
 			if err := r.reap(); err != nil {
-				logError("dead_pool_reaper.reap", err)
+				r.logger.Error("dead_pool_reaper.reap", err)
 			}
 		}
 	}
@@ -93,7 +107,9 @@ func (r *deadPoolReaper) reap() error {
 			}
 		} else {
 			// try to clean up locks for the current set of jobs if heartbeat was not found
+			r.mtx.Lock()
 			lockJobTypes = r.curJobTypes
+			r.mtx.Unlock()
 		}
 		// Remove dead pool from worker pools set
 		if _, err = conn.Do("SREM", workerPoolsKey, deadPoolID); err != nil {
@@ -103,6 +119,10 @@ func (r *deadPoolReaper) reap() error {
 		if err = r.cleanStaleLockInfo(deadPoolID, lockJobTypes); err != nil {
 			return err
 		}
+		// Release the concurrency keys its jobs held
+		if err = reapStaleKeyLocks(r.namespace, r.pool, deadPoolID, lockJobTypes); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -128,31 +148,51 @@ func (r *deadPoolReaper) cleanStaleLockInfo(poolID string, jobTypes []string) er
 }
 
 func (r *deadPoolReaper) requeueInProgressJobs(poolID string, jobTypes []string) error {
+	_, err := requeueInProgressJobs(r.namespace, r.pool, poolID, jobTypes, r.wakeup)
+	return err
+}
+
+// requeueInProgressJobs moves every job in poolID's in progress queues back onto its job queue, releasing the locks
+// it held, and publishes a wakeup if wakeup is set. It returns the raw jobs that were moved.
+func requeueInProgressJobs(namespace string, pool *redis.Pool, poolID string, jobTypes []string, wakeup bool) ([][]byte, error) {
 	numKeys := len(jobTypes) * requeueKeysPerJob
 	redisRequeueScript := redis.NewScript(numKeys, redisLuaReenqueueJob)
-	var scriptArgs = make([]interface{}, 0, numKeys+1)
+	var scriptArgs = make([]interface{}, 0, numKeys+2)
 
 	for _, jobType := range jobTypes {
 		// pops from in progress, push into job queue and decrement the queue lock
-		scriptArgs = append(scriptArgs, redisKeyJobsInProgress(r.namespace, poolID, jobType), redisKeyJobs(r.namespace, jobType), redisKeyJobsLock(r.namespace, jobType), redisKeyJobsLockInfo(r.namespace, jobType)) // KEYS[1-4 * N]
+		scriptArgs = append(scriptArgs, redisKeyJobsInProgress(namespace, poolID, jobType), redisKeyJobs(namespace, jobType), redisKeyJobsLock(namespace, jobType), redisKeyJobsLockInfo(namespace, jobType)) // KEYS[1-4 * N]
 	}
-	scriptArgs = append(scriptArgs, poolID) // ARGV[1]
+	scriptArgs = append(scriptArgs, poolID)                        // ARGV[1]
+	scriptArgs = append(scriptArgs, redisKeyJobsPrefix(namespace)) // ARGV[2]
 
-	conn := r.pool.Get()
+	conn := pool.Get()
 	defer conn.Close()
 
+	var requeued [][]byte
+
 	// Keep moving jobs until all queues are empty
 	for {
 		values, err := redis.Values(redisRequeueScript.Do(conn, scriptArgs...))
 		if err == redis.ErrNil {
-			return nil
+			if len(requeued) > 0 {
+				err = publishWakeup(conn, wakeup, namespace, "")
+				return requeued, err
+			}
+			return requeued, nil
 		} else if err != nil {
-			return err
+			return requeued, err
 		}
 
 		if len(values) != 3 {
-			return fmt.Errorf("need 3 elements back")
+			return requeued, fmt.Errorf("need 3 elements back")
+		}
+
+		rawJSON, ok := values[0].([]byte)
+		if !ok {
+			return requeued, fmt.Errorf("response msg not bytes")
 		}
+		requeued = append(requeued, rawJSON)
 	}
 }
 
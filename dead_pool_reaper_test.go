@@ -91,6 +91,29 @@ func TestDeadPoolReaper(t *testing.T) {
 	assert.Nil(t, v)
 }
 
+func TestDeadPoolReaperNamedQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	// Pool 2 died running a job of the critical queue
+	rawJSON, err := (&Job{Name: "type1", ID: "1", Queue: "critical"}).serialize()
+	assert.NoError(t, err)
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), "2")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, "2", "type1"), rawJSON)
+	assert.NoError(t, err)
+
+	_, err = requeueInProgressJobs(ns, pool, "2", []string{"type1"}, false)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "2", "type1")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "type1")))
+	assert.Equal(t, "1", jobOnQueue(pool, redisKeyJobsQueue(ns, "type1", "critical")).ID)
+}
+
 func TestDeadPoolReaperNoHeartbeat(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -345,3 +368,51 @@ func TestDeadPoolReaperCleanStaleLocks(t *testing.T) {
 	v, err = conn.Do("HGET", lockInfo2, workerPoolID2)
 	assert.Nil(t, v)
 }
+
+func TestDeadPoolReaperCleanStaleKeyLocks(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	job1 := "type1"
+	workerPoolID1, workerPoolID2 := "1", "2"
+	keyLocks := redisKeyJobsKeyLocks(ns, job1)
+	waitingA := redisKeyJobsKeyWaitingPrefix(ns, job1) + "a"
+	waitingB := redisKeyJobsKeyWaitingPrefix(ns, job1) + "b"
+
+	// workerPoolID1 runs a job with key "a" and one with key "b", workerPoolID2 another one with key "b"
+	_, err := conn.Do("HMSET", keyLocks, "a", 1, "b", 2)
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyJobsKeyLockInfo(ns, job1, workerPoolID1), "a", 1, "b", 1)
+	assert.NoError(t, err)
+	_, err = conn.Do("HSET", redisKeyJobsKeyLockInfo(ns, job1, workerPoolID2), "b", 1)
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", waitingA, "a1", "a2")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", waitingB, "b1")
+	assert.NoError(t, err)
+
+	err = reapStaleKeyLocks(ns, pool, workerPoolID1, []string{job1})
+	assert.NoError(t, err)
+	v, err := redis.StringMap(conn.Do("HGETALL", keyLocks))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"b": "1"}, v)
+	assert.EqualValues(t, 0, hashSize(pool, redisKeyJobsKeyLockInfo(ns, job1, workerPoolID1)))
+	// The oldest waiting job of each released key is back at the front of the queue
+	jobs, err := redis.Strings(conn.Do("LRANGE", redisKeyJobs(ns, job1), 0, -1))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a1", "b1"}, jobs)
+	assert.EqualValues(t, 1, listSize(pool, waitingA))
+	assert.EqualValues(t, 0, listSize(pool, waitingB))
+
+	// The reaper releases the keys of the pools it finds dead, here because their heartbeat expired
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), workerPoolID2)
+	assert.NoError(t, err)
+	reaper := newDeadPoolReaper(ns, pool, []string{job1})
+	err = reaper.reap()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, hashSize(pool, keyLocks))
+	assert.EqualValues(t, 0, hashSize(pool, redisKeyJobsKeyLockInfo(ns, job1, workerPoolID2)))
+}
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -12,10 +13,12 @@ type Enqueuer struct {
 	Namespace string // eg, "myapp-work"
 	Pool      *redis.Pool
 
-	queuePrefix           string // eg, "myapp-work:jobs:"
 	knownJobs             map[string]int64
+	routes                map[string]string // job name -> named queue, see RouteJob
 	enqueueUniqueScript   *redis.Script
 	enqueueUniqueInScript *redis.Script
+	wakeup                bool // see SetWakeup
+	logger                Logger
 	mtx                   sync.RWMutex
 }
 
@@ -28,21 +31,80 @@ func NewEnqueuer(namespace string, pool *redis.Pool) *Enqueuer {
 	return &Enqueuer{
 		Namespace:             namespace,
 		Pool:                  pool,
-		queuePrefix:           redisKeyJobsPrefix(namespace),
 		knownJobs:             make(map[string]int64),
+		routes:                make(map[string]string),
 		enqueueUniqueScript:   redis.NewScript(2, redisLuaEnqueueUnique),
 		enqueueUniqueInScript: redis.NewScript(2, redisLuaEnqueueUniqueIn),
+		logger:                withFields(defaultLogger, "namespace", namespace),
 	}
 }
 
+// SetLogger makes the enqueuer log the errors of the jobs it fails to enqueue with logger, instead of slog.Default().
+func (e *Enqueuer) SetLogger(logger Logger) {
+	e.logger = withFields(logger, "namespace", e.Namespace)
+}
+
+// SetWakeup makes the enqueuer publish a wakeup on Redis for the jobs it enqueues, so that worker pools with
+// WorkerPoolOptions.BlockingFetch start them right away rather than on their next BlockingFetchPoll. It costs a
+// PUBLISH per enqueue, in the same round trip, which is why it's off by default. It applies to all of its enqueue
+// methods, and to its workflows and batches. Call it before enqueueing.
+func (e *Enqueuer) SetWakeup(wakeup bool) {
+	e.wakeup = wakeup
+}
+
+// RouteJob makes the enqueuer put the jobName jobs it enqueues on the named queue, instead of DefaultQueue. It applies
+// to all of its enqueue methods, and to the jobs of its workflows and batches. Only the worker pools that consume the
+// queue, see WorkerPoolOptions.Queues, run them. The jobs stay on their queue when they're retried, requeued or
+// rescheduled.
+func (e *Enqueuer) RouteJob(jobName, queue string) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if queue == "" || queue == DefaultQueue {
+		delete(e.routes, jobName)
+	} else {
+		e.routes[jobName] = queue
+	}
+}
+
+// queueOf returns the queue jobName jobs are routed to, as stored in Job.Queue.
+func (e *Enqueuer) queueOf(jobName string) string {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	return e.routes[jobName]
+}
+
+// queueKey returns the list job is pushed onto.
+func (e *Enqueuer) queueKey(job *Job) string {
+	return redisKeyJobsQueue(e.Namespace, job.Name, job.Queue)
+}
+
 // Enqueue will enqueue the specified job name and arguments. The args param can be nil if no args ar needed.
 // Example: e.Enqueue("send_email", work.Q{"addr": "test@example.com"})
 func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueContext(context.Background(), jobName, args)
+}
+
+// EnqueueContext is like Enqueue, but the job carries the trace of the span of ctx, if any. The spans of its runs are
+// then part of that trace, see WorkerPool.TraceJobs.
+func (e *Enqueuer) EnqueueContext(ctx context.Context, jobName string, args map[string]interface{}) (*Job, error) {
+	return e.enqueue(ctx, e.queueOf(jobName), jobName, args)
+}
+
+// EnqueueOnQueue is like Enqueue, but puts the job on the named queue, whatever RouteJob says.
+func (e *Enqueuer) EnqueueOnQueue(queue, jobName string, args map[string]interface{}) (*Job, error) {
+	return e.enqueue(context.Background(), normalizeQueue(queue), jobName, args)
+}
+
+func (e *Enqueuer) enqueue(ctx context.Context, queue, jobName string, args map[string]interface{}) (*Job, error) {
 	job := &Job{
-		Name:       jobName,
-		ID:         makeIdentifier(),
-		EnqueuedAt: nowEpochSeconds(),
-		Args:       args,
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: traceParent(ctx),
+		Args:        args,
+		Queue:       queue,
 	}
 
 	rawJSON, err := job.serialize()
@@ -53,11 +115,13 @@ func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, e
 	conn := e.Pool.Get()
 	defer conn.Close()
 
-	if _, err := conn.Do("LPUSH", e.queuePrefix+jobName, rawJSON); err != nil {
+	if err := doAndWakeup(conn, e.wakeup, e.Namespace, jobName, "LPUSH", e.queueKey(job), rawJSON); err != nil {
+		e.logger.Error("enqueuer.enqueue.lpush", err, jobFields(job)...)
 		return nil, err
 	}
 
 	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		e.logger.Error("enqueuer.enqueue.known_jobs", err, jobFields(job)...)
 		return job, err
 	}
 
@@ -66,11 +130,27 @@ func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, e
 
 // EnqueueIn enqueues a job in the scheduled job queue for execution in secondsFromNow seconds.
 func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueInContext(context.Background(), jobName, secondsFromNow, args)
+}
+
+// EnqueueInContext is like EnqueueIn, but the job carries the trace of the span of ctx, if any, see EnqueueContext.
+func (e *Enqueuer) EnqueueInContext(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.enqueueIn(ctx, e.queueOf(jobName), jobName, secondsFromNow, args)
+}
+
+// EnqueueOnQueueIn is like EnqueueIn, but the job goes on the named queue once it's due, whatever RouteJob says.
+func (e *Enqueuer) EnqueueOnQueueIn(queue, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.enqueueIn(context.Background(), normalizeQueue(queue), jobName, secondsFromNow, args)
+}
+
+func (e *Enqueuer) enqueueIn(ctx context.Context, queue, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
 	job := &Job{
-		Name:       jobName,
-		ID:         makeIdentifier(),
-		EnqueuedAt: nowEpochSeconds(),
-		Args:       args,
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: traceParent(ctx),
+		Args:        args,
+		Queue:       queue,
 	}
 
 	rawJSON, err := job.serialize()
@@ -88,10 +168,12 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 
 	_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON)
 	if err != nil {
+		e.logger.Error("enqueuer.enqueue_in.zadd", err, jobFields(job)...)
 		return nil, err
 	}
 
 	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		e.logger.Error("enqueuer.enqueue_in.known_jobs", err, jobFields(job)...)
 		return scheduledJob, err
 	}
 
@@ -105,12 +187,24 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 // In order to add robustness to the system, jobs are only unique for 24 hours after they're enqueued. This is mostly relevant for scheduled jobs.
 // EnqueueUnique returns the job if it was enqueued and nil if it wasn't
 func (e *Enqueuer) EnqueueUnique(jobName string, args map[string]interface{}) (*Job, error) {
-	return e.EnqueueUniqueByKey(jobName, args, nil)
+	return e.EnqueueUniqueByKeyContext(context.Background(), jobName, args, nil)
+}
+
+// EnqueueUniqueContext is like EnqueueUnique, but the job carries the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueContext(ctx context.Context, jobName string, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueUniqueByKeyContext(ctx, jobName, args, nil)
 }
 
 // EnqueueUniqueIn enqueues a unique job in the scheduled job queue for execution in secondsFromNow seconds. See EnqueueUnique for the semantics of unique jobs.
 func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
-	return e.EnqueueUniqueInByKey(jobName, secondsFromNow, args, nil)
+	return e.EnqueueUniqueInByKeyContext(context.Background(), jobName, secondsFromNow, args, nil)
+}
+
+// EnqueueUniqueInContext is like EnqueueUniqueIn, but the job carries the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueInContext(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueUniqueInByKeyContext(ctx, jobName, secondsFromNow, args, nil)
 }
 
 // EnqueueUniqueByKey enqueues a job unless a job is already enqueued with the same name and key, updating arguments.
@@ -120,7 +214,13 @@ func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args ma
 // In order to add robustness to the system, jobs are only unique for 24 hours after they're enqueued. This is mostly relevant for scheduled jobs.
 // EnqueueUniqueByKey returns the job if it was enqueued and nil if it wasn't
 func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
-	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
+	return e.EnqueueUniqueByKeyContext(context.Background(), jobName, args, keyMap)
+}
+
+// EnqueueUniqueByKeyContext is like EnqueueUniqueByKey, but the job carries the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueByKeyContext(ctx context.Context, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
+	enqueue, job, err := e.uniqueJobHelper(ctx, jobName, args, keyMap)
 	if err != nil {
 		return nil, err
 	}
@@ -136,7 +236,13 @@ func (e *Enqueuer) EnqueueUniqueByKey(jobName string, args map[string]interface{
 // EnqueueUniqueInByKey enqueues a job in the scheduled job queue that is unique on specified key for execution in secondsFromNow seconds. See EnqueueUnique for the semantics of unique jobs.
 // Subsequent calls with same key will update arguments
 func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
-	enqueue, job, err := e.uniqueJobHelper(jobName, args, keyMap)
+	return e.EnqueueUniqueInByKeyContext(context.Background(), jobName, secondsFromNow, args, keyMap)
+}
+
+// EnqueueUniqueInByKeyContext is like EnqueueUniqueInByKey, but the job carries the trace of the span of ctx, if any,
+// see EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueInByKeyContext(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
+	enqueue, job, err := e.uniqueJobHelper(ctx, jobName, args, keyMap)
 	if err != nil {
 		return nil, err
 	}
@@ -153,35 +259,316 @@ func (e *Enqueuer) EnqueueUniqueInByKey(jobName string, secondsFromNow int64, ar
 	return nil, err
 }
 
-func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
-	needSadd := true
-	now := time.Now().Unix()
+// BatchItem is a single job passed to EnqueueBatchMixed or EnqueueBatchMixedIn, or a batch callback.
+type BatchItem struct {
+	Name  string
+	Args  map[string]interface{}
+	Queue string // named queue to put the job on (default is the one set with Enqueuer.RouteJob)
+}
 
-	e.mtx.RLock()
-	t, ok := e.knownJobs[jobName]
-	e.mtx.RUnlock()
+// enqueueBatchChunkSize caps the number of values sent in a single LPUSH or ZADD command. The chunks are still sent in
+// the same transaction, so a batch costs one round trip and is queued as a whole.
+const enqueueBatchChunkSize = 1000
+
+// EnqueueBatch enqueues a jobName job for each of the specified arguments, as per Enqueue, but in a single round trip
+// to Redis. The jobs are returned in the order of argsList, which is also the order in which they'll be processed. The
+// batch is queued in a MULTI/EXEC transaction: if an error is returned, none of the jobs were queued, unless Redis
+// rejected a command of the transaction because a queue's key holds something other than a list.
+func (e *Enqueuer) EnqueueBatch(jobName string, argsList []map[string]interface{}) ([]*Job, error) {
+	return e.EnqueueBatchMixedContext(context.Background(), batchItems(jobName, argsList))
+}
+
+// EnqueueBatchContext is like EnqueueBatch, but the jobs carry the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueBatchContext(ctx context.Context, jobName string, argsList []map[string]interface{}) ([]*Job, error) {
+	return e.EnqueueBatchMixedContext(ctx, batchItems(jobName, argsList))
+}
+
+// EnqueueBatchMixed enqueues the specified jobs, which can have different names, in a single round trip to Redis.
+func (e *Enqueuer) EnqueueBatchMixed(items []BatchItem) ([]*Job, error) {
+	return e.EnqueueBatchMixedContext(context.Background(), items)
+}
+
+// EnqueueBatchMixedContext is like EnqueueBatchMixed, but the jobs carry the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueBatchMixedContext(ctx context.Context, items []BatchItem) ([]*Job, error) {
+	jobs, rawJSONs, err := e.newBatchJobs(ctx, items)
+	if err != nil {
+		return nil, err
+	}
 
-	if ok {
-		if now < t {
-			needSadd = false
+	if err := e.sendBatch(e.queueCmds(jobs, rawJSONs), items); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// queueCmds returns the LPUSH commands that enqueue jobs, grouped per queue, and the PUBLISH commands that announce
+// them if the enqueuer publishes wakeups.
+func (e *Enqueuer) queueCmds(jobs []*Job, rawJSONs [][]byte) []batchCmd {
+	// Group the jobs per queue, keeping the order of the items
+	var queueKeys, jobNames []string
+	queueJobs := map[string][]interface{}{}
+	seenNames := map[string]bool{}
+	for i, job := range jobs {
+		key := e.queueKey(job)
+		if _, ok := queueJobs[key]; !ok {
+			queueKeys = append(queueKeys, key)
+		}
+		queueJobs[key] = append(queueJobs[key], rawJSONs[i])
+		if !seenNames[job.Name] {
+			seenNames[job.Name] = true
+			jobNames = append(jobNames, job.Name)
+		}
+	}
+
+	var cmds []batchCmd
+	for _, key := range queueKeys {
+		cmds = appendChunkedCmds(cmds, "LPUSH", key, queueJobs[key])
+	}
+	if e.wakeup {
+		for _, jobName := range jobNames {
+			cmds = append(cmds, batchCmd{name: "PUBLISH", args: []interface{}{redisKeyWakeup(e.Namespace), jobName}})
 		}
 	}
-	if needSadd {
-		if _, err := conn.Do("SADD", redisKeyKnownJobs(e.Namespace), jobName); err != nil {
+	return cmds
+}
+
+// EnqueueBatchIn enqueues a jobName job for each of the specified arguments in the scheduled job queue for execution
+// in secondsFromNow seconds, as per EnqueueIn, but with a single ZADD.
+func (e *Enqueuer) EnqueueBatchIn(jobName string, secondsFromNow int64, argsList []map[string]interface{}) ([]*ScheduledJob, error) {
+	return e.EnqueueBatchMixedInContext(context.Background(), secondsFromNow, batchItems(jobName, argsList))
+}
+
+// EnqueueBatchInContext is like EnqueueBatchIn, but the jobs carry the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueBatchInContext(ctx context.Context, jobName string, secondsFromNow int64, argsList []map[string]interface{}) ([]*ScheduledJob, error) {
+	return e.EnqueueBatchMixedInContext(ctx, secondsFromNow, batchItems(jobName, argsList))
+}
+
+// EnqueueBatchMixedIn enqueues the specified jobs, which can have different names, in the scheduled job queue for
+// execution in secondsFromNow seconds, with a single ZADD.
+func (e *Enqueuer) EnqueueBatchMixedIn(secondsFromNow int64, items []BatchItem) ([]*ScheduledJob, error) {
+	return e.EnqueueBatchMixedInContext(context.Background(), secondsFromNow, items)
+}
+
+// EnqueueBatchMixedInContext is like EnqueueBatchMixedIn, but the jobs carry the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueBatchMixedInContext(ctx context.Context, secondsFromNow int64, items []BatchItem) ([]*ScheduledJob, error) {
+	jobs, rawJSONs, err := e.newBatchJobs(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	runAt := nowEpochSeconds() + secondsFromNow
+	scheduledJobs := make([]*ScheduledJob, 0, len(jobs))
+	members := make([]interface{}, 0, 2*len(jobs))
+	for i, job := range jobs {
+		scheduledJobs = append(scheduledJobs, &ScheduledJob{RunAt: runAt, Job: job})
+		members = append(members, runAt, rawJSONs[i])
+	}
+
+	// enqueueBatchChunkSize is even, so a chunk never splits a (score, member) pair.
+	cmds := appendChunkedCmds(nil, "ZADD", redisKeyScheduled(e.Namespace), members)
+
+	if err := e.sendBatch(cmds, items); err != nil {
+		return nil, err
+	}
+
+	return scheduledJobs, nil
+}
+
+func batchItems(jobName string, argsList []map[string]interface{}) []BatchItem {
+	items := make([]BatchItem, 0, len(argsList))
+	for _, args := range argsList {
+		items = append(items, BatchItem{Name: jobName, Args: args})
+	}
+	return items
+}
+
+func (e *Enqueuer) newBatchJobs(ctx context.Context, items []BatchItem) ([]*Job, [][]byte, error) {
+	now := nowEpochSeconds()
+	tp := traceParent(ctx)
+	jobs := make([]*Job, 0, len(items))
+	rawJSONs := make([][]byte, 0, len(items))
+	for _, item := range items {
+		job := &Job{
+			Name:        item.Name,
+			ID:          makeIdentifier(),
+			EnqueuedAt:  now,
+			TraceParent: tp,
+			Args:        item.Args,
+			Queue:       e.itemQueue(item),
+		}
+
+		rawJSON, err := job.serialize()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		jobs = append(jobs, job)
+		rawJSONs = append(rawJSONs, rawJSON)
+	}
+	return jobs, rawJSONs, nil
+}
+
+// itemQueue returns the queue of the item's job.
+func (e *Enqueuer) itemQueue(item BatchItem) string {
+	if item.Queue != "" {
+		return normalizeQueue(item.Queue)
+	}
+	return e.queueOf(item.Name)
+}
+
+type batchCmd struct {
+	name string
+	args []interface{}
+}
+
+// appendChunkedCmds appends "name key values..." commands to cmds, splitting values in chunks of at most
+// enqueueBatchChunkSize values.
+func appendChunkedCmds(cmds []batchCmd, name string, key string, values []interface{}) []batchCmd {
+	for len(values) > 0 {
+		n := len(values)
+		if n > enqueueBatchChunkSize {
+			n = enqueueBatchChunkSize
+		}
+		args := make([]interface{}, 0, n+1)
+		args = append(args, key)
+		args = append(args, values[:n]...)
+		cmds = append(cmds, batchCmd{name: name, args: args})
+		values = values[n:]
+	}
+	return cmds
+}
+
+// sendBatch runs cmds, plus the known jobs update for items, in a MULTI/EXEC transaction, in a single round trip, so
+// that a failure on the way to Redis doesn't leave the batch half queued.
+func (e *Enqueuer) sendBatch(cmds []batchCmd, items []BatchItem) error {
+	if len(cmds) == 0 {
+		return nil
+	}
+
+	jobNames := make([]string, 0, len(items))
+	for _, item := range items {
+		jobNames = append(jobNames, item.Name)
+	}
+	unknownJobNames := e.unknownJobNames(jobNames...)
+	if len(unknownJobNames) > 0 {
+		args := make([]interface{}, 0, len(unknownJobNames)+1)
+		args = append(args, redisKeyKnownJobs(e.Namespace))
+		for _, jobName := range unknownJobNames {
+			args = append(args, jobName)
+		}
+		cmds = append(cmds, batchCmd{name: "SADD", args: args})
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	for _, cmd := range cmds {
+		if err := conn.Send(cmd.name, cmd.args...); err != nil {
 			return err
 		}
+	}
 
-		e.mtx.Lock()
-		e.knownJobs[jobName] = now + 300
-		e.mtx.Unlock()
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err == nil {
+		for _, reply := range replies {
+			if replyErr, ok := reply.(redis.Error); ok {
+				err = replyErr
+				break
+			}
+		}
+	}
+	if err != nil {
+		e.logger.Error("enqueuer.enqueue_batch.exec", err)
+		return err
 	}
 
+	e.markKnownJobs(unknownJobNames...)
+
 	return nil
 }
 
+func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
+	unknownJobNames := e.unknownJobNames(jobName)
+	if len(unknownJobNames) == 0 {
+		return nil
+	}
+
+	if _, err := conn.Do("SADD", redisKeyKnownJobs(e.Namespace), jobName); err != nil {
+		return err
+	}
+
+	e.markKnownJobs(unknownJobNames...)
+
+	return nil
+}
+
+// unknownJobNames returns the distinct job names among jobNames that we haven't written to the known jobs set recently.
+func (e *Enqueuer) unknownJobNames(jobNames ...string) []string {
+	now := time.Now().Unix()
+	seen := make(map[string]bool, 1)
+	var unknown []string
+
+	e.mtx.RLock()
+	for _, jobName := range jobNames {
+		if seen[jobName] {
+			continue
+		}
+		seen[jobName] = true
+
+		if t, ok := e.knownJobs[jobName]; ok && now < t {
+			continue
+		}
+		unknown = append(unknown, jobName)
+	}
+	e.mtx.RUnlock()
+
+	return unknown
+}
+
+func (e *Enqueuer) markKnownJobs(jobNames ...string) {
+	expiresAt := time.Now().Unix() + 300
+
+	e.mtx.Lock()
+	for _, jobName := range jobNames {
+		e.knownJobs[jobName] = expiresAt
+	}
+	e.mtx.Unlock()
+}
+
 type enqueueFnType func(*int64) (string, error)
 
-func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{}, keyMap map[string]interface{}) (enqueueFnType, *Job, error) {
+func (e *Enqueuer) uniqueJobHelper(ctx context.Context, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (enqueueFnType, *Job, error) {
+	scriptFn, job, err := e.uniqueJobScriptHelper(ctx, jobName, args, keyMap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	enqueueFn := func(runAt *int64) (string, error) {
+		conn := e.Pool.Get()
+		defer conn.Close()
+
+		if err := e.addToKnownJobs(conn, jobName); err != nil {
+			return "", err
+		}
+
+		script, scriptArgs := scriptFn(runAt)
+		return redis.String(script.Do(conn, scriptArgs...))
+	}
+
+	return enqueueFn, job, nil
+}
+
+type uniqueScriptFnType func(*int64) (*redis.Script, []interface{})
+
+// uniqueJobScriptHelper builds a unique job, and a function returning the script and arguments that enqueue it
+// (scheduled at runAt if it isn't nil).
+func (e *Enqueuer) uniqueJobScriptHelper(ctx context.Context, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (uniqueScriptFnType, *Job, error) {
 	useDefaultKeys := false
 	if keyMap == nil {
 		useDefaultKeys = true
@@ -194,12 +581,14 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 	}
 
 	job := &Job{
-		Name:       jobName,
-		ID:         makeIdentifier(),
-		EnqueuedAt: nowEpochSeconds(),
-		Args:       args,
-		Unique:     true,
-		UniqueKey:  uniqueKey,
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: traceParent(ctx),
+		Args:        args,
+		Unique:      true,
+		UniqueKey:   uniqueKey,
+		Queue:       e.queueOf(jobName),
 	}
 
 	rawJSON, err := job.serialize()
@@ -207,20 +596,13 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 		return nil, nil, err
 	}
 
-	enqueueFn := func(runAt *int64) (string, error) {
-		conn := e.Pool.Get()
-		defer conn.Close()
-
-		if err := e.addToKnownJobs(conn, jobName); err != nil {
-			return "", err
-		}
-
+	scriptFn := func(runAt *int64) (*redis.Script, []interface{}) {
 		scriptArgs := []interface{}{}
 		script := e.enqueueUniqueScript
 
-		scriptArgs = append(scriptArgs, e.queuePrefix+jobName) // KEY[1]
-		scriptArgs = append(scriptArgs, uniqueKey)             // KEY[2]
-		scriptArgs = append(scriptArgs, rawJSON)               // ARGV[1]
+		scriptArgs = append(scriptArgs, e.queueKey(job)) // KEY[1]
+		scriptArgs = append(scriptArgs, uniqueKey)       // KEY[2]
+		scriptArgs = append(scriptArgs, rawJSON)         // ARGV[1]
 		if useDefaultKeys {
 			// keying on arguments so arguments can't be updated
 			// we'll just get them off the original job so to save space, make this "1"
@@ -236,10 +618,192 @@ func (e *Enqueuer) uniqueJobHelper(jobName string, args map[string]interface{},
 			scriptArgs = append(scriptArgs, *runAt)        // ARGV[3]
 
 			script = e.enqueueUniqueInScript
+		} else if e.wakeup {
+			scriptArgs = append(scriptArgs, redisKeyWakeup(e.Namespace)) // ARGV[3]
+			scriptArgs = append(scriptArgs, jobName)                     // ARGV[4]
 		}
 
-		return redis.String(script.Do(conn, scriptArgs...))
+		return script, scriptArgs
 	}
 
-	return enqueueFn, job, nil
+	return scriptFn, job, nil
+}
+
+// The Tx variants below don't talk to Redis themselves: they queue their commands on conn with conn.Send, so that the
+// enqueue becomes part of the caller's transaction. conn is expected to be in MULTI mode; the job is enqueued when the
+// caller EXECs and is dropped if it DISCARDs. Eg:
+//
+//	conn := pool.Get()
+//	defer conn.Close()
+//	conn.Send("MULTI")
+//	conn.Send("SET", "account:42:state", "settling")
+//	if _, err := enqueuer.EnqueueTx(conn, "settle_account", work.Q{"account_id": 42}); err != nil {
+//		conn.Do("DISCARD")
+//		return err
+//	}
+//	_, err := conn.Do("EXEC")
+
+// EnqueueTx queues the commands that enqueue the specified job on conn, which should be in MULTI mode. See Enqueue.
+func (e *Enqueuer) EnqueueTx(conn redis.Conn, jobName string, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueTxContext(context.Background(), conn, jobName, args)
+}
+
+// EnqueueTxContext is like EnqueueTx, but the job carries the trace of the span of ctx, if any, see EnqueueContext.
+func (e *Enqueuer) EnqueueTxContext(ctx context.Context, conn redis.Conn, jobName string, args map[string]interface{}) (*Job, error) {
+	job := &Job{
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: traceParent(ctx),
+		Args:        args,
+		Queue:       e.queueOf(jobName),
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Send("LPUSH", e.queueKey(job), rawJSON); err != nil {
+		return nil, err
+	}
+	if e.wakeup {
+		if err := conn.Send("PUBLISH", redisKeyWakeup(e.Namespace), jobName); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := e.sendAddToKnownJobs(conn, jobName); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// EnqueueInTx queues the commands that schedule the specified job on conn, which should be in MULTI mode. See EnqueueIn.
+func (e *Enqueuer) EnqueueInTx(conn redis.Conn, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueInTxContext(context.Background(), conn, jobName, secondsFromNow, args)
+}
+
+// EnqueueInTxContext is like EnqueueInTx, but the job carries the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueInTxContext(ctx context.Context, conn redis.Conn, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	job := &Job{
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: traceParent(ctx),
+		Args:        args,
+		Queue:       e.queueOf(jobName),
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + secondsFromNow,
+		Job:   job,
+	}
+
+	if err := conn.Send("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON); err != nil {
+		return nil, err
+	}
+
+	if err := e.sendAddToKnownJobs(conn, jobName); err != nil {
+		return nil, err
+	}
+
+	return scheduledJob, nil
+}
+
+// EnqueueUniqueTx queues the commands that enqueue the specified unique job on conn, which should be in MULTI mode.
+// See EnqueueUnique.
+// Whether the job was a duplicate is only known once the transaction runs, so the job is always returned: the reply of
+// the unique enqueue in EXEC's replies is "ok" if the job was enqueued and "dup" if it wasn't.
+func (e *Enqueuer) EnqueueUniqueTx(conn redis.Conn, jobName string, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueUniqueByKeyTxContext(context.Background(), conn, jobName, args, nil)
+}
+
+// EnqueueUniqueTxContext is like EnqueueUniqueTx, but the job carries the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueTxContext(ctx context.Context, conn redis.Conn, jobName string, args map[string]interface{}) (*Job, error) {
+	return e.EnqueueUniqueByKeyTxContext(ctx, conn, jobName, args, nil)
+}
+
+// EnqueueUniqueInTx queues the commands that schedule the specified unique job on conn, which should be in MULTI mode.
+// See EnqueueUniqueIn and EnqueueUniqueTx.
+func (e *Enqueuer) EnqueueUniqueInTx(conn redis.Conn, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueUniqueInByKeyTxContext(context.Background(), conn, jobName, secondsFromNow, args, nil)
+}
+
+// EnqueueUniqueInTxContext is like EnqueueUniqueInTx, but the job carries the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueInTxContext(ctx context.Context, conn redis.Conn, jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueUniqueInByKeyTxContext(ctx, conn, jobName, secondsFromNow, args, nil)
+}
+
+// EnqueueUniqueByKeyTx queues the commands that enqueue the specified unique job on conn, which should be in MULTI
+// mode. See EnqueueUniqueByKey and EnqueueUniqueTx.
+func (e *Enqueuer) EnqueueUniqueByKeyTx(conn redis.Conn, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
+	return e.EnqueueUniqueByKeyTxContext(context.Background(), conn, jobName, args, keyMap)
+}
+
+// EnqueueUniqueByKeyTxContext is like EnqueueUniqueByKeyTx, but the job carries the trace of the span of ctx, if any,
+// see EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueByKeyTxContext(ctx context.Context, conn redis.Conn, jobName string, args map[string]interface{}, keyMap map[string]interface{}) (*Job, error) {
+	scriptFn, job, err := e.uniqueJobScriptHelper(ctx, jobName, args, keyMap)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.sendUniqueJob(conn, jobName, scriptFn, nil); err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// EnqueueUniqueInByKeyTx queues the commands that schedule the specified unique job on conn, which should be in MULTI
+// mode. See EnqueueUniqueInByKey and EnqueueUniqueTx.
+func (e *Enqueuer) EnqueueUniqueInByKeyTx(conn redis.Conn, jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
+	return e.EnqueueUniqueInByKeyTxContext(context.Background(), conn, jobName, secondsFromNow, args, keyMap)
+}
+
+// EnqueueUniqueInByKeyTxContext is like EnqueueUniqueInByKeyTx, but the job carries the trace of the span of ctx, if
+// any, see EnqueueContext.
+func (e *Enqueuer) EnqueueUniqueInByKeyTxContext(ctx context.Context, conn redis.Conn, jobName string, secondsFromNow int64, args map[string]interface{}, keyMap map[string]interface{}) (*ScheduledJob, error) {
+	scriptFn, job, err := e.uniqueJobScriptHelper(ctx, jobName, args, keyMap)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledJob := &ScheduledJob{
+		RunAt: nowEpochSeconds() + secondsFromNow,
+		Job:   job,
+	}
+
+	if err := e.sendUniqueJob(conn, jobName, scriptFn, &scheduledJob.RunAt); err != nil {
+		return nil, err
+	}
+
+	return scheduledJob, nil
+}
+
+func (e *Enqueuer) sendUniqueJob(conn redis.Conn, jobName string, scriptFn uniqueScriptFnType, runAt *int64) error {
+	script, scriptArgs := scriptFn(runAt)
+
+	// Send uses EVAL rather than EVALSHA: inside MULTI we can't fall back to EVAL on a NOSCRIPT error.
+	if err := script.Send(conn, scriptArgs...); err != nil {
+		return err
+	}
+
+	return e.sendAddToKnownJobs(conn, jobName)
+}
+
+// sendAddToKnownJobs queues adding jobName to the known jobs set. Unlike addToKnownJobs it doesn't use the cache, since
+// the caller's transaction may still be discarded.
+func (e *Enqueuer) sendAddToKnownJobs(conn redis.Conn, jobName string) error {
+	return conn.Send("SADD", redisKeyKnownJobs(e.Namespace), jobName)
 }
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gomodule/redigo/redis"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -98,6 +99,228 @@ func TestEnqueueIn(t *testing.T) {
 	assert.NoError(t, j.ArgError())
 }
 
+func TestEnqueueOnQueue(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	// Routed jobs go on their named queue
+	enqueuer.RouteJob("charge", "critical")
+	job, err := enqueuer.Enqueue("charge", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "critical", job.Queue)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "charge")))
+	j := jobOnQueue(pool, redisKeyJobsQueue(ns, "charge", "critical"))
+	assert.Equal(t, job.ID, j.ID)
+	assert.Equal(t, "critical", j.Queue)
+
+	// EnqueueOnQueue overrides the route, DefaultQueue being the job's own list
+	job, err = enqueuer.EnqueueOnQueue("bulk", "charge", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "bulk", job.Queue)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsQueue(ns, "charge", "bulk")))
+	job, err = enqueuer.EnqueueOnQueue(DefaultQueue, "charge", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", job.Queue)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "charge")))
+
+	// Batch items go on their own queue, or their job's route
+	_, err = enqueuer.EnqueueBatchMixed([]BatchItem{{Name: "charge"}, {Name: "charge", Queue: "bulk"}, {Name: "wat"}})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsQueue(ns, "charge", "critical")))
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobsQueue(ns, "charge", "bulk")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Scheduled jobs go on their queue when they're due
+	scheduled, err := enqueuer.EnqueueOnQueueIn("bulk", "wat", 0, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "bulk", scheduled.Queue)
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	assert.True(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsQueue(ns, "wat", "bulk")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Routing a job to DefaultQueue removes its route
+	enqueuer.RouteJob("charge", DefaultQueue)
+	job, err = enqueuer.Enqueue("charge", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", job.Queue)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "charge")))
+}
+
+func TestEnqueueBatch(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	argsList := make([]map[string]interface{}, 0, 2500)
+	for i := 0; i < 2500; i++ {
+		argsList = append(argsList, Q{"i": i})
+	}
+
+	jobs, err := enqueuer.EnqueueBatch("wat", argsList)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2500)
+	assert.EqualValues(t, 2500, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+	assert.True(t, enqueuer.knownJobs["wat"] > (time.Now().Unix()+290))
+
+	// Jobs come off the queue in the order they were passed in
+	for i := 0; i < 3; i++ {
+		j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+		assert.Equal(t, jobs[i].ID, j.ID)
+		assert.EqualValues(t, i, j.ArgInt64("i"))
+	}
+
+	jobs, err = enqueuer.EnqueueBatch("wat", nil)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 0)
+
+	// A command rejected inside the transaction fails the batch
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SET", redisKeyJobs(ns, "foo"), "not a list")
+	assert.NoError(t, err)
+	jobs, err = enqueuer.EnqueueBatchMixed([]BatchItem{{Name: "foo"}, {Name: "wat"}})
+	assert.Error(t, err)
+	assert.Nil(t, jobs)
+}
+
+func TestEnqueueBatchMixed(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	jobs, err := enqueuer.EnqueueBatchMixed([]BatchItem{
+		{Name: "wat", Args: Q{"a": 1}},
+		{Name: "foo", Args: Q{"a": 2}},
+		{Name: "wat", Args: Q{"a": 3}},
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 3) {
+		assert.Equal(t, "wat", jobs[0].Name)
+		assert.Equal(t, "foo", jobs[1].Name)
+		assert.Equal(t, "wat", jobs[2].Name)
+	}
+	assert.ElementsMatch(t, []string{"wat", "foo"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+
+	assert.Equal(t, jobs[0].ID, jobOnQueue(pool, redisKeyJobs(ns, "wat")).ID)
+	assert.Equal(t, jobs[2].ID, jobOnQueue(pool, redisKeyJobs(ns, "wat")).ID)
+	assert.Equal(t, jobs[1].ID, jobOnQueue(pool, redisKeyJobs(ns, "foo")).ID)
+}
+
+func TestEnqueueBatchIn(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	jobs, err := enqueuer.EnqueueBatchIn("wat", 300, []map[string]interface{}{{"a": 1}, {"a": 2}})
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 2) {
+		assert.EqualValues(t, jobs[0].EnqueuedAt+300, jobs[0].RunAt)
+		assert.EqualValues(t, jobs[1].EnqueuedAt+300, jobs[1].RunAt)
+	}
+	assert.EqualValues(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+
+	score, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.Equal(t, jobs[0].RunAt, score)
+	assert.Equal(t, "wat", j.Name)
+
+	scheduled, err := enqueuer.EnqueueBatchMixedIn(10, []BatchItem{{Name: "foo"}, {Name: "bar"}})
+	assert.NoError(t, err)
+	assert.Len(t, scheduled, 2)
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.ElementsMatch(t, []string{"wat", "foo", "bar"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+}
+
+func TestEnqueueTx(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	assert.NoError(t, conn.Send("MULTI"))
+	assert.NoError(t, conn.Send("SET", "work:tx:state", "done"))
+	job, err := enqueuer.EnqueueTx(conn, "wat", Q{"a": 1})
+	assert.NoError(t, err)
+	scheduledJob, err := enqueuer.EnqueueInTx(conn, "foo", 300, Q{"a": 2})
+	assert.NoError(t, err)
+	assert.EqualValues(t, scheduledJob.EnqueuedAt+300, scheduledJob.RunAt)
+
+	// Nothing happens until EXEC
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	_, err = conn.Do("EXEC")
+	assert.NoError(t, err)
+
+	state, err := redis.String(conn.Do("GET", "work:tx:state"))
+	assert.NoError(t, err)
+	assert.Equal(t, "done", state)
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, job.ID, j.ID)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.ElementsMatch(t, []string{"wat", "foo"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	// DISCARD drops the enqueue along with the rest of the transaction
+	assert.NoError(t, conn.Send("MULTI"))
+	_, err = enqueuer.EnqueueTx(conn, "bar", nil)
+	assert.NoError(t, err)
+	_, err = conn.Do("DISCARD")
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "bar")))
+	assert.ElementsMatch(t, []string{"wat", "foo"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+}
+
+func TestEnqueueUniqueTx(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	assert.NoError(t, conn.Send("MULTI"))
+	job, err := enqueuer.EnqueueUniqueTx(conn, "wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.True(t, job.Unique)
+	_, err = enqueuer.EnqueueUniqueTx(conn, "wat", Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUniqueInByKeyTx(conn, "foo", 300, Q{"a": 1}, Q{"key": "x"})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUniqueInTx(conn, "foo", 300, Q{"a": 2})
+	assert.NoError(t, err)
+
+	// Each unique enqueue is followed by its SADD to the known jobs
+	replies, err := redis.Values(conn.Do("EXEC"))
+	assert.NoError(t, err)
+	if assert.Len(t, replies, 8) {
+		assert.Equal(t, "ok", string(replies[0].([]byte)))
+		assert.Equal(t, "dup", string(replies[2].([]byte)))
+		assert.Equal(t, "ok", string(replies[4].([]byte)))
+		assert.Equal(t, "ok", string(replies[6].([]byte)))
+	}
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+
+	// The unique lock is shared with the non-transactional API
+	j, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Nil(t, j)
+}
+
 func TestEnqueueUnique(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
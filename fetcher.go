@@ -0,0 +1,223 @@
+package work
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// fetcher fetches the jobs of a pool with WorkerPoolOptions.Prefetch, for all of its workers. It moves up to prefetch
+// jobs at a time to the pool's in progress queues, with a single script call, and buffers them in jobs until a worker
+// takes them. The buffered jobs are accounted for like running ones: they hold their job's lock, and if the process
+// dies the reaper requeues them along with the pool's other in progress jobs.
+type fetcher struct {
+	namespace     string
+	poolID        string
+	pool          *redis.Pool
+	sleepBackoffs []int64
+	logger        Logger
+
+	// jobTypesMtx guards sampler and redisFetchScript, which change when job types are added or removed
+	jobTypesMtx      sync.Mutex
+	jobTypes         map[string]*jobType
+	queues           []QueueSubscription
+	strictQueues     bool
+	sampler          fetchSampler
+	redisFetchScript *redis.Script
+	requeueScript    *redis.Script
+
+	jobs      chan *Job
+	takenChan chan struct{}
+
+	// wakeChan is set with WorkerPoolOptions.BlockingFetch. When there are no jobs, the fetcher then waits for a wakeup
+	// on it instead of polling.
+	wakeChan *wakeChannel
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+
+	drainChan        chan struct{}
+	doneDrainingChan chan struct{}
+	loopDone         chan struct{} // closed once the fetcher stopped
+}
+
+func newFetcher(namespace string, poolID string, pool *redis.Pool, jobTypes map[string]*jobType, prefetch uint, sleepBackoffs []int64) *fetcher {
+	if len(sleepBackoffs) == 0 {
+		sleepBackoffs = sleepBackoffsInMilliseconds
+	}
+
+	f := &fetcher{
+		namespace:     namespace,
+		poolID:        poolID,
+		pool:          pool,
+		sleepBackoffs: sleepBackoffs,
+		logger:        withFields(defaultLogger, "namespace", namespace, "pool_id", poolID),
+
+		requeueScript: redis.NewScript(4, redisLuaRequeuePrefetchedJob),
+
+		jobs:      make(chan *Job, prefetch),
+		takenChan: make(chan struct{}, 1),
+
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+
+		drainChan:        make(chan struct{}),
+		doneDrainingChan: make(chan struct{}),
+	}
+	f.updateJobTypes(jobTypes)
+
+	return f
+}
+
+func (f *fetcher) updateJobTypes(jobTypes map[string]*jobType) {
+	f.jobTypesMtx.Lock()
+	defer f.jobTypesMtx.Unlock()
+
+	f.jobTypes = jobTypes
+	f.sampler, f.redisFetchScript = newFetchSampler(f.namespace, f.poolID, jobTypes, f.queues, f.strictQueues)
+}
+
+// subscribe makes the fetcher fetch the jobs of queues, see WorkerPoolOptions.Queues. It must be called before start.
+func (f *fetcher) subscribe(queues []QueueSubscription, strict bool) {
+	f.jobTypesMtx.Lock()
+	defer f.jobTypesMtx.Unlock()
+
+	f.queues = queues
+	f.strictQueues = strict
+	f.sampler, f.redisFetchScript = newFetchSampler(f.namespace, f.poolID, f.jobTypes, queues, strict)
+}
+
+func (f *fetcher) start() {
+	f.loopDone = make(chan struct{})
+	go f.loop()
+}
+
+// stop stops fetching and gives back the jobs no worker took. The workers should be stopped already.
+func (f *fetcher) stop() {
+	f.stopChan <- struct{}{}
+	<-f.doneStoppingChan
+
+	var jobs []*Job
+	for len(f.jobs) > 0 {
+		jobs = append(jobs, <-f.jobs)
+	}
+	f.requeue(jobs...)
+}
+
+// drain returns once no job can be fetched and the workers took all the buffered ones, or the fetcher stopped.
+func (f *fetcher) drain() {
+	select {
+	case f.drainChan <- struct{}{}:
+	case <-f.loopDone:
+		return
+	}
+	select {
+	case <-f.doneDrainingChan:
+	case <-f.loopDone:
+	}
+}
+
+// taken tells the fetcher that a worker took a job from jobs.
+func (f *fetcher) taken() {
+	select {
+	case f.takenChan <- struct{}{}:
+	default:
+	}
+}
+
+// requeue moves prefetched jobs that didn't start back to the front of their queues, in the order they were fetched,
+// and releases their locks.
+func (f *fetcher) requeue(jobs ...*Job) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	conn := f.pool.Get()
+	defer conn.Close()
+
+	// The job fetched first must end up at the very front
+	for i := len(jobs) - 1; i >= 0; i-- {
+		if err := requeueFetchedJob(conn, f.requeueScript, f.namespace, f.poolID, jobs[i]); err != nil {
+			f.logger.Error("fetcher.requeue", err, jobFields(jobs[i])...)
+		}
+	}
+
+	if err := publishWakeup(conn, f.wakeChan != nil, f.namespace, ""); err != nil {
+		f.logger.Error("fetcher.requeue.publish", err)
+	}
+}
+
+// requeueFetchedJob moves job, which pool poolID fetched but didn't start, back to the front of its queue with script,
+// which runs redisLuaRequeuePrefetchedJob.
+func requeueFetchedJob(conn redis.Conn, script *redis.Script, namespace, poolID string, job *Job) error {
+	_, err := script.Do(conn, job.inProgQueue, job.dequeuedFrom, redisKeyJobsLock(namespace, job.Name), redisKeyJobsLockInfo(namespace, job.Name), job.rawJSON, poolID)
+	return err
+}
+
+func (f *fetcher) loop() {
+	defer close(f.loopDone)
+
+	var drained bool
+	var full bool
+	var consequtiveNoJobs int64
+
+	// Begin immediately. We'll change the duration on each tick with a timer.Reset()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-f.stopChan:
+			f.doneStoppingChan <- struct{}{}
+			return
+		case <-f.drainChan:
+			drained = true
+			timer.Reset(0)
+		case <-f.wakeChan.get():
+			timer.Reset(0)
+		case <-f.takenChan:
+			// Fetch again if we were waiting for room in the buffer, or for the workers to empty it while draining
+			if full || (drained && len(f.jobs) == 0) {
+				full = false
+				timer.Reset(0)
+			}
+		case <-timer.C:
+			room := cap(f.jobs) - len(f.jobs)
+			if room == 0 {
+				full = true
+				continue
+			}
+
+			// Only this goroutine sends on jobs, so buffering the fetched jobs can't block.
+			f.jobTypesMtx.Lock()
+			jobs, err := fetchJobs(f.pool, f.redisFetchScript, &f.sampler, f.poolID, room)
+			f.jobTypesMtx.Unlock()
+			for _, job := range jobs {
+				f.jobs <- job
+			}
+			if err != nil {
+				f.logger.Error("fetcher.fetch", err)
+				timer.Reset(10 * time.Millisecond)
+			} else if len(jobs) > 0 {
+				consequtiveNoJobs = 0
+				timer.Reset(0)
+			} else {
+				if drained && len(f.jobs) == 0 {
+					f.doneDrainingChan <- struct{}{}
+					drained = false
+				}
+				if f.wakeChan != nil {
+					// Wait to be woken up
+					continue
+				}
+				consequtiveNoJobs++
+				idx := consequtiveNoJobs
+				if idx >= int64(len(f.sleepBackoffs)) {
+					idx = int64(len(f.sleepBackoffs)) - 1
+				}
+				timer.Reset(time.Duration(f.sleepBackoffs[idx]) * time.Millisecond)
+			}
+		}
+	}
+}
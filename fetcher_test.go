@@ -0,0 +1,174 @@
+package work
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+
+	jobTypes := map[string]*jobType{"wat": {Name: "wat", JobOptions: JobOptions{Priority: 1}}}
+	sampler, script := newFetchSampler(ns, "1", jobTypes, nil, false)
+
+	// The jobs are fetched in order, and accounted for as in progress
+	jobs, err := fetchJobs(pool, script, &sampler, "1", 3)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 3) {
+		for i, job := range jobs {
+			assert.EqualValues(t, i, job.ArgInt64("i"))
+			assert.Equal(t, redisKeyJobsInProgress(ns, "1", "wat"), string(job.inProgQueue))
+		}
+	}
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobsInProgress(ns, "1", "wat")))
+	assert.EqualValues(t, 3, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+	assert.EqualValues(t, 3, hgetInt64(pool, redisKeyJobsLockInfo(ns, "wat"), "1"))
+
+	// MaxConcurrency counts the fetched jobs
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SET", redisKeyJobsConcurrency(ns, "wat"), 4)
+	assert.NoError(t, err)
+	jobs, err = fetchJobs(pool, script, &sampler, "1", 3)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+
+	jobs, err = fetchJobs(pool, script, &sampler, "1", 3)
+	assert.NoError(t, err)
+	assert.Empty(t, jobs)
+}
+
+func TestFetchJobsQueues(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.EnqueueOnQueue("bulk", "wat", nil)
+		assert.NoError(t, err)
+		_, err = enqueuer.EnqueueOnQueue("critical", "wat", nil)
+		assert.NoError(t, err)
+		_, err = enqueuer.Enqueue("wat", nil)
+		assert.NoError(t, err)
+	}
+
+	// With a strict order, the critical jobs come first, and the default queue isn't consumed
+	jobTypes := map[string]*jobType{"wat": {Name: "wat", JobOptions: JobOptions{Priority: 1}}}
+	queues := []QueueSubscription{{Name: "critical", Weight: 1}, {Name: "bulk", Weight: 1}}
+	sampler, script := newFetchSampler(ns, "1", jobTypes, queues, true)
+	jobs, err := fetchJobs(pool, script, &sampler, "1", 4)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 4) {
+		for i, job := range jobs[:3] {
+			assert.Equal(t, "critical", job.Queue, i)
+		}
+		assert.Equal(t, "bulk", jobs[3].Queue)
+		assert.Equal(t, redisKeyJobsQueue(ns, "wat", "bulk"), string(jobs[3].dequeuedFrom))
+		assert.Equal(t, redisKeyJobsInProgress(ns, "1", "wat"), string(jobs[3].inProgQueue))
+	}
+	jobs, err = fetchJobs(pool, script, &sampler, "1", 4)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Without queues, only the default queue is consumed
+	sampler, script = newFetchSampler(ns, "1", jobTypes, nil, false)
+	jobs, err = fetchJobs(pool, script, &sampler, "1", 4)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 3) {
+		assert.Equal(t, "", jobs[0].Queue)
+	}
+}
+
+func TestWorkerPoolPrefetch(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 50; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+
+	var ran int64
+	wp := NewWorkerPoolWithOptions(TestContext{}, 3, ns, pool, WorkerPoolOptions{Prefetch: 5})
+	wp.Job("wat", func(job *Job) error {
+		atomic.AddInt64(&ran, 1)
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 50, atomic.LoadInt64(&ran))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, "wat")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+}
+
+func TestWorkerPoolPrefetchStopRequeues(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 8; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+
+	started := make(chan struct{}, 8)
+	release := make(chan struct{})
+	var ran int64
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{Prefetch: 5})
+	wp.Job("wat", func(job *Job) error {
+		started <- struct{}{}
+		<-release
+		atomic.AddInt64(&ran, 1)
+		return nil
+	})
+	wp.Start()
+	<-started
+
+	// The running job and the buffered ones are in progress, so the reaper would requeue them if we died
+	inProgKey := redisKeyJobsInProgress(ns, wp.workerPoolID, "wat")
+	assert.Eventually(t, func() bool { return listSize(pool, inProgKey) == 6 }, time.Second, 10*time.Millisecond)
+	assert.EqualValues(t, 6, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+	assert.EqualValues(t, 6, hgetInt64(pool, redisKeyJobsLockInfo(ns, "wat"), wp.workerPoolID))
+
+	// Stopping gives the buffered jobs back, in their original order, without waiting for the running job
+	stopped := make(chan struct{})
+	go func() {
+		wp.Stop()
+		close(stopped)
+	}()
+	assert.Eventually(t, func() bool { return listSize(pool, inProgKey) == 1 }, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.EqualValues(t, 7, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, inProgKey))
+	close(release)
+	<-stopped
+
+	assert.EqualValues(t, 1, atomic.LoadInt64(&ran))
+	assert.EqualValues(t, 7, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, listSize(pool, inProgKey))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, "wat"), wp.workerPoolID))
+
+	job := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.EqualValues(t, 1, job.ArgInt64("i"))
+}
@@ -0,0 +1,352 @@
+package work
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// filterChunkSize is the number of jobs read, or changed by a bulk action, at a time when filtering a zset of jobs.
+const filterChunkSize = 1000
+
+// JobFilter selects jobs in the scheduled, retry or dead queue, see DeadJobsMatching and the like. The zero JobFilter
+// selects all of them.
+type JobFilter struct {
+	Name        string // Only jobs with this name
+	ErrContains string // Only jobs whose last error contains this
+	From        int64  // Only jobs that run, are retried or died at this time or later, in epoch seconds. 0 means no lower bound.
+	To          int64  // Only jobs that run, are retried or died at this time or before, in epoch seconds. 0 means no upper bound.
+	ArgKey      string // Only jobs with this arg
+	ArgValue    string // Only jobs whose ArgKey arg is this, if not empty. Strings are compared as is, other values as JSON.
+}
+
+// onlyRange returns whether the filter only selects jobs by time, which Redis can do without reading the jobs.
+func (f *JobFilter) onlyRange() bool {
+	return f.Name == "" && f.ErrContains == "" && f.ArgKey == ""
+}
+
+// scoreRange returns the bounds of the filter for ZRANGEBYSCORE.
+func (f *JobFilter) scoreRange() (string, string) {
+	minScore, maxScore := "-inf", "+inf"
+	if f.From != 0 {
+		minScore = strconv.FormatInt(f.From, 10)
+	}
+	if f.To != 0 {
+		maxScore = strconv.FormatInt(f.To, 10)
+	}
+	return minScore, maxScore
+}
+
+// matches returns whether the filter selects the job. The time range isn't checked, it's up to ZRANGEBYSCORE.
+func (f *JobFilter) matches(job *Job) bool {
+	if f.Name != "" && job.Name != f.Name {
+		return false
+	}
+	if f.ErrContains != "" && !strings.Contains(job.LastErr, f.ErrContains) {
+		return false
+	}
+	if f.ArgKey != "" {
+		v, ok := job.Args[f.ArgKey]
+		if !ok {
+			return false
+		}
+		if f.ArgValue != "" && argString(v) != f.ArgValue {
+			return false
+		}
+	}
+	return true
+}
+
+// argString returns how JobFilter.ArgValue is compared to the arg value v.
+func argString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// ScheduledJobsMatching is like ScheduledJobs, but only lists the jobs the filter selects, and returns how many there
+// are. Filters other than the time range read all scheduled jobs in that range, so they get slow with millions of jobs.
+func (c *Client) ScheduledJobsMatching(filter JobFilter, page uint) ([]*ScheduledJob, int64, error) {
+	jobsWithScores, count, err := c.getFilteredZsetPage(redisKeyScheduled(c.namespace), filter, page)
+	if err != nil {
+		c.logger.Error("client.scheduled_jobs.get_zset_page", err)
+		return nil, 0, err
+	}
+
+	jobs := make([]*ScheduledJob, 0, len(jobsWithScores))
+	for _, jws := range jobsWithScores {
+		jobs = append(jobs, &ScheduledJob{RunAt: jws.Score, Job: jws.job})
+	}
+
+	return jobs, count, nil
+}
+
+// RetryJobsMatching is like RetryJobs, but only lists the jobs the filter selects, and returns how many there are.
+// Filters other than the time range read all retry jobs in that range, so they get slow with millions of jobs.
+func (c *Client) RetryJobsMatching(filter JobFilter, page uint) ([]*RetryJob, int64, error) {
+	jobsWithScores, count, err := c.getFilteredZsetPage(redisKeyRetry(c.namespace), filter, page)
+	if err != nil {
+		c.logger.Error("client.retry_jobs.get_zset_page", err)
+		return nil, 0, err
+	}
+
+	jobs := make([]*RetryJob, 0, len(jobsWithScores))
+	for _, jws := range jobsWithScores {
+		jobs = append(jobs, &RetryJob{RetryAt: jws.Score, Job: jws.job})
+	}
+
+	return jobs, count, nil
+}
+
+// DeadJobsMatching is like DeadJobs, but only lists the jobs the filter selects, and returns how many there are.
+// Filters other than the time range read all dead jobs in that range, so they get slow with millions of jobs.
+func (c *Client) DeadJobsMatching(filter JobFilter, page uint) ([]*DeadJob, int64, error) {
+	jobsWithScores, count, err := c.getFilteredZsetPage(redisKeyDead(c.namespace), filter, page)
+	if err != nil {
+		c.logger.Error("client.dead_jobs.get_zset_page", err)
+		return nil, 0, err
+	}
+
+	jobs := make([]*DeadJob, 0, len(jobsWithScores))
+	for _, jws := range jobsWithScores {
+		jobs = append(jobs, &DeadJob{DiedAt: jws.Score, Job: jws.job})
+	}
+
+	return jobs, count, nil
+}
+
+// RetryDeadJobsMatching requeues the dead jobs the filter selects, like RetryDeadJob, and returns how many were
+// requeued. Jobs that died while it runs aren't requeued.
+func (c *Client) RetryDeadJobsMatching(filter JobFilter) (int64, error) {
+	jobsWithScores, err := c.getFilteredZsetJobs(redisKeyDead(c.namespace), filter)
+	if err != nil {
+		c.logger.Error("client.retry_dead_jobs_matching.get_zset_jobs", err)
+		return 0, err
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		c.logger.Error("client.retry_dead_jobs_matching.known_jobs", err)
+		return 0, err
+	}
+
+	script := redis.NewScript(len(jobNames)+1, redisLuaRequeueDeadJobsCmd)
+
+	var requeued int64
+	for start := 0; start < len(jobsWithScores); start += filterChunkSize {
+		end := start + filterChunkSize
+		if end > len(jobsWithScores) {
+			end = len(jobsWithScores)
+		}
+
+		args := make([]interface{}, 0, len(jobNames)+1+2+end-start)
+		args = append(args, redisKeyDead(c.namespace)) // KEY[1]
+		for _, jobName := range jobNames {
+			args = append(args, redisKeyJobs(c.namespace, jobName)) // KEY[2, 3, ...]
+		}
+		args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
+		args = append(args, nowEpochSeconds())
+		for _, jws := range jobsWithScores[start:end] {
+			args = append(args, jws.JobBytes)
+		}
+
+		n, err := redis.Int64(script.Do(conn, args...))
+		if err != nil {
+			c.logger.Error("client.retry_dead_jobs_matching.do", err)
+			return requeued, err
+		}
+		requeued += n
+	}
+
+	if requeued > 0 {
+		if err := c.publishWakeup(conn, ""); err != nil {
+			c.logger.Error("client.retry_dead_jobs_matching.publish", err)
+			return requeued, err
+		}
+	}
+
+	return requeued, nil
+}
+
+// DeleteDeadJobsMatching deletes the dead jobs the filter selects, and returns how many were deleted.
+func (c *Client) DeleteDeadJobsMatching(filter JobFilter) (int64, error) {
+	return c.deleteZsetJobsMatching(redisKeyDead(c.namespace), filter)
+}
+
+// DeleteRetryJobsMatching deletes the retry jobs the filter selects, and returns how many were deleted.
+func (c *Client) DeleteRetryJobsMatching(filter JobFilter) (int64, error) {
+	return c.deleteZsetJobsMatching(redisKeyRetry(c.namespace), filter)
+}
+
+// DeleteScheduledJobsMatching deletes the scheduled jobs the filter selects, like DeleteScheduledJob, and returns how
+// many were deleted.
+func (c *Client) DeleteScheduledJobsMatching(filter JobFilter) (int64, error) {
+	return c.deleteZsetJobsMatching(redisKeyScheduled(c.namespace), filter)
+}
+
+// deleteZsetJobsMatching deletes the jobs the filter selects in the specified zset, with their unique keys.
+func (c *Client) deleteZsetJobsMatching(key string, filter JobFilter) (int64, error) {
+	jobsWithScores, err := c.getFilteredZsetJobs(key, filter)
+	if err != nil {
+		c.logger.Error("client.delete_zset_jobs_matching.get_zset_jobs", err)
+		return 0, err
+	}
+
+	script := redis.NewScript(1, redisLuaDeleteJobsCmd)
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	var deleted int64
+	for start := 0; start < len(jobsWithScores); start += filterChunkSize {
+		end := start + filterChunkSize
+		if end > len(jobsWithScores) {
+			end = len(jobsWithScores)
+		}
+
+		args := make([]interface{}, 0, 1+2*(end-start))
+		args = append(args, key) // KEY[1]
+		for _, jws := range jobsWithScores[start:end] {
+			uniqueKey := ""
+			if jws.job.Unique {
+				uniqueKey, err = redisKeyUniqueJob(c.namespace, jws.job.Name, jws.job.Args)
+				if err != nil {
+					c.logger.Error("client.delete_zset_jobs_matching.redis_key_unique_job", err, jobFields(jws.job)...)
+					return deleted, err
+				}
+			}
+			args = append(args, jws.JobBytes, uniqueKey)
+		}
+
+		n, err := redis.Int64(script.Do(conn, args...))
+		if err != nil {
+			c.logger.Error("client.delete_zset_jobs_matching.do", err)
+			return deleted, err
+		}
+		deleted += n
+	}
+
+	return deleted, nil
+}
+
+// getFilteredZsetPage returns the 1-based page of 20 jobs the filter selects in the specified zset, and how many jobs
+// it selects.
+func (c *Client) getFilteredZsetPage(key string, filter JobFilter, page uint) ([]jobScore, int64, error) {
+	if page == 0 {
+		page = 1
+	}
+	offset := int64(page-1) * 20
+
+	if filter.onlyRange() {
+		return c.getZsetRangePage(key, filter, offset)
+	}
+
+	var pageJobs []jobScore
+	var count int64
+	err := c.eachZsetJob(key, filter, func(jws jobScore) {
+		if count >= offset && count < offset+20 {
+			pageJobs = append(pageJobs, jws)
+		}
+		count++
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return pageJobs, count, nil
+}
+
+// getZsetRangePage returns the page of 20 jobs from offset in the time range of the filter, and how many jobs are in
+// that range.
+func (c *Client) getZsetRangePage(key string, filter JobFilter, offset int64) ([]jobScore, int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	minScore, maxScore := filter.scoreRange()
+	values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, minScore, maxScore, "WITHSCORES", "LIMIT", offset, 20))
+	if err != nil {
+		c.logger.Error("client.get_zset_range_page.values", err)
+		return nil, 0, err
+	}
+
+	jobsWithScores, err := c.scanJobScores(values)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	count, err := redis.Int64(conn.Do("ZCOUNT", key, minScore, maxScore))
+	if err != nil {
+		c.logger.Error("client.get_zset_range_page.zcount", err)
+		return nil, 0, err
+	}
+
+	return jobsWithScores, count, nil
+}
+
+// getFilteredZsetJobs returns all the jobs the filter selects in the specified zset.
+func (c *Client) getFilteredZsetJobs(key string, filter JobFilter) ([]jobScore, error) {
+	var jobsWithScores []jobScore
+	err := c.eachZsetJob(key, filter, func(jws jobScore) {
+		jobsWithScores = append(jobsWithScores, jws)
+	})
+	return jobsWithScores, err
+}
+
+// eachZsetJob calls fn with the jobs the filter selects in the specified zset, by order of score. The zset is read in
+// chunks, so jobs added or removed meanwhile may be missed or seen twice.
+func (c *Client) eachZsetJob(key string, filter JobFilter, fn func(jobScore)) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	minScore, maxScore := filter.scoreRange()
+	for offset := 0; ; offset += filterChunkSize {
+		values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, minScore, maxScore, "WITHSCORES", "LIMIT", offset, filterChunkSize))
+		if err != nil {
+			c.logger.Error("client.each_zset_job.values", err)
+			return err
+		}
+
+		jobsWithScores, err := c.scanJobScores(values)
+		if err != nil {
+			return err
+		}
+		for _, jws := range jobsWithScores {
+			if filter.matches(jws.job) {
+				fn(jws)
+			}
+		}
+
+		if len(jobsWithScores) < filterChunkSize {
+			return nil
+		}
+	}
+}
+
+// scanJobScores parses the reply of a ZRANGEBYSCORE WITHSCORES of jobs.
+func (c *Client) scanJobScores(values []interface{}) ([]jobScore, error) {
+	var jobsWithScores []jobScore
+	if err := redis.ScanSlice(values, &jobsWithScores); err != nil {
+		c.logger.Error("client.scan_job_scores.scan_slice", err)
+		return nil, err
+	}
+
+	for i, jws := range jobsWithScores {
+		job, err := newJob(jws.JobBytes, nil, nil)
+		if err != nil {
+			c.logger.Error("client.scan_job_scores.new_job", err)
+			return nil, err
+		}
+		jobsWithScores[i].job = job
+	}
+
+	return jobsWithScores, nil
+}
@@ -0,0 +1,172 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// addZsetJobs adds jobs to the zset at key, with the scores from 1000 up.
+func addZsetJobs(t *testing.T, pool *redis.Pool, key string, jobs ...*Job) {
+	conn := pool.Get()
+	defer conn.Close()
+	for i, job := range jobs {
+		rawJSON, err := json.Marshal(job)
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", key, 1000+i, rawJSON)
+		assert.NoError(t, err)
+	}
+}
+
+func TestClientDeadJobsMatching(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	addZsetJobs(t, pool, redisKeyDead(ns),
+		&Job{Name: "wat", ID: "a", LastErr: "connection refused", Args: Q{"user_id": 1}},
+		&Job{Name: "wat", ID: "b", LastErr: "timeout", Args: Q{"user_id": 2}},
+		&Job{Name: "foo", ID: "c", LastErr: "connection reset", Args: Q{"user_id": "1"}},
+		&Job{Name: "foo", ID: "d", LastErr: "timeout"},
+	)
+
+	client := NewClient(ns, pool)
+	ids := func(filter JobFilter) []string {
+		jobs, count, err := client.DeadJobsMatching(filter, 1)
+		assert.NoError(t, err)
+		assert.EqualValues(t, len(jobs), count)
+		var ids []string
+		for _, job := range jobs {
+			ids = append(ids, job.ID)
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, ids(JobFilter{}))
+	assert.Equal(t, []string{"a", "b"}, ids(JobFilter{Name: "wat"}))
+	assert.Equal(t, []string{"a", "c"}, ids(JobFilter{ErrContains: "connection"}))
+	assert.Equal(t, []string{"b", "c"}, ids(JobFilter{From: 1001, To: 1002}))
+	assert.Equal(t, []string{"c", "d"}, ids(JobFilter{From: 1002}))
+	assert.Equal(t, []string{"a", "b", "c"}, ids(JobFilter{ArgKey: "user_id"}))
+	assert.Equal(t, []string{"a", "c"}, ids(JobFilter{ArgKey: "user_id", ArgValue: "1"}))
+	assert.Equal(t, []string{"d"}, ids(JobFilter{Name: "foo", ErrContains: "timeout", To: 1003}))
+	assert.Empty(t, ids(JobFilter{Name: "nope"}))
+
+	jobs, count, err := client.DeadJobsMatching(JobFilter{ErrContains: "connection"}, 1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	if assert.Len(t, jobs, 2) {
+		assert.EqualValues(t, 1002, jobs[1].DiedAt)
+	}
+}
+
+func TestClientDeadJobsMatchingPages(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var jobs []*Job
+	for i := 0; i < 50; i++ {
+		jobs = append(jobs, &Job{Name: "wat", ID: fmt.Sprint(i), LastErr: fmt.Sprintf("err%d", i%2)})
+	}
+	addZsetJobs(t, pool, redisKeyDead(ns), jobs...)
+
+	client := NewClient(ns, pool)
+	page, count, err := client.DeadJobsMatching(JobFilter{ErrContains: "err1"}, 2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 25, count)
+	if assert.Len(t, page, 5) {
+		assert.Equal(t, "41", page[0].ID)
+	}
+
+	page, count, err = client.DeadJobsMatching(JobFilter{From: 1010}, 2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 40, count)
+	if assert.Len(t, page, 20) {
+		assert.Equal(t, "30", page[0].ID)
+	}
+}
+
+func TestClientRetryDeadJobsMatching(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SADD", redisKeyKnownJobs(ns), "wat", "foo")
+	assert.NoError(t, err)
+
+	addZsetJobs(t, pool, redisKeyDead(ns),
+		&Job{Name: "wat", ID: "a", LastErr: "ohno", Fails: 3},
+		&Job{Name: "wat", ID: "b", LastErr: "fine"},
+		&Job{Name: "foo", ID: "c", LastErr: "ohno"},
+		&Job{Name: "gone", ID: "d", LastErr: "ohno"},
+	)
+
+	client := NewClient(ns, pool)
+	n, err := client.RetryDeadJobsMatching(JobFilter{ErrContains: "ohno"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+	job := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, "a", job.ID)
+	assert.EqualValues(t, 0, job.Fails)
+	assert.Equal(t, "", job.LastErr)
+
+	// The job of an unknown name stays dead
+	dead, count, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	if assert.Len(t, dead, 2) {
+		assert.Equal(t, "b", dead[0].ID)
+		assert.Equal(t, "d", dead[1].ID)
+		assert.Equal(t, "unknown job when requeueing", dead[1].LastErr)
+	}
+}
+
+func TestClientDeleteJobsMatching(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueUniqueIn("wat", 300, Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("foo", 300, nil)
+	assert.NoError(t, err)
+	addZsetJobs(t, pool, redisKeyRetry(ns),
+		&Job{Name: "wat", ID: "a"},
+		&Job{Name: "foo", ID: "b"},
+	)
+	addZsetJobs(t, pool, redisKeyDead(ns),
+		&Job{Name: "wat", ID: "c"},
+		&Job{Name: "wat", ID: "d"},
+	)
+
+	client := NewClient(ns, pool)
+
+	n, err := client.DeleteScheduledJobsMatching(JobFilter{Name: "wat"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, n)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+	// The unique key was deleted with the job, so it can be enqueued again
+	job, err := enqueuer.EnqueueUniqueIn("wat", 300, Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	n, err = client.DeleteRetryJobsMatching(JobFilter{Name: "foo"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, n)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+
+	n, err = client.DeleteDeadJobsMatching(JobFilter{})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, n)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+}
@@ -0,0 +1,260 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ErrJobNotFound is returned by Client.FindJob when there is no job with the given ID.
+var ErrJobNotFound = fmt.Errorf("job not found")
+
+// findJobChunkSize is the number of jobs read at a time when scanning a queue or a set for a job.
+const findJobChunkSize = 1000
+
+// JobLocation says where a job is, see Client.FindJob.
+type JobLocation string
+
+const (
+	JobLocationQueue      JobLocation = "queue"       // Waiting in the queue of its job name, on the named queue in Job.Queue
+	JobLocationInProgress JobLocation = "in_progress" // Being run by a worker pool
+	JobLocationScheduled  JobLocation = "scheduled"   // Waiting to be run at a given time
+	JobLocationRetry      JobLocation = "retry"       // Waiting to be retried at a given time
+	JobLocationDead       JobLocation = "dead"        // In the dead queue
+	JobLocationKeyWaiting JobLocation = "key_waiting" // Parked until its concurrency key, in ConcurrencyKey, is released
+	JobLocationWorkflow   JobLocation = "workflow"    // Held back by its workflow, in Job.WorkflowID, until its dependencies succeed
+)
+
+// FoundJob is a job found by Client.FindJob, with where it is.
+type FoundJob struct {
+	Location       JobLocation `json:"location"`
+	PoolID         string      `json:"pool_id,omitempty"`         // The worker pool running the job, if it's in progress
+	At             int64       `json:"at,omitempty"`              // When a scheduled or retry job runs, or when a dead job died, in epoch seconds
+	ConcurrencyKey string      `json:"concurrency_key,omitempty"` // The concurrency key the job is parked on, see JobOptions.ConcurrencyKeys
+	*Job
+}
+
+// FindJob looks for the job with the specified ID in the job queues, the in progress queues of the worker pools, the
+// lists of jobs parked until their concurrency key is released, the scheduled, retry and dead queues, and the jobs
+// workflows hold back until their dependencies succeed, in this order, and returns the first one it finds, or
+// ErrJobNotFound. The At of a dead job is what DeleteDeadJob and RetryDeadJob take as diedAt.
+//
+// There is no index of the jobs: they're scanned in chunks, using a script per queue and SCAN for the sets and the
+// workflows. This is fine for lookups by hand, but it gets slow with millions of jobs.
+func (c *Client) FindJob(jobID string) (*FoundJob, error) {
+	quotedID, err := json.Marshal(jobID)
+	if err != nil {
+		return nil, err
+	}
+	needle := `"id":` + string(quotedID)
+
+	found, err := c.findJobInQueues(jobID, needle)
+	if found != nil || err != nil {
+		return found, err
+	}
+
+	for _, set := range []struct {
+		location JobLocation
+		key      string
+	}{
+		{JobLocationScheduled, redisKeyScheduled(c.namespace)},
+		{JobLocationRetry, redisKeyRetry(c.namespace)},
+		{JobLocationDead, redisKeyDead(c.namespace)},
+	} {
+		found, err := c.findJobInSet(set.key, jobID, needle)
+		if err != nil {
+			return nil, err
+		}
+		if found != nil {
+			found.Location = set.location
+			return found, nil
+		}
+	}
+
+	found, err = c.findJobInWorkflows(jobID)
+	if found != nil || err != nil {
+		return found, err
+	}
+
+	return nil, ErrJobNotFound
+}
+
+// findJobInQueues looks for the job in the job queues, on all known named queues, in the in progress queues and in the
+// concurrency key waiting lists of the known jobs, or returns nil.
+func (c *Client) findJobInQueues(jobID, needle string) (*FoundJob, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		c.logger.Error("client.find_job.known_jobs", err, "job_id", jobID)
+		return nil, err
+	}
+	poolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(c.namespace)))
+	if err != nil {
+		c.logger.Error("client.find_job.worker_pools", err, "job_id", jobID)
+		return nil, err
+	}
+	namedQueues, err := c.namedQueues(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	type list struct {
+		key            string
+		poolID         string
+		concurrencyKey string
+	}
+	var lists []list
+	for _, jobName := range jobNames {
+		lists = append(lists, list{key: redisKeyJobs(c.namespace, jobName)})
+		for _, queue := range namedQueues {
+			lists = append(lists, list{key: redisKeyJobsQueue(c.namespace, jobName, queue)})
+		}
+	}
+	for _, jobName := range jobNames {
+		for _, poolID := range poolIDs {
+			lists = append(lists, list{key: redisKeyJobsInProgress(c.namespace, poolID, jobName), poolID: poolID})
+		}
+	}
+	for _, jobName := range jobNames {
+		// A job only waits for a key that's held, so the held keys name all the waiting lists
+		keys, err := redis.Strings(conn.Do("HKEYS", redisKeyJobsKeyLocks(c.namespace, jobName)))
+		if err != nil {
+			c.logger.Error("client.find_job.key_locks", err, "job_id", jobID)
+			return nil, err
+		}
+		for _, key := range keys {
+			lists = append(lists, list{key: redisKeyJobsKeyWaitingPrefix(c.namespace, jobName) + key, concurrencyKey: key})
+		}
+	}
+
+	script := redis.NewScript(1, redisLuaFindJobInList)
+	for _, l := range lists {
+		rawJSON, err := redis.Bytes(script.Do(conn, l.key, jobID, needle, findJobChunkSize))
+		if err == redis.ErrNil {
+			continue
+		} else if err != nil {
+			c.logger.Error("client.find_job.list", err, "job_id", jobID)
+			return nil, err
+		}
+
+		job, err := newJob(rawJSON, nil, nil)
+		if err != nil {
+			c.logger.Error("client.find_job.new_job", err, "job_id", jobID)
+			return nil, err
+		}
+		found := &FoundJob{Location: JobLocationQueue, Job: job}
+		if l.poolID != "" {
+			found.Location, found.PoolID = JobLocationInProgress, l.poolID
+		} else if l.concurrencyKey != "" {
+			found.Location, found.ConcurrencyKey = JobLocationKeyWaiting, l.concurrencyKey
+		}
+		return found, nil
+	}
+
+	return nil, nil
+}
+
+// findJobInSet looks for the job in a zset of jobs with SCAN, or returns nil. At is set to the job's score.
+func (c *Client) findJobInSet(key, jobID, needle string) (*FoundJob, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	pattern := "*" + globEscaper.Replace(needle) + "*"
+	cursor := "0"
+	for {
+		values, err := redis.Values(conn.Do("ZSCAN", key, cursor, "MATCH", pattern, "COUNT", findJobChunkSize))
+		if err != nil {
+			c.logger.Error("client.find_job.zscan", err, "job_id", jobID)
+			return nil, err
+		}
+
+		var members []interface{}
+		if _, err := redis.Scan(values, &cursor, &members); err != nil {
+			c.logger.Error("client.find_job.scan", err, "job_id", jobID)
+			return nil, err
+		}
+		var jobsWithScores []jobScore
+		if err := redis.ScanSlice(members, &jobsWithScores); err != nil {
+			c.logger.Error("client.find_job.scan_slice", err, "job_id", jobID)
+			return nil, err
+		}
+
+		for _, jws := range jobsWithScores {
+			job, err := newJob(jws.JobBytes, nil, nil)
+			if err != nil {
+				c.logger.Error("client.find_job.new_job", err, "job_id", jobID)
+				return nil, err
+			}
+			if job.ID == jobID {
+				return &FoundJob{At: jws.Score, Job: job}, nil
+			}
+		}
+
+		if cursor == "0" {
+			return nil, nil
+		}
+	}
+}
+
+// findJobInWorkflows looks for the job among the pending jobs of the workflows, found with SCAN, or returns nil.
+func (c *Client) findJobInWorkflows(jobID string) (*FoundJob, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	prefix := redisKeyWorkflow(c.namespace, "")
+	pattern := globEscaper.Replace(prefix) + "*:states"
+	cursor := "0"
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", pattern, "COUNT", findJobChunkSize))
+		if err != nil {
+			c.logger.Error("client.find_job.scan_workflows", err, "job_id", jobID)
+			return nil, err
+		}
+
+		var keys []string
+		if _, err := redis.Scan(values, &cursor, &keys); err != nil {
+			c.logger.Error("client.find_job.scan", err, "job_id", jobID)
+			return nil, err
+		}
+
+		for _, key := range keys {
+			state, err := redis.String(conn.Do("HGET", key, jobID))
+			if err == redis.ErrNil {
+				continue
+			} else if err != nil {
+				c.logger.Error("client.find_job.workflow_state", err, "job_id", jobID)
+				return nil, err
+			}
+			// Once released, the job is in one of the queues searched before
+			if WorkflowJobState(state) != WorkflowJobPending {
+				continue
+			}
+
+			workflowID := strings.TrimSuffix(strings.TrimPrefix(key, prefix), ":states")
+			rawJSON, err := redis.Bytes(conn.Do("HGET", redisKeyWorkflowJobs(c.namespace, workflowID), jobID))
+			if err == redis.ErrNil {
+				continue
+			} else if err != nil {
+				c.logger.Error("client.find_job.workflow_job", err, "job_id", jobID)
+				return nil, err
+			}
+			job, err := newJob(rawJSON, nil, nil)
+			if err != nil {
+				c.logger.Error("client.find_job.new_job", err, "job_id", jobID)
+				return nil, err
+			}
+			return &FoundJob{Location: JobLocationWorkflow, Job: job}, nil
+		}
+
+		if cursor == "0" {
+			return nil, nil
+		}
+	}
+}
+
+// globEscaper escapes the special characters of the patterns of SCAN's MATCH.
+var globEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
@@ -0,0 +1,114 @@
+package work
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientFindJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	queued, err := enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	scheduled, err := enqueuer.EnqueueIn("wat", 300, Q{"b": 2})
+	assert.NoError(t, err)
+
+	conn := pool.Get()
+	defer conn.Close()
+	add := func(cmd, key string, args ...interface{}) {
+		_, err := conn.Do(cmd, append([]interface{}{key}, args...)...)
+		assert.NoError(t, err)
+	}
+	rawJob := func(job *Job) []byte {
+		rawJSON, err := json.Marshal(job)
+		assert.NoError(t, err)
+		return rawJSON
+	}
+	// A job whose args contain another job's ID must not be mistaken for it
+	add("LPUSH", redisKeyJobs(ns, "wat"), rawJob(&Job{Name: "wat", ID: "decoy", Args: Q{"id": "running"}}))
+	add("SADD", redisKeyWorkerPools(ns), "pool1")
+	add("LPUSH", redisKeyJobsInProgress(ns, "pool1", "wat"), rawJob(&Job{Name: "wat", ID: "running"}))
+	add("HSET", redisKeyJobsKeyLocks(ns, "wat"), "acct:1", 1)
+	add("LPUSH", redisKeyJobsKeyWaitingPrefix(ns, "wat")+"acct:1", rawJob(&Job{Name: "wat", ID: "parked"}))
+	add("ZADD", redisKeyRetry(ns), 1425263409, rawJob(&Job{Name: "wat", ID: "retrying", Fails: 1}))
+	add("ZADD", redisKeyDead(ns), 1425263429, rawJob(&Job{Name: "wat", ID: "dead*", Fails: 3, FailedAt: 1425263429}))
+
+	client := NewClient(ns, pool)
+
+	found, err := client.FindJob(queued.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationQueue, found.Location)
+		assert.Equal(t, queued.ID, found.ID)
+		assert.EqualValues(t, 1, found.ArgInt64("a"))
+	}
+
+	// Jobs on the named queues pools consume are found too
+	add("SADD", redisKeyKnownQueues(ns), "critical")
+	critical, err := enqueuer.EnqueueOnQueue("critical", "wat", nil)
+	assert.NoError(t, err)
+	found, err = client.FindJob(critical.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationQueue, found.Location)
+		assert.Equal(t, "critical", found.Queue)
+	}
+
+	found, err = client.FindJob("running")
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationInProgress, found.Location)
+		assert.Equal(t, "pool1", found.PoolID)
+	}
+
+	found, err = client.FindJob("parked")
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationKeyWaiting, found.Location)
+		assert.Equal(t, "acct:1", found.ConcurrencyKey)
+		assert.Empty(t, found.PoolID)
+	}
+
+	found, err = client.FindJob(scheduled.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationScheduled, found.Location)
+		assert.Equal(t, scheduled.RunAt, found.At)
+		assert.EqualValues(t, 2, found.ArgInt64("b"))
+	}
+
+	found, err = client.FindJob("retrying")
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationRetry, found.Location)
+		assert.EqualValues(t, 1425263409, found.At)
+	}
+
+	found, err = client.FindJob("dead*")
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationDead, found.Location)
+		assert.EqualValues(t, 3, found.Fails)
+		assert.NoError(t, client.DeleteDeadJob(found.At, found.ID))
+	}
+
+	_, err = client.FindJob("dead*")
+	assert.Equal(t, ErrJobNotFound, err)
+
+	// A workflow job held back until its dependencies succeed is found in the workflow
+	wf := enqueuer.NewWorkflow()
+	first := wf.Add("wat", nil)
+	pending := wf.Add("wat", Q{"c": 3}, first)
+	assert.NoError(t, wf.Enqueue())
+	found, err = client.FindJob(pending.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationWorkflow, found.Location)
+		assert.Equal(t, wf.ID, found.WorkflowID)
+		assert.EqualValues(t, 3, found.ArgInt64("c"))
+	}
+	found, err = client.FindJob(first.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, JobLocationQueue, found.Location)
+	}
+
+	_, err = client.FindJob("nope")
+	assert.Equal(t, ErrJobNotFound, err)
+}
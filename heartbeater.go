@@ -4,6 +4,7 @@ import (
 	"os"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
@@ -18,12 +19,19 @@ type workerPoolHeartbeater struct {
 	namespace    string // eg, "myapp-work"
 	pool         *redis.Pool
 	beatPeriod   time.Duration
-	concurrency  uint
-	jobNames     string
 	startedAt    int64
 	pid          int
 	hostname     string
-	workerIDs    string
+	logger       Logger
+
+	// concurrency and workerIDs change when the pool is resized, and the job names when job types are added or removed
+	mtx         sync.Mutex
+	concurrency uint
+	workerIDs   string
+	jobNames    []string
+	// removedJobNames are listed in job_names until the pool has none of their jobs in progress, so that the reaper
+	// still requeues them if the process dies
+	removedJobNames []string
 
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
@@ -36,6 +44,7 @@ func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID s
 		pool:             pool,
 		beatPeriod:       beatPeriod,
 		concurrency:      concurrency,
+		logger:           withFields(defaultLogger, "namespace", namespace, "pool_id", workerPoolID),
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 	}
@@ -45,23 +54,49 @@ func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID s
 		jobNames = append(jobNames, k)
 	}
 	sort.Strings(jobNames)
-	h.jobNames = strings.Join(jobNames, ",")
+	h.jobNames = jobNames
 
 	sort.Strings(workerIDs)
 	h.workerIDs = strings.Join(workerIDs, ",")
 
 	h.pid = os.Getpid()
+
+	return h
+}
+
+// update changes the concurrency and worker IDs in the heartbeat, and beats right away.
+func (h *workerPoolHeartbeater) update(concurrency uint, workerIDs []string) {
+	sort.Strings(workerIDs)
+	h.mtx.Lock()
+	h.concurrency = concurrency
+	h.workerIDs = strings.Join(workerIDs, ",")
+	h.mtx.Unlock()
+
+	h.heartbeat()
+}
+
+// updateJobNames changes the job names in the heartbeat, and beats right away. removedJobNames are the ones the pool
+// no longer handles, but may still have jobs in progress for.
+func (h *workerPoolHeartbeater) updateJobNames(jobNames, removedJobNames []string) {
+	sort.Strings(jobNames)
+	h.mtx.Lock()
+	h.jobNames = jobNames
+	h.removedJobNames = removedJobNames
+	h.mtx.Unlock()
+
+	h.heartbeat()
+}
+
+func (h *workerPoolHeartbeater) start() {
+	// Looked up here rather than in newWorkerPoolHeartbeater, so that a failure is logged with the pool's logger
 	host, err := os.Hostname()
 	if err != nil {
-		logError("heartbeat.hostname", err)
+		h.logger.Error("heartbeat.hostname", err)
 		host = "hostname_errored"
 	}
 	h.hostname = host
+	h.startedAt = nowEpochSeconds()
 
-	return h
-}
-
-func (h *workerPoolHeartbeater) start() {
 	go h.loop()
 }
 
@@ -71,7 +106,6 @@ func (h *workerPoolHeartbeater) stop() {
 }
 
 func (h *workerPoolHeartbeater) loop() {
-	h.startedAt = nowEpochSeconds()
 	h.heartbeat() // do it right away
 	ticker := time.Tick(h.beatPeriod)
 	for {
@@ -93,11 +127,21 @@ func (h *workerPoolHeartbeater) heartbeat() {
 	workerPoolsKey := redisKeyWorkerPools(h.namespace)
 	heartbeatKey := redisKeyHeartbeat(h.namespace, h.workerPoolID)
 
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	jobNames := h.jobNames
+	if len(h.removedJobNames) > 0 {
+		h.removedJobNames = h.inProgressJobNames(conn, h.removedJobNames)
+		jobNames = append(append([]string(nil), h.jobNames...), h.removedJobNames...)
+		sort.Strings(jobNames)
+	}
+
 	conn.Send("SADD", workerPoolsKey, h.workerPoolID)
 	conn.Send("HMSET", heartbeatKey,
 		"heartbeat_at", nowEpochSeconds(),
 		"started_at", h.startedAt,
-		"job_names", h.jobNames,
+		"job_names", strings.Join(jobNames, ","),
 		"concurrency", h.concurrency,
 		"worker_ids", h.workerIDs,
 		"host", h.hostname,
@@ -105,8 +149,31 @@ func (h *workerPoolHeartbeater) heartbeat() {
 	)
 
 	if err := conn.Flush(); err != nil {
-		logError("heartbeat", err)
+		h.logger.Error("heartbeat", err)
+	}
+}
+
+// inProgressJobNames returns the jobNames the pool has jobs in progress for. It keeps them all if it can't tell.
+func (h *workerPoolHeartbeater) inProgressJobNames(conn redis.Conn, jobNames []string) []string {
+	for _, jobName := range jobNames {
+		conn.Send("LLEN", redisKeyJobsInProgress(h.namespace, h.workerPoolID, jobName))
+	}
+	if err := conn.Flush(); err != nil {
+		h.logger.Error("heartbeat.in_progress", err)
+		return jobNames
+	}
+
+	var inProgress []string
+	for _, jobName := range jobNames {
+		n, err := redis.Int64(conn.Receive())
+		if err != nil {
+			h.logger.Error("heartbeat.in_progress", err, "job_name", jobName)
+		}
+		if err != nil || n > 0 {
+			inProgress = append(inProgress, jobName)
+		}
 	}
+	return inProgress
 }
 
 func (h *workerPoolHeartbeater) removeHeartbeat() {
@@ -120,6 +187,6 @@ func (h *workerPoolHeartbeater) removeHeartbeat() {
 	conn.Send("DEL", heartbeatKey)
 
 	if err := conn.Flush(); err != nil {
-		logError("remove_heartbeat", err)
+		h.logger.Error("remove_heartbeat", err)
 	}
 }
@@ -0,0 +1,108 @@
+package work
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// JobFate is what became of a job whose run failed, see WorkerPool.OnFailure.
+type JobFate struct {
+	Retry   bool      // The job will be retried at RetryAt
+	RetryAt time.Time // Only set if Retry is
+	Dead    bool      // The job ran out of retries, or has no handler, and was put in the dead set
+	Dropped bool      // The job ran out of retries and was dropped, because of JobOptions.SkipDead, or it couldn't be serialized
+}
+
+// jobHooks are the hooks registered on a worker pool. Its workers share them.
+type jobHooks struct {
+	onStart   []func(job *Job)
+	onSuccess []func(job *Job)
+	onFailure []func(job *Job, err error, fate JobFate)
+	onRetry   []func(job *Job, err error, retryAt time.Time)
+	onDead    []func(job *Job, err error)
+}
+
+// OnStart registers fn to be called before the middleware and handler of a job run. It isn't called for jobs without a
+// handler.
+//
+// Hooks are called from the workers' goroutines, so they should be quick, and they must be registered before Start.
+func (wp *WorkerPool) OnStart(fn func(job *Job)) *WorkerPool {
+	wp.hooks.onStart = append(wp.hooks.onStart, fn)
+	return wp
+}
+
+// OnSuccess registers fn to be called once a job succeeded and was removed from its in progress queue.
+func (wp *WorkerPool) OnSuccess(fn func(job *Job)) *WorkerPool {
+	wp.hooks.onSuccess = append(wp.hooks.onSuccess, fn)
+	return wp
+}
+
+// OnFailure registers fn to be called once a run of a job failed with err, including a stray job without a handler,
+// and its fate was recorded in Redis. Unlike middleware, fn knows whether the job will be retried.
+func (wp *WorkerPool) OnFailure(fn func(job *Job, err error, fate JobFate)) *WorkerPool {
+	wp.hooks.onFailure = append(wp.hooks.onFailure, fn)
+	return wp
+}
+
+// OnRetry registers fn to be called once a job failed with err and was put in the retry set, to be retried at retryAt.
+func (wp *WorkerPool) OnRetry(fn func(job *Job, err error, retryAt time.Time)) *WorkerPool {
+	wp.hooks.onRetry = append(wp.hooks.onRetry, fn)
+	return wp
+}
+
+// OnDead registers fn to be called once a job failed with err and was put in the dead set. It isn't called for jobs
+// dropped because of JobOptions.SkipDead.
+func (wp *WorkerPool) OnDead(fn func(job *Job, err error)) *WorkerPool {
+	wp.hooks.onDead = append(wp.hooks.onDead, fn)
+	return wp
+}
+
+func (h *jobHooks) started(logger Logger, job *Job) {
+	if h == nil {
+		return
+	}
+	for _, fn := range h.onStart {
+		callHook(logger, "on_start", job, func() { fn(job) })
+	}
+}
+
+// finished calls the hooks of a job that ran and whose fate was recorded.
+func (h *jobHooks) finished(logger Logger, job *Job, runErr error, fate JobFate) {
+	if h == nil {
+		return
+	}
+
+	if runErr == nil {
+		for _, fn := range h.onSuccess {
+			callHook(logger, "on_success", job, func() { fn(job) })
+		}
+		return
+	}
+
+	for _, fn := range h.onFailure {
+		callHook(logger, "on_failure", job, func() { fn(job, runErr, fate) })
+	}
+	if fate.Retry {
+		for _, fn := range h.onRetry {
+			callHook(logger, "on_retry", job, func() { fn(job, runErr, fate.RetryAt) })
+		}
+	}
+	if fate.Dead {
+		for _, fn := range h.onDead {
+			callHook(logger, "on_dead", job, func() { fn(job, runErr) })
+		}
+	}
+}
+
+// callHook calls fn, a hook of job. A hook that panics is logged, and doesn't keep the worker from going on with the
+// other hooks and jobs.
+func callHook(logger Logger, hook string, job *Job, fn func()) {
+	defer func() {
+		if panicErr := recover(); panicErr != nil {
+			logger.Error("job_hook.panic", fmt.Errorf("%v", panicErr),
+				append(jobFields(job), "hook", hook, "stack", string(debug.Stack()))...)
+		}
+	}()
+	fn()
+}
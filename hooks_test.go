@@ -0,0 +1,194 @@
+package work
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerPoolHooks(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	var events []string
+	fates := map[string]JobFate{}
+	record := func(event string, job *Job) {
+		mtx.Lock()
+		events = append(events, event+" "+job.Name)
+		mtx.Unlock()
+	}
+
+	fail := func(job *Job) error {
+		return fmt.Errorf("ohno")
+	}
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("ok", func(job *Job) error {
+		return nil
+	})
+	wp.JobWithOptions("flaky", JobOptions{MaxFails: 2, Backoff: func(job *Job) int64 { return 100 }}, fail)
+	wp.JobWithOptions("doomed", JobOptions{MaxFails: 1}, fail)
+	wp.JobWithOptions("dropped", JobOptions{MaxFails: 1, SkipDead: true}, fail)
+	wp.OnStart(func(job *Job) {
+		record("start", job)
+	}).OnSuccess(func(job *Job) {
+		record("success", job)
+	}).OnFailure(func(job *Job, err error, fate JobFate) {
+		assert.EqualError(t, err, "ohno")
+		assert.EqualValues(t, 1, job.Fails)
+		record("failure", job)
+		mtx.Lock()
+		fates[job.Name] = fate
+		mtx.Unlock()
+	}).OnRetry(func(job *Job, err error, retryAt time.Time) {
+		record("retry", job)
+	}).OnDead(func(job *Job, err error) {
+		record("dead", job)
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, jobName := range []string{"ok", "flaky", "doomed", "dropped"} {
+		_, err := enqueuer.Enqueue(jobName, nil)
+		assert.NoError(t, err)
+	}
+
+	startedAt := time.Now()
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// The fate hooks only run once the fate is in Redis
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	for _, jobName := range []string{"ok", "flaky", "doomed", "dropped"} {
+		assert.Contains(t, events, "start "+jobName)
+	}
+	assert.Contains(t, events, "success ok")
+	assert.Contains(t, events, "retry flaky")
+	assert.Contains(t, events, "dead doomed")
+	assert.Len(t, events, 10)
+
+	flaky := fates["flaky"]
+	assert.True(t, flaky.Retry)
+	assert.WithinDuration(t, startedAt.Add(100*time.Second), flaky.RetryAt, 2*time.Second)
+	assert.False(t, flaky.Dead || flaky.Dropped)
+	assert.Equal(t, JobFate{Dead: true}, fates["doomed"])
+	assert.Equal(t, JobFate{Dropped: true}, fates["dropped"])
+}
+
+func TestWorkerHooksStrayJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var started, dead []string
+	var fate JobFate
+	hooks := &jobHooks{}
+	hooks.onStart = append(hooks.onStart, func(job *Job) {
+		started = append(started, job.ID)
+	})
+	hooks.onFailure = append(hooks.onFailure, func(job *Job, err error, f JobFate) {
+		fate = f
+	})
+	hooks.onDead = append(hooks.onDead, func(job *Job, err error) {
+		assert.EqualError(t, err, "stray job: no handler")
+		dead = append(dead, job.ID)
+	})
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, nil)
+	w.hooks = hooks
+	job := &Job{Name: "wat", ID: "a1", inProgQueue: []byte(redisKeyJobsInProgress(ns, "1", "wat"))}
+	w.processJob(job)
+
+	assert.Empty(t, started)
+	assert.Equal(t, JobFate{Dead: true}, fate)
+	assert.Equal(t, []string{"a1"}, dead)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestWorkerHooksPanic(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var succeeded []string
+	hooks := &jobHooks{}
+	hooks.onStart = append(hooks.onStart, func(job *Job) {
+		panic("ohno")
+	})
+	hooks.onSuccess = append(hooks.onSuccess, func(job *Job) {
+		panic("ohno again")
+	}, func(job *Job) {
+		succeeded = append(succeeded, job.ID)
+	})
+
+	jobTypes := map[string]*jobType{
+		"wat": {Name: "wat", IsGeneric: true, GenericHandler: func(job *Job) error { return nil }},
+	}
+	logger := &testLogger{}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.setLogger(logger)
+	w.hooks = hooks
+	job := &Job{Name: "wat", ID: "a1", inProgQueue: []byte(redisKeyJobsInProgress(ns, "1", "wat"))}
+	w.processJob(job)
+
+	// The handler and the other hooks still ran
+	assert.Equal(t, []string{"a1"}, succeeded)
+	assert.Len(t, logger.entries, 2)
+	entry := logger.find("job_hook.panic")
+	if assert.NotNil(t, entry) {
+		assert.EqualError(t, entry.err, "ohno")
+		assert.Equal(t, "on_start", entry.fields["hook"])
+		assert.Equal(t, "a1", entry.fields["job_id"])
+		assert.NotEmpty(t, entry.fields["stack"])
+	}
+}
+
+func TestWorkerHooksUnserializableJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var fates []JobFate
+	var retried, dead int
+	hooks := &jobHooks{}
+	hooks.onFailure = append(hooks.onFailure, func(job *Job, err error, f JobFate) {
+		fates = append(fates, f)
+	})
+	hooks.onRetry = append(hooks.onRetry, func(job *Job, err error, retryAt time.Time) {
+		retried++
+	})
+	hooks.onDead = append(hooks.onDead, func(job *Job, err error) {
+		dead++
+	})
+
+	fail := func(job *Job) error {
+		return fmt.Errorf("ohno")
+	}
+	jobTypes := map[string]*jobType{
+		"flaky":  {Name: "flaky", JobOptions: JobOptions{MaxFails: 3}, IsGeneric: true, GenericHandler: fail},
+		"doomed": {Name: "doomed", JobOptions: JobOptions{MaxFails: 1}, IsGeneric: true, GenericHandler: fail},
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.setLogger(&testLogger{})
+	w.hooks = hooks
+	for _, jobName := range []string{"flaky", "doomed"} {
+		// A job whose args can't be marshalled can't be put in the retry or dead set
+		job := &Job{Name: jobName, ID: jobName, Args: map[string]interface{}{"ch": make(chan int)},
+			inProgQueue: []byte(redisKeyJobsInProgress(ns, "1", jobName))}
+		w.processJob(job)
+	}
+
+	assert.Equal(t, []JobFate{{Dropped: true}, {Dropped: true}}, fates)
+	assert.Zero(t, retried)
+	assert.Zero(t, dead)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+}
@@ -10,12 +10,16 @@ import (
 // Job represents a job.
 type Job struct {
 	// Inputs when making a new job
-	Name       string                 `json:"name,omitempty"`
-	ID         string                 `json:"id"`
-	EnqueuedAt int64                  `json:"t"`
-	Args       map[string]interface{} `json:"args"`
-	Unique     bool                   `json:"unique,omitempty"`
-	UniqueKey  string                 `json:"unique_key,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	ID          string                 `json:"id"`
+	EnqueuedAt  int64                  `json:"t"`
+	TraceParent string                 `json:"traceparent,omitempty"` // W3C traceparent of the span that enqueued the job, see Enqueuer.EnqueueContext
+	Args        map[string]interface{} `json:"args"`
+	Unique      bool                   `json:"unique,omitempty"`
+	UniqueKey   string                 `json:"unique_key,omitempty"`
+	WorkflowID  string                 `json:"workflow_id,omitempty"`
+	BatchID     string                 `json:"batch_id,omitempty"`
+	Queue       string                 `json:"queue,omitempty"` // named queue the job is on, empty for DefaultQueue, see Enqueuer.RouteJob
 
 	// Inputs when retrying
 	Fails    int64  `json:"fails,omitempty"` // number of times this job has failed
@@ -27,6 +31,9 @@ type Job struct {
 	inProgQueue  []byte
 	argError     error
 	observer     *observer
+
+	concurrencyKey string // set once the worker took the job's concurrency key, see JobOptions.ConcurrencyKeys
+	result         []byte // set by SetResult
 }
 
 // Q is a shortcut to easily specify arguments for jobs when enqueueing them.
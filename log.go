@@ -1,10 +1,66 @@
 package work
 
 import (
-	"github.com/juju/errors"
-	commonlog "github.com/wallester/monorepo/pkg/common/log"
+	"log/slog"
 )
 
-func logError(key string, err error) {
-	commonlog.Error(errors.Annotate(err, key))
+// Logger logs the errors of worker pools, enqueuers, clients and the web UI, including the ones that can't be returned
+// to anyone, like a worker failing to fetch a job. It must be safe for concurrent use.
+type Logger interface {
+	// Error logs err. msg says where it happened, like "worker.fetch". fields are key/value pairs giving more context,
+	// among them "namespace", "pool_id", "worker_id", "job_name" and "job_id" where they're known.
+	Error(msg string, err error, fields ...interface{})
+}
+
+// defaultLogger is the Logger used until one is set with SetLogger.
+var defaultLogger = NewSlogLogger(nil)
+
+// NewSlogLogger returns a Logger that logs to l with level error, err being the "error" attribute. If l is nil, it
+// logs to slog.Default() at the time of the call.
+func NewSlogLogger(l *slog.Logger) Logger {
+	return slogLogger{l: l}
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+func (s slogLogger) Error(msg string, err error, fields ...interface{}) {
+	l := s.l
+	if l == nil {
+		l = slog.Default()
+	}
+	l.Error(msg, append([]interface{}{"error", err}, fields...)...)
+}
+
+// withFields returns a Logger that logs with logger, adding fields before the fields of every error.
+func withFields(logger Logger, fields ...interface{}) Logger {
+	if logger == nil {
+		logger = defaultLogger
+	}
+	if fl, ok := logger.(fieldLogger); ok {
+		return fieldLogger{logger: fl.logger, fields: concatFields(fl.fields, fields)}
+	}
+	return fieldLogger{logger: logger, fields: fields}
+}
+
+type fieldLogger struct {
+	logger Logger
+	fields []interface{}
+}
+
+func (fl fieldLogger) Error(msg string, err error, fields ...interface{}) {
+	fl.logger.Error(msg, err, concatFields(fl.fields, fields)...)
+}
+
+// concatFields returns a new slice, so that concurrent calls never share the backing array of a.
+func concatFields(a, b []interface{}) []interface{} {
+	fields := make([]interface{}, 0, len(a)+len(b))
+	fields = append(fields, a...)
+	return append(fields, b...)
+}
+
+// jobFields returns the fields identifying job.
+func jobFields(job *Job) []interface{} {
+	return []interface{}{"job_name", job.Name, "job_id", job.ID}
 }
@@ -0,0 +1,126 @@
+package work
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := withFields(NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, nil))), "namespace", "work")
+	logger = withFields(logger, "pool_id", "abcd")
+	logger.Error("worker.fetch", fmt.Errorf("ohno"), "job_name", "wat")
+
+	var record map[string]interface{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "ERROR", record["level"])
+	assert.Equal(t, "worker.fetch", record["msg"])
+	assert.Equal(t, "ohno", record["error"])
+	assert.Equal(t, "work", record["namespace"])
+	assert.Equal(t, "abcd", record["pool_id"])
+	assert.Equal(t, "wat", record["job_name"])
+}
+
+func TestWorkerPoolSetLogger(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	logger := &testLogger{}
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.SetLogger(logger)
+	wp.JobWithOptions(job1, JobOptions{MaxFails: 1}, func(job *Job) error {
+		panic("ohno")
+	})
+
+	job, err := NewEnqueuer(ns, pool).Enqueue(job1, nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	entry := logger.find("run_job.panic")
+	if assert.NotNil(t, entry) {
+		assert.EqualError(t, entry.err, "ohno")
+		assert.Equal(t, ns, entry.fields["namespace"])
+		assert.Equal(t, wp.workerPoolID, entry.fields["pool_id"])
+		assert.Equal(t, wp.workers[0].workerID, entry.fields["worker_id"])
+		assert.Equal(t, job1, entry.fields["job_name"])
+		assert.Equal(t, job.ID, entry.fields["job_id"])
+		assert.Contains(t, entry.fields["stack"], "runJob")
+	}
+}
+
+func TestClientAndEnqueuerSetLogger(t *testing.T) {
+	pool := newTestPool("notworking:6379")
+	ns := "work"
+
+	logger := &testLogger{}
+	client := NewClient(ns, pool)
+	client.SetLogger(logger)
+	_, _, err := client.RetryJobs(1)
+	assert.Error(t, err)
+
+	entry := logger.find("client.retry_jobs.get_zset_page")
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, err, entry.err)
+		assert.Equal(t, ns, entry.fields["namespace"])
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.SetLogger(logger)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.Error(t, err)
+
+	entry = logger.find("enqueuer.enqueue.lpush")
+	if assert.NotNil(t, entry) {
+		assert.Equal(t, err, entry.err)
+		assert.Equal(t, ns, entry.fields["namespace"])
+		assert.Equal(t, "wat", entry.fields["job_name"])
+		assert.NotEmpty(t, entry.fields["job_id"])
+	}
+}
+
+// testLogger keeps the errors it's given.
+type testLogger struct {
+	mtx     sync.Mutex
+	entries []*testLogEntry
+}
+
+type testLogEntry struct {
+	msg    string
+	err    error
+	fields map[string]interface{}
+}
+
+func (l *testLogger) Error(msg string, err error, fields ...interface{}) {
+	entry := &testLogEntry{msg: msg, err: err, fields: map[string]interface{}{}}
+	for i := 0; i+1 < len(fields); i += 2 {
+		entry.fields[fields[i].(string)] = fields[i+1]
+	}
+
+	l.mtx.Lock()
+	l.entries = append(l.entries, entry)
+	l.mtx.Unlock()
+}
+
+// find returns the first error logged with msg, or nil.
+func (l *testLogger) find(msg string) *testLogEntry {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for _, entry := range l.entries {
+		if entry.msg == msg {
+			return entry
+		}
+	}
+	return nil
+}
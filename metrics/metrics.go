@@ -0,0 +1,263 @@
+// Package metrics exports the state of a work namespace, and the outcome of the jobs run by worker pools, in the
+// Prometheus text format.
+//
+//	exporter := metrics.NewExporter("my_app_namespace", redisPool)
+//	workerPool.RecordJobs(exporter)
+//	http.Handle("/metrics", exporter)
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/wallester/work"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the buckets of the job duration histograms.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 300}
+
+// liveHeartbeatAge is how recent the heartbeat of a worker pool must be for it to count as live. It's the age at
+// which the dead pool reaper considers a pool dead.
+const liveHeartbeatAge = 10 * time.Second
+
+// Exporter is an http.Handler that exports metrics in the Prometheus text format:
+//
+//   - the number of jobs and latency of every queue, and whether it's paused, from Client.Queues
+//   - the number of jobs in the retry, scheduled and dead sets
+//   - the number of busy and idle workers, and of live worker pools
+//   - the number of jobs that succeeded or failed, and a histogram of their duration, per job name
+//
+// The first three are read from Redis on every scrape, so they cover all worker pools of the namespace. The job counts
+// and durations only cover the worker pools of this process that record their jobs with the Exporter, see
+// WorkerPool.RecordJobs.
+type Exporter struct {
+	namespace string
+	client    *work.Client
+	buckets   []float64
+
+	mtx  sync.Mutex
+	jobs map[string]*jobStats
+}
+
+type jobStats struct {
+	succeeded    uint64
+	failed       uint64
+	bucketCounts []uint64 // not cumulative
+	count        uint64
+	sum          float64
+}
+
+// NewExporter creates a new Exporter for the specified namespace, with the DefaultBuckets.
+func NewExporter(namespace string, pool *redis.Pool) *Exporter {
+	return NewExporterWithBuckets(namespace, pool, DefaultBuckets)
+}
+
+// NewExporterWithBuckets creates a new Exporter whose job duration histograms have the specified buckets, in seconds.
+func NewExporterWithBuckets(namespace string, pool *redis.Pool, buckets []float64) *Exporter {
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	return &Exporter{
+		namespace: namespace,
+		client:    work.NewClient(namespace, pool),
+		buckets:   buckets,
+		jobs:      make(map[string]*jobStats),
+	}
+}
+
+// RecordJob implements work.JobRecorder.
+func (e *Exporter) RecordJob(jobName string, duration time.Duration, err error) {
+	seconds := duration.Seconds()
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	stats, ok := e.jobs[jobName]
+	if !ok {
+		stats = &jobStats{bucketCounts: make([]uint64, len(e.buckets))}
+		e.jobs[jobName] = stats
+	}
+
+	if err != nil {
+		stats.failed++
+	} else {
+		stats.succeeded++
+	}
+
+	stats.count++
+	stats.sum += seconds
+	if i := sort.SearchFloat64s(e.buckets, seconds); i < len(e.buckets) {
+		stats.bucketCounts[i]++
+	}
+}
+
+// ServeHTTP writes the metrics. It fails with a 500 if Redis can't be queried.
+func (e *Exporter) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	if err := e.write(&buf); err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	rw.Write(buf.Bytes())
+}
+
+func (e *Exporter) write(out io.Writer) error {
+	queues, err := e.client.Queues()
+	if err != nil {
+		return err
+	}
+	sizes, err := e.client.SetSizes()
+	if err != nil {
+		return err
+	}
+	observations, err := e.client.WorkerObservations()
+	if err != nil {
+		return err
+	}
+	heartbeats, err := e.client.WorkerPoolHeartbeats()
+	if err != nil {
+		return err
+	}
+
+	w := &writer{out: out, namespace: e.namespace}
+
+	w.header("work_queue_jobs", "gauge", "Number of jobs waiting in the queue.")
+	for _, q := range queues {
+		w.sample("work_queue_jobs", float64(q.Count), "job_name", q.JobName)
+	}
+	w.header("work_queue_latency_seconds", "gauge", "How long ago the next job of the queue was enqueued.")
+	for _, q := range queues {
+		w.sample("work_queue_latency_seconds", float64(q.Latency), "job_name", q.JobName)
+	}
+	w.header("work_queue_paused", "gauge", "Whether the queue is paused.")
+	for _, q := range queues {
+		w.sample("work_queue_paused", boolValue(q.Paused), "job_name", q.JobName)
+	}
+
+	w.header("work_retry_jobs", "gauge", "Number of jobs waiting to be retried.")
+	w.sample("work_retry_jobs", float64(sizes.Retry))
+	w.header("work_scheduled_jobs", "gauge", "Number of scheduled jobs.")
+	w.sample("work_scheduled_jobs", float64(sizes.Scheduled))
+	w.header("work_dead_jobs", "gauge", "Number of dead jobs.")
+	w.sample("work_dead_jobs", float64(sizes.Dead))
+
+	var busy, idle int
+	for _, ob := range observations {
+		if ob.IsBusy {
+			busy++
+		} else {
+			idle++
+		}
+	}
+	w.header("work_workers", "gauge", "Number of workers by state.")
+	w.sample("work_workers", float64(busy), "state", "busy")
+	w.sample("work_workers", float64(idle), "state", "idle")
+
+	var live int
+	liveSince := time.Now().Add(-liveHeartbeatAge).Unix()
+	for _, hb := range heartbeats {
+		if hb.HeartbeatAt >= liveSince {
+			live++
+		}
+	}
+	w.header("work_worker_pools", "gauge", "Number of worker pools with a recent heartbeat.")
+	w.sample("work_worker_pools", float64(live))
+
+	e.writeJobStats(w)
+
+	return w.err
+}
+
+func (e *Exporter) writeJobStats(w *writer) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	jobNames := make([]string, 0, len(e.jobs))
+	for jobName := range e.jobs {
+		jobNames = append(jobNames, jobName)
+	}
+	sort.Strings(jobNames)
+
+	w.header("work_jobs_total", "counter", "Number of jobs run by this process, by outcome.")
+	for _, jobName := range jobNames {
+		stats := e.jobs[jobName]
+		w.sample("work_jobs_total", float64(stats.succeeded), "job_name", jobName, "outcome", "succeeded")
+		w.sample("work_jobs_total", float64(stats.failed), "job_name", jobName, "outcome", "failed")
+	}
+
+	w.header("work_job_duration_seconds", "histogram", "How long the jobs run by this process took.")
+	for _, jobName := range jobNames {
+		stats := e.jobs[jobName]
+		var cumulative uint64
+		for i, upperBound := range e.buckets {
+			cumulative += stats.bucketCounts[i]
+			w.sample("work_job_duration_seconds_bucket", float64(cumulative), "job_name", jobName, "le", formatFloat(upperBound))
+		}
+		w.sample("work_job_duration_seconds_bucket", float64(stats.count), "job_name", jobName, "le", "+Inf")
+		w.sample("work_job_duration_seconds_sum", stats.sum, "job_name", jobName)
+		w.sample("work_job_duration_seconds_count", float64(stats.count), "job_name", jobName)
+	}
+}
+
+// writer writes metrics in the Prometheus text format. Every sample gets the namespace label. The first error is kept
+// in err, and nothing is written after it.
+type writer struct {
+	out       io.Writer
+	namespace string
+	err       error
+}
+
+func (w *writer) header(name, typ, help string) {
+	w.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+// sample writes a sample with the specified label names and values, in pairs.
+func (w *writer) sample(name string, value float64, labels ...string) {
+	var b strings.Builder
+	b.WriteString(`namespace="`)
+	b.WriteString(escapeLabelValue(w.namespace))
+	b.WriteByte('"')
+	for i := 0; i+1 < len(labels); i += 2 {
+		b.WriteByte(',')
+		b.WriteString(labels[i])
+		b.WriteString(`="`)
+		b.WriteString(escapeLabelValue(labels[i+1]))
+		b.WriteByte('"')
+	}
+
+	w.printf("%s{%s} %s\n", name, b.String(), formatFloat(value))
+}
+
+func (w *writer) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = fmt.Fprintf(w.out, format, args...)
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(v string) string {
+	return labelValueReplacer.Replace(v)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
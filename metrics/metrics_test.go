@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/wallester/work"
+)
+
+func TestExporter(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	exporter := NewExporterWithBuckets(ns, pool, []float64{10, 0.5})
+	wp := work.NewWorkerPool(struct{}{}, 2, ns, pool)
+	wp.RecordJobs(exporter)
+	wp.JobWithOptions("wat", work.JobOptions{MaxFails: 1}, func(job *work.Job) error {
+		if job.ArgBool("fail") {
+			return fmt.Errorf("ohno")
+		}
+		return nil
+	})
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", work.Q{"fail": false})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", work.Q{"fail": true})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("wat", 100, nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+
+	_, err = enqueuer.Enqueue(`q"uote`, nil)
+	assert.NoError(t, err)
+	exporter.RecordJob("slow", 2*time.Second, nil)
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	exporter.ServeHTTP(recorder, request)
+	wp.Stop()
+
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+	lines := strings.Split(recorder.Body.String(), "\n")
+	for _, expected := range []string{
+		"# TYPE work_queue_jobs gauge",
+		`work_queue_jobs{namespace="work",job_name="q\"uote"} 1`,
+		`work_queue_jobs{namespace="work",job_name="wat"} 0`,
+		`work_queue_paused{namespace="work",job_name="wat"} 0`,
+		`work_retry_jobs{namespace="work"} 0`,
+		`work_scheduled_jobs{namespace="work"} 1`,
+		`work_dead_jobs{namespace="work"} 1`,
+		`work_workers{namespace="work",state="busy"} 0`,
+		`work_workers{namespace="work",state="idle"} 2`,
+		`work_worker_pools{namespace="work"} 1`,
+		"# TYPE work_jobs_total counter",
+		`work_jobs_total{namespace="work",job_name="wat",outcome="succeeded"} 1`,
+		`work_jobs_total{namespace="work",job_name="wat",outcome="failed"} 1`,
+		"# TYPE work_job_duration_seconds histogram",
+		`work_job_duration_seconds_bucket{namespace="work",job_name="wat",le="0.5"} 2`,
+		`work_job_duration_seconds_bucket{namespace="work",job_name="wat",le="+Inf"} 2`,
+		`work_job_duration_seconds_count{namespace="work",job_name="wat"} 2`,
+		`work_job_duration_seconds_bucket{namespace="work",job_name="slow",le="0.5"} 0`,
+		`work_job_duration_seconds_bucket{namespace="work",job_name="slow",le="10"} 1`,
+		`work_job_duration_seconds_sum{namespace="work",job_name="slow"} 2`,
+	} {
+		assert.Contains(t, lines, expected)
+	}
+}
+
+func TestExporterRedisError(t *testing.T) {
+	pool := newTestPool("notworking:6379")
+	exporter := NewExporter("work", pool)
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	exporter.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+}
+
+func newTestPool(addr string) *redis.Pool {
+	return &redis.Pool{
+		MaxActive:   3,
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+		Wait: true,
+	}
+}
+
+func cleanKeyspace(namespace string, pool *redis.Pool) {
+	conn := pool.Get()
+	defer conn.Close()
+
+	keys, err := redis.Strings(conn.Do("KEYS", "{"+namespace+"}:*"))
+	if err != nil {
+		panic("could not get keys: " + err.Error())
+	}
+	for _, k := range keys {
+		if _, err := conn.Do("DEL", k); err != nil {
+			panic("could not del: " + err.Error())
+		}
+	}
+}
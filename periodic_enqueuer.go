@@ -19,6 +19,7 @@ type periodicEnqueuer struct {
 	pool                  *redis.Pool
 	periodicJobs          []*periodicJob
 	scheduledPeriodicJobs []*scheduledPeriodicJob
+	logger                Logger
 	stopChan              chan struct{}
 	doneStoppingChan      chan struct{}
 }
@@ -40,6 +41,7 @@ func newPeriodicEnqueuer(namespace string, pool *redis.Pool, periodicJobs []*per
 		namespace:        namespace,
 		pool:             pool,
 		periodicJobs:     periodicJobs,
+		logger:           withFields(defaultLogger, "namespace", namespace),
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 	}
@@ -62,7 +64,7 @@ func (pe *periodicEnqueuer) loop() {
 	if pe.shouldEnqueue() {
 		err := pe.enqueue()
 		if err != nil {
-			logError("periodic_enqueuer.loop.enqueue", err)
+			pe.logger.Error("periodic_enqueuer.loop.enqueue", err)
 		}
 	}
 
@@ -76,7 +78,7 @@ func (pe *periodicEnqueuer) loop() {
 			if pe.shouldEnqueue() {
 				err := pe.enqueue()
 				if err != nil {
-					logError("periodic_enqueuer.loop.enqueue", err)
+					pe.logger.Error("periodic_enqueuer.loop.enqueue", err)
 				}
 			}
 		}
@@ -130,7 +132,7 @@ func (pe *periodicEnqueuer) shouldEnqueue() bool {
 	if err == redis.ErrNil {
 		return true
 	} else if err != nil {
-		logError("periodic_enqueuer.should_enqueue", err)
+		pe.logger.Error("periodic_enqueuer.should_enqueue", err)
 		return true
 	}
 
@@ -13,23 +13,29 @@ type sampleItem struct {
 	priority uint
 
 	// payload:
-	redisJobs               string
-	redisJobsInProg         string
-	redisJobsPaused         string
-	redisJobsLock           string
-	redisJobsLockInfo       string
-	redisJobsMaxConcurrency string
+	redisJobs                string
+	redisJobsInProg          string
+	redisJobsPaused          string
+	redisJobsLock            string
+	redisJobsLockInfo        string
+	redisJobsMaxConcurrency  string
+	redisJobsMaxConcOverride string
+	redisJobsRateLimit       string
+	redisJobsRateWindow      string
 }
 
-func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency string) {
+func (s *prioritySampler) add(priority uint, redisJobs, redisJobsInProg, redisJobsPaused, redisJobsLock, redisJobsLockInfo, redisJobsMaxConcurrency, redisJobsMaxConcOverride, redisJobsRateLimit, redisJobsRateWindow string) {
 	sample := sampleItem{
-		priority:                priority,
-		redisJobs:               redisJobs,
-		redisJobsInProg:         redisJobsInProg,
-		redisJobsPaused:         redisJobsPaused,
-		redisJobsLock:           redisJobsLock,
-		redisJobsLockInfo:       redisJobsLockInfo,
-		redisJobsMaxConcurrency: redisJobsMaxConcurrency,
+		priority:                 priority,
+		redisJobs:                redisJobs,
+		redisJobsInProg:          redisJobsInProg,
+		redisJobsPaused:          redisJobsPaused,
+		redisJobsLock:            redisJobsLock,
+		redisJobsLockInfo:        redisJobsLockInfo,
+		redisJobsMaxConcurrency:  redisJobsMaxConcurrency,
+		redisJobsMaxConcOverride: redisJobsMaxConcOverride,
+		redisJobsRateLimit:       redisJobsRateLimit,
+		redisJobsRateWindow:      redisJobsRateWindow,
 	}
 	s.samples = append(s.samples, sample)
 	s.sum += priority
@@ -4,14 +4,32 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
+// redisNamespacePrefix returns the prefix of all of the keys of the namespace, eg, "{work}:" for "work". The namespace
+// is wrapped in a hash tag unless it has one already, so that the keys are all in the same Redis Cluster slot: see
+// Redis Cluster in the README.
 func redisNamespacePrefix(namespace string) string {
-	l := len(namespace)
-	if (l > 0) && (namespace[l-1] != ':') {
-		namespace = namespace + ":"
+	namespace = strings.TrimSuffix(namespace, ":")
+	if namespace == "" {
+		return ""
 	}
-	return namespace
+	if !hasHashTag(namespace) {
+		namespace = "{" + namespace + "}"
+	}
+	return namespace + ":"
+}
+
+// hasHashTag reports whether Redis Cluster only hashes part of key: a non-empty part between the first "{" and the
+// next "}".
+func hasHashTag(key string) bool {
+	start := strings.Index(key, "{")
+	if start < 0 {
+		return false
+	}
+	end := strings.Index(key[start+1:], "}")
+	return end > 0
 }
 
 func redisKeyKnownJobs(namespace string) string {
@@ -28,6 +46,19 @@ func redisKeyJobs(namespace, jobName string) string {
 	return redisKeyJobsPrefix(namespace) + jobName
 }
 
+// redisKeyJobsQueue returns the list of the jobName jobs on the named queue. The jobs of the default queue are on the
+// job's own list, "<namespace>:jobs:<jobName>". Keep in sync with jobQueue in redisLuaJobQueue.
+func redisKeyJobsQueue(namespace, jobName, queue string) string {
+	if queue == "" || queue == DefaultQueue {
+		return redisKeyJobs(namespace, jobName)
+	}
+	return redisKeyJobs(namespace, jobName) + ":queue:" + queue
+}
+
+func redisKeyKnownQueues(namespace string) string {
+	return redisNamespacePrefix(namespace) + "known_queues"
+}
+
 func redisKeyJobsInProgress(namespace, poolID, jobName string) string {
 	return fmt.Sprintf("%s:%s:inprogress", redisKeyJobs(namespace, jobName), poolID)
 }
@@ -72,6 +103,37 @@ func redisKeyJobsConcurrency(namespace, jobName string) string {
 	return redisKeyJobs(namespace, jobName) + ":max_concurrency"
 }
 
+// max concurrency set by Client.SetMaxConcurrency. Takes precedence over the configured one.
+func redisKeyJobsConcurrencyOverride(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":max_concurrency_override"
+}
+
+// hash with the configured "count" and "period" (in milliseconds) of the job's rate limit, and their "override_count"
+// and "override_period" counterparts set by Client.SetRateLimit
+func redisKeyJobsRateLimit(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":rate_limit"
+}
+
+// number of jobs started in the current rate limit window. Expires at the end of the window.
+func redisKeyJobsRateWindow(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":rate_window"
+}
+
+// hash of the concurrency keys (see JobOptions.ConcurrencyKeys) of the job's running jobs, to their count
+func redisKeyJobsKeyLocks(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":key_locks"
+}
+
+// hash of the concurrency keys held by a worker pool, to their count. Used to release them when the pool dies.
+func redisKeyJobsKeyLockInfo(namespace, jobName, workerPoolID string) string {
+	return redisKeyJobs(namespace, jobName) + ":key_lock_info:" + workerPoolID
+}
+
+// prefix of the lists of jobs waiting for a concurrency key to be released. Append the key to get the list.
+func redisKeyJobsKeyWaitingPrefix(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":key_waiting:"
+}
+
 func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (string, error) {
 	var buf bytes.Buffer
 
@@ -90,20 +152,111 @@ func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (
 	return buf.String(), nil
 }
 
+// returns "<namespace>:workflow:<workflowID>"
+// the workflow's keys all start with it
+func redisKeyWorkflow(namespace, workflowID string) string {
+	return redisNamespacePrefix(namespace) + "workflow:" + workflowID
+}
+
+// hash of job ID -> WorkflowJobState
+func redisKeyWorkflowStates(namespace, workflowID string) string {
+	return redisKeyWorkflow(namespace, workflowID) + ":states"
+}
+
+// hash of job ID -> serialized job, as it was added to the workflow
+func redisKeyWorkflowJobs(namespace, workflowID string) string {
+	return redisKeyWorkflow(namespace, workflowID) + ":jobs"
+}
+
+// hash of job ID -> number of its dependencies that haven't succeeded yet
+func redisKeyWorkflowWaiting(namespace, workflowID string) string {
+	return redisKeyWorkflow(namespace, workflowID) + ":waiting"
+}
+
+// hash of job ID -> JSON array of the IDs of the jobs depending on it
+func redisKeyWorkflowDependents(namespace, workflowID string) string {
+	return redisKeyWorkflow(namespace, workflowID) + ":dependents"
+}
+
+// zset of batch IDs, scored by creation time
+func redisKeyBatches(namespace string) string {
+	return redisNamespacePrefix(namespace) + "batches"
+}
+
+// hash of the batch's counters and callbacks
+func redisKeyBatch(namespace, batchID string) string {
+	return redisNamespacePrefix(namespace) + "batch:" + batchID
+}
+
+// set of the IDs of the batch's jobs that succeeded or died
+func redisKeyBatchFinished(namespace, batchID string) string {
+	return redisKeyBatch(namespace, batchID) + ":finished"
+}
+
+// set of the IDs of the batch's jobs that failed at least once
+func redisKeyBatchFailed(namespace, batchID string) string {
+	return redisKeyBatch(namespace, batchID) + ":failed"
+}
+
+// JSON of the JobResult of a job that succeeded or died, see JobOptions.ResultTTL
+func redisKeyJobResult(namespace, jobID string) string {
+	return redisNamespacePrefix(namespace) + "result:" + jobID
+}
+
 func redisKeyLastPeriodicEnqueue(namespace string) string {
 	return redisNamespacePrefix(namespace) + "last_periodic_enqueue"
 }
 
+// pub/sub channel on which the job names of newly queued jobs are published, see WorkerPoolOptions.BlockingFetch. An
+// empty message means jobs of any name may have been queued.
+func redisKeyWakeup(namespace string) string {
+	return redisNamespacePrefix(namespace) + "wakeup"
+}
+
+// held by the worker pool pruning the dead queue, so that the pools don't archive the same dead jobs
+func redisKeyDeadPrunerLock(namespace string) string {
+	return redisNamespacePrefix(namespace) + "dead_pruner_lock"
+}
+
+// redisLuaJobQueue defines jobQueue, which returns the list a job goes onto given the jobs prefix, eg, "{work}:jobs:",
+// and the decoded job: the list of its named queue if it has one, else its job's own list. payloadQueue does the same
+// for a job's JSON, falling back to the given list for payloads it can't decode. It's prepended to the scripts that
+// queue up jobs from their JSON. Keep in sync with redisKeyJobsQueue.
+const redisLuaJobQueue = `
+local function jobQueue(jobsPrefix, j)
+  if j['queue'] then
+    return jobsPrefix .. j['name'] .. ':queue:' .. j['queue']
+  end
+  return jobsPrefix .. j['name']
+end
+
+local function payloadQueue(jobsPrefix, payload, fallback)
+  local ok, j = pcall(cjson.decode, payload)
+  if ok and type(j) == 'table' and j['name'] then
+    return jobQueue(jobsPrefix, j)
+  end
+  return fallback
+end
+`
+
 // Used to fetch the next job to run
 //
-// KEYS[1] = the 1st job queue we want to try, eg, "work:jobs:emails"
-// KEYS[2] = the 1st job queue's in prog queue, eg, "work:jobs:emails:97c84119d13cb54119a38743:inprogress"
-// KEYS[3] = the 2nd job queue...
-// KEYS[4] = the 2nd job queue's in prog queue...
+// KEYS[1] = the 1st job queue we want to try, eg, "{work}:jobs:emails" or "{work}:jobs:emails:queue:critical"
+// KEYS[2] = the 1st job queue's in prog queue, eg, "{work}:jobs:emails:97c84119d13cb54119a38743:inprogress"
+// KEYS[3] = the 1st job queue's paused key
+// KEYS[4] = the 1st job queue's lock
+// KEYS[5] = the 1st job queue's lock info hash
+// KEYS[6] = the 1st job queue's max concurrency
+// KEYS[7] = the 1st job queue's max concurrency override
+// KEYS[8] = the 1st job queue's rate limit hash
+// KEYS[9] = the 1st job queue's rate limit window counter
+// KEYS[10] = the 2nd job queue...
 // ...
-// KEYS[N] = the last job queue...
-// KEYS[N+1] = the last job queue's in prog queue...
 // ARGV[1] = job queue's workerPoolID
+// ARGV[2] = max number of jobs to fetch (default 1). Each one is taken from the first queue, in the order of KEYS, that
+// has a job which can run.
+//
+// Returns nil if no job can run, else {job, job queue, in prog queue} for each fetched job, one after the other.
 var redisLuaFetchJob = fmt.Sprintf(`
 local function acquireLock(lockKey, lockInfoKey, workerPoolID)
   redis.call('incr', lockKey)
@@ -131,61 +284,133 @@ local function canRun(lockKey, maxConcurrency)
   end
 end
 
-local res, jobQueue, inProgQueue, pauseKey, lockKey, maxConcurrency, workerPoolID, concurrencyKey, lockInfoKey
+-- returns the count and period of the rate limit, or nil if there's none. An override takes precedence over the
+-- configured limit, and an override with a count of 0 lifts the limit.
+local function rateLimit(rateLimitKey)
+  local limit = redis.call('hmget', rateLimitKey, 'count', 'period', 'override_count', 'override_period')
+  local count, period = tonumber(limit[1]), tonumber(limit[2])
+  if limit[3] then
+    count, period = tonumber(limit[3]), tonumber(limit[4])
+  end
+  if not count or count == 0 or not period or period == 0 then
+    return nil
+  end
+  return count, period
+end
+
+local function withinRateLimit(rateWindowKey, count)
+  local started = tonumber(redis.call('get', rateWindowKey))
+  return not started or started < count
+end
+
+local function takeRateLimit(rateWindowKey, period)
+  redis.call('incr', rateWindowKey)
+  if redis.call('pttl', rateWindowKey) < 0 then
+    -- first job of the window
+    redis.call('pexpire', rateWindowKey, period)
+  end
+end
+
+local res, jobQueue, inProgQueue, pauseKey, lockKey, maxConcurrency, workerPoolID, concurrencyKey, concurrencyOverrideKey, lockInfoKey, rateLimitKey, rateWindowKey, rateCount, ratePeriod, found
 local keylen = #KEYS
+local fetched = {}
 workerPoolID = ARGV[1]
+local maxJobs = tonumber(ARGV[2]) or 1
 
-for i=1,keylen,%d do
-  jobQueue = KEYS[i]
-  inProgQueue = KEYS[i+1]
-  pauseKey = KEYS[i+2]
-  lockKey = KEYS[i+3]
-  lockInfoKey = KEYS[i+4]
-  concurrencyKey = KEYS[i+5]
-
-  maxConcurrency = tonumber(redis.call('get', concurrencyKey))
-
-  if haveJobs(jobQueue) and not isPaused(pauseKey) and canRun(lockKey, maxConcurrency) then
-    acquireLock(lockKey, lockInfoKey, workerPoolID)
-    res = redis.call('rpoplpush', jobQueue, inProgQueue)
-    return {res, jobQueue, inProgQueue}
+while #fetched < maxJobs * 3 do
+  found = false
+  for i=1,keylen,%d do
+    jobQueue = KEYS[i]
+    inProgQueue = KEYS[i+1]
+    pauseKey = KEYS[i+2]
+    lockKey = KEYS[i+3]
+    lockInfoKey = KEYS[i+4]
+    concurrencyKey = KEYS[i+5]
+    concurrencyOverrideKey = KEYS[i+6]
+    rateLimitKey = KEYS[i+7]
+    rateWindowKey = KEYS[i+8]
+
+    -- an override takes precedence over the configured max concurrency
+    maxConcurrency = tonumber(redis.call('get', concurrencyOverrideKey)) or tonumber(redis.call('get', concurrencyKey))
+    rateCount, ratePeriod = rateLimit(rateLimitKey)
+
+    if haveJobs(jobQueue) and not isPaused(pauseKey) and canRun(lockKey, maxConcurrency) and (not rateCount or withinRateLimit(rateWindowKey, rateCount)) then
+      acquireLock(lockKey, lockInfoKey, workerPoolID)
+      if rateCount then
+        takeRateLimit(rateWindowKey, ratePeriod)
+      end
+      res = redis.call('rpoplpush', jobQueue, inProgQueue)
+      table.insert(fetched, res)
+      table.insert(fetched, jobQueue)
+      table.insert(fetched, inProgQueue)
+      found = true
+      break
+    end
+  end
+  if not found then
+    break
   end
 end
-return nil`, fetchKeysPerJobType)
 
-// Used by the reaper to re-enqueue jobs that were in progress
+if #fetched == 0 then
+  return nil
+end
+return fetched`, fetchKeysPerJobType)
+
+// Used by the reaper to re-enqueue jobs that were in progress. Each job goes back to the queue it was enqueued on.
 //
 // KEYS[1] = the 1st job's in progress queue
 // KEYS[2] = the 1st job's job queue
-// KEYS[3] = the 2nd job's in progress queue
-// KEYS[4] = the 2nd job's job queue
+// KEYS[3] = the 1st job's lock
+// KEYS[4] = the 1st job's lock info hash
+// KEYS[5] = the 2nd job's in progress queue
 // ...
-// KEYS[N] = the last job's in progress queue
-// KEYS[N+1] = the last job's job queue
 // ARGV[1] = workerPoolID for job queue
-var redisLuaReenqueueJob = fmt.Sprintf(`
+// ARGV[2] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+var redisLuaReenqueueJob = redisLuaJobQueue + fmt.Sprintf(`
 local function releaseLock(lockKey, lockInfoKey, workerPoolID)
   redis.call('decr', lockKey)
   redis.call('hincrby', lockInfoKey, workerPoolID, -1)
 end
 
 local keylen = #KEYS
-local res, jobQueue, inProgQueue, workerPoolID, lockKey, lockInfoKey
+local res, queue, inProgQueue, workerPoolID, lockKey, lockInfoKey
 workerPoolID = ARGV[1]
 
 for i=1,keylen,%d do
   inProgQueue = KEYS[i]
-  jobQueue = KEYS[i+1]
   lockKey = KEYS[i+2]
   lockInfoKey = KEYS[i+3]
-  res = redis.call('rpoplpush', inProgQueue, jobQueue)
+  res = redis.call('rpop', inProgQueue)
   if res then
+    queue = payloadQueue(ARGV[2], res, KEYS[i+1])
+    redis.call('lpush', queue, res)
     releaseLock(lockKey, lockInfoKey, workerPoolID)
-    return {res, inProgQueue, jobQueue}
+    return {res, inProgQueue, queue}
   end
 end
 return nil`, requeueKeysPerJob)
 
+// Used by a worker pool's fetcher to give back the jobs it prefetched but no worker started. They go back to the
+// front of their job queue.
+//
+// KEYS[1] = the job's in progress queue
+// KEYS[2] = the job's job queue
+// KEYS[3] = the job's lock
+// KEYS[4] = the job's lock info hash
+// ARGV[1] = job
+// ARGV[2] = workerPoolID
+var redisLuaRequeuePrefetchedJob = `
+if redis.call('lrem', KEYS[1], 1, ARGV[1]) == 0 then
+  -- already requeued, eg by StopWithTimeout
+  return 0
+end
+redis.call('rpush', KEYS[2], ARGV[1])
+redis.call('decr', KEYS[3])
+redis.call('hincrby', KEYS[4], ARGV[2], -1)
+return 1
+`
+
 // Used by the reaper to clean up stale locks
 //
 // KEYS[1] = the 1st job's lock
@@ -218,12 +443,99 @@ end
 return nil
 `
 
+// Used by workers to take a concurrency key for a job they fetched. If the key is taken by too many jobs already, the
+// job is parked on the key's waiting list instead, and the job type lock and the rate limit token taken by the fetch
+// are given back.
+//
+// KEYS[1] = the job's key locks hash
+// KEYS[2] = the job's key lock info hash for this worker pool
+// KEYS[3] = the waiting list of the concurrency key
+// KEYS[4] = the in progress queue the job was fetched into
+// KEYS[5] = the job's lock
+// KEYS[6] = the job's lock info hash
+// KEYS[7] = the job's rate limit window counter
+// ARGV[1] = the concurrency key
+// ARGV[2] = the max number of running jobs per concurrency key
+// ARGV[3] = the job
+// ARGV[4] = the worker pool id
+var redisLuaAcquireKeyLock = `
+local held = tonumber(redis.call('hget', KEYS[1], ARGV[1])) or 0
+if held < tonumber(ARGV[2]) then
+  redis.call('hincrby', KEYS[1], ARGV[1], 1)
+  redis.call('hincrby', KEYS[2], ARGV[1], 1)
+  return 1
+end
+
+redis.call('lrem', KEYS[4], 1, ARGV[3])
+redis.call('lpush', KEYS[3], ARGV[3])
+redis.call('decr', KEYS[5])
+redis.call('hincrby', KEYS[6], ARGV[4], -1)
+-- only there while a rate limited job type's window is open
+if redis.call('exists', KEYS[7]) == 1 then
+  redis.call('decr', KEYS[7])
+end
+return 0
+`
+
+// Used by workers to release the concurrency key of a job that ran. The oldest job waiting for the key, if any, is put
+// back at the front of its job queue.
+//
+// KEYS[1] = the job's key locks hash
+// KEYS[2] = the job's key lock info hash for this worker pool
+// KEYS[3] = the waiting list of the concurrency key
+// KEYS[4] = the job queue
+// ARGV[1] = the concurrency key
+// ARGV[2] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+var redisLuaReleaseKeyLock = redisLuaJobQueue + `
+if redis.call('hincrby', KEYS[1], ARGV[1], -1) <= 0 then
+  redis.call('hdel', KEYS[1], ARGV[1])
+end
+if redis.call('hincrby', KEYS[2], ARGV[1], -1) <= 0 then
+  redis.call('hdel', KEYS[2], ARGV[1])
+end
+
+local res = redis.call('rpop', KEYS[3])
+if res then
+  redis.call('rpush', payloadQueue(ARGV[2], res, KEYS[4]), res)
+end
+return nil
+`
+
+// Used by the reaper to release the concurrency keys held by a dead worker pool. For every released key, one waiting
+// job is put back at the front of its job queue.
+//
+// KEYS[1] = the job's key locks hash
+// KEYS[2] = the job's key lock info hash for the dead worker pool
+// KEYS[3] = the job queue
+// ARGV[1] = the prefix of the job's waiting lists
+// ARGV[2] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+var redisLuaReapStaleKeyLocks = redisLuaJobQueue + `
+local info = redis.call('hgetall', KEYS[2])
+local key, count, res
+for i=1,#info,2 do
+  key = info[i]
+  count = tonumber(info[i+1])
+  if redis.call('hincrby', KEYS[1], key, -count) <= 0 then
+    redis.call('hdel', KEYS[1], key)
+  end
+  for j=1,count do
+    res = redis.call('rpop', ARGV[1] .. key)
+    if not res then
+      break
+    end
+    redis.call('rpush', payloadQueue(ARGV[2], res, KEYS[3]), res)
+  end
+end
+redis.call('del', KEYS[2])
+return nil
+`
+
 // KEYS[1] = zset of jobs (retry or scheduled), eg work:retry
 // KEYS[2] = zset of dead, eg work:dead. If we don't know the jobName of a job, we'll put it in dead.
-// KEYS[3...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
-// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// KEYS[3...] = known job queues, eg ["{work}:jobs:create_watch", "{work}:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
-var redisLuaZremLpushCmd = `
+var redisLuaZremLpushCmd = redisLuaJobQueue + `
 local res, j, queue
 res = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, 1)
 if #res > 0 then
@@ -233,7 +545,7 @@ if #res > 0 then
   for _,v in pairs(KEYS) do
     if v == queue then
       j['t'] = tonumber(ARGV[2])
-      redis.call('lpush', queue, cjson.encode(j))
+      redis.call('lpush', jobQueue(ARGV[1], j), cjson.encode(j))
       return 'ok'
     end
   end
@@ -269,13 +581,13 @@ return {deletedCount, jobBytes}
 `
 
 // KEYS[1] = zset of dead jobs, eg, work:dead
-// KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
-// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// KEYS[2...] = known job queues, eg ["{work}:jobs:create_watch", "{work}:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
 // ARGV[3] = died at. The z rank of the job.
 // ARGV[4] = job ID to requeue
 // Returns: number of jobs requeued (typically 1 or 0)
-var redisLuaRequeueSingleDeadCmd = `
+var redisLuaRequeueSingleDeadCmd = redisLuaJobQueue + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
 local jobCount = #jobs
@@ -292,7 +604,7 @@ for i=1,jobCount do
         j['fails'] = nil
         j['failed_at'] = nil
         j['err'] = nil
-        redis.call('lpush', queue, cjson.encode(j))
+        redis.call('lpush', jobQueue(ARGV[1], j), cjson.encode(j))
         requeuedCount = requeuedCount + 1
         found = true
         break
@@ -309,12 +621,12 @@ return requeuedCount
 `
 
 // KEYS[1] = zset of dead jobs, eg work:dead
-// KEYS[2...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
-// ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// KEYS[2...] = known job queues, eg ["{work}:jobs:create_watch", "{work}:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
 // ARGV[3] = max number of jobs to requeue
 // Returns: number of jobs requeued
-var redisLuaRequeueAllDeadCmd = `
+var redisLuaRequeueAllDeadCmd = redisLuaJobQueue + `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, ARGV[3])
 local jobCount = #jobs
@@ -330,7 +642,7 @@ for i=1,jobCount do
       j['fails'] = nil
       j['failed_at'] = nil
       j['err'] = nil
-      redis.call('lpush', queue, cjson.encode(j))
+      redis.call('lpush', jobQueue(ARGV[1], j), cjson.encode(j))
       requeuedCount = requeuedCount + 1
       found = true
       break
@@ -349,9 +661,14 @@ return requeuedCount
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
 // ARGV[1] = job
 // ARGV[2] = updated job or just a 1 if arguments don't update
+// ARGV[3] = wakeup channel, eg work:wakeup. Optional: without it, no wakeup is published.
+// ARGV[4] = job name
 var redisLuaEnqueueUnique = `
 if redis.call('set', KEYS[2], ARGV[2], 'NX', 'EX', '86400') then
   redis.call('lpush', KEYS[1], ARGV[1])
+  if ARGV[3] then
+    redis.call('publish', ARGV[3], ARGV[4])
+  end
   return 'ok'
 else
   redis.call('set', KEYS[2], ARGV[2], 'EX', '86400')
@@ -373,3 +690,198 @@ else
 end
 return 'dup'
 `
+
+// Used by workers when a job belonging to a workflow succeeded or died. Runs inside the worker's MULTI. A job is only
+// counted once, even if it finishes again (eg, after being requeued by the reaper).
+//
+// KEYS[1] = workflow states hash
+// KEYS[2] = workflow jobs hash
+// KEYS[3] = workflow waiting hash
+// KEYS[4] = workflow dependents hash
+// KEYS[5] = zset of dead jobs, eg work:dead
+// ARGV[1] = job ID
+// ARGV[2] = new state of the job: 'done' or 'dead'
+// ARGV[3] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[4] = current time in epoch seconds
+// ARGV[5] = seconds to keep the workflow around for once none of its jobs are pending or queued anymore
+var redisLuaWorkflowJobFinished = redisLuaJobQueue + `
+local function dependents(jobID)
+  local d = redis.call('hget', KEYS[4], jobID)
+  if d then
+    return cjson.decode(d)
+  end
+  return {}
+end
+
+local jobID, state, now = ARGV[1], ARGV[2], tonumber(ARGV[4])
+local j, raw
+local prev = redis.call('hget', KEYS[1], jobID)
+if prev == 'done' or prev == 'dead' or prev == 'cancelled' then
+  return 'dup'
+end
+redis.call('hset', KEYS[1], jobID, state)
+
+if state == 'done' then
+  for _,childID in ipairs(dependents(jobID)) do
+    if redis.call('hget', KEYS[1], childID) == 'pending' and redis.call('hincrby', KEYS[3], childID, -1) <= 0 then
+      raw = redis.call('hget', KEYS[2], childID)
+      j = cjson.decode(raw)
+      j['t'] = now
+      redis.call('lpush', jobQueue(ARGV[3], j), cjson.encode(j))
+      redis.call('hset', KEYS[1], childID, 'queued')
+    end
+  end
+else
+  -- cancel everything downstream of the dead job
+  local toCancel = dependents(jobID)
+  while #toCancel > 0 do
+    local childID = table.remove(toCancel)
+    if redis.call('hget', KEYS[1], childID) == 'pending' then
+      j = cjson.decode(redis.call('hget', KEYS[2], childID))
+      j['err'] = 'workflow dependency ' .. jobID .. ' died'
+      j['failed_at'] = now
+      redis.call('zadd', KEYS[5], now, cjson.encode(j))
+      redis.call('hset', KEYS[1], childID, 'cancelled')
+      for _,grandchildID in ipairs(dependents(childID)) do
+        table.insert(toCancel, grandchildID)
+      end
+    end
+  end
+end
+
+for _,s in ipairs(redis.call('hvals', KEYS[1])) do
+  if s == 'pending' or s == 'queued' then
+    return 'ok'
+  end
+end
+for i=1,4 do
+  redis.call('expire', KEYS[i], ARGV[5])
+end
+return 'ok'
+`
+
+// Used by workers to count a batch's job once it ran. Runs inside the worker's MULTI. Jobs are only counted once, even
+// if they're run again (eg, after being requeued by the reaper).
+//
+// KEYS[1] = batch hash
+// KEYS[2] = batch finished set
+// KEYS[3] = batch failed set
+// ARGV[1] = job ID
+// ARGV[2] = what became of the job: 'succeeded', 'failed' (it'll be retried) or 'dead'
+// ARGV[3] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[4] = current time in epoch seconds
+// ARGV[5] = seconds to keep the batch around for once all of its jobs finished
+var redisLuaBatchJobDone = redisLuaJobQueue + `
+local jobID, outcome, now = ARGV[1], ARGV[2], tonumber(ARGV[4])
+
+local function enqueueCallback(field)
+  local raw = redis.call('hget', KEYS[1], field)
+  if raw then
+    local j = cjson.decode(raw)
+    j['t'] = now
+    redis.call('lpush', jobQueue(ARGV[3], j), cjson.encode(j))
+  end
+end
+
+if redis.call('exists', KEYS[1]) == 0 then
+  return nil
+end
+
+if outcome ~= 'succeeded' and redis.call('sadd', KEYS[3], jobID) == 1 then
+  redis.call('hincrby', KEYS[1], 'failed', 1)
+end
+if outcome == 'failed' or redis.call('sadd', KEYS[2], jobID) == 0 then
+  return 'ok'
+end
+
+redis.call('hincrby', KEYS[1], outcome, 1)
+if redis.call('hincrby', KEYS[1], 'pending', -1) > 0 then
+  return 'ok'
+end
+
+if tonumber(redis.call('hget', KEYS[1], 'dead')) == 0 then
+  enqueueCallback('on_success')
+end
+enqueueCallback('on_complete')
+redis.call('hset', KEYS[1], 'completed_at', now)
+for i=1,3 do
+  redis.call('expire', KEYS[i], ARGV[5])
+end
+return 'ok'
+`
+
+// Used by Client.FindJob to look for a job in a list. Returns the first job with the ID, or nil. The list is read in
+// chunks, and only the jobs whose JSON contains the ID are decoded.
+//
+// KEYS[1] = list of jobs, eg, "{work}:jobs:send_email"
+// ARGV[1] = job ID
+// ARGV[2] = what the JSON of the job contains, eg, '"id":"abcd"'
+// ARGV[3] = chunk size
+var redisLuaFindJobInList = `
+local jobID, needle, chunk = ARGV[1], ARGV[2], tonumber(ARGV[3])
+local start = 0
+repeat
+  local jobs = redis.call('lrange', KEYS[1], start, start + chunk - 1)
+  for _, raw in ipairs(jobs) do
+    if string.find(raw, needle, 1, true) and cjson.decode(raw)['id'] == jobID then
+      return raw
+    end
+  end
+  start = start + chunk
+until #jobs < chunk
+return nil
+`
+
+// KEYS[1] = zset of dead jobs, eg work:dead
+// KEYS[2...] = known job queues, eg ["{work}:jobs:create_watch", "{work}:jobs:send_email", ...]
+// ARGV[1] = jobs prefix, eg, "{work}:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
+// ARGV[2] = current time in epoch seconds
+// ARGV[3...] = dead jobs to requeue, as they are in the zset. Jobs no longer in it are skipped.
+// Returns: number of jobs requeued
+var redisLuaRequeueDeadJobsCmd = redisLuaJobQueue + `
+local i, j, queue, found, requeuedCount
+requeuedCount = 0
+for i=3,#ARGV do
+  if redis.call('zrem', KEYS[1], ARGV[i]) == 1 then
+    j = cjson.decode(ARGV[i])
+    queue = ARGV[1] .. j['name']
+    found = false
+    for _,v in pairs(KEYS) do
+      if v == queue then
+        j['t'] = tonumber(ARGV[2])
+        j['fails'] = nil
+        j['failed_at'] = nil
+        j['err'] = nil
+        redis.call('lpush', jobQueue(ARGV[1], j), cjson.encode(j))
+        requeuedCount = requeuedCount + 1
+        found = true
+        break
+      end
+    end
+    if not found then
+      j['err'] = 'unknown job when requeueing'
+      j['failed_at'] = tonumber(ARGV[2])
+      redis.call('zadd', KEYS[1], ARGV[2] + 5, cjson.encode(j))
+    end
+  end
+end
+return requeuedCount
+`
+
+// KEYS[1] = zset of jobs, eg work:dead
+// ARGV[1...] = pairs of a job, as it is in the zset, and its unique key, or "" if it isn't a unique job. Jobs no longer
+// in the zset are skipped.
+// Returns: number of jobs deleted
+var redisLuaDeleteJobsCmd = `
+local i, deletedCount
+deletedCount = 0
+for i=1,#ARGV,2 do
+  if redis.call('zrem', KEYS[1], ARGV[i]) == 1 then
+    deletedCount = deletedCount + 1
+    if ARGV[i+1] ~= '' then
+      redis.call('del', ARGV[i+1])
+    end
+  end
+end
+return deletedCount
+`
@@ -0,0 +1,24 @@
+package work
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedisNamespacePrefix(t *testing.T) {
+	for namespace, prefix := range map[string]string{
+		"":            "",
+		"work":        "{work}:",
+		"work:":       "{work}:",
+		"{work}":      "{work}:",
+		"app:{work}":  "app:{work}:",
+		"{}work":      "{{}work}:",
+		"app}{work":   "{app}{work}:",
+		"app{work}ns": "app{work}ns:",
+	} {
+		assert.Equal(t, prefix, redisNamespacePrefix(namespace), namespace)
+	}
+
+	assert.Equal(t, "{work}:jobs:wat", redisKeyJobs("work", "wat"))
+}
@@ -2,15 +2,21 @@ package work
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
 )
 
 type requeuer struct {
-	namespace string
-	pool      *redis.Pool
-
+	namespace  string
+	pool       *redis.Pool
+	requeueKey string
+	logger     Logger
+	wakeup     bool // publish a wakeup once jobs were requeued, set with WorkerPoolOptions.BlockingFetch
+
+	// mtx guards the script and its args, which change when job types are added or removed
+	mtx                sync.Mutex
 	redisRequeueScript *redis.Script
 	redisRequeueArgs   []interface{}
 
@@ -22,21 +28,11 @@ type requeuer struct {
 }
 
 func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames []string) *requeuer {
-	args := make([]interface{}, 0, len(jobNames)+2+2)
-	args = append(args, requeueKey)              // KEY[1]
-	args = append(args, redisKeyDead(namespace)) // KEY[2]
-	for _, jobName := range jobNames {
-		args = append(args, redisKeyJobs(namespace, jobName)) // KEY[3, 4, ...]
-	}
-	args = append(args, redisKeyJobsPrefix(namespace)) // ARGV[1]
-	args = append(args, 0)                             // ARGV[2] -- NOTE: We're going to change this one on every call
-
-	return &requeuer{
-		namespace: namespace,
-		pool:      pool,
-
-		redisRequeueScript: redis.NewScript(len(jobNames)+2, redisLuaZremLpushCmd),
-		redisRequeueArgs:   args,
+	r := &requeuer{
+		namespace:  namespace,
+		pool:       pool,
+		requeueKey: requeueKey,
+		logger:     withFields(defaultLogger, "namespace", namespace),
 
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
@@ -44,6 +40,26 @@ func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames
 		drainChan:        make(chan struct{}),
 		doneDrainingChan: make(chan struct{}),
 	}
+	r.updateJobNames(jobNames)
+
+	return r
+}
+
+// updateJobNames sets the jobs whose queues the requeuer moves due jobs to. It can be called while the requeuer is started.
+func (r *requeuer) updateJobNames(jobNames []string) {
+	args := make([]interface{}, 0, len(jobNames)+2+2)
+	args = append(args, r.requeueKey)              // KEY[1]
+	args = append(args, redisKeyDead(r.namespace)) // KEY[2]
+	for _, jobName := range jobNames {
+		args = append(args, redisKeyJobs(r.namespace, jobName)) // KEY[3, 4, ...]
+	}
+	args = append(args, redisKeyJobsPrefix(r.namespace)) // ARGV[1]
+	args = append(args, 0)                               // ARGV[2] -- NOTE: We're going to change this one on every call
+
+	r.mtx.Lock()
+	r.redisRequeueScript = redis.NewScript(len(jobNames)+2, redisLuaZremLpushCmd)
+	r.redisRequeueArgs = args
+	r.mtx.Unlock()
 }
 
 func (r *requeuer) start() {
@@ -73,34 +89,51 @@ func (r *requeuer) loop() {
 			r.doneStoppingChan <- struct{}{}
 			return
 		case <-r.drainChan:
-			for r.process() {
-			}
+			r.requeueAll()
 			r.doneDrainingChan <- struct{}{}
 		case <-ticker:
-			for r.process() {
-			}
+			r.requeueAll()
 		}
 	}
 }
 
+// requeueAll moves all the due jobs onto their queues, then wakes the worker pools up if there were any.
+func (r *requeuer) requeueAll() {
+	requeued := false
+	for r.process() {
+		requeued = true
+	}
+	if !requeued || !r.wakeup {
+		return
+	}
+
+	conn := r.pool.Get()
+	defer conn.Close()
+
+	if err := publishWakeup(conn, r.wakeup, r.namespace, ""); err != nil {
+		r.logger.Error("requeuer.publish", err)
+	}
+}
+
 func (r *requeuer) process() bool {
 	conn := r.pool.Get()
 	defer conn.Close()
 
+	r.mtx.Lock()
 	r.redisRequeueArgs[len(r.redisRequeueArgs)-1] = nowEpochSeconds()
-
 	res, err := redis.String(r.redisRequeueScript.Do(conn, r.redisRequeueArgs...))
+	r.mtx.Unlock()
 	if err == redis.ErrNil {
 		return false
 	} else if err != nil {
-		logError("requeuer.process", err)
+		r.logger.Error("requeuer.process", err)
 		return false
 	}
 
 	if res == "" {
 		return false
 	} else if res == "dead" {
-		logError("requeuer.process.dead", fmt.Errorf("no job name"))
+		r.logger.Error("requeuer.process.dead", fmt.Errorf("no job name"))
 		return true
 	} else if res == "ok" {
 		return true
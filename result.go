@@ -0,0 +1,112 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ErrResultNotFound is returned by Client.JobResult when the job didn't succeed or die yet, its result expired, or its
+// job type doesn't keep results, see JobOptions.ResultTTL.
+var ErrResultNotFound = fmt.Errorf("result not found")
+
+// resultPollIntervals are the waits between two reads of a job result in WaitForResult. The last one is repeated.
+var resultPollIntervals = []time.Duration{10 * time.Millisecond, 25 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond, 250 * time.Millisecond}
+
+// JobResult is what became of a job that succeeded or died. It's kept for the JobOptions.ResultTTL of its job type.
+type JobResult struct {
+	JobID      string          `json:"id"`
+	JobName    string          `json:"name"`
+	Succeeded  bool            `json:"succeeded"`        // false if the job died
+	Result     json.RawMessage `json:"result,omitempty"` // JSON of what the handler passed to Job.SetResult, if anything
+	Err        string          `json:"err,omitempty"`    // the error the job died with
+	FinishedAt int64           `json:"finished_at"`
+}
+
+// Decode unmarshals the result the handler set into v.
+func (r *JobResult) Decode(v interface{}) error {
+	if len(r.Result) == 0 {
+		return fmt.Errorf("job %s has no result", r.JobID)
+	}
+	return json.Unmarshal(r.Result, v)
+}
+
+// SetResult sets the result of the job to the JSON encoding of v. It's kept once the job succeeded or died if its job
+// type has a JobOptions.ResultTTL, and can be read with Client.JobResult. Calling it again replaces the result.
+func (j *Job) SetResult(v interface{}) error {
+	result, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	j.result = result
+	return nil
+}
+
+// JobResult returns the result of the job with the specified ID, or ErrResultNotFound.
+func (c *Client) JobResult(jobID string) (*JobResult, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	rawJSON, err := redis.Bytes(conn.Do("GET", redisKeyJobResult(c.namespace, jobID)))
+	if err == redis.ErrNil {
+		return nil, ErrResultNotFound
+	} else if err != nil {
+		c.logger.Error("client.job_result.get", err, "job_id", jobID)
+		return nil, err
+	}
+
+	var result JobResult
+	if err := json.Unmarshal(rawJSON, &result); err != nil {
+		c.logger.Error("client.job_result.unmarshal", err, "job_id", jobID)
+		return nil, err
+	}
+	return &result, nil
+}
+
+// WaitForResult waits for the job with the specified ID to succeed or die, and returns its result. It polls Redis,
+// and gives up with ctx.Err() once ctx is done, so give it a deadline: it never returns if the job's type doesn't keep
+// results.
+func (c *Client) WaitForResult(ctx context.Context, jobID string) (*JobResult, error) {
+	for i := 0; ; i++ {
+		result, err := c.JobResult(jobID)
+		if err != ErrResultNotFound {
+			return result, err
+		}
+
+		wait := resultPollIntervals[len(resultPollIntervals)-1]
+		if i < len(resultPollIntervals) {
+			wait = resultPollIntervals[i]
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// storeJobResult queues the write of the result of job on conn, once it succeeded or died.
+func (w *worker) storeJobResult(conn redis.Conn, job *Job, outcome jobOutcome, ttl time.Duration) {
+	result := JobResult{
+		JobID:      job.ID,
+		JobName:    job.Name,
+		Succeeded:  outcome == jobSucceeded,
+		Result:     job.result,
+		FinishedAt: nowEpochSeconds(),
+	}
+	if !result.Succeeded {
+		result.Err = job.LastErr
+	}
+
+	rawJSON, err := json.Marshal(result)
+	if err != nil {
+		w.logger.Error("worker.store_job_result.marshal", err, jobFields(job)...)
+		return
+	}
+	conn.Send("SET", redisKeyJobResult(w.namespace, job.ID), rawJSON, "PX", ttl.Milliseconds())
+}
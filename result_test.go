@@ -0,0 +1,84 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobResult(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("add", JobOptions{ResultTTL: time.Minute, Timeout: time.Second}, func(job *Job) error {
+		return job.SetResult(job.ArgInt64("a") + job.ArgInt64("b"))
+	})
+	wp.JobWithOptions("fail", JobOptions{ResultTTL: time.Minute, MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("ohno")
+	})
+	wp.Job("forget", func(job *Job) error {
+		return job.SetResult("lost")
+	})
+	// Redis can't keep a result for less than a millisecond
+	assert.PanicsWithValue(t, "work: JobOptions.ResultTTL must be at least a millisecond", func() {
+		wp.JobWithOptions("fleeting", JobOptions{ResultTTL: time.Microsecond}, func(job *Job) error { return nil })
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	add, err := enqueuer.Enqueue("add", Q{"a": 1, "b": 2})
+	assert.NoError(t, err)
+	fail, err := enqueuer.Enqueue("fail", nil)
+	assert.NoError(t, err)
+	forget, err := enqueuer.Enqueue("forget", nil)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	_, err = client.JobResult(add.ID)
+	assert.Equal(t, ErrResultNotFound, err)
+
+	wp.Start()
+	defer wp.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.WaitForResult(ctx, add.ID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, add.ID, result.JobID)
+		assert.Equal(t, "add", result.JobName)
+		assert.True(t, result.Succeeded)
+		assert.Equal(t, "", result.Err)
+		var sum int64
+		assert.NoError(t, result.Decode(&sum))
+		assert.EqualValues(t, 3, sum)
+	}
+
+	result, err = client.WaitForResult(ctx, fail.ID)
+	if assert.NoError(t, err) {
+		assert.False(t, result.Succeeded)
+		assert.Equal(t, "ohno", result.Err)
+		assert.Error(t, result.Decode(new(string)))
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+	ttl, err := redis.Int64(conn.Do("PTTL", redisKeyJobResult(ns, add.ID)))
+	assert.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= time.Minute.Milliseconds())
+
+	// Jobs without a ResultTTL don't keep their result
+	wp.Drain()
+	_, err = client.JobResult(forget.ID)
+	assert.Equal(t, ErrResultNotFound, err)
+
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer shortCancel()
+	_, err = client.WaitForResult(shortCtx, forget.ID)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
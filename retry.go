@@ -0,0 +1,121 @@
+package work
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy says whether and when a failed job is retried, see JobOptions.RetryPolicy. Jobs are retried at most
+// until they failed JobOptions.MaxFails times. Build one with ExponentialRetry, LinearRetry, FixedRetry or NoRetry.
+// The zero RetryPolicy retries all errors but permanent ones, with JobOptions.Backoff.
+type RetryPolicy struct {
+	// Delay returns how long to wait before running a job that failed job.Fails times again. Retries are scheduled to
+	// the second, so it's rounded up to whole seconds. If nil, JobOptions.Backoff is used.
+	Delay func(job *Job) time.Duration
+
+	// ShouldRetry returns whether a job that failed with err may be retried. If nil, all errors may be. Errors made
+	// with Permanent are never retried.
+	ShouldRetry func(err error) bool
+}
+
+// ExponentialRetry retries after a random delay between 0 and base * 2^(fails-1), capped at max, which is known as
+// full jitter: it spreads out the retries of jobs that failed together. A max of 0 means no cap.
+func ExponentialRetry(base, max time.Duration) RetryPolicy {
+	if max <= 0 {
+		max = math.MaxInt64
+	}
+	return RetryPolicy{Delay: func(job *Job) time.Duration {
+		fails := job.Fails - 1
+		if fails < 0 {
+			fails = 0
+		}
+		delay := max
+		if fails < 63 && base <= max>>uint(fails) {
+			delay = base << uint(fails)
+		}
+		if delay <= 0 {
+			return 0
+		}
+		return time.Duration(rand.Int63n(int64(delay)))
+	}}
+}
+
+// LinearRetry retries after step * fails, capped at max. A max of 0 means no cap.
+func LinearRetry(step, max time.Duration) RetryPolicy {
+	return RetryPolicy{Delay: func(job *Job) time.Duration {
+		delay := step * time.Duration(job.Fails)
+		if max > 0 && delay > max {
+			delay = max
+		}
+		return delay
+	}}
+}
+
+// FixedRetry retries after the delays in order: the first retry after delays[0], the second after delays[1] and so
+// on. The last delay is repeated if the job fails more times than there are delays.
+func FixedRetry(delays ...time.Duration) RetryPolicy {
+	if len(delays) == 0 {
+		panic("work: FixedRetry needs at least one delay")
+	}
+	return RetryPolicy{Delay: func(job *Job) time.Duration {
+		i := job.Fails - 1
+		if i >= int64(len(delays)) {
+			i = int64(len(delays)) - 1
+		}
+		if i < 0 {
+			i = 0
+		}
+		return delays[i]
+	}}
+}
+
+// NoRetry never retries: failed jobs go to the dead queue right away, unless JobOptions.SkipDead is set.
+func NoRetry() RetryPolicy {
+	return RetryPolicy{ShouldRetry: func(err error) bool {
+		return false
+	}}
+}
+
+// RetryOn returns a copy of the policy that only retries the errors shouldRetry returns true for.
+func (p RetryPolicy) RetryOn(shouldRetry func(err error) bool) RetryPolicy {
+	p.ShouldRetry = shouldRetry
+	return p
+}
+
+// permanentError is an error that is never retried, see Permanent.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as permanent: a job that fails with it, even wrapped, goes to the dead queue right away instead
+// of being retried, whatever its RetryPolicy. Permanent(nil) is nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent returns whether err, or an error it wraps, was made with Permanent.
+func IsPermanent(err error) bool {
+	var permanentErr *permanentError
+	return errors.As(err, &permanentErr)
+}
+
+// shouldRetry returns whether a job of this type that failed with err may be retried, if it has fails remaining.
+func (jt *jobType) shouldRetry(err error) bool {
+	if IsPermanent(err) || isArgsDecodeError(err) {
+		return false
+	}
+	return jt.RetryPolicy.ShouldRetry == nil || jt.RetryPolicy.ShouldRetry(err)
+}
@@ -0,0 +1,115 @@
+package work
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyDelays(t *testing.T) {
+	delay := func(p RetryPolicy, fails int64) time.Duration {
+		return p.Delay(&Job{Fails: fails})
+	}
+
+	exp := ExponentialRetry(time.Second, time.Minute)
+	for i := 0; i < 100; i++ {
+		d := delay(exp, 3)
+		assert.True(t, d >= 0 && d < 4*time.Second, d)
+		d = delay(exp, 100)
+		assert.True(t, d >= 0 && d < time.Minute, d)
+	}
+	uncapped := ExponentialRetry(time.Hour, 0)
+	assert.True(t, delay(uncapped, 80) >= 0)
+
+	linear := LinearRetry(10*time.Second, 25*time.Second)
+	assert.Equal(t, 10*time.Second, delay(linear, 1))
+	assert.Equal(t, 20*time.Second, delay(linear, 2))
+	assert.Equal(t, 25*time.Second, delay(linear, 3))
+
+	fixed := FixedRetry(time.Second, time.Minute, time.Hour)
+	assert.Equal(t, time.Second, delay(fixed, 1))
+	assert.Equal(t, time.Minute, delay(fixed, 2))
+	assert.Equal(t, time.Hour, delay(fixed, 3))
+	assert.Equal(t, time.Hour, delay(fixed, 10))
+	assert.Panics(t, func() { FixedRetry() })
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	// Delays are rounded up to whole seconds
+	jt := &jobType{JobOptions: JobOptions{RetryPolicy: FixedRetry(0, 100*time.Millisecond, time.Second, 1500*time.Millisecond)}}
+	for fails, want := range []int64{0, 1, 1, 2} {
+		assert.Equal(t, want, jt.calcBackoff(&Job{Fails: int64(fails + 1)}), "fails %d", fails+1)
+	}
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	errTransient := errors.New("connection refused")
+	errInvalid := errors.New("invalid email")
+
+	jt := &jobType{}
+	assert.True(t, jt.shouldRetry(errInvalid))
+	assert.False(t, jt.shouldRetry(Permanent(errInvalid)))
+	assert.False(t, jt.shouldRetry(fmt.Errorf("sending: %w", Permanent(errInvalid))))
+
+	jt.RetryPolicy = NoRetry()
+	assert.False(t, jt.shouldRetry(errTransient))
+
+	jt.RetryPolicy = LinearRetry(time.Second, 0).RetryOn(func(err error) bool {
+		return errors.Is(err, errTransient)
+	})
+	assert.True(t, jt.shouldRetry(errTransient))
+	assert.False(t, jt.shouldRetry(errInvalid))
+	assert.NotNil(t, jt.RetryPolicy.Delay)
+
+	assert.Nil(t, Permanent(nil))
+	err := Permanent(errInvalid)
+	assert.EqualError(t, err, "invalid email")
+	assert.True(t, errors.Is(err, errInvalid))
+	assert.True(t, IsPermanent(err))
+	assert.False(t, IsPermanent(errInvalid))
+}
+
+func TestWorkerRetryPolicy(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	jobTypes := map[string]*jobType{
+		"fixed": {
+			Name:           "fixed",
+			JobOptions:     JobOptions{Priority: 1, MaxFails: 3, RetryPolicy: FixedRetry(time.Minute)},
+			IsGeneric:      true,
+			GenericHandler: func(job *Job) error { return fmt.Errorf("sorry kid") },
+		},
+		"invalid": {
+			Name:           "invalid",
+			JobOptions:     JobOptions{Priority: 1, MaxFails: 10},
+			IsGeneric:      true,
+			GenericHandler: func(job *Job) error { return Permanent(fmt.Errorf("invalid email")) },
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("fixed", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("invalid", nil)
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	ts, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, "fixed", job.Name)
+	assert.InDelta(t, nowEpochSeconds()+60, ts, 2)
+
+	// A permanent error goes straight to the dead queue
+	_, job = jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, "invalid", job.Name)
+	assert.EqualValues(t, 1, job.Fails)
+	assert.Equal(t, "invalid email", job.LastErr)
+}
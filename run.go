@@ -1,16 +1,15 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"runtime/debug"
-
-	commonlog "github.com/wallester/monorepo/pkg/common/log"
 )
 
 // returns an error if the job fails, or there's a panic, or we couldn't reflect correctly.
-// if we return an error, it signals we want the job to be retried.
-func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType) (returnCtx reflect.Value, returnError error) {
+// if we return an error, it signals we want the job to be retried. Panics are logged with logger.
+func runJob(ctx context.Context, job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType, logger Logger) (returnCtx reflect.Value, returnError error) {
 	returnCtx = reflect.New(ctxType)
 	currentMiddleware := 0
 	maxMiddleware := len(middleware)
@@ -21,9 +20,16 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 			mw := middleware[currentMiddleware]
 			currentMiddleware++
 			if mw.IsGeneric {
+				if mw.GenericContextMiddlewareHandler != nil {
+					return mw.GenericContextMiddlewareHandler(ctx, job, next)
+				}
 				return mw.GenericMiddlewareHandler(job, next)
 			}
-			res := mw.DynamicMiddleware.Call([]reflect.Value{returnCtx, reflect.ValueOf(job), reflect.ValueOf(next)})
+			args := []reflect.Value{returnCtx, reflect.ValueOf(job), reflect.ValueOf(next)}
+			if mw.DynamicTakesContext {
+				args = []reflect.Value{returnCtx, reflect.ValueOf(ctx), reflect.ValueOf(job), reflect.ValueOf(next)}
+			}
+			res := mw.DynamicMiddleware.Call(args)
 			x := res[0].Interface()
 			if x == nil {
 				return nil
@@ -31,9 +37,16 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 			return x.(error)
 		}
 		if jt.IsGeneric {
+			if jt.GenericContextHandler != nil {
+				return jt.GenericContextHandler(ctx, job)
+			}
 			return jt.GenericHandler(job)
 		}
-		res := jt.DynamicHandler.Call([]reflect.Value{returnCtx, reflect.ValueOf(job)})
+		args := []reflect.Value{returnCtx, reflect.ValueOf(job)}
+		if jt.DynamicTakesContext {
+			args = []reflect.Value{returnCtx, reflect.ValueOf(ctx), reflect.ValueOf(job)}
+		}
+		res := jt.DynamicHandler.Call(args)
 		x := res[0].Interface()
 		if x == nil {
 			return nil
@@ -43,11 +56,10 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 
 	defer func() {
 		if panicErr := recover(); panicErr != nil {
-			debug.PrintStack()
 			// err turns out to be interface{}, of actual type "runtime.errorCString"
 			// Luckily, the err sprints nicely via fmt.
 			errorishError := fmt.Errorf("%v", panicErr)
-			commonlog.RecoveredPanic(errorishError)
+			logger.Error("run_job.panic", errorishError, append(jobFields(job), "stack", string(debug.Stack()))...)
 			returnError = errorishError
 		}
 	}()
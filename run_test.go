@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -48,7 +49,7 @@ func TestRunBasicMiddleware(t *testing.T) {
 		Args: map[string]interface{}{"a": "foo"},
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(context.Background(), job, tstCtxType, middleware, jt, defaultLogger)
 	assert.NoError(t, err)
 	c := v.Interface().(*tstCtx)
 	assert.Equal(t, "mw1mw2mw3h1foo", c.String())
@@ -77,7 +78,7 @@ func TestRunHandlerError(t *testing.T) {
 		Name: "foo",
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(context.Background(), job, tstCtxType, middleware, jt, defaultLogger)
 	assert.Error(t, err)
 	assert.Equal(t, "h1_err", err.Error())
 
@@ -108,7 +109,7 @@ func TestRunMwError(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt, defaultLogger)
 	assert.Error(t, err)
 	assert.Equal(t, "mw1_err", err.Error())
 }
@@ -137,7 +138,7 @@ func TestRunHandlerPanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt, defaultLogger)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
@@ -165,7 +166,64 @@ func TestRunMiddlewarePanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt, defaultLogger)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
+
+func TestRunContextMiddlewareAndHandler(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+
+	mw1 := func(ctx context.Context, j *Job, next NextMiddlewareFunc) error {
+		j.setArg("mw1", ctx.Value(ctxKey{}))
+		return next()
+	}
+
+	mw2 := func(c *tstCtx, ctx context.Context, j *Job, next NextMiddlewareFunc) error {
+		c.record(j.Args["mw1"].(string))
+		c.record(ctx.Value(ctxKey{}).(string))
+		return next()
+	}
+
+	h1 := func(c *tstCtx, ctx context.Context, j *Job) error {
+		c.record("h1")
+		c.record(ctx.Value(ctxKey{}).(string))
+		return nil
+	}
+
+	middleware := []*middlewareHandler{
+		{IsGeneric: true, GenericContextMiddlewareHandler: mw1},
+		{IsGeneric: false, DynamicMiddleware: reflect.ValueOf(mw2), DynamicTakesContext: true},
+	}
+
+	jt := &jobType{
+		Name:                "foo",
+		IsGeneric:           false,
+		DynamicHandler:      reflect.ValueOf(h1),
+		DynamicTakesContext: true,
+	}
+
+	job := &Job{
+		Name: "foo",
+	}
+
+	v, err := runJob(ctx, job, tstCtxType, middleware, jt, defaultLogger)
+	assert.NoError(t, err)
+	c := v.Interface().(*tstCtx)
+	assert.Equal(t, "vvh1v", c.String())
+
+	var handlerCtx context.Context
+	jt = &jobType{
+		Name:      "foo",
+		IsGeneric: true,
+		GenericContextHandler: func(ctx context.Context, j *Job) error {
+			handlerCtx = ctx
+			return nil
+		},
+	}
+
+	_, err = runJob(ctx, job, tstCtxType, nil, jt, defaultLogger)
+	assert.NoError(t, err)
+	assert.Equal(t, ctx, handlerCtx)
+}
@@ -0,0 +1,85 @@
+package work
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the name of the tracer the worker pools get from their TracerProvider.
+const tracerName = "github.com/wallester/work"
+
+// traceParent returns the W3C traceparent of the span of ctx, or "" if it has none.
+func traceParent(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ""
+	}
+
+	return fmt.Sprintf("00-%s-%s-%s", sc.TraceID(), sc.SpanID(), sc.TraceFlags())
+}
+
+// parseTraceParent parses a W3C traceparent into a remote span context. ok is false if it isn't a valid traceparent.
+func parseTraceParent(traceParent string) (sc trace.SpanContext, ok bool) {
+	parts := strings.Split(traceParent, "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return sc, false
+	}
+
+	traceID, err := trace.TraceIDFromHex(parts[1])
+	if err != nil {
+		return sc, false
+	}
+	spanID, err := trace.SpanIDFromHex(parts[2])
+	if err != nil {
+		return sc, false
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || len(flags) != 1 {
+		return sc, false
+	}
+
+	sc = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.TraceFlags(flags[0]),
+		Remote:     true,
+	})
+	return sc, sc.IsValid()
+}
+
+// startJobSpan starts the span of a run of job. It's a child of the span that enqueued the job, if any, so all the
+// runs of a job, retries included, are part of the trace it was enqueued in.
+func (w *worker) startJobSpan(ctx context.Context, job *Job) (context.Context, trace.Span) {
+	parent := ctx
+	if sc, ok := parseTraceParent(job.TraceParent); ok {
+		parent = trace.ContextWithRemoteSpanContext(ctx, sc)
+	}
+
+	return w.tracer.Start(parent, job.Name+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("work.namespace", w.namespace),
+			attribute.String("work.job.name", job.Name),
+			attribute.String("work.job.id", job.ID),
+			attribute.Int64("work.job.fails", job.Fails),
+		),
+	)
+}
+
+// endJobSpan ends the span of a run of a job, that returned err.
+func endJobSpan(span trace.Span, err error) {
+	outcome := "succeeded"
+	if err != nil {
+		outcome = "failed"
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.SetAttributes(attribute.String("work.job.outcome", outcome))
+	span.End()
+}
@@ -0,0 +1,271 @@
+package work
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+func TestTraceParent(t *testing.T) {
+	sc := newTestSpanContext()
+	ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+	tp := traceParent(ctx)
+	assert.Equal(t, "00-"+sc.TraceID().String()+"-"+sc.SpanID().String()+"-01", tp)
+	parsed, ok := parseTraceParent(tp)
+	assert.True(t, ok)
+	assert.Equal(t, sc.TraceID(), parsed.TraceID())
+	assert.Equal(t, sc.SpanID(), parsed.SpanID())
+	assert.True(t, parsed.IsSampled())
+	assert.True(t, parsed.IsRemote())
+
+	assert.Equal(t, "", traceParent(context.Background()))
+	for _, invalid := range []string{
+		"",
+		"00-" + sc.TraceID().String() + "-" + sc.SpanID().String(),
+		"00-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-01-extra",
+		"ff-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-01",
+		"00-00000000000000000000000000000000-" + sc.SpanID().String() + "-01",
+		"00-" + sc.TraceID().String() + "-nothex-01",
+		"00-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-1",
+	} {
+		_, ok := parseTraceParent(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestWorkerPoolTraceJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	tp := &testTracerProvider{}
+	var mtx sync.Mutex
+	var handlerTraceIDs []trace.TraceID
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.TraceJobs(tp)
+	wp.JobWithOptions(job1, JobOptions{Backoff: func(job *Job) int64 { return 0 }}, func(ctx context.Context, job *Job) error {
+		mtx.Lock()
+		handlerTraceIDs = append(handlerTraceIDs, trace.SpanContextFromContext(ctx).TraceID())
+		mtx.Unlock()
+		if job.Fails == 0 {
+			return fmt.Errorf("ohno")
+		}
+		return nil
+	})
+
+	enqueueSpan := newTestSpanContext()
+	ctx := trace.ContextWithSpanContext(context.Background(), enqueueSpan)
+	job, err := NewEnqueuer(ns, pool).EnqueueContext(ctx, job1, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, job.TraceParent)
+
+	wp.Start()
+	// The retry is picked up by the requeuer
+	deadline := time.Now().Add(5 * time.Second)
+	for len(tp.endedSpans()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	wp.Stop()
+
+	spans := tp.endedSpans()
+	if assert.Len(t, spans, 2) {
+		for i, span := range spans {
+			assert.Equal(t, "job1 process", span.name)
+			assert.Equal(t, enqueueSpan.TraceID(), span.sc.TraceID())
+			assert.Equal(t, enqueueSpan.SpanID(), span.parent.SpanID())
+			assert.Contains(t, span.attrs, attribute.String("work.job.id", job.ID))
+			assert.Contains(t, span.attrs, attribute.Int64("work.job.fails", int64(i)))
+		}
+		assert.Contains(t, spans[0].attrs, attribute.String("work.job.outcome", "failed"))
+		assert.Equal(t, codes.Error, spans[0].status)
+		assert.Contains(t, spans[1].attrs, attribute.String("work.job.outcome", "succeeded"))
+		assert.Equal(t, codes.Unset, spans[1].status)
+	}
+	assert.Equal(t, []trace.TraceID{enqueueSpan.TraceID(), enqueueSpan.TraceID()}, handlerTraceIDs)
+}
+
+func newTestSpanContext() trace.SpanContext {
+	var traceID trace.TraceID
+	var spanID trace.SpanID
+	rand.Read(traceID[:])
+	rand.Read(spanID[:])
+	return trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: trace.FlagsSampled})
+}
+
+// testTracerProvider records the spans started by its tracers.
+type testTracerProvider struct {
+	embedded.TracerProvider
+
+	mtx   sync.Mutex
+	spans []*testSpan
+}
+
+func (tp *testTracerProvider) Tracer(name string, options ...trace.TracerOption) trace.Tracer {
+	return &testTracer{tp: tp}
+}
+
+func (tp *testTracerProvider) endedSpans() []*testSpan {
+	tp.mtx.Lock()
+	defer tp.mtx.Unlock()
+
+	var spans []*testSpan
+	for _, span := range tp.spans {
+		if span.ended {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+type testTracer struct {
+	embedded.Tracer
+
+	tp *testTracerProvider
+}
+
+func (t *testTracer) Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	parent := trace.SpanContextFromContext(ctx)
+	sc := newTestSpanContext()
+	if parent.IsValid() {
+		sc = sc.WithTraceID(parent.TraceID())
+	}
+
+	config := trace.NewSpanStartConfig(options...)
+	span := &testSpan{
+		Span:   trace.SpanFromContext(context.Background()),
+		tp:     t.tp,
+		sc:     sc,
+		parent: parent,
+		name:   name,
+		attrs:  config.Attributes(),
+	}
+	t.tp.mtx.Lock()
+	t.tp.spans = append(t.tp.spans, span)
+	t.tp.mtx.Unlock()
+
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type testSpan struct {
+	trace.Span // no-op for everything that isn't recorded
+
+	tp     *testTracerProvider
+	sc     trace.SpanContext
+	parent trace.SpanContext
+	name   string
+	attrs  []attribute.KeyValue
+	status codes.Code
+	ended  bool
+}
+
+func (s *testSpan) SpanContext() trace.SpanContext {
+	return s.sc
+}
+
+func (s *testSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.attrs = append(s.attrs, kv...)
+}
+
+func (s *testSpan) SetStatus(code codes.Code, description string) {
+	s.status = code
+}
+
+func (s *testSpan) End(options ...trace.SpanEndOption) {
+	s.tp.mtx.Lock()
+	s.ended = true
+	s.tp.mtx.Unlock()
+}
+
+func TestEnqueuerTraceParent(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	ctx := trace.ContextWithSpanContext(context.Background(), newTestSpanContext())
+	tp := traceParent(ctx)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	var jobs []*Job
+	job, err := enqueuer.EnqueueUniqueContext(ctx, "wat", Q{"i": 1})
+	assert.NoError(t, err)
+	jobs = append(jobs, job)
+	job, err = enqueuer.EnqueueUniqueByKeyContext(ctx, "wat", Q{"i": 2}, Q{"key": 2})
+	assert.NoError(t, err)
+	jobs = append(jobs, job)
+	scheduledJob, err := enqueuer.EnqueueUniqueInContext(ctx, "wat", 100, Q{"i": 3})
+	assert.NoError(t, err)
+	jobs = append(jobs, scheduledJob.Job)
+	scheduledJob, err = enqueuer.EnqueueUniqueInByKeyContext(ctx, "wat", 100, Q{"i": 4}, Q{"key": 4})
+	assert.NoError(t, err)
+	jobs = append(jobs, scheduledJob.Job)
+
+	batchJobs, err := enqueuer.EnqueueBatchContext(ctx, "wat", []map[string]interface{}{{"i": 5}})
+	assert.NoError(t, err)
+	jobs = append(jobs, batchJobs...)
+	scheduledJobs, err := enqueuer.EnqueueBatchInContext(ctx, "wat", 100, []map[string]interface{}{{"i": 6}})
+	assert.NoError(t, err)
+	for _, scheduledJob := range scheduledJobs {
+		jobs = append(jobs, scheduledJob.Job)
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+	conn.Send("MULTI")
+	job, err = enqueuer.EnqueueTxContext(ctx, conn, "wat", Q{"i": 7})
+	assert.NoError(t, err)
+	jobs = append(jobs, job)
+	scheduledJob, err = enqueuer.EnqueueInTxContext(ctx, conn, "wat", 100, Q{"i": 8})
+	assert.NoError(t, err)
+	jobs = append(jobs, scheduledJob.Job)
+	job, err = enqueuer.EnqueueUniqueTxContext(ctx, conn, "wat", Q{"i": 9})
+	assert.NoError(t, err)
+	jobs = append(jobs, job)
+	scheduledJob, err = enqueuer.EnqueueUniqueInTxContext(ctx, conn, "wat", 100, Q{"i": 10})
+	assert.NoError(t, err)
+	jobs = append(jobs, scheduledJob.Job)
+	_, err = conn.Do("EXEC")
+	assert.NoError(t, err)
+
+	wf := enqueuer.NewWorkflowContext(ctx)
+	jobs = append(jobs, wf.Add("wat", Q{"i": 11}))
+	assert.NoError(t, wf.Enqueue())
+
+	b := enqueuer.NewBatchContext(ctx, BatchOptions{OnComplete: &BatchItem{Name: "done"}})
+	jobs = append(jobs, b.Add("wat", Q{"i": 12}))
+	assert.NoError(t, b.Enqueue())
+
+	for _, job := range jobs {
+		assert.Equal(t, tp, job.TraceParent, "job %v", job.Args["i"])
+	}
+
+	// The jobs in Redis carry it too, callbacks included
+	var stored []*Job
+	rawJSONs, err := redis.ByteSlices(conn.Do("LRANGE", redisKeyJobs(ns, "wat"), 0, -1))
+	assert.NoError(t, err)
+	for _, rawJSON := range rawJSONs {
+		job, err := newJob(rawJSON, nil, nil)
+		assert.NoError(t, err)
+		stored = append(stored, job)
+	}
+	rawJSON, err := redis.Bytes(conn.Do("HGET", redisKeyBatch(ns, b.ID), "on_complete"))
+	assert.NoError(t, err)
+	callback, err := newJob(rawJSON, nil, nil)
+	assert.NoError(t, err)
+	stored = append(stored, callback)
+	assert.Len(t, stored, 8)
+	for _, job := range stored {
+		assert.Equal(t, tp, job.TraceParent, "job %s %v", job.Name, job.Args["i"])
+	}
+}
@@ -0,0 +1,151 @@
+package work
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// JobDef binds a job name to the Go type of its arguments. Use it with RegisterTyped and EnqueueTyped so that
+// handlers get their arguments as a T instead of pulling them out of Job.Args one key at a time.
+// T is encoded as JSON and must encode to a JSON object, so it's typically a struct.
+//
+// Example:
+//
+//	type SendEmailArgs struct {
+//		Address    string `json:"address"`
+//		CustomerID int64  `json:"customer_id"`
+//	}
+//
+//	var SendEmail = work.NewJobDef[SendEmailArgs]("send_email")
+type JobDef[T any] struct {
+	Name string
+}
+
+// NewJobDef creates a JobDef for jobs named name with arguments of type T.
+func NewJobDef[T any](name string) JobDef[T] {
+	return JobDef[T]{Name: name}
+}
+
+// RegisterTyped registers fn as the handler for def's jobs on wp, as per WorkerPool.Job. fn receives the job's
+// arguments decoded into a T. Jobs whose arguments can't be decoded are sent straight to the dead queue without being
+// retried, since retrying won't fix them.
+func RegisterTyped[T any](wp *WorkerPool, def JobDef[T], fn func(context.Context, T) error) *WorkerPool {
+	return RegisterTypedWithOptions(wp, def, JobOptions{}, fn)
+}
+
+// RegisterTypedWithOptions registers fn as per RegisterTyped, but permits you to specify additional options as per
+// WorkerPool.JobWithOptions.
+func RegisterTypedWithOptions[T any](wp *WorkerPool, def JobDef[T], jobOpts JobOptions, fn func(context.Context, T) error) *WorkerPool {
+	return wp.JobWithOptions(def.Name, jobOpts, func(ctx context.Context, job *Job) error {
+		args, err := def.decode(job)
+		if err != nil {
+			return err
+		}
+		return fn(ctx, args)
+	})
+}
+
+// EnqueueTyped enqueues a def job with the specified arguments, as per Enqueuer.Enqueue.
+func EnqueueTyped[T any](e *Enqueuer, def JobDef[T], args T) (*Job, error) {
+	m, err := def.encode(args)
+	if err != nil {
+		return nil, err
+	}
+	return e.Enqueue(def.Name, m)
+}
+
+// EnqueueTypedIn enqueues a def job with the specified arguments for execution in secondsFromNow seconds, as per
+// Enqueuer.EnqueueIn.
+func EnqueueTypedIn[T any](e *Enqueuer, def JobDef[T], secondsFromNow int64, args T) (*ScheduledJob, error) {
+	m, err := def.encode(args)
+	if err != nil {
+		return nil, err
+	}
+	return e.EnqueueIn(def.Name, secondsFromNow, m)
+}
+
+// encode turns args into the map stored in Job.Args. Numbers are kept as json.Number so that they survive the round
+// trip without being turned into a float64.
+func (def JobDef[T]) encode(args T) (map[string]interface{}, error) {
+	rawJSON, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(rawJSON))
+	dec.UseNumber()
+
+	var m map[string]interface{}
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("work: arguments of %s jobs must encode to a JSON object: %w", def.Name, err)
+	}
+	return m, nil
+}
+
+// decode extracts the job's arguments from Job.Args as they are when the handler runs, so that changes made by
+// middleware are seen. As long as Job.Args still matches the raw JSON the job was read from, the raw JSON is decoded
+// instead so that large integers don't get mangled by a detour through float64.
+func (def JobDef[T]) decode(job *Job) (T, error) {
+	var args T
+
+	rawArgs, err := job.rawArgs()
+	if err == nil {
+		err = json.Unmarshal(rawArgs, &args)
+	}
+	if err != nil {
+		return args, &argsDecodeError{jobName: job.Name, typeName: fmt.Sprintf("%T", args), err: err}
+	}
+	return args, nil
+}
+
+// rawArgs returns the JSON encoding of the job's arguments: the one the job was read from if Job.Args wasn't changed
+// since, or else Job.Args encoded anew.
+func (j *Job) rawArgs() ([]byte, error) {
+	if len(j.rawJSON) == 0 {
+		return json.Marshal(j.Args)
+	}
+
+	var envelope struct {
+		Args json.RawMessage `json:"args"`
+	}
+	if err := json.Unmarshal(j.rawJSON, &envelope); err != nil {
+		return nil, err
+	}
+
+	// Decoded the same way as Job.Args, so they're equal unless the args were changed
+	var readArgs map[string]interface{}
+	if len(envelope.Args) > 0 {
+		if err := json.Unmarshal(envelope.Args, &readArgs); err != nil {
+			return nil, err
+		}
+	}
+	if !reflect.DeepEqual(readArgs, j.Args) {
+		return json.Marshal(j.Args)
+	}
+	return envelope.Args, nil
+}
+
+// argsDecodeError is returned by typed handlers when a job's arguments don't fit the handler's type.
+// Such jobs are never retried.
+type argsDecodeError struct {
+	jobName  string
+	typeName string
+	err      error
+}
+
+func (e *argsDecodeError) Error() string {
+	return fmt.Sprintf("can't decode arguments of %s job into %s: %v", e.jobName, e.typeName, e.err)
+}
+
+func (e *argsDecodeError) Unwrap() error {
+	return e.err
+}
+
+func isArgsDecodeError(err error) bool {
+	var decodeErr *argsDecodeError
+	return errors.As(err, &decodeErr)
+}
@@ -0,0 +1,128 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type typedTestArgs struct {
+	Address    string `json:"address"`
+	CustomerID int64  `json:"customer_id"`
+}
+
+func TestTypedEnqueueAndProcess(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	def := NewJobDef[typedTestArgs]("send_email")
+
+	var got []typedTestArgs
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	RegisterTyped(wp, def, func(ctx context.Context, args typedTestArgs) error {
+		got = append(got, args)
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := EnqueueTyped(enqueuer, def, typedTestArgs{Address: "a@example.com", CustomerID: 9007199254740993})
+	assert.NoError(t, err)
+	assert.Equal(t, "send_email", job.Name)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, []typedTestArgs{{Address: "a@example.com", CustomerID: 9007199254740993}}, got)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+}
+
+func TestTypedDecodeFailureGoesToDead(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	def := NewJobDef[typedTestArgs]("send_email")
+
+	called := false
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	RegisterTypedWithOptions(wp, def, JobOptions{MaxFails: 10}, func(ctx context.Context, args typedTestArgs) error {
+		called = true
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(def.Name, Q{"customer_id": "not a number"})
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.False(t, called)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Regexp(t, "can't decode arguments of send_email job into work.typedTestArgs", job.LastErr)
+}
+
+func TestTypedEncodeNonObject(t *testing.T) {
+	pool := newTestPool(":6379")
+	enqueuer := NewEnqueuer("work", pool)
+
+	_, err := EnqueueTyped(enqueuer, NewJobDef[int]("wat"), 1)
+	assert.Error(t, err)
+
+	_, err = EnqueueTypedIn(enqueuer, NewJobDef[[]string]("wat"), 10, []string{"a"})
+	assert.Error(t, err)
+}
+
+func TestTypedDecodeWithoutRawJSON(t *testing.T) {
+	def := NewJobDef[typedTestArgs]("send_email")
+	job := &Job{Name: def.Name, Args: Q{"address": "a@example.com", "customer_id": 3}}
+
+	args, err := def.decode(job)
+	assert.NoError(t, err)
+	assert.Equal(t, typedTestArgs{Address: "a@example.com", CustomerID: 3}, args)
+
+	job.Args["customer_id"] = "3"
+	_, err = def.decode(job)
+	assert.True(t, isArgsDecodeError(err))
+	assert.True(t, isArgsDecodeError(fmt.Errorf("wrapped: %w", err)))
+}
+
+func TestTypedDecodeArgsChangedByMiddleware(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	def := NewJobDef[typedTestArgs]("send_email")
+
+	var got []typedTestArgs
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Middleware(func(job *Job, next NextMiddlewareFunc) error {
+		if job.ArgString("address") == "old@example.com" {
+			job.setArg("address", "new@example.com")
+		}
+		return next()
+	})
+	RegisterTyped(wp, def, func(ctx context.Context, args typedTestArgs) error {
+		got = append(got, args)
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := EnqueueTyped(enqueuer, def, typedTestArgs{Address: "old@example.com", CustomerID: 3})
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, []typedTestArgs{{Address: "new@example.com", CustomerID: 3}}, got)
+}
@@ -0,0 +1,236 @@
+package work
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	defaultBlockingFetchPoll = 5 * time.Second
+	wakeupReconnectWait      = time.Second
+)
+
+// wakeupListener wakes the idle workers of a pool with WorkerPoolOptions.BlockingFetch when jobs they handle are
+// queued. Every wakeup lets one idle worker fetch; a worker that gets a job passes the wakeup on, so a burst of jobs
+// spreads to as many workers as there are jobs, without every idle worker hitting Redis for a single job. It also
+// wakes a worker every poll period, to catch what isn't announced, like a rate limit window passing.
+type wakeupListener struct {
+	namespace string
+	pool      *redis.Pool
+	wakeChan  *wakeChannel
+	poll      time.Duration
+	logger    Logger
+
+	mtx      sync.Mutex
+	jobNames map[string]bool
+	psc      *redis.PubSubConn
+	stopped  bool
+	stopChan chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newWakeupListener(namespace string, pool *redis.Pool, jobNames []string, wakeChan *wakeChannel, poll time.Duration) *wakeupListener {
+	if poll <= 0 {
+		poll = defaultBlockingFetchPoll
+	}
+
+	l := &wakeupListener{
+		namespace: namespace,
+		pool:      pool,
+		wakeChan:  wakeChan,
+		poll:      poll,
+		logger:    withFields(defaultLogger, "namespace", namespace),
+		stopChan:  make(chan struct{}),
+	}
+	l.updateJobNames(jobNames)
+
+	return l
+}
+
+// updateJobNames sets the jobs whose wakeups the listener passes on. It can be called while the listener is started.
+func (l *wakeupListener) updateJobNames(jobNames []string) {
+	names := make(map[string]bool, len(jobNames))
+	for _, jobName := range jobNames {
+		names[jobName] = true
+	}
+
+	l.mtx.Lock()
+	l.jobNames = names
+	l.mtx.Unlock()
+}
+
+func (l *wakeupListener) start() {
+	l.wg.Add(2)
+	go l.subscribeLoop()
+	go l.pollLoop()
+}
+
+func (l *wakeupListener) stop() {
+	l.mtx.Lock()
+	l.stopped = true
+	close(l.stopChan)
+	if l.psc != nil {
+		l.psc.Unsubscribe()
+	}
+	l.mtx.Unlock()
+	l.wg.Wait()
+}
+
+func (l *wakeupListener) subscribeLoop() {
+	defer l.wg.Done()
+
+	for {
+		if err := l.subscribe(); err != nil {
+			l.logger.Error("wakeup_listener.subscribe", err)
+		}
+
+		select {
+		case <-l.stopChan:
+			return
+		case <-time.After(wakeupReconnectWait):
+		}
+	}
+}
+
+// subscribe wakes the workers on the messages of the wakeup channel until it's unsubscribed or the connection fails.
+func (l *wakeupListener) subscribe() error {
+	psc := &redis.PubSubConn{Conn: l.pool.Get()}
+	defer psc.Close()
+
+	if err := psc.Subscribe(redisKeyWakeup(l.namespace)); err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	if l.stopped {
+		l.mtx.Unlock()
+		return nil
+	}
+	l.psc = psc
+	l.mtx.Unlock()
+
+	defer func() {
+		l.mtx.Lock()
+		l.psc = nil
+		l.mtx.Unlock()
+	}()
+
+	for {
+		switch v := psc.Receive().(type) {
+		case redis.Message:
+			jobName := string(v.Data)
+			l.mtx.Lock()
+			handled := jobName == "" || l.jobNames[jobName]
+			l.mtx.Unlock()
+			if handled {
+				l.wake()
+			}
+		case redis.Subscription:
+			if v.Count == 0 {
+				return nil
+			}
+			// Jobs may have been queued while we weren't subscribed
+			l.wake()
+		case error:
+			return v
+		}
+	}
+}
+
+func (l *wakeupListener) pollLoop() {
+	defer l.wg.Done()
+
+	ticker := time.NewTicker(l.poll)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stopChan:
+			return
+		case <-ticker.C:
+			l.wake()
+		}
+	}
+}
+
+func (l *wakeupListener) wake() {
+	l.wakeChan.wakeOne()
+}
+
+// wakeChannel is what the idle workers of a pool with WorkerPoolOptions.BlockingFetch wait on. It buffers a wakeup per
+// worker, so it's replaced by a channel of the new size when the pool is resized.
+type wakeChannel struct {
+	ch atomic.Value // chan struct{}
+}
+
+func newWakeChannel(size uint) *wakeChannel {
+	c := &wakeChannel{}
+	c.ch.Store(make(chan struct{}, size))
+	return c
+}
+
+// get returns the channel to wait on for a wakeup. It's nil, so it never delivers, if c is nil.
+func (c *wakeChannel) get() chan struct{} {
+	if c == nil {
+		return nil
+	}
+	return c.ch.Load().(chan struct{})
+}
+
+// wakeOne lets one idle worker fetch a job, if c isn't nil. It never blocks: if the workers are all busy already, the
+// wakeup is buffered or dropped.
+func (c *wakeChannel) wakeOne() {
+	wakeChan := c.get()
+	if wakeChan == nil {
+		return
+	}
+	select {
+	case wakeChan <- struct{}{}:
+	default:
+	}
+}
+
+// resize makes room for a wakeup per worker of a pool of size workers. The workers waiting on the old channel are all
+// woken up, so that they look for jobs and then wait on the new one.
+func (c *wakeChannel) resize(size uint) {
+	old := c.get()
+	if uint(cap(old)) == size {
+		return
+	}
+	c.ch.Store(make(chan struct{}, size))
+	for i := 0; i < cap(old); i++ {
+		select {
+		case old <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// doAndWakeup runs the command on conn and, if wakeup is set, publishes a wakeup for jobName in the same round trip.
+func doAndWakeup(conn redis.Conn, wakeup bool, namespace, jobName, cmd string, args ...interface{}) error {
+	if !wakeup {
+		_, err := conn.Do(cmd, args...)
+		return err
+	}
+	conn.Send(cmd, args...)
+	return publishWakeup(conn, true, namespace, jobName)
+}
+
+// publishWakeup publishes a wakeup for jobName if the client publishes wakeups, see SetWakeup.
+func (c *Client) publishWakeup(conn redis.Conn, jobName string) error {
+	return publishWakeup(conn, c.wakeup, c.namespace, jobName)
+}
+
+// publishWakeup tells the worker pools with WorkerPoolOptions.BlockingFetch that jobName jobs were queued, or jobs of
+// any name if jobName is "". It does nothing unless wakeup is set: the publishers opt in, see Enqueuer.SetWakeup and
+// WorkerPoolOptions.BlockingFetch.
+func publishWakeup(conn redis.Conn, wakeup bool, namespace, jobName string) error {
+	if !wakeup {
+		return nil
+	}
+	_, err := conn.Do("PUBLISH", redisKeyWakeup(namespace), jobName)
+	return err
+}
@@ -0,0 +1,291 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWakeupListener(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wakeChan := newWakeChannel(1)
+	l := newWakeupListener(ns, pool, []string{"wat"}, wakeChan, time.Hour)
+	l.start()
+	defer l.stop()
+
+	// Subscribing wakes a worker, since jobs may have been queued before
+	assertWoken(t, wakeChan, true)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	// Only the jobs the pool handles wake it
+	assert.NoError(t, publishWakeup(conn, true, ns, "other"))
+	assertWoken(t, wakeChan, false)
+	assert.NoError(t, publishWakeup(conn, true, ns, "wat"))
+	assertWoken(t, wakeChan, true)
+	assert.NoError(t, publishWakeup(conn, true, ns, ""))
+	assertWoken(t, wakeChan, true)
+}
+
+func TestEnqueuerWakeup(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wakeChan := newWakeChannel(1)
+	l := newWakeupListener(ns, pool, []string{"wat"}, wakeChan, time.Hour)
+	l.start()
+	defer l.stop()
+	assertWoken(t, wakeChan, true)
+
+	// Wakeups are off by default
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUnique("wat", Q{"i": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueBatch("wat", []map[string]interface{}{{"i": 2}})
+	assert.NoError(t, err)
+	assertWoken(t, wakeChan, false)
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	enqueuer.SetWakeup(true)
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assertWoken(t, wakeChan, true)
+	_, err = enqueuer.EnqueueUnique("wat", Q{"i": 3})
+	assert.NoError(t, err)
+	assertWoken(t, wakeChan, true)
+	_, err = enqueuer.EnqueueBatch("wat", []map[string]interface{}{{"i": 4}})
+	assert.NoError(t, err)
+	assertWoken(t, wakeChan, true)
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.UnpauseJob("wat"))
+	assertWoken(t, wakeChan, false)
+	client.SetWakeup(true)
+	assert.NoError(t, client.UnpauseJob("wat"))
+	assertWoken(t, wakeChan, true)
+}
+
+func TestRequeuerWakeup(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wakeChan := newWakeChannel(1)
+	l := newWakeupListener(ns, pool, []string{"wat"}, wakeChan, time.Hour)
+	l.start()
+	defer l.stop()
+	assertWoken(t, wakeChan, true)
+
+	retry := func() {
+		rawJSON, err := (&Job{Name: "wat", ID: makeIdentifier(), Fails: 1}).serialize()
+		assert.NoError(t, err)
+		conn := pool.Get()
+		defer conn.Close()
+		_, err = conn.Do("ZADD", redisKeyRetry(ns), nowEpochSeconds()-1, rawJSON)
+		assert.NoError(t, err)
+	}
+
+	// Only pools with BlockingFetch publish wakeups
+	r := newRequeuer(ns, pool, redisKeyRetry(ns), []string{"wat"})
+	retry()
+	r.requeueAll()
+	assertWoken(t, wakeChan, false)
+
+	r.wakeup = true
+	retry()
+	r.requeueAll()
+	assertWoken(t, wakeChan, true)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestWakeupListenerPoll(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wakeChan := newWakeChannel(1)
+	l := newWakeupListener(ns, pool, []string{"wat"}, wakeChan, 20*time.Millisecond)
+	l.start()
+
+	for i := 0; i < 3; i++ {
+		assertWoken(t, wakeChan, true)
+	}
+
+	l.stop()
+}
+
+func TestWakeChannelResize(t *testing.T) {
+	wakeChan := newWakeChannel(2)
+	waiting := make(chan struct{})
+	woken := make(chan struct{})
+	for i := 0; i < 2; i++ {
+		go func() {
+			ch := wakeChan.get()
+			waiting <- struct{}{}
+			<-ch
+			woken <- struct{}{}
+		}()
+	}
+	<-waiting
+	<-waiting
+
+	// The workers waiting on the old channel are woken, and the new one buffers a wakeup per worker
+	wakeChan.resize(4)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-woken:
+		case <-time.After(time.Second):
+			t.Fatal("waiting worker not woken")
+		}
+	}
+	for i := 0; i < 5; i++ {
+		wakeChan.wakeOne()
+	}
+	assert.Equal(t, 4, len(wakeChan.get()))
+
+	wakeChan.resize(1)
+	assert.Equal(t, 1, cap(wakeChan.get()))
+	assert.Equal(t, 0, len(wakeChan.get()))
+}
+
+func TestWorkerPoolBlockingFetchSetConcurrency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	running := make(chan string, 10)
+	release := make(chan struct{})
+	wp := NewWorkerPoolWithOptions(TestContext{}, 1, ns, pool, WorkerPoolOptions{BlockingFetch: true, BlockingFetchPoll: time.Hour})
+	wp.Job("wat", func(job *Job) error {
+		running <- job.ID
+		<-release
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+	defer close(release)
+
+	wp.SetConcurrency(3)
+	assert.Equal(t, 3, cap(wp.wakeChan.get()))
+
+	// Let the workers go idle, then check that all of them are woken for a burst of jobs
+	time.Sleep(100 * time.Millisecond)
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.SetWakeup(true)
+	var ids []string
+	for i := 0; i < 3; i++ {
+		job, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, job.ID)
+	}
+	assert.ElementsMatch(t, ids, receiveJobIDs(t, running, len(ids)))
+}
+
+func TestWorkerPoolBlockingFetch(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	done := make(chan string, 10)
+	wp := NewWorkerPoolWithOptions(TestContext{}, 3, ns, pool, WorkerPoolOptions{BlockingFetch: true, BlockingFetchPoll: time.Hour})
+	wp.Job("wat", func(job *Job) error {
+		done <- job.ID
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	// Let the workers go idle, then check that jobs are picked up although nobody polls
+	time.Sleep(100 * time.Millisecond)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.SetWakeup(true)
+	var ids []string
+	for i := 0; i < 4; i++ {
+		job, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+		ids = append(ids, job.ID)
+	}
+	jobs, err := enqueuer.EnqueueBatch("wat", []map[string]interface{}{{"i": 4}, {"i": 5}})
+	assert.NoError(t, err)
+	for _, job := range jobs {
+		ids = append(ids, job.ID)
+	}
+	job, err := enqueuer.EnqueueUnique("wat", Q{"i": 6})
+	assert.NoError(t, err)
+	ids = append(ids, job.ID)
+	assert.ElementsMatch(t, ids, receiveJobIDs(t, done, len(ids)))
+
+	// Pausing still holds jobs back, and unpausing wakes the workers
+	client := NewClient(ns, pool)
+	client.SetWakeup(true)
+	assert.NoError(t, client.PauseJob("wat"))
+	job, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	select {
+	case <-done:
+		t.Fatal("paused job ran")
+	case <-time.After(200 * time.Millisecond):
+	}
+	assert.NoError(t, client.UnpauseJob("wat"))
+	assert.Equal(t, []string{job.ID}, receiveJobIDs(t, done, 1))
+}
+
+func TestWorkerPoolBlockingFetchDrain(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 20; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+
+	wp := NewWorkerPoolWithOptions(TestContext{}, 2, ns, pool, WorkerPoolOptions{BlockingFetch: true, BlockingFetchPoll: time.Hour})
+	wp.Job("wat", func(job *Job) error { return nil })
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.Nil(t, wp.wakeupListener)
+}
+
+func assertWoken(t *testing.T, wakeChan *wakeChannel, woken bool) {
+	t.Helper()
+
+	timeout := time.Second
+	if !woken {
+		timeout = 100 * time.Millisecond
+	}
+	select {
+	case <-wakeChan.get():
+		assert.True(t, woken, "unexpected wakeup")
+	case <-time.After(timeout):
+		assert.False(t, woken, "no wakeup")
+	}
+}
+
+func receiveJobIDs(t *testing.T, done chan string, n int) []string {
+	t.Helper()
+
+	var ids []string
+	for len(ids) < n {
+		select {
+		case id := <-done:
+			ids = append(ids, id)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("got %d of %d jobs", len(ids), n)
+		}
+	}
+	return ids
+}
@@ -89,7 +89,7 @@ func indexHtml() (*asset, error) {
 	return a, nil
 }
 
-var _workJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xfd\x0b\x77\xdb\x36\xb6\x28\x8e\x7f\x15\x89\xa7\x7f\x16\xa8\x60\x5a\x72\xd2\xb4\xa5\x82\x6a\x52\xc7\x9d\x66\x26\x69\x3c\x79\xb4\x73\x8e\xa2\xe3\x4b\x93\x90\xc4\x9a\x06\x34\x20\x64\xc7\x23\xe9\xbb\xff\x17\x36\x00\x12\xa4\x28\xc7\xe9\xcc\x3d\xbf\x39\x77\xcd\x6a\x57\x2c\xe2\xfd\xd8\xd8\xd8\x6f\xf4\xe7\x6b\x9e\xaa\x5c\x70\xc4\xf0\xc6\xfd\xee\x29\x24\xf0\x26\x9f\x23\x3e\x15\x33\x2c\x99\x5a\x4b\xde\xd3\xbf\x23\xf6\x71\x25\xa4\x2a\xc7\x37\x89\xec\x49\xaa\x93\xe8\x26\x8f\x05\x29\xe2\xfe\x88\xd8\xcc\x78\xb3\xdb\x8d\x6d\x25\xa6\x2b\xa5\x49\x51\x20\xe9\xea\x12\x49\xea\xdf\x0a\x13\x19\x15\xb4\x3f\xac\xd3\x76\xba\x6d\x4e\x37\xbb\xb1\x8a\xae\x29\x23\x2a\x4a\x29\x27\x2a\xca\x68\x3d\x54\xc2\x89\xc0\x1b\x15\x09\xfd\x13\x6f\xb7\xaf\x2f\x7f\x63\xa9\x8a\x32\x36\xcf\x39\x3b\x97\x62\xc5\xa4\xba\x83\x62\x9b\x54\xf0\x79\xbe\x58\xcb\xe4\xb2\x60\x30\x48\xbe\xbe\x66\xf6\x6b\x48\x16\x4c\xc5\x62\x87\x77\x44\x45\x9c\xfa\x4b\x61\x06\xc1\xc2\x90\x45\x17\x17\xac\x7c\x25\xb2\x75\xc1\x26\x55\x09\xbc\x71\x13\xd4\x9d\x26\xeb\x42\xed\xe2\x8e\xcc\x6a\x1d\x54\x94\x21\x4e\x82\x24\x20\x1c\x13\xae\xbb\x13\xfe\x74\x54\x55\xc5\xce\x64\x25\x85\x12\xea\x6e\xc5\xa2\x65\x52\xbe\xbe\xe5\x6e\x4e\x66\x2d\x75\x05\xdd\xc6\x8a\x06\x01\x51\x48\x45\x25\x7d\x7c\x82\x77\x68\xea\x37\x49\x38\xde\x30\xb7\xa8\x94\xa3\xc7\x8f\x31\xc2\x3b\xd2\x2e\x12\xac\x4b\xd6\x2b\x95\xcc\x53\x15\x8c\x1b\xe5\x1f\x7d\xaa\xb4\x5e\xa3\xe6\x34\xf4\xbe\x10\x49\x0a\x92\x90\x1c\x00\xa8\x6f\x97\x32\x1d\xe7\x73\x74\x23\xf2\xac\x37\xa4\x94\x2a\x9c\x52\xce\x6e\x7b\x67\x52\x0a\x89\x82\x57\x39\xcf\xe7\x39\xcb\x7a\xec\x63\xca\x56\x00\x80\x22\x4d\xd7\x52\xb2\x6c\xdc\xd3\x1d\xaa\x25\xeb\x71\xc1\x8f\xae\x5d\xc1\x8c\xdd\xf4\x18\xbf\xc9\xa5\xe0\xd7\x8c\xab\xde\x5c\x48\x28\x34\x5f\x17\x45\x8f\xe9\x56\x7b\xd7\xac\x2c\x93\x05\xeb\x25\x3c\xeb\x25\x59\x96\xeb\x66\x93\xa2\xb7\x64\xc5\x6a\xbe\x2e\x7a\xb7\x89\xe4\x39\x5f\x94\x51\x80\xc7\xac\x28\x19\x8c\xb2\xa4\x53\x6f\x02\x33\xb2\xa6\xc3\xb1\x3f\x52\x15\x49\xb6\x2a\x92\x94\xa1\xe3\xff\x5f\x79\xbc\x20\xfb\x3b\x5e\x4e\xd7\x83\xc1\x6c\x87\x31\x49\x23\x9e\x5c\x33\x1a\xbc\xe0\x37\x89\xcc\x13\xae\x7a\xbf\xe4\xa2\x48\x74\xf1\x60\xa7\x96\x52\xdc\xf6\xd2\x68\x2e\x93\x6b\x56\xbe\x13\xe7\x62\x45\x47\x24\xdd\xed\xbc\x1d\x10\x0f\x5a\x7d\x8e\x46\x98\x48\xca\xd1\x93\x6f\x71\x63\x8d\x05\x36\x9d\xd8\x35\x4e\x25\x4b\x14\x3b\x92\x2c\x49\xd5\x51\x5a\x24\x65\xd9\x4b\xc5\xba\xc8\x7a\x5c\xa8\xde\x3c\xe7\x19\xac\xdf\x1b\x9d\xdd\x13\x06\x06\x7b\x2f\xe6\xbd\x3b\xb1\xee\x25\x92\xf5\xd6\x65\xce\x17\xbd\x32\x95\xf9\x4a\xf5\x54\xb2\x28\x49\xef\x3a\xb9\x62\xbd\x72\x2d\xf5\xf6\x24\xca\x56\xcd\xcb\xde\x25\xd3\x45\x0b\x91\x64\x2c\xeb\x5d\xb2\xb9\x90\xac\xb7\xdf\xbb\x5e\x77\x3d\x83\x82\x22\xbd\xbe\x22\x3a\x15\xd7\x2b\xc1\x19\x57\x38\x5a\xaf\xb2\x44\x31\xe9\xad\x85\x44\x5e\x01\x22\xa2\xbc\xfc\x25\x29\xf2\xec\xac\x60\x7a\xfb\x49\xf1\x99\x90\x8a\x37\x9f\xb3\xd0\x15\x56\x14\x1a\x31\x38\x6c\xb8\x2e\x0a\x4a\xd9\x76\xbb\x8e\x92\xd6\x70\x10\xc3\xbb\xaa\x8e\xf4\xea\xe8\xfa\xdb\xed\x33\x29\x93\xbb\x28\x2f\xe1\x2f\x62\x58\xa3\x18\x76\xc3\xe4\x1d\x12\x5e\xbd\xa2\x81\x15\x32\xb4\xd9\x11\x38\xf5\x55\x81\xc4\x61\x29\x45\x59\xa4\x31\x05\xe1\xb4\x40\xca\xa1\x23\x8d\x30\x4a\xc2\x34\x22\x59\x95\x00\x19\x3c\x4a\x97\x79\x91\x49\xc6\x4d\x3d\x41\x73\x2f\x8d\x70\x3c\x16\x51\xc1\xf8\x42\x2d\xc3\xd0\x65\xbc\x11\x6b\xc5\x4a\x2a\x30\xc9\x58\xc1\x14\xeb\xd5\x15\x76\x6e\x21\xea\x21\xe5\x66\xcc\x06\x75\x4e\x67\x0e\xf7\xe9\x15\x3a\xb5\xb5\xa2\xb9\x90\x67\x49\xba\x44\x8c\xf8\xd8\x36\x9f\xa3\xce\x75\xc4\xf9\x1c\x99\xd9\x45\x06\x84\x60\x40\x3f\x4a\x71\x0d\xe0\x66\x0b\xba\xf9\x7c\xba\x24\x0c\x70\x2c\xc2\x90\x47\xab\x75\xb9\xd4\x2b\xae\x0f\x7f\xcf\x7e\xea\x35\xc5\x3b\x8d\xa0\xab\x39\xa5\xde\xfe\xe9\xbd\x9c\x30\x9a\x23\x86\x63\x16\x86\xfd\xfd\x9d\x44\x8c\x4e\xd9\x0c\x13\xb6\xd3\xb7\x96\x24\x2a\x4a\x68\x42\x54\x74\x49\xd3\xb1\x41\x30\x70\x5e\xd7\x94\x47\x1c\x95\x98\x64\xd4\xe2\xfb\xa4\x2c\xf3\x05\xdf\x6e\x1b\xb7\xb1\x90\xc8\x6c\xf0\x68\xac\x9e\x26\x72\xb1\xd6\x53\x28\xed\x26\x8d\xd5\x60\xe0\xd6\xba\xca\x9b\xaa\xd9\xd8\x55\x13\xbd\x9c\xf7\x38\x7e\xd0\x85\xa2\xaf\x53\x3d\x7a\x7d\x9f\xc3\x9d\xbf\xab\x6e\xb0\x4f\x1c\x0e\xe5\x5d\x8f\xfa\x1a\x57\x76\xf9\xcf\x13\xb5\xa4\x2a\x5a\x25\xb2\x74\xbf\x17\x4c\xfd\x65\xcd\xe4\xdd\x5b\x25\x73\xbe\xf8\x25\x29\xd6\xb0\x3f\x36\x57\x37\xb8\xba\x27\x3f\xc9\xb2\x76\xee\x3b\x01\x79\x06\xe5\x55\xf8\xf0\xb1\xc6\x87\xc8\x5f\xc8\xd6\x2d\xbe\x43\x02\x93\xfb\x1a\x6c\x4f\xd8\xb4\x5c\x20\xa6\x5b\x16\xd1\x2a\x51\x4b\x8d\xdb\x49\x4e\x45\x54\xb2\x44\xa6\x4b\x92\x52\xa1\x17\x76\xe9\x60\x3e\x41\x1b\x57\x2c\x96\xc4\x14\x8a\xf3\x01\x3a\x1a\x51\x4a\xf3\x28\xe7\x19\xfb\xf8\x7a\x8e\x82\x49\x80\x27\xc1\x24\x88\x83\x30\xc0\x03\x35\x08\x68\x30\xe0\x44\x37\x14\xa7\x86\x2a\xb9\x7f\x59\x9a\x24\x84\x01\x06\x18\xa7\xa0\xbc\x1e\xa7\xa4\xdc\x8d\x33\xa7\xfc\xe0\x38\x85\x1b\xa7\xac\xae\x39\x8d\x9a\xdf\xb0\xc5\xd9\xc7\x15\x0a\xd0\x74\x12\xce\x70\x00\xa3\x9c\x26\x47\x7f\x7f\x76\xf4\x5f\xc3\xa3\xef\x66\x03\x14\x4e\x70\x80\xf7\xa0\xc4\x74\x10\x4c\x02\x7d\xdf\x4f\x54\xcc\x77\xd8\x4c\x2c\x37\x13\xbb\x0f\x1a\xee\x9d\x96\x9d\x89\xde\x89\xeb\x44\xa5\xcb\xc6\x20\xf5\x18\xcd\x10\x91\x3f\x46\x1c\x60\xec\xa6\x2c\xc3\x50\x4e\x47\xb3\x1d\x69\x93\x7b\x1a\x91\x9a\x16\x8f\xff\x1b\x2d\x95\x5a\x95\x93\x18\x4f\x3e\x1c\x7f\x38\x9e\xfe\xf7\x87\xe3\xd9\x57\xc7\x55\x13\x06\xf9\xab\x09\x8b\x59\x54\xae\x2f\x4b\x98\x02\x52\xd3\xe1\xcc\x1e\x4e\x8d\x44\x8a\x06\xf8\xef\x77\xa6\xb1\x09\xe1\x9a\x82\x13\xfa\x1f\x5d\xbc\x02\x8b\xff\x08\xf0\xf8\x68\xd4\xa7\xb4\x08\x43\x24\xf4\xe9\xa8\x7a\x29\x30\x51\x8d\x84\x21\x29\xb0\xb9\x4b\x13\xbf\x89\x49\xe0\x86\x0b\x0d\x25\x1a\xa9\x37\xea\x25\xfb\x0d\x25\x18\x93\x00\x36\x2c\x0c\x91\xa2\xc1\x71\x80\x49\x0d\x1f\xca\xc1\x87\x85\x50\xb1\xdb\x11\xdd\xa5\x77\xe0\x5b\x38\xbd\xba\x24\x03\xd3\x45\x40\xa9\x46\x41\x62\xde\x63\x8e\xab\x60\x63\xb7\xf4\x97\x49\xc9\x00\x5c\x39\x65\x35\xec\x6a\xac\x5e\xed\x38\x20\xaf\x25\x29\x28\x52\xdb\x6d\x10\xe0\x01\x77\x3b\x22\xc2\x30\x98\x04\x7d\x4a\x45\x18\xa2\x62\xa0\x6f\x2c\x69\x7e\x49\x4c\x8a\x0e\x24\xe6\xb0\xc5\x93\x11\x1e\xef\x8d\x4e\xc0\xaa\x4f\xa7\x2c\xca\x89\x20\x41\x30\x9b\x61\xcb\xf1\x6c\x96\xd7\x32\xee\x0f\x77\x63\x19\x29\x99\xf0\x72\x2e\xe4\xb5\x43\x40\x1c\x3d\x1a\x62\x24\x88\x84\x9b\x54\xa4\x49\x51\x6a\x9c\x5a\xd3\x19\x36\xf1\x53\xc4\x4a\x37\x33\xa3\x48\x50\xa3\xb0\x80\x6c\x6e\xf4\x89\xd1\x43\xd1\xb8\xcc\x5e\xfa\x3e\x85\xe3\xae\x01\x46\xdb\x37\x07\x51\xd4\xdd\x58\x84\xd3\xe1\x98\x3f\x65\x63\x3e\x18\x60\x35\xe5\x33\xef\x2a\xe1\xb3\x9a\x79\xf9\x4d\xe4\x1c\x05\xbd\x00\x7f\xea\x3a\xa8\x91\xf0\x58\x5f\x71\xe2\x53\x73\xed\xa4\xad\x58\x4d\x66\x4f\xa3\xaf\x06\x93\xff\xfe\x62\xb3\x43\x78\x3b\xfd\x30\xfb\xf0\x61\x76\xbc\x20\xc1\x87\x0f\x5f\x84\x41\x9b\xc8\xaa\xaf\xcb\x20\x20\x9a\xfe\x20\x52\xff\x53\xd8\xed\x21\x09\x1d\x92\x9c\x1e\xc7\x0e\x31\x5c\x7c\x31\xab\x51\xc4\xc5\x17\xb3\xaf\xf0\xf6\xc3\x57\x1f\xbe\xda\xea\xff\xd1\xf6\x03\xde\x7e\xf8\xa0\xff\x7e\xf8\x80\x8f\x17\xe3\x82\xe6\x11\xfb\xc8\x34\x35\x30\xc6\x85\x39\x65\xf6\x54\x49\x43\x3b\xb0\xa8\x2c\xf2\x94\xa1\x84\xd8\x6c\x8c\x89\x1a\x50\xd1\x95\x81\x49\x31\x1d\xcd\x26\x48\x0d\x68\x80\xa6\xff\x7d\xac\x11\x14\xb1\x34\x88\xce\xc1\x38\x0e\xbe\xfa\x4a\x1f\xc4\x62\x3a\x74\xe5\xa2\xaf\xea\x42\x41\xb9\x2a\x12\x15\x40\xb9\xbd\x62\x93\xce\x72\xa8\x2a\x07\xc5\x26\x71\x10\x07\xb8\x91\x86\xf5\x3d\xf4\xe1\x43\xb3\xa0\xfe\xd6\xa9\x55\x51\x8d\x19\x20\x5d\x23\x7d\xe9\xc6\x3c\x9c\x61\x92\xd0\x3c\x2a\x92\x52\xbd\xd0\x93\xac\x2e\x98\x3e\xa5\xac\xa6\x2b\xf7\x16\xcb\xe5\xed\xaf\x56\x9d\x63\x90\x90\x62\x92\xc7\x8c\x48\xb6\x60\x1f\x57\x6f\xc5\x5a\xa6\x1a\x25\xad\x12\x99\x5c\xff\xac\xb9\xa8\x98\x13\x25\xae\x18\x2f\x63\xb9\x6b\xd0\xd1\x35\x15\x3d\x65\xb3\xed\x16\xe9\x3f\x80\x7d\x31\xd1\x3f\x1b\x24\xb5\xbe\x6f\x82\xe3\x00\x06\x9d\x2e\x13\xf9\x4c\xa1\xa1\xa1\xeb\x82\xe3\x60\xc0\x0c\x1a\xf0\x2e\x23\x7f\x34\x70\xcf\xd6\xe3\x21\x09\xe5\x91\x19\xd1\xb8\xd5\xa4\x9b\xda\xd1\x48\xb7\x2d\x06\x34\x38\x9e\x04\x98\x98\xbd\x4c\xa6\x49\x95\x3d\xb3\xd9\x5f\x58\x6e\x29\xa7\xaa\xe3\xd2\xfb\xef\x60\x20\x48\x90\xeb\x3b\xae\x42\xba\x39\xf6\xee\x2a\xa8\x9b\xd2\x7c\x3a\x9c\x91\xb2\xc2\xf9\x28\x75\x2b\xac\xab\x95\x80\xb1\xcd\x40\x53\x37\xd0\xb4\x1e\x68\xa3\xbd\x12\x96\xa3\xb4\x54\xe2\x46\xb2\xeb\x24\xd7\xec\xf4\xb9\xbb\x2b\x4a\x7f\x63\xa4\xf9\x80\x3b\xbe\x8c\x73\xbb\xc3\x23\x1c\x5d\x27\xab\x06\xa1\xe6\xce\x7f\x18\x66\x2c\x15\x19\x7b\xff\xe6\x45\xc5\xf5\x69\xae\x0a\xef\x1a\xcc\x46\x55\x41\x6f\x87\xb7\xf2\x0d\xea\x5d\xef\xa8\xa2\x6a\xbb\xdd\xec\x2a\xd2\xd8\xdb\x40\xb3\x41\x44\x52\x8d\x20\x82\x80\xe4\x74\x48\x52\x8d\x37\x4a\x87\x37\x32\xf7\x63\xee\x7e\xac\xe8\x90\x5c\x52\xc7\x2d\x8d\x57\x4f\x2f\xc7\x83\xc1\x4a\xf3\x2a\x66\x07\x51\x49\xc5\x74\x35\xc3\xdb\xad\x3d\xc5\x25\x9e\xd3\x26\xb3\xa0\x22\x38\x99\x78\x62\x7f\x4c\xf3\xc1\x60\x16\xdb\x0f\xa2\xd7\xb8\x4f\xe7\xdb\xad\xfc\x7e\xb8\xdd\xae\x11\x46\xfd\x11\x76\xa9\x61\x88\x92\x01\x65\xdc\x2e\x10\x9a\x63\x23\xc1\xe8\xe9\xfe\x91\xe9\x2e\x9d\xca\x99\x9e\x8d\x1c\xd0\x51\x9d\x89\x4d\x26\xdc\x7d\x4b\x9a\x46\x2b\xb1\x42\x78\x2c\x8f\xe8\x88\xc8\x49\x3a\x95\x47\xa3\xd9\x80\x2e\xe3\x64\x40\x97\xbb\xaa\x92\x45\x08\x25\x4e\x34\xda\x08\xc6\x5e\x06\xae\x33\xb0\x97\x11\x43\x72\x7d\x7c\xf4\xc2\x64\xb4\xf4\x28\x8d\x11\x26\x73\xaa\xa6\xd9\xec\x9e\x99\x52\x3a\x07\x88\x94\x78\x63\x86\x46\x83\xa0\xda\xc0\x6b\x2a\x2a\x4a\xa7\xc4\x64\xa1\x09\x71\x00\xaa\x6b\xe2\xb6\x05\x93\x0b\x7a\x34\x22\x37\x74\x38\xbe\x79\xba\x70\x7b\x75\x33\x18\x60\xb7\x14\x8b\xe9\xcd\x0c\x6f\x2e\xe8\xcd\xf8\x52\xb2\xe4\x6a\x77\xe1\x0f\x61\x45\xaf\x07\x17\x47\xa3\x9d\x59\x88\x7a\x79\xaa\x75\xaf\x01\x73\x8e\xe3\xe4\x40\xc6\xb8\x55\xbb\xd4\x25\xcb\x8a\x12\x7d\x4a\xbd\x1e\x93\xfa\xd6\xfb\x70\x3c\xd0\xd7\xdc\x71\x80\x81\x8f\x34\x1c\x64\x0e\xdc\x64\xcd\x47\x9e\x74\xf1\x91\x86\x16\x03\x1c\xf0\xfb\xae\xde\x96\xc0\x93\xc5\x1b\x4b\x5b\xc4\x6c\xb7\xdb\x67\xf6\x34\x45\xa3\x1b\x29\x9f\x49\x76\xf6\xb7\x75\x52\x00\x27\x97\x28\xe6\x27\x98\x41\xbd\xb4\x45\xab\x04\x20\xff\x7d\xee\x4d\xd2\xc0\x0d\xaa\xa6\xc5\xde\xde\x5d\x5f\x8a\x22\x0c\x83\x12\x7e\xb4\x33\xa2\x5c\x31\x99\x28\x21\x27\x1d\x88\xc4\x11\x9b\x9e\x6c\xb6\x31\xd5\x7b\xba\x63\x51\x2a\x78\xa9\xe4\x3a\x55\x42\x52\x4a\xab\xf4\xbe\xfb\x5d\xb3\xd4\x13\x37\xb6\xb8\xea\x90\x14\xff\xfa\x9c\xbd\xbe\xa0\x34\x14\xe5\x54\x68\x66\x20\x35\xbc\x73\x49\x91\x40\x29\x26\x1c\x3d\xc1\x00\x61\x68\xf4\x44\x03\x18\x6a\x6e\x5b\xc7\x82\x16\xa8\x03\x08\x09\x03\x6e\xaf\x05\x02\x1e\x9d\xda\x4d\xa3\x7e\x3f\x0c\x43\x03\x19\x9a\xca\xaa\x16\x61\x38\x9b\xf8\x1f\x71\x70\x1c\x10\xb5\x5f\x79\xd4\x59\x79\xe4\x57\x1e\xcd\xe2\x75\x74\xfe\xfa\x9c\xf0\xfd\xea\x27\x9d\xd5\x4f\xfc\xea\x27\xb3\x58\x4f\x4f\xb3\x6e\x7b\xac\xcd\x04\x0d\x49\x59\xb3\x7e\x18\xc4\x45\xf6\xcc\x7b\xdc\x76\xc5\xe3\x6c\xb7\x7a\x1a\x96\xb7\x72\x12\x05\xcd\xdf\x58\x36\x0b\x98\x1e\xf8\x86\x93\xa5\x8b\xe8\xbf\x24\x81\x25\x8c\x15\xb9\x62\x77\x31\xdf\x35\x19\x5a\xcb\x7e\x4f\x8d\x2c\xb7\xf7\x3c\x51\x6c\xa6\xf1\xf2\x1e\xd0\x28\x61\x38\x70\xab\x59\xd0\x9c\xeb\x7c\xff\x10\x57\xc8\x82\x21\xc0\x24\xf9\x1c\x29\x4a\x29\xb7\x54\x41\xdf\x89\x5f\x6a\x11\xff\x24\x58\x73\xc3\xc2\x64\x41\x2c\x91\x02\x22\x43\xf4\x29\xad\x45\xd4\xbc\x55\x86\x63\x47\x65\xf4\x47\xba\xb4\x7f\x3c\x81\xb1\x1b\x92\xdc\xb1\x3a\x06\x67\x06\x66\x7a\x90\x0f\x83\xca\x90\xc2\x61\x98\x21\x8e\xbb\x8a\xb7\x24\x76\x0a\x1b\xf0\xab\xce\xea\x15\xbb\x2b\x91\xd2\x94\xac\x9f\xc0\x2b\xbe\xbf\xb0\x10\xa2\xa9\xb4\x8a\xa6\x2d\xac\xf4\xb6\x5a\x7d\x51\xa3\x18\xa4\xa6\x62\x46\xcc\xb9\xab\x4e\x5e\x73\x10\x7a\xa4\xaa\x6e\x97\x57\xed\xaa\x76\xbb\x8a\x34\x5a\x6e\x35\xdb\x1f\xed\xc6\x5d\xf8\xb8\x53\xcb\xc4\xf4\xcc\xf4\x2e\xe9\xbf\x1a\xd9\x39\x58\x84\x34\xf7\xa1\x33\x0c\x34\x42\xb2\xf9\xa9\x13\x35\x40\x42\x92\xfe\x11\x86\x73\x4d\xb5\x03\x48\x5a\xb8\xf9\x24\x9b\xe8\xdf\x3d\xc4\x01\x14\x9b\xaa\x5a\xd9\x58\x6b\x87\x9e\x06\x03\x3e\xf8\xf2\xfb\x5e\xb9\xac\xf4\x15\xcb\xe4\x86\xf5\x92\x5e\xf0\xe5\x40\x0d\xbe\x0c\x7a\x2b\x29\x56\x5f\x9a\xab\x52\x10\x1e\x65\x9a\x57\x4e\x82\x0e\xed\x4c\xb1\xc3\x2e\xff\xb2\x2b\x3f\xa9\xf3\xb3\xae\xfc\x74\xe7\xe4\x00\x1c\x0d\x31\x29\x28\xd2\xf7\xaf\xc4\xc4\x80\x0b\x92\x51\xb9\x4c\x56\x0c\xa3\x4d\x91\x97\x8a\xf1\x58\x46\xba\x91\x28\x2f\xdf\xb0\xbf\xad\x73\xc9\x32\xa2\xd9\x9f\x8e\x64\x7b\xf5\x77\xe4\x2c\x44\x67\xe2\x0f\x49\x7a\xd5\x99\xf1\xa3\x90\xb7\x89\xcc\xf6\xf3\x76\xde\x30\x05\x67\xaf\xe7\xef\xee\xf4\x50\xa7\xa6\x20\x91\x91\x41\x64\x33\x5c\xc3\x7f\xab\x64\x41\x64\x64\x0e\xdc\x4c\xdf\x1a\x07\x5a\x33\x25\x88\x8c\x98\x91\xb9\xcf\xf4\xd5\xd2\x59\x36\xaf\x07\x94\xe8\xb3\xf0\x7a\x8e\x51\x8e\x67\x9f\x47\xb8\x54\x1c\x0f\x65\xdb\x6d\x85\x5a\x98\xd5\x7e\x69\x38\xd2\xbd\x59\x58\x6a\xdc\xc9\xbd\x34\xe1\x1a\x9a\x2e\x59\x4f\xe3\x3e\x96\xf5\x6e\x73\xb5\x04\x06\xa7\x27\x64\xaf\x46\x4d\xd5\xf9\xb7\xc3\x65\x78\x67\xa0\xc0\x36\xb7\x60\xca\xbb\x77\x0d\x75\x50\xd6\x14\xc0\xa1\x0b\xba\x46\x33\x75\x89\x95\xcd\x7b\x51\x9e\x55\x7a\x6a\x4f\x55\xe5\x81\xa4\x92\x77\xa0\x60\x6d\x4c\xa9\x46\x9f\xe6\x5a\xd5\xd3\x37\xe8\x1d\x05\xc9\x65\x1a\x00\x12\x66\xd3\xaf\x67\x34\xc8\x58\x40\x82\xaf\xbd\x2b\xa1\x39\x0b\x60\xa4\x10\xc3\x9a\xb3\xaf\x1a\xad\xe9\x96\xcd\xce\x8a\x8e\x46\x43\x2b\x3b\x0a\x2e\x82\x81\xbd\x49\xe6\x52\x5c\x9f\x2e\x13\x79\x2a\x32\x86\x38\x9e\x51\x0e\xd8\x7c\x38\x3a\x79\xf4\xf8\xeb\x27\xdf\x7c\xfb\x9d\xe6\x31\xef\xe9\x55\x1d\xe4\x0c\x95\xe6\xd7\xb1\x15\x47\x05\xb8\x39\x5d\x41\x37\x4e\xdf\xae\x27\x9b\xb1\xf9\x62\x99\xff\x76\x55\x5c\x73\xb1\xfa\x9b\x2c\x55\xa0\xb9\xab\x5c\xe9\x8a\x95\x1a\xca\xef\x43\x4c\xd9\x8c\xb2\x1d\x26\xdd\xb5\xeb\x95\x82\x5b\xa1\xb1\xee\x68\xb3\x23\x02\xd7\x03\xdb\xa5\xc0\xa4\x57\x03\xef\x8f\x76\x3b\x84\x27\x8d\x3a\x71\x13\x33\x57\xbc\x29\xc9\x49\x4a\x35\x68\x93\x92\x8e\xc6\xe5\x3e\x79\x58\x6a\xf2\x90\xbb\x23\x55\xd3\x24\xe5\x0c\x57\x3b\xb4\x36\x24\x62\xe1\x88\xc0\xb5\xbe\x0f\xd3\xe9\x5a\x13\x81\xeb\x19\x80\x81\xc4\x9b\x9c\xea\x4b\xb7\xaa\x94\xd1\xe1\x38\x7b\x9a\xbb\x7e\xb2\xc1\x00\x27\xae\x81\x7c\x9a\xcd\x4c\x1b\xfa\x97\x6e\x06\xfe\xe2\xdd\xae\xc2\x8d\xbf\x87\xdf\xe8\xb2\x99\xb0\x87\xab\xa1\xad\x95\x91\x5a\x26\xea\x0d\x14\x29\xa9\x20\x8d\xef\x1f\x93\xa2\x64\x54\xc0\x15\xdf\xc8\x78\x27\xd7\x90\x3e\x6c\xa5\xff\xac\x11\x86\xb0\x04\x6a\xb3\xc6\x32\x6f\x9c\x32\x07\x77\xcb\xbc\xdc\x35\x4b\x3e\xb3\xeb\xde\x45\x09\xb3\x1d\xf1\x74\xd7\xff\x7c\x3e\xcc\x17\x7c\x6a\xd8\xd1\x78\x00\xb1\x5e\xce\x4b\x95\xf0\x54\x13\xa1\x0a\x77\x23\xc0\x53\x83\xf2\xf4\xa6\xf6\x92\x9e\xb1\x03\x48\xca\x5e\x52\x6d\x44\xb0\xaf\x83\x36\x66\x1c\x75\x73\x6f\xd8\x9c\x49\xc6\x53\xd7\xa6\x5e\x9c\xde\x32\x29\xf9\x97\x1a\x97\x32\xde\xcb\x79\xae\xf2\xa4\xc8\x4b\x96\xf5\x8e\x7a\xe5\x7a\xc5\x24\xc2\x8d\x12\x06\xdf\x56\x88\xb5\xaf\xb6\x5b\x47\xca\xf5\x1d\x1d\xad\x7c\xfe\xac\x4e\x9d\xb0\x58\xed\x89\xec\x1a\xc4\xa2\xdf\x82\x91\x2f\x50\xd5\xbd\x1a\x6f\xf5\xd0\x7a\xec\xe3\x4a\xb2\xb2\xd4\xcd\x5d\xaf\x4b\xd5\x63\xb9\x5a\x32\xa9\xaf\x05\x77\x15\xd4\xcb\x43\x80\xfe\x08\x06\xae\x07\x3c\x66\x35\xf6\x6e\x31\xe1\x4a\xd3\x70\x55\x26\x58\x22\x39\x86\x32\xb6\xe2\x7b\xd6\x30\x47\x1a\x91\x5b\x99\x2b\x67\x9a\xd4\x34\x5c\x1a\xee\x76\x98\xa8\x30\x74\x78\xa7\x64\xea\xdc\x35\xfd\x7a\x3e\xe9\x4c\x85\xb5\x89\x35\x1c\xc1\x28\x2e\x2e\xa8\xc2\xbb\xcf\xd5\x2e\x58\xb9\xa3\xaf\x59\xa8\x79\x81\x26\xe6\x82\x0b\xc1\xd1\xb2\x70\x2d\x58\x35\x8b\x9a\xf2\xd9\x58\x44\xf5\x5c\xa9\xff\xb1\xdd\xf6\x47\x44\x44\xfe\x7c\x69\x7f\x48\x02\x18\x43\x90\x73\xa3\x8e\x89\xdc\xda\x50\x7d\x9c\x0f\x19\x7c\x09\x8d\x9c\x89\xa8\xd1\x92\x47\x3d\x73\x8f\x7e\xe6\x61\xc8\x90\xbf\x3b\x1c\x13\x01\x69\x44\x60\xa2\x34\xb6\x06\x86\x78\xa4\xb1\x30\xf0\xc3\x6b\x43\xf3\x65\x54\xa0\xb5\x66\x91\x0e\xd8\xcd\x79\xc6\x02\xfa\x5c\x10\x85\x49\x61\x7e\x21\x55\x6f\x44\x65\xb1\xb6\x68\x6e\x98\xc2\x38\x4a\x56\xab\xe2\xce\x54\xa9\x70\x3b\xae\x98\x87\x04\x29\xc2\x30\xc9\x91\x22\xd3\x8d\x66\xfc\x02\xc9\x78\xc6\x64\x40\xcc\x96\xed\x71\xd6\x1a\xe4\x35\xf7\x87\x46\xec\xd1\x57\xba\x55\x63\x12\x12\xa9\xb2\x22\x6b\x4a\xc7\x25\x59\xc8\x75\xc6\x12\x81\xca\xaf\x35\x30\x64\x89\x62\xef\xf2\x6b\x16\x6b\x6e\xf1\xc5\xdb\xd7\x96\xa2\xc0\x1a\xcb\x35\x12\xac\x10\x6e\x48\x46\xdf\xe1\x5a\x9a\x75\x64\x65\x59\x55\x4a\xf0\x2e\x20\x41\x2f\xc0\x78\xb7\x9b\xe9\xc5\x46\xf5\x00\x6a\x2b\xa0\xf1\x1c\x06\xaa\x4f\x6b\x49\x37\xaa\x8c\xb3\xaa\x10\x5f\x5f\x5f\x32\xe9\x93\xb6\xbe\x0a\xec\xf3\x0c\x16\xc6\x2a\x3a\x7f\xff\xf6\x27\x1a\xe8\x7f\x03\xa2\xa2\x37\x67\xe7\x2f\x9f\x9d\x9e\xd1\xc0\xfe\xd0\x69\xe7\xaf\xcf\x69\x70\xfe\xfa\x3c\xf8\xec\xb6\x93\x2c\x3b\xbb\x61\x5c\xbd\x04\x7e\x80\xc9\x3d\xdb\x82\x8a\x21\x6b\x97\x9c\xec\x27\x01\x0c\xf7\x47\xfa\x48\x27\x4a\x25\xe9\x12\x72\x51\x20\x78\x30\xd0\x8d\xe9\x65\x90\xec\x5a\xdc\xb0\x07\xf6\xd9\x51\x78\xd2\x99\xea\xf5\x9c\xb1\x03\x3d\x97\xeb\x15\x5c\x78\x3f\xe5\xa5\x12\xbe\x84\xc8\x41\xe2\x6d\xce\x33\x71\x1b\xf1\xe4\x26\x5f\x24\x4a\xc8\x68\x5d\x32\xf9\x6c\xc1\xb8\xb2\x80\x68\xac\x23\x58\xad\xc3\x7e\xc6\x33\xa9\x29\xfa\x93\x28\xc0\x61\x78\x28\xf7\x71\x34\x0c\xf0\x76\xdb\xce\x7e\x25\x2e\xf3\x82\xf5\xde\x26\xf3\x44\xe6\xa6\x40\xbf\x51\xe0\x74\x29\xc5\x35\xeb\xca\xf9\x15\x46\x5a\xf6\xce\x97\x82\xb3\x00\x6b\xba\xc7\x0e\x7e\x69\x26\x17\x86\x81\x66\xe4\xde\x6a\x66\x57\x63\xa8\x66\x6e\x63\x39\xfe\x28\x7e\xcd\xd5\x52\xac\xd5\x1b\x56\x88\x24\x7b\x5f\xe6\x7c\xf1\x93\xe6\x9f\xf7\xa8\x0c\x98\xc0\xe1\x45\xaa\x87\xf7\x63\x2e\xd9\x5c\x7c\x0c\x1a\xfd\x9c\x8b\x15\xf0\xde\xaf\xb9\x6e\x3d\x5d\x26\x7c\xc1\xfe\x81\x3e\xde\xc9\x3c\x63\x5c\x99\x3e\xf2\xf2\xec\xa3\x92\x09\x67\x62\x5d\xf5\x03\x10\xd0\x45\xfc\xd4\x3c\x98\x11\x06\xd8\x8d\xbb\xb7\xb7\x53\x99\xbf\x7e\xfb\x50\xed\xf2\x3f\x6e\xbc\xf7\x6f\xea\xea\xdf\xd4\xd5\xbf\xa9\xab\x7f\x65\xea\x8a\xa3\x6f\x30\x59\x51\x81\xe6\x98\x5c\xfe\xeb\xd0\x5a\x46\x06\xf9\x76\x29\x6e\xf7\xe8\xad\x1a\xbb\x8c\x40\x14\xc5\x28\x05\x6a\x4b\x09\x95\x14\xe7\xc9\x82\x6d\xb7\xaf\x12\xb5\x8c\x92\xcb\x12\x79\x64\xd8\x2a\x59\xb0\x23\x86\x9f\xd2\xd1\x6e\x47\x3e\x41\xd0\xe5\x73\x34\x6c\xb7\xda\x50\xcb\xd7\x36\x3f\xd3\x19\x31\xca\xa5\x56\x71\xd0\x2d\x29\xdb\x48\x3d\x82\x09\x33\x76\x19\x07\x29\xc1\x22\x0f\xcc\xe8\x14\x01\xb4\xf6\x73\x72\xcd\xe2\x55\x55\x3a\x49\x55\x7e\xc3\x76\xe4\x60\xfd\x72\x95\xf0\x00\xf4\xba\x44\x61\x8c\x63\xe8\xbf\x5e\x4d\xa4\xf0\x67\x0c\xe1\x9e\x7e\x92\x80\x6c\x04\x3f\x2d\xf2\xf4\x2a\xf6\xe6\xf8\xdb\xfa\x7a\xf5\x4e\x20\xf0\x9a\xe8\xec\x1e\x2c\x31\xfe\xc1\x45\xc8\xf2\x52\x9f\xa0\xec\x61\xcb\x10\x44\x51\x80\xf1\xa7\x69\xf0\x75\x11\x90\x4d\x57\x77\xab\x64\x91\x73\x10\xf6\xef\x08\x48\xdc\x0d\xf4\x54\x5b\x1d\x80\x73\xcb\xbe\x2c\x03\xa0\x30\x65\x79\xe1\x83\x21\xd4\x3a\x15\x6b\xae\x8e\x7d\xd0\x60\x12\x60\xec\x5e\x3a\xfd\xd2\xa7\xd3\x35\x30\xdd\x47\xa9\x13\xdb\xe4\xbd\x65\xea\xc1\xdc\x5b\xcc\x6c\xaa\x57\xa4\x2d\xf0\xf6\xb8\x82\xcb\xcf\x56\x48\x78\x29\x70\xf2\x12\x8d\x35\x2b\xab\x1a\x4d\xe4\xa0\x92\x4e\x67\x1a\xb1\xa6\x89\x42\x46\xa9\x53\x0b\x72\x81\x13\x32\xc2\x33\x0f\xb3\xe0\x31\xef\xc6\x39\x3e\xd1\x61\x68\x82\x24\x0c\x51\xaa\xfb\xec\xe7\xd8\xdc\x02\xb9\x66\x29\x74\x7a\xf1\x94\x85\x61\x3a\xc6\x29\xed\x8f\x88\x32\x9d\x40\x7b\xc5\x60\x40\x24\x11\x20\xde\xcb\x75\x66\xe2\x8f\xb7\xd7\xe8\xbb\xc4\xe3\xe2\x7b\xaa\x1b\x0a\x43\x33\x39\x8e\x34\x47\x66\x14\x62\x43\x92\xe8\xfa\xd0\x08\x74\xe3\xcc\x61\xc6\x12\xe1\x16\x85\xd4\x5a\x2d\x9d\x22\xf0\x26\xd9\x6e\x91\x9a\xb8\x96\x15\xc6\x31\x2a\xa6\x6c\x46\x05\x41\x09\x1d\x0c\x72\x4a\xa9\xc4\x61\xc8\x41\x0a\x47\x0a\x8c\x9d\x50\xdd\x19\x47\x91\x82\x4e\x67\x63\x83\xf3\xa8\xac\xf0\x9c\x2b\x6f\x2d\x5e\x61\x90\xc3\x31\xeb\x90\xe8\xea\x71\x29\x70\x02\x6b\x4a\x5a\x05\xe2\xc6\x97\x42\xff\xa7\xa2\x84\x0a\xb0\xaf\xf8\x94\x9c\xce\x99\x36\x9e\x80\xbf\x0d\x18\x37\x92\xc2\x5c\x62\x09\x7c\x17\x98\xe4\x94\xa3\x47\x70\xb7\x45\x1c\xe5\xfa\x76\x83\x6b\x6d\x6d\x14\x45\x25\x26\x1c\x7d\xf3\x18\xeb\x7b\x8e\xa3\x93\x13\x73\xd1\x7d\xad\x2f\xba\x7f\x7d\x63\x81\xcb\xff\x87\x8c\x33\x96\x34\x45\x18\x6d\xb2\xbc\x5c\x15\x09\x28\x1d\xe2\x00\xfc\x45\xe4\xa9\xe0\x8a\x7d\x54\x01\xb9\xce\x3f\xe6\xbc\x8c\xa7\x56\xca\x9e\x45\x09\x46\x81\x84\x32\x01\x9e\x91\x0a\xe5\xc5\x1b\x93\x18\x97\x56\xf1\xe5\x63\x28\xa7\x8d\xed\xcc\x84\x7a\x65\x5c\x1a\xdd\x57\x03\x47\x26\x32\xb9\x2e\x3b\x2b\xa5\x0e\xef\x76\x56\x6c\x5c\x1d\x71\xb9\x8f\x10\x17\x4c\x3d\xf7\xfc\x83\xf6\x6f\x87\x4d\xb3\x89\x24\x4a\x9a\xf7\xd1\x6e\x47\xc0\x01\xc8\xae\xd3\x27\x97\x00\xba\x3c\xf5\x6a\x74\x74\x69\x6b\x7b\xf7\x8e\x49\xd1\x7d\x35\x46\xd3\xa9\xca\xb6\x36\xe6\x13\xfd\xd7\x6f\xa3\x79\x8d\x1a\x8f\x49\x43\x59\xed\xcb\xc8\x0c\xf5\xe8\x11\x45\x84\xd3\x5a\x97\x4e\x04\x55\x66\x48\xa5\x33\xea\x4f\xae\x4b\x92\x53\x15\xd5\xdb\x41\x52\x57\x48\xea\x53\xaf\xa9\x31\x3b\xc0\x3c\x0c\x51\x49\xf3\x48\xb2\x6c\x9d\xb2\x37\xf9\x62\xa9\x7c\x9d\xbe\x24\x89\xa7\xc4\xac\xf0\x9c\xb2\x9c\x81\x98\x26\x33\x52\x3a\x65\xcf\x5a\x83\x61\x4e\x0a\x8d\x41\x36\x15\x74\x71\x07\x31\x85\x01\xaa\x38\x27\x76\x4d\x53\xf3\xe3\xdc\x02\x94\x83\x39\xb1\xd3\x78\xd5\xb6\x39\x8f\x52\xac\xf1\x73\x56\xb9\x76\x51\x55\x19\xf3\x29\xec\xf0\xc8\x52\xe3\x11\xf5\x30\x3c\xa2\xc8\x52\xe3\x91\x6c\xba\x9c\x51\x35\x5d\x1a\x7d\x93\x67\xab\x51\x9b\x80\xc8\x86\x09\xc8\x25\x92\xd8\xda\x64\x5c\x53\xcf\x5e\x73\xa1\xfb\x96\x0f\xeb\x5b\x92\x85\xee\xfb\x7a\xba\x98\x51\xd6\x82\x02\x39\x5d\xcc\xc8\x0a\x01\xa1\xb4\xd8\x91\xcc\xa3\xbf\xae\x2b\x54\xd7\xae\x43\x32\xbc\x23\x25\x76\x56\x89\xb4\xd4\x1c\x95\xf9\x9b\xec\xfb\xac\x95\x78\xbb\x95\xd6\xa2\xaf\xdc\xed\xf0\x58\x5f\x30\xcb\xdf\x21\xa4\x08\xfe\xf0\x87\xd4\x1c\x99\xb7\xeb\xcb\x32\x95\xf9\x25\x93\xc7\xc1\x80\xb5\xac\xde\xe1\x66\x20\x9c\x48\xa3\x32\x2c\xa8\x1c\x04\xc7\x85\x15\xdb\x95\x01\x49\x20\x81\xdd\x30\x6e\xec\xb2\x03\x92\x43\x4a\xe9\x1a\x0d\x2a\xa7\x16\xba\xd9\x3f\xda\x08\x54\xbd\x6a\x2a\x67\xb4\x61\x67\xa0\xf0\x7d\x07\x1b\x4e\x55\xe5\x02\xae\x5b\x60\xba\x85\x4d\x3d\x0c\x38\xa9\x00\xda\x6e\x1c\x26\x25\x9f\xed\x08\xdb\xd5\x68\xee\xd7\xbc\x28\x5e\x01\x25\xe8\xab\xbe\x75\xc9\x62\x06\x4c\x8e\x69\x86\x0e\x5b\x75\xde\xb0\x94\xe5\x37\x6c\x0f\xcb\xd9\xf2\x83\x81\x57\xfe\x79\x9e\xbd\x07\x8f\xd3\x6e\xc4\x30\xb6\xdd\xed\x53\x18\x35\x12\x52\x88\x4d\x13\x30\xc6\xd9\x11\x3e\xcd\x67\x1d\xee\x3e\xd0\x94\xa7\x4e\xd4\x2d\x1a\x5b\x78\xec\x5b\x9a\x28\x3d\x31\x05\xdd\xe5\x85\x62\xb2\xb3\xb7\x3e\xa5\xcc\xf4\xd5\x32\x74\xef\x86\x86\xa4\x54\x6f\x00\xf3\x31\x23\x4f\xb6\x80\x60\x40\x63\x99\xf0\xac\x60\x76\x13\xcd\x3a\x38\x18\x59\xf3\x6e\x28\x39\x04\x20\x16\x2a\x5e\x18\x01\x15\x08\x47\x0f\x42\x05\xe0\x5a\xdb\xd2\x54\xce\x26\xc8\x82\x89\x9e\x7d\x33\x2b\xaa\xa1\x86\x30\x1c\x6f\x76\xcd\xad\xeb\x84\x0e\xaf\x7a\x18\x22\x0b\x59\x7b\x0d\x57\xb3\x43\x16\x2c\x30\x7e\x20\x18\x99\xa9\xec\xf5\x64\xd8\x4a\xa6\x60\xea\xe8\xa1\x53\x6a\x77\xfa\x9e\x5f\x77\x42\x7c\xee\xcd\x05\x61\xe2\x66\x65\x6c\x7d\x35\xdc\x25\xb3\xb6\x03\x96\x26\x88\xcc\xa0\xf4\x88\xa6\xc5\xcc\x82\xc8\xb8\x35\x52\xbb\x89\xc5\x8c\xea\x8b\xd2\x40\x96\x46\x5c\x12\x28\xe3\xc2\xd2\xda\x40\xcb\xe6\x40\xdb\x82\xbd\x68\x1e\x25\xc6\xe0\x09\x6d\xea\x63\xac\x13\xdb\x56\x49\xde\xb9\xd7\xd9\xfb\xfa\x9b\x4f\x99\xfc\xdc\x67\xb4\x25\x6b\xa3\xad\x43\x46\x5f\x15\xed\x3e\x04\xdf\x50\x4b\xbc\xdb\xfb\x4f\x54\x46\x5b\x60\x9b\x25\x0e\xda\x66\xed\xe7\x2c\x44\x67\x22\xd8\x66\x75\x65\x38\xdb\xac\xfd\xbc\x92\x29\x20\x40\x5f\xb2\xe4\x86\xfd\x24\x44\x57\xfd\xbc\x7c\x06\x62\x96\xfd\xac\x1d\xc6\x95\xb9\x91\x3f\x9f\xda\xd6\xd4\xd8\x76\x35\x7b\x74\xf6\xa6\xfb\x59\xd6\xcc\xd4\x5a\x72\x59\x3b\xd3\xae\x92\xfa\x22\x75\xe9\x9f\xdc\xc4\xb6\x76\xec\xf3\x1c\x76\xdb\x4d\x5b\x97\x6f\x30\x47\x1d\x3b\x9e\xf1\xe9\x89\xe6\x28\x05\x86\x7b\xd7\xf1\xfe\x9f\x62\xec\x0e\x8d\xeb\xdb\xaf\x6d\x64\x05\xc3\xe7\x9d\x3c\xd6\x8c\x5e\x37\xaf\x72\x8f\x36\xc1\xb1\x85\xa3\x27\x56\xe6\x09\x42\xcf\x7f\x96\x01\xb4\x3e\xb6\x94\x45\xfa\x2e\x5e\x4b\xc9\xb8\x72\x26\xd6\xe0\x45\xb9\x60\xea\x7d\x09\x4c\xcd\x3c\x97\xd7\x26\xbd\xa0\x46\xcc\x55\x15\x2c\x69\xe5\x6f\x57\xa5\xad\x75\x65\x41\x32\x0a\x86\xa2\x2f\x0d\x3f\xee\xf9\xbf\xd8\x1f\x97\xfa\x0a\x5e\xea\x7f\xae\xf5\x3f\x8b\x0e\xcb\x99\x55\x18\xae\x22\x03\x41\xe0\xf9\x7c\xfe\xfa\x7c\x72\x5d\x29\x7e\x56\xba\x79\x1c\xcf\xbd\xa4\xb9\x49\x3a\x1a\xed\xc8\x45\xc7\x35\xba\x40\x78\x3c\xa7\x8c\xcc\x1b\x8d\xbe\x7f\xfb\xd3\xe4\xda\x93\xc6\x5c\x57\x9a\x68\x35\x18\x61\x32\x85\x46\x67\x38\x6e\xd4\xb2\x0a\x5e\x20\x16\xd5\x8c\x9a\x8e\xc9\xb2\xd3\x36\xac\x32\xbb\x9d\xeb\x4b\x9e\xdc\x74\xc1\xc1\x65\xd7\x7d\xee\xf2\xe8\xe5\x83\x2e\xf4\x4e\xc3\xfa\xe5\x3d\x0d\x2f\xe9\xf2\x41\x0d\x7f\x6c\x19\x02\xa3\x21\x11\x51\x21\xc4\xea\x59\x79\xc7\x53\x8c\x2e\x9d\xd4\xa5\x2d\xd2\x47\x43\x92\xd4\x56\xd4\x97\x53\x35\x23\xac\xc3\xd2\xbc\x67\x74\x41\x6c\xa2\x09\x8f\x98\x23\xdc\x44\x07\x78\xc3\xc3\xb0\xc3\x54\x9e\xa3\xce\xc6\x14\xea\x83\x4a\x16\xef\x70\xec\xfd\xde\x91\xdb\xa6\x2e\x00\x8c\xbc\xd3\x7d\xab\x67\x8c\xe6\x84\xe1\xed\x76\x75\xb8\xc0\x0a\x0a\xa0\x15\x65\xe4\xa3\x3f\x08\xa3\xef\x5a\x81\x0d\x29\xfc\xb0\x42\x6b\xb8\x4e\xdb\x40\xe7\x24\x2f\x68\x48\xa4\xe7\x07\x8d\xd1\x1c\x13\xb1\x9f\xca\xf0\x58\x50\x4a\xb9\x1d\x55\xd3\xc6\x1e\xa3\xb9\xb5\xa2\xb6\x8a\x53\x10\xc5\xb8\x1e\x2b\x70\xc5\x3b\xd6\x3e\x4f\x17\xe0\x61\xb0\x77\x20\x60\xd9\x0a\x88\x4b\xb1\x5f\xa2\x02\x7e\x28\x55\x56\xa5\x2a\xc7\xaf\xb9\xc6\x6a\xcd\x8e\xcc\x6c\x13\xda\x3e\xac\x24\xf3\x92\x00\x63\x58\xef\xf5\x24\x0c\xe1\x6f\x16\x86\x6b\x94\x1c\x65\x78\xb7\xd3\xd4\xce\x55\x17\x90\xdf\xa2\x97\x88\x11\xbb\xac\x78\x47\xce\xee\x2b\xe4\xd6\x02\xef\xc8\x69\x07\xd2\x59\xa3\xa3\x11\xde\x91\xd7\x9d\x59\x3a\xe7\xbc\x23\x07\xe4\xe2\x32\xe1\x99\xb8\x46\xb8\x72\x8b\x40\x8f\x9e\x60\xe7\x2f\x79\x42\xb2\xed\xf6\x09\xde\x91\x57\x1d\x63\xeb\xd8\xec\x1d\x79\xd9\x19\xca\xe0\x77\x3a\x9c\x9c\x23\xc7\xad\x02\xf4\x34\x5d\x6a\xb0\xbd\xda\x9c\x05\xec\x66\xff\x4a\x88\x15\x31\x8c\xe1\x0f\x10\x94\x27\xbe\xb1\x9f\xf1\x1d\x01\x5f\x76\x08\x91\xf4\x4e\xc4\xb7\xc6\x48\xfd\xaa\x22\x7c\xce\x34\xa1\xb3\x76\x84\xcd\xa9\x47\xc8\xbc\xb6\x12\x9a\x3f\xb3\xbb\xf8\x9c\xd4\xb3\x8f\xfd\xa5\xb0\xe9\x3f\x49\x36\x8f\x5f\x91\xe6\xb0\xe3\x97\xbb\x9d\x77\x55\x97\x9f\x6d\x60\x93\x26\xfc\x7d\xc9\x9e\xbf\x7e\x45\xfb\xc8\x13\x25\x54\x28\xc6\xd8\x3a\x6c\xb7\x7d\x6b\xf4\x90\x89\x14\x56\x74\x3f\xa5\xc9\xf7\x7f\x26\x31\x43\x54\xb4\x00\x31\x51\xf3\x22\xa5\xaa\x71\xd7\x1a\xbf\x1a\x59\x5b\xe6\xa8\xae\x2b\xda\xa4\xb6\x36\xaf\x1d\xe5\x64\x64\xc3\x3e\x8d\xbe\x31\xd4\xc9\xe3\x21\x88\xa1\x35\xb1\xa2\xa9\x8d\x93\x27\x86\x40\xaf\x96\x27\x0c\xfb\x00\xa1\xf7\x99\x8b\x60\xd4\x20\x4d\xea\xb0\x18\x1a\x15\x5c\xb1\xbb\x1a\xfa\xc4\x1e\xf4\xd5\xc4\xa6\x5d\x56\x87\x72\xeb\x50\x0e\x96\xe2\x6c\xe7\xdb\xe8\x0e\xe0\xeb\xd4\xce\x83\x70\x0f\x86\x1c\x55\x13\x34\x24\x45\x24\x59\x92\x01\xd3\x82\x91\xc2\xb1\x59\x94\x1d\xb1\xf4\x88\xde\xb5\x75\xf7\xf2\xed\x11\x5c\x76\x3d\x95\xbc\xdb\x54\xa6\x49\xd6\x7e\xc7\xa0\xdf\xed\x76\xb3\xb3\x76\xdc\x0a\x6f\x34\x2f\xe7\x44\x44\x25\x9c\x6d\x70\x89\xeb\xda\xbe\x4e\x31\xa5\x72\x16\x44\xa9\x29\x0a\x91\x86\x48\x1b\x1e\xf6\xd7\xde\xbf\x97\x60\x03\x0f\xda\xe2\xe8\x05\xd9\x6e\x19\x2a\x91\xf3\xc2\xc1\xbb\x31\x54\x69\xdb\x91\x61\x3b\x16\x12\xac\x6c\x03\x01\x51\xce\xb5\x7d\x1f\x31\x32\xb4\x46\xb8\x42\x2c\xbd\x14\x6e\xaf\xfb\x5a\x5d\x56\x10\x05\xe1\xee\xbc\x16\xa1\x62\x87\x7d\x59\xf7\x88\x88\xeb\xea\xde\x1a\xcd\xde\x5a\x26\x44\x55\x20\x3f\x7b\xb5\x0a\x43\xd1\x8e\x2b\x74\x6b\xee\xe2\x22\x2a\x93\x1b\x66\xe1\x4a\xe8\x41\x2b\x23\x25\x14\x3b\x02\x04\x50\x13\xb1\x1b\xfe\xa2\x71\xb2\x3b\x2e\xab\xac\x41\xdb\x78\xb0\xd0\x82\xb6\xca\x92\x0c\x31\xa7\x21\xdf\x59\x5b\xbe\x26\x32\xf9\x07\xfa\xb0\x4d\x75\x76\xb3\x10\xb4\xc5\x7c\xb5\x2a\x2f\x34\x4d\xf7\xb9\x2e\x5d\x44\x98\x28\x7a\x24\x35\x6e\xdc\x48\x61\xe2\xa2\x01\x96\xad\x68\x80\xe5\xbf\x64\x34\xc0\xb5\x89\x06\x68\x67\x31\x23\x19\x1d\x8e\xcb\xcf\x8a\x06\xb8\x9e\x66\x36\x1a\x60\xf9\xe9\x68\x80\x65\x2b\x1a\x60\xb9\xe7\x08\xc1\xee\x8d\x5b\xe7\x29\x61\x9b\xa0\x3f\x1d\xcd\xc0\x7b\x54\x52\x36\x7d\x04\x1a\xd5\x7e\xad\x4e\xd5\x9f\xaa\x53\xd9\x76\xa9\x44\xe2\xfc\x23\x05\x92\x8e\xf6\x98\xf2\x8a\xcb\x92\x51\x09\x81\x2f\xca\x03\xee\x3a\xc1\xf1\x57\xff\xd1\x33\x45\xde\xbf\x79\x49\x83\x81\xab\xf0\x46\x08\x35\x60\x83\xa0\xf7\xd5\x71\xb0\xc3\xd8\xb5\x37\x2d\x66\xce\x85\xe6\x03\x0f\x9c\xf2\x51\x77\xe8\x25\x76\xca\xca\xeb\x8e\x5e\x25\xab\x55\xce\x17\xba\xbf\x2c\x51\x49\x9c\xac\x56\x45\x6e\xce\xcf\xf1\x6f\xa5\xe0\xe3\x74\x99\xc8\x92\x29\xba\x56\xf3\xa3\x6f\xc7\x97\x49\xc9\x9e\x3c\x26\xc1\x40\xcf\x16\xad\x39\x2b\xd3\x64\xc5\x50\x87\x33\xff\x9f\xde\xbe\xfe\xd9\x4a\x3a\xf2\xf9\x9d\x46\x02\x18\xdb\x19\x74\xf8\x67\x55\xf8\x7b\xea\x45\xd1\x71\x34\xe5\x01\x07\x93\xe6\x2a\xaa\x3a\x46\x91\x22\xac\xd2\x53\x28\x4d\x16\x06\x7f\xb8\x66\x59\x9e\xf4\x82\x81\xfe\x1c\x04\x9b\x60\x20\x06\xc1\x2e\x88\x85\xe7\x1c\x05\x86\x9b\xb4\xa9\x0e\xdf\xe7\xc0\x4c\xcc\xba\x29\x20\x04\x66\x43\x1d\x55\xaa\x63\xba\xd9\x11\x49\x87\x63\xf9\x14\xc6\x67\x35\xce\xd2\x69\x9c\x0b\x90\x2d\x4e\xe5\x6c\x3a\x9c\x8d\x03\x23\xd5\xab\x9b\x86\xd0\x55\xd3\x62\x46\xfb\x43\xbc\xd3\x4d\x9a\x96\xd8\x5e\x33\x09\x65\x53\xd9\xd1\x40\x02\xd1\x66\xc4\x54\xff\x9d\x6d\xb7\x88\x87\x61\x3f\x01\xaa\x78\x7a\x32\xa3\x3c\xd6\xa8\x1b\x7e\x06\x28\x18\x24\xb0\x10\x18\x0e\x38\x0a\x06\x7c\x10\xe0\x00\x13\x65\xe3\xfa\x81\x48\x53\x75\xa0\xb0\x26\xd6\x6a\x5b\xdb\xb1\x0e\x6b\x3b\x36\xe5\x33\x22\xe9\xe5\x54\x44\x79\x36\xb3\x8e\x54\xfa\x8a\x9a\x97\x83\x41\xb5\x74\x05\x1d\x8e\x8b\xa7\x32\x5a\x25\xb2\x56\xd5\x17\x83\x01\xb6\x49\xd3\x62\x86\x44\xf5\xd3\x2c\xf9\xb8\x78\x2a\x0e\x56\x30\x13\x59\x7b\x95\x40\x50\x0b\xc8\xca\x75\x9a\x98\x78\x47\xc3\xee\x86\x92\xee\x26\xc6\x76\x26\x74\x93\x67\xb1\xfe\x45\xf4\x54\xe2\x11\x81\x42\x71\xb2\xdb\xed\x5b\xc4\xd6\xab\x34\x9d\x11\x0f\x4a\xf6\xf7\xb6\x80\xbd\x85\x30\x61\xfa\xa8\x4d\x8a\xe9\x70\x36\x30\xbf\x63\xfd\x9b\xe4\xb4\x98\x8e\x66\x24\xa5\xc5\xf4\x64\x46\x4a\x5a\x4c\x1f\xcd\xc8\x9a\x6e\xd2\xb2\x8c\x73\x02\x60\x1e\xa7\xa4\x3a\xdb\x71\xb9\x1b\x8b\x69\x32\x9b\xe8\x7f\x1a\x2b\x83\x63\x17\xd3\x71\x9a\x98\xc9\x24\x76\x0a\xd3\xf5\xac\x76\xbe\xe6\x7b\x06\xb8\x66\x22\xd7\x88\x45\x39\x2f\x99\x54\x2f\xb8\x32\x16\x34\x7d\xee\xd9\xb5\x3a\x0b\x5f\xb1\x2e\x32\xfe\xa5\x0d\x99\x9a\xf4\x4a\x75\x57\xb0\x9e\x4a\xe4\x82\xa9\xa8\xf7\x6e\x99\x97\xbd\x95\x14\x97\xc9\x65\x71\xd7\xbb\x66\x09\x2f\x4d\x70\x54\x7d\x1b\x81\x39\x5f\x75\x37\x7d\x59\x77\xf6\x65\x0f\xd4\xb7\x4c\x31\xd9\xcb\xcb\x5e\xce\x6f\x92\x22\xcf\x5c\x80\x54\x41\x6f\xa6\x37\x75\x40\x20\xd0\xa4\x2a\xb1\x0a\xac\xb1\xbb\x6e\xe4\x99\xc2\x62\x22\x22\xce\x3e\xaa\xb7\xf9\x65\x91\xf3\xc5\x84\xdb\x2c\xc3\xea\x21\x45\x1a\xd9\x7a\xad\x92\xd5\x8a\xf1\x0c\x34\x78\x9a\x92\xde\xab\xc0\xa3\x79\x2e\x4b\xa3\xe2\xc3\xe4\xc6\xac\xad\xf2\x42\xcd\x5c\x0a\xa5\xc4\x75\x6b\x1c\xed\x76\xcd\x6d\xea\x69\x7f\x2b\xf3\xe1\xba\xd2\x76\xdb\xaf\x3f\x22\x13\x31\x76\x7f\xe5\xa7\x6f\x61\xa9\x5f\x8a\x24\x63\x72\xf6\x81\x7f\xe0\xbd\x17\x66\xa5\xbc\x95\xad\x17\xf2\x4b\xd7\xe0\x97\x3d\xf4\xa5\x00\x32\xa2\xac\x3a\xf9\x12\xf7\xe6\x62\xcd\xb3\xe8\x03\xef\xbd\x5a\x97\xe0\x02\xfc\xa5\x12\xab\x2f\x49\xef\x4b\x33\xad\x2f\x49\x4f\x48\x17\x8c\xf9\x03\xef\x99\x78\x80\xf1\xf1\xf1\x22\x57\xcb\xf5\x65\x94\x8a\xeb\xe3\x5b\x76\xb9\x4a\xd2\xab\xa3\x54\x70\x25\xf3\xcb\x63\x80\x85\x23\x08\x7c\x2b\xff\xc3\x74\x95\x28\xac\x2f\x2d\xeb\x34\xde\x84\xb1\x41\xd0\x0b\x06\xfb\x13\x1f\xef\xed\x84\xf4\xc3\x1e\x25\x2d\x9f\x67\x7d\x06\x18\x57\x3f\x8b\x8c\xd5\xde\xb0\x7e\xaa\x25\x9e\xcd\x86\xd8\x00\x56\x8a\xde\xd4\xf2\x1a\x3c\x56\xdf\xd3\x61\x18\xde\x80\x7b\x6c\xaa\x7b\x1c\xe1\x56\x9c\x25\x53\xa9\x9b\x53\x46\x01\x4c\xbc\xf6\x92\x06\xef\x14\x59\x42\x30\x56\x1a\x28\xf6\x51\x1d\xa7\x65\x19\x90\x52\x5f\x64\x26\x0f\x13\x73\xf8\x88\x6a\x45\x5a\xbd\xbf\xa3\x22\xe7\x57\x9f\xee\xc7\xa5\x4b\x56\x50\x33\xb6\x72\xc9\x98\xfa\xe4\x00\x4a\x83\x0f\x9a\xb1\x20\xf6\xa5\xc1\x10\x56\xbb\x64\xea\x99\xd2\xbb\xbe\x56\x0c\x71\xa2\xa6\x1c\xb4\xbf\x55\x5b\x6b\x0f\xb7\x98\x88\xd2\x40\x68\xd5\x41\x03\xc1\x88\xa9\x2c\xad\x77\x41\x41\xbd\x2c\x64\x72\xaa\x88\x5b\x1e\xb5\xa0\xc9\xbf\xb4\x2c\x69\xb1\x83\xd6\xca\x9c\x2f\x0a\xa6\x84\x55\x87\x94\xf4\x62\x30\x18\x73\xba\xd8\x6e\xd1\x82\xe6\x48\x61\x4c\x04\xcd\xa2\xcb\x9c\x67\xc6\x6c\x8e\x93\x92\x80\x97\xec\x7e\xea\xd0\x8a\xfe\x58\x54\x21\xdb\x4e\xb2\xf0\xfd\x9b\x97\x87\xd2\xed\x76\x99\x05\xbc\xaf\x9c\x64\x37\xe2\xea\x13\xe5\x7e\x28\xc4\xe5\x41\xba\x74\x82\x38\x4d\x35\x47\x21\xe8\xaa\x31\x11\xa5\xe7\xe6\x2d\x58\x82\x38\x26\x3c\x5a\x4a\x36\x0f\xc3\x8e\xae\x91\xc9\xc3\x3b\x1c\x23\x0e\x4b\x46\x04\x9d\xfb\x4d\x76\x34\xb8\xab\x40\x50\x34\x84\xf2\x46\x48\x6c\xff\x85\x7d\x82\x98\x6f\x65\x19\x86\x2a\x82\xab\x0c\x12\xe0\x97\x4e\xaa\x16\xda\x38\xcb\xb8\x2f\xbb\xef\x63\x81\x18\x55\x76\x57\x24\xf2\x91\x40\xe6\x58\x2c\xb3\xef\x92\xf2\x49\x10\xc4\x42\x77\x05\x0e\xfe\x11\x40\xfd\x5b\x0d\xf5\xd8\xff\xd0\x05\xde\xb1\x8f\x8a\x7e\x04\xb4\x52\xb3\x3a\x45\xfb\xc8\xe9\x52\x1a\x7b\x20\x89\x49\x02\x81\xeb\xf2\x22\xd3\x09\xe5\x38\x99\xaa\x99\x86\x5e\x1f\xaf\xe8\x34\x4c\x5c\x4c\x94\x09\x6b\xa2\xb0\x82\x40\x7e\xcc\x1a\x97\x43\xe1\xcf\x68\xee\xdf\xc5\x30\x4e\xb0\xba\x82\xa5\x82\xc0\x31\x26\x16\x89\x77\xe8\x02\xc8\x0b\x88\xc0\xe4\xd3\xf3\xe5\xe3\x8a\x50\x1a\x33\xef\x62\x1b\xe3\x16\x7e\xf4\x2f\xbd\x71\x73\xbc\x87\x56\x88\x63\x7f\x22\xab\x56\x80\x51\x98\x0a\x04\xe0\x75\xdb\x5b\x45\xa4\x84\x08\x2a\xa9\xe0\x37\x4c\xaa\x77\xe2\xd9\x65\x29\x8a\xb5\x62\xef\x65\x51\x86\xa1\x1c\xa3\x03\x79\xdb\x6d\x01\x41\x03\xe9\x1d\x12\xd8\x44\x39\x15\x03\x1a\x7c\xe0\x9f\xc1\x03\xfd\x6e\xae\x47\xd6\x5c\x8f\x33\xc0\xd5\x57\xb4\x3e\xab\x68\x2a\x66\x64\xa3\xcf\x68\x5c\xe3\xe2\x1d\x26\x39\x65\x70\xc4\xc6\xe6\x0f\xed\xc0\x13\x28\xc1\x24\xef\x3e\x9e\xb9\xb1\x0a\xbe\xd4\x34\x66\x47\x10\xdd\xf1\xc1\x30\x02\xf5\x12\x43\x28\x5b\x76\xc0\xd5\x03\x9c\x51\xf6\x6b\x1b\x01\x48\x18\xba\x3d\xaf\x7f\x45\x49\x51\x84\xa1\x93\x1d\x27\x4a\x5c\xee\x30\xb9\xee\x60\xfc\xaa\x58\x14\x3d\xff\xe6\x68\xc8\x3d\xf4\xa5\x51\x85\x2e\xaf\xad\xb8\xb9\x09\x95\xe4\x3b\x9c\xd9\xee\x7e\x7a\xf7\xea\xe5\x8b\x1f\x35\x89\xe3\xa4\xd4\x4a\xde\x6d\x04\x15\xc6\xb6\x84\xab\xe7\x6e\x94\x4b\x96\x64\x75\x10\x0a\x01\x4a\xac\x1d\x04\x78\x15\xbb\x8a\x93\xe4\x33\x7f\xf2\x9e\x50\xc9\xb5\xf2\x37\x08\xd0\xcc\x0a\x96\x2a\x21\x4d\x6c\xa8\x85\xd1\x87\x5d\xd0\x21\xb9\xd1\xd4\xff\x1d\xe5\xe8\xc9\x10\x77\xc5\x28\xa9\x7c\xc7\x6a\xb1\x7c\x45\xfd\x3d\x3f\xfb\xe1\xfd\x1f\xc3\xd0\xfe\xd9\x23\x0e\xdd\x08\xf6\xc9\xc0\x77\x4b\xd6\xf3\x09\x2d\x2f\x88\xcb\xba\x64\x59\x2f\xe7\xbd\x04\xa4\x43\x97\x52\xdc\x96\x4c\xfa\x82\xa1\x00\x8f\x6d\x24\x48\xa2\x0c\x19\x40\x6b\xa3\x44\xe7\x73\x66\x32\x26\xf6\x2f\x28\xd8\xeb\x7b\x76\xbb\xf5\x6f\x5d\xba\x44\x1a\x82\x3c\x9a\x0e\xf2\xeb\x4f\x1a\xe8\x7d\x08\xea\x32\x9a\xd8\x45\xf5\x07\x75\x44\xb4\x13\xbe\x1a\xfe\xaa\xbe\x5d\xe0\x42\xeb\x32\x06\x2f\xf4\xda\x27\x74\x38\x4e\x9e\xba\x88\x51\xe3\xc4\x31\x5d\x39\xe5\xd3\x44\xf3\x54\x97\xd3\x1c\x98\xd4\x14\xd8\xd3\xa3\x23\x52\x18\x32\x3e\xc5\x9a\x7e\xd0\x80\x81\xa4\x25\x83\x0c\x8b\x6e\x8e\xb4\x6e\xb5\xd8\x6b\x55\xb3\x68\x49\x65\x90\x6f\x9a\xac\x07\x54\xd2\xe1\xb8\x7c\x9a\x36\xd9\xce\x72\x30\xc0\x36\x69\x5a\xce\x10\x1e\xdb\x57\x04\x2e\xa7\xa9\x1e\x97\x66\x2d\x61\xe2\x1f\xf7\xc5\xf3\xb5\xdc\xc4\x57\x48\xd7\x32\xe9\xa9\x9a\x51\x4e\x98\xd3\x7d\xff\x20\x44\xc1\x12\xde\x10\x1f\xed\x3e\xf9\xdc\x49\x53\x96\x54\x19\xda\x6b\x20\x02\xe5\xef\x83\x1f\x63\xa9\xc3\xa0\x55\xf1\x5e\xf6\x78\xe6\x5a\x38\xe7\x4f\x44\x55\x46\xaa\x4e\xcc\xab\x69\x10\x6b\xca\x93\x47\x09\x06\x3d\xfc\x4d\x6d\x8c\x7c\xe3\x4c\x91\x6f\xac\x21\x72\xcb\x1d\x83\xe3\xcd\x59\x18\x9e\x55\x15\x34\x6d\x31\xc9\xd0\x19\xe1\x38\xb6\xcd\x96\xa6\x59\x5f\xe9\x0d\xcf\xe9\x4c\x74\xdf\xb1\x98\x64\x68\x0d\x01\x6d\x48\x6d\x62\xcc\x76\x58\x37\x60\xf4\xfa\x4d\x3a\xa4\x31\x35\x4e\xa4\x61\x4f\xb6\xdb\x4a\xc6\x28\x20\xd5\x86\xb5\x46\xa9\xee\xdc\xeb\x1a\xa8\x18\x3e\x51\x88\xe3\x58\x59\x9a\x4b\xff\x73\x43\xd7\xe6\xd1\x8b\x8d\x67\xf3\x2e\x76\xb8\x31\x00\xf3\xfe\xcf\x86\x4d\x14\x62\x75\xf5\x66\x78\x26\x54\xe8\x1e\x6f\x08\xd3\x94\x8c\x8c\x0a\x96\xdc\x98\xc7\x21\x4a\x92\x53\x19\x19\xd5\x81\x4d\x28\xa9\x8c\x18\x57\x4c\x9a\xef\xf1\x15\x4a\xc8\x8d\x26\x6c\xda\x26\x16\xac\xe1\x50\x5d\x7a\xdc\xd4\xae\x66\x19\xae\x31\xb9\x45\x39\xb9\x69\xae\xb4\x59\x20\xd5\x58\x20\xa0\xc6\x3e\xa2\x92\xe8\xe9\xf8\x13\x9c\xd7\x17\xca\x83\xa3\x1c\x86\xa1\xff\x55\x73\x4b\x17\x17\x79\x76\x71\xb1\xdd\x2a\xb0\x2b\x30\x5f\xf4\x74\x30\xc0\x0d\xea\xc5\x0b\x0f\x70\x20\x08\xd3\xeb\xa9\x1e\xd5\x4c\x4f\xf4\xd0\xaa\xf4\x98\x3f\x89\x4b\xb3\x4b\xf7\x43\x5f\xc5\xd3\x8a\xa6\xcf\x12\xc2\xe3\x33\xda\x05\x8f\x95\xac\x4d\xd2\x15\x6a\x6e\xf5\x19\xf6\xb7\x59\xef\xbb\x55\x10\x9a\xe8\xbe\xb2\x12\xeb\x41\x87\x49\x18\xe6\x4f\xd3\xf1\x60\x90\xe3\x84\xca\x69\x3e\xd3\x3c\x31\x47\x49\x63\x23\x96\xc6\x2d\xcb\x9d\x3e\x3f\xbe\xfa\xaa\x4e\x25\xca\xf5\xc4\xe9\x10\x94\x4d\xb6\x27\x27\x78\x6d\x78\x4f\x3f\x15\xe3\xc1\x80\x63\x05\x32\x45\x54\x8b\x78\x3d\x72\xf2\xda\xd3\x04\x02\xab\x1e\x86\xc8\x22\xd1\xd7\x53\x35\x23\x02\xbd\xc6\xdb\x2d\x3a\x0f\x43\x74\x8e\x30\x39\x37\xd6\xa0\xe4\x55\x18\xa2\x57\x08\x93\x57\xe6\x1b\x7b\x13\x59\xa0\x8a\x3c\x95\xb4\xaf\xeb\x93\x82\xce\x91\xd2\x1c\x60\x15\xe7\x6c\x5a\x68\xec\xaa\x69\xcb\x73\x3d\x07\xcf\x6a\x00\x5d\x6a\x7a\xdb\x4f\x79\xcf\xf5\x4d\xac\x3b\xa4\x5d\x19\x08\x02\x78\x7b\xf8\xfd\x1a\x29\x9f\x60\xbe\x40\x2d\x0c\x82\x37\x37\x3e\xf2\x02\xd4\x00\x08\x01\xc7\x12\xf1\x06\xe2\xd0\x67\xc9\xa2\x0e\x31\xa9\xa3\xc6\x0b\x1c\xcb\x30\xb4\xd5\xa4\xde\x46\x47\x64\x99\xe1\x79\x11\x1d\xeb\xae\xbc\x6e\x38\xea\xb2\xa8\xd3\x77\xbd\x80\x96\x6e\x34\x35\x7a\x57\x05\xe0\xd2\x30\x87\xc9\x47\x7a\x17\xc9\x35\x3f\xd3\xd8\xe3\x27\x21\xae\x4a\x72\x6b\x52\x4e\x01\xc1\x98\xa4\x2b\x93\x54\x59\x79\x96\xe4\xcc\x01\xcc\x29\x1d\x91\xd7\x5d\x61\x7c\xc9\xb9\x2b\xf2\xaa\xf2\xc1\x33\x26\x1e\x95\x41\x28\x27\x10\x14\x3c\x96\x0d\x03\x0f\xdd\x4b\xce\x17\x70\x08\xe2\x85\x33\xf6\xb8\xd8\x19\xcb\xde\xea\xf5\x25\xf4\x1d\x04\x7b\xfd\x0e\x1b\x03\x82\x6f\x8c\x8d\x2f\xfa\x66\x64\xec\x15\xbf\x39\x31\x6e\x6c\xdf\x3c\xc2\x64\xfd\xaf\xef\xa7\xf6\x3b\xc3\x41\x74\xc8\x19\x58\xa4\x96\xcc\x98\x41\x7f\x4e\x90\x89\x46\x88\x15\x89\x0a\x7b\x89\xed\x1b\xf8\xaa\xe8\xd0\x66\xd5\x96\xbe\x2a\x72\x3f\xe1\xfe\xdd\x23\x2a\x1c\xbf\xdc\x70\x55\xb2\xfe\x49\xb2\xf2\x5a\xaa\xaf\x80\xea\x5c\x69\xd2\xc9\x94\xa3\x82\x30\x5b\x8e\x4a\x78\xb8\xe8\x12\x1c\x22\x6b\x10\xf9\x7f\x69\xcf\xeb\x45\xf3\xdc\x8b\x84\x21\xf8\xea\x78\x24\x02\x7f\x4f\x87\x55\xdc\x80\x4f\x91\x7f\x30\x2e\x78\x0a\x51\x0f\xae\x7e\x24\xa6\xfb\x11\x30\x13\x19\xe5\x72\xad\x94\x68\x7b\x6f\x58\xda\xb1\x8f\x58\x74\xcd\x54\xf2\x67\x76\xb7\xdd\xb2\x28\x29\x94\xfd\x95\x2a\x59\xd8\x9f\xe5\x32\x9f\xeb\xe4\xf6\x83\x60\xff\x04\x1a\x76\xe4\x68\xd8\xe1\xde\xcb\x5e\x56\xdb\xda\x71\x56\x26\x0c\xd5\x50\x88\x63\xb6\x33\x1c\x83\x0d\xf1\xc0\x23\xee\x82\x3c\x3c\x02\xe7\xd7\x88\xbb\x30\x0f\x43\x4c\x56\xc6\x22\x7f\xae\xd1\xd0\x09\xc6\xe4\x12\xf2\x57\x98\x2c\x29\x47\x27\x8f\x34\x7b\x6d\xbc\x65\x17\xff\xfa\xa0\x48\x2e\x68\xb6\xe7\x5a\xfa\x32\xe7\x57\x7b\x1e\xa5\xd7\xd1\x65\xc3\xa3\x34\xed\xf2\xa8\x5c\x46\x97\x3b\xdf\xd7\x54\x89\xb8\xf2\xda\xf3\x23\xa9\xce\xad\x8c\x86\xcc\x9d\xd1\xfe\x1c\x7c\x04\x66\x98\x98\xd0\x0c\xa0\x3c\x89\xe7\xbe\x49\xff\x0d\x3b\xad\xa2\x0a\x54\xf5\x05\x2f\xee\x0c\xc2\x79\xcd\x8d\xc3\xc6\x3c\xba\x14\xa2\xf0\x8d\xfe\x5d\x7c\x05\xd3\x05\x31\xca\xaf\xaa\x89\x87\x38\x99\xee\xf7\xd2\x1f\x11\xe0\xea\xe3\xcd\x6e\xb7\x23\xd6\x27\xc9\xf4\xe2\xed\xb1\xa6\x9b\x6b\x0f\x4f\x3b\x0e\xeb\x76\xd3\x48\x43\x0c\x93\x3e\xab\xdf\xc2\x03\x07\x14\x96\xf9\xbe\x58\xce\x1d\xc7\x3a\x6d\x8e\xd5\x76\x7b\x69\xfd\xf6\xfa\xc6\x68\x56\x9a\x27\xdd\xfc\x28\x09\x30\xd5\xed\x16\xb1\x68\x65\xda\xb4\x13\x45\x95\xaa\x39\x6f\x46\x55\x80\x70\x13\x78\x77\xbf\xeb\xa9\xf5\x39\x85\x87\xfc\x04\x18\xee\xb7\xf6\x07\x9e\x3e\xf2\x36\x12\x8c\xf8\xf7\x16\xd1\x84\x0d\x25\xd3\x40\x89\x80\x04\xad\x26\xaa\x14\x68\x20\x20\xc1\x5e\xf5\x60\xa6\x4f\x68\xd7\xd2\xe4\x73\xb4\x36\x8a\x0a\x85\xab\x70\x15\x49\x47\xe8\x8d\xd4\xc8\x31\x32\x9a\x23\x45\xd6\x78\x9c\x1a\x91\xdf\x3a\xaa\x2d\x40\x51\x86\x89\xe6\x0e\x8d\xb9\xb8\x0c\xc3\x7e\x82\x24\xc6\x61\xb8\xae\xae\x3a\x94\x11\x10\x71\xf2\x30\x44\x69\x54\x85\xbe\x98\x78\xbf\x07\x34\xe8\x05\x03\x1e\x7b\x49\x94\x1b\x61\x68\x6a\xe4\xbf\x74\xa1\x2f\x5e\xfb\x41\x24\xae\xa3\xba\x74\x0f\xdd\x14\x6f\x05\x0f\xf1\xe0\x50\x33\x9e\xd6\x9b\xf3\xe2\x81\x81\x02\x1e\xb5\x02\x05\x80\x85\x26\xb2\x91\x02\x0c\xaa\xf3\x9c\xa9\x20\x10\x00\xbc\x0f\x38\xb4\x61\xf7\x4f\x34\xaa\x94\xfb\x8e\xea\x2c\xcb\x25\x4b\x95\x09\x07\x9f\xa7\x65\xbc\xb9\xe7\xc5\xc3\x78\x5f\x32\xd9\x60\xc4\x6b\xae\x03\xc2\xf6\x6a\x78\x07\x8b\x4d\x6a\xbe\x35\x5c\x0b\x43\xd4\xb6\x4c\x49\x2a\x92\xda\x91\x1e\x92\x3a\x92\xa2\x92\x70\x8f\xcd\x8b\x32\x20\xe8\x56\xc2\x7b\x11\xa4\x72\x56\x32\xcc\xa0\x06\x7b\x59\xab\x96\xf5\x77\x65\x1a\x62\x69\x93\xea\x6e\x56\x7a\xd5\x32\x4d\x9f\xbf\x31\xae\xcd\xf0\x30\x10\x72\xe5\x48\x72\x34\x32\xa6\xaf\xde\x6b\x1a\x5f\x7d\x71\x0c\x11\x08\x07\xba\xe5\x71\xb3\xf3\x54\x73\x08\xd0\x73\xe1\xbd\xed\x37\xe6\x9e\x45\x6b\x41\x40\x10\x1a\x5b\x81\xe8\x76\x2b\xcc\x0f\x67\x99\xea\xec\x45\x6d\x60\x7e\xb0\xaa\x03\x34\xe8\x8f\xf0\x50\xb8\x5f\xf3\x00\x9c\x1a\x8b\xef\xe9\x70\x2c\x8e\x8e\x1c\x6b\xa6\x6f\x17\x52\x50\x09\x43\xda\x6e\x83\x00\xde\xf6\xa1\x45\xe7\xbc\x38\xd1\x64\x45\x51\x07\x54\x3b\x0e\x30\x36\xaf\x9a\x38\x0b\xa9\x60\xc0\x77\x8d\xbb\x44\xb7\x1b\x17\xd5\xc5\x21\xc5\x75\xfd\xa5\x44\xf5\xdb\xc7\xfc\x66\x19\x0a\x77\x8b\x98\xe9\x57\x9f\x16\x4e\xe2\x75\x94\x12\xe7\x4a\xae\x3f\xba\x90\x5f\x6e\x50\xad\x3b\x52\xd9\x67\x91\xed\x06\x8c\x4b\x84\xcd\x0b\x66\x1d\xd6\x54\x95\xec\xbd\x40\x18\xe9\xbe\x38\x06\x83\x7c\x20\xe6\xab\x10\xee\x8f\x8c\xed\xb4\x09\xe1\xae\x79\x9f\x47\xdf\xb6\x8e\xe4\xb7\x8f\x6b\xf2\xe5\x77\x79\x91\xfd\xeb\x13\x2c\x7a\x21\xbe\x3d\xf9\xe7\xb8\xb8\x59\x96\xf1\x49\xdb\x8c\xdc\xf3\x90\xa8\x74\x49\x7a\x3f\x3c\x03\xca\x93\xe1\xf1\x82\x04\x83\x00\x2c\xb8\xa5\x79\x3d\x83\x64\x5d\x63\x69\x5d\xa1\x1d\x82\xb1\x07\xbb\xce\x71\xca\x10\x68\x8c\x55\x3d\x2e\x78\xde\xa4\x7e\xbc\xd1\x7b\xa3\x72\xdc\x11\x04\x4f\xdf\x38\x92\x96\x98\x74\xe4\x15\x61\x88\x0a\xba\x76\x77\x62\xd7\xb2\x4e\xdc\x13\x89\x06\xa1\x80\x60\x0e\x7e\xd1\x02\xb9\xb7\x17\x1a\xef\x26\x61\x4c\x98\x26\xaf\x1b\x01\x68\xad\x9e\xc5\xbe\x4a\xd9\x7c\x59\x91\x1f\x78\xa0\x24\x6d\x3f\x50\xa2\x71\x0d\xf2\x75\x0f\x7a\x7d\x34\xdf\x0a\x46\xfb\xc5\x24\x98\x04\x83\x22\x0e\x82\x5d\x6d\x09\xee\xca\x74\xb8\x9a\xec\x9f\xc9\x0c\xf1\x6e\xa1\xca\xae\xe9\x95\xd2\xb1\x4c\xbc\x29\x81\xea\x52\x08\x34\xbd\xd4\x18\xc9\xf4\xbe\x82\x28\xd5\xc9\x3d\xee\x69\xb7\xcb\x73\x8e\xc1\x53\x22\xd0\x00\x78\xc3\x74\x56\x07\x8a\x6f\x8e\x18\xb1\xbb\x86\x21\xc6\x88\x71\x9a\xe9\xac\xe0\xe0\xbd\x55\xc7\x73\x9c\xe9\xac\x56\xe7\x77\xd7\x04\xd7\x9a\x7b\x6a\x02\xe1\xd5\x59\x73\x7f\xc3\x1a\x98\x69\xef\xe9\x48\x61\x9f\x8e\x54\xdf\x8f\x26\xea\x68\x14\x83\x43\xf5\x68\x2c\x9f\x2a\xb0\xf9\x13\xe6\xa5\xae\xfa\x9c\xc9\x99\x15\x22\xf0\x96\x96\xc3\xea\x63\x39\x99\xfa\xe3\xaa\x4c\x8b\x05\xf6\xcc\x7c\xdc\xd9\x28\xec\xd9\x80\x87\x5d\xbc\xc7\x88\x30\xaa\xe6\x45\x8c\x5d\x01\x6e\x78\x15\x7d\xea\x76\xf9\xdf\x8c\xba\x35\xca\x2e\x7e\x07\xca\x96\x9e\xbb\xd0\xff\x18\x92\xad\x9e\x78\xed\xec\x93\x4d\x90\x34\x31\x45\xa9\xf7\x1c\x6c\x18\xa2\xa1\x35\x74\x33\xe4\x58\xa4\xc4\x4b\x71\xcb\xe4\x69\x52\x32\x84\x2b\x92\x47\x36\xd3\xf1\x04\x79\xaf\xda\x78\xb5\x6b\x6c\x2a\xab\x97\xe2\xea\xde\xa8\x34\x0f\xe0\x32\xef\x11\x1c\xbf\x21\x20\xac\x62\xaf\x7c\x10\x38\x8c\x9c\xb6\x83\x2f\xd4\x56\xf6\xee\x91\xdb\x6e\x54\x9c\xec\xa1\x62\xee\x3d\xc2\x43\x0a\x6a\x48\x68\x69\x7d\x9a\x8f\x46\x78\x22\x63\x39\x08\x8e\x03\x92\xdb\x3c\x5e\xd3\xd6\x13\x5e\xbd\xaf\x18\xf3\x06\xa6\x56\xde\x5b\xbe\xc5\x20\xff\xbd\x88\x3c\xff\xbf\x8d\xc8\x0b\x1f\x91\xe7\xff\x38\x22\xcf\x1f\x88\xc8\x53\xe3\x93\xf5\x20\x04\x6e\xcb\x7e\x06\xe2\x6e\xd4\x78\x10\xc2\x6e\xd4\xf8\x1f\x40\xd4\xde\xfe\x1e\xc2\xd5\x7a\x48\x3e\x8e\xde\x43\xb5\xf9\x3f\xf4\x08\x88\xf2\xde\x92\x33\x74\x3b\xc3\x18\xd4\x45\x9f\x41\xb8\x7f\xb6\xe3\x66\xe5\x52\xa8\xc9\x40\xe7\x06\xf6\xfb\x5e\x95\xdf\xfc\x65\x2d\x54\x72\xf6\x31\x65\x2c\x63\x19\x98\xac\xc4\xfd\x21\xf9\xcb\xfb\xd7\xef\x9e\x5d\x9c\xfd\xf5\xf4\xec\xec\xf9\xd9\xf3\x8b\xb3\x37\x6f\xcc\xc3\xcd\x05\xdd\xbc\x65\xe9\x5a\xe6\xea\xce\x95\xdd\x75\x12\xe0\xc1\x1f\xfe\x60\xc3\xaa\x43\x08\xa8\xb1\x3f\xd0\x3d\x3a\xd0\x1a\x0b\x95\x26\xea\xf2\x5b\x25\x64\xb2\x60\x60\x29\xe4\xde\x2d\xef\x2c\x61\x0d\xe1\x5e\x28\x76\x8d\x12\xbd\xf0\x71\x77\xb1\x92\xa9\xaa\x0c\x69\xd9\x87\xe9\x83\x56\x59\x1d\xe5\x73\x54\x4c\x19\x38\x3e\xb9\x37\x84\xc0\x5d\xc2\xa5\x85\xe1\xb0\x0e\x46\xde\xea\xc4\x62\x66\x5b\xcb\x58\x01\xb1\xdd\xae\xb1\x5b\xfb\x92\x0d\xcf\xa7\x53\x1d\x68\x78\xe1\x8d\xfe\xfe\xb1\x82\x89\x2d\x2c\x9b\x85\x49\x98\x2b\xa0\x6a\x0d\x92\x55\xd5\xdd\xe7\xe9\x9c\x1a\x23\x75\xd0\x0e\x36\x6a\xf5\x63\x58\x1a\xee\xc1\xb4\xa8\x0d\xf7\xdf\x69\x98\xf9\x9f\x75\x74\x3e\xa4\x31\xfb\x77\x00\xf5\x7f\x07\x50\xff\xbf\x10\x40\xfd\xe6\xdf\xf1\xd0\xbb\xe3\xa1\x3f\x7e\x82\xc9\x8a\x72\xf4\xf5\x13\x4c\x2e\xa9\x70\xfa\xb1\x27\x27\x98\x5c\x53\x81\x96\x60\x9a\x89\x9e\x3c\xc2\xe4\x82\x0a\xb4\xc0\xe4\x46\x7f\x3e\xc6\xe4\x8e\x0a\x74\x83\xc9\x47\xfd\xf9\x35\x26\xb7\x54\xa0\x8f\x98\x5c\xe9\xcf\x27\x98\x9c\x99\x50\xeb\xa7\x54\xa0\x33\x4c\x5e\x53\x8e\xbe\xc5\xe4\x9c\x82\x5d\xc8\xab\x7f\x9d\xc0\xeb\x07\x63\xa2\x57\x42\xfd\x03\xe1\xb3\xb3\xfc\xa6\x11\x3f\xfb\xb4\x2e\x28\xb8\x4a\x72\xce\xa4\xd3\x3c\x5d\x27\x72\x91\xf3\x77\x62\x15\x3f\x1a\x12\xf3\xf1\x03\x98\x67\xc6\x4f\x86\xbb\x7b\x82\x7a\x2f\x59\x02\x63\x03\xf3\x91\xc3\xa5\x46\x2e\xf0\xf7\x42\xa4\x32\x99\xab\xe3\x5b\x21\xaf\x02\xf0\x0d\x3e\x54\xc5\x36\x7a\x4f\x91\xc3\xd3\x93\xe2\xf6\x9e\x31\x5f\x27\x39\x3f\xb8\x2e\xc5\xab\x6c\x34\xdc\x11\x3f\x98\xaa\x15\xe7\xde\x33\x92\xa4\xcc\x33\x76\x5f\x93\x27\xf7\x0c\x87\x27\x37\x9f\x5a\xbf\x56\x1c\x74\x34\x24\xe7\x35\x9f\x50\x77\xc5\x93\x1b\xd2\xf8\x3a\xcf\x8b\xa2\x6c\x26\xbd\x55\x49\x7a\xc5\x20\xac\xe7\x7d\xa1\xde\xef\x1d\xcf\x55\xf4\x32\xe7\x57\x64\xa3\x44\x1c\x1c\xaf\xa4\x48\x59\x59\xb2\x32\xd8\x91\xe0\xbc\xfa\xb8\x6f\x6f\x3f\xaf\x83\xbf\xad\xd9\xda\xb4\xfe\x17\xf3\xeb\x9f\xd7\xb4\x64\x4a\xde\x5d\xfc\x26\x2e\xa1\xf9\x37\xfa\xab\xf7\x27\xfd\xf5\xcf\xeb\xa2\x4c\x97\x4c\x93\x09\x59\xd5\xcd\x5b\x97\xf2\xcf\xee\x2a\x63\x49\xdd\xcb\x73\x96\x54\x1d\x60\xa3\x87\xbd\x27\x8e\xfd\x2b\x3f\x8e\x7d\xa5\xbf\xa8\xa3\xcb\xdb\xe7\x4c\x1b\xc1\x8d\xd1\x90\xcc\x23\x83\x9b\xf0\xc1\x77\x03\xae\x22\x13\x5b\x9a\x6c\x6c\x40\x85\xf8\x0a\x02\x9b\x58\x8a\xfe\x30\x14\xda\x8a\x86\x6d\x87\xb7\xae\x2b\x53\xd8\xf8\xd5\xc3\xab\xd5\xd0\xe9\xd5\x7f\x38\x0a\xbd\x74\xe9\x64\x73\xb9\x2e\xef\x7e\x15\xf2\x8a\xc9\xf7\x6f\x5e\xc6\xc1\xb1\xfe\xbe\xb8\x85\x84\x32\x20\xe6\xc7\xb9\x10\x85\xc9\x35\xdf\x17\x2b\x21\x8a\x32\xd0\x7c\xe2\xe1\x5d\x6e\x0f\xd9\xc2\xfb\xef\x1a\xef\x45\x3d\xde\xb5\x2c\xbc\xc3\xf3\x59\x23\xf0\x8e\xc5\xef\x1a\xc5\x5d\x7b\x14\xfe\x39\xfb\xac\x91\xb4\x4e\xcf\xef\x1a\xcd\x6d\x7b\x34\xed\x23\xf9\x59\x23\xaa\x0f\xd9\xef\x1a\xcc\x75\x3d\x98\x39\x53\xe9\xd2\x40\x8b\xd7\x28\x2c\x95\x49\x35\xab\xe6\xf2\x6c\xd6\xb3\xa2\xf0\x73\x93\xa2\xb8\xf0\x6a\x1b\xf3\x58\xd7\xa8\xfe\xed\xd5\x37\x09\x55\x03\x36\xbf\xd9\xc2\xa7\x16\x03\xac\x25\x9c\x16\x9e\x6c\x40\x73\x1a\x04\xa4\x7d\x13\x60\x8c\x49\xc5\x72\x2d\x98\x53\xc8\xff\x70\xf7\x22\x43\x41\xb2\x5a\x05\xf8\x77\xba\x27\xec\x09\x7b\x8e\x46\x84\xd3\x3a\x6e\x0a\xa8\xff\x6d\xdc\x93\xff\x08\x06\x6c\x10\x8c\x7b\x37\x79\x99\xab\xde\x52\xa9\x55\x7c\x7c\x3c\x4f\x52\x76\x29\xc4\x55\x64\x9d\x99\x73\x71\x2c\x75\x9d\xe3\x4c\xa4\xe5\x31\x54\x3c\xca\x58\x2a\x32\x26\xa3\xa5\xba\x2e\x26\xb9\x8b\x5a\x42\x83\x01\x23\x82\x0e\xc7\xe2\xa9\x1a\x8b\xc1\x00\xf3\x01\x0d\xc2\x44\x2e\xca\xe9\x4c\xe7\xed\x3b\x90\xd5\x12\x26\x31\x18\xcd\xb0\x65\xe5\x15\x35\x2c\x0e\x1f\x04\xcd\x68\x2d\x2e\x4e\x8b\x90\x0f\x0f\xf5\x52\x87\x79\x29\x1f\x10\xdf\x85\xa8\x7b\x42\xb1\x10\xd5\x0a\xc2\xa2\x3a\xde\xba\xa8\xe9\x22\xca\x88\x6f\x2f\x43\x95\xf9\x94\x6c\x5e\xd2\x1b\xf3\x7b\x0d\x11\x95\x25\xe5\xdb\xed\xab\x16\xeb\xfa\x4f\x6a\x2b\x41\x78\xd3\xb2\xd4\xfb\x27\xb5\x9c\x36\x7c\x19\x89\xa4\x9b\x1d\x29\x8c\xff\x57\x62\x42\xdc\x5b\xad\x63\x9f\x9a\x38\xf1\xa0\xd8\xa8\xb4\x02\x2a\x02\xaf\x5b\x94\x98\x5f\x98\x78\x39\xf0\xec\x3c\x2a\x68\x10\x0c\x94\x89\x21\xa8\xf0\x5b\x17\x3c\x5e\xe0\x30\xec\xbf\x6b\xa9\x43\x10\xe8\x41\xe4\x54\xcc\xa8\x02\x33\x4b\xc3\x29\xee\x9d\x85\x13\x3d\xa8\x11\xa5\x34\xc7\xb2\x8e\x68\xcf\x2b\xe3\x92\xd1\xd3\xbc\x3e\x4b\xa9\x95\x92\xc2\x53\x1d\xc3\x71\xf9\x34\x37\x3e\x4b\xd3\xd2\x97\x8d\x96\x83\x93\xd9\xd8\x6b\x2c\x05\xef\xa9\x30\xf4\x0c\xcc\xc4\xaa\xac\x57\x20\xa7\xad\x9c\x3a\xdc\xfd\x54\xcc\xdc\x2c\x72\xcf\x58\x74\xf3\xc5\x17\x86\x65\x8f\x6f\x09\xb8\x50\x32\x08\xad\x5b\x10\xc9\xe6\x71\x02\x56\x1b\x65\x2c\xc9\x85\xb8\xe5\x4c\xc6\xbf\x45\xa9\x11\xbe\xef\x1a\xce\xeb\x95\xb0\xb0\xed\xd3\xc6\x2a\x41\x03\x0c\xda\xf5\x45\x29\xbd\x6d\x78\xac\x57\xae\x8b\x01\x0d\xe2\x80\x0e\x03\x12\xc4\xfa\xc7\x49\x50\xbd\xab\xfd\x45\x30\x40\x41\x30\xf0\x55\xf7\x53\x1a\xcf\xfc\xe8\x47\xed\xd7\xba\x3b\xdd\x96\xf3\x39\xfa\xd9\x09\xf9\xac\xc1\xcb\xcf\xd1\x4a\xac\x6a\x2f\x08\x19\x49\x56\xae\x0b\x45\x19\x91\x1a\x42\xce\x25\x9b\xe7\x1f\xa9\x22\xe6\xbd\x7a\xca\x89\xac\x20\x5a\xc0\xef\x35\x57\x74\x48\xa4\x65\x22\x37\xa6\xba\x59\x49\x53\x39\x56\x30\xca\x98\x3b\x63\xcb\x58\x10\xa8\x16\x0f\x77\x2d\x97\x1b\xe6\x7a\x37\x81\x71\xbc\x01\xd8\x04\x33\x06\xf3\xdb\x0d\xa3\xfa\x34\x23\x19\x0d\xbf\x77\x73\x0c\xc3\x9f\x5d\x0c\xd7\x3d\x57\x61\x22\xab\x68\x3a\x76\xbb\xc6\x0d\x8f\x49\x5a\x84\x61\x70\x69\xfc\xdb\xe0\x73\xbb\x45\xcc\xf8\x75\x54\x6f\xe0\x8c\xbd\x88\x10\x38\xa1\xfd\xa1\x01\xf7\xf2\x36\x57\xe9\x12\x15\x78\x93\x26\x25\x73\xda\xa7\x18\x3e\x6c\xb4\x9d\x18\x4a\x83\xa9\xd0\x18\xd2\x2d\xf0\xc4\xb6\x6e\x0d\x2e\xa6\x91\xde\x2d\x54\xef\x5d\x99\x3f\x67\xe6\xcf\x29\x34\xb3\xd3\xe7\x22\xa9\x5f\xea\x91\x10\x51\x88\x52\xaa\x26\x41\x14\x0c\x2e\x11\x23\x43\x1c\x2b\x4c\x60\xbc\x09\x1d\x12\x45\xeb\xfc\x58\x0d\x82\x38\x20\xcf\xec\xa3\x26\xe6\x60\x32\x5c\x3d\x40\x91\xd3\xe1\x38\xaf\x43\xcc\xe4\x83\x01\xde\x14\x94\x4d\x73\xa3\x62\x4e\xa9\x1a\x5c\xa2\x82\xe4\x78\x9c\x0c\xe8\x0a\x15\x24\x85\xb5\xad\x02\x9a\xba\xf5\xd9\x6e\xeb\x17\x2a\x27\x66\x0f\x63\x94\xd2\xf3\x30\x64\xd3\xf3\xd9\x76\xcb\xa6\xc1\x1f\xfe\xe0\x1e\xae\x09\x66\x24\xed\x7a\xf8\x26\x9d\xa4\xb1\x61\xcd\xbb\x32\x61\xcc\x8c\xa6\xd6\xb8\x1a\xc3\x13\x45\x7d\x54\x50\x06\x51\x58\x10\xc6\x51\x26\x38\x1b\xe3\x82\x16\x11\x88\x34\x48\x35\xfc\xc1\x00\x93\xc6\x04\x60\x2b\xbd\xc7\x33\x8a\x30\x44\x5c\x23\x4e\x46\x04\x0a\x1e\x8d\x02\x12\x4c\x4d\xae\x8d\x57\x32\x03\x8d\xe0\xc4\x56\xe9\xdd\xe6\x6a\xd9\xbb\x62\x77\x65\x6f\x13\x0c\xfc\xe0\x16\xcc\x79\x4b\x92\x5e\x80\x21\x7c\x14\x27\x41\x50\x2b\xde\x93\xa6\x07\x97\x7a\x18\x7e\xb1\x71\x80\x75\x17\x93\xb5\x8d\x06\x1b\xab\x46\x3c\x55\xdf\xab\x0a\xda\x35\xc7\xc9\xae\x96\x3b\x4e\x44\xb9\xb3\xd4\x70\x51\xbb\x6e\x05\x00\xb7\x07\x15\xcc\x0f\xab\x53\x3a\x66\xf4\xd3\x6d\xee\x81\xda\x64\x81\x18\x11\x84\x93\xbb\xae\x87\xde\x71\x6c\xa7\x16\x86\xa8\xb4\xa6\xc1\x54\x0e\x50\x1f\xfa\x05\xb7\x3a\x58\x58\xd0\x1e\xeb\xd9\x07\x41\x0c\xb8\x12\x96\xa0\xc2\x97\x3f\x91\xe0\x8b\xf0\x58\x2f\xb8\x31\xdc\x63\x74\x1f\xf9\x43\x48\x13\x62\x5e\x9a\xd3\x37\x80\x9e\xe4\xdc\xde\x02\xcc\x5a\x0b\xdb\xbb\x80\x45\xe6\xc7\x0e\x13\xe1\x50\x4c\xc3\xdb\xcb\x05\xe3\xab\xb0\x4c\x10\x8c\xcd\x44\x38\xdc\xc0\x7a\x88\xfc\xc0\xf0\x88\xa2\x19\x52\xa4\x80\xea\xf6\xfd\x13\xb6\xdd\x6a\xc4\x15\x04\xe4\x1a\x9c\x93\x91\x15\xda\x5e\x50\x8e\x46\x8f\x8c\xd8\x71\xf4\x2d\x06\xc7\xf0\xd1\x63\x4c\x3e\xde\xf7\x6e\x94\x7d\xac\x69\x2e\x24\xb9\xa5\x1f\x27\xf5\x27\x0a\x80\x22\x75\x4c\x77\x80\xe3\x27\xc3\xd1\xf0\x11\xb9\xea\x2c\xa5\x37\xd9\x16\x79\x4c\xce\x3a\x8b\x58\xd0\x35\x85\xbe\x26\xa7\x9d\x85\x56\x42\xaa\xc4\xb5\xf4\x84\xbc\xee\x2c\x34\x97\xc9\xc2\x1b\xd3\x37\xe4\xfc\x01\x33\x74\xf8\x84\xbc\xa2\x9b\xbc\x84\x37\x2b\x58\xb6\xcf\x33\xf5\x47\x3b\xc2\x38\x30\xac\x3f\x0a\x99\xb2\xfd\x77\x49\xaa\xfc\x37\x5e\x44\xc7\xce\x02\x6f\xed\xeb\x0e\x8d\xcc\xdd\x58\x7a\x06\x27\x79\x09\xbc\x42\x45\xad\x43\x24\x2f\xff\x11\x3b\xdb\x44\x4b\xc7\xb8\x1f\xcb\xa9\x53\x17\x52\x23\x83\x30\x14\x28\xf8\xf6\xeb\x00\x37\xa8\xcd\xa8\x35\x50\x23\x0e\xd6\xf0\x1a\xb8\x8e\x03\xdc\x1c\xd0\xbc\x5e\x94\x86\x6c\xba\xab\x59\x6f\x01\x5d\xcb\x81\x57\x5f\x37\x9d\x78\xca\x11\xaf\x1b\xb8\x4f\x5e\x52\xef\x11\x31\x88\x72\x91\x8c\x5f\x36\xde\x1d\x2b\xc8\x05\x7a\xe9\x0f\x0f\x93\x97\x51\x5e\x9e\xaf\x25\x6b\x2d\xab\xb5\x29\x7a\x43\xf3\xbd\x26\xdf\x34\x9a\xcc\xc9\x05\x7a\xd3\x6c\xf2\x4d\xb4\xe6\x25\xa8\x22\x2e\xf2\x12\x82\xb5\xef\x35\x4e\xde\x58\x19\xd4\xa1\xc0\x82\x4d\x21\xae\xf1\xb9\xff\x8d\x6e\x2c\x15\x09\x78\x6d\x47\xde\xd2\x4f\x18\x24\x91\x77\x14\x84\xf1\xfd\x21\x20\xa5\xfe\x90\x5c\x5c\x94\xac\x70\xbf\x20\xf4\x08\x68\xb0\x7f\xa2\xc7\x1f\x8e\x07\xc7\x0b\xf2\x33\x9d\xce\xc8\x7b\xba\x39\x75\xd2\xb4\xcd\x75\xb2\x8a\xdb\x3c\x6f\x75\x37\xb3\xa6\xbd\x8c\xf0\x82\x00\x58\xcc\x0c\x61\x4b\x41\x7b\xb2\x23\xd6\xcb\xfa\x41\xcd\x69\x3c\x56\xbb\x96\x43\x0b\x6d\x6c\xb6\xac\xb0\x99\xa5\x09\xbb\xcc\x33\xec\x5b\x63\xc3\xd8\x56\x6a\x5c\x14\x3f\xbb\x0e\xf0\x8e\x28\x01\x97\x4a\x87\xf5\x7d\x73\x4e\xca\x8c\xa8\xfb\xc2\x21\x6a\x07\x0e\x39\x5d\x43\xd1\x37\xd0\x76\x2b\x50\x30\x7a\xfc\x28\xc0\x84\xed\x76\xa4\x02\x89\x58\x12\x0d\x84\xf5\x77\x41\x2a\x18\x02\x08\xaa\x73\x72\xf2\xa3\x45\x67\x55\x2c\x6f\xe7\x38\x90\x92\xb4\x10\xbc\xf3\xf1\xb5\xfa\xfa\xbd\x00\xf7\x46\x03\x60\xd8\xdd\xc1\xe0\x9e\xa2\x6f\xac\x84\xba\xeb\xc9\xe7\x14\xeb\x20\x28\x1e\x93\x58\x98\x5f\x24\xa1\x15\x77\xd3\xc1\x30\x4a\x8f\x61\x34\xb7\xa4\x66\x68\x00\x56\x9b\x0c\x17\xd0\x8b\x1d\x39\x60\x83\x97\x9a\xa7\xcc\x2a\x7e\x33\xed\xe4\x37\x53\x08\xb3\x33\x4d\x67\x5e\xb8\x9e\x69\x3a\xf3\xcc\xde\xf2\x49\x3e\x4d\x67\xb1\x4e\xc5\xd6\xe1\xed\x30\x3b\x9a\x62\xd1\xcd\x8e\xa6\x78\x93\x5b\x36\x34\xad\x9d\xda\x6d\x3c\x0d\x60\x47\xf3\x0e\x76\xd4\x6b\x2c\xdf\x1d\x62\x22\x6b\x3a\x42\xc2\x91\x2d\x2c\x0d\x21\x1c\xf5\x90\x54\x6f\xec\xfd\x98\xa4\x20\x9a\xde\x07\xd8\xd4\x8b\x86\xe5\xbb\x8b\x00\x12\x63\x1a\x46\x9b\x4f\xa0\xc5\x25\xb9\x61\xb2\xcc\x05\x8f\x83\xd1\x93\xe8\x24\x1a\x06\xe4\xe2\xe2\xed\xd9\xe9\x9b\xb3\x77\x17\x2f\x7e\x7e\x77\xf6\xe6\xe7\x67\x2f\xdf\x5e\x3c\x7f\x7d\xf1\xf3\xeb\x77\x17\xef\xdf\x9e\x5d\xbc\x7e\x73\xf1\x9f\xaf\xdf\x5f\xfc\xfa\xe2\xe5\xcb\x8b\x1f\xce\x2e\x7e\x7c\xf1\xe6\xec\x79\xbc\x31\x68\xce\x80\xc2\x6b\xc3\xf7\x12\x63\x8c\x19\x5f\xec\x76\xe4\x85\x43\x57\x73\xc9\xd8\xdf\x19\xaa\x0c\x10\xde\xef\x30\x79\x4e\x5f\x84\xe1\xfb\xed\xf6\x85\x17\x97\xe6\xb9\x03\x84\x49\xf5\x2b\x7e\xfe\x40\x57\x9e\xd1\x63\x13\x6d\xfd\xe4\x5b\x1b\x6d\xfd\xeb\xce\x88\x37\x6d\x6d\x35\x10\x5e\x09\xc9\xf1\x26\x37\x7c\x9c\x44\xfd\x11\x09\x4e\x93\xa2\xc8\xf9\xa2\x77\xee\xf4\x0d\x3d\x88\x63\xa8\xe9\x83\xb2\x67\xc4\x8a\xc5\x5d\x2f\x2f\x41\x99\x6f\x03\xb6\xb3\xac\x77\x79\x07\xf2\xb0\xff\xa3\xf7\xf0\x48\xaf\x7e\xf9\x7f\x7a\xab\x24\xbd\x4a\x16\x2c\xea\xbd\x2f\x59\xdd\x5e\x94\x2e\x59\x7a\x55\x7d\x22\xdc\x53\xc2\x18\x55\xa8\x25\xbb\x8e\x7a\x6f\x58\x92\xf5\xae\x85\x64\xbd\xa4\x16\x0a\x5e\x46\xd7\xec\x78\x5d\xb2\x23\xa8\x7c\x54\xf7\xe2\xdb\x5c\x78\xba\x5f\xb6\x63\x9e\x2e\x84\x3a\x73\xf3\x0d\xbc\x4a\x19\x33\xa2\x59\xd6\xd8\x44\x79\x88\x19\x31\xec\x66\xcc\x88\xa1\x1d\x62\x46\x0c\x37\xaa\x7f\x00\x89\x14\x33\x92\x70\x5d\x0f\xea\xbf\x9e\xc7\x8a\x58\xe2\x2f\x66\xc4\x99\x8e\x40\x32\x17\x19\xab\x1a\x82\x14\x70\x85\x74\x7f\xf5\x94\x63\x45\xe0\x11\x24\xdd\xc8\xc7\x24\x55\x71\xed\x11\xc2\x5b\x8b\x43\x05\xe1\x51\xfd\xc5\x09\xff\x94\x3d\x4f\xbd\xf1\x81\x85\x6a\x0b\xcb\xe7\xcf\xde\xbe\xbd\x78\xf7\xd3\x8b\xb7\x9d\x10\x1d\x3c\x5c\xda\x7b\x30\x6c\xd2\xc5\xc5\x9b\xb3\x67\xa7\xef\x2e\x9e\x9f\xfd\xf2\xee\xf5\xeb\x97\x6f\x2f\xfe\xf8\xf2\xf5\x0f\xcf\x5e\x5e\xfc\xf4\xfa\xf5\x9f\x2f\x2e\x3a\xdd\xd8\xef\xaf\x62\x16\xe3\xf9\xe9\x19\x18\x3a\x3d\xb0\x2c\x12\x9e\xf9\x93\x26\x5f\x44\xc1\x22\x10\xc0\x42\x9c\x70\x81\x34\x72\x76\x6b\xc4\xd1\xe3\x6f\xfe\x2d\xe8\xfe\x5f\x27\xe8\xae\xec\x71\x59\xa8\xb0\xbe\xfa\xba\xec\xae\x5e\xf2\xf6\xa5\xa9\x89\x92\x93\x4a\x48\x13\x86\x28\x10\x60\x4e\x3d\x1d\xce\xb6\xdb\xe0\xb5\xfb\xad\xaf\xd6\x80\x9b\x2f\x88\x4e\xfe\xb3\xfb\xed\xb9\xa1\xd7\x32\x1b\x55\xc7\x57\xb2\x62\xa9\xca\xc0\xc9\x48\xb6\x9c\x78\x2c\xb6\x87\xbc\x6b\x60\x13\x46\xfb\xc3\x18\x29\x0a\xa6\x7f\x13\x46\x95\x2e\x63\x03\x47\xfd\x92\x14\x6b\xb6\xdd\x42\x92\x11\x54\x74\x64\xbc\xbe\x61\x12\xa2\x8e\x65\x7e\x66\x8c\x18\x6d\x1b\xa5\xbb\xd7\xa7\xbe\xc6\x84\xd1\x20\x4b\x54\x72\x14\x18\xd9\x53\xa0\xb7\xc2\x7c\x60\xc2\x8c\xc8\xcd\x7b\x01\xb5\x21\x9a\xdb\x17\xda\x39\xe1\x5c\xb5\x1c\xee\x22\xab\xd8\xc7\x56\x3c\x00\xbb\x1e\xbf\x75\xad\xc7\x6f\x7c\xca\x66\x86\xf8\xf7\xd5\x07\x5e\x8c\xad\xd1\x2c\x52\xe2\xfd\x6a\xe5\xe6\xb5\xa7\x1a\x30\xa1\x4d\x5d\x98\xff\x5f\x79\x74\xb1\x4c\xca\xd3\x64\xbd\x58\x2a\x80\x7b\xda\x1f\x11\x9d\x9a\x7a\x49\xa0\x38\x30\x24\xce\x43\x1e\x4d\x27\x8f\xb0\x31\xe8\xac\xac\x90\x4b\x0f\xfb\xb4\x1b\x67\xa4\x6b\x10\xc3\x86\xa0\x1c\x40\xd7\x16\x7b\xc3\xe0\x9c\x42\x39\xe7\x92\xad\xb3\xa4\x97\x6e\x8f\x72\x3b\xd9\x88\x7b\x3b\xda\xd1\x93\xf6\xad\x20\xd7\xa6\xc7\xbf\xf2\x4a\x94\xc9\x34\x01\xfa\x0b\x77\x14\xd6\x2f\x7a\x23\x08\xa7\x7f\xe5\x7e\x54\xde\x7c\x8e\x8e\x46\x4f\x39\x10\xf9\xdf\x3d\x09\x08\xc3\xa4\xff\x67\x0e\x31\x03\x55\xc4\x3e\x2a\x99\xa4\x0a\x9e\xc4\x28\x4d\x91\x6f\xa0\x08\x94\xa0\x0a\x5c\x08\xc0\x43\x1d\xae\xb5\x3a\x56\x92\x71\x3d\xb1\xa2\x20\x1b\x57\x26\xa1\x7c\x2a\x67\x24\xa7\x8a\xa4\x54\x8e\xff\xb8\x07\x2c\x9a\x14\xd6\x5d\x7c\x17\x90\x14\x93\x3f\x72\x4d\x14\x27\x76\x0f\x93\x68\xb5\x4c\x4a\x96\xbd\x61\x8b\xbc\x54\x12\xd0\xcb\xcf\x1a\xb3\xe8\x09\xd8\x38\x49\x85\xee\xb5\xc4\x65\xbb\xdd\x02\x87\x61\x86\x20\x12\xb9\x06\xa0\x71\xa1\xb7\x0a\x28\xe3\x24\x92\xad\xf6\x26\x28\x43\xfb\xa9\x50\x8f\xe8\x7a\x38\x2e\x68\x7f\x34\x2e\xcc\x62\x7c\x1b\x10\x49\xf4\x65\xb4\x1f\x35\x15\x6f\x7e\xd0\xeb\x0d\x33\x1a\x0d\x87\xb0\x6a\x90\x42\x15\xf9\x8b\xf9\xeb\xad\xdc\x94\xcf\xa2\x8c\xad\x34\x7b\xcd\xd3\x9c\x95\x2d\x3d\xc1\x5f\xb9\x6d\x67\x14\x60\xf2\x57\x7e\x1f\x44\x33\x4c\xd6\xa8\x1d\x6b\xeb\xc6\x3c\xd8\xaa\x47\xae\x17\xaa\x0e\xdb\xd1\x66\xbe\x91\xc2\xb5\x67\xb0\x9a\x8d\x7f\xd9\xdb\x24\x85\xc3\xf0\x17\x3e\x55\x33\x4a\xa9\xdc\x6e\x11\xfc\xb6\xa3\x3b\x81\xe7\x54\x4c\xae\xd4\xdd\x0d\xf1\x8e\x87\x61\x63\x3c\x97\xb5\x76\x46\x59\xd6\x69\xbb\x0d\xd6\xfc\x8a\x8b\x5b\x7e\x04\x2b\x12\x10\xe6\x98\xb3\x77\x10\x0a\x81\x32\x81\x04\xd6\x87\x20\xe7\x37\xe2\x8a\xfd\x71\x9d\xc8\x8c\x65\x9a\xc0\xbd\x4c\xd2\xab\x67\x3c\x3b\xd5\x27\xf5\xc7\x5c\x96\xca\x3e\x9a\x41\xb8\x7b\xad\x47\x23\xc0\x56\x73\x4d\x64\xb4\xec\x78\xe8\x5b\xc1\x8c\x1e\x0d\x83\x8a\x83\x9f\xa8\x78\x4f\x90\xdb\x4c\x50\xc6\x19\x68\x5d\x2e\x2d\x0e\x81\x90\x87\x0c\xc7\x36\x15\x99\xaf\xbd\x4a\x53\x56\xf9\x3a\x28\x1c\x4f\x19\x51\xb3\x0e\x39\xf4\x5e\xe7\xac\x0a\x00\xa7\xf3\x63\x16\x86\xca\xf9\x72\xb1\xb6\x68\xd6\x3c\x2b\x57\xbf\x52\x73\x91\xe5\xe5\x4a\x2f\x99\x7b\xe7\xa6\x84\x28\x62\x55\xf2\x0b\x4b\x08\xc3\xe9\x6a\xf6\xcc\x6b\x55\x89\x89\xc6\xcf\xab\x5b\xb8\xaf\x29\x75\x0d\x28\xc9\x02\x4e\xce\x5b\x25\x56\x2b\x96\x21\x0c\xbe\x20\x76\xe3\x35\x0a\x10\x53\x39\xb3\x7e\xf8\x3c\x0c\x6b\x88\x18\x77\x8d\xcc\xe9\xbb\xf6\x07\xe7\x72\xf2\xf2\x5c\x73\x82\xba\xbc\x42\x18\x42\xd8\xd4\xc2\xae\x48\xb2\x82\xe9\x6b\x85\x35\xc3\x81\xd5\x17\x90\x5e\x21\x78\x3c\xc2\x65\xde\xb4\x33\xfd\x40\xe5\x77\x1d\xef\xfd\xfc\x2c\x32\x66\x43\xf9\x7b\x50\x64\xed\xe9\xff\x04\x81\xc0\x9a\xcf\xa1\xe8\x5c\x4e\xa5\x3e\x61\xcc\xa9\xc0\x1c\x91\x61\x43\x43\xb8\xab\xd8\x7c\x9d\x26\x2b\xb5\x96\xac\x4a\x7c\x2e\xd6\x97\x36\x64\x44\x57\x5a\xbb\xf8\x2b\xb1\x2e\xd9\x73\x71\xcb\xf7\x53\x3a\x8b\xbe\x12\x37\x1d\x29\x9d\x45\xdf\xaf\xda\xdf\x55\x31\x24\x69\x5f\x46\x59\x5e\x26\x97\x05\xcb\x30\x28\x14\xad\xb0\x40\xd2\x3e\x0a\x4c\x74\x22\x47\xb1\xe4\x7c\xb5\x56\xee\xa3\x84\x68\xb0\xee\x4b\xb1\x8f\x2a\x91\x2c\x31\xf4\x8c\x26\x76\xfa\xd2\x28\x13\x7b\xac\xa2\x4d\x18\xed\x8f\x2a\xaf\x45\xf3\x4e\x3f\xe2\x9d\x12\x63\x83\x4c\x4f\x1e\x8d\x02\xa2\x88\x4b\x6b\x06\xbe\xfa\x58\x63\xa9\x0a\xda\xed\x9b\x17\x7f\xe6\xfe\x73\x17\x36\x7e\xc4\x9f\xf9\xb4\x98\x8d\x13\x30\x43\x48\x9a\xd7\x66\xd5\x12\x18\x16\xd0\x25\x92\x24\xa9\x6d\x90\x65\xdd\xe7\xad\x75\xf7\x41\x4a\xd0\x25\x52\x82\x34\x54\x2c\x57\xde\x1b\xdf\x62\xac\x84\x7d\x30\x32\x0c\x11\x9b\x5c\x23\x45\x2e\x70\xac\xff\xdc\x60\xa2\x84\xb9\x46\xbf\x0e\x00\x65\x5a\x62\xc2\xa3\x54\x90\xdf\xee\x99\x7b\xc3\x79\x5a\x88\x59\x25\xca\xd4\x1f\xe3\x9a\x43\x9a\xce\xc6\x7d\x93\x66\xa3\x8e\xbb\x97\x42\xfb\x1d\x2f\x03\x18\xf0\x66\x8d\x47\x03\x76\xe6\xbc\x58\x94\x2c\xa8\x69\x2c\x9f\xa3\xaf\x29\xa5\x22\x52\xc9\x62\xbb\x7d\xe2\x7e\x56\x01\x2b\xcd\x7b\x25\x0c\xe2\x50\x9b\xf1\x8d\x35\x3d\x0d\x0a\x7c\xcc\xa9\xe8\x88\x81\x75\x6a\xa7\xf3\x35\xe8\xc7\xaa\x76\x99\xdf\xae\x7f\x6a\x35\x96\x7f\xe4\x8b\x1e\x5e\x37\x48\xd3\x44\xcc\x4c\x10\x99\xba\xc0\xb9\x2e\x90\x89\x0d\x03\xe9\x23\xb8\xf0\xdc\x2e\xf3\x82\x21\x16\x86\x5f\xf7\x5d\x57\x95\x5b\x71\xbb\xfa\xab\xea\x4d\x98\xea\xf9\x9b\xe9\x6c\xcc\xc6\xb8\xd2\xab\x11\x46\x75\x1f\x63\xa3\xdd\x15\x0e\xda\x86\x4f\xd9\xd1\xd1\x18\x2b\x24\x34\x19\x17\xa4\xe6\x94\x65\x01\xe1\xae\xe8\x70\xcc\x9e\x56\xc5\xd9\x60\x50\x95\xbd\x5c\x5f\xea\x13\x18\x34\x40\xfc\xa5\x1b\x08\x52\x54\x23\x35\x1e\x39\x04\x0b\xcf\xcc\x2d\x0e\x11\x5c\x53\x35\x03\x50\xe6\x5d\xb8\x7a\xd9\x99\xac\xef\x41\xde\x85\xc0\x1b\xc5\xab\xe4\x26\xe0\xbf\xa9\xbc\xe0\x1e\x36\xbe\x30\x7c\x85\x58\x74\x61\x82\x28\xe9\x36\xc9\xcb\xc6\x85\xf8\x9b\x03\xf8\x87\x37\x59\xbd\x50\xe8\x37\x3b\x56\x54\x4d\xce\x91\x55\xd8\x92\x57\xc8\x76\x54\xf7\xf4\xd6\xad\x30\x0b\x43\x1e\x86\x7b\x0b\xdc\xa6\x32\x41\xd5\xdb\xb9\x15\xed\x92\xff\xa3\x1b\xf0\xee\xd0\x06\xec\x8f\xff\x6d\x6b\xe5\xad\x34\xb7\x6e\xeb\x27\xdd\x96\xa6\x69\xde\x78\x89\x3f\x37\x0c\x76\x34\x76\xc6\x2c\xae\xf1\x2e\xe5\xa4\xa0\x82\x24\x74\x48\x72\x2a\xc7\xf9\x38\xa7\xe7\x28\xc7\x38\x19\x0c\xc6\x39\x1d\x8e\x6b\x2b\xab\x62\x9c\x8e\x53\x7a\x8e\x52\x8c\x73\xfb\x4a\xd2\x78\xf8\x34\x39\xca\xc7\x58\xd2\x73\xf0\xe2\x3c\x3a\x72\xc9\xf9\x51\x32\xc6\x05\x3d\x87\xb0\x22\x2e\x59\xe7\x9b\x87\xe4\x28\x98\xc0\xc0\x9f\x28\x29\x14\x93\x3c\x51\x0c\xbb\x4b\xc7\x36\x67\xaa\xef\x0c\x93\x66\x9f\x50\x30\x9c\x27\xf0\x23\x54\x12\xa9\x4f\xb7\xde\xfc\xbe\x35\xa9\x30\xa2\x06\x94\x50\xee\x35\xbb\xdd\x26\x3a\x1f\x8f\xb1\x34\x58\x40\x5f\x47\xf4\x1c\xd9\xa3\xcd\x75\x23\x1a\xab\xef\x35\x22\x3a\x1b\x71\x4f\x14\x61\x22\xe8\x39\x12\xa6\x11\x23\x66\xaa\x82\xd2\x8a\xc1\x00\xbf\x05\x13\x31\x0f\x3f\x54\x58\x87\xb7\xb0\xce\x5b\xc4\x5b\x58\x47\x79\x1b\xf8\xbe\xd6\x2c\x97\x22\x0c\xef\xb8\xff\x20\x28\x2a\x85\x79\x36\xe5\xd4\x04\x8f\x0f\x72\x2f\xf4\xbb\xfb\x61\x85\xfa\x93\x46\xc1\x38\xc8\x39\x67\xf2\x1d\xfb\xa8\x02\x4c\x4a\x51\x77\xf8\xc2\xf0\xba\x6b\x11\x5d\xcc\x2d\x13\xa0\x4b\x39\x04\xdf\x4e\x07\x4a\x0c\x60\x8c\xea\x2c\x78\x0c\x53\xa7\x13\x51\x4d\x94\x48\xfa\x1c\x61\x88\x84\x64\x67\xee\xe1\xd4\x30\x04\x86\x8d\x52\x39\x65\x33\xc0\xac\xd6\x24\x4a\x1c\x31\xc3\x50\x41\x84\x06\x9a\xe8\x82\xe2\xc8\xf0\x46\xd3\xe2\x48\xcd\x20\x48\xc3\xf8\xc0\xb0\x2a\x5f\x7b\x46\x46\x4f\xd5\x64\x74\xa4\xec\x4b\xa3\x98\xb4\x8b\xd6\x53\x7f\x5e\xad\x75\xed\x4b\xa6\x4b\x4b\x21\xd4\xc4\xfd\x30\xd6\x3e\xb1\xfb\x9c\xbe\x47\xd8\xe3\x29\x9e\x79\x2c\xd8\x32\x2f\x5b\xc7\xda\xd9\x71\x7a\xe7\xd8\xd9\x72\xf2\x44\xe5\xf6\x81\x4c\xca\x09\xab\xa2\xb8\x55\xb4\xf6\x0b\xae\x98\x9c\x27\x29\x6b\x4a\x02\x18\xde\xe3\x33\xa5\xc6\x60\x48\xc1\xbb\x5d\x78\x62\x1f\x78\xa3\x10\x63\x37\x30\x1d\x43\x30\x02\xc8\xb1\x01\xf1\xa8\x88\x5d\x39\x0e\x0c\x84\xaf\x7b\xce\xcb\xe7\xad\x28\x7c\xd4\x6a\x02\xf9\x5e\x7c\xbe\xc9\x7e\x52\xdc\x1f\x41\x7c\x03\xd3\x24\x08\xdc\xf0\xe4\x96\xfb\xfa\xd2\x77\x72\xcd\xe2\x66\xd2\x8f\x49\x51\xda\xd5\xea\x62\x7c\xe8\xa1\xd2\xf5\x56\xfc\x67\x03\xf5\x41\x4b\x30\xa2\x73\x21\x8a\x96\xe1\x62\x2b\xb3\x61\xc5\x68\x36\x42\x33\x7f\x92\xb8\x06\x2b\x13\x45\xf0\xf7\xd4\x25\xaa\xbe\xea\xfe\xff\x06\xb8\xbd\xe1\x33\xbb\xcc\x8d\xa4\xe7\xe4\x04\x34\xbe\x51\xc6\xdc\xf6\x22\x4c\x46\xc3\xef\x3b\x07\x69\xa3\x23\x7a\xc3\x6b\x9b\x21\xfe\x68\xec\x1d\xab\x12\x74\x3a\x23\xe0\x27\xad\x3f\x58\x46\xff\x93\x30\xc7\xaa\xd1\xbf\xd5\xd5\xfe\x5e\x2f\x90\x9d\xcd\x33\xef\x0d\x8b\xfd\x09\xfd\xfa\x99\xe5\xff\x6a\xd8\x39\x67\x86\x68\x79\x30\x25\x56\x7f\x66\x77\x9a\xb5\x31\x8d\xc0\xf3\xe2\x97\xa2\x8e\x0e\x17\x5d\xb1\xbb\x53\x4d\xf2\x8e\xbd\xf2\x86\xc1\xb2\xdd\x9e\x9c\x7c\xe7\x34\xca\xba\xa0\x5f\xee\x5c\xb2\xb2\xb4\x5c\x93\x12\xab\x36\x73\xa6\xc4\xea\x87\x62\x2d\x1f\x26\x7e\xfd\xc5\x27\x57\xc1\x20\x58\x25\x79\x41\xba\x8c\xe7\x40\x40\xac\xd1\x05\x9b\xb0\x08\x5e\x6c\x69\x52\xa6\x7f\x3e\xb4\x14\xa0\x7d\x30\xef\x76\x9f\xf1\xac\x9a\xe1\x2f\x48\xe1\x8e\x69\xd9\xdc\x47\x27\x30\xfd\xdb\x65\x9e\x2e\x2d\x37\x76\x26\x68\x7f\x48\x6e\x45\x5d\x4b\xa3\xb6\x17\xc0\xf7\xc5\xd5\x1c\x14\x8c\x8d\x30\x4a\xe9\xad\x08\xc3\x33\x61\x6a\xb3\xd6\x2a\x00\x97\xe1\x2d\xc4\x1f\x2b\x39\xc7\xa9\x0b\xed\xde\x31\x76\xc3\x50\xf6\x97\x22\x0c\xcd\xce\x4f\x10\xa3\x2f\x90\x41\xb8\x1a\x55\x1a\xb6\xaa\x71\x4b\xd4\x49\x0d\xe4\x0d\xa9\xa7\x02\xc4\xb0\x86\x06\x1c\xef\x2f\xdd\x79\x52\x2a\x16\xf8\x43\xee\x58\x31\x70\x5c\x57\x75\xdc\x5d\x55\x05\xe3\x55\x2e\x42\x2f\xd6\xbf\x6d\x81\x30\x74\x05\xdc\x13\x50\xcb\x44\x86\xe1\xe8\xa9\xf9\xd5\x0c\x6a\xd0\x33\x89\xe6\x59\x30\xd8\x0c\x97\x61\xf4\x0f\x10\x7e\xf1\x74\x99\x48\x0d\xa4\x55\x91\x5d\xd7\x80\x0f\x80\xc1\x47\xbb\x41\x66\xdf\x3e\xb1\x4b\x3f\x38\xe2\x9b\x7e\xc1\x11\xc3\x78\x73\x2e\x3a\xd5\x89\xe7\x22\x92\xac\x54\x42\x32\x4d\x0c\x48\x51\x14\xcc\x84\x63\x30\xc6\x28\xdf\x3d\x0e\xdc\x13\x73\x7f\xe2\xc8\xe3\xe7\xf0\xf8\x60\x4d\xbf\x98\xb5\xea\x20\x8d\x10\xf1\x7f\xd1\x63\x7b\x25\x26\x2f\xf5\xff\x0e\x81\xc5\x2f\x05\x9d\xb2\x59\xfc\x4a\x50\x56\x17\xfd\x2f\x43\x7f\xbc\x12\x4e\x98\xff\x4a\x10\x45\x5f\x0a\xbd\xcc\x2f\x05\x7d\x65\xb9\x73\x3d\x5b\xa2\x70\x4d\x49\x28\x9f\x3b\xfb\x01\xa9\x29\x9b\xf9\x23\xf8\x53\x43\x12\x09\xf1\x1f\xaa\xbc\x2f\x2a\xf8\x7e\x5b\x3d\x5d\x60\xca\x8f\xdf\x0a\x78\xa4\xde\x0b\x21\x01\xe9\xbb\x79\xce\x93\xa2\xb8\xdb\xbc\x05\x20\xfd\xaf\xc6\xdb\x5e\x8c\xb5\x1e\x5e\xe7\x22\x63\x86\x88\xaf\x7f\x37\xd5\x4d\xce\x38\xbf\x12\xd4\xa8\x49\xbf\xff\x4e\x4c\xcd\x5a\xce\xe2\x86\x98\xc6\xa3\x58\x14\x6b\xe1\x28\x17\xdf\x96\x45\xa5\x4c\x2d\xe5\xb7\xdd\xda\x87\xb6\x59\x94\x0a\x29\x59\xb9\x12\x3c\xcb\xf9\xe2\x7d\xe9\x4c\x83\xe0\x09\xd9\x83\xb9\x98\x3c\x02\x36\x5f\x0f\xfd\xdd\xdd\x4a\xa3\xb8\x5a\xfa\x10\x7b\x5b\xc7\x59\x1d\xcf\xc1\x27\x54\xc1\xb2\xb6\x8f\x82\xf6\x7b\xe3\x3e\xb9\xea\x69\x0d\x15\x0d\x04\x0f\x06\xce\x1c\x01\x24\xea\xae\x58\x25\x96\xd8\x6e\x11\x3f\xf8\xca\x60\x96\xdf\x04\x9a\x2b\x6b\x3c\x37\xa6\x48\x60\x83\x91\xe8\xbc\x2e\x8b\x4f\x0e\x6f\xa0\xf5\x79\x18\xde\xe8\xa3\x73\xbb\x64\xac\x00\xb4\x06\xf6\xda\x55\x67\xf9\xf5\xca\x74\x54\xbd\x70\xff\x23\x4b\x34\xe9\x8e\x02\x23\x96\x8a\x4c\x4d\x12\x3c\x8a\x86\x01\xc6\xc4\x93\xa1\x08\x1f\x36\x60\x73\xdd\x93\xfc\x8c\xd6\xc0\x81\xc3\xd0\x93\xd9\x35\x41\x25\x0c\x51\x00\x6a\xfc\x4b\xf1\x11\xa0\x61\xbb\x0d\x64\x92\xe5\xa0\xa5\xf5\xa1\x5a\x7a\x5d\x41\xb7\x13\x53\x8f\x65\x41\x6c\xe9\x5b\xc2\x69\xed\xe0\xef\x91\x90\xcf\x59\x99\xca\x7c\x05\x4f\x46\x35\x88\xd0\x3a\xfe\x01\x3c\xb3\x17\x04\x03\x50\x65\x40\x00\x8f\x2e\x6d\x46\xd7\x22\xeb\xbe\x0e\xe4\x94\xac\xe2\x33\x0e\x05\x6c\x50\x64\xe3\x45\xb4\xe0\x5e\x58\x88\x76\x3c\x0b\x02\x31\xad\xf7\xcc\x29\xa1\xff\x9a\x6e\xc1\x3b\x52\xb2\xa6\xa9\xa0\x99\x97\x01\x1f\x9f\xc0\x01\xa7\xc2\xcd\x82\xa9\x5f\x0e\x84\x30\x10\xd0\x56\x2b\xb7\x6a\x70\x47\x4a\x7d\x2d\xcb\x24\xbd\xca\xf9\xc2\xaf\xce\xa2\x0b\xd8\x0f\xc8\x63\x56\x2d\x69\xdf\x08\xd1\xeb\xeb\xab\xc0\x0a\x66\x88\xbd\x46\x0d\x88\x67\xdd\x6c\x03\x76\xdf\x8f\x2d\xcf\x5c\x4c\x33\xef\x95\xd1\x4a\xf4\xe2\x57\x1d\xfb\x61\xa2\xad\xdd\x2b\xa7\x00\x22\x30\x35\x64\x76\x7e\x5c\x07\x92\x41\xc2\x02\x18\x8b\x2c\x84\x4d\x02\x25\xd7\x2c\x88\x83\xb9\x26\xcc\x83\x98\x19\xae\x09\x13\xc4\xa8\xc0\xf6\xd1\x7e\x88\x26\x62\x9a\x64\xb8\xa9\x26\xc8\x59\xeb\x19\x06\x46\x9f\xd5\x04\x2d\xfa\x49\xd8\x47\x7f\x0c\xd5\xe9\x2c\x09\x69\x60\x52\x03\xf2\x17\xcd\xe5\xff\x04\x02\x3f\x4f\xe3\x0d\x4b\x70\xab\x53\xaf\x50\x43\xf1\x50\xba\xc5\x49\x18\x3a\x85\x87\xc1\x2b\x0b\xa9\x5a\x07\x5c\x23\x37\xb8\xb1\x4d\x4f\xd4\x33\x52\xf5\xf0\x72\xc6\x10\xde\xfc\x2c\xc2\x10\xfd\x2c\x80\x6e\x4c\x97\x80\x1a\x50\x20\xf8\xca\x02\xb3\x1b\xeb\x9c\x61\xf2\x5e\xd0\x9f\xcd\xc5\xe6\xbf\x1c\x04\xa3\xb2\x47\xd5\x44\xb4\xb3\x55\xcd\x65\x52\x32\xf4\xde\x84\x16\xa4\xb9\xfe\xa9\x17\x03\x76\x9d\x7c\x81\x52\xd6\x14\x34\xad\xaa\x05\xd5\x83\xff\x51\xa4\xeb\x12\x9a\x9c\x20\x3d\x54\xf2\xb3\xa0\x4a\x0f\x82\x93\x9f\x45\x94\xa8\x4f\x8d\x57\x33\x92\x96\x92\x36\xe8\x31\x6b\x98\x16\x5c\xba\xe5\xd4\xa5\xcc\xe3\x73\xb9\xe0\xde\x8a\x6d\xb7\x35\x1b\xd0\xf8\x06\x52\xdd\x5f\x53\x33\x47\x4f\xa1\xd8\xda\x05\xd0\xd2\xb4\x2a\xf8\x48\xf0\xba\x59\xfe\x85\xaf\x08\xe9\xde\xc5\x56\x03\x0b\xf6\x99\x5c\xcf\x85\x87\x76\xdb\x9c\x7d\x6d\x7a\xb9\x60\xea\x95\xc8\xf2\x79\xce\x24\x50\x50\x93\xfd\x24\x4d\x21\xf5\xfb\x88\xd1\x67\x42\xd3\x32\x61\xd8\xef\x83\x3b\x5e\xad\x4b\x63\x35\xea\xb9\xf0\x20\xf5\xee\x73\x87\xfc\x71\x8f\x8a\xd0\x18\xbe\x23\xd4\x21\x8b\xbb\x5f\x8e\x88\xbc\xf0\xe6\x9a\xf6\x80\x00\x85\x4d\x0e\xe8\xd6\xbf\xf8\xcc\x13\xa7\xb5\x78\x0d\xe4\x83\xce\xc3\x63\x8c\x15\xad\x7e\xbb\xc7\xa7\x87\x7d\x8a\x4e\x42\x15\xb1\xf9\x9c\xa5\xea\x5d\xb2\xa8\x8e\xa8\x51\xbb\x7b\xb5\x35\x39\x5e\x35\x40\xee\xa9\xe8\x28\xf1\x47\x26\xba\x79\xb2\x98\x9c\xc4\x8f\x3c\xad\x10\x6b\xbe\xe8\xa1\xf1\x24\xd8\x9e\x81\x24\x86\x27\xc5\x8f\xf9\x25\x93\x38\x0c\x4f\x34\x3b\xc5\x1a\x1c\xf9\x19\xd4\xd5\x6c\x1a\x64\x18\x1d\xfe\xb7\xdf\xfa\xca\x90\xd3\x06\x25\x50\xad\x45\x03\xfb\xf6\x94\xa9\x0f\x04\x98\xaa\x5b\x21\x23\x20\x11\x2d\xf3\x56\xc7\x53\x62\x44\x52\x35\x1e\x3b\xe3\x10\x27\x73\x21\x09\x2d\x26\x9e\xa8\x35\x76\x9e\xbf\xfd\x62\xbb\xed\x27\x46\xf2\xaa\xbf\x0b\x63\x92\x4c\x29\x4d\xcc\xaf\x5a\x95\x92\x53\x9b\x39\xce\x8d\x14\x37\xa7\x94\x56\x3a\xd9\x33\x86\x0a\x4c\x60\xf0\x3a\x5d\x36\xd3\xd5\x38\xa7\x79\x54\x9a\x97\xcb\x77\xd5\x52\xe4\x73\xe4\x46\xd8\xa7\x54\xda\x9f\x98\xd3\x82\x48\x9a\xd8\xad\x77\x76\x15\x56\x0a\x6d\xc7\x90\x9a\x31\xa4\x30\x06\x5d\x68\x48\x5c\x35\x13\x33\xdd\x66\x4a\x9b\x29\x69\x41\x78\x95\x99\xd2\xb4\x1a\x8d\x5e\x05\xeb\x4e\x9c\xba\x59\x77\x37\x9f\xe8\x56\x0e\x35\x9f\xe8\xfe\xbb\x9a\x37\x4c\xd5\xb7\xdf\x05\x78\xb7\xf3\xc4\xd2\x7a\xc2\x66\x3f\xbf\x1b\x06\x15\x4f\xf8\x48\xdf\x8c\x1a\x12\xbd\xad\xe6\x35\x6b\xe5\x4c\x2d\xc0\x39\xb0\x11\xa5\xec\x75\x75\xbb\x23\x46\x01\xb2\x70\x43\x63\x58\xab\x1b\xd9\x78\x5c\x29\xf1\x54\xad\xc4\x53\xbe\x12\x4f\x8d\x2d\xef\xab\x21\xc0\xfe\xb5\xbb\x43\x15\xd1\x87\xcb\x2c\x93\x3b\x9c\x0a\xf0\xa7\x81\x22\x38\x8c\x7d\xe5\x16\x60\x6c\x5f\x9d\xb0\xd5\x35\xab\x6d\x1b\xf2\x50\x2e\x0c\xb1\x3e\xb3\xbb\xaa\x76\xd5\xa9\x03\x64\x5d\xc8\x2d\xad\xcf\x48\x7b\x2a\xc4\xff\x6b\x2b\x11\x86\x8f\xfb\x2e\xfb\x7f\xd9\xa2\xbc\x6a\x72\x1d\xb5\x9e\x2d\x13\xf6\x51\x90\x0d\x8b\x40\x3d\xa5\x84\x2c\x9d\x45\x8d\x05\x67\x40\x2d\x56\x57\xa2\xc6\xbc\xc2\xb3\xbc\xc2\x2f\x80\x4a\x10\xa7\x15\xf8\x1a\xcc\xd4\x80\x5c\x17\xaa\xeb\x05\x9f\x0b\xfb\xb0\xc1\xb8\xa3\x4f\xa2\xe8\x19\xe2\xd8\xaa\x7d\x95\xd3\xd1\x0c\xc7\xfc\xa9\x5f\xda\x0b\x19\x07\xd8\xd3\x65\x4c\xf9\x8c\xfc\x55\x20\xcd\x32\xad\x5e\xb2\x1b\x56\xbc\x03\x5e\x85\x30\xff\x16\x06\x2a\xc9\x4f\xf0\x09\xa5\x97\xb0\x54\xbf\x0a\xda\xef\x7b\x57\xea\x9b\x36\x3d\xca\x27\x57\x55\xc8\x5a\x4e\x14\x79\xcb\x1a\x3e\x16\xb8\x75\xfb\xfd\xd6\x59\xdf\x6a\x7c\x1e\xd2\xc0\xdb\x9a\x8e\xf9\x55\x54\xaf\x86\xc1\x0a\xc1\xa3\x12\x67\xa8\x72\x88\xa2\x7c\xbb\x75\x06\xa8\xb5\x5d\x86\x01\x70\x7b\x55\x71\x0c\xec\xb3\x71\x7f\xfe\xbb\x68\x48\xc3\x05\xfd\xbb\xb0\x12\x70\xd1\x58\x46\xca\x88\x68\xa8\x29\x14\x11\x1e\x2c\x81\xd6\x42\xd8\x17\xe8\xe9\xc6\xaf\x19\x33\xe2\xd5\x8b\x15\xa9\x6b\xc5\x9c\x54\x9b\x17\x4f\x67\x3b\x10\xb5\x7c\x81\x5e\x31\x50\x6d\x5a\x21\x4b\x73\x3b\x9d\x69\x52\x43\x63\x62\x92\xfc\xd1\x98\x94\x36\xc8\x18\xa7\xfe\x6a\xca\x61\xf8\xf7\x4a\x1c\xe5\x73\x59\xef\x58\xeb\xa1\x31\xb7\x6d\xd3\x16\x37\x3e\x83\x77\x51\xbc\x04\xc2\xa7\xc1\xaf\xec\xf2\x2a\x57\xc1\x80\xcd\x68\x70\x6b\x7f\x2b\x9d\xf1\x4a\xfc\xdd\xa4\x5e\xeb\x1f\x90\x74\x5d\x9a\x94\x57\x6f\x6d\xc2\x6b\xf3\x2d\x82\xc1\x5e\xcb\x9e\xae\xd7\x61\xb9\x3f\x02\x6d\x68\x47\x07\x1f\x70\x18\xff\xec\x27\xdb\xd8\xd5\x84\x53\x48\xae\xcd\x14\x39\x86\x2b\xb8\x83\x6f\x87\xec\x1f\x44\xa3\x61\xca\x35\xab\x6f\x05\x55\x81\xa7\x62\xf6\xa9\xc7\x07\xbe\x55\xf6\x85\x00\x93\xa5\xe9\x17\x62\x46\xff\x24\x06\x03\xf2\x5f\x62\x0a\x5f\x33\xba\xd9\xe1\xfa\xcb\xd3\x83\x32\xe7\x58\x30\x06\x39\x5a\xe3\x05\x78\xda\xf8\xde\xe7\xdd\x5e\x34\xb6\x13\x9a\x1a\x33\x4f\xbd\x2d\xc6\xdc\xe0\xe4\x47\xa0\xa9\x72\x82\x2e\x48\x12\x94\x0d\x78\xe4\xe9\x4e\x9d\x56\x93\x3d\x05\x92\xec\xfb\xca\xb6\x7d\x03\x6e\x2d\x9c\x88\xf9\xbc\x64\x2a\x56\x47\x6c\x37\x86\x23\x61\x14\xee\xae\x0f\x0e\x11\x04\xde\x1a\x84\x8d\x37\x1a\x8d\x7a\x09\xce\xf6\x6a\xa7\xd3\x3d\x23\x1f\x67\xe0\xb3\x83\xf1\x73\x5f\xde\xf8\xfc\x77\xcb\x1b\x7b\x2a\x0c\x91\x27\x74\x0c\x43\x10\x34\x5a\xa9\x93\x31\x1f\x6d\x48\x1e\xf5\xb7\x74\xdc\xa8\x7d\xb1\xfc\x2c\x33\x71\x36\x3d\x24\xfa\xac\xc6\x56\x85\x34\x56\x3a\x94\x72\xfd\x4b\xf6\x29\x3d\xe3\x08\xef\x19\xf3\x71\xca\xa5\x03\xae\xd2\xb1\x8c\x6f\x55\x22\x41\xa9\xcd\xc3\xf0\xb9\x9e\xf5\x84\xd3\x0d\x28\x0f\xf4\xe5\xd2\x28\x45\x18\xcf\xfc\xc4\x33\x9e\xed\x5c\xf8\xe3\x05\x53\x15\x17\x3a\x41\x9c\x76\x24\xeb\xe3\x45\x37\x09\x4f\x97\x42\x82\x58\x93\x47\xf5\x07\x31\x3f\x5f\x9b\x7d\x75\x39\xe6\x93\xcc\x35\x2f\x6d\xab\x54\xbf\x4d\x6a\x55\xc1\xfb\xda\xe1\xb8\xb2\xd5\x92\x32\x0c\x4f\x39\x92\x92\xe8\x99\x81\x1e\x47\x4a\xc0\xa3\xbe\xb0\x43\x49\x3b\x2b\x22\x40\x03\x59\x4b\x3b\xac\x25\x5f\x85\xf8\x28\x97\x56\xe8\xe1\xed\xc5\x7f\x7e\x2e\x37\xf8\xb7\xcf\xad\xf0\xe3\xe7\x56\xf8\x7b\x83\x1c\x71\x5a\x3c\xbb\xf9\xa9\x55\x98\x18\x6d\xda\x90\x52\x6a\x64\xd3\x36\x19\x87\xe1\xc8\x72\x43\x88\xd1\xd1\x23\x1c\x33\xaa\xc8\xa3\x93\xa7\x9a\xa5\x87\x1c\xcd\xa6\x7a\x0f\x1f\xfe\xfa\xb9\xa3\xfb\xeb\xe7\x56\xf8\xe5\x73\x2b\xfc\xf9\x73\x2b\xfc\xf1\x73\x2b\xfc\x00\x2b\x3c\xfc\x7e\x65\x44\x83\x8e\x6f\x98\xcb\xe9\x4a\xce\x88\xf9\x63\xae\xc8\x95\x3c\x3a\xf2\x2a\xfe\xc5\x9e\xdc\x95\x1c\x0c\x5c\xb9\xaa\x7e\x6d\xec\xed\xeb\x20\xfe\xcb\xc7\xff\x5f\x80\x1c\xf0\x5a\xc6\x97\xb2\x0a\x71\x54\xeb\x60\x58\xd3\xc0\x17\x2e\x08\xff\xd5\xc4\xa6\xa1\xef\x2b\x3e\x76\x11\x42\x1a\xa6\xc0\x22\x0c\x45\x74\xd1\xe4\xc5\x5f\xb1\x6b\x91\xff\x9d\x65\xef\xf9\x75\x52\x5e\xb1\x0c\x6e\x82\x53\x1b\xd5\xc7\x7b\x52\xe7\x60\xc5\x57\x7b\xd5\xc6\xce\x32\xd5\xbe\x33\x6a\x1d\x2f\x0a\x30\xa6\xf0\xa2\xed\x0b\xab\x3d\xf1\x35\x60\x9f\x33\x3a\x75\xb8\xfc\xfe\xa0\x68\x81\x89\x47\x16\x7e\xe1\xaf\xfd\x89\x33\xc6\xac\x43\xb8\x98\x15\xf6\xea\xc3\x32\x7b\xaa\x2a\xa5\xeb\x7f\x61\x64\x14\xe8\x07\x86\x96\x12\x9c\x29\x18\xba\x94\x4d\x01\xa2\x52\x8e\x82\x35\x6d\x9b\xfd\x2d\x85\xe5\x62\x9f\x68\x56\xf5\x2f\x50\x0d\x02\xff\xfe\x05\x9a\x6a\x9a\xcb\x73\x75\xc0\xbe\x1b\xbc\xce\xbb\x87\x3a\xee\x0e\xbc\x6d\xde\xaf\xf0\x0a\xd7\x0c\x9b\xbe\x37\x5b\x99\xa8\x76\xca\x2e\xec\x26\xea\x3f\xd2\xf0\xe6\xc3\x6f\x03\x02\x12\xb0\xed\x36\x78\x6f\x7c\x25\x02\x52\x54\x57\xe4\x47\x6e\x5e\xde\xf0\xed\x3b\x85\x72\x4c\x26\x2c\xdd\xbe\x40\xbd\x86\xd7\x4a\x90\x03\xc1\x65\x0e\xed\x33\x93\x8b\xe6\x3e\x6f\xb7\xaf\x38\xb9\x96\xb4\x3e\x46\xfb\xab\xbb\xac\xf3\x40\x7e\xee\xa9\x7e\x0e\xad\xf4\xd8\x3c\xec\x0f\x3b\xf6\x5d\x80\x5d\x19\x49\x61\x6f\xae\x25\x1e\xf3\x87\x8f\x91\x4a\xb2\x07\x31\x76\x94\xe0\xb9\x03\xac\x80\x2b\x31\x36\x63\x56\x0d\x88\x28\x54\x23\xd6\x9d\x4a\x2a\x0b\xa9\x2b\x76\xe7\x2c\xa3\xaa\xc1\x1b\x71\xaa\xaa\x58\x00\x93\x6b\xc8\x26\x6b\x2f\x05\xd2\x2b\x1b\x1b\x0f\x98\xe4\xba\x20\xd8\x97\xd0\xa1\xf9\xb8\xb6\x93\xb2\x8f\x46\xd4\xb1\x47\x20\x38\x2f\x6d\x94\x81\xa8\x03\xf6\x95\x4f\x06\xaa\x51\x2f\x45\xb2\x79\xa3\x0f\xb3\x66\x0e\x82\x93\x05\xe5\x26\xa7\x12\x3f\xba\x11\x14\x49\xa9\xce\x20\xd1\x34\x04\xf4\xab\x9f\xa0\x69\x42\xfb\x5d\x77\xc0\x3e\xae\x72\x63\x14\xfa\x2e\xbf\x66\xae\xad\x4a\xaa\xd4\xf2\xbe\x49\x54\x3b\xd2\x52\x2d\x66\xf4\xe8\x2f\x4a\xa9\x98\x20\x01\xe1\x4c\xf4\x8e\xe8\x7d\x30\x01\xd3\x08\xeb\x98\x12\xd6\x6c\x1f\xf0\x83\x46\x4e\x2c\xbc\x1d\xf2\x0f\xb5\x67\x3f\x49\x35\x4e\xac\xbf\x04\x8e\x91\x68\x2c\x89\xd8\x9b\xae\x68\xac\x88\x4d\xf2\x16\xcd\xf0\xae\xa2\xbd\x22\x9c\x88\xd6\x2e\x11\x1b\x67\x81\x5a\xec\x42\x44\x6b\x67\x59\xf3\xdb\xcb\x37\xd0\xc1\x9a\xdf\x44\x34\x40\x85\xf9\x5f\x7a\x2d\x2c\x3c\x32\xf7\x8b\x58\xd3\x26\xca\xcc\x5f\x22\x00\x68\x4c\x78\x0d\xcf\xee\x32\x6f\x6c\x96\x74\x34\x62\xe1\x96\x39\x31\xa4\x92\x23\x93\xf6\xe5\xf0\xc5\x04\x49\x3f\xe2\x4d\x18\x16\xf7\x04\x0a\x9a\xd8\xdd\x3e\x21\x09\x51\x38\xb6\x5f\x43\xf8\x22\xd2\x6c\x70\x41\x64\x73\x31\x5d\x98\x90\x78\x4f\x37\x00\x9d\xdb\x46\xbe\x7e\x68\x23\x6d\x43\xaa\xa2\x8a\x42\x56\x84\xa1\x13\x61\xd4\xb9\x20\x59\xd2\x73\x3c\xd8\xa2\xc6\x6b\x8f\x86\x81\x15\x84\x14\x93\x22\x76\x75\x49\x10\xe8\x11\xed\x41\x8b\xe7\x23\x92\xaa\x3d\x22\x4b\xb9\x29\x8d\x86\x44\xe8\x39\xa9\x56\xc7\x44\xed\x37\xe9\x11\x3c\xa5\x6a\x89\x7d\xaa\x06\x9f\xd8\x38\x36\x9f\xdd\xe4\xfa\x60\x93\xdf\xd8\x33\x0b\x6d\xda\x03\x6a\x9e\xbf\x95\x9f\xdb\x49\xd6\xee\x84\xb9\x4e\xbe\xab\xc6\xcd\xf6\x5b\xf0\x38\xee\xf9\xc1\x61\x3e\xf6\x87\xd9\xda\x46\x17\x10\x65\xbb\x9d\xce\x3a\x87\xe8\xa1\x9a\x4d\x43\xa4\x18\xb7\x44\x8c\xc4\xb6\x5c\xc5\x2d\x82\x61\x37\x2d\x37\x62\xd6\x32\xe5\xd8\xf9\x6b\xb0\x52\x07\xde\x37\xf2\x8c\x81\xf6\x9e\x91\xa9\x55\xa0\x8e\x42\xe8\x7a\xe8\xe5\xfe\x20\x0d\x6d\x6a\xe2\xfe\xd2\x46\x40\x9d\x97\xcf\xad\x2b\xd8\x76\xdb\x57\x91\x8d\x38\x52\x1a\x0d\x55\xa5\xc9\xd7\x23\x77\xda\xfc\x9c\xc3\x53\x35\x0c\x8f\x17\x92\xae\x54\xe3\x5d\xa4\x4a\x4b\x29\xf8\xa9\xb8\xbe\xce\x15\xb4\xf3\x46\x08\x65\x1c\x20\x31\xb9\x78\x60\x9d\xf7\xfc\x5a\xac\xb9\xab\xe6\x2d\x95\x1b\x93\xa7\xd9\x85\x15\xeb\xc0\x6b\x0b\x19\x86\x0b\xd9\x50\xaf\x5d\x1f\x2a\x7b\x21\xc3\xf0\xa2\x59\x76\xe1\xed\xe3\xe6\x32\x29\x6d\xbc\x35\x46\x9a\xd0\x15\x0f\x09\x5c\x38\x06\x50\xf4\x45\x63\x7e\xa5\xd6\x2e\xf1\x65\xee\x52\x96\x49\xe9\x47\x76\xeb\x8f\x48\x5e\xbe\xb0\x0f\xd2\xfc\x1d\xcc\x9d\x7d\x67\x48\x4b\x89\xb9\xd0\x95\x70\x85\x4d\xac\xb8\xca\x7e\x52\x15\x23\xf3\x0b\x6e\x41\x60\x08\x4c\x3a\x26\xf6\xe5\xbc\xe6\x58\xb7\xdb\x76\xca\xf7\xed\xc3\x02\x66\x01\xed\x03\xb4\x57\xc8\xd3\x25\xb7\x28\xc6\xea\xb2\x06\xe7\x55\xef\x8a\x1b\x3b\x8a\xc1\x78\x8e\x35\xee\xc2\x85\x02\xf1\x35\x36\x92\xe8\x3e\xa5\x7c\xe2\xdc\x29\x18\xe5\x7e\x51\x63\xb5\xc0\xbb\x6b\xc7\x4e\xc4\x4b\x59\x5f\x93\x26\x46\x5f\xe9\xa4\xdb\x6c\x72\xa1\x90\x20\xd6\x37\x08\xfc\xda\xf4\x62\x39\x29\x93\x5b\x62\x64\x4b\x11\xbb\x03\x38\xae\x52\xaa\xd5\xf5\x54\xe6\xfb\xb8\xdf\x3a\xd9\xe9\x6d\x35\xf7\xfe\x01\xd5\xb7\x8d\x40\xa5\xab\xfa\xb6\x70\x1f\x95\x1f\x5f\xc1\x71\x4d\x46\x3e\xe7\xcf\xda\x98\xc2\xe8\x13\xe9\xa7\x7a\x80\xca\xa3\xea\x77\x1b\x64\x79\x6b\x3f\x1d\x04\x1b\xe8\xb2\x40\x0c\x93\x6d\x81\x28\x8f\x1a\xdf\x0f\x01\xf2\x1d\x26\xed\xee\xe8\x90\xb4\x1a\x9a\xe8\x3d\xad\x87\x8e\x1a\x9f\x54\xb5\x08\xa9\x56\x65\xda\x1f\xd6\x7c\x5a\x42\xfb\x43\x78\x0f\xce\x4c\x25\xa5\xfd\x91\x8d\x79\x49\x73\xab\xdf\x2e\x69\xde\x1a\x10\xc4\x2b\xf8\xbe\x30\xd9\x6b\xda\x1e\xef\x18\x0e\xd3\x7a\xbb\x5d\x7f\x5f\x1a\x27\xaf\xd6\x7c\x4a\x4c\xd2\xed\x16\xa5\xa0\xef\xf5\x06\xee\x78\x19\x9d\x69\x47\x44\x73\x38\xab\x95\xca\xc5\xa4\x42\xa3\x00\x5c\xf6\x1c\xe4\x40\x77\x41\x98\xc7\x09\x62\xf4\x4e\xa1\x9c\x08\xc2\x08\x3c\x7a\xd7\x1f\xe2\x18\x95\x7e\xa2\x39\x16\xc9\xc4\xf0\x9c\x8c\x94\x38\xfe\xc8\x91\xfe\xab\x8b\x8f\x4c\x7b\xb0\x2f\x19\x74\xd5\xdc\x25\x78\xe8\xc8\x2d\x52\xe4\xf6\x34\x0c\x51\x49\x79\xe4\x6f\xb1\x1b\x75\xd9\x91\x47\xa7\x33\x4c\xac\x3a\x2e\xc7\x18\x34\xf7\x7a\xa6\xbe\x62\x11\xd4\x7c\x7e\xa5\x89\x67\x5f\xb1\xa5\x8f\x4e\xe2\xaa\x14\x2c\xcb\x76\xdb\x1e\xea\x76\x8b\x9a\x00\x6f\xc8\x79\xb3\xc0\xfe\xc2\xfb\x34\xc5\x6d\x0b\x47\xf9\x23\xa8\xa3\xd3\x51\x13\xa4\xa3\x99\xed\x10\xca\x70\xcc\x2a\xa7\x7a\x30\x14\x76\xec\xef\x94\x99\xa7\xda\x5d\xa5\x71\xfd\xd3\xd4\xed\x7e\x1d\x1a\x14\xf8\xa3\x80\x14\x98\x14\x16\x13\xf8\x92\xf9\x2b\x55\x07\x88\xae\x99\x5e\x33\x0b\x55\x85\x6c\x4f\x08\xf3\x99\x5c\xa2\xba\xec\x4c\xa8\xf1\xf3\x4d\xfc\xe0\xa3\x57\xec\x70\xa4\x50\x1b\x30\x96\x53\xde\xd5\x1a\x91\x75\x48\x3c\x69\x44\xcf\xd2\x3e\xf3\xab\x10\xc7\xe3\x1b\x7d\x65\x6f\x5a\xe8\xa6\x20\x3e\x3e\x8c\x45\x85\x36\x62\x49\x2a\x30\x37\xf7\xa1\x81\x51\xfd\x5b\xc3\x8e\x0b\x26\x61\xf1\x38\xfb\x68\x03\x56\x62\xc2\x10\x27\x05\xfe\x44\x44\xd4\xff\xef\xa6\x32\xfc\xfd\x53\xe9\x0c\xfe\x0a\xf7\xcb\xa1\x79\x88\x7a\x1e\xd6\xec\xdf\x3d\x1b\x78\x78\x1e\xb2\x39\x8f\x06\xc5\x12\x8b\x43\xbb\x32\x7c\xc0\x54\x24\xde\x39\xad\xe7\x26\xc9\xc4\x4a\x9d\x16\x49\x59\x3a\x37\xd6\xb8\x20\x95\x59\x70\x33\xa3\xa6\x09\xdb\x92\x5e\x22\x28\x88\x8a\x89\xa4\x07\x25\x95\x9e\xe4\x8f\x24\x54\x4e\x40\x62\x2c\x70\xfc\x8a\x8f\x1b\x7c\x04\x47\x8a\x24\x98\x98\xa3\x44\xe4\x3f\x28\x81\x15\x9f\x25\x81\x4d\x20\xaa\x27\xd0\xb5\x0f\x99\x7a\x4d\x49\xc9\xc6\x10\x35\xb6\x29\x8d\xdb\x85\xe1\x4d\x34\xbd\xe1\xb1\x43\x63\x6b\x3a\xf4\xf5\xb7\xd6\x1f\x23\x35\xcb\x67\xc2\xff\xae\x4a\x9a\x13\xdb\x40\x5b\x42\x01\x0c\x32\xbc\x0d\xf1\xca\x0f\xb1\x0f\x6b\x99\xba\x1b\xc2\xc5\xde\x6c\xac\xbf\x27\x3b\xe8\x0f\x2b\x25\xa0\x27\x45\xb8\x3f\x90\x2d\xd0\x9c\xfb\x02\xa3\x2d\x1d\x75\x06\x59\xd7\x43\xb3\x35\x7f\xcd\x8b\x02\xb0\x1a\xc4\x11\xb5\xf3\x22\x5d\x05\x10\x26\x05\x58\x8b\x41\x91\x30\x4c\xa2\x0e\xdc\x81\xa4\x5b\x1b\xfb\xfa\x9a\xbd\x1a\x20\x7e\x7b\x8b\x0c\x75\x8b\xf8\x11\xb8\x14\x52\x10\x49\x72\x4d\x2f\x7e\x6a\xc8\xcf\xf3\xcc\x8d\x98\xf9\xb7\xdf\x63\xbc\x23\xa6\x8b\xc3\xd0\x41\x2c\x8d\x92\x53\xe5\xcb\x66\xed\xc6\xaa\x96\x40\x2a\xb7\x23\x6c\xd1\x4f\x2e\x66\x7e\xab\xb4\xae\xdf\x80\xa3\xd2\x91\xc6\xa8\xa4\x36\x0c\xd4\xe8\xeb\xef\x2c\x50\xad\x35\xa5\x60\x23\xab\x67\x1a\xc0\x8c\x41\x49\xa6\xa1\x45\x91\x0c\x77\xdd\x7b\x79\x73\x5b\xde\xb0\x94\xe5\x37\x60\xa3\x5f\x6e\xb7\xa9\xa1\x2b\xd6\x94\xd2\x6c\xbb\x45\xba\x7d\xb3\x11\xf7\xd4\x42\xa5\xee\xc9\x16\xec\x53\xba\x3e\xb4\xad\xb9\x2b\x44\x2c\x7d\xb5\xde\x23\x2a\xcd\xeb\x26\x60\xa8\xe5\x6d\xf4\xc4\x92\xe3\x8d\x44\x92\x93\x92\x14\x38\x5e\x93\x3e\x4a\xfb\x94\x96\xdb\xed\xda\xbc\xce\x50\x4b\xd6\xcd\xda\xb5\x9b\x0b\xc3\xc6\x67\x8b\xb8\x71\xfa\x80\xfb\x97\xee\x79\x9e\x39\x5a\x28\x85\xa3\xd6\xd8\x46\xb3\x82\xad\x83\x0d\x54\x53\x03\xd4\x88\x95\x12\xcc\x69\xe9\x05\xdb\x4b\x7f\xd7\xa8\xe7\xee\xd1\x09\x00\xcd\x95\x0f\x9a\xe4\x92\x1a\x91\xd2\x78\xde\xe5\x37\xb3\x8a\xca\xa5\x58\x6b\x0c\x69\xe7\x66\x5a\x9c\x1c\x48\x47\x73\x52\x90\x0c\xc7\xfd\xcb\x1a\xb3\x6c\xb7\xfe\x57\x67\x0c\xee\xed\x16\xf5\x4f\x39\x4a\xc9\x1c\x6f\xb7\xfa\xd7\x9a\x14\x75\xb0\x97\xf9\x04\x75\x8c\xac\x05\x74\xa6\xff\x30\xec\x4c\x46\x25\x0c\xab\xeb\xd8\x77\x6d\x1b\x38\x30\x34\x36\x03\xc7\x5d\xaa\xaa\x7f\xfa\x96\xeb\xbb\xaf\xc4\x44\x22\x8d\x46\xf4\xb1\x31\x58\xa3\xc6\x13\x05\xa9\xce\x34\xcd\xc8\xdc\x17\x4b\x9d\xa9\xbd\x58\xd8\xad\xe7\x34\x8c\x19\x02\xa3\xa7\x32\x0c\xd9\xf4\x54\x76\x3c\xa7\xd1\xcd\x08\xc7\x2d\xef\x85\xd3\x06\xb5\x0e\xa1\xa1\x7d\x22\xbd\x3b\x7a\x90\xf5\xba\x34\xb1\x8d\xf1\x46\x51\xf7\x7b\xec\x0b\xc5\xc7\x1a\xe9\x9e\x38\x63\x4c\x83\xd1\x46\xc3\x40\x53\x16\x1e\xd0\x62\x62\x15\x7c\x8f\xbf\x81\x40\x2e\xee\xed\x84\x01\x1f\x37\x79\x19\xef\xf1\x0b\x13\xca\x1a\xfe\x44\x17\x46\xc6\xfd\x86\xcd\x29\xa5\xc5\x04\x12\xf5\xca\xf8\x52\x2f\x23\xa1\xb3\xb7\x2d\xa5\xaf\xf8\xc4\xfe\xde\xec\x62\xf3\x6b\x5c\x89\x2d\xac\x43\x8f\x9a\x16\xb3\x58\xff\x43\xd9\x4e\xd3\x1d\x75\x2f\x10\xce\xc3\x49\xd6\x5b\x11\x95\x46\x8f\xbf\x85\x78\xa0\x66\x35\xec\xbc\xbe\x83\xa8\x33\x6e\x32\x9e\x35\xb0\x5d\xfa\xda\x76\xa7\x4f\xab\x0b\xff\x9e\x17\x47\xf6\x8c\xb7\xdc\x93\x1f\x8e\xb5\xf9\xf4\x8b\x24\x6a\xef\x45\x12\x45\x02\xdf\xec\xfd\x5c\xb5\x5e\xa2\x75\x11\xdf\x59\xf5\xf8\xaf\xa7\xe6\x71\x3c\xbf\x98\xa0\xa6\x8a\x88\xa8\x86\x32\x08\xc7\xaa\xa9\x41\x6b\xe4\x12\xbe\xa7\x5e\xe2\x9e\xfa\xe9\x5b\xef\x80\x58\x1a\xdd\xf7\x93\xaa\xed\x86\xab\xd1\x8c\xb1\x82\x72\x44\xd0\x4a\xe3\x33\xee\xb4\xbc\x95\xf5\x93\xc7\x46\x86\xfe\x2a\x59\xb9\x66\xd4\x18\x57\x68\xfa\x8a\xdd\x4d\xe0\xb5\x06\xe3\xff\x6e\x9e\x55\x36\x9f\x46\x6b\x04\xa6\xb1\xaa\xdd\x99\xc7\x1d\x17\x7b\x22\xfb\x4a\xfd\x47\x58\xa5\xfd\xac\xd4\x52\x96\x23\xf6\x94\x85\xad\x17\x8f\x6d\xc7\x9a\x38\x9e\x38\xf2\x49\x6f\x4e\xed\xb5\x31\x41\x7a\xfa\x56\xa9\xf5\x94\x4f\x7c\x4c\x45\x4f\x08\xc7\xb1\xc0\xf1\x7e\xa2\x07\xa8\x39\x52\xfe\x83\xe4\xf6\xa8\x78\x7d\x34\x91\x2c\x3d\xc1\xbe\x84\x3e\xdd\x93\xd9\xb9\x06\xb6\xdb\x27\x0e\x3b\x4c\x90\xa2\x25\x90\x76\x1d\x94\x29\xbc\xdd\xec\x54\xc5\xf0\x96\x35\x52\xd4\x49\xf3\x9a\x39\x9e\x52\xa7\xb3\xdb\x3e\x35\x1a\x7e\x50\xbc\x80\x19\xf4\xdd\x8a\xe9\x15\x82\xe6\xec\x8b\x2f\x1a\x62\x40\xfb\x77\x6a\xe0\x1e\xbe\x6c\x17\xa0\x14\xa5\xf9\x7d\x43\xbd\xa7\xae\xa7\x20\xba\x67\x55\xbe\xf1\x57\x65\xfd\x4f\x5d\x95\xec\x9e\x6e\xbf\xf3\xbb\xcd\xee\xef\xd6\xa8\xf7\xed\x93\x4a\xdd\xa3\x00\x5d\xf0\xfd\x65\x3d\x45\xd4\x3d\xe3\xaa\xec\xf9\xb7\x5b\x75\xc8\x56\xdd\xc8\xba\xfc\x94\x66\xe1\xa6\x06\x09\x4a\x37\x93\xf4\x9c\xe7\x9f\xbf\xd4\x4d\x5d\xd8\xe1\x75\x5f\xf9\xcf\x03\xed\xcd\x70\x34\xf4\x97\x3e\x3d\x3c\x0c\x22\x7f\xc7\x9e\x5f\x7a\x0f\x76\xec\x69\x84\x55\x6d\x85\x5e\xa7\xd5\x7e\x4d\xa5\x42\x41\x30\x50\xdd\xc3\xe1\xcd\x2e\xc1\x08\xa8\xad\x2c\xf6\xdf\xde\x77\x81\x35\x54\xfb\xa9\xb3\x3b\x19\x57\xe8\xcc\x9e\xcc\x33\x69\x97\x42\xb5\x1e\x55\x39\x30\x14\xe2\x5e\x37\x6c\xae\x0e\xd7\x47\xf5\xf0\xf0\xb9\x3b\xaa\x4e\x5d\xca\xeb\xea\xdc\x04\xe9\xe8\x7d\xac\x07\xa7\x0f\xe3\x03\xd7\xc2\x3c\xe1\x56\x55\xe5\xfa\x40\xdd\x37\x0e\x98\xb6\x8d\x5a\xd4\x18\x85\x69\xe9\xca\x1b\xc4\xfc\xa1\x83\xd8\xe5\x73\xf4\x5a\x22\x85\xb7\xdb\x33\x85\x2a\xdf\xfd\x9e\x59\xd7\x43\x0b\x69\x58\xf1\xc6\x64\x2c\xa5\xd2\xed\xb5\xb2\xac\x8f\xae\x95\xd2\xda\xfd\x9e\xc0\x96\x54\x5e\x6c\x7b\xa0\xc7\x3b\x40\xaf\x11\x3e\xb4\x5f\x89\x0a\xcd\x1d\xa2\x89\x42\x22\x70\x27\x9c\xf1\x0a\xce\x78\x05\x67\xfc\x30\x9c\x71\xfb\x80\x98\x9c\x70\x0f\xe2\xec\x29\x6d\x43\x9c\x3e\x76\x71\x7d\x9f\xc4\x55\x4c\x10\x0f\x36\xea\x06\xd7\x5d\x05\x3d\x48\x30\xa7\x5e\x4e\xb2\xae\x72\x57\x1d\x0d\xce\x9b\x05\xed\xae\x72\xb3\xab\x1c\x77\xaf\x58\x8d\x70\x60\x3f\xcd\x16\xf2\x03\x5b\x78\xed\x63\xa7\xae\xad\x12\x1d\x5b\x55\xb9\x1d\x30\x0a\x51\x8c\x60\x44\x46\xb4\xa9\x81\x4b\xef\x96\x6e\xaf\x73\xb7\x44\xb5\x5b\xa2\xda\x2d\x71\x78\xb7\xaa\x1e\x9c\x2a\x51\xd3\x61\x3c\x86\xbf\xae\x53\xd1\xd8\x47\x3d\x00\xd1\xde\x47\x49\x4c\x8d\xb8\x34\xf9\x7a\x74\xad\x6d\x7c\x48\x4f\xeb\x56\xed\xdb\xfd\xda\xae\x68\xd6\x2a\x7a\xf5\x59\x1d\xcd\xab\xda\x76\xcf\x85\xd9\x73\x81\x0f\x2e\xfd\xca\x55\xa9\xb7\x5d\x69\xba\xa3\x73\xdb\x17\x48\x90\xc2\x44\x94\xaf\x5f\xc3\x31\x53\xb4\xc1\x0d\x68\x41\xe6\xb4\xa0\x43\xb2\x32\x31\xfd\xec\xa6\x65\x61\x38\x7f\x9a\x57\x61\xe2\x06\x03\xbc\xc9\x0c\x85\xf9\xfd\x7c\x82\x56\x34\x23\x99\x51\x15\xc5\x2b\x9a\x55\xc4\xb0\x6e\x7f\x41\x97\x48\x90\x8c\xe4\xd3\xf9\x8c\xa4\xd8\x93\x86\x2c\x2a\xed\x7b\x16\x86\x28\xa3\x2b\xe7\xd8\xc6\xc2\x30\xab\x88\xce\x85\x4f\x74\x2a\xdd\x14\x26\x05\x4d\xd0\x82\x14\x64\x5e\xb9\x56\xad\x27\x25\x5d\xc4\xeb\x8a\x88\x5e\x90\x35\x5d\x90\x8c\xae\xf4\x3a\x6a\x3e\x31\x6f\x85\x08\xe2\xa6\x25\x5f\x3a\x63\x9d\x6a\xc7\xad\x89\xa2\x8c\x5e\x22\xe1\xc6\x8f\x41\x10\x9a\xa0\x6c\xaf\xfb\xcc\xeb\x3e\x23\x6b\x9a\x55\x96\xaa\xe5\x4e\xb7\x9b\x51\x09\x7d\xee\xb5\xbf\xa2\xd7\x28\x23\x82\xcc\xfd\x3e\x6a\xde\x77\xe5\x2f\x40\x16\x19\x6e\xd5\x8d\x79\x05\x50\x34\x8f\x57\xe6\x2e\xd4\x23\x5b\xed\x8d\x6c\xe5\x8d\x6c\x45\xd6\x7a\xa1\x6b\xda\x3e\xab\x42\x6a\x77\x59\x8d\x20\x61\xec\x4a\x3c\xd3\xe4\x0b\x04\x10\x44\x4a\xa7\xce\x3d\x53\x28\xc5\xe3\x0e\xa9\xc1\xda\xca\x34\xeb\x88\xe2\x28\xa5\x6b\xc3\xb3\xc2\x2c\x21\x77\x14\xd4\xda\xe5\x8c\x5a\x38\x9c\xd3\x9c\xac\x68\x4e\x87\xc4\x32\x44\x17\x34\xb5\x0f\x7c\x3a\x90\x9c\x87\x61\xff\xc2\x3c\xf6\xb9\x1a\x0c\xbc\x02\x78\x33\xb7\xa0\xb9\x9a\xa0\x05\x9d\x93\xb9\x05\xcd\x05\x9d\x37\x40\xf3\x86\x2e\x51\x41\xe6\xe4\xc2\x5e\xc2\xa5\x0f\x9d\x37\x78\x33\xdf\x6e\xd1\x9c\x2e\x3c\xb0\x9c\x57\x60\x79\xd3\x04\x4b\x58\xf2\x9c\x26\xe8\x86\xe4\x64\x55\xad\x7e\x36\x59\xd3\x9b\xb8\x3a\x10\xf4\x86\x64\xf4\x86\xcc\x29\xb8\x37\x9b\xc1\xd7\x00\x09\x6d\xac\xbd\x21\xcc\x2d\x40\x1e\x98\xa6\x63\xfe\x2e\xe8\x25\x2a\xbc\x49\x68\xf0\xd1\x43\xb9\xd8\x1b\xca\x85\x37\x94\x0b\x92\xd1\x8b\x3a\x4c\x23\x80\xe8\x9c\x4a\x18\xc5\xa7\x7b\xbc\x06\x59\xe1\xaa\xd5\x6b\x0d\xb4\x17\xfe\xf2\xcc\x5b\x40\x7b\x01\x40\xbb\x8a\x2f\x0c\xd0\x7e\xee\x58\xa1\xc5\x7b\x81\xb6\x30\x40\xbb\xde\xb5\xad\xc4\x18\x91\x24\xd1\xd8\x6f\xef\x7e\x4a\xaa\xa1\x27\x61\xf8\xff\x67\xef\xcf\x9b\xdb\xb6\xbd\x85\x71\xfc\xad\x48\x9c\x0c\x2f\x71\x0d\xab\x92\x9d\x66\xa1\x82\x6a\x12\x2f\x4d\xda\xac\x76\xd2\x34\x75\xfd\x78\x68\x09\xb2\x58\x53\x80\x0a\x42\x5e\x22\xf1\xbd\xff\x06\x07\x0b\xc1\x45\x8e\xd3\x4f\x7f\xf7\xfb\xcc\x33\xf7\x1f\x09\xc4\xbe\x1e\x9c\x73\x70\x96\xa4\xbc\x59\xdc\x82\x27\xc6\x4f\x5a\x42\x92\xda\x2d\x83\x8c\xbb\x86\xbb\xea\xd4\x1e\x1b\xcc\xbd\x97\xb4\xdc\x7b\x34\x36\xa7\x09\xda\x81\x03\x91\x13\x61\xf7\x7a\xae\x35\xd4\x72\x83\x21\x2c\xe1\x6b\xd0\x27\x84\x80\x70\xf6\xc8\xeb\x70\x9c\xdb\xb0\x8e\x44\x2b\x10\x54\xb0\x73\x89\xb0\x20\x59\x94\x63\xaf\xc4\xa8\x3e\xa0\xd8\x44\xe0\x31\xd2\x0f\x56\x0a\x5f\xce\x71\xa2\xbf\x0c\x8e\x48\x89\x28\x55\xe2\x40\x14\xc2\x1c\x13\xa9\xe5\x22\x72\xe2\xda\x2c\xfc\xc6\x22\xa1\x70\xd1\x7a\x93\xed\xa8\xe9\x18\x27\x7a\x8b\x54\xdb\x45\x71\x34\x56\x58\x7e\xb2\xa1\x14\xc2\x63\xdb\x6b\xa1\x7a\x3d\xf6\x4b\x8f\xdd\xd5\x98\x46\xb4\xc4\x06\x8c\x2e\x60\x6e\xe6\xdf\xa2\x56\xc6\x30\xae\x99\xf7\x1c\xbe\x1e\x2b\x1c\x0d\xb4\xcd\x61\x6a\x45\x75\x6a\xf5\x06\xbb\x6b\xa6\x84\x3f\x53\x42\x95\x72\x55\x14\x42\x11\x1c\x9b\xc7\x55\xad\xb5\x39\x90\x6b\x18\x48\xc9\xcd\xd5\xc8\xdd\xd3\x6f\x74\x58\xa3\x70\x4e\x30\x38\x31\x67\xfa\xce\x21\x14\x42\xd1\x37\x77\xf5\xf4\x8e\xba\x9a\x1d\xbf\xfc\xae\x15\x78\x68\x07\x14\x86\x62\x13\x7f\x40\xdb\xca\xf0\x63\xaa\x99\xab\xcc\x00\xc8\x5d\x8d\xda\xb4\xba\x55\x06\xc0\x7f\xb2\xd4\xd3\x7f\xb6\xd4\x45\x1b\xbe\x9e\xb4\xe0\xeb\xce\x5f\x78\x42\x82\x60\x2b\xb1\x8f\xad\x22\x0c\x1f\xd9\x09\x1c\x45\x1b\xb7\xb0\xa2\xa5\x6d\xaf\xf3\x3b\x7a\x5a\xef\x2a\x4e\x8d\x8b\x9d\x77\x22\x4a\x1c\xde\x7a\xe1\x60\xaf\x4a\x3a\x90\x5e\xd2\x59\x25\x29\x0f\x43\xa0\x5c\x12\xeb\xa3\x52\x2d\x0d\x72\x2e\xd3\x61\x17\xc3\x96\xd9\xd1\xfe\xd1\x07\xf1\xd8\xc9\x31\x28\x74\x62\x27\xc0\xe3\xaa\xd9\x9b\x31\x98\xbd\x59\xaf\x03\xf7\x62\x55\x1a\xf6\x30\x9b\xd9\xb3\xc8\xe0\x24\x83\x70\xe6\xb1\xc3\x93\xaa\xc2\x46\x5d\x60\x73\x68\x8c\x4c\x10\xf7\xa8\xf0\x46\x58\x8e\x18\x90\x71\xef\x05\x90\xdf\x5a\xd1\xa1\xaa\x12\x98\x36\x9f\x63\x98\xb3\x17\x40\xcd\xa3\x87\xfe\xaa\x3e\x39\x0d\x76\x9e\xb4\xb9\x8d\xd2\x46\x60\xb4\x00\x46\xa9\xb3\xa7\x76\xbf\xa2\x0c\xba\x03\x84\x97\xda\x84\x22\xab\xbc\x24\x1e\xf2\x52\x87\xd0\x3c\xea\x30\xe3\xfb\xd6\x53\x4b\xae\xb4\x8f\xf5\xac\x00\x87\xaa\x2a\xe8\x60\x2c\x4c\xe0\xda\x1b\x38\x71\xfc\x56\xdb\x9b\xbe\x2a\x0b\xb3\x52\xe1\xe3\x96\x4a\xa8\xe5\x2b\xbc\x7b\x3d\x37\xbb\x6f\xa4\x35\xdf\x6a\xb1\x8d\x08\xe0\xb3\x99\x57\x37\x14\xbb\x60\x18\x9a\xe2\xf6\x91\x5d\x4d\xcc\xdc\xc5\x94\x26\x31\x6a\x0c\x5c\xe4\x41\x57\xed\x7e\x46\x75\x1e\x9e\x71\x8c\x31\x12\xd8\x85\xbb\x81\x93\x6d\xb0\xf6\x5a\x56\xd4\x99\x22\xd1\x6b\x0d\xcf\x00\x55\xd9\x92\x86\xf0\xbe\x46\x8d\xdd\xee\x2a\xf9\x44\xee\x05\x86\x96\xc6\x4b\xa8\xa7\xdc\xc2\xd4\x1a\x18\xf4\xe9\x5e\x0d\x55\xaa\xae\x3c\x46\x14\x6e\xf1\x6b\x0b\x35\xa9\x18\xcf\x95\xde\xd9\xdc\x55\x04\xb7\x81\x7d\x43\x73\x5a\xb9\xac\x45\x3d\x04\xe7\x1d\x1b\x19\xbc\x55\x12\x56\x3f\xa6\x53\xf3\x70\x7d\x4c\xc1\x4a\xad\x51\xfc\xc7\x0b\x42\x7b\xcb\x9c\x1e\xdf\xb2\xf1\xf1\x78\x46\x27\x4b\x98\x82\x73\x97\x7d\x9f\x2e\x44\xca\x45\x2a\xd3\xaf\xf4\x78\x79\x2e\x05\xa5\x78\x46\xb4\x47\x8f\x97\x3c\x97\x76\xef\xcc\x6b\x71\xd0\x17\x45\x81\xf4\xa8\x82\x7c\x2f\x6f\x27\xc2\xd8\xba\x56\x9b\xfb\x0c\x0e\x48\x4e\x65\x2d\xfe\x8a\xb0\x9e\x14\xb7\x1f\xf9\x5e\x96\xa4\xf3\xb7\xf4\xc6\x64\x48\xce\x33\x6a\xa5\x4e\x86\x94\x5c\x2a\xec\x24\xc3\x55\xe1\x24\x5a\xd7\x6e\x2b\x36\x66\x30\xb2\xbb\x85\x46\x3f\x6f\x09\xed\x35\xc5\xc1\xf0\x0d\xf1\xec\x44\x56\x93\xae\x09\xed\x35\x85\xa5\xf0\xa5\x93\xc4\xa9\xc4\x5b\x91\xb3\x73\x7a\x91\xb2\xcf\x5c\x5c\xb6\x39\x87\x06\x47\xbe\x0d\x99\xf8\x7a\xcc\x4f\x0e\x28\xe9\x0d\x34\x6c\xd9\x40\xfd\xb8\x3c\x63\x70\xa0\x7e\x34\x72\x31\xc2\x08\x3c\xe0\xac\x26\x4e\x83\x0f\x8c\x94\x8c\xa9\xfb\x40\x4b\xca\x1c\xc0\xa5\x1b\x65\x2a\x50\x83\x5e\x0d\x7c\x5d\x38\x7c\x5d\xb4\xda\xc1\x14\x06\x1e\x8e\x74\x57\xc9\x0e\xce\x08\xec\x6a\x7c\x1b\x01\x18\xbc\xd6\x0f\x40\x92\x68\x60\xdc\xed\xe3\x0c\xc1\x4b\x9b\xca\xdd\x0a\x2e\xf5\x2a\x67\xa5\x99\x22\x64\x39\xc9\x03\x83\xc9\x65\x76\xc0\xac\x3e\x60\xd1\x10\x31\x2a\xc5\x63\x90\xbd\x42\x40\x86\x5d\x20\xe7\x14\xb9\xbc\x5a\x84\x36\xa2\x25\x89\xb9\x0e\x2c\xde\x22\xf4\x3c\x62\xa1\x27\x10\x3c\x3e\x64\x20\x78\x58\x9f\xc0\xc4\x88\x4c\x35\xc1\x7c\x39\x1e\x07\x3c\x2c\x18\x30\xdf\x76\xe6\x9c\x3e\x9d\xbb\x39\x3d\x80\x30\xb2\xb0\x34\x8e\x6e\x22\x59\x53\x4b\x72\xd3\x2d\x40\x60\x5b\x90\x4b\xfb\xaa\xaa\x67\x5f\x5d\xe0\x43\x03\x8d\x2c\x23\x46\xb5\x98\x55\x85\x6f\x2c\x98\x06\x45\xb9\xa6\xd0\x92\x91\x4f\x32\xee\xcd\xf5\x8f\x6a\x12\x0a\x9c\x45\x6a\xb1\x97\x56\x8f\x41\x90\xac\x67\x1c\xeb\xe2\x83\xca\xe5\x1a\x95\x22\x1d\x36\x64\x96\x3b\x0c\x0f\x7a\x33\x00\x0f\x34\x0c\x2f\xc0\x9f\x5a\x65\x96\x77\xec\xfd\x48\x4a\x8c\x42\x60\x06\x6a\x13\x11\x72\x5b\xaa\x79\x05\xfb\x7b\xca\x64\x76\x5d\xb5\x7b\xec\xc7\x18\x4c\x3b\xd9\x2e\x85\xe1\x95\x9d\x20\xe7\xab\x7f\xaf\xbe\xc9\xec\xf9\x12\xf5\xed\x68\xd9\xdc\x8e\x62\x8e\x04\xd9\xb3\x4c\x9e\x87\x01\xc2\x07\xf6\x9d\x80\x8e\x6a\x60\xce\xa8\x36\x34\x85\xbb\x44\x18\xee\x01\x87\x3b\xda\x23\xa2\x24\x16\xa7\x51\x86\x05\x1a\xed\x41\x85\xf1\x41\x18\x4e\xe1\x80\xd7\x25\x7f\x06\x8f\x10\x36\x88\xd0\xce\xe0\xe1\xe3\x87\x4f\x76\x1f\x3d\x7c\xac\x65\x5c\xba\x8b\x30\x3c\xd7\xb5\x44\x0d\x7d\xb6\x32\x33\x36\x0a\x03\x71\xa4\x27\x7a\xaf\xb9\xd7\x45\x65\x9e\xed\x14\xdb\x19\x7e\x54\x7b\x04\xb0\x73\x4c\x37\xcc\x1e\x05\x89\xd9\x5a\x1b\xcd\x46\xf5\xae\xd5\x4d\x3c\x89\x35\x7c\x79\xac\x3f\x1f\x97\x27\xac\xd6\x44\x39\xbf\x56\xfb\x27\x2b\xd7\x2a\xd3\x1a\x30\xd5\x76\xcb\xc5\xb3\xe0\x38\x53\x28\x4f\x25\x93\x16\xcc\x03\x30\x55\xeb\xb4\xb0\x56\x01\xd5\x92\xf9\x1a\x82\x55\x24\xd9\x3f\x27\x6a\x67\x6b\x5c\xb9\x1e\xdb\x06\x33\x3d\xe4\x10\x86\xfe\xb4\x61\x73\xbe\xf3\xd0\x40\xd1\xbb\x31\x8d\xe6\x65\xd2\x84\xa4\x6e\xae\xbe\x35\x55\x75\x50\x9b\x69\x93\xf6\x7e\xdf\x09\xc9\x5a\x00\xaf\x07\x01\xf5\x89\x7f\xef\x4e\xbc\x02\x3a\xf1\x46\x70\x9b\x6d\x04\xb7\x83\xbe\xe5\x07\x7c\x7b\x80\x56\xdd\xa9\x3a\xae\x96\x8b\xa3\x39\x9a\xb6\x6b\xc4\x52\x4c\xf7\xbb\x1c\xac\x05\x7d\x98\xc4\x47\x01\x2a\x0a\x0c\x98\xc6\x61\x92\x66\x74\xd2\x8a\x6f\xb4\x60\x0d\x2d\x48\x66\x05\x13\xad\xb4\xf2\xb8\xa4\x02\x2b\x50\xe3\xd1\x43\xdc\x58\x09\xa6\x8d\xfd\x37\x70\x7d\x87\x99\x5b\x70\x8e\xef\x8b\x02\xe9\xc7\x40\x40\x0e\x1a\xfa\xf4\xb2\xae\x4f\x8f\xef\x22\x2f\xf5\x95\x54\xa3\x30\xcd\x3d\xb5\x43\x9c\x2c\x1e\x80\x95\xf2\x4c\x35\x20\x8a\xa1\xcc\xea\xd7\x88\xa1\xc0\x1c\x99\x56\xb1\xc6\xf6\xda\x29\x13\xfb\x72\x55\xe0\xf2\xc4\x93\x8c\x2c\xb4\x80\x1f\x35\xc6\xe0\x1d\xa6\x99\xb8\x28\xed\x12\xc3\x44\xa7\x0a\x93\x5d\xa8\xcd\x6a\x74\xd0\x40\xc8\x1f\x8f\xc1\x96\x18\x03\x95\x34\x3f\x41\x01\x17\xdd\x7d\xba\x48\x84\x91\x99\xc5\x4b\x10\xda\x37\x0e\x32\xc7\x14\x4f\xb4\x9d\xec\x23\xce\x65\x15\xb9\x9f\x6a\xdf\x7a\x3e\x15\xb0\xd0\x79\xfd\xa8\xf3\x6a\x2e\x5d\x74\x06\xe4\x2c\xb4\xfa\x91\x6b\x14\x9f\xaa\x1c\x6e\x24\xf3\x0d\x19\x2a\xc3\x55\xd4\x85\xaa\xba\x4e\x5b\x18\xb4\xe8\xca\x06\x6e\xad\x8c\xa5\xe5\x82\xf7\xe6\x4b\x2b\xc8\x72\x56\xca\x3e\xa2\x55\x81\xaf\x48\xfd\xac\xd4\x95\xba\x50\x18\x8a\x48\xa2\x02\xdf\xd6\xb2\x82\x1e\x4c\x17\xb4\x48\x21\x03\x8a\x79\xb4\x1c\x05\x3b\xbb\x3f\x06\x71\xb0\xb3\xfb\x28\x40\x78\x35\xe6\xf3\x45\x46\x25\x6d\x3d\x95\x9a\x89\x5d\x97\x74\x37\xaf\xf3\xf6\x49\x6a\x59\x07\x33\x71\x79\xe5\xb6\x9c\x9f\x30\xac\x5e\xdf\xc0\x08\xa9\x68\xa8\x5b\x09\x86\x12\x14\x0c\x9a\x77\x81\xc3\x3d\xa9\xb4\x58\x4f\x0d\x3f\x3c\x57\xf1\xda\x30\x8a\xb4\xa6\x53\xa4\x16\x66\x2f\x4f\xce\xb2\xc6\x60\x08\xc3\x68\xe9\xc4\x7a\xeb\x89\x8d\xdc\xa6\xab\xac\x29\xe1\x0a\xc7\x6b\xbd\x8e\x2e\xc0\xda\x66\x79\x82\x42\xb2\xbd\x8b\xf0\x59\xb5\xcb\x3f\xc6\x53\x88\x20\x93\x48\xd3\x45\x37\x16\x69\xab\xb0\x27\x74\xc8\x97\xbd\x85\x05\xba\x6e\xd8\xd6\xb8\xac\x0c\xf1\x80\x2c\x22\x34\xbc\x24\x79\x74\x89\x6f\xf0\x35\x5e\x62\xa6\xe8\xa7\x2b\x58\x64\x17\x85\xc0\x7b\xd1\x14\x58\x1c\x20\x9e\xdb\xc2\x9e\xb2\x46\x57\xbb\x4b\x54\x93\x81\x2a\x9b\x33\x86\x8a\x1e\x19\x96\x09\xd8\xa0\x56\x1d\xc0\x6a\x26\x10\xb8\xfa\xc5\xd4\xec\x58\x2d\x07\x4f\x49\x16\xdd\x40\xaf\xe0\xca\xa1\xf1\x94\x8b\xe8\xda\xb1\x57\xcc\xf8\xaf\x4b\x33\xb2\xd7\xa5\x19\x59\x08\x22\x85\x16\x5e\xfb\x2e\x54\xec\x35\xf7\xb0\x6b\xb2\xb8\x95\xb9\xb6\xfc\x9b\xeb\xaa\x6d\xd9\x6b\x7c\x6d\xd3\x86\x6a\xf9\x53\xb6\xa4\x45\x3a\x8d\xae\xc1\xac\x95\x67\x5f\xd6\x8c\xf8\xba\x6a\x65\xd6\xc5\xea\xea\xd6\xeb\xeb\xd2\xd6\xac\x74\x3e\xf7\xae\x5d\x86\xe2\xba\x6e\x67\xd6\xa6\x40\x3f\x2c\x57\x59\xd1\x3e\x30\x35\x66\xc2\x2a\x88\x97\xc6\x31\xee\x5c\xb0\xc6\xa9\x79\x14\x6b\xbf\x91\xcd\xad\xa4\xbd\x3e\xd4\x77\xd2\x12\x39\x4b\xbb\x0d\x91\xe9\xef\xd8\x04\x54\xed\x6c\xcc\xdc\x3e\x18\xcd\xc1\xfe\xa5\x1a\x52\xec\x0f\x29\x89\x96\x98\x62\x56\x93\x55\xb2\xa8\x70\xd4\x80\x34\xc8\x18\x59\xfa\x31\x30\xf0\x82\x3c\xc1\x37\xe0\x8d\x14\x36\x11\xec\xa2\x72\x88\x61\x18\xb9\x65\x91\xe8\x1e\x1b\x6b\xbd\x7e\xe8\xf6\x18\x8f\x82\x9d\x87\x8f\x3d\x84\xf0\xa9\x4b\xba\xd1\x7a\xbc\xd7\xfa\x85\xae\x82\x63\x7d\xef\x8e\xfb\x9f\xdf\x62\x66\x9e\xaf\xc9\xd2\x99\x31\x59\x92\xeb\x68\x69\x30\x88\x1b\x87\x2b\x94\x08\xac\x21\xfa\x20\x56\x13\x7b\x4b\x8d\x1a\x9a\xc1\x68\x3a\xa6\x94\x08\x54\xe4\x8c\x07\xed\x9e\xc6\x16\x9b\x6d\x41\xf0\x7d\xd8\x5d\x83\x92\x7d\xc0\xf4\x1e\xa9\x35\x68\x22\x98\x1e\x1e\x73\x64\xe4\xf2\x3d\x69\x73\xcf\x71\x78\x4d\x41\x02\x49\x71\xbb\x62\xda\x24\x82\x31\x9c\xa1\xf0\x5f\x2d\x6c\x55\x54\x11\x21\x73\xf1\xb5\xf0\x92\xe6\x32\x0c\xc1\x5a\x06\xae\xbe\x69\xa8\x96\xdb\xad\xeb\xb5\x54\xc2\x6b\xea\x32\xda\xa8\x07\x74\xd0\x88\x42\xb5\x18\x51\x6a\x55\x4d\xc4\xed\x55\x45\xcd\x11\x7a\xb8\xf5\x8f\xba\xb7\x5e\xcc\xe3\x38\xab\x7a\xa6\xaa\xb0\x7b\xf3\x30\x4c\xab\x2e\xc9\x33\x6b\x3b\xb6\xb4\xfc\x9d\x4e\x23\xe1\x31\x25\xa4\xbd\x17\xf3\x30\x7c\xe8\xac\x80\xb7\xda\xf1\x2e\x81\x4a\xcb\x21\xb8\xd3\xa6\xf7\xf7\x9b\xf3\x86\x13\xbb\xd9\xd8\xb8\x2f\xbd\x5f\x0a\x26\x78\xfe\x99\x77\xcb\xe0\x43\x1b\xac\x3c\x09\x55\xa6\x05\x33\xd2\x1d\xe0\xc4\xa2\x82\xa9\x45\x05\x8d\xbd\x72\x86\x56\xcc\xf3\xcc\x00\x80\x4c\xa5\x55\xd1\x2e\xa3\xaf\xf2\x08\xa4\x71\xbc\x3d\xf7\x63\x9c\x10\xcf\x22\x38\x06\xcb\xfe\x16\x38\x18\xfc\xc8\x2c\x60\x25\x63\xcd\xae\x4f\x4a\xba\xfd\x8a\x8d\x5c\x33\x9f\x8c\x74\xfb\xc5\x26\x6b\xee\x99\x5a\xe9\x74\x74\x15\x25\xfe\x3d\x85\xe2\xb3\x5a\x44\x79\x3d\x3b\x97\x10\x09\xd9\x48\xcf\xc7\x6e\x03\xf9\xcf\x2f\x77\x98\x86\xf7\xaf\xef\xff\xff\x6c\x2b\xfc\xd0\xa3\xc4\xd4\x6a\xa2\xfb\xda\x8d\xd7\xca\xa4\xda\x6b\xe4\xf2\x3c\x4b\xc7\x8e\x72\x80\xc3\x6d\x68\x00\xed\x7a\xdc\x23\x78\xc0\x9f\x25\xad\x60\xee\x46\xaf\x34\x57\x27\x7d\x9e\x4a\x50\x92\xc5\x13\xf7\x6d\x28\xa7\x29\xc9\xf5\xab\x46\xf5\x81\xc5\xe6\x52\xb1\x26\xe7\x39\xc9\x0d\xa1\xa6\x29\xb4\x59\xf5\xfb\x23\x2f\x49\xa5\x39\xc9\x7b\x29\xcb\xa9\x90\x2f\xe8\x94\x0b\x45\xf5\xd8\x88\x57\xcc\x65\x33\x69\x67\xd0\x83\x39\xbf\xa2\xba\xde\xab\xea\xf7\xa1\xe0\x73\x57\xc4\x3e\x4b\xe8\xce\x1d\xd5\xfa\x5d\x71\xf6\x34\xad\x40\xa6\x02\xeb\x22\xef\xb3\x64\x0c\x1c\xdb\x4a\x5e\xcf\x15\xb3\x74\x2e\xcf\x3d\xf5\x20\x70\x49\xa4\xb0\x53\xfb\x6c\xeb\x76\xbe\x07\x49\xca\xd3\x66\x4d\x44\x9b\x07\x0c\x7b\x7c\x9d\xd0\xb2\x7f\x18\x65\xe5\x30\x0a\x77\x18\x6b\x47\x51\xde\x71\x14\x85\x3b\x8a\x95\x6b\xef\xd1\x20\x40\xc5\xe0\x51\xe8\xa9\x58\x85\x61\xa4\xe9\x88\x0a\xb9\x31\x78\xac\x70\x6a\x19\x6b\x13\xff\xe0\x8e\xc1\x3f\x09\xac\xf5\x24\x30\x77\x12\x12\xe7\x3d\x04\x81\x51\x05\x75\x1d\xb7\x00\x06\xa8\xbd\x7e\x06\x9c\x67\x14\xef\xfd\x72\xf8\x63\xe9\x7d\xe3\x91\x0d\xea\xb6\x77\xfc\xc1\x20\x7b\x8c\x3b\x72\xe8\xf7\xcb\x5c\x20\x0f\x89\x29\xea\xe7\x63\x55\xa0\xa0\xdb\xd5\x20\x1c\x7c\x27\x54\x1b\xd0\x36\x22\xca\x5b\xd9\x8e\xaa\x70\x56\x4c\x2b\xce\x2b\xb2\x12\xdc\x41\x10\xb1\x91\x18\x5d\x44\x12\x67\xde\x0a\x33\x04\xcf\xa0\xb5\x28\x31\x9a\x55\x23\x51\x7c\x5e\x8b\xa8\x90\x2c\x59\x85\x64\xc9\x2c\xcc\xcb\xaa\xc3\xcb\x70\x56\x7a\x6a\xf1\x60\x5e\xb6\x01\xe6\x65\xad\x2b\x9d\xb9\x95\xce\x5a\x60\x5e\xe6\xd2\x8b\xac\xbe\xba\x36\x05\xba\xe1\x20\x9c\x39\x89\xfb\x34\xa3\x60\xfd\xcd\x3f\x88\xa9\xc6\x90\xec\xf2\x68\xdf\x01\x25\x2f\xcc\xb7\x5e\x09\xfa\xfe\xee\x6b\x43\x26\xbf\x26\x07\x03\x1a\x8c\x8c\x0d\xcc\xc5\x0a\xea\x63\xe5\x35\xaa\x26\x5c\xb5\xf7\x65\xe7\xbe\xb8\xf6\x74\x42\x37\xbf\x80\x38\xd3\x28\xd6\xa8\x64\x85\x63\x33\x6c\x1a\xe5\xc1\xa5\xb8\xe2\x24\x62\x38\xc1\x19\xa6\x58\x28\x3a\xc8\xeb\xe6\xa3\x78\x23\xa5\xb5\x03\x80\xa9\xfe\x82\xb8\x88\x7c\x3e\xc0\xe6\xf7\x1a\xcc\x6a\x5c\xd6\x16\x70\xb3\x0b\x6c\x5c\x3d\xc5\xaf\xd3\x29\xdd\xbb\x1d\x67\x34\xbf\xcf\x44\xb7\xce\xed\xc3\x8a\xb3\xa8\x72\x3b\x52\xc4\x36\xd8\x67\x60\xed\xf6\x19\x30\x6b\x1a\x8b\x88\x50\xa9\x61\x2f\xea\x48\xf3\xb0\x89\x2e\xff\x67\x4d\x1a\xbd\x76\x30\xc6\x2b\xdb\x9f\x23\x65\x18\x5e\x6b\x7d\xc2\xea\x3c\xb3\xf6\xec\x0c\xb2\xb3\x1a\xd2\x63\xf9\xd7\xe5\x44\x6a\x65\xf0\x61\x15\x89\x27\xf5\x35\xd7\x0f\x51\x95\x09\x0f\xc3\x65\xc4\x8c\x6a\x5f\x9d\x79\x8c\xab\x2c\xf7\x47\xf6\x8e\xfa\xc6\x9e\x78\x0e\xee\xec\x8e\xe8\x34\x6e\x6a\x6e\xd7\xa9\xae\x56\x9b\xc9\x2d\x42\x61\x3f\xc6\x32\x1a\x47\x0c\xd5\x39\xfe\x12\x3c\x33\x94\xa0\xa6\xad\x65\x2b\xdd\x4c\x75\xeb\x28\x0c\xa9\xa1\xf2\x3c\x8a\xe5\xaf\xba\x9a\xb4\xef\xa0\x8d\x90\xd7\xc6\xec\xf6\xe3\x87\x01\xc2\xda\x32\x14\xd3\xc8\x1b\xe0\x2e\x3e\x4f\x59\xe8\x78\x9f\x25\xad\xa2\x33\xb2\x32\x0f\x33\xf1\x6b\x51\xe0\xa4\xfa\x99\x56\x3e\x2d\xf6\x53\xe5\x56\xb7\x38\xc0\x94\x51\xe6\x9e\x7b\x0a\xdc\xc6\x08\x6f\x2d\x94\x7a\x85\xea\xec\xef\xca\xd4\xbd\xa0\x20\x73\x8d\x5f\xd0\x28\x31\xff\xa9\xda\xdc\xb8\xca\x5a\xaf\x94\x49\x3c\xaf\x59\x54\x1b\x39\xf7\xeb\x50\x85\xeb\xa2\x38\x35\xd8\xf1\x41\xb5\x02\x36\xb6\x25\x01\x94\xff\x03\x14\x35\xd6\xae\x33\x6d\xd3\x1a\xd7\x84\x7c\x1a\x9b\x8d\x13\x7f\xa0\x58\x10\x7f\xb2\x86\x9c\x30\x75\x4e\x8d\x80\x21\xc2\x42\x33\xc7\xa3\x5a\x53\x5c\xf7\x58\x8b\x03\x6d\x9a\x57\x57\x2d\x79\x2d\x70\xea\x7d\xf8\x1b\xec\xb8\xb1\xc1\x4a\xe9\x40\x67\xc7\x17\x2e\x80\x3e\x1a\x1a\x55\xb6\x60\xff\xe0\xf5\xc1\xc7\x83\xfd\xc0\x77\x4a\x46\x64\x45\xab\xd0\xd7\xab\x77\x80\xdd\x7b\x68\x1a\x45\xfe\x57\x55\xa7\x9f\xd6\x74\xfa\x69\xe5\xcd\xaa\x9a\xea\xab\xec\x57\x7c\xab\x57\x4e\x69\x55\x83\x2d\x92\x24\x81\xd7\x2b\x98\xe5\xaa\xa4\x9a\x56\x2c\xa8\x0c\xab\xdb\x47\x2d\xaf\xeb\xba\x9e\x14\xea\xb9\x47\x05\x77\xf8\x97\x17\x96\xfc\xa6\x0d\xdc\x9f\x86\xe1\x8f\x5d\x67\x23\x6b\xd7\x06\xb5\xec\x9d\xc9\x3a\x35\x26\xe1\xb2\x0d\xe2\x6a\x43\xe3\x04\x8d\x1a\xc1\x8f\x94\x3b\x95\xbe\x55\x8b\x9c\x59\xf3\x64\x76\x07\x76\xa7\x6d\xcc\x58\xe0\x6f\x49\xa0\x55\x73\xdf\xf5\x8e\xe5\xe7\x04\xd8\xf6\x63\x80\xda\x4b\xf8\x0f\x5b\x8d\x52\x8f\x02\x03\x0f\xbe\x3d\xba\x62\xa8\x4d\xea\xd1\xde\x38\x61\xa6\xfe\xca\xfb\x60\x19\x5d\x79\x4b\x33\x74\x72\x75\xc0\xc6\x7f\x0f\x90\xcb\x17\x54\x1e\xaa\x9d\x5b\xa6\x6a\x12\x73\xe9\x16\xa3\x6c\x67\x52\xc6\x55\x1a\x31\x76\xe5\xb5\x7e\x1c\x3e\x57\x04\xda\x7d\x16\xaf\xbc\x27\x16\x24\xf7\xf7\x63\x5d\x16\x61\x4a\x14\x69\xdd\xed\x7f\x73\x8d\x17\x64\x5a\xf6\xe1\x7b\x16\x5c\x1b\x40\x3e\xb7\xe0\x6f\x01\xbe\x3b\x0c\x2b\x09\xcc\xba\x19\x3f\x7d\x9c\x8c\x23\x8e\xd0\x7a\x6d\xa3\xdd\xd3\x63\x45\x40\x49\x35\x0f\xb2\xda\xd1\x94\x50\x34\x94\xd1\x14\x2f\x50\xa1\x86\xa1\x46\xca\x8d\x8d\xce\xb6\x42\xea\xa4\xdc\x77\xef\xd5\xad\x56\x2f\xdb\x7c\xdb\xd7\xb9\x9d\xfa\xe9\x08\x57\x2d\xbb\x3a\x96\xb4\xfc\x9e\x5d\x5c\xae\xdf\xa4\xd6\x72\xb5\x49\x7a\xf7\x2e\xd7\x73\x4f\xbb\x84\x4c\x4b\x7b\xd2\x6a\xb6\xcf\xed\xec\x2a\xe8\x03\x1b\xc0\xda\x90\xb2\x76\xfa\x54\x36\x07\x7c\xd6\xeb\x60\x46\x93\x49\x60\x4c\xf6\x9c\xf3\xc9\xad\x09\x77\x33\x30\x26\x50\x7d\xf5\x40\x9a\x96\x5f\x0c\xd9\x10\x69\x46\x2e\x66\x44\x21\x4a\x43\xbf\xd5\x05\x99\x8e\xc6\x15\x36\x89\x66\xd6\x77\xfb\x15\x1f\x6c\x2d\x28\x50\x46\xc9\x0b\x62\x7c\x52\xcb\x3a\xe7\x5a\x56\x6f\x7a\x80\x06\x8f\xe1\xfd\x05\x8c\xdf\x4f\x6e\x0f\xb9\xd0\xb6\xa9\xd5\xa6\xf0\x04\xd1\xa1\xf1\xc1\x33\xda\xc4\xfb\x6b\x97\x17\x5a\x6d\xba\xbc\xa8\x9b\x42\xef\xca\xd2\x3b\x92\x11\x3a\xb4\x01\x3f\x15\x48\xe0\xcf\x11\xc2\x0f\x88\x83\xfb\x0f\x86\x96\xa6\xeb\x0e\x70\xe6\xcc\x1d\x89\xdb\x95\x6f\x7f\xc6\xe6\x4a\xc8\x83\xb2\xcb\x6a\x06\x06\x8f\xc2\x24\x0c\x3f\x46\x0f\x10\x1e\xec\x3c\x09\x13\x6b\x65\xee\x81\xe7\xf8\xc1\xd4\x91\x86\xe1\xf3\x28\x45\x85\xb9\x36\xb7\x77\x1e\xee\xa8\xfc\x86\x32\x7a\xa9\xaa\x78\x50\x7d\x1c\xae\xe2\xde\x6d\x39\xf0\xa7\xc8\x6b\x09\x3f\xa8\xf1\xe5\xef\x4a\x7d\x12\x27\x54\xed\xc5\xb7\xaa\x5a\x15\x1c\x14\x0f\xc8\x03\x6f\x86\x8b\xd2\x78\xb8\x50\x19\x33\x42\x0b\x11\x86\x96\x3a\x7b\x60\xd6\xfb\x49\x80\xf0\x38\x7a\x80\xcb\xb7\xef\x07\x61\x18\x55\xab\x42\x08\x18\x29\xbf\x47\xf0\x32\x64\xf6\x00\xad\x2f\x03\xf0\xc2\xc5\xb7\x96\x20\xaf\x2f\xc1\xee\xa3\x30\x0f\xc3\x57\xb5\xa1\xc2\x72\xe4\x61\xb8\xaf\x86\xf7\xe8\x61\xe8\x74\xf4\x32\xf2\xa0\x64\xb8\xdb\x1d\x47\x41\xff\x8f\x6a\x0d\xec\x0c\x61\x4a\xad\x66\x63\x66\x1f\x2c\x12\x8f\x09\xe3\xb3\x26\x32\xff\x1b\x6f\xaa\x04\x79\xd5\x80\xa3\x59\x45\x46\x64\x15\xda\x38\xab\xdc\x1c\x25\x4d\xb9\x97\x68\xd5\x42\x2a\x04\x17\x5a\x48\x03\x92\x8e\x65\x32\xbe\x8c\x93\x5e\x35\xa2\xa8\x93\x95\x46\x29\x1d\x9c\xc6\x53\x62\xaa\xd9\x28\x37\xa5\x39\xc9\xfe\xda\x0d\x1f\x34\x4c\x39\x3d\x20\x4b\x6f\x73\x30\xed\x8f\x97\x16\x6c\xf3\xe6\x10\x77\x6f\x0e\x03\xaa\x5e\x90\x4c\xed\xc3\x36\x73\x67\x33\x19\x86\x33\x59\x81\x60\x98\xa9\x31\x31\xea\x34\x46\x67\x2a\xaa\x2a\x86\xc1\xb5\xd8\x25\xa7\x98\x1b\xe6\xca\x25\xb8\x6b\x87\x17\x02\xbd\x0f\xeb\x16\xbd\xfb\xc6\xd5\x99\xa4\x84\xda\xda\x64\x51\x7d\x40\xb4\xef\x30\x1a\x28\x1e\xfb\xec\x29\x4c\xf1\x2f\x0a\x04\x5b\xa4\x11\xac\xb9\x5b\xdd\x0d\xa1\x1d\x71\x57\x64\x62\x7e\x59\xaf\x2b\x42\x30\x75\x97\x19\x9a\x1d\xda\x75\x7a\x71\xbb\x36\x88\x34\x2a\xaa\x2d\x08\x76\x32\x22\x2a\xec\x83\xcc\x8a\x94\x65\xa3\x7e\x9c\xd5\xb5\x9a\x4a\xa3\xdf\xa2\x2a\x5a\x91\x0c\x51\x5f\xfd\x35\x04\x75\x40\x05\x20\x5b\xaf\xb3\x9f\xea\x69\x46\x71\xbe\xa1\x76\x92\x90\xa4\x34\x9e\x55\x97\xc4\x05\xd3\x17\x8e\x05\xe0\xa4\x05\x2b\xe6\xea\x4a\x46\xaf\x07\xc3\xc1\x0a\x77\x95\x48\xf1\xbe\x50\x1b\x01\x64\x6a\x02\x06\x73\x35\x76\xd3\xd5\x52\xab\xd2\x27\x83\x2a\x97\x53\xf5\x0a\x6b\x6f\x67\xb4\xb1\x95\xb8\x36\x90\x5a\x43\xa5\x51\x7f\x67\x9e\x83\xfb\xec\x6f\x75\x35\x7a\x5a\x8f\x8d\x0b\xb7\x6f\xb5\xdb\x09\x6b\xb7\x19\x21\x4a\xae\xcc\x51\x63\x0b\xd7\xdc\x15\xc1\x89\x20\x0c\x0e\x4f\xfd\x1e\x97\xb5\x67\x5f\x5d\xe5\x5f\xff\x52\x95\x89\xc1\xac\x1c\xa8\x36\x38\x6c\x5f\x9f\x9d\x5f\x7e\xa2\x48\xe1\x0e\xbf\x3c\x23\xbf\x22\xff\xbe\xf8\x30\x44\x1f\x48\x1e\x7d\x40\xa3\x2c\xfa\x80\x62\x11\x7d\x30\x8c\xf5\x4a\xa6\x30\xec\x5e\x47\xa8\x99\xb5\xb0\x3c\xf8\x7b\x36\xf4\xcd\xea\xa1\xd2\xba\x6a\x60\x3a\x8d\x5e\x00\xa4\xdc\x79\xb8\x1b\x20\xac\x10\x2d\xdc\xb6\x94\x03\xac\xf0\xca\x3f\xd6\x6b\xd9\x85\xbe\x98\x19\xfc\x60\x20\xd1\xf6\xe0\xd9\x42\x0c\x51\xdd\xc9\xe0\x70\x2e\xc8\x1b\x86\x4b\x87\x66\xea\xab\x14\x0f\x56\xd5\xbe\x89\x10\xfe\x83\x50\xfc\x0b\x91\xf8\x03\x49\x64\xf4\x87\x73\x70\xa6\xd7\x01\x19\x4e\x17\xdc\xcc\xf0\xe0\xa3\xee\xe5\xa4\xe2\x85\xc5\x5d\x00\xbe\xcf\x55\x41\xd1\x8a\x3b\xad\xd9\x42\x63\x45\x1f\xbc\xfd\x9b\x22\x41\x2b\xf6\x4f\x73\x32\x8e\x52\x63\x65\x86\x39\x73\xf9\x70\x59\xaa\xc9\xe9\xaa\x1a\x2d\x52\xc0\x08\x68\xc9\xe0\xbc\xc4\x20\xd2\xf2\x5d\x3c\xad\xdc\xab\x54\x46\x69\x8d\x31\xfa\xbe\x16\xb3\x1b\xbf\xab\xc5\x3c\x84\x98\xc2\x78\xd7\xcf\xd7\xeb\xd4\xf3\xa6\x45\x48\x6e\x3d\xf8\x93\xd4\xbe\x89\x7c\x20\x59\xc4\x10\x4e\x22\xd1\x32\x35\xe5\xa3\x8c\x9e\x0e\xe7\x35\x5c\xaa\xbb\x49\x00\x8e\x3d\x70\x77\x54\xc9\x29\xbe\x04\x95\x82\xc6\x8e\x18\x39\xb7\x90\x65\xaf\x6a\xee\xab\xc7\x3e\x7b\xa9\x7e\xbc\xb8\x5e\x4f\xc0\x78\xad\x64\x9e\x13\x5c\x40\x8c\x50\xbc\xd4\xfe\x09\x61\x89\xbc\x8d\x6d\xa9\xf7\x2a\xef\x24\x71\xda\x06\x66\xf1\x77\xb4\x91\x82\xe4\x62\xd4\x72\x95\x27\x77\xe1\x39\xe0\xe3\x04\x90\xcd\x1b\x1a\x25\xea\x22\x4d\x8c\x1a\xd0\xae\xad\x13\xde\xd9\x13\x84\x97\x51\xa2\xe9\xd9\x84\x3a\xb5\x12\x8b\x12\xcc\x92\x3c\x4a\x90\x8b\x4e\x7c\x8c\xcd\x26\x7b\x76\x15\xab\xce\x71\xcd\x73\x94\x9a\x9f\x42\x5d\x62\x66\x81\x7d\x49\x21\x6b\xdf\x46\xdb\x4b\xd4\x76\x26\x8f\xa9\x44\x58\xd2\x5e\x32\x99\x44\xc6\x74\x44\x4a\x82\x60\x98\x10\x3a\x9c\xf0\x15\x8d\x9d\x91\x08\x5f\x1b\xce\xaa\x3a\x7b\x8a\xcf\xfa\x09\x6a\x4c\x92\xde\xd9\x84\x9e\x2f\x2f\xde\x5d\x33\x2a\x70\xee\xbe\x8f\xf9\x52\x00\x1b\x43\x4f\x91\xe6\x15\x0c\xc7\x61\x18\x4d\x55\xd4\x18\x21\x3c\x26\x39\x9e\x90\xe0\x4f\xd6\xe9\x74\x3a\x29\xeb\x04\x5b\xd1\xc4\x73\xb6\x88\xb6\xa2\xf1\x28\xe8\x44\x89\xec\x04\x5b\xe3\xde\x34\xcd\xb4\xc7\x62\xa1\x4d\x3f\x47\x3f\xfc\x9f\xde\x7f\x9f\xfc\xf9\xe7\x9f\x3f\x9c\xfe\x80\x83\x00\x6d\x05\xb1\xca\x96\xa5\x8c\xbe\x05\x4d\xf4\xad\x00\x05\xf1\x54\xd5\xa0\xc5\xbc\x27\x9d\xf3\xdb\x4e\xb0\x35\x85\xf8\x20\x70\xba\x02\x0e\xbf\x9c\x90\x20\x28\xd2\x2d\x32\xc1\xde\x84\x6a\x8f\xf3\x09\x1a\x26\x24\xc5\x94\x80\x47\x48\xf7\x4a\x01\x88\x9b\x33\xe0\xa9\x30\xb6\x12\xf3\x55\x7d\x8d\x29\xae\x63\xc2\x18\x50\xdb\x58\xea\xff\x17\x7c\xc9\x26\x89\xb8\x8d\xf9\x88\xd5\x9e\x49\xaa\x19\x0e\xd5\x7f\xcc\xab\x91\xd0\x44\x86\xaf\xc1\x6e\xb6\x14\xb7\xb1\x28\x14\x3e\x9f\x53\x19\x31\x2c\x91\x73\xec\xb4\x20\x52\x63\xd4\xc3\x45\x18\x2e\xc0\x0d\x94\xa0\xb9\xf6\xfc\x76\xa0\xe2\x5f\xf3\x8b\x8b\x94\x5d\xac\xd7\x63\xce\x72\x9e\x51\x9d\x3b\x5a\x78\x40\x02\x94\x4e\xee\x5d\x92\x3a\x74\x39\xa3\x23\x87\x0c\xe8\x7d\x5f\xee\x43\x66\xf7\x21\x8a\x67\xe0\xa8\xbe\xa8\x5e\xbe\x96\x1e\x30\x18\x53\x4d\x47\xbb\xca\x7c\x35\x6b\x01\xa7\x86\x96\x87\xaa\xf2\x42\x6b\x93\xfd\x43\x55\x51\xb0\x6e\x98\xee\xd4\x27\xe7\xce\x4a\xe5\x9d\x95\x4e\xca\x07\x8d\x9d\xfe\x7f\x47\x83\xad\x28\x9a\x47\x68\x6b\xd0\xef\xa3\x1f\x76\xfa\xeb\x3e\xaa\xda\xa4\x74\x99\x15\x7e\xfb\xf3\xe8\xe7\xf8\xc5\x28\xa3\xa3\x41\xfc\x4b\xdc\xfd\xba\x5e\x0f\xc2\x36\x23\x08\xa3\x49\x84\xe2\x41\xcd\xf4\xa3\xab\x48\x1b\x63\xec\x0e\xea\xf6\x19\x51\x85\x6e\xa5\x1a\x24\xde\xdb\xad\x54\xbb\x1f\x29\x0f\xb1\xf5\x89\x4f\x5d\x69\xf9\x08\xde\xac\x7e\x53\x9a\x6d\x68\x53\x3a\xf1\xd4\x07\xad\xd0\x07\xf8\x6b\xb7\x7c\x2a\x73\x03\x36\x1f\xef\xba\x2f\xc2\x90\x11\x42\xfe\x08\x43\xf9\xec\x97\x30\x8c\x3e\x90\x3f\x34\x44\xfd\x85\xf4\xad\xae\x2f\xc3\x19\x01\x74\xff\x9a\xfe\x74\x6b\x58\x47\x4f\x7e\x74\xe6\xa4\x88\xd0\x1e\xda\xb5\xce\x37\x9d\x1c\x71\x2e\x91\xe8\x09\x3a\x4f\x52\x96\xb2\x8b\x83\x1a\x35\x61\x8b\x8d\xe9\x28\x4a\x29\x19\x53\x22\x70\x4b\x15\xda\xc2\x8c\x4a\x6f\x4b\xc3\xad\xd1\x29\xf5\x5e\x90\x13\xb2\xb1\x13\xda\xd7\x51\xb2\x5e\x67\xcf\x12\xed\xe5\x60\x63\x6f\x51\x31\xa1\xeb\x75\x74\x46\x47\x57\x6a\x11\xa7\xaa\xb7\x0b\x4a\x06\xf8\x26\x9a\x52\xbc\xa0\x08\xc5\x03\xa0\xdc\x6e\xa3\x81\x36\xfe\x16\x5f\x44\x19\x42\xf8\xee\xa9\x2d\xca\xa7\x8a\xa2\x62\xd3\x11\xad\xd4\xbe\x1c\xe0\x6e\xdf\xf7\xe8\x56\x1e\x9f\x5f\xc9\xce\x56\x14\x7d\x89\xd0\xf6\x6f\xe8\x87\x81\x3a\x3a\xbe\x61\x39\x8d\x70\xab\x53\x93\x1b\x1e\xe7\x4f\xb9\x13\x0a\x39\x8c\x96\x54\xe3\x88\x92\x40\x05\xc3\x9c\x2a\xf4\x81\x92\xbf\xa3\x2b\xbc\x92\xe9\x9c\xf2\xa5\x8c\x07\xfd\xff\x8e\xe8\xf6\x0e\xda\x96\x05\xf2\x0d\x8e\x69\x04\x05\xdc\xaa\x3a\x44\xc4\x6c\xf4\x31\x45\x16\xe3\x60\x64\x4c\xb1\x20\x29\xf5\x6d\xc7\x68\xea\x77\xf3\x72\x18\xad\xf5\x4c\x9f\x3e\xe6\x94\xdc\xdc\x4e\x41\x06\xf3\x7e\x18\x68\xb5\xdf\xb6\x2d\xb7\x32\x9b\xa9\x65\x5f\x94\xd2\x4f\x0a\x33\x50\x15\xa4\x14\xa5\x94\x64\x6d\xb9\xdb\xb7\x56\xd6\xba\x47\xa1\x62\x2b\x69\x2f\x74\x57\x57\x63\x4a\xd8\xa6\xfd\xb9\xb9\x16\xdd\x3d\xd6\xb6\xd9\x5b\xfa\xb8\xa9\x9a\x42\x90\x96\x2a\x9c\x06\x88\x06\x42\x6a\xdf\x1b\x27\x73\x19\x56\x07\x0d\x9b\xae\x6b\x30\xc1\xd4\x81\x6c\x6b\xb5\x28\x18\x99\xfa\xd2\x0e\x20\xc3\x3e\xba\xa6\x5b\x5b\xf1\xb5\xda\x18\x53\x4a\xa4\x3a\x1d\x9e\xe1\xec\x2b\xb5\x27\x6f\xa3\x7e\xc5\xb3\xf1\x6d\x09\x7a\x2f\x54\x91\x33\xcf\xa0\x1c\x0d\x43\xb5\x7f\x17\x0e\x64\xae\xd7\x0b\xfa\x8c\xa8\xfe\x76\xcf\xe9\x10\xd9\x73\x07\x85\xca\x2d\x78\xa1\xf2\xe7\xaa\x13\x4b\x4a\xb6\x07\x08\xdb\x4a\x2e\x22\x95\xf9\xc2\xa0\xeb\xe7\xc0\xb4\x82\xce\xce\x28\x92\x33\xc1\xaf\x3b\x94\xcc\x29\x9e\x9b\x0c\x33\xc8\xe0\xbb\xaa\x03\x46\xbd\x9a\x3c\x23\xb5\xb3\xf3\x50\xc1\xbe\x09\x30\x64\xd9\x33\x32\x8f\x10\x72\x12\x2b\xd3\x94\xa5\xf9\x4c\xeb\x4a\xba\x03\x30\x8a\xaa\x09\x56\x1e\x6a\x13\xcc\x91\x91\x40\x28\x6e\x2d\x64\x9f\x58\x05\x49\xa1\x5b\xfa\x5e\xb8\xb3\x22\x43\x1e\xd7\x7b\x87\xff\x87\x7a\x77\x1d\xa1\x51\x2d\xaf\x88\xbf\xd5\xe5\xe1\x04\x78\xdc\xa5\x6f\xb3\xf2\x5d\xd2\xd9\xad\xa4\xeb\xf5\x05\xed\x29\xa0\x75\x64\xeb\x8a\xd0\x4f\x97\xb0\xaf\xcf\x81\x14\xf2\x3c\x8d\x59\xc9\x12\xa2\x37\x18\xac\xe2\xa3\x00\xe1\xe9\xe6\x9e\xa8\x0d\xb2\x5e\x47\x33\x58\xe7\x39\xb8\xb9\x53\xab\x7c\x40\x40\xf8\x04\xef\x11\x90\x11\xc0\xef\xc8\x41\x53\x29\xf1\x7d\x35\x92\xde\x48\xfc\x86\x1c\xf4\x9a\x82\x09\xf8\x35\x01\x5b\x47\x07\x78\x0f\x2f\xf0\x14\xe1\x23\xf2\xba\xe7\xac\x7b\xe0\xbf\xec\x57\xa9\x81\x8b\x8f\xc9\x6b\x53\x02\xf5\x7c\x25\xc0\xe1\x01\x01\x0d\x8a\xb1\xbe\xb6\x3f\x92\x83\x5e\xbb\x3c\x2e\x7e\xe9\x92\x9c\xdc\x2d\x7e\xeb\xe2\xac\x04\x20\xfe\xe4\xa2\xa0\xdd\x57\xee\xb3\x14\x23\xc3\xfb\x2e\xd2\xc9\x11\xe1\xe7\x5e\x55\x36\xee\x0b\xa1\x3d\xc6\xaf\xf1\xdf\x0a\xfb\x30\x20\x65\x9f\x4e\xa9\x10\x74\x62\x3d\x6d\xe1\x43\xfd\xa6\x3c\xa6\x59\x23\xe9\x6b\xab\x6d\x99\xcf\xa5\xc6\xa9\x23\xb7\xf1\xef\x70\xb1\xe6\x54\x3e\x9f\x4a\x6a\x23\x7f\x53\x17\x1e\xfe\x95\xec\xe0\x9f\x49\x1f\x03\x01\xff\x41\xef\xd9\xf2\x4e\xc6\x0f\xcc\xd6\xb7\x0e\xd4\x2d\x85\x6f\xfe\x2d\xc5\x2f\x00\x42\x68\xee\x37\xbc\xc5\xe0\xd4\xa4\x8c\xcd\x7f\x09\x86\x00\x4c\x0c\x14\x5c\xd4\x9c\x1e\x95\xa0\x41\x90\x86\x33\x16\xea\x58\xf0\x74\x06\xb1\x57\xf0\x7b\x4b\xc9\x80\xee\x6a\x58\x75\x49\xc9\xc0\x93\xb2\x5e\x2c\x25\x05\x0f\x53\xe5\x9e\x8d\x27\xd8\x24\x94\x71\x87\x5c\x80\xf3\xb4\x78\x8a\xed\xa4\x83\x94\xe5\x02\x9f\x2b\x7a\x86\x1a\x11\xb8\xba\x34\xa0\xbe\xc6\xcf\xe8\xf0\x0c\x98\x3e\x75\x8f\xbe\xa0\xff\x9b\xdd\xae\xa2\x33\x4a\x18\x5a\xaf\x15\x7e\xe4\x90\x9f\xa2\xc0\x4b\xb6\xb1\x76\x00\xa3\x67\x34\x0c\xbb\x57\x14\xad\xae\x9a\xd5\x97\xb5\xc3\x1c\x38\xd6\x8b\x4a\xc1\xd3\x6c\x99\xcf\xd4\x16\x68\x11\x55\xab\xf4\xd6\x98\x71\x64\xe4\xe7\xe1\xcf\x64\xe0\xe8\x3f\x41\x68\x54\xaa\xc6\xdb\x86\x7e\x26\xac\xb0\x0f\x59\xb6\x39\xe1\x52\xcf\xd4\xf5\x64\xc5\x35\x9f\x3c\x0e\x10\xf6\x87\x3a\x31\xfb\xb4\x6d\xa4\xba\x5f\xaa\x07\x93\x08\x6d\x1a\xe5\xcf\x44\x56\x74\xa0\x5e\xde\x25\xd8\xf6\xae\x42\x74\x6b\x7b\xd3\x1e\x32\xef\xcb\xb9\x55\x95\x14\x86\x94\xc0\x73\xf1\xd0\x5e\x53\xed\x5b\x08\xbc\xa9\x6f\xdc\x44\xa0\x29\xee\x6f\x23\xb7\x1f\x81\xad\xb0\x49\x3c\xac\x22\x33\xb5\xeb\x64\xa6\xdc\xa0\x9c\x24\x1d\xc3\x35\x97\xd5\xed\x4e\xaa\x37\x80\xeb\xb8\x8f\xeb\x8c\xb7\x58\x1d\x3c\xef\xde\x31\xfe\xff\x8c\x00\x9a\x39\x8f\xbe\x56\xb0\x31\x80\xa2\x65\x10\x62\x89\x1b\xf8\x8f\x66\xdb\x55\xc4\xbb\xa8\x73\x67\xd6\x3a\x7e\xcc\x70\xaa\xe7\x60\x5c\xbe\x62\x01\xd6\xb2\xd1\xd3\xa1\xb6\x63\x3a\x34\xca\x0f\x3b\x84\x90\x6b\x1a\x31\x14\x86\x3b\x56\x74\x5e\x2b\x4f\x3e\xee\x07\x08\x78\xaa\x8a\xca\xcb\x4b\x69\xfc\x07\x34\xca\x11\x5a\x81\xe5\x4f\xc7\xbb\xdb\xe4\xbf\x8f\x8a\x8b\xaa\xff\x3e\x77\x3c\xa2\x1c\x14\x3e\x80\x96\x34\x0d\x0e\x02\x54\x54\xab\x35\x93\x59\x30\xf2\x40\xf5\x71\x04\xbe\xac\x73\x14\xe7\xf6\x6d\xff\x0d\x2b\x35\x77\xc6\xd6\x62\x9c\xd3\xd3\x66\x71\xdd\x4e\x1c\xd8\xa5\x48\xb1\x2c\x9d\x3d\x6a\x33\x29\xb1\xc4\xa9\x11\xdb\xae\x79\xe5\xfb\x77\x7d\xf4\x8d\x44\x84\xe2\x2c\x1a\x23\x7c\x25\xa3\x71\xc3\xa1\x64\x5a\x75\x28\xb9\x32\x76\x86\x62\x5a\x94\x7e\x25\xe5\x3f\xf5\xf6\x99\x44\x63\x9c\xa2\xa2\x0e\x9b\x69\xaf\x1a\xd1\x84\xaf\x6a\x50\xb5\x3c\x75\xc0\x44\x7b\xb5\x18\x0f\x98\xd2\x9e\x0b\x63\x07\x3a\xd4\x76\xdf\x24\xb3\xa4\x48\x57\xfb\xf2\xd0\xb5\x36\x37\x7c\xa6\xac\x13\x36\xd4\x72\xcf\x6d\x22\x87\x2e\xd1\x53\xa5\xa8\x0a\x03\x76\x1a\x39\x8a\x42\x9d\xe7\x49\x45\x32\x49\x36\xa2\x3e\xa7\x72\xf6\x96\xbf\xe7\x42\x26\x59\xde\x26\x39\x44\xc9\xfb\x6f\x00\x52\xac\xdd\xbf\xbf\x62\x92\xef\xd3\xab\x8f\x9c\x67\x4d\xd0\xae\xc5\x55\xd8\x04\xce\xec\x8b\x5b\xbf\x07\x16\xb8\x9d\xcb\xc8\xba\x2a\x5e\xd5\x7b\xf9\xe2\xd6\xdc\xcc\x6d\x96\x93\x29\xd2\x23\x6d\xa9\xbb\xad\x00\x1b\xa9\x2f\xbd\x8d\x0a\x54\x11\x94\x7e\x2b\xab\x26\x33\x77\x9f\x25\xe2\x62\xa9\xf6\x6c\x6e\xac\x9f\x87\xa1\x3e\x6a\x5d\x42\x5c\xd2\xc9\xee\xe9\xc8\xff\xd0\x8e\x12\x0c\xb8\xb7\x56\x93\xe3\x4b\x81\xad\x9f\x09\xe7\x93\x15\x9c\x0f\x38\x0b\xc6\xb4\x06\xd2\x25\xae\x1a\x77\x8d\x7d\xde\xc7\x27\xef\xb2\xeb\x76\x5f\x88\xde\x2c\xc9\xdf\x5d\xb3\xf7\x82\x2f\xa8\x90\xb7\xc0\x78\xec\xfe\xdc\x12\x1d\x86\xd1\xaf\xa2\x27\x69\xae\x2a\x18\xbd\x10\x27\xf4\x94\x74\xfb\x71\xf4\xb3\x09\xe1\xee\xc0\x67\x2f\xbe\xaa\x4d\x49\x62\x9c\x3a\xf2\x30\x04\x8f\x2e\x8e\x9a\xe3\x4e\xaf\xef\x0d\x95\x33\x3e\x19\x8a\x91\x00\xb2\xc6\x0c\x99\xad\xd7\x5c\xf5\xe6\x05\xe7\x19\x4d\xd8\x6f\x49\xb6\x54\x50\xc7\x46\xbf\x5d\xce\xa9\x48\xc7\x26\x3a\xcd\xdf\x26\x6f\xc1\x5f\x00\x24\xbe\xe7\x79\x2a\xd3\x2b\x5a\xcd\x34\xf8\xc9\x16\x7e\x77\x45\x45\xc6\x93\x09\x9d\xd4\x6a\x1f\xa8\x4b\x60\xf4\x5c\x0b\x24\xc7\xaa\x8b\xb9\xfc\x94\x53\x3b\x1d\x23\x7a\xa2\x75\x8e\xd5\xc7\xdb\x64\x4e\x4f\x09\x8b\x23\x49\x78\x2f\x91\x52\xa4\xe7\x4b\x09\x8f\x07\x38\x12\xf5\xa8\x7c\x91\x8c\x29\xd2\x5e\xb6\x9e\xbb\x84\xe3\x48\x18\x07\x20\xaa\xb1\xda\x60\xbf\xd5\xd7\x3e\xf4\xb5\x5a\x63\x04\x0e\xcf\xe2\x96\xc8\x2d\x66\x49\xd5\x7d\x63\xed\x0d\x56\x63\xc4\xea\xae\xf3\xf6\xdd\x02\x7e\x92\x60\x8e\xc0\x90\x84\x0c\x9c\xd0\xcd\xf9\x15\xf5\x2a\xde\xdc\x94\xab\xef\x79\x45\xba\x1b\xb6\x03\xb8\x25\x62\xb5\xf5\x47\x23\x95\x51\x9f\x16\x14\xb3\x96\xa9\x67\xb5\xa9\xef\xb2\xe6\xfe\x08\x82\xb8\xd9\x4d\x56\x5d\x0b\xd4\x92\xc5\xf7\x2c\xf4\xa5\xe6\x3c\x50\x5b\x77\xe6\xa0\x70\x42\xc7\x97\x74\x62\x21\x90\x02\x3f\xea\xb4\xc6\x46\x84\x2a\x97\x74\x61\xc3\xf3\x94\xb9\x60\x72\x63\x82\x05\x96\x78\x65\xe0\xf0\x9e\xae\xcb\x66\x32\xb1\x30\x0c\x1b\x07\x0d\x1b\xab\x64\x6c\xc4\x62\xda\x3b\xbb\x16\xc9\x62\x41\x05\x5c\x90\xbd\x54\x9b\x08\x82\x32\xd8\xf4\xcd\x64\xe7\x23\xbe\x29\xbb\x69\xd8\xe7\x3e\xfe\x5d\x1b\xb1\xdf\x99\x61\xad\x1a\xb2\xaa\xd6\x63\x1a\x74\x93\x33\x72\xa1\xd8\x55\x64\xb2\x62\xbf\xc3\xae\x1c\x8c\x72\x64\xfe\x0d\x9e\x2a\x78\x96\xd1\x49\x1c\x40\x4d\xe7\xfc\x26\xd0\x9a\xc5\xe0\xab\x33\x10\xc9\x24\xe5\x65\xcc\xa8\xd6\x81\x6a\xc5\x1e\x08\x3c\x34\xa3\xd4\xe9\x91\x2c\x8b\xa0\x30\x04\xb8\x15\x98\xef\xa0\xe2\x69\xea\xab\x29\x67\xca\x0f\x2b\xfb\x62\x68\x97\xa7\xaf\xd5\xcf\x83\x00\xd0\x22\x3d\x28\xf3\x4f\x82\x7e\x10\x97\x96\xab\x6d\xbf\x23\x49\x16\x89\xc8\xe9\x61\xc6\x13\x19\x99\xbc\x68\xbd\xee\xe3\x88\x75\x89\x5c\xaf\x19\x3c\x7c\x9b\x84\x2e\x58\x34\x8a\x5c\x95\x70\xcc\x62\x97\x08\x11\xf5\xf4\xd8\x9c\x5d\xd3\xd7\xd2\xc0\x89\xbf\xc0\xaa\x05\xff\x5b\xd7\x55\xcf\x13\x55\x33\x35\xb3\xd8\x1b\xdf\x4d\x6a\xa3\xb5\x3d\x1b\x5f\xd6\x65\xa2\x48\xb7\x5b\xcf\xe5\x83\x90\xcf\xb2\xae\x47\x07\x36\xff\x15\xce\x13\xe4\xcb\xf3\x79\x2a\x03\x78\x2e\x0e\x80\x31\x11\x78\x3a\x8c\xc1\x98\x67\x5c\x98\x54\x85\x99\x79\x41\x99\xce\xeb\x9f\xdb\x19\x1f\x27\x99\x89\x9c\x73\x26\x67\x26\xec\xe5\xbd\xa6\xf4\x32\x88\xcb\x69\xc6\x36\x58\x9d\xa0\x9a\x54\x61\x99\x49\xef\x4a\x49\x28\x58\xec\xc6\x41\xa0\xc5\x1b\x22\xfd\x4d\x82\x00\xe1\xe6\xf4\xd4\x63\xee\x93\xa5\x5e\xb3\xbf\xa5\x7f\x97\x25\x0d\x1d\x04\x16\x9e\x5d\xb1\x9e\xa5\x06\x9d\x24\x21\xc5\x75\xf5\x31\xe0\x19\x37\xcc\xde\x28\xd8\x6b\x76\x78\x19\xb7\x5e\x47\x72\x8b\x80\x9f\x0a\x4f\x8c\xe9\x37\x59\x79\x3b\xa4\x44\xc1\x51\x87\xd5\x38\x48\x89\x70\x24\xc9\xef\xd2\x9a\x9f\x13\xd4\xf2\x63\xed\x27\x91\xc8\xe7\x24\xff\x2a\x2b\xd6\xc1\xd5\x5c\xf3\x85\x4a\xc9\x55\x63\x92\xac\x0a\x27\xeb\x27\x48\x7f\x28\x9e\x31\xeb\xa1\x46\x6c\x6d\x21\x79\x12\x3c\x08\xb6\xd8\x89\x38\x55\x48\xcd\x50\x4b\xd6\xf4\x87\xec\x19\xb5\xb9\xd8\xd6\x16\x12\x44\xd6\x31\x24\x55\x8c\x9e\xb0\x53\x73\x7c\x31\x84\x73\x9a\xd1\xb1\xa4\x13\x6d\xde\x33\xaa\xc4\xc1\x83\x40\x18\x72\x1b\x6f\x96\xed\xd8\x26\x77\xfb\xc6\xa4\x94\xee\x03\xb8\x07\x93\x56\x0e\x43\xf5\x9b\xfa\xfd\x86\xa1\x9e\x08\xd3\x3c\x08\xc3\xd9\x79\x55\xb1\x79\x59\xab\x56\x5f\xd0\xcd\x8a\xd6\x66\xdd\x2b\x81\x5c\xaf\x75\x9e\x34\x57\xc4\xdc\x44\xad\x23\x51\x31\xa8\x28\x05\x72\x22\xe9\xd7\xee\x5b\x3c\xf9\xb9\xed\x02\xdd\x78\x8f\xfc\x56\xbb\xe5\xaa\x60\x05\x5f\x27\xf9\x9b\x65\x26\xd3\x45\x46\x63\x05\x26\xe6\xe6\xc3\x6b\xef\x45\x75\x43\x39\xb0\x93\xb0\x0b\x2a\xf8\x32\xcf\x6e\x8f\xa9\x7c\xc5\x18\x15\x2f\x3f\xbe\x79\x0d\xcc\xa6\xa7\x83\x00\x61\x4d\x3e\x48\xbc\xba\xf2\x6f\xdd\xb6\x9b\xd8\xed\xce\x20\xd8\xba\xeb\x16\xf6\x2f\xd5\x0f\xad\xb3\x60\x90\x29\x63\x87\xb2\x32\x52\x67\xab\x43\x50\xab\xe4\x5a\x0a\x6c\x6a\xcb\x26\x4f\x77\x02\x84\x9f\x0b\x91\xdc\xf6\xd2\x1c\xfe\x35\x8a\x36\xf8\x89\x48\xb3\x2b\x80\x9b\xf0\x74\x37\x00\xc9\xde\x93\xfe\x29\xc2\xb0\x87\xdc\x1b\xb6\x6e\x3a\x08\x90\x82\x33\x77\xad\x88\xda\x78\xde\x24\xff\xb1\x71\x38\x5d\x57\xe7\x16\xc3\xac\xeb\xae\x3f\xef\x36\x62\xe5\xed\x70\xf7\xb5\xc2\x9c\xa8\xe7\xb7\x72\xd6\x6e\x9f\xb2\xa3\xbf\x48\x5f\xc5\x56\x7a\x3a\x69\xa0\x9b\x71\xc7\xea\x79\x1d\xf6\x81\xe5\x03\xe9\x99\x39\x72\xf7\xce\xd5\x45\x60\x48\xe9\x60\x26\xe5\x22\xfe\xe1\x87\xeb\xeb\xeb\xde\xf5\x6e\x8f\x8b\x8b\x1f\x76\xfa\xfd\xfe\x0f\x2a\x8b\xbe\x85\xe6\x89\xba\x49\x36\xe6\x1e\x3c\x7d\xfa\xe4\x87\x37\x89\x9c\xc1\xcf\x9b\xd7\x41\x8d\x56\x6f\x2f\xf2\xf4\x87\x9b\x99\x9c\x67\x81\xb7\x46\x94\x35\x0e\x82\x86\xd6\x77\x56\x00\x54\xfa\x03\x85\xec\xc7\x77\x0d\x45\x2b\xb6\x04\x53\x2e\x68\x7a\xc1\xde\x59\xbb\xe2\x44\x8e\xee\xae\x3e\xf6\x60\xb4\x64\x4e\xda\x53\xfa\x84\xbe\xc8\xb5\xee\x30\xf0\xee\xf4\xc3\xa7\x96\x2e\xde\xd3\x36\x48\x77\x81\x39\xc7\xf8\x84\x7e\x54\x57\xbf\xbd\xaf\x14\x40\xd3\xd1\xbf\xd9\x55\xab\xac\x38\xf1\xa5\xd5\xad\x71\x79\x90\xd0\xb8\xcd\x4a\xc9\x6f\xd6\x49\x59\x47\x22\x50\xe2\xa9\x01\x77\x4b\xae\x72\x02\xe8\x9d\x76\xb9\xf5\x6e\x1a\x05\xdb\xdb\xf0\x54\x0e\xb2\x1b\x27\xec\x74\x28\x8c\x8c\x79\xb6\x5e\x07\xe7\x9a\x22\x29\xc5\xfc\x54\x64\x00\x52\x0c\x41\x10\x7b\x4e\x07\xbb\x5e\x06\x23\x53\xfe\xa0\x41\x81\x0b\x14\x86\x0f\xc4\x89\x38\x1d\x45\x41\xb0\x95\xa1\x9e\x14\xe9\x3c\x42\x71\xb6\x15\x2c\x6e\x02\x1c\x4c\x15\xf6\x18\x38\xdb\xb6\xc1\x38\xcf\x01\xa1\x0c\x10\xe6\x9a\x3a\x2c\x47\x83\x05\x8a\xd5\x9d\x43\x84\xb7\x69\x4a\xbd\x33\x00\xea\xd9\x09\x3d\x75\x50\x47\x7a\x8e\x60\xbe\x01\x56\x8d\x75\xe0\xdd\xc7\x01\xa6\x98\x45\xfe\x39\xde\x04\x87\x1b\x8d\x40\x15\x60\x4e\xa6\xe9\x71\x75\x73\x35\xc1\xd9\x19\x6c\x34\xb5\x8a\x9b\x72\x01\x54\x7c\x34\x08\xbc\x6e\xc1\x26\x08\x43\xdb\x52\xb7\x6c\xc9\xa4\xa8\x12\x3b\x01\x8c\xc5\x83\x06\xa2\xdc\xc2\xdb\x03\xd8\xa7\xe5\xa6\x08\xac\xf4\x64\xd3\x21\x6d\x2f\x75\x26\x42\x2d\x08\x49\x18\xe3\x9a\x02\xde\xbe\x99\x5b\xac\x13\x10\xd6\xed\x85\xe0\xd3\x34\xb3\x28\xe7\x94\x33\xb9\x3d\x4d\xc6\x8d\xef\xed\x5c\x8c\x1b\x71\x4b\x91\x36\xe2\xa6\x5c\xcc\x13\xd9\x88\x56\x78\xa1\xc5\x76\xd3\x3c\x4f\xd9\xc5\xf6\x45\x76\xbb\x70\xb0\xaa\x3b\xa8\xab\x10\xf7\x7d\xc3\x66\xee\x44\x3d\x85\x79\xb0\xc7\x73\xbd\x1e\x0c\x2a\x11\x23\x1a\xd3\x1e\xf8\x8e\xdf\xe7\x63\x60\x7b\x19\x1a\xea\x2d\x8d\x28\x1a\x4a\xf2\x81\x9d\xc8\x53\x77\x1e\x39\xe9\x0f\xf9\x33\x7b\x9f\x0d\xb9\xc2\x72\x8c\xad\x91\x13\x7e\x3a\x64\x6d\x27\x44\x21\x6e\xeb\x75\x14\x48\xbe\x38\x1e\x2b\xea\x31\x00\x27\xa3\x7f\x51\x3f\x0a\x07\xb9\x09\x50\x14\xab\xf8\x43\x3e\x5e\xe6\x90\x73\xbd\x56\xdf\x2f\xb2\xa5\xd0\x05\x23\x53\x52\xe7\xc0\xc1\x54\xff\x53\x84\x4d\x02\x64\xc5\xc1\x39\xfc\x81\x67\x11\x13\x09\x72\x0b\x3d\x5b\x14\xe4\x75\x55\xfe\x3d\x78\x79\x35\x95\x33\x1a\x05\xfa\x29\x36\xc0\xdd\x3e\x0a\x43\x53\xa9\xc9\x84\x5d\xa2\xad\x58\x27\x94\x95\x65\x3c\xa7\x7e\x5d\xf0\x5d\xad\x4a\x47\xd9\x24\x57\x91\xfa\x84\x7a\x3e\xf0\xb6\x79\x3c\xa2\x91\xc0\x1f\xf8\x89\x38\x85\x22\x27\xe2\xb4\x86\xdb\x25\xac\xe9\xd3\x1a\xd6\xbf\x04\xcf\x23\x16\xb3\xea\x72\x63\xae\x32\x64\x20\xd1\x0c\xb7\x29\x32\x31\x23\xb5\x22\xe9\x42\xea\x3b\x28\xa2\x84\x19\x6b\xc9\x07\x9a\x8f\x19\x05\x93\xf4\x2a\xd0\x3e\xe4\xcd\x49\x26\xc1\x33\x5d\xe6\xa7\x67\x7f\xfe\x60\x42\x01\xd6\xf2\x5e\xce\x7e\x56\xe4\x5f\x2a\x8a\x4c\x26\xad\x27\x72\x54\x6f\x8e\xe2\x55\x9a\xc7\x2a\xa9\x40\x71\x23\x51\xd5\x53\x8b\x7c\x7b\x0c\xde\x23\x7d\x3a\x64\x5c\xb9\x84\xc1\x20\xa6\x2c\xe7\x46\xc6\xb2\x3a\x37\xc8\xb3\x0f\xfd\x96\x4f\xd4\x89\xf0\x44\x50\x59\xdd\x0d\xb1\x60\xda\x60\x8a\xa5\x85\x0d\x2c\x49\xa7\xa2\x3c\xcb\x06\xa2\xc5\x47\x7a\x2b\xbc\xe6\xc9\x24\xc0\x41\x06\x7f\xe6\x99\x32\x23\xcc\x93\xf1\x0f\xae\xd2\x09\xe5\xa6\x74\xb2\x9c\xa4\x3c\x00\xc3\x58\x99\xba\x19\x45\x86\x44\x56\xdf\x2b\x99\xde\x2b\x19\x16\xd9\x49\xa6\xf6\xca\xb0\x56\x63\x0e\x92\xd9\xae\x0f\x20\xc6\x1b\xe0\x80\xea\x7f\x8a\x70\x2d\x7f\x3a\xbf\x30\xed\xa7\xf3\xe4\xe2\xae\x82\xed\xa3\xaa\xd7\xa7\x20\x9e\xab\x04\x64\x34\x02\x6c\xf8\x03\x5e\x25\xc7\x9a\x7b\x80\x2d\x1b\xa1\xa5\xa2\x09\x95\x49\x9a\xe5\xae\xae\x8f\xfc\xe2\x22\x53\x27\x4b\x9a\x40\xcb\x58\xd8\x62\x29\x83\xf8\x6f\xa3\x84\x9c\x91\x2f\x26\x64\xaa\x78\xc5\xae\x92\x2c\x55\xbd\x4f\x6d\x48\x55\xc2\x22\x8e\x03\xce\xf6\x66\xea\x0a\x0b\x7c\x1d\x8c\x40\x13\xb2\x41\x9c\x91\xdf\x74\x55\x95\xa9\x06\x2a\x2c\x88\x7f\x76\xed\x69\xc2\x86\x55\x09\x9b\xe2\x3f\x68\x5f\xe1\x54\x89\xa0\x49\x10\x7f\x70\x8d\xbc\xf8\x0f\x06\x65\x6f\x95\x8c\xb0\x82\xab\x1d\x9d\x61\x9e\xe9\x9d\x99\xe0\x94\x64\x70\x17\x24\x6a\xf7\xa5\x0a\x2f\x4b\xeb\xdb\x2f\x41\xf6\xd9\x37\x3d\x49\x4e\x87\x01\xdc\xd8\x0a\x88\x24\x23\xc0\xf3\xc6\xaa\xba\x38\xd8\x80\x0c\x98\x8c\x9a\x0d\x38\x26\xe3\xd1\xb8\xa7\x51\x08\x33\x53\x28\x0c\x7f\x11\x20\xeb\x13\x07\x16\x35\xd1\x65\x1a\x50\x64\x3c\x8a\xca\xc9\xd1\x94\x33\xf0\x5d\xc6\x28\x0c\x01\xd9\x55\x75\xd4\x7d\xd6\x8d\x4d\x5a\x10\x6c\xa9\x64\x2b\xef\x6e\x90\xd6\x83\x49\x0a\x4f\xa8\x9f\x13\xc1\x34\x8b\x85\x24\x61\xe8\x72\x39\xa5\xfb\x5a\x7a\xb2\x94\x5c\xdf\x56\x3a\x22\x7a\xd1\xb8\x28\x13\x64\x06\x3d\x0e\x43\x58\x98\x04\xc5\x62\x04\x0c\xff\x44\x75\xd4\x25\xbe\xb2\x51\x4e\x45\xdb\xc1\x19\xbd\xb5\x33\x78\xe2\xfb\xdc\xdc\x8b\xe5\x46\xd1\x59\x7e\x69\x66\xb1\x7b\xd9\x90\x8e\x96\x56\xac\x3d\x24\x04\x10\x1d\x60\x93\xde\xba\xe1\xa9\x63\x06\x90\x6e\x97\xb9\x0f\x2c\x6d\xad\x16\xd7\x1b\x01\x6f\xa8\x9a\x07\x77\x07\xc8\xf5\xa1\x4a\x6a\x36\x73\x57\x73\x80\x6d\x95\xea\x46\x6e\x51\xee\xc9\x7a\x9c\xed\x65\xe9\xf8\x12\xc8\x4a\xce\xc6\x2a\x4c\xae\x2b\x06\x74\x97\xcc\xf7\x51\x6e\x00\xb3\xf7\xc6\x5b\x9b\x76\xe6\x20\x09\xd7\x21\x70\xeb\x71\x72\xda\x3a\xbd\x16\x54\x60\xae\x43\x2d\x79\xed\x44\xb2\x8d\x10\x83\x9b\x14\xde\x48\xa9\xd7\x55\xae\x3c\x73\xa0\x81\xeb\x50\xb5\xe5\xe6\x94\x39\xd4\x9b\x95\x53\xd6\x6a\x09\x78\xd3\x84\x02\x08\xe1\x3e\x08\x31\x66\xe8\x4a\x38\xc2\x14\x1c\xe9\x36\x70\x9e\x44\xe1\x8e\xad\x91\x7a\x67\x9c\x24\xa7\x48\x5b\xf9\xb6\x00\x06\x44\xb1\x53\x20\x19\x75\x72\x83\x68\x4c\x81\x4d\xb9\x5e\x47\x94\xac\x0a\x84\xe9\x49\x7a\x4a\x02\x63\x22\x7b\x23\x48\x32\xa7\xd8\x81\x9b\xda\xa9\xff\x9f\x80\x0d\xda\xc5\xcd\xc9\x29\x8a\xa3\x4c\xd1\xa2\x27\xa7\x48\x9b\xf0\xd6\x5e\x35\x11\x2a\x67\x93\x5b\xf0\xcb\x15\xf8\x05\x8b\xb2\x8e\x81\x77\x92\x9c\x5a\xb6\x59\xeb\x74\x8f\x0d\xfb\xd0\x40\x1b\x47\x58\xa2\xfa\x44\x6b\x26\x41\x39\xdd\xa8\xdb\x32\xd5\xeb\xf5\x38\x0c\xc7\x6d\xf1\x6d\x4b\xe0\x2a\x1b\xa3\x96\x32\x61\x28\x4f\xd2\x53\x05\xbd\x4f\xd2\xd3\xe6\x1a\xaa\x58\x6b\xe8\x45\x4d\x95\x9d\x2e\x9c\xd9\x69\x52\x88\x2d\x25\xe3\xbb\x57\x1a\x2e\x92\xb6\x6b\x07\x4b\x22\x47\xb2\x16\xe7\x60\xb2\x54\xfd\xd2\x46\x16\x2a\x4b\x03\x97\x48\xe3\xa2\x92\x84\xa8\xa9\x6d\xb0\xe9\xc7\x2d\x6c\xfa\x31\x0c\xa4\xa5\xd6\x7f\xe9\x6a\x6a\xdf\x6d\x91\x7f\x15\x81\x0b\xe3\x6c\xbd\x36\xdd\xd6\xf3\xda\xb2\x0f\xc7\xa5\x85\x04\xda\x98\x0b\xb3\x77\x00\xed\xf0\x74\xa3\x2a\x20\xd6\x40\x52\xf3\x56\xe7\x5e\x12\x45\x45\xd8\x49\xc0\x83\x49\x18\x1e\x5a\x8f\xb6\x2c\x52\x18\x38\xe6\x04\x42\xa2\xf4\xe5\x9e\x91\xfe\x30\x2b\x49\xd5\x6c\x8b\xec\x58\xeb\x28\x52\xe1\xc5\xa9\xfa\xdb\x1a\xb4\x60\x28\xe9\x3d\x30\x14\x40\x42\xd2\xe6\xda\x32\x1d\xcd\xcd\x6d\x9e\xda\x2b\x3c\x6d\x7b\xd9\x4e\xec\x2d\x97\x8e\x5e\xb9\x6c\xcf\xb5\xf7\x59\x7b\xbb\xb3\xea\x35\xf3\x55\x7a\x6e\x7d\xeb\xd0\xfd\x8f\x66\x62\x79\x1f\x6f\xe6\xad\x12\xb7\xc7\xeb\xb9\x3c\x06\x7f\x9d\x23\xed\xa7\x91\x6e\x57\x78\x17\x32\x11\x95\x2b\x9e\xd9\x2b\xde\xcf\x03\x57\xbc\x3a\x37\x7e\xb4\x83\x3c\xa2\x72\xa7\xb7\x94\x17\x8d\x4b\x3f\x6e\xcb\x64\x83\xa3\x93\xd3\x38\x08\xb4\x38\x8c\xa7\x6e\x57\xd9\x7e\xff\x01\xd5\xf6\x6d\x6a\x0d\x36\xe4\x77\x50\x6c\xdf\x41\xad\xfd\x9b\x94\xda\xbf\x42\xa5\x7d\x27\x85\x76\x07\x75\xf6\x2d\xf2\x45\x6c\xa2\x89\xea\x64\xd7\x3f\xae\xa8\x49\x5c\x7d\x57\x55\x1a\xfb\x61\x0a\x9e\x60\x5e\x62\x3d\x00\x85\x34\xe6\xd3\x8a\xe0\x44\x19\xa0\x2f\xf8\x5b\x14\x4e\x36\xaa\xb0\xd4\xb5\x7f\xbc\x88\x93\x93\xb2\x20\xce\x4e\x5b\xa8\x9c\x4c\xe1\xf4\xd5\x92\x41\xb0\xd5\x2c\xac\x22\x4f\x51\xdc\x76\x49\x58\x80\x9c\xb9\x4b\xa2\xc9\xf8\xf8\xcf\x09\x12\xbb\x90\x15\xf4\xf9\x9b\xe8\x3d\xdb\x88\x8d\x5a\x0b\x25\x9e\xb2\x6c\xf5\x5d\x86\x96\x4f\x16\x60\xa1\xad\xd4\x99\xf5\xe4\x0e\xbb\x51\x97\xae\xd7\x83\xae\xcf\x48\x0d\xc3\xa7\xb5\xef\x41\x3d\x43\xf4\xa4\x5b\xe5\xc5\x06\x1d\x90\x4d\xde\x06\x5f\x17\xdb\x0b\x9e\x32\xb9\x6d\xa5\x74\x3b\x81\xcb\xac\x5f\xd1\x3c\xd6\xd3\xac\xda\x97\x88\x12\x3a\x7a\x5a\x63\xeb\xf6\x26\x86\x89\x75\x60\x25\x75\x3d\xb6\x9b\x16\x2c\xab\x0f\x61\xbd\xee\x82\x2a\xb3\x47\xe9\x4d\x12\x99\x6c\x43\x27\x05\xe7\x32\xf0\x3b\x31\x67\xbe\xa7\xf2\x73\x16\x19\x69\xe9\x9d\x7e\xdf\xd8\xfa\x4f\x9c\x98\xf7\x11\xe7\xa5\xe6\x8f\xc2\x49\x13\x34\xce\x7a\x35\xf1\xf1\x48\xe2\x04\x53\x9c\x95\x0e\x7c\xba\x91\x20\x62\xbd\x9e\xa9\xba\xb5\x09\x39\x6b\x12\x6b\x98\x18\xeb\x35\xfa\x69\x0a\xb1\x0a\x87\x31\xb1\x76\x0d\xc6\x59\xaf\x26\xa3\xaf\x71\x84\x0d\x3d\x23\x29\x56\xdd\xaa\x89\x14\x47\x9e\xcd\xc3\xd6\x5e\xa7\xd0\xeb\xc2\xed\xae\x71\xd6\x6b\x95\x26\x8e\x12\x5f\x63\xb5\xa2\x25\xb0\x73\x3f\xe9\xd4\x1d\x5f\x3a\x75\xa7\x22\x9d\xaa\x36\xa8\xf4\x56\x00\x5b\x01\x58\xed\x8a\xcf\x53\x67\x35\x0d\xcb\x59\x9a\xb7\x4e\x42\xcb\xa4\x51\x6b\x62\xe5\x8a\x11\x16\x0d\x10\xbe\x55\xff\x0f\x9f\x20\x7c\x03\x11\xbb\x08\x5f\x43\xe0\x21\xc2\x97\x90\xf4\x14\xe1\x03\x15\xf8\xb1\x8f\xf0\x1e\x04\x06\x08\xbf\x83\xc0\x0e\xc2\xef\x21\xf0\x23\xc2\x6f\xa0\xd4\x13\x34\xbc\x62\xba\xeb\x3b\x8f\xcd\xe6\x79\xcd\x48\x29\x78\xd2\xed\xe3\x0d\xd8\x18\x24\xf9\x52\x01\xe5\xb7\x15\x82\xeb\xf6\x71\xea\x67\xbf\x1b\x71\xf6\x73\xd4\x91\x66\x48\x53\xc8\x62\xdc\xed\x17\xf8\x88\x91\xd5\x9b\x4f\xc7\x1f\xcf\x3e\x1d\x1f\x9c\xbd\x3f\x7a\xf7\xfe\xe0\xe8\xe3\x97\x78\x80\x5f\x3e\x3f\x3e\x7b\xf1\xee\xdd\xeb\x83\xe7\x6f\xcf\x7e\x7b\xfe\xfa\xd3\x41\xfc\x10\xe2\xde\x7e\x7a\x73\x70\xf4\x6a\xcf\xc4\x3d\x81\xb8\xf7\xef\x8e\x5f\x7d\x7c\xf5\xdb\x41\x2d\x71\x47\x97\x78\xf7\xdb\xc1\xd1\xeb\x77\xcf\xf7\x0f\xf6\x6b\x15\xee\xee\x40\xfa\xf1\xc7\xa3\x57\x6f\x7f\xae\xa5\x3d\x7a\x68\x84\xb8\xf7\xdf\xbd\xb1\xf0\x7a\x8f\xb3\x69\x7a\xd1\xa2\xa4\x73\xc4\xc0\x08\x98\xc9\x97\xd2\x7c\xbd\x5e\x15\xa0\x0d\xb3\xff\xee\xcd\xf3\x86\x94\xac\x4e\x4e\xda\x92\x55\x0a\x18\x2a\xdf\x7f\xf7\xe6\x4d\x45\x7a\x54\x27\xd9\x7b\x2f\xd5\xf7\xde\xea\xaf\xc6\x9d\x92\xea\x67\xca\x87\x4f\x02\x9c\xea\x3d\x30\x26\x69\x4f\xf2\xd7\xfc\x9a\x8a\xbd\x24\xa7\x11\x68\x7d\x9c\xa4\xa7\xc3\x31\x59\x55\xc4\x46\xe3\x31\x6e\x8a\xf4\x1a\x45\x17\x4f\x2e\x35\x4e\x71\x55\xb0\x55\x67\xa9\x89\xb3\xc6\x22\xca\xb1\xec\x35\x96\x16\xe1\x9a\x48\xab\xc9\xd8\x58\x6f\xc8\xe8\xcb\x37\x7b\x19\x2b\xeb\x0c\x19\xdb\x04\xa2\xbd\x02\xed\x3b\x04\x4a\xb6\x8b\x1f\x7b\x65\x37\xed\x1f\x28\x7d\x0c\xc8\xc4\x86\x92\x6d\x3b\x0b\x15\x78\xf0\x13\x19\xd7\x05\x7b\xb7\xc6\x75\x99\x6f\x1d\xd3\xde\x3b\x38\xe5\x3f\xf6\xd5\x12\xe3\xa4\x95\x3b\x33\xae\x4a\x04\x93\x44\x91\xf6\xb8\x81\x33\xb7\xe5\x85\x65\x27\x19\x14\xa0\x1b\x0a\x54\x37\x00\xa1\x90\xf9\x2f\x06\x4c\x84\xa2\x28\xf0\x5f\x8c\xac\x0a\x7c\xcc\xd4\xd1\xf8\xc8\xc8\x31\x6b\x6e\x04\xfc\x12\xe2\x1b\xeb\x8e\xdf\xba\xf8\xca\x62\xe1\x4f\x2e\xbe\x7d\x35\xf1\x2b\x97\x61\xd3\x92\xe1\x7d\x97\xa5\x6d\x6d\xf0\x73\x46\x56\xe5\x29\x8e\x57\x49\x96\xf1\xeb\xc3\x65\x96\x1d\x8f\x05\xa5\x2c\x7e\xc9\x70\x92\xdf\xb2\x31\x04\x2c\xcb\xc9\x7e\xbc\xcf\x92\x5b\x15\x1e\x27\x0b\xb9\x14\x34\x7e\xc5\x9c\xc8\xf2\x47\xb6\x56\x09\x3c\xcb\xe3\x4f\x5a\xfe\xd7\x03\x99\xf1\xbe\x13\x09\x86\xba\x2c\x4a\xa6\x83\x54\x40\xc0\xc8\xa9\x41\x98\x5f\x33\xb5\x25\x54\x03\x13\x91\x5c\x5c\xd8\x4a\x14\xad\xf1\x96\xff\xa6\xf0\xe8\x44\x52\x95\x75\x96\x4e\x26\xba\xdb\x19\xe7\x0b\xf5\x6f\x69\x39\xd3\xa5\xf9\x52\xba\xee\xb1\x4a\x51\xbe\xd0\x05\x17\x59\x72\x9b\xbf\x62\x59\xca\x20\x5a\xd0\x64\xf2\x8e\x65\xb7\x3a\xfc\xf7\x32\x15\xba\x53\x82\x5e\x51\x91\x9b\x30\xbf\x86\x71\x0a\x7e\x7d\xbc\x48\x58\xfc\x96\xe1\x7c\xcc\x17\x3a\x31\xa7\xc9\x3c\xa3\x79\xae\xc3\x5a\xba\xce\x74\x20\x4f\xbf\x52\x55\x2e\x97\x89\x90\x50\x4a\x95\x56\x11\x0b\x9a\x69\x69\x6c\x35\x4c\x7d\x77\xf4\xb1\x4c\xce\x5f\xb1\x09\xbd\x89\xfb\x38\x95\x74\x7e\xac\x9a\x80\xb5\x18\x8f\xe9\x42\xee\xcd\x12\x91\x53\x19\xf7\xf1\x38\x4b\xf2\x1c\x00\x57\x1f\xcf\xe4\x3c\x3b\xe4\x02\x42\x72\x71\xf0\xf7\x32\xbd\x8a\xad\x18\xfa\x3e\x2b\x70\x03\x20\xc7\xab\x6a\x75\x81\xfe\xdc\x1e\xeb\xef\xc0\xab\x3d\x80\x60\xe0\xda\x50\xb4\x5f\xe0\xb5\x03\x42\x44\xdb\x6a\xce\xae\x02\x68\xa9\x06\xe0\x63\xc3\x80\xae\xea\x3f\x3a\x03\x69\xb2\x34\xdc\x5c\xe7\x80\x98\xa7\x04\x34\x2c\x39\x5f\x46\x71\x6d\xbd\xee\x6a\x49\x85\x2a\x42\x6a\xf2\xd7\x75\x2b\xec\x93\x04\x48\xd3\x69\x89\xde\x74\x92\xca\xdb\x30\xec\x96\x1f\xbd\xf3\x64\xf2\x4a\xd1\x24\x8a\xfa\xa9\x3c\xf4\xf6\x92\xb1\x02\xc1\x06\x55\xd6\x76\xd6\xef\x6a\x41\x01\x8a\x2f\x77\x1f\xc8\xbf\x19\x59\xdd\x64\x29\xbb\x6c\x13\xdc\xd2\x22\x58\x2a\x35\xc0\x37\xf3\xac\x2d\xcb\xef\x6f\x5e\x6b\xe1\x33\x66\x01\x5b\x50\xe0\xc3\xfa\x29\x5f\x4a\x7e\xa4\xf7\x6f\xfc\x85\x61\x7a\xa3\x95\x1c\x8f\xa8\x66\x1a\xe4\x47\x76\x9f\x7f\x61\x58\x61\x34\x54\x5c\xd1\xe7\xd9\x62\x96\xc4\x5f\x36\x6c\x19\xaf\xc2\xc0\xfb\x08\xee\xa8\x3b\xd8\x98\x14\xd4\x1a\x0d\x2a\x9f\x41\x4b\x0f\x00\xc9\x88\xf5\xc4\x3d\x1f\xcb\xa5\x3a\xd2\x7f\xb3\x1e\x7c\x63\x1d\x2b\xc6\x82\x67\xf5\xd8\x97\x82\x4e\x6b\x51\x47\xcd\x5c\xc7\x33\x7e\x5d\x8b\xfa\x98\xca\x46\x36\x45\x0b\x79\x51\xf3\xec\x45\x92\xeb\x88\x79\xa6\x3e\x5f\x27\xec\xc2\xfb\x3c\x06\x64\x43\x7f\x17\x05\xfe\xca\xc8\x0f\x27\x7f\x6e\xff\x19\x9f\x46\x27\xc9\xf6\xd7\x53\xf4\xc3\xc5\x10\xce\x1e\x93\xdb\x33\x9a\x5e\xcc\x64\x27\xc9\xd2\x0b\xa6\x76\xda\xf6\x79\x92\x53\x05\xa0\x3a\x89\x48\xce\xd3\x31\x08\x03\x75\x6c\xe4\x76\x3e\x4b\xa7\xb2\x33\x4e\x16\xb6\xe0\x38\x4b\x17\xdb\x8b\x44\xce\x74\x48\x2c\x33\xda\xd1\x52\x49\x60\x15\x6a\xc1\xb3\x44\xcb\x39\x34\xe3\xb6\xa7\x69\x26\xa9\xc8\x3b\x15\x29\x26\xf3\x25\x28\x9b\x50\x85\x0e\x74\x26\x7c\x9e\xb2\xc4\xef\x19\x65\x0a\x4a\x6f\x9f\x27\xe3\xcb\x0b\xc1\x97\x6c\xd2\x99\xa6\x59\xb6\xcd\x17\xc9\x38\x95\xb7\xfa\x03\x3a\x32\xcd\x38\x9f\x6c\x43\x85\x26\xec\xf2\x68\xa9\xa6\x79\x9a\x99\xb0\x82\x97\x65\x68\x3b\x99\xfc\xb5\xcc\xa5\x89\x90\x82\xca\xf1\xcc\x7e\xdc\x66\x26\xe3\x55\x22\xd2\x84\x99\x4c\xd7\x7a\x3a\x40\x20\x0a\x24\xa5\x4c\x90\x8b\xd4\x6a\xd8\x6d\xcf\xb8\x48\xbf\x2a\x0a\x26\x6b\x49\xbc\x52\x27\x68\x9c\x64\x1d\xc8\xb5\x9d\x4c\xae\xb6\x6f\x4c\x98\x8b\xf4\x22\x65\xdb\x37\x1d\x60\xa1\x79\x53\x93\x51\x29\xa9\xd8\x56\x7b\x14\x3e\x55\x17\x52\x76\x61\x46\x3c\x4f\xc4\x25\x15\xdb\x94\x4d\x6c\x70\x9e\xba\x20\xdc\x0b\x1d\xae\x30\x22\xb5\xae\x0b\x40\xf9\x38\x2b\x63\xe4\x2c\x1d\x5f\x32\x9a\xe7\x9d\x45\x92\x32\xb9\xcd\xc5\x84\x8a\xce\x22\x61\x3c\xa7\xdb\x83\x0e\xb0\x06\x54\xed\x57\x8a\xf2\xeb\xb8\x3e\xc1\x12\x33\xd9\xc9\x67\xc9\xc2\xef\x6a\x2e\xf9\xc2\xf4\x0b\x82\x76\x21\x72\x29\xd2\x4b\x2a\x67\x82\x2f\x2f\x66\x65\x37\xaa\xd1\x65\x5f\x72\x29\xf8\x25\xdd\x9e\x24\xf9\x2c\x11\x22\xb9\xf5\x23\xf8\x74\x9a\x53\x69\x63\xd4\x20\xc6\xc9\xc2\xff\xfc\x8b\xa7\xcc\x7e\xcf\x53\xa9\x06\x3a\x4f\x5d\x01\xaf\x47\xea\xf3\x3a\x9d\xc8\x59\x47\xd2\x1b\xb9\x9d\xb0\xf1\x8c\x0b\x1d\x9e\xd0\x31\xd7\x14\x98\xfe\x2e\x47\xb8\x54\x81\xea\x64\x96\x51\xe5\x08\x96\x2c\x1d\xf3\x09\xdd\x3e\x4f\x27\xa9\xfb\x10\x8a\x94\x54\x5f\x32\xdf\x5e\xa8\x59\x9d\x77\xae\xb6\x13\x05\x92\xce\xa9\x4c\xc7\x9d\xab\xed\x59\xc2\x2e\x54\x2b\x57\xdb\xe9\x84\xf2\x0b\x91\x2c\x66\x10\x3f\x4f\xe4\x8c\xce\x13\xbd\x75\xae\xe8\x58\x72\xb1\xad\x0d\xeb\x76\xd4\x8e\x82\x7d\x74\xab\x83\x6e\x1b\xf9\x5f\xb7\x9d\x6b\x2e\x26\x6e\x0b\x5d\x8b\x14\x76\xd0\x9c\x4f\x68\xe7\xc6\x1e\x72\x7d\x6b\x24\x1a\xfa\xd9\x2f\x0d\xf5\xcc\xd7\x4c\xd0\xa9\x09\x7a\xb1\xf9\x8c\x5f\x9b\xa0\x54\x50\xcd\x86\x6f\x17\xb4\xa3\x2e\x19\x75\xa2\x55\x80\xe5\x31\xa4\x40\x64\x96\xb0\x0b\x08\xe8\x2b\xa6\x97\x2f\xb2\x54\x46\x41\x27\x40\x4e\xcd\xa4\xdd\x3b\x92\x36\xc1\xf8\x95\x29\x5a\xed\x90\x79\x14\xe4\x89\x3c\x25\x7d\x7c\xc8\x9a\x24\xa2\x4a\xa1\x05\xc2\xc7\xac\xb7\x81\x42\x8d\x9e\xb3\x3b\x93\x0f\x8d\xc5\xca\xcf\x8c\xac\xce\xc6\xc9\xf2\x62\xa6\x8d\x10\x6a\x3a\xee\x6c\x96\xe4\x7b\x5e\x64\x77\x80\xcf\x04\x6c\xeb\xeb\x5a\xae\x23\x3f\xb6\x3b\x30\x04\x73\xca\x59\xbc\xd2\x41\x48\xf9\x24\xd3\x9a\xfa\x73\xcb\xbb\x38\xed\xa5\xec\x8a\x5f\xd2\x9f\x97\x89\x98\x94\x76\x5b\xb4\xc4\xed\xd3\xc7\x01\x02\xaf\x20\xad\x79\x8a\x02\xb7\xc6\xd7\xed\x28\x70\x2c\x70\x86\x13\x9c\xe2\x1c\xad\xc6\xbd\x64\xb1\xc8\x6e\xa3\xcf\x0c\x3b\x56\x10\xda\x50\xd1\x73\xa6\xcd\x95\x82\xcf\x11\x3d\xd8\x8d\x55\x8f\x01\x61\xfb\xcc\xda\xbb\x6a\x1a\x95\xb3\x34\xf7\x9a\xc5\x9f\x81\x88\xf7\xe6\xdc\x5a\x84\xca\xc9\x67\xd6\x1b\x67\x34\x11\x95\xc4\xe1\x67\xd6\xab\xcf\xff\x7a\x1d\xb5\xc4\x92\x6e\x5f\xd5\x5e\x59\x3f\x92\xa3\xa2\xc0\x26\xc6\x5f\xe8\xa6\xef\xa8\xbc\x7d\x96\x6a\x1b\xa4\x59\xce\xf4\xc4\xcb\x54\xe0\xfa\x30\xfc\x62\x7a\xca\x6a\x45\xac\xb9\x38\x95\xe2\x6d\xd2\xa1\xd7\x86\x17\xad\xd5\x87\x9a\xb5\x68\x63\x5c\xb0\x8b\x9e\x80\xd7\xb2\xdf\x8d\xc5\xd7\xdf\x80\x42\xfd\x95\x91\x93\x53\xfc\x33\x84\x5f\xc0\xef\x07\xf8\xfd\x83\x11\x2d\xff\xdf\x9b\x0a\x4a\xbf\xd2\x68\xb5\xc8\x96\x17\x29\xcb\xe3\x5f\x19\x86\xfb\x43\x9d\xc5\xfd\x34\x5f\xa8\x9d\xa1\xcf\x55\x1e\xff\xac\xe8\xa0\x8b\x34\x97\x1a\xda\xaa\x2c\x6f\xf8\x64\x99\xd1\x3c\x7e\xd1\x4c\xda\xa7\x0b\x05\x88\xd9\x58\x21\xa1\x1f\x18\x5e\xf0\x3c\x4f\xcf\x33\x7a\x54\xcb\x97\xeb\x13\x67\x8e\x94\x6a\xfb\x3d\xf4\xe5\x9d\xba\xdd\xe2\x69\x33\x21\x7f\xa1\xf9\x34\x8b\x02\xe1\x5f\xcc\x70\x1f\x58\xbf\x8b\xdc\x18\x27\x32\xff\x8c\x93\xd5\x3f\xa9\x19\x73\x5e\x9f\xa1\xf2\xf8\x33\x8e\x2f\xa8\x7c\x0d\x5e\x6c\xa9\x88\x6f\x15\xda\x2b\x12\x53\x53\x1e\xdf\x60\xca\xfe\x5e\xd2\x25\x35\xdf\xd7\x78\x21\xf8\x98\xe6\x39\x7c\x83\x49\xfb\xf8\xb2\x40\x58\x70\xf2\x26\x91\xb3\x9e\x48\xd8\x84\xcf\x23\xd4\x93\x5c\xb3\x5b\xa2\xdd\x47\xa8\x97\x67\xe9\x98\x46\x3b\x08\x67\x9c\x04\x35\xfb\x24\x96\x57\xfc\x20\xd8\x12\x1c\x27\x65\x06\x68\xe1\xa5\xf6\x77\x9e\xeb\xd4\xb4\x31\x90\x85\xa0\xe3\x64\x3c\xa3\x60\x3e\x01\x0c\xc9\x56\x49\x32\x79\x92\x71\x05\x83\xd5\x20\x41\xdc\xb8\x34\xaf\x70\x28\xf8\x1c\x4a\x1c\xa8\xc4\x46\x6c\xab\x35\x09\xaa\xaa\xc3\x5d\xba\x5e\x7b\x4e\xa9\x1e\xd9\xa0\xb1\x2e\x01\x8d\xa9\x3a\x54\x5d\xce\x98\xc3\x1e\x06\x5f\x48\xe7\x54\xec\x69\x7b\x1a\xe0\x27\xc6\x35\xf7\xce\xd8\x96\x81\x1c\xda\xe7\x63\x75\x24\xf4\x24\xe1\xa7\x44\x16\x05\xc2\xe3\xc6\x34\x24\xe3\xf1\x72\xbe\xcc\x12\x49\x3f\x5e\xf3\xf7\xb3\x24\x77\xfb\x9d\xe6\xf1\x4b\x7c\x57\xf2\xf1\x65\xba\xf8\x98\x88\x0b\x5a\x75\xd4\x36\x8f\x28\xfe\x0b\x15\x5e\xd1\x03\xb5\x60\xaf\x69\x72\xe5\xd7\xfd\xd6\xcb\xb0\x9f\x0a\x75\x87\x95\x89\xf5\xfa\x3e\x22\xd5\xfb\xdc\x6c\xe7\x25\x27\xab\x33\x61\x4d\xed\xe0\x33\x40\x19\x3f\x3a\xdb\x3c\x67\x53\x03\x89\x5d\x54\x81\xa7\x9c\x04\x93\xca\x41\xee\x9c\x49\xe8\xbb\x9a\xe6\x0e\x4b\x80\xa6\x55\xfb\xa6\x93\xe6\xfb\x9a\x37\xf3\x5e\x00\x0c\xa0\x93\x4e\x9a\xab\x79\x4d\x2e\x8c\x97\x20\xbe\x58\xd0\x49\xe7\xcc\xd6\x67\x8f\x40\x5e\x46\xd9\xb5\xcb\x7d\x2c\x01\x2f\x38\xd1\x8a\xf6\xfa\x70\xea\xa9\xd3\xf6\x86\xf4\xc2\x9a\xd9\xbc\x66\x3d\x39\x4b\xe4\x11\xec\x9d\xfc\x2d\x9c\x68\x38\x9e\x6a\xfe\x75\x81\xf3\xe5\xf9\x79\x66\x81\x86\x16\xb3\x07\x06\x91\xae\x39\x9d\xd3\x63\x99\xcc\x17\xad\x5b\xb1\xe7\x92\xd7\xeb\xfd\x44\x82\xc9\xb5\x08\x15\x96\x23\xe5\x46\x6d\x00\x52\xfe\x51\x2c\x73\xfb\x59\x0c\x6f\x58\xf4\xbc\x34\x94\x83\xd5\x31\x52\xd9\xcd\x94\xf9\xf0\x1e\x1e\x4d\xea\x95\x5a\xc7\x43\x94\x40\xb2\x37\xef\x43\xad\xb7\x56\xad\x6f\x54\x8f\x88\x50\x1c\x2c\x8d\xcd\x6b\x0f\xaf\xd0\x63\x2b\xb5\xdf\xbc\x6f\xd2\x1d\x20\x0c\x8d\x35\x17\x96\x54\x67\xfa\xa3\x58\x52\x54\x60\x45\x06\x78\xeb\xed\x8f\xe9\xce\xae\xd7\xca\x8d\x1a\x31\x1b\x3a\xaf\x97\xef\x05\xac\xa8\xd6\x0c\xf6\x22\x48\xb7\xef\xba\xdf\xdc\x84\xed\x03\x30\xce\xc5\x1b\x8b\x91\xe6\xef\xad\xdb\x71\xd9\x52\xb2\xc0\x7e\x86\xda\x2e\x3c\x4c\xb2\x9c\xe2\x09\xcd\xa5\x58\x2a\x5c\xbe\x31\x2b\x58\xea\x79\x19\x73\x66\xf3\xf4\x00\x52\x4f\x93\xb1\x56\x52\xa3\x5a\xfc\x4d\xe5\x3a\xa1\xa7\xe5\x13\xbf\x24\xfd\xa1\x7c\x36\xe5\x56\xfc\x48\x6e\x6d\xe9\x4c\x53\x7e\x22\x4f\x75\x46\x75\xfc\x9f\x97\xf5\x91\x05\xc7\xcf\x7b\xc9\xf2\x42\x61\x2b\x7b\x59\x92\xe7\xa4\x0a\xf3\x3c\xef\x2e\x68\x55\xb0\x72\xc7\xea\x4e\xba\xcf\xa1\xd6\xd4\x61\xf4\xba\xc3\xd4\xde\xe6\xd8\x33\x03\x85\xfc\x0f\x52\x49\xf2\x87\x49\x28\xa6\x5e\xd7\x8c\x3a\xae\x6a\xc6\x45\x62\x30\xfa\x5f\xe9\x2f\x64\xf0\x63\xf0\x21\x98\xf4\x39\x8c\x9e\xa3\xda\xd8\xa2\xaf\x78\x35\x49\x64\x62\x6d\x40\xd5\x52\x3f\x57\x52\x61\x44\xe7\x9c\x9c\x3c\xc5\x83\x5d\xbc\xf3\x18\xef\xee\x9c\xe2\x19\x27\xb7\x4c\xed\xab\x4f\x39\xdd\x7f\xf7\x26\x0c\x03\x30\x66\xa5\xa9\x41\xd8\xc3\x41\xca\x3a\xd7\x29\x9b\xf0\x6b\x3c\x37\xe2\x17\xb5\x12\xf6\x91\xfc\x0d\x9f\x50\x95\xdb\x7e\x87\x61\x34\xe7\xc4\x7e\xf5\xfc\x6c\xba\x33\x17\xe0\xe4\xe4\xa2\xd1\x05\x05\x9e\x6b\x6d\x87\x61\x77\x6e\x84\x32\xcf\x38\xd1\x71\x3d\x45\xd6\x24\xc3\x0b\x4e\xba\x51\x43\x9f\xee\x8c\xb7\xca\xd8\x9e\xf1\xde\x95\xda\xc9\x9c\x85\xe1\x60\xe7\x27\x6d\xa0\xe2\x15\x93\x51\x99\x10\x21\x3c\xe8\x23\xf3\x54\xcb\xf1\x2d\x27\x17\x1c\xdf\xd4\x3b\x19\x75\x67\x7c\xbd\x9e\xf3\x30\x7c\xf2\x4c\xfd\x0e\x06\x3f\x91\x39\x47\xf8\x9a\x13\x8d\xa5\xf4\xa6\x82\xcf\xf7\x66\x89\xd8\xe3\x13\x1a\xed\xee\x20\x7c\xc9\xc9\xea\x1c\xdc\xe3\x03\x03\x35\x5e\x2d\x14\xd8\x9e\x34\x11\xbe\x95\x06\xe2\x93\x38\xe0\xec\x45\x59\x20\xb0\x6f\x0b\xf5\x84\x3d\x1d\x1d\x28\x50\xed\x21\x95\x27\xa0\x27\xe5\x2d\x27\x9b\x80\xd0\xd0\xe2\x57\x7a\xfb\x5e\xd0\x3c\xd7\x5f\xda\x55\x1e\xd4\xaf\x3e\xdf\x27\xb9\xa4\xc1\x69\xa1\x6d\xee\xbb\x92\xf7\xeb\x6d\xbd\x35\xbf\xc3\xd5\xb4\x0d\x7d\xb6\xaa\x67\x9d\x46\xd7\x3b\xba\xe3\xfb\xfc\x9a\x75\xbc\x31\x98\xf0\xa7\x85\x0a\xbc\xe1\xcb\x9c\xaa\x1c\xfe\xed\x5a\x19\xc9\x31\x3c\x2e\x7c\xef\x58\xa0\xd4\xc6\xd1\x40\xea\xf7\x8d\x07\x8a\xfc\x3b\x23\xd2\x02\x16\xdf\x3d\x24\x5d\x6c\xe3\x98\x74\xf2\xf7\x0d\x4a\x97\xf9\xe7\xa3\x2a\xf0\x01\x78\x2b\xd9\x83\xdf\x77\x9c\xac\xe0\x36\xfe\x78\xbb\xa0\x79\x7c\xc9\x6b\x64\x44\x9d\x08\x37\x0a\x64\x0a\xa2\xcc\x38\xa2\x71\x5d\xa1\xbd\x65\x01\x82\x58\xcb\xb8\x5e\xf2\x5e\x7d\x8b\x38\xb7\x16\x2d\x65\xd5\xce\x8e\xeb\xa5\x0e\xd8\xe4\xae\x32\x66\xba\x1b\xc5\x74\xbc\x33\x9b\x68\xbd\x12\x6a\x29\xec\x3d\x3e\xfa\x1d\xa4\xb9\x40\x9a\xad\xd1\x9e\xd6\x95\x34\x73\x6d\xd4\xd7\x77\x76\xb4\xae\xe2\x25\xbd\xdd\x03\x3f\xf1\x8d\x82\x30\x3c\x67\x41\x33\x1b\x45\x37\x3c\x0c\xa3\x3d\xbe\x5e\x67\x5d\xd2\x96\x77\x94\x91\x7a\xfc\x01\x9b\x84\xe1\x9e\x2a\x27\xc8\xab\x08\xa1\x38\x5a\xf2\x1e\x20\xde\x84\x63\x15\x74\x88\x37\xd9\x8f\x10\x2c\x68\x1f\x21\x9c\x91\xaf\x20\xd4\xc3\x79\x46\x27\xe0\xdb\x19\xc4\x8e\xc5\x28\xeb\xa9\x7b\x8a\x88\xb8\x34\x01\xfd\x5b\xa4\xcd\xa1\xd8\x24\x84\x5f\x46\x19\xc2\x82\x64\x28\x36\xe4\x7e\x44\xc9\x2d\x1f\xfd\xaa\x8d\xaa\xfd\xac\x4d\x46\x8f\x22\x49\x3e\x7b\x8d\x5c\xf2\x9e\x07\x72\x6d\x8b\x52\x57\x4a\xf1\xcb\x48\x22\x14\x1b\xeb\x23\x27\x02\xcb\xd3\xa2\xc0\xef\x0d\x35\xf1\xc6\xfc\xbf\x36\xff\x47\x8e\x58\xd6\x34\x97\xb3\xe3\xa4\x6d\x32\x1b\x1b\x90\x15\xd4\xfa\x3d\x07\xef\xa8\x7f\xdd\x41\x2d\x1f\x71\x4b\x0f\x83\x48\xf0\x11\xcd\x25\x17\x34\xfe\x80\x85\x0e\x41\xec\xab\xe9\x5b\x4a\x27\x74\x12\xff\x51\x20\x7c\x0c\xe7\xe3\x23\x27\x2b\xe0\x4e\x6b\xc1\x1e\x49\xed\xbf\xc2\xe2\x55\xb8\x61\xf5\xa7\xdb\xc7\x74\x9e\xa4\x99\x0a\x80\xf1\x1f\x15\xd0\x4f\x86\x2a\xb4\x48\xf2\xfc\x9a\x0b\x90\xf5\x01\xee\x2e\x48\xea\xd0\x44\x8c\x21\xa3\xa4\x99\xfe\xbb\x91\xf0\x6f\x5a\x59\x0a\x88\xbe\xa6\xf4\x32\xee\xf6\x8b\x1a\x6e\x10\x5d\x79\x08\x40\xd5\x7a\x5f\x18\x6e\x48\xe8\xcd\x92\xfc\x90\x26\x0a\xf0\x84\x61\xb7\xdf\x25\x9b\x6a\xf0\x32\x46\x41\x80\x83\x00\x69\xb4\xe2\xa5\x9a\x19\x10\x1e\xbd\x27\x54\xd4\x82\xa6\x55\x50\x08\x71\xf7\x81\x7f\x90\x11\x42\x59\x3a\xbe\xec\x58\xc5\xe8\x0e\xc8\xb7\x2e\x96\x2d\x50\x5e\xc3\x40\x6d\x98\x26\x75\xcd\x57\x21\xe1\x5b\xb3\xe7\x3e\x99\xff\x57\x6a\xcd\xeb\x93\xfb\x4a\xe1\xa7\x91\x11\x11\x55\x87\xa5\xdb\x8a\x6c\xad\xd7\x4f\x9f\xb5\x63\x61\x7a\xbe\xf6\xab\x90\xf6\x25\xc7\x67\x69\x0e\x7d\x07\x50\x7b\xbc\x5c\x2c\xb8\x50\x84\xde\xab\x7b\xc2\x60\x22\x47\x7b\x91\x44\xb1\x41\x1b\xc1\x4d\x02\x9f\x00\xff\x3a\x0c\xcb\x70\x55\x2c\x69\x08\xda\x31\x5a\x54\x55\xdb\x8a\x28\x75\x19\x32\x85\xcb\xa5\x5a\xbf\x40\xab\x32\x20\x2d\x1b\xb9\x34\xde\x5f\xd3\x69\x44\x69\x24\x40\xc5\xe6\x15\x47\x09\x99\xeb\x84\x55\x42\xce\xa9\x91\x63\x5c\x50\x80\xaa\xdd\xc8\xef\x0f\x72\xcd\x80\xb7\xcf\x4a\x8f\xd6\xeb\xd2\x3e\x5b\xb7\xd4\xa1\x30\x5a\x15\x2e\x66\xbd\x8e\x12\x32\xa3\x30\x80\x04\x9c\x8b\x26\x40\x6a\x38\x4f\x53\x29\x8d\x12\x98\x9d\x61\x1a\x86\x69\x44\xb1\x50\x58\xbf\xaf\x8f\x5f\x5a\x2e\x03\xaf\x0a\xb2\xaa\x25\xb0\x5e\x8b\x6a\x04\x0a\x43\x6d\x3c\x57\x83\x9f\x52\xe5\xc5\xd3\xf4\x88\xc0\x84\x99\x55\x22\x10\x0a\x24\x36\x25\x00\xf4\x23\xbd\x68\x7f\xa4\xa7\x08\x15\xc5\xb0\x46\x52\x5c\x50\xbc\xba\x4a\xe9\xb5\xe6\x01\x68\xc9\x74\x9f\xbe\x78\xce\xc9\xea\x79\x26\xe3\x20\xc9\xe4\xaf\xf4\x36\xc0\x06\x4a\xc6\xc1\x58\x8a\x0c\x62\xde\x50\x99\xc4\xc1\x9c\xca\x04\x3e\x8f\x67\xe9\x54\xc6\x01\x3c\xd3\xaa\x88\x62\x78\x51\x25\x88\xa2\x5b\x8a\x57\x39\x48\xe0\xfc\xae\x9b\xd5\x1f\x5f\x0c\x8f\x23\x4b\x29\x93\xbf\xfb\x1f\x26\x65\x91\x5c\xd0\xdf\xcb\xa0\xcd\xaf\xfb\x61\x6a\x32\xad\xea\x2f\xdd\x67\x23\xcf\xa6\xfb\xa7\x3f\x2e\xa8\x3a\x2f\xe9\x34\x35\x0b\x10\x5f\x51\x7c\xbe\x94\x92\x33\xcb\x74\x51\xe1\xdc\x9a\x98\xce\x12\x49\x27\x2d\x0c\x30\x4f\x82\xc3\xcb\xb2\x5e\x47\x14\x88\x05\x23\x39\x01\x02\x1b\xb9\x18\x9b\xcf\x91\x3a\x27\x9e\xfc\x71\x99\x11\x15\x66\xd2\xbf\x70\xb2\x9a\x2b\x94\x0a\xf8\x69\xf1\xaa\xce\x6a\x56\x00\xed\x8d\x4b\x0f\x5a\xa8\x04\x48\x7d\x67\x49\x00\xfd\x75\x45\x85\x22\x03\xa0\x62\xe0\xd1\xdd\x51\x31\xa4\x7f\x5f\xc5\x05\xfe\xbb\x0a\x77\xbe\x7c\x1b\xba\x28\x10\x51\xa9\x46\x9f\x9d\x88\xd5\x67\x94\x55\xe6\x49\x1b\xbf\x70\x5d\xd1\xbb\xbe\x5a\x91\x8a\xab\x18\x21\xb6\x46\x5c\x35\x0c\x23\x04\x2c\x4e\x82\xdd\xd3\xaa\x75\x83\x51\xa9\x45\x93\xd2\x6b\x75\x54\x17\x89\xa0\x4c\x7e\x86\x72\x06\x04\x0e\x2b\x1d\x5f\x96\xe6\x20\x24\x96\x04\x6c\x85\x36\xfa\xef\xd6\x1c\x8d\x0e\x14\x2c\x05\x61\xf3\xd8\xd8\xf9\xa7\xc4\x13\x08\x72\xdd\xb5\x7e\x60\xe9\x48\xc4\x7b\x11\x45\xce\x68\x8f\x84\x08\x69\x05\xca\x6f\xa9\x87\x20\x7d\xe1\xbd\x72\x85\x31\xd5\x70\xca\xd4\x9c\x00\x2c\x21\x01\x64\xc8\xf4\x12\x27\x3d\xcd\x9e\x24\x19\x4e\xaa\xbd\x26\x02\xb3\xf6\xba\x61\xdb\x59\xdc\x8b\xf9\x95\x52\xbd\x21\x99\xad\x54\xe0\xda\x54\x90\x0c\xbf\x05\xe8\x09\x9c\x92\x93\x04\x33\xb5\x73\x0e\x39\xb9\x62\xbd\xb3\xb3\xe3\x83\xbd\xa3\x83\x8f\x67\xaf\xde\x7e\x3c\x38\x7a\xfb\xfc\xf5\xf1\xd9\xfe\xbb\xb3\xb7\xef\xb4\xa0\xe1\xbb\xa3\xb3\x2f\xef\x3e\x9d\x7d\x7e\xf5\xfa\xf5\xd9\x8b\x83\xb3\xc3\x57\x47\x07\xfb\x3d\x6d\xae\x5b\xf3\x54\xb5\xaf\xbd\xaf\x9c\x9c\x9c\xe2\xcf\xe0\x89\xf0\x77\x6e\xb5\xb8\x7e\x6b\x20\x6c\x17\x54\x76\xce\xb4\x18\x87\xe7\x8d\xec\x33\x07\xce\x7c\xe7\x6c\x06\x8f\x0a\x1f\xf9\xe2\x35\xbd\xa2\x59\x99\xe1\x77\x5e\xe0\x9c\x9a\x37\x07\x9b\x5c\x01\x0a\xbf\x2b\x14\x51\xe5\x39\xd0\x95\xc7\xaf\x29\x4e\x73\xfb\xd1\xf2\xba\xc6\x0b\x2c\x45\xb2\xd0\x5c\xc0\x09\x9c\x95\xf8\x88\x42\x9c\xc1\x57\x4c\xe4\x5f\x14\x5b\xb6\xb3\x8e\x38\xa6\x05\xc2\xbf\x72\xb2\x4a\x58\x3a\x87\x43\x4c\xd9\x24\xfe\x48\xa3\xe0\xb9\x8d\x08\x70\x19\x56\x74\x0e\xc2\x2e\x6f\x2a\xa9\xf1\x15\xb1\xb1\xc4\x2b\x9b\xc5\x2f\xa7\xe5\xf9\x36\x96\xd1\x94\x18\x52\xfd\x67\x9a\xba\xb0\x9d\xfa\xe8\x62\x02\xec\x7d\x40\xb7\x0a\xfc\x33\x87\x57\x3b\xf5\x5b\xc7\x8b\x5e\x78\x48\x67\x9b\xb9\x15\x6d\x00\xc9\x1f\x69\x95\xd9\xb4\x5e\x47\xda\x9b\x77\xe7\x57\xde\xf3\xe7\xaa\xfc\xc0\x2d\x19\xdc\x04\xdd\x9d\x0d\xe6\xa3\xfc\x44\x95\xb1\xd5\x3b\x52\x96\xaf\xcc\x8f\xf7\xa5\x4f\xf5\x07\x4e\x56\x92\x2f\x9e\x9f\x73\xa1\x2e\x5f\xf5\x17\x60\x15\xe1\x2d\x66\xfc\x92\x46\x81\x3f\x9c\x40\x41\xc6\x4a\x44\xa5\x88\x5b\xcd\x6a\xc1\xb4\x5c\x64\xbf\x78\x19\x5d\xa9\x44\x33\x5c\x2a\x15\xe4\x7a\xc5\xfd\xc2\x3a\x0a\x1b\x94\x28\x36\x66\x87\x9c\x61\xa0\xd8\x19\x0c\xd2\x51\x20\x6b\xab\xe2\x16\x59\x72\xeb\x47\x7e\xd4\xe2\x37\x2e\xcd\x88\xe3\xe8\x2c\x9a\x24\x08\xc6\x06\xdd\xb7\x28\x7b\x1c\x80\x6a\x93\x89\xe0\x39\x88\x72\x82\x51\xa1\x06\xd9\x1f\x07\x1e\x11\x6c\xe7\xab\xce\x55\xa8\x64\x2a\x07\xd6\x60\x06\x54\xf2\x2d\x0d\x3b\x06\x32\x82\x2f\x83\x37\x94\x2d\x55\x16\xf8\x98\x53\xb6\x34\x89\x0b\x35\x72\xbe\x30\xc3\x5e\xaa\xe6\x96\xba\x85\x7d\xbe\x3c\xcf\xa8\x19\xd3\xe4\x3c\x2b\x87\xb5\x2f\x92\x8b\x38\x98\x88\xe4\xc2\x7d\xc2\x68\x54\x8c\x1d\x86\x8e\x54\xf8\x83\x89\x06\xc8\x6c\x13\x6e\x52\x69\xe2\x6f\x52\xe9\xa2\x35\x5a\x00\xf1\xe6\x7a\x30\x09\xea\x4e\xd5\xf1\xfc\xca\xab\xc6\xcc\x8f\x8a\x2f\x27\x66\x5f\xf0\x85\x8a\xe3\x0b\xfd\xb9\xd4\xdb\xc8\x2e\xd7\xc4\x7c\x7b\xcb\x76\x30\x5f\xc8\x14\x84\x26\x75\x40\x47\xb2\xb1\xb8\x5d\x48\x88\xb6\x41\x93\x30\xd1\x91\x13\x1b\x01\x02\x08\x56\x8f\xd3\x12\x6c\xb1\xb5\x81\x65\x09\xb7\xd8\x10\x06\xb8\x24\xe0\xe2\xe0\x92\xde\x4e\xf8\x35\xb3\x91\xc0\xda\x82\xd8\x85\x66\xa8\x5a\x0a\x0f\xe2\x96\x7a\x44\xaf\x41\x80\x7f\x3f\x51\x68\xaf\x16\xe6\x9f\x24\x32\x71\x49\xb1\xd1\x1c\x75\x39\x15\x82\x3c\xf1\x72\xcf\xcd\xb7\xcb\x62\xa6\x51\xa5\x96\xd3\xe8\x78\x6a\xb1\xbe\x5a\x5d\x37\x21\xe1\x0d\x57\x0b\x05\x09\x73\x6e\x16\xca\x62\x23\x26\x9e\x2f\xbd\x8a\xf4\x02\xea\x78\xbb\x82\x90\xa0\x86\x06\xd1\x66\x70\xc0\x2e\x8e\x83\x05\x70\x8d\x75\xc4\x32\x87\x88\xa5\x39\x42\xfa\xb0\xba\x93\xaa\x3e\x53\x76\xa1\x63\x52\xa6\x77\xe4\x7b\xc1\x2f\xf4\x54\x2e\x4c\x08\xa2\x8f\x12\x49\xed\x3e\x10\x89\xa4\xde\x1e\xd0\x26\xcf\x62\x67\xf1\x0c\x28\x6a\x7a\xa9\x56\x3a\x87\x7f\x17\x05\x8d\xe5\x3a\x60\x22\x2b\x94\x77\x6c\xa8\xce\xea\x1e\x3b\x96\x89\x36\xd1\x9d\xeb\x80\x8e\x5c\xe6\x0a\xc1\x8d\x83\x5c\x07\x20\xd2\xf1\xcf\x63\x50\xc6\x7c\xe5\x36\xcd\xc7\x74\x4e\xed\x51\x97\xe9\x9c\x7a\x67\x5c\xab\xf0\xc6\x4e\x83\x17\xa2\x96\xe3\x99\x05\x77\x52\x7d\x78\x30\x0f\x12\xe1\xc8\x42\x8a\x6b\x5a\x7d\xe8\xa5\x85\x78\xb7\xb4\x90\x60\xb6\x09\xa4\x94\xfb\xa4\x72\x91\x02\x60\xae\xdc\x2c\x00\x97\xab\x31\xaa\xd4\x6f\x3c\x5b\xce\xdd\x5a\x5c\xc1\x97\x37\x5b\x9f\x13\x10\xc2\x8b\x83\x6b\x1d\xd0\x91\x33\xaa\xc6\x72\xad\xfe\x82\x02\xff\x01\x17\xf6\x2f\x9c\xf4\xf1\x03\x4e\x02\x2d\x91\xe1\x9e\xc8\x5f\xed\x07\x5b\x51\x10\x6c\x55\x44\x3e\x3c\x31\x0f\x2a\xea\xaf\x44\x9b\x9f\x9d\x06\x83\x9f\xda\x9f\x9d\xb0\x14\x64\xa5\x57\xfb\x7e\xac\x21\xbd\x55\xaa\xac\x21\x1d\x77\x2f\xd6\xb8\x03\xe4\x15\xb6\x50\x1b\x37\xc8\x9d\xde\x4e\x79\xd0\xbe\xcd\x25\x62\x86\x13\x6a\x05\xa0\x84\xf9\xcf\x40\xf0\x29\x11\x15\xfa\x4a\x8a\xfb\x71\x6f\x70\x56\x23\x7b\xdc\x14\xc6\x4f\x55\x44\xa9\x1f\xcb\xe3\x1a\x35\x34\x34\xfa\xa6\xdd\x0c\xa1\x15\x8d\x57\x19\x79\x4b\x35\xd7\xf6\x03\xeb\xd9\x99\x2b\xb5\xb7\x49\x7f\x98\x3c\x13\xf6\x75\x37\xb1\x76\x10\x53\x22\x8c\xd5\x95\x6e\x8b\xc6\xd2\x7a\xdd\x05\xe5\xa4\x55\x46\xba\x03\xc7\x38\x2f\x32\xd2\xed\x17\xaa\x65\x70\xd1\xd9\xe6\x4f\x26\xab\xb1\xa5\xbc\x87\x01\x6d\x3b\x26\x8e\xa8\xea\x2d\xec\xff\x25\xf0\x9a\xb2\x5e\x4d\x59\x07\xdc\x45\x08\x02\x33\x2e\xed\x74\x57\xf5\xb1\x0d\x57\x27\x16\x82\xa8\x65\x11\x9e\x7b\x4e\x97\xa3\x7c\xff\x88\xd5\x52\xf5\x6b\xc9\xde\xc6\xb1\xf6\xd9\xdd\xa6\xb0\xa6\x2b\x3b\x7a\x8d\x9f\x53\x30\xdd\xe1\x8a\xd6\xf7\x4b\x9c\x4e\x23\x2a\x50\xb5\x7e\xfb\x64\x10\x7b\x11\x5e\xcd\xa6\x4e\xdf\x8d\x74\x93\x11\xf4\x85\xe2\x92\x8c\x00\x5e\x80\xa6\x4c\xb3\x64\x91\xd3\x09\xb8\x3b\xd2\x9c\x97\x9c\x2e\x27\x8e\x7f\xd1\xfe\x4e\xfd\x37\xc5\xab\x71\x96\x2e\xce\x79\x22\xf4\x3d\xd9\xe4\x9a\x04\x95\x0c\xea\xbc\xd3\x11\xed\x55\x4b\x99\x67\xe1\x4a\x64\x51\x20\x5c\x67\x28\x1d\x52\xbc\xaa\x72\x69\x3c\x0e\x56\x2a\xc8\xea\x20\x1f\xc7\xc1\x41\x3e\x4e\x16\x34\xc0\xa0\x9a\x70\x9e\x88\x38\xe8\x04\xf8\x35\x9d\xca\x38\x78\x2e\x04\xbf\x56\xc1\x00\xab\xfb\x10\x3e\x3f\x2d\x02\x7c\x94\x5e\xcc\x6c\x32\x84\x03\xac\x6f\x64\x88\x81\x39\xc7\xfb\x0a\x26\x82\x97\x44\x1a\xe0\xcf\x29\x8b\x83\x77\xc7\x01\xd6\xd8\x9e\xbf\xf0\xf8\xf9\x62\x91\xd7\xa2\xec\xa5\xa7\xff\x5f\x73\x85\xde\xbd\xe1\x5f\xdf\x8b\x94\xc1\xf6\xfc\x95\xde\xc6\xc1\x27\x96\x4e\x28\x93\xe9\x54\x61\x46\x05\x1e\x0b\xb2\x7a\x12\x07\x2f\x92\xf1\xa5\x16\x4f\xc6\x4f\xe3\xe0\x63\x72\x1e\xe0\xc1\x4e\x1c\xec\x65\x34\x11\x01\x1e\xec\xc6\x81\x61\x0e\x0d\x1e\xc5\x01\xf0\xe4\x02\x3c\x78\xac\xdb\x17\x3c\x0b\xf0\xe0\x49\x1c\x3c\xcf\x54\xec\xd3\x38\x78\xaf\x6f\xf7\x9d\x7e\x1c\xec\x25\x8b\x5c\xf7\x64\xe7\x71\x39\x69\xbb\x3b\x30\x5d\xbb\xbb\x2a\xef\x85\xda\xb7\x78\xf7\xa1\x0e\xeb\x69\xd8\xfd\x51\xb5\x38\x09\xf0\xee\xa3\x38\x78\xc9\xe7\xaa\xcc\xe3\xca\xcc\xee\x3e\xf1\x66\x76\xf7\x69\x75\x5a\x1f\xf6\x2b\x93\xfa\xf0\xc7\x38\x78\xc5\x72\xaa\xee\xb8\x87\x8f\xca\xf9\x1d\xa8\x31\x1e\x0e\x54\x60\x37\x0e\x0e\x77\x54\xe0\x61\x1c\x1c\xee\xaa\xc0\x8f\x71\x70\xf8\x50\x05\x1e\xc5\xc1\xe1\x8f\x2a\xf0\x38\x0e\x0e\x1f\xa9\xc0\x93\x38\x38\x7c\xac\x02\x4f\xe3\xe0\xf0\x89\x9a\xaa\x7e\x1c\x1c\x3e\x55\x81\x81\xaa\xb0\xaf\x42\x50\xb5\xaa\x7b\x47\xd5\x3d\x50\x95\x3f\x7c\x18\x07\x6f\x97\x73\x3d\x1f\x03\xd5\x2b\x7f\xa9\x76\x76\x1e\xc6\x81\xc2\xee\x5a\x98\x9c\x9f\x29\x5e\x5d\xd2\xdb\x86\xb3\xac\xde\x25\xbd\xb5\x22\xe3\xa9\x38\x81\xef\xd3\xf5\x1a\xfe\x81\xad\x54\x59\xee\xae\xc7\x17\x92\xe6\x00\x97\xd8\xa9\x73\x68\x36\x8a\x28\xf9\x0a\xe6\x22\x06\xbb\xc0\x8a\x32\x8b\x1f\xb7\x89\x2e\x50\x84\x4a\xbc\x97\x94\xaa\x65\x06\xc3\x2d\x2b\x1d\x9b\xee\xa9\x52\xa7\xeb\x75\xb5\x67\x71\x10\x14\x38\xe3\x63\xe3\xb9\xe9\x9f\x72\x62\x05\x5d\xd0\xc4\xc8\xc6\xc1\xb3\x16\xdd\xcc\xa1\x1d\x9b\x11\xb4\x41\x93\x96\x39\x81\x09\x89\xfb\x05\x36\x43\xd8\x50\xec\x9b\xd3\xe0\xe6\x40\xd5\x75\x3d\x4b\xc7\xb3\xef\xea\xc0\x77\xb7\x51\x20\x7c\x5b\xdb\x4c\xbf\x53\x2d\xf9\x03\xb8\xde\x94\x0a\x0b\x77\xeb\x9b\xee\x37\x8a\x57\x1a\xa1\xcc\x7d\xd1\xc3\x8f\x7e\x94\xc6\xf2\x26\x95\xb8\x8d\xcb\x73\xc7\x92\xb6\xac\x50\xf3\x26\xf8\x15\x04\x07\x3d\x65\xf3\xef\xb8\x54\xea\x93\xf0\xb3\x9a\x04\x9a\xc9\xe4\xf7\xb6\xf9\xd7\x29\xf6\x1e\x31\xf9\x34\xae\xba\xef\x25\x6d\xd3\x9e\x17\xa7\x56\x14\xb2\x7e\xd9\x58\xe5\x97\x4a\x95\x5f\xfc\x2a\xbf\xb4\x54\x59\xc9\xd0\x92\xee\x5a\xfc\xc3\xbe\xb8\x64\x32\x51\xa8\xac\x7f\x65\xe5\x42\x61\xd6\x4b\xf5\x3b\xd4\xbc\x9f\x4e\xe2\xf1\x7d\xca\x0f\xc7\xd1\x29\xa3\xb4\x24\xca\xb9\x42\x59\x35\xbd\xa1\xfe\x14\x8d\x66\xff\x0d\x4b\xa5\x03\xdc\x84\x0e\x70\x46\x3a\x63\x0f\xb3\x1d\xf3\xc5\x6d\x67\xbc\x94\x9d\x49\xc9\x7e\xe8\x28\x0a\x1f\x7e\x54\xf3\x13\xcb\x59\xd0\xa1\x9b\x54\x42\x00\x98\x07\x10\x52\xc4\x26\x04\x74\x67\x26\x82\x2f\x3a\x93\x0a\x1b\xa0\x63\x28\xfd\x8e\x23\xed\x3b\x40\xcf\x77\x80\x88\xef\x00\xe5\xde\x01\x72\xbd\x63\x4c\x09\x75\x2e\x0d\x96\x7d\x69\x05\x50\x2e\x01\xd7\x56\x44\x73\x27\x73\x14\xb9\x09\x5a\x92\x1b\x3e\x75\x2f\xe6\x0e\x23\x9f\x5b\x02\x5a\x87\xde\x2d\x4d\x22\x74\x7b\x6e\x70\x75\x20\x80\x3b\x40\xf5\x76\x14\x65\xdb\x31\xe4\x6d\xc7\x12\xb4\x1d\xe1\x88\xd9\x0e\x58\x80\xea\x68\xd2\xb5\xa3\xc9\xd5\x8e\xa1\x50\x3b\x86\xde\xec\x68\x5b\x50\x1d\x43\x69\x76\xa4\x23\x26\x3b\x9a\x68\xec\xc8\x92\x60\xd4\x61\x2d\x24\x65\x28\x42\x1d\x32\x72\x46\x3e\xc9\xd7\xb9\xf2\x48\xb9\x8e\xa1\xd7\x3a\x9a\x48\xbb\xa7\xe6\xd2\x49\xff\xb4\x27\xf9\xa7\xc5\xc2\xbe\x94\x6e\x51\x43\xa8\x0d\xc0\x1b\x07\x67\xc1\x96\x1c\x4a\xa2\x50\xcb\x60\x4b\x62\x46\xbe\x4d\x6c\xb1\x92\xc6\x62\x5b\xc1\x26\x89\x36\x79\x5a\xe0\x1c\x1c\xf3\x31\xbc\x14\x27\xf2\x94\x30\x73\x06\x26\x55\x62\x27\xbf\x27\xb1\x43\x96\xaa\x36\xa0\x35\x5a\x89\x06\x8f\x48\x70\x52\x73\x0a\xa7\xee\x13\xa2\x6e\x50\x86\x2a\x85\xbe\x85\x60\x53\xf2\x99\xb6\x12\x0b\x35\x42\x84\x92\xc3\x7a\x3e\x38\x57\xd0\xb4\x76\xe4\xaa\x9f\x1c\x5b\x5b\xf7\xf8\x80\x35\xf2\xa1\xda\x29\xc7\x18\x6a\x21\x32\x2a\x11\xef\x96\xb2\x1e\x73\x45\xbd\x5e\x57\xe8\x15\x4a\x6e\xeb\x7d\xdf\x17\xc9\x45\x99\xdb\x70\x1f\xeb\x11\xd2\xaf\xd1\xf2\x1c\xab\x31\xfa\x95\xb1\x12\x55\xed\x88\xe3\x31\xfa\x51\x1c\x26\xfe\xf7\x7a\xa7\x3c\x7e\x4b\x99\xdb\xf2\x59\x6a\x31\xd5\x49\x2a\x99\x2b\xaa\xe2\xdf\xea\x15\x57\xde\x4f\xe2\x46\x6c\xf9\x46\xd2\x4c\x73\x95\x7e\x69\xf4\xb6\xf2\xfc\x11\x53\xf2\x6b\x3d\x87\xf1\x01\x10\x53\x72\x51\x4f\x02\x46\x8c\x4a\xf9\xb9\xb1\xab\xf8\xe2\xd6\x5b\x47\x7f\x9d\xb5\x1c\x68\x4c\xc9\xdf\x0d\x5f\x5f\xe4\xb9\xa5\x0d\x25\xf1\xdf\xfb\xdc\x1b\xdf\xcb\x48\x22\x2c\x8b\x62\xf8\x3b\x27\xcd\x83\x47\xc9\x8d\xfb\xc0\xd7\x0a\x0b\xbd\x8c\xba\x03\x54\x60\xc6\x7b\xed\xca\x48\x51\x70\x44\xf3\x05\x67\x13\x2a\xbc\xa4\xce\x31\xc8\x03\xf9\x31\x1f\x93\x85\xff\x59\xea\x97\xf8\xb1\x1a\xf4\x55\x2a\x02\xaa\xd9\x8f\xf1\xa4\x6b\xbd\x68\x1f\x40\x82\x72\x55\xca\x7b\x77\xa9\xe0\xe0\x07\x36\x4b\x5d\x17\x08\x53\x6e\x52\xea\x5a\x3d\xad\xb3\x60\x34\xaf\xa2\x55\x63\xc8\xf1\x44\xe0\xd6\x61\xc6\x7f\x73\xdc\x18\x69\xbc\xcf\x71\x63\xb0\x71\x22\x70\xfb\x78\xe3\x77\xdc\x80\xd7\xa9\x20\x27\xa7\x78\x21\xc8\xf6\x60\xc8\xe8\x75\xe7\x98\x6a\x1f\x17\xe7\xa2\x74\xc1\xfd\x86\x15\x78\xe6\x7d\x77\x07\x05\x9e\x0b\xf2\x86\xe1\x0b\xc3\x97\x3a\x33\xff\x57\x82\xb4\xc8\x66\x1f\xdf\xce\xcf\x79\x16\x86\xfa\x5f\x5d\x40\xf8\x56\x90\x2b\x31\x2a\x23\xa2\x00\xb8\x86\x3d\xe3\x39\x39\x40\xf1\xa3\xfe\xa0\xbf\x8b\x6f\x36\xe4\x1b\x27\x59\x66\x32\x3d\xc4\xd7\x1b\x32\x19\xf4\x4d\x67\xfb\x11\x5f\x6e\xc8\xb6\x00\x9f\xc0\x26\xdb\x23\x7c\xb0\x21\xdb\x54\x24\x17\x5e\xdf\x1e\xe3\xbd\xfb\x0c\x56\xbf\x9e\x71\x81\xdf\x09\x52\xf1\x7f\x85\xdf\x0b\xf2\x5e\x46\xdd\x3e\xc2\x6f\x74\x68\x80\xf0\x6b\x40\xf8\x8e\x44\xfd\x91\xda\xd9\x8e\x91\x05\xc2\x7f\x09\x72\x24\xc2\xf0\xa5\x5c\xaf\x8f\x04\x3e\x16\xe4\x2f\x27\xaa\x30\x2a\x83\xf1\x5f\x02\x7f\x14\xa4\x21\x3f\xbf\xa0\x02\x9c\xaa\xb0\x31\x6d\x15\xa4\xf7\xd2\x7b\x8c\x5f\xe3\x97\xd6\x09\xfe\xf0\xa5\x20\x1f\xc5\xa8\xf9\x70\x5d\x2b\x11\xa1\xa2\xe5\x75\xdb\xd3\x3b\x82\xfd\xf5\xd6\x56\x8b\x3f\xb9\x06\xd2\x69\xe4\xb3\x82\xc1\xac\x72\x53\xa7\x58\xd0\xbf\x97\x34\x97\xaf\x26\x19\xb5\x0a\xb8\xeb\x75\x4b\x3e\x8d\xf5\xfa\xd9\x34\x6a\xf0\x4a\xe0\x7d\xb3\x5d\x9f\x03\xab\xed\x8b\xda\xfc\xf8\x6f\x08\x1f\x0a\xd2\xc7\x5f\x05\xd9\xdd\xc5\x9f\xd5\xef\xf0\x15\x0c\x7a\x35\x49\x81\x4a\xe1\x4b\x70\x1f\xaf\x70\xb6\x23\xeb\x70\xbe\xa9\x2d\x74\x28\xb6\x1b\x73\x62\x45\x2f\xfa\xcf\xe8\x08\x88\xbb\xf8\xbb\xeb\x2c\xa7\xb0\x51\x19\x4c\xe9\xef\xa2\x54\x98\xf4\x46\xfd\xa0\xc6\x7b\x6f\x57\xb7\x1c\x1a\x1e\x5b\x32\xd1\x42\x06\x96\x7f\x1f\x05\x73\x9a\xe7\xc9\x05\x0d\x70\x83\x83\xa1\x0d\x9a\x10\x42\xac\x2a\x07\xd5\xe2\xb7\x84\xfc\x2e\x20\x8b\x9e\x5f\x4a\x5e\x8a\x08\xe1\xfe\x4f\x6a\x10\xba\x6c\x37\xda\x1e\x74\x09\xf9\x22\xc2\xf0\x8b\x78\x46\x28\xaa\x78\xc6\xfa\x5b\xac\xd7\xd1\xdf\xa0\xe5\x6c\x16\xdb\x5d\xa4\x87\x42\x41\xcb\xdf\x04\x42\x68\xf8\x4a\xf4\xca\x29\x24\x5d\x23\x5b\x5d\x8b\x1d\x0c\xf5\xf2\x52\xb2\x5f\xae\xbb\x91\x46\xa6\x61\x48\xa3\x57\x02\x15\x05\xee\x0e\x34\x38\xfc\x4d\x10\x7f\x98\xb0\x29\x86\x06\x3b\xde\x3e\x14\x5b\x9f\xc5\x50\x3e\xfb\x2c\xc2\xf0\xab\x78\xf6\x59\x8c\xa2\x27\x3f\x81\xef\x29\xf2\x04\xa9\xfd\x22\x9f\x7d\x15\xa3\xaf\x22\x96\x28\xfe\x2a\x88\x54\xbb\x89\x6e\x7d\x16\xf8\xb9\x1a\xd0\x73\x18\x90\x99\xe6\x05\xcf\xe5\x1b\x3d\xb1\xd1\xef\x02\x07\xff\x1d\x20\x54\x0c\xdf\x8a\x9a\x52\x93\x3d\x3c\x82\xd0\xd2\xb1\x5e\xc3\x60\xa9\x04\x05\x3f\xbe\x54\x5d\xf9\x22\x60\xb6\xb7\x5c\x1c\xc2\xf7\x9a\x4e\xdc\x2f\xd4\x49\xf4\xf6\x5d\xcb\x21\x29\xf4\x14\xbf\x15\x56\x51\xa7\xe5\x2c\xaa\x5a\x2c\xbf\xb6\x71\x02\x87\xb6\x7c\x8b\xf4\x5c\x4e\xa5\x59\x35\xdf\xa6\x24\x8d\x56\x1b\x4f\x86\x29\x38\xf8\xa1\x5f\x14\xa8\x40\xd5\x01\x28\x04\x3f\xa3\x89\xb0\x75\x52\x03\x78\x7e\x15\xe4\x87\xff\x73\x12\x3f\xdf\xfe\xe3\x2c\xd9\xfe\xfa\xe7\xb2\xdf\xdf\xeb\x6f\xab\xbf\xfd\x47\xf0\xfb\x04\x3e\x0e\xe1\xe3\x10\x3e\x76\x0e\x0f\xff\x5c\xf6\x77\x1f\x43\xb6\xdd\xc7\xfb\xf0\x7b\xb8\xfd\xe7\x72\x70\xa8\x52\x76\xfa\xfd\xbd\x6d\xf8\xdb\x57\xbf\x90\x6d\x67\xf0\x44\xa5\xec\xf5\xe1\xe3\xf0\xe0\xf0\xcf\xe5\x6e\xbf\x3f\xd8\xfe\x73\xb9\xff\x58\x95\x39\x7c\x0a\x29\x87\xfb\x7b\xea\x63\xff\x10\x3e\x0e\x0f\xf7\x4f\xff\x6f\xed\xd8\x9f\xdb\xbd\xfe\xf6\x53\xd5\xf4\x8b\xc7\xaa\x99\xbe\x6e\xf3\x11\x34\xb3\x7b\x08\xcd\x3c\xec\x9f\xfe\xf7\x83\x1f\xf0\xcf\x70\x87\xbd\x80\xdf\x0f\x82\xac\xc0\x28\xfc\xdd\x9e\xf6\xf0\x3c\x91\xb3\x8d\xb9\xaa\x2e\x06\x71\x7e\x75\x71\xa7\xbb\xbf\x02\xff\xe1\xee\x96\x5f\xda\x76\x5a\xb0\x64\x13\x3a\x4d\x19\xb0\x55\xcd\x11\x7a\x73\xfc\x7c\xb1\x08\x43\xf8\xeb\xd1\x1b\x3a\xfe\xc4\xf2\x64\x4a\x5f\xf3\x71\x92\x1d\x9a\x1a\xca\xab\xcf\x99\xc5\xbe\x33\x7f\xd4\xdc\xaa\x14\x7c\x20\x15\xa8\x88\x69\x11\x35\x0c\x82\x69\x7f\xb7\xc0\xcc\xff\x74\xf4\xaa\x4b\xc8\x07\xd1\xcb\xaf\x2e\x40\x92\xd9\xda\x57\x4f\x59\x87\x22\xdf\xab\x94\x1c\x3a\x9f\xab\x7f\x08\xf2\x87\x58\xaf\xef\x14\x91\xc2\x7f\x88\xaa\x4b\xaa\xab\x8b\x9f\x82\x2d\xb9\x15\x3c\xfb\x01\x82\x58\x92\x3f\x84\xef\xd8\xb0\xe2\xe5\x10\xdd\xe1\xac\x0a\x9e\xff\x86\xb2\x96\x3d\x59\x28\x32\x5f\x67\xf7\xd3\x40\xbd\xfb\x81\xf0\x44\xd6\x1c\xfd\xb4\xc7\x97\x0c\x54\x11\xce\xc1\xd0\xcf\xab\x79\x72\xa1\x28\xd5\x9c\xd6\x23\x8f\xd5\xdd\x55\x8b\xfb\x9c\x4e\xb4\xfe\xc3\x39\xbf\x39\xcc\xe8\x8d\x17\xfc\x59\xf0\xe5\xc2\x7c\xbf\x13\x93\x94\x25\x99\x8b\x1a\xf3\x6c\x39\x2f\x5b\xd6\x9f\xb9\x0a\x4e\x4d\x25\x53\x5d\xc3\xb5\x0d\x5b\xcb\x94\xf6\xfb\x78\x26\x52\x76\x69\xbf\xde\xd2\x8b\xc4\x4f\xd5\xa6\x17\xba\x7d\x7c\x21\xd2\xc9\x91\xae\xc5\x04\x0f\xd8\xc4\xfb\x02\xcb\x7a\xde\x27\x3c\xf5\x9b\xef\x3d\xe8\x55\xf5\xcb\x2b\xad\x23\xfc\x0a\x4c\x8c\xad\x63\xca\x99\xfc\x0c\x66\x74\xd4\x57\x96\x32\xba\x97\x25\xf3\x85\xfd\x78\xe9\x92\x8c\xfd\x21\x08\xda\x8e\x73\xb1\x98\x25\x7a\x4a\x64\x72\x7e\x9c\x7e\x85\xb1\x5d\xa7\x13\x7e\x0d\x91\x5f\xb5\xd1\x3e\x15\xe2\x7c\x0e\xcd\xa5\x59\xf6\xae\xac\x09\xac\x5e\x79\xdf\xb9\xe4\x8b\xca\xa7\xe0\x97\x74\xdf\x5a\x53\xaa\x46\x69\x7b\x4a\x65\xdc\x1b\x67\x32\xa9\x8c\x6b\xd4\x65\xb7\x42\x81\x69\x46\x4e\x82\xcf\xf4\xfc\x12\x8c\xb1\xcf\xf3\x00\x07\x6f\xf8\xd7\x00\x07\xef\x82\xd3\xa1\x41\xb5\x2f\xe9\x6d\x1e\x3d\x10\xed\xfc\x31\x9a\x35\xa3\xc1\x37\xb3\xdc\xa2\xbd\xf1\x2c\x11\xcf\x65\xd4\x47\x4d\xde\xd9\xf2\x5c\x5b\x25\x8f\x06\x6a\xb3\x9f\xc8\x53\xf2\x40\x9c\xd0\x53\x75\x5b\x69\xac\x42\xbb\xbc\x9a\x53\xb6\x4c\x25\x9d\x43\x67\x57\x89\xa0\x09\x6c\xd3\x24\xd7\xbb\x5b\x98\x2d\xa9\x15\x7d\xce\xb5\xd5\xde\x19\xc4\xa6\xf3\x0b\x6d\xc2\x77\xb1\x84\xa9\xb8\xa4\xb7\x17\xda\x24\x70\x66\x76\xe3\x9c\xca\x44\x6b\x01\x89\x04\x96\x45\x63\x6d\xb0\x8e\x22\x19\x43\x9e\x6b\x68\xa2\x40\x98\x65\x0a\xe6\x28\x98\x8c\x79\x56\x53\x18\x8f\x82\x00\x61\x91\xb5\x8b\x23\xfe\x53\xd9\xbd\xff\x0f\x84\xc2\xbe\x21\xb5\xf5\x4f\xc5\xb4\xfe\xa7\xc4\xa3\xee\x2b\x0b\x75\x7f\x31\xa7\xcd\xd2\x4c\xdf\x2b\x1c\x54\xe0\x2c\xab\x93\xae\x95\x4b\x28\x4e\x18\xae\x7a\x0d\x8c\xc7\x0c\xe7\x54\xbe\xd2\x1e\x37\x3c\xf3\x8d\x39\xc3\x93\x74\x3a\xf5\x62\x96\xcc\xd8\x3d\xf1\xe2\x26\x3a\x97\xb6\x45\x4d\x27\x5e\xca\xb4\x9a\x02\x96\x41\x16\x0c\x5f\x27\x82\x1d\x72\xf1\x89\xcd\xb5\x19\x73\x88\xf7\x2e\xea\xc2\xe6\xd0\x2f\xcd\x13\x5d\x3e\x39\xcf\xdc\x10\xee\x95\x79\x63\xb5\xfa\x31\xdb\x66\xa5\x93\xbb\x6a\xad\xe7\x6d\x56\x6a\x54\x09\x4b\x8d\x45\xfd\x02\x57\x65\xcd\x35\xbd\x77\x68\xd7\x03\xe9\x34\xfa\x6a\xfc\x36\x48\xc2\x8c\x57\x7f\xe7\x59\x86\x55\x3c\xcb\x18\x7f\x46\x8c\xd0\x21\x33\x8a\x10\x6a\xf5\x86\x88\x91\xca\xb7\xce\xc4\x7a\x7f\x2f\xa9\xb8\xd5\x0c\x29\x2e\x9e\x67\x99\x51\x66\x3b\x01\x87\xfe\xc1\xd6\x2f\xc7\xef\xde\xf6\x34\x68\x4c\xa7\xb7\x11\x98\x0d\xdd\xfa\xaf\xd3\x13\xad\x41\xa0\xfb\x70\xfa\x5f\xaa\x5f\xfd\xa1\x2c\xfd\xdd\xcb\xd2\xa3\x2a\x38\x5e\x4d\xa7\x91\xb0\xaa\x4e\x8a\xdc\x86\x77\x54\x15\xb0\x2e\xc9\xde\x45\x02\x0d\x33\xed\x59\xbc\x1f\x20\x9c\xd0\x48\x20\xfc\x55\x46\x02\x67\xa8\x28\x8a\xcd\x8e\x62\xda\x3d\x2d\x18\xd7\x7b\xce\x4f\x1b\x6a\xf3\xb9\x06\x1e\xda\x8a\xa2\x40\xc3\x23\xcb\xf1\xbb\x4b\xaf\x34\xca\xac\xf7\x2f\xad\xe7\x81\x53\xf3\x3f\xce\xc8\xb1\x00\x05\x85\x23\xce\xa5\x2e\x03\xfc\xf8\x16\x53\xe5\xa5\x9f\x82\x9a\x9f\x89\xce\x53\x60\xfc\x76\x06\x83\x98\x92\x88\x92\x86\xeb\x03\x34\xaa\xa2\x9d\x31\x65\x60\x99\x16\x07\x0d\xa7\x87\x92\x3c\x01\xb7\xd9\xd4\x5b\xf1\x98\x62\x4a\x64\xa5\x06\xed\x18\x0b\xbc\xc2\xcb\xe4\xe2\xad\xda\x56\x94\x18\x0f\xdf\xce\x09\x92\xb6\xb2\xa4\x30\xc1\xf6\x81\xf9\x5e\x27\x4c\x51\xec\xfc\x06\x38\x43\x49\x6d\x4a\x58\x05\x5e\x08\xaa\x3a\x78\xc8\xc5\x1e\x9f\x2b\x1c\xc1\x3b\x33\x49\x46\x3e\x73\x63\x90\xe6\x80\x69\xed\xc4\x7d\x90\x85\x58\x95\x7a\x8a\x8e\x63\x0f\xd8\xb6\x9e\xe1\x95\x56\x77\xa0\xbd\x6a\x16\xac\xe0\xa9\x17\x79\xc0\x26\x85\xa6\x72\x69\x6c\xdc\x17\x18\x6a\xf8\x82\x4a\x27\xc4\x15\x86\x2d\x91\xba\x33\x2c\x0c\xfb\x5d\x75\xfc\x40\x55\x17\x30\x52\x85\x68\xb0\x9e\x36\xfd\x08\xa7\xcc\x98\x30\x31\x51\xef\x00\x39\xc2\x82\xb0\x1e\xbc\x97\x42\x16\x66\xbf\x74\xea\x50\x8a\xdb\x55\xe9\xcd\x15\x0b\x17\x2c\xc6\x89\x79\x0f\x93\x9e\x44\x5b\x87\x16\xc6\x4d\x14\x4e\xc8\xf6\x00\xa7\xea\x67\x4c\xfa\x38\x27\x7d\xbc\x24\x14\x4f\x74\x66\x09\x0e\x7c\x86\x43\x0d\x1e\x80\xa1\x3c\x5c\x6a\x77\x92\x6a\x14\x3c\x0c\x77\xbb\x84\x2c\x3d\x2f\x1a\x51\x42\xb2\x2d\x8e\xb0\xca\x25\x74\x2e\xd6\x92\x2b\x25\xd9\x16\x43\x78\x97\xf8\xf1\x61\x18\x65\x5b\xe6\x1b\x4c\xfd\x18\xc0\x60\x7d\x63\x93\x68\x4a\x96\x15\x9a\x04\x4d\xc8\x12\x2f\xc9\x74\x68\xbb\x99\x4e\xa3\xa5\x02\x13\x5a\x72\xae\x03\x92\x8d\x13\x02\x8e\xdd\xb6\xb6\xc6\x04\xba\xac\xba\x88\xb0\x8a\x15\x2a\x36\x37\x3e\xca\x53\x15\xeb\x37\xc4\xe8\x8d\x3c\x4e\xcf\xb3\x94\x5d\x20\x23\x89\xb7\x24\x13\x3c\x21\x4b\xef\x80\x14\x4b\x32\x2d\x24\x01\x97\xdb\xc9\x7a\x0d\xff\xa9\xb6\xfe\x65\xb6\x54\x02\x7b\x28\x35\xcc\x15\xbd\x0a\x85\x54\x53\x68\x32\xf4\x21\x43\xbf\x92\x61\x98\x66\x64\x05\x0b\xac\xef\x90\x98\x62\xb7\x07\x8f\xe0\xbe\x96\x05\x7e\x4d\xf5\xfb\x0b\x3c\x4b\x3f\x9f\x4a\xda\x72\x20\xf4\x51\x48\xd5\x79\x55\xe7\x01\x3c\x1e\x78\xf5\x62\x4e\xbc\xdd\x0d\x35\x83\xdb\x3c\xbd\x6c\xef\x58\xd4\x90\xc7\x35\x70\x05\x33\x7d\xa4\xf6\xe1\x31\x15\x3c\xed\xf1\x5e\xae\x8f\x0c\xe1\x3d\xca\x26\x58\x13\xe9\x46\xd5\x8f\x12\x89\x70\xcb\xf9\x63\x88\xd5\x8e\x1c\x91\x98\x55\x0e\x9c\x36\x27\x37\x4f\x15\x9c\x30\x80\xd9\x6e\x0c\xa7\x45\xdc\x72\xde\xd4\x8e\x6f\x3f\x86\xf6\x8a\xf9\x14\xa1\x53\x7b\xf7\xd0\xb2\x15\x3b\x0e\x81\xb0\x55\x2c\x03\xf9\x59\xca\x26\x23\x1a\x7b\xd9\xd4\x20\x05\xc2\x5d\xd9\xa3\x37\x92\xb2\x49\x18\xd2\x9f\xb4\x71\x05\xae\xe6\x15\x53\x22\x10\x16\xe4\x15\x8d\x58\xe9\xdf\xf8\x95\x91\xfc\x54\x97\x5b\x18\x66\x61\x18\x0d\xd4\x81\xf2\xe0\xc1\x7a\x2d\x3d\x68\x00\xfa\xca\x0c\xd4\xc1\x65\x05\x22\x40\x82\xa6\x9c\x54\x92\x83\x0d\xa0\x10\x6d\x0b\x78\x30\x02\xe2\x75\x7e\x64\x9d\xc1\xd5\x78\x09\xb0\xfe\x11\x1a\x26\xbd\x9c\x4a\x58\x8c\x48\xb7\x8d\x6d\x4b\x08\x4b\x6b\xc7\x3c\xcb\x20\x7b\x1e\x21\x4c\x7f\xe2\xa3\x48\xf6\x92\xc9\x44\xd7\x90\xa8\x6c\x7a\x4e\x22\xdd\x17\x5c\x36\x1d\x47\x50\xfd\x41\x4b\x1a\xae\xd4\x81\x8a\x42\x5b\x93\x3a\x39\xc5\x94\x30\x70\xb0\xe1\xe3\x26\x55\x67\xee\x61\x28\xb5\xbb\xbd\x15\xb5\x4a\xb7\x38\xa3\x53\x00\xe9\x5a\x96\x8f\x4e\xa5\x42\x6b\x5d\xc4\x47\xbe\x28\x34\x5b\xe3\x3d\x8b\x98\x3a\x1a\xfd\x21\x2b\x9d\xe6\xb1\xad\x2d\x44\x89\x3c\x61\xa7\x98\xda\x87\x2b\xaf\x2a\xa2\x36\xe1\x54\x36\xd2\x3e\xf2\x05\xa1\x3d\xc9\x17\x85\xbd\xe8\x5f\xd3\x28\xc9\x10\x36\xf7\x7f\x61\x30\xe4\x96\x4b\xae\xf4\xc7\xe6\xac\x0c\x7a\x6e\xcd\x31\x58\x1c\x24\x02\x1b\xf3\x7f\x98\x16\x58\xb3\x5f\x0c\x76\xad\xbd\x1b\xd5\x4c\x05\x56\x39\x34\xa8\xc0\xd3\x94\x25\x59\xfa\x95\xfa\x85\x04\x65\x2d\x82\x16\x9e\x93\xef\x61\x69\x97\xc5\x62\x99\x5a\x90\xc1\x7a\x5c\xd3\x28\x67\xd3\x6d\x55\x89\x76\x69\x5f\xb8\xce\xfd\x82\xbb\x87\x28\xe9\x0e\x8a\xc6\xe5\x6e\x28\x96\x3b\xe6\xa6\xe2\xa2\xb6\xc0\xf9\x8c\x2f\xb3\xc9\x31\x05\xeb\x25\xc6\xbb\x4d\x2b\xbe\x51\x76\x49\x6d\x9f\xd2\x15\xa5\xc7\x6e\xb7\xae\x77\xd6\xeb\x16\x5e\xbc\x97\x58\x7f\x7b\x93\xbd\x0d\x8e\x7a\x2c\x9a\x4d\xee\xc8\xd1\xd2\x8f\x0d\x79\x8d\x27\x4e\x3b\xe6\x7d\xba\x10\x29\x17\xa9\x4c\xbf\xd2\xe3\xe5\xb9\x14\xb4\x6e\xf9\xd2\x38\xcb\xea\xca\x9e\xf6\x2c\x51\x78\x44\xda\xc6\x6d\xe8\x6d\x42\xe3\x39\xde\x6c\x40\xae\x36\x1e\xe3\xd7\xf1\x4b\xe1\x3c\xca\xc4\xab\x31\xdc\x3c\x6f\x80\xc3\x56\x61\xad\x68\x10\x14\x69\xe3\x47\xf3\x54\xde\xb1\xb2\x7a\x63\x0b\x3c\xa9\x2e\x2d\x5c\x6f\xed\xcb\xaa\xea\xf7\x5c\xb9\x91\x20\xb0\xcd\xa8\xfc\xad\x4d\xa9\x22\x0e\xc1\x20\xcc\x9e\xa1\xfb\x1d\x1e\xef\xfb\x23\x77\x2e\xa2\x6a\xc5\x9e\xd4\xbd\x91\x95\x40\xab\x97\x02\xcd\xa7\x5f\xed\x23\x89\x29\x8a\x9b\x8d\xf8\x79\x5a\x3a\x5f\xab\x82\xb9\x12\xaf\x98\xeb\xd1\x86\xc2\xdf\xd1\x33\xa6\x7a\xd6\xd2\x94\xc7\x1f\x6e\xcc\x96\xcf\x3b\x96\xd5\xbc\x87\x82\xcf\xff\xc9\x7c\x55\xab\x8c\x1b\x6d\x14\x78\x66\xfd\x54\xc5\xab\x71\xc2\x0c\x2d\xdd\xba\xab\xcb\x17\xa5\x9a\xe7\x37\x59\xb5\x1f\xe2\x52\x9b\xc6\x4e\x9c\x59\xd7\xb2\xa9\x8d\x87\xc8\x01\x1c\x05\x68\xe4\x7a\xbd\x5b\x69\xb5\x62\x20\x96\xde\xc8\x92\xb7\x60\x50\xce\xca\x2e\x07\x6b\x8b\xaa\x74\x89\x92\x0e\x69\x18\xd6\x9d\xec\x55\x9b\x18\xa2\x7a\x91\x0a\x75\x06\x86\xb1\xcb\x66\xeb\x4b\x5a\x36\xea\xbf\x16\xfc\x27\x6d\xce\xee\x58\x1b\x6b\x8e\xbb\x84\x3a\x00\x6b\x32\x73\xd9\x31\x3c\x2d\x3d\xee\x71\xe4\xea\xba\x03\x82\xd5\x6a\x62\x78\x61\xe9\xcc\x49\x3a\x79\xcb\xe5\x1b\x45\x17\x59\xd6\x8b\xdb\x9a\xed\x15\xa2\x55\x6b\xa9\x6f\x65\x36\xf9\x5c\xe5\xf7\xc8\x7b\x57\x96\xc3\x94\x79\x2c\xa8\x7b\xd5\xba\xa1\xc8\xb7\x3a\x5e\x2d\x76\xff\x9c\x1b\xeb\x2d\x70\x3e\x9e\xd1\xc9\x32\xa3\xfb\x74\x4a\x85\xf0\xec\xbd\xbf\x15\xc6\xdc\x6d\x23\xe5\x93\xc0\xcb\x9c\x1e\xdf\xb2\xf1\xb1\x2e\xac\xbd\xd0\x15\x68\xf8\x0b\x19\x67\xbd\xaa\xa3\x42\x7c\xe6\xd9\x04\xed\x69\x5f\x09\xb5\xd7\xf6\x16\xff\x85\x14\x6f\xf2\x04\xa8\x7d\x07\xaa\xed\x52\xa9\x78\xc9\xc0\x5f\x65\xed\x1d\xba\x51\xaf\x2e\x7c\x67\xd5\xf6\xc1\x3a\xcf\x88\x61\xa2\xbe\x07\x41\xa5\xf8\x82\x29\x1c\x6d\xb2\xff\xee\x4d\xc3\xf8\xb4\xf3\x2a\x54\xb5\x79\x92\x4e\xa3\x2a\x2e\xec\x7c\x0e\x59\xd9\x86\x5e\xd5\xd0\x36\xf0\xab\x80\xce\x43\xa5\xdb\x46\xd5\xe8\x4b\x5e\x1a\xc5\x8e\x24\x1a\xb6\x08\x10\xd9\xc9\x1d\xf1\x28\x18\x3c\x79\x12\xa0\x98\x47\xc1\xce\x60\x37\xc0\xfe\x4b\x0b\x45\xe5\x21\xdd\x74\x30\xe7\x86\x13\x05\x2c\xb5\xbe\xb9\x5d\xd8\x84\xd6\x0d\xf5\xb7\x17\x18\x40\x01\xeb\x31\xf4\x4c\x97\x34\xf8\xcf\x2b\x26\x37\xdc\xce\x0a\x80\xd8\xfa\xec\x5c\xae\xd7\x25\xa5\xda\x36\x51\xda\x35\xdf\xee\x93\x00\x61\xe7\xf7\xb3\x3b\x00\xd4\xc4\x6c\x07\xc7\xec\x7b\x2e\x37\x19\x0c\x07\x17\xaa\xc0\xa9\x7c\xd8\x0f\x10\xee\x76\x69\xdb\xd6\x08\xc3\xe8\x1b\x4e\x38\xed\x1c\x98\x89\xe8\x0e\xb0\x2f\x4d\xd1\xea\xd3\x52\x6b\x1c\x14\x08\x77\xfb\xfe\x8c\xd5\x37\x9d\x4b\xa8\x36\x1e\x3f\x28\x53\x26\xe6\x88\xda\xa4\x71\xd6\xab\x45\xe1\x69\xb6\xcc\x67\xea\xcc\xaa\x44\xf7\x81\xbf\xdf\xe6\x4b\xbc\xf2\x04\x1a\x5f\x2e\xcf\x63\xce\xb1\x17\x63\x44\xd2\x6f\xe3\x3f\x98\x89\x36\xb6\x94\xb9\xc8\xe3\x31\xc7\xda\x62\x8c\x63\xc9\x96\x66\xfe\xfe\x32\x69\xfb\xef\xde\xb8\xc8\x8f\x0a\x67\x4e\xcb\x84\x8a\x88\x54\xfc\x1b\x2f\x8a\xe1\x38\x33\xbc\x5e\xb5\xb7\xf6\xe9\xd5\x47\xce\xb3\x3c\x5a\xa9\x43\x03\x9b\xe4\xc5\xad\x7f\x76\xe2\x03\x7c\xbe\x04\xd3\x31\xb7\x0b\x1a\xf7\xb1\x31\x51\x1b\x07\x83\x47\xbd\x9d\x5e\x3f\x30\x1b\x9d\x8a\xf7\xc9\xf8\x32\xb9\xd0\x7e\x1c\xb5\x20\xe2\xf6\x84\xcf\x03\xf3\x7e\xb8\x6c\x3c\xb7\x58\x5e\x6d\x9e\x15\x08\x4f\x32\xb2\xcc\xc2\x30\xcf\xd6\xeb\x65\x36\xa4\x3d\x7a\xb3\xe0\x42\xe6\x64\x92\x39\x81\xc1\x32\x18\x4f\xb2\x02\xd7\x0f\x56\xb0\xcc\x29\x78\xc0\x19\xcb\xc0\xb0\x1d\xbb\x91\x27\x40\xe1\x4e\xbd\xb5\x6b\xd2\x35\x1c\x15\xcb\x38\x68\xc6\x54\xc5\x12\x10\x16\x64\xe5\x44\xfe\x62\x8e\x75\xf8\x33\x17\x97\x54\xe4\x71\x9b\xac\x86\x4e\x33\x19\x2b\x2b\x91\xc7\x3c\x0c\xbb\x51\x77\x83\x2c\x5b\x18\xba\x14\x29\x13\x63\x3c\x07\x99\x8a\x7e\x4b\xe9\xb5\x9a\x1d\xa8\xc2\xe6\xd3\x06\xc9\x70\x9a\xbf\x62\xba\xd5\xb8\xcb\x0b\x6f\x22\xc5\xbd\x66\x4c\xbb\x7e\x15\x64\x95\x41\x47\x36\xe2\x21\x8d\x0e\x8f\xa2\x66\x5c\xa4\xa1\x0b\xc2\x2b\x8d\xe9\xc6\x95\xe3\xad\xe3\x5a\x0b\x14\x05\xd0\x12\xe5\xc8\xa1\xf6\xf2\x33\xd2\xea\x1d\x98\x6d\xa8\x7a\x42\x5b\xb2\xaa\x4a\x8d\x65\x53\xe7\x20\xf1\x5f\x18\x5e\xff\x7b\x87\xd7\x87\x9e\xd8\x32\x1c\x3c\xa1\xa8\xf3\x4f\x45\x8b\x9d\xfa\xe2\x7b\x96\xd0\xd9\x15\xe7\xe6\x82\x75\x77\x81\xc2\x7d\xd7\xeb\xa8\x6d\x8b\xda\xbd\x3e\x72\x1a\xf6\xba\x14\xaa\xaa\x95\x48\x71\xeb\xcd\x8e\xef\x65\x6f\xbd\xa6\xbd\x73\x3e\xb9\x35\xdc\x79\xdf\x05\x37\x44\x17\xe5\x00\xf8\x77\x0c\xc0\x7f\x55\x81\xa7\x9c\x7e\x17\xee\xb7\xbe\x66\xd9\x0f\x7e\xa0\x84\x0c\x7e\x90\x31\xd5\xaf\x6a\xb2\xea\xe5\x5b\x38\x49\x25\x5d\x95\x19\x4b\x17\x24\x77\x2d\xb3\xa3\x34\x73\xaf\xdf\x81\x0c\x13\xa5\x9e\x2a\x5d\xaa\x45\x31\x8c\xa0\xa5\xf3\xd0\x62\xd0\x04\x2c\x2a\x11\x52\x3f\x92\x18\x1e\x1c\xb0\x38\x0d\xab\xd7\xd5\x52\x35\x43\xc0\x7c\x33\x04\xda\xf4\xc0\x38\xc9\x32\xb5\x6d\x4e\x92\x53\xb4\x5e\x77\x79\x44\x4f\x54\xf8\x14\x4b\xfd\x8f\xca\xba\xec\x08\xcd\x6b\x88\xe9\x49\x89\xee\x55\xad\x18\xfc\xc3\x6d\xe5\xb1\x60\xc0\x77\x7a\x57\x22\x60\x88\xc3\x9a\x74\x45\x04\xd6\x09\x44\x24\xd1\x08\x32\x7b\xe4\x2f\xd2\x86\x84\x92\x94\xe5\x4e\x73\xde\x7c\x2b\x6a\x58\x21\x11\x63\x3e\x57\xf9\xad\x25\x87\xf7\xc6\x2e\x91\x02\x72\xd1\xe0\x51\xb8\x31\x43\x24\x11\x32\xc6\xd2\x05\x61\xd1\x8f\xbb\x68\xf8\xcf\xf6\x9c\xdb\x71\x7a\x20\xbb\x3e\x1e\x5a\x56\xff\xf0\x9f\x57\x6f\x50\xd8\x51\xcd\x6d\xe5\x7a\x4d\x63\x7b\xfc\x30\x23\xb2\x6a\xce\xcf\xd8\xef\xab\x4c\x7b\xd4\xea\x2d\x5f\xcd\xf3\x88\x76\x52\x73\x73\xbb\xb8\xb8\xc1\xde\xa3\x2d\xb2\xb9\x3e\x25\xdc\xe0\xe0\x95\x5c\x04\x84\xfe\xe1\x89\x46\x2b\x05\x44\x4a\x26\x9a\x7b\xcd\xfb\xa7\x20\xa2\x04\x10\x61\x48\x7b\x67\x67\x34\xd7\xbe\x8e\x46\x34\x76\xc8\x05\x2d\xda\x80\x42\x37\xaa\xcc\x92\x44\x08\x7c\x51\x75\x18\xbd\xee\xa8\x09\xd0\x6e\xae\x82\xbd\x84\x31\x2e\x3b\xea\x10\x76\x92\x0e\x38\x57\xed\x24\x79\x27\xe9\xb8\xc9\xf7\xfc\xb1\x67\x65\xed\xd4\xab\xee\x48\x21\x95\x94\x8d\x6d\x9d\x8a\x92\xea\xcc\x92\x9c\xfd\x97\xec\x9c\x53\xca\x3a\xa9\x66\x5e\xa7\x39\x9d\x74\xb6\x3b\xf9\x72\x41\x45\x84\x2a\x39\xc6\x5a\x2c\xc6\xca\xd2\x77\x65\x1b\x90\xf2\x35\x24\xca\xd8\x11\x8d\x3d\xa8\x98\xb8\x2e\xb6\xe5\x2d\x39\xbb\xed\xb3\x71\xac\xba\xd6\xa1\x37\xa0\x33\xae\xaa\x9b\x2f\x73\xd9\xa1\xa9\x9c\x51\xd1\x39\xa7\x70\x4f\x74\xb8\xf0\xa6\x07\x77\xd4\xf4\x05\x5b\xb6\x05\x75\x70\x4a\x9f\x15\x06\x42\x69\x1c\x2b\x92\xf0\xe8\x51\xfa\x6e\xf1\x7c\x58\x58\xb7\xb4\x14\x53\xb6\x9c\x53\x01\xce\x64\xba\x03\x7c\x2d\x8c\xfb\x62\x90\x39\x63\xd3\xf4\x62\x69\xd2\x40\x23\x5d\x86\x61\x64\xda\xc8\xa9\xc2\xa9\x75\xd5\xef\xa6\xa3\xd6\x58\x98\x9b\x58\xed\x23\xe8\xc5\xd9\x19\x91\xa8\x30\x39\xf5\xad\xe9\x2c\xc0\x48\x1c\x94\xbb\x2d\xc0\xa6\x7b\x40\xce\x6b\xe3\x31\xde\x0d\xee\x26\x9f\x1a\xe7\x1f\x06\xe4\xb7\xbc\xd0\xac\x34\x0e\x26\x4f\xd8\xe9\x90\xf7\xca\xb1\x12\xff\x63\xbd\xee\x0e\x30\xef\xf9\xe3\x25\xdd\x3e\x36\x16\x73\x53\xd6\xe1\x61\x18\xf1\x9e\x9d\x1b\x70\xd1\xd2\x3e\x0a\x8a\xb9\xba\xa8\x30\x47\x85\x7d\xb4\xa8\x8a\x0d\xbb\xf3\xc5\xc2\x90\x46\xfe\xea\x30\x84\x39\xc4\x81\x25\xf3\xa2\x88\x10\x1e\x2b\xdc\x11\xe1\x9c\xf0\x68\x8c\xf0\x92\xb0\xa8\x8f\xf0\x84\xf0\x68\x89\xf0\x54\xa5\xfd\x88\xf0\x82\xf0\x68\x8a\xf0\xb9\x82\xa2\x8f\x11\x9e\x11\x1e\x9d\x23\x3c\x27\x2c\x7a\x8c\xf0\x05\xe1\xd1\x1c\xe1\x33\xc2\xa2\x27\x08\x5f\x11\x1e\x9d\x21\x7c\x5b\xe1\x4e\xb8\xb9\x94\x91\x07\xaa\xc1\x99\x9d\x44\x38\xd3\xa1\x48\x96\x4b\xb8\x5e\x9b\xa1\x5f\x54\x97\x5a\x22\xd4\xee\x09\xcf\x49\x7d\x24\xc0\xd1\xc6\x69\x24\xf1\x09\x18\xc5\x08\x34\x99\x13\xe0\x9a\x8b\x64\xcf\x55\x9d\x81\x39\x6d\xc2\xcc\x78\x55\x7a\x69\xbe\x70\x19\x61\x85\xb4\x5a\x61\x9e\x5e\xd1\x02\x6f\xac\x03\x72\xde\x55\xcb\x5d\x65\x15\x1a\x16\x68\x2a\x7b\x73\x26\xf1\xcd\x1c\x33\x93\x23\x50\xed\x07\xe8\x3e\x39\x9f\xdb\x89\xbd\x5f\x76\x78\xa6\xa5\x93\xce\x73\x79\xbf\xfc\xe0\x90\x7b\x3b\x65\xdf\x5d\x20\x40\xc6\x6f\xd1\x42\xf0\x45\xde\xbb\x06\x82\xa9\x37\x4f\x16\x51\x9b\xb6\xc7\x5d\x73\x06\x7b\x83\x9a\x1a\xce\xd2\xc9\x5d\xeb\x30\x31\xdd\xa0\xbd\xbf\xf8\xf9\x19\x53\x77\xe9\xbd\x72\x27\xe2\x22\x3f\xfb\x2b\xe7\xec\x3e\xd9\x37\xe5\x58\xd8\x78\xbc\x92\x79\x4c\xb5\x5c\x00\x9d\x9c\x25\xb2\x40\xff\x66\xbd\x60\xae\x3c\x65\xf7\xae\xd7\x95\x40\xa8\x00\x34\xae\x38\x55\x20\x25\x2a\x4b\x3a\xd6\x06\x1a\xde\xc2\x82\x81\x7a\x3d\x59\xe9\x39\x8f\x27\x2e\x23\x48\x63\xbf\x9b\x46\x65\x8c\xbe\x25\x51\x2f\x75\xae\xac\x35\xa3\xf2\x66\x33\x58\xd1\xfe\xa2\x18\xe6\x38\x19\x3a\xd8\xe2\x10\xf5\x94\x38\x58\x61\x20\x37\x1e\x6b\x65\xc9\x28\x55\xc0\xaf\x3f\xcc\x9f\xa5\xc3\x7c\x6b\x0b\x8d\x4f\xf2\xd3\x32\xf3\x49\x7e\x6a\x5f\x0f\x18\xe1\xc4\x82\x2a\xb0\xb3\x7e\x1f\x60\xa5\x50\x00\x03\xb1\x28\x3e\x51\x85\x4f\xd5\x15\x30\x4e\x64\x34\x46\x08\x61\x90\xf3\x90\x94\xac\xce\x97\xf9\xad\xa1\xff\x4f\x4e\xb1\x9e\xa2\xf7\x9c\x67\xf1\xc9\x69\x81\x13\xc2\x70\x16\x71\x9c\xdc\x01\xe6\xc6\x76\xb6\x3f\xa7\x59\x06\x6f\xa0\x2d\x20\xaf\x74\x35\x36\xf4\x8e\x51\xd9\xf6\xa7\xa3\xd7\x61\x38\xa5\x40\x0f\x6e\x48\x47\x3d\x39\xa3\xac\xed\xbc\xd1\x9e\xda\xeb\x11\x2a\xea\x59\x24\x5a\x49\xe3\xcf\x1d\x84\x47\x23\x7f\xb4\x12\xd8\x82\x8d\x43\xad\xc6\xde\xde\x9b\x4a\xfa\x3f\xeb\x8d\xbe\xc7\x4f\x4e\x87\xb2\x01\x3b\x82\xa0\x4b\x68\x6f\xc6\x73\x85\x56\x69\x61\x0e\xaa\x3a\xe8\xf7\xde\x5b\x1d\x06\x5a\x61\x85\x06\x27\xc1\x05\x95\x2f\x96\xf9\xad\x4a\xd1\xa3\x6b\x2c\x81\xa3\x25\x4e\xdc\xbe\x82\xa1\xc1\x2e\xf0\x26\xba\xda\x2f\x78\x73\x75\xa0\x2a\xef\xa5\x6c\x9c\x2d\x27\x34\x8f\x58\x19\x8b\x9c\xf0\x09\x83\x09\x75\x9d\xda\x78\xff\x79\x9b\xe1\x5b\x10\x33\xd7\x72\x4b\xdf\xba\x6a\x66\x34\x81\xa6\x34\xd0\x7e\xaf\x5d\x73\xd2\x3b\x2f\x91\x85\xc9\xed\xcd\x42\x39\xbd\xf6\xa8\x06\x86\x21\xd7\x31\xde\x3e\x23\x9a\xa3\x5e\x27\xc0\xed\x53\xe7\x0a\x69\x76\x47\x47\xd7\x17\xe5\xa8\xc3\x97\xb2\xc3\xa7\xb6\xa0\x8e\x07\x71\x27\x1c\xf4\x02\xb4\xa1\x0f\x95\x95\x70\x9b\x07\x6c\x17\x54\x17\x3b\x2a\x5d\xe3\x7c\x03\xa7\x50\xeb\x22\xed\xd2\x2d\x38\xcf\xce\xd2\x09\x2e\x51\x84\xa8\x8f\xaf\x6c\x05\x28\x2a\x11\x86\x45\xc2\x68\x86\x6b\xdf\x86\xf9\x84\xee\xb8\xc0\xfe\x5f\x41\x64\xec\xd5\x23\xe1\x7c\xe2\x20\x56\x2b\xd9\x5b\xa4\x93\xfb\xdc\x57\x0e\x49\x09\xee\x79\x25\xca\xef\xbe\x6a\x83\xd7\x49\x2e\x3b\x2f\x69\x22\xe4\x39\x4d\xe4\x77\xb4\x34\xb3\x65\xee\xdf\xd6\x1e\x67\xda\x58\xc2\xf8\x16\xa6\x61\x5c\x7e\xdf\x59\xc1\xbd\xa6\x79\x35\xe6\x19\x68\x79\x05\x0f\x83\x02\x07\xc7\x54\x5c\xa5\xe0\x31\x7d\xf3\x90\x66\xe5\x90\x40\xcf\x48\x3a\x64\x29\x2f\x10\x9c\xaf\x7f\xb9\x57\xec\x8e\x83\x9e\xb0\x09\x4c\x8a\x07\x33\x75\xe6\x6d\xaf\x54\x3a\xc9\xe8\xbf\xdf\xad\x7b\x9f\xc2\x7f\xf9\x94\xdf\x62\x8b\x58\x31\xc0\xc7\x00\x27\x43\xe5\xdd\xe4\x41\xbb\x00\x57\xbc\x8b\xd8\x9b\xa0\xdf\x72\x23\xdd\x01\x07\xe9\x16\x69\x99\x60\x75\x55\xde\x89\x0a\xde\xf8\xa8\x60\x05\xb3\xf0\x30\x42\xcd\xc5\xc2\x95\xab\xbe\x91\x5c\x60\xc7\x70\x23\x37\xff\xcb\x7e\xfa\x5f\xf6\xd3\xff\xb2\x9f\xfe\xdf\x63\x3f\xfd\xf8\xc4\x63\x3f\xfd\xdf\xcf\x53\xfa\x0e\x9c\x7a\x99\x55\xae\x03\x87\x12\xf4\x96\x59\xe1\xd3\x43\xea\x36\xad\x01\x5f\xef\xc9\x93\x3d\xdb\x1d\x6d\x6c\x22\x4b\x0d\xb2\xc9\x70\x5b\x43\x59\x5a\xa8\xbd\xbb\x4b\x08\xfb\x8f\xea\xa0\x7e\x4f\xcd\x3d\xbb\x8b\x83\xce\x9c\x0b\x1a\xb8\xc7\xdb\x6f\xb0\x08\xce\xfd\x7b\x01\x30\x88\xbb\x18\x04\xfa\x06\xa8\x30\x08\xbc\xdb\xe0\xbc\x79\x1b\xd8\x67\xf2\x1f\x9f\xa2\x61\xe3\x91\x04\xf6\x3e\x39\x39\xa1\xbd\x14\x73\x1c\x04\xa7\xa7\x56\x41\x63\x35\x9b\x83\xde\xf2\x50\x68\xb7\x29\xa0\x02\x68\x6c\x09\xb1\x68\xb7\x8f\x22\x8e\x05\x1a\xf2\x1e\x18\x94\xcd\xc1\xd3\xb6\x7b\x12\x31\x91\xa8\xd9\x1b\x49\xca\x6c\x2c\xda\x79\x8a\xcc\x93\x2f\xc2\x86\x86\x83\xae\xfc\x57\xef\x6c\x67\x67\x37\x3d\xd8\x5e\x66\x9d\xd5\x9f\xac\xd3\x99\xa4\xf9\x22\x4b\x6e\xe3\x4e\xca\xb2\x94\xd1\xa1\x8a\xcb\xd2\x5c\x6e\x83\x53\x9a\xb8\xc3\xb8\x89\x5c\x24\x93\x49\xca\x2e\xe2\x4e\x7f\xf8\x27\x2b\xfe\x64\x7f\xb2\xde\xd9\xe0\xe1\xf5\xee\xf5\x76\x96\x6e\xaa\xaa\x96\x2d\x4e\xa6\xff\x3f\xf6\xde\x44\xbd\x6d\x1c\x69\x17\xbe\x15\x85\xf3\xb7\x5b\xea\x10\x8a\xa8\xcd\x5a\x46\xe9\x13\x2f\x89\x9d\xd8\xf1\x1a\x3b\xce\xf2\xe9\xa3\x48\x48\x62\x4c\x91\x32\x49\x59\x5e\xa2\x7b\xff\x1f\x2c\x24\x01\x10\xa0\x24\x3b\x3d\x33\x67\x4e\x4f\xa6\x13\xb1\xf0\xe2\x05\x50\x28\x00\x05\x80\x04\x22\x18\x10\x30\x3d\xc9\x1c\xf9\xdb\x05\x4d\x02\x75\xcd\x30\x02\xf8\xa5\xf4\x0e\xb9\x31\x53\x8c\x86\xfd\xb1\xdc\x78\xd2\xd4\x68\x5a\xbf\xa3\x2a\x41\xaa\x21\xfa\xec\x3d\xce\xdc\x8e\x96\xa8\x46\xd3\x5d\xa7\xa3\x25\xa4\xda\x82\x57\x37\x9a\x3a\xc7\xaa\xce\x4e\xc3\x65\x3b\xeb\xf1\x51\x43\xf4\x05\x8d\xf8\xe0\x61\x7c\xd0\x23\x3b\xc6\xd1\xf1\x6d\xe8\xdc\x7d\x0a\xdc\x10\x1f\x1a\xe5\x04\x30\x2c\x08\xf1\x34\xbc\x9d\xfc\x82\x79\xcd\x3f\xdd\xbf\xe6\x5f\x92\xf3\x7a\xb4\xb3\xb4\x7c\xf7\xa5\xf6\xea\x95\xf6\x92\xce\x74\xfc\x9e\xf7\x12\x39\x82\xd1\x18\xf9\xd3\xe5\x00\x4e\x5d\xd3\x82\xc5\x57\xdf\x5e\x7d\xfd\x9f\x6f\xaf\xf0\x91\x28\xda\xab\x64\xa0\x2f\x30\x88\x59\xe0\x7e\x0b\xff\xf8\x56\x2c\x16\xff\xec\x7c\xfd\x9f\x52\xf1\xfb\xcf\x6f\xc9\xcf\x97\x3f\xbf\x15\xf1\x8f\x3f\xbe\x95\x4a\xf8\xbf\xd2\xb7\xd2\xab\x91\x23\xa8\x8f\x5e\xcb\x58\x8e\x02\x67\x52\x2c\xa5\xd4\xff\xa3\x15\xcb\x7f\x94\xb4\xff\xef\x95\x2e\x7d\xc1\x39\x5a\xb0\xd8\xdf\x11\xf6\xf7\x1c\x2c\xd2\xd1\xab\xff\x29\xfe\xe3\x27\xfe\x2c\xfe\x27\x3e\x7c\xe5\xdb\xab\x6f\xaf\xf0\xaf\x90\xfc\x1c\x3a\x2e\xc4\xbf\xbe\xbd\x2a\xbd\x72\xca\x11\x0c\xa3\x62\x50\xe2\x75\xe8\x26\xb7\xd3\xf6\x2a\xf8\x4a\x43\xc7\xb3\xe1\xdd\xd1\xb0\x88\xf4\x59\xfa\x33\xe8\x88\x52\xad\xf4\xa7\xf7\x32\xe8\xf8\x2f\x03\x26\xbb\xdf\xca\xdf\x5e\xbd\xd2\x35\xad\xa4\x6b\xb3\xc0\x2d\x66\xbe\x26\x76\x4b\x2f\xb5\x92\x86\x57\x7e\x9e\xd8\xb4\x5f\xfd\xf1\xe2\x9b\x57\xf8\xa3\xb0\xe5\xfb\x51\x18\x05\xe6\xb4\x70\x5b\x2b\xd7\xca\x8d\x42\x91\x9e\x3b\x33\x82\xd1\x20\x0e\x2b\x5b\xfe\xa4\x84\xe1\xdb\xfe\xf4\x3e\x70\x46\xe3\xa8\x50\xad\x18\x06\xa8\x56\x8c\x66\xe1\x7c\xee\x44\x11\x0c\xf4\xc2\xbe\x67\x95\x31\xea\xc0\xb1\xa0\x87\x1c\x43\x64\xdd\x41\xe1\x70\xff\x9c\xd0\x86\x88\xd7\x89\xc6\xb3\x01\x62\x7c\x15\xcd\x07\xe1\xab\x24\x91\x57\x03\xd7\x1f\xbc\x9a\x98\x61\x04\x83\x57\x07\xfb\xdb\xbb\x1f\xcf\x76\x71\xa2\xaf\x50\xab\x8d\xf3\xfb\x0e\x7a\x10\xbf\x78\x5c\x98\x85\x68\x42\x18\x8d\x21\x53\x86\xed\x59\x18\xf9\x13\xe7\x01\x06\xca\x72\xbc\xb2\x62\xcc\xab\x3f\x1d\xbb\x67\x9b\x6d\x58\x87\xd5\x46\xab\x5a\x6b\x55\x37\x1b\x66\xcb\x6a\x42\xab\x6d\x57\x6b\x83\xc1\xa0\x0a\xdb\xd5\xb8\xd8\xc8\x6d\x29\x84\xe6\x2d\xb4\x0b\x91\x5f\x20\x6e\x0c\x5e\xe1\xc3\x73\xbd\xb4\x70\x61\x54\x66\x4a\xb8\x8c\x1d\x17\x4f\x51\x15\xcd\xb5\xab\xa2\xf1\xd7\x57\xc5\xab\x3f\x5e\x14\x3c\x3f\x98\xe0\xcf\xa7\xca\x56\x18\xa2\xbc\x56\xca\xb5\xc2\x4f\x4c\x4d\x53\x2b\xfc\x2c\x30\xdc\x1e\xb4\x7c\xd7\x0c\x5f\xf1\xf1\xfe\x78\x35\x8e\x26\xee\xe3\xd0\xf7\x22\x30\x34\x27\x8e\x7b\xdf\x09\x4d\x2f\x04\x21\x0c\x9c\x61\x17\x4c\x42\x10\xc1\xbb\x08\x84\xce\x03\x04\xa6\xfd\x63\x16\x46\x1d\xa3\x52\xf9\xad\x0b\xe6\xf8\x90\x13\x79\xe8\x62\xe0\xdb\xf7\x8f\x13\x33\x18\x39\x5e\xa7\xb2\x30\x83\xc8\xb1\x5c\xa8\x9b\xa1\x63\x43\x7a\xe3\x67\xa8\x0f\x9d\x91\x65\x4e\xb1\xa3\x8f\x7d\x51\xa8\x0f\x7d\x1f\x69\x8d\x2c\x1f\xea\xe3\x51\xe0\xcf\xa6\xfa\xc4\x74\x3c\x7d\x02\xbd\x99\xee\x99\xb7\x3a\x5d\x81\xd4\xc3\xd9\x64\x62\x06\xf7\x8f\xf1\xf8\x35\x70\x7d\xeb\x7a\x61\xce\x6c\x07\xbf\xd7\x77\x6b\x86\x7a\x7c\xce\x85\x7e\xeb\xd8\xd0\x4f\x90\x64\xa0\x03\x38\x42\xf7\x16\xa2\xac\x99\x2e\x30\x5d\x67\xe4\x75\x06\x66\x08\x51\x28\x21\xea\x78\x7e\x54\xfc\x4a\x2f\x49\x0d\xbf\x97\x12\x0a\x3c\xbe\x8e\xc9\x19\x36\x95\xc5\x57\xf2\x25\xd3\x77\x3d\x82\x93\xa9\x6b\x46\x90\xc3\x2d\xcc\xc7\x81\x69\x5d\xa3\xb2\x78\x36\x20\xb7\x2d\x63\x17\x82\xbc\x5a\xb3\x30\x3b\x64\xd5\x42\x37\x3b\x63\xff\x16\x06\x8f\xfe\x2c\x42\x59\x40\x6a\x1b\x0c\x82\xaf\x91\x13\xb9\xf0\xfb\x23\x39\x77\x08\x0c\xfc\x28\xf2\x27\x1d\x63\x7a\x57\xb0\xfd\x28\x82\xf6\x62\x80\x8f\xa0\xf1\x46\xa4\x06\xe7\x24\x53\x03\xdf\xb5\x17\xf6\xd0\x23\x42\xe2\x15\x38\x91\xe9\x3a\xd6\x62\x6c\x50\xa1\xf3\x00\x3b\x55\x38\xe9\xc6\xb5\x54\x6e\x6e\xc2\x49\xa1\xb2\x98\x98\xc1\x35\x93\xe5\xce\x3f\x86\xc3\x4a\x97\xe4\xfb\x1f\x95\x4a\x65\x11\x4e\x4c\xd7\x65\x38\x5a\x95\xdf\x16\xe1\x6c\xa0\x87\xb3\x29\x23\xdd\x6c\xfc\xd6\xc5\x7a\x8e\xd5\xd4\x8d\xaf\x29\xeb\xe0\x6b\x33\x9c\x5b\xa8\x54\x3e\x62\x8a\xfc\x69\x07\x54\xca\x0d\x38\x41\xe4\x8f\xb4\xdc\xa0\x52\xae\x22\x91\x33\x19\x51\x8d\x74\x2a\x8b\xf0\x76\x84\x6b\xaa\x13\xf8\x7e\x54\x7a\x44\x4a\x1c\xba\xfe\xbc\x43\x3f\x30\x23\xb6\x15\x1b\xa3\x01\x27\x85\x7a\x65\x7a\xb7\x18\x07\x8f\xb1\x09\x0f\xfc\x3b\x94\x69\xe4\x28\x51\x9f\x03\x89\xba\x60\xe2\x3f\xa8\xc2\x14\xe2\xc4\x26\xa6\x01\x4c\x33\x62\xce\x22\x7f\x61\xf9\x36\xd4\xaf\x07\xb6\x3e\x0d\xa0\x1e\x9a\x93\x29\xd7\xe4\x26\xbe\xe7\xe3\x43\x0d\xf4\x42\xf2\xb3\x9b\x6a\xd3\x80\x93\x05\xf9\xc8\x91\x9c\xbf\xa3\xfb\xd3\x88\xb4\x0e\xf2\x85\xa3\x1e\x7f\x48\x48\xaf\xf3\x76\xbc\x31\x0c\x9c\x08\x33\x24\x0f\x49\x73\x24\x4c\x69\xfe\x6e\x9d\xd0\x19\xb8\x30\x4e\x81\x50\x3e\xe2\x86\x9d\x38\xbb\xc4\x96\x29\x02\xf5\x18\xe4\xa8\x74\x7a\x8c\x06\xfd\x00\xf3\xbb\xce\x0a\xf1\xb7\x72\x82\x8c\x9c\x48\xae\x7d\x4f\x74\x6f\x4e\xa7\xd0\x0c\xf0\x7b\xca\x84\xa4\x6b\xcd\x82\xd0\x0f\x3a\x53\xdf\xf1\x22\x18\xd0\x24\xbf\xda\x4e\x88\x6f\xb3\xfc\xce\x26\x9e\x08\x1f\x69\x24\xea\xf8\xd3\x48\x9d\x0e\xae\x42\xfc\xbe\x12\xc0\x27\x91\xd1\xd3\x8b\x32\xf2\xc4\x98\xba\xb1\xc7\x5c\x59\x60\xe8\x23\x6b\xc3\xa4\xe3\x5c\xb0\xe5\x49\x2e\x77\x16\x8a\x4e\x8e\x15\x91\x59\x58\xd2\x90\xb3\x06\xc6\x04\xc9\xa5\x42\xe6\x68\x62\xf4\x3d\xb0\xef\x9d\x4e\x9c\x1c\x2e\x13\x08\xa7\x8e\x07\x58\xab\x51\xe2\xfd\x59\xc4\xe3\x1f\x69\x89\xb1\xe5\x72\xf5\x87\xef\x72\x97\xd7\x1f\x32\x98\xa1\x03\x5d\xbb\xfb\xcb\x1b\x96\x34\x0f\x69\xfe\x89\x04\x90\x6f\x77\x64\x45\x56\x46\xb1\xa1\xe5\x93\x4f\xf6\x64\x25\xc2\x36\x8f\x8b\x14\xc2\x28\xb6\x11\xd4\xf7\x86\xbe\xeb\xd8\x85\x7f\x58\x15\xf4\x27\x69\x58\x85\xea\x94\xa9\xa3\x72\xad\x81\xfa\xd3\x72\xb3\x4a\xfe\xdd\x44\x1d\x97\x0b\x47\xd0\xb3\x65\xe6\x96\xb4\x60\xbe\xdb\x88\x1b\x7a\xb6\x7b\xc7\xeb\x33\xf1\xb8\x60\xf9\x2e\xbe\xe0\xa2\x13\xff\xe8\xd2\x00\xd4\x93\xd0\x04\x6c\x3d\x1a\x3f\xa6\x09\x22\x17\xe2\x8c\x1c\xd0\x55\x90\x78\x22\xe3\xc6\x60\x8a\x5d\x83\x06\x18\xf8\x8e\x0b\x03\x3c\xb4\x71\x1e\x49\x18\x58\xaf\xac\x30\x7c\x85\x46\x68\xea\x4b\xfc\x9f\x09\xb4\x1d\xb3\x30\x0d\x1c\x2f\x7a\xfc\x43\xff\x83\xce\x03\xf5\x3f\xc8\xc4\x8e\x1d\x57\x98\x41\xb0\xf0\xc2\x99\xe0\xa3\x91\xbd\x88\x19\x69\x58\x29\x67\x51\x63\xd3\xf6\xe7\xb8\x72\x58\x48\x4e\x10\xf1\x51\xa4\x61\x0b\x53\x37\x71\x07\x18\x41\x9b\x74\x79\xa9\x49\x74\xb0\xaf\x46\xfc\x81\xaf\xe3\x00\x0e\xbf\xd3\x52\xc4\x33\x53\xad\x50\xd4\x0a\x66\x14\x05\x45\x14\x5a\x2a\xa0\xb9\x00\x33\x68\x2b\xd1\x38\x98\xc2\x31\xf1\xff\xf4\xb4\x7f\x68\x14\xaf\x27\xa2\x1f\xe6\xad\x19\x5a\x81\x33\x8d\x3a\x5a\x86\x4c\x43\x63\x8c\x8e\xbd\x98\x9b\x99\x1f\x41\x89\x79\xb6\xdb\xed\xee\xd4\x1c\x41\x80\xbf\x6f\x07\x8e\x87\xdc\xaf\x8e\x89\xe6\x21\x8b\x08\x39\x59\x89\xa3\x82\x6d\x09\x10\xbf\x0b\x60\x7b\x5b\x44\x81\x8e\x46\x59\x55\x7c\x14\x36\x31\xef\xc0\x1c\x9f\x7c\x87\x7c\x3e\x56\xa9\x53\x7d\x5c\xd5\xc7\xb5\xc7\xf8\x24\xbf\x5a\x97\x9e\xde\x57\x5b\x90\x00\x86\x16\x97\x8b\xb2\x96\xef\x1e\x80\xb9\x05\x3c\xf3\x76\x60\x06\xbc\x1b\x55\xee\xd7\xc0\xe7\x4f\x2e\x18\x44\xde\xeb\xf2\xe5\xf0\xf3\xe9\x15\xb0\xcc\x00\x46\x7a\xf9\xee\xe4\xb0\xfe\x19\xd8\x81\x3f\x9d\x4d\x5f\xab\x60\x71\x43\x89\xfc\x29\x90\x9a\x18\x4a\x60\x74\xfd\xee\x0c\xb8\xe6\x00\xba\x12\x6d\x22\xdf\xa7\x3c\xdc\xdb\x6b\xb8\x20\xbf\xe9\x71\xa4\x4c\x84\x42\x64\xeb\xfc\xf3\x38\xeb\x1a\xfe\x63\x38\x1c\x0a\xb9\xba\xf9\x74\x7d\x4a\x62\x00\x92\x24\x9a\xfb\x8c\x75\x55\x88\x2d\xc9\xbb\x6d\xdb\x2c\xe9\xe2\x8f\x5f\x3c\x32\x2d\x32\x0d\xfd\x17\xf3\xa7\x73\x14\xe2\x0f\x55\xa6\x77\xe9\x0c\xc4\x9c\x82\xb1\x33\x1a\xbb\xa8\x6f\xa4\x6a\x0c\x46\x03\xb3\x58\xd1\xf1\x9f\x12\x99\x8d\xb0\xee\x96\xb6\x07\xdd\x5b\x88\x9c\xcf\xc2\x47\x38\x83\x9a\x9e\x3c\xeb\x6f\x02\xc7\x74\x75\x66\x0a\xc4\xa4\x5a\x9f\xde\x71\x4e\xad\x51\xae\x57\x5b\x8d\x4d\xa3\x5e\x8b\xbb\xad\x5a\xad\xd6\x95\xd6\x29\x19\xc1\x74\xce\xc5\x4a\xbd\x36\x36\x6f\xac\xef\x46\xd2\x8d\x25\x6c\xd2\x54\xb6\x88\x3d\xbe\x7f\xd4\x6a\x9b\xe6\x60\xb3\x2b\x76\x61\x64\x0a\x42\xa6\x16\xba\xd9\xc1\x3e\x4f\x1c\xa5\x5a\x6b\x54\x37\xad\x4c\x14\xa6\xd7\xa3\xf8\x78\x4a\x12\x8d\xf1\x1d\x99\x68\xde\xd1\x8d\x65\x8d\xe9\x5d\x01\x8d\x54\x85\xb8\x3a\x88\x5b\x15\x38\xde\x88\x14\x3f\x46\x02\x7a\xb2\x26\xa8\x4e\xef\x04\xa7\xbc\x82\xbb\x13\x61\x3a\x30\x71\x6c\xdb\x45\xed\xbe\xfa\x70\x1a\x85\xc0\x99\x8c\x40\x90\xbc\xa1\xc0\xcf\xfb\xba\x7c\x4f\xd4\x65\xbd\x97\x72\xdf\xa8\x1b\xf3\x4b\x12\x1f\x55\x0a\x8c\x1b\x08\x40\x5e\xda\x2c\xec\x34\xa7\x77\xa8\x99\x4d\x8e\x2f\xb6\x30\x2a\x1a\xcf\x26\x03\xcf\x74\xdc\x64\xb0\xcc\xa9\x77\x69\x65\x77\xa5\x2d\xb0\xcb\x27\x5b\x67\x4d\x38\xb9\x9a\xa3\x63\xba\x6e\xa1\x5c\x0d\x0b\xd0\x0c\x21\x70\x3c\xe4\x9b\x75\x81\xbf\x0c\xb1\x24\x58\x3a\xf7\xcd\x57\xda\xf4\xdd\xbb\x09\x56\x87\xe5\x04\x56\xda\xdb\xd1\xcc\x37\x2a\xbf\xa1\x19\x14\xa9\x3f\xd4\xa9\x76\xaa\xa8\x49\xd2\x67\x3a\x5b\xc3\xa2\xc4\xd5\x49\x3b\x60\x56\x2f\x10\xe2\x2a\x9e\xda\xe0\x0b\x08\x03\xe0\x7b\xee\xfd\x63\x32\x53\x34\x07\xa1\xef\xce\x22\xd8\xa5\xd9\x9c\x26\x33\x2c\x23\x49\xac\x03\x0c\xd6\xeb\xea\x0a\xd3\xbf\xae\xe5\x3a\xd3\x4e\x00\xad\xa8\x58\xd1\x0b\xf4\xff\xa5\x24\x57\x28\x6d\xff\x74\x3e\x8b\xd3\x26\xd6\x8b\x77\x01\xe9\xb4\x5c\x0d\x20\x6d\x23\xc9\x6c\x18\x99\x91\x63\xd1\xac\x22\x2d\xb2\x1a\x4d\x7c\xc4\xae\x38\xeb\x22\x19\xc4\x5a\xff\x1a\xf8\x2e\x33\x99\x7a\x14\x66\x42\x63\x83\x8c\xaa\xfa\xb8\xae\x8f\x1b\xfa\xb8\xa9\x97\xdf\x5a\x3b\x5b\x6d\x30\x36\x50\x2e\x4f\xa3\xdd\x16\x18\x57\xd1\xcf\xbd\x87\xf6\x15\x18\xd7\xd0\xcf\xca\xa7\xdd\x0a\x18\xd7\xd1\x68\xe1\x8c\xea\x9f\xc1\xb8\xa1\x97\xfb\xc6\xfe\xe5\xc0\x01\xe3\xa6\xba\xdf\xa1\x8e\x66\xa3\x52\x11\x0c\xdf\xe8\x72\x33\xcc\xc5\xd8\x28\xe0\x05\x01\x7d\x5c\x8d\x7f\xd4\xe2\x1f\xf5\xf8\x47\x23\xfe\xd1\xa4\x3f\x92\x8c\xc7\xcf\x49\xf6\x53\x01\x2d\x44\x2a\xa0\x45\x49\x04\x71\x81\x12\x41\x5c\xac\x38\x35\xa3\x50\xee\x1b\x77\x37\xbb\x01\x48\xb2\x28\x08\x6a\xa2\xa0\x2e\x0a\x1a\xa2\xa0\x29\x08\xd2\xa2\x08\xf2\xb4\x48\x99\x80\xb8\x68\x99\x80\xb8\x88\x62\x40\x52\x54\x31\x20\x29\x32\x17\xc0\x4d\x16\xc8\xac\x95\xaf\xc6\x78\xb8\xda\xdc\xdc\x44\x66\x95\xda\x11\xb1\x9f\xd8\x94\x88\x09\xd1\xdc\x2e\x69\xec\x06\x5e\x54\x31\x14\x15\xcc\x56\x2c\x5f\xd3\x6c\x0d\xf3\x55\x9e\x55\xa9\x42\xd5\x32\x15\xcb\x75\x2f\xd3\xb9\xb4\x32\x18\x5f\xa3\xd9\xf8\x6d\x81\x1b\x50\xd2\x96\x70\x1b\x4a\x9a\x13\x6a\x87\x69\x8b\x62\x94\x64\xa8\x94\x54\x57\xd9\x34\x63\xcb\x82\x71\xa7\x2d\x27\x63\xe5\x59\x6b\x51\x98\x91\xc4\x7c\x14\x76\x95\x35\xf2\x5c\x43\x8b\x17\xfc\x38\x53\x62\x82\x6a\x68\x84\xe5\x2c\x8b\x0d\xc4\x2a\xe1\x0c\x8d\x59\x9f\xac\x63\x7d\x31\x65\x62\x9d\xc0\x16\x0a\x64\xeb\xe2\x91\xf7\xd5\x16\x7c\xd5\x30\x81\xc8\x05\x99\x26\xde\x47\xa1\x52\xc0\x15\x53\xde\x3a\xf7\x0f\x26\xc0\x45\x33\x23\xc9\x48\xc6\xc4\x6f\xc6\x8f\xb4\x8d\xd5\x32\xfd\x64\x7d\x41\xa7\xc2\xc5\x89\xe3\xd1\x61\x76\xb3\xd9\x9a\xde\x95\x1e\xd9\x64\x98\xa2\x1a\xd3\xbb\xc5\x22\x51\xb7\x54\xc7\xad\xc6\x6f\x78\x79\x16\x01\xb6\x3f\x0d\x5d\x20\xac\xd5\x26\x2e\x88\x35\x6c\xc1\x5a\x32\x28\x96\xab\x70\x82\x86\x74\x7b\x77\xfc\x8e\xac\xd7\xbb\x70\x48\x17\xf8\x88\xaf\x85\x9e\x11\xa2\x06\x4e\x0f\x08\x02\xef\x68\xb0\x10\x2c\x40\x98\xea\xc9\xf5\x35\xc1\x58\x10\x8d\x4b\x2c\x88\x48\xd0\xa0\xba\xbf\xf5\xde\x27\xa8\x1f\xb3\x30\x72\x86\xf7\x2c\x8c\x8a\x10\x6e\xa7\x55\xf3\x08\xce\xf3\xe7\x81\x39\x7d\x9c\x8f\x9d\x08\xe2\x65\x0b\xd8\x21\x22\xe4\x9c\x4d\xf7\x0f\x2e\x69\xd6\xfd\x39\x0c\x2c\x33\x84\xe2\x02\x65\x12\xb0\x28\xef\xee\xbd\xb3\xdf\x12\xf8\x6c\x3a\x95\xc3\x93\x80\x45\xf9\xe3\x87\x63\xff\x81\x16\xc9\x9c\xe2\x75\xf2\x87\x0c\x3e\x0d\x59\x94\xcf\x0f\x0e\x1d\x1a\x61\x32\x8b\xa0\xfd\xc8\x74\xa7\xe5\x09\xb8\x88\x0c\x12\x38\x0d\x1c\xbc\x49\xc1\xb9\xe8\x0b\x53\x02\x89\x1d\x74\x59\x10\xef\xb3\xb7\x9a\x95\x36\x72\x5b\x8c\xd1\xfb\x93\x1d\xba\xfb\x32\xb3\x2c\x18\x26\x90\x9a\xb5\xd9\xac\xd9\x0b\x53\x86\x49\xd2\x91\x85\xf1\x09\x0d\x1a\xf5\xaa\x85\x74\xef\x7d\x78\xf7\x9e\x00\x1d\x6f\xe8\x27\xa9\x18\x9b\x95\xd6\x10\xa5\x22\x00\xd2\x24\xc4\x00\x9e\xbf\xde\xa8\x36\xdb\xa8\x20\x07\xad\x63\x8b\xa0\xe6\x66\xe0\x39\xde\x28\x86\xb4\xcc\xa6\x5d\x1b\xe0\x82\x64\x30\x4c\x41\xb2\x61\x5c\x42\xcd\x66\xc3\xc0\x05\xa9\x9e\xc0\x9b\x3b\x02\x24\xa7\xd9\xc5\x08\xb3\x5d\xaf\xd7\xab\x28\x9d\x0c\x24\x4d\x26\x1b\xc4\xa5\xd2\xaa\xd7\x1a\xb5\xfa\xa2\x7c\xf1\xae\xd5\x3c\x03\x83\x91\x58\xf7\x78\x56\x90\x69\xa8\x89\x45\x88\xd1\x92\x74\x33\x01\x24\xd5\x2c\x55\x6c\x18\x6f\xc7\x95\xb6\x89\x22\xc4\x56\x91\x85\xda\xc3\x61\xc5\x6e\x2d\xcc\x0c\x36\x49\x35\x13\xa0\x4a\xd5\x32\x60\x75\x50\x43\x56\xb2\x03\x1a\x3e\x8a\x81\x6d\x44\x92\x66\x1b\xda\xc3\x4d\x6c\x2e\x2c\x92\x31\x16\x4e\xac\x4a\xcf\x1c\xda\x6d\x32\x63\xa8\x5d\x7d\xf4\x10\x3c\xb6\x19\x55\x2f\x88\xab\x55\x00\x33\xb5\x2a\x86\xa8\x12\x1e\x6e\x42\x6b\xd0\xc0\xed\xae\xb9\xf9\x06\xc5\xa0\x36\x24\x81\x56\x6d\x68\x43\xd2\xfe\x38\x2c\xdb\xf8\xf8\x00\x55\xaa\xb0\x3e\x68\x0f\x50\x23\xa9\x99\xf7\x07\x63\x80\x57\xcd\xc8\x2a\x5d\x3c\x37\x8d\x47\xa8\x76\x3a\x21\xaa\x57\xa6\x77\x85\x4a\x81\x99\x7d\xb1\xdb\x86\xcc\xbc\x6b\xe6\xea\xbe\xcb\x7a\x2c\x15\x99\xbb\x32\x73\x0b\x18\x88\xfe\x9e\xb9\x05\x1f\xff\x4e\xe3\x51\x28\x9e\x49\x45\x83\xe6\x29\xc0\x2f\x22\xcd\x3c\xbc\xe9\x68\x27\xd9\xc4\xc7\x9f\xa2\x41\x32\x79\x4b\x89\xae\xea\x55\xcd\x63\x67\x46\x22\x91\xd9\xe9\x92\x28\x71\x16\x71\x28\x68\xe0\xb9\x7b\x86\xe3\xb5\xeb\xc8\xf7\x7b\x39\xee\x46\x3a\x71\x24\xe3\x1d\x92\x2c\xec\x5c\x95\x20\xad\x2e\xec\x48\xb7\xed\x47\xf9\x8a\xc0\xc2\x8e\x24\x5b\xb1\x89\x43\x41\x0a\x95\xe3\x1c\xf4\xab\x3b\x5f\xfc\x09\xb0\x5d\x30\xf6\x03\xe7\xc1\xf7\x22\xd3\x2d\x20\x4e\xd7\x37\x23\x3c\x50\xc7\xb3\xe1\x26\xaa\x60\x7c\xf1\x14\x11\x8b\x83\x75\x66\x2a\x8c\x01\x89\x10\xba\xae\x33\x0d\x9d\xb0\x2b\x1d\x71\xa5\xb9\xe0\x4b\x61\xb4\x90\x2e\xd8\x05\x6f\x1d\xff\xb6\xcd\xc8\x04\x7e\xe0\x8c\x1c\xcf\x74\x01\xdd\xbf\xa6\x73\xd9\x31\x74\xa7\x12\xa3\x24\x6b\x4a\x05\x32\x80\xf6\x6b\xed\xf7\xd7\x3b\x20\x79\xb1\x79\xc2\x78\x40\xed\xca\x6f\x5d\xe5\x40\xce\x2c\x86\xc7\xae\x0f\xb2\xe0\x02\x33\x5b\xc1\xce\x9e\xe8\xcd\x6d\x96\x1b\x69\x53\x89\x2d\x83\x6d\x28\x29\x71\x61\xca\xbc\xac\xc6\xac\xbe\x17\x66\xae\x22\xc0\x67\x03\x32\x8d\x86\x01\xd2\x97\x1f\x18\x09\x71\x06\x19\x01\xef\x17\xf2\x4b\x60\xdc\x86\xfc\x92\x55\x4a\xa4\xe6\x4c\xca\xf1\x0a\xae\x98\x01\x89\x9c\xcb\x07\x0d\x4f\x76\x26\xbe\xfd\xfe\xed\x5b\xb5\x62\xd4\x0b\x85\xc2\xb7\x6f\x6f\x2a\xdf\x7e\x47\x35\x7a\x74\xbd\x7f\x00\x52\x06\x10\xc0\x5b\x18\x84\x2c\x69\xb9\x6f\xec\xcc\xf7\x4f\xc1\x74\xe6\xba\xd4\xfb\xe4\xdb\xa6\xc1\x36\x57\xda\x37\xc4\x8b\x52\x71\xe3\x65\x2a\x8d\xab\xcf\x4a\xa6\x71\xe4\x64\x4a\xa9\x8f\x6c\x16\x05\x68\x0e\xa7\x42\x95\x12\x4a\x0e\x99\xc3\x28\xab\x84\x7c\xe6\x65\xd5\x96\x57\x53\x71\x49\xc9\x16\xd5\x2a\x3a\x21\xc8\xa5\x2a\x59\x81\x90\x05\xae\xac\x90\x15\x78\x25\x11\x38\x2b\x7e\x53\xc1\x36\x8c\x8c\xf9\xdb\xef\x0b\xd3\xb6\x03\xe4\x4f\x29\x67\x86\x74\x88\xca\xae\xb6\xa4\x63\x43\xf9\xdd\xf0\x62\x78\x05\xac\xf8\x3c\xbc\x98\x2c\x10\x17\x0a\x89\xd9\x62\x01\x67\xf2\x46\x76\xcc\x42\xa2\x9c\xf1\x44\x4c\x91\x86\x36\x70\xdf\x9d\x8d\xd6\x6e\x57\x73\xa2\xb5\x37\x15\xd1\x8c\x6a\xa5\x92\x13\xcf\x30\x48\xc4\x72\xdf\x38\x1f\xed\x9d\xa5\xe1\x60\xe8\xce\x1c\xfb\x97\xa9\xa1\xdc\xaf\x7d\xfe\x31\xba\x00\x81\x3f\xe7\x86\x2a\x80\x23\x70\xa9\x00\x1a\xa1\xbe\x6f\x5c\x61\x7f\x0b\xdc\x85\xc0\xd0\x0b\xe5\x2f\x3f\x3e\x98\x35\x2c\x08\x27\x58\xd0\xaf\xde\x9c\x7c\xb6\xb0\x64\x62\x53\x49\x25\xa2\x18\x77\x44\x25\x3b\xde\xf4\x3e\xa6\xa9\x22\x89\xe1\x54\x67\xa3\x98\x07\x4b\xaa\x51\x6d\xf3\x32\xe6\x21\x92\x3d\xb7\x76\x1f\xf3\x20\xc9\xd1\xe7\x69\xab\x1f\xd3\xd4\x30\x64\xdb\x6b\xbc\x8d\x69\x90\xe4\xcd\xa0\x06\xf7\x62\x16\x02\x69\xbe\x0b\xaf\x62\x16\x22\x39\x7d\x13\x3d\xc4\x34\x75\xbd\x50\x1e\x9a\x95\xfd\x20\x66\x41\x82\xf1\xb4\xb2\x3b\x8b\x59\xea\x38\xbf\x9b\xb7\xed\x9b\x98\x05\x4b\xaa\x57\xb5\xf9\x69\xcc\xd2\x40\x92\xda\xb8\xbf\xf5\x2e\xa6\x41\x92\x10\x1c\x5e\x57\x63\x1a\x02\x99\xfa\xe7\xd5\x98\x06\x4b\xaa\x07\x07\x43\x27\xa6\x69\x62\xc9\xec\x36\x38\x8b\x69\xb0\xa4\x66\x5e\x0e\x1a\x31\x0f\x96\x18\xd7\x1f\xa2\x66\xcc\x43\x24\xee\x95\x71\x11\xf3\x6c\xe2\x58\x87\x5f\x86\x89\x8a\x89\xc4\x7a\xef\x37\x63\x1e\x22\xb9\x7b\x38\xad\xc4\x3c\x9b\x44\xe9\x77\x6f\xef\x62\x9e\x16\x96\x8c\x1c\x7b\x37\xe6\x21\x92\xe3\x53\xf3\x2c\xe6\x41\x92\x83\xf7\x00\x84\x31\x0d\x81\xf8\x47\xc3\x1f\x31\x4d\x9b\xd4\xc3\xd6\x7e\x3d\xa6\x21\x92\x9d\xb1\xfd\x2e\xa6\xc1\x92\xda\x4d\x33\xd8\x8f\x79\x90\x64\x3a\x71\xb6\x3e\x27\xf6\x57\x41\x3a\x6d\x5c\x93\x19\x00\x36\xc0\x0a\x2e\xba\xff\x71\xfb\x30\xb1\xc0\x0a\xa1\xbe\xba\xfc\x92\x98\x20\x12\xdd\x5f\x7a\x4e\xa2\x67\x03\x59\xa5\xeb\x7c\xfc\x94\xd8\x8e\x41\xec\x74\x7b\xf2\x09\x26\x4c\x58\x64\xdc\xfc\x38\xdb\x49\x98\x08\xca\x1e\x59\x20\xa1\xc2\xa6\x5a\xbb\x77\xb7\x1b\x09\x17\xb1\xde\x9d\xed\xcb\xb4\x5d\x10\xd1\xdb\x8f\x81\x97\x70\x55\x1f\xb3\x2f\xfd\xa1\x3e\x83\xd9\xc4\x59\xb1\x59\x67\x5a\x69\xb6\xc1\x49\x9a\x8e\xd8\x0a\x24\x16\x9d\x35\xce\xac\x99\x49\x0c\x46\xac\xfb\x6c\x2d\x66\x6b\x43\xd4\x2a\xe3\xd2\x2f\xb2\x81\xe9\xce\xdc\x42\xe4\x8a\x3b\x63\xa3\xdc\x24\xff\xdb\xfc\x6d\x21\xe6\x80\x62\x5a\xb5\x72\x8d\xfe\xef\xb7\x85\x98\xef\x64\x2c\xc0\x41\x5c\x21\x69\x50\xb3\xc9\x26\x21\xa8\x86\x62\x1a\x2d\x3e\x09\x4e\xa1\x31\xa6\x82\x83\x38\xed\xd3\xa0\x3a\x57\x0a\xa1\xce\x28\xa6\xc6\x95\x82\xaf\x68\x0a\xa9\x92\x42\x70\x46\x11\xeb\x90\x2f\x04\x6f\x4a\xb1\x9e\xd8\x04\x66\xd7\xad\x83\x93\x18\x81\xfd\x05\xa3\xfa\x48\xcd\x11\xd7\x87\x77\x72\x0c\x3e\xf3\x00\x83\x02\xf8\x4a\xe9\xd7\x2e\xab\x7b\x7d\x1e\x59\xa1\x48\xa1\x6a\x8c\xd6\xcd\xfe\x98\x43\xb6\x29\x10\xd7\xcf\xf6\xee\x68\xea\x72\xc1\x2d\x1a\x2c\xd4\x51\xed\xd3\xc5\x21\xcf\xb3\x49\x81\x42\x45\x19\xc7\xbb\xe3\x5b\x0e\xd8\x8c\x81\xa8\x8c\x17\x77\x6f\x6f\x2f\xb9\xe0\x06\x0d\xae\x8b\x45\xbc\x99\x46\x1c\xb0\x4e\x81\x35\xa1\x84\xd5\x2f\xd0\xe6\x80\x35\x0a\x24\x95\x57\x9b\x82\x88\x57\x7b\xa2\x75\xae\x88\xa7\x3b\xbb\x37\x82\xf6\x63\x95\xf2\x96\xf8\xd6\xb8\xe4\xf9\x62\xd5\xc7\x7b\xdc\x6f\x8e\x6f\xf7\x53\x40\x38\x46\xf5\x4c\x3a\x22\x5c\xcd\xfd\xda\xf6\x66\x6d\x87\x07\x18\x04\xc0\x57\xf3\xb8\xd1\xdc\xbb\xe3\x71\x15\x82\x13\x2b\xf9\xb8\x5d\x3b\xe6\x80\x6d\x82\xa3\x6d\xf0\xe3\x60\x3e\xe7\x82\x5b\x24\x58\x6c\x87\x1f\x6a\xf0\x0d\x87\xdb\x24\x38\xb1\x2d\x7a\x5b\xd6\x39\x87\x6b\x52\x1c\x29\xde\x14\x46\x7c\x6e\x1a\x24\x58\x6c\x93\xfb\x37\x37\x97\x1c\xae\x4e\x70\x7c\x05\x1f\x6e\x47\xb3\x26\x07\xab\x11\x18\xad\xde\xe3\x43\x3b\xe0\x82\x63\x65\x0b\x85\x9b\x7f\x6a\xd7\x78\x65\x52\x5d\xf2\xaa\x9c\x9c\x9c\x4c\x39\x18\x55\x39\xad\xdc\x6a\x7b\xf7\x4b\xd2\xd5\x91\x57\x4f\x50\xf5\x72\x0b\x18\xa4\x96\xab\xe3\x21\x1d\xda\x19\xa4\xc1\x21\xf9\xea\x3e\xfe\xe4\x1c\xcf\xc4\x08\x15\x2e\x82\xd8\xef\x36\x2f\xdf\x9e\x0a\x31\xda\x5c\x04\x62\x00\x86\xb5\xbd\x07\x05\x5c\x8b\xc3\x89\xad\xfd\xf3\x79\xd4\x16\x22\x6c\x72\x11\x04\x93\xa8\x05\xb3\x1b\x43\x88\xd0\xe4\x23\x10\xa5\xbc\xbf\xb5\xe7\x02\xae\xc1\xe1\xc4\x6e\xe0\xc0\x32\x76\x84\x08\x75\x2e\x82\xd8\x1d\x1c\xbe\xbb\x18\x08\x11\x6a\x5c\x04\x6a\x37\x17\xbb\x07\x17\x02\x4e\xa8\x47\xc1\x80\x6e\xce\xf7\xc4\x22\xf2\xd5\x29\xa8\x64\x5a\x9f\x1c\x0b\xf8\xca\xca\xeb\x75\xd9\xe9\x82\xc4\xf1\x17\x7d\xf8\x8c\x3b\x9e\xf5\xac\x65\x4e\x72\xc6\xdd\x95\x38\xae\xa2\x0f\x9a\xf5\x26\x25\x5e\xa1\xe0\xdc\xf1\x9e\x89\x18\xc8\x7a\x26\x02\x97\xd4\x33\x11\x72\xa0\xf0\x4c\xb8\x7c\xf3\x9e\x09\x57\x48\xb9\x67\xc2\xab\x46\xe1\x99\x70\x0a\xe5\x3c\x13\x5e\xfb\x52\xcf\x84\xaf\x32\xa9\x63\x22\xd4\x33\xe7\x99\xf0\x46\x21\xf5\x4c\x78\x4b\x92\x39\x26\xfd\xaa\x3b\x32\x3f\xc6\x10\x99\x67\xd2\x6f\xef\xec\x1a\xa7\x3c\x42\xee\x9a\x5c\xef\x3e\x5c\x03\x1e\x28\xf7\x4c\x3e\x46\xd5\x1f\x36\x07\xe4\x1c\x93\x7e\x2d\x9c\x1f\x6f\x73\xe1\x2a\xcf\x64\xef\xc2\xbe\xe4\x80\x72\xcf\x64\x7e\xbe\xfb\xd6\xe4\x70\x9c\x63\x72\x7e\x70\xb3\x7d\xcf\x05\x2b\x1c\x13\xa3\xd1\x7f\xcf\x67\x4c\xee\x98\x5c\x7f\x98\x8d\x3e\x70\x38\xde\x2f\x31\x46\xc3\xf9\x17\x2e\x5c\xee\x97\x7c\x3a\x78\xb7\x75\xcc\x6b\x54\xee\x97\x6c\x36\x0d\x5e\x0f\xbc\x5f\x72\x7b\x71\x13\xde\xa6\xe1\x12\xb7\x24\x08\x1e\xb6\x78\x80\xd4\x2d\xe9\x1b\x4d\x67\xef\x0b\x0f\x94\xfb\x25\xb5\xd1\x51\xbd\xcf\x01\x79\xbf\xc4\x39\x67\xcd\x4e\xed\x97\x34\x0e\x6f\xce\x39\x9c\xdc\x2f\xa9\x5d\x6e\x6d\xef\x70\x38\xde\x2f\xb9\x77\x9a\x77\x5c\xb0\xd4\x2f\xd9\x73\xfa\x91\xc5\xc1\xa4\x6e\x49\xbf\xb6\xbd\x53\xbd\xe2\x70\x9c\x5f\x52\xfd\x70\x7c\x31\xe3\x82\x15\x7e\xc9\x6d\x10\x41\x5e\x99\x32\xbf\xa4\xea\xbc\x3b\x3b\xe0\x60\x82\x5f\x32\xda\x3a\x4a\xfa\x88\x5c\xbf\xc4\xf0\xae\x6f\x80\x88\xcc\xf3\x4b\xfa\x35\x6b\x70\x90\xe1\xce\x75\x4c\x8c\xab\x9b\x4f\xd7\x42\x0c\x89\x63\xe2\x36\x0e\xe8\xa2\x4e\x0a\xcb\xf5\x4b\x8c\xbe\x7b\x57\x11\x22\xe4\xf9\x25\x27\xc3\xe8\xf4\x4e\xc0\xcb\xdc\x12\x63\xdb\x79\xf3\x46\xc0\xe5\xbb\x25\x1f\x8e\xee\x0f\x85\x08\xb9\x6e\x49\xed\x73\xeb\xec\x4c\x88\x20\x73\x4b\xaa\xb3\xbb\x5b\x43\xc0\xe5\xba\x25\xb5\x83\x9d\x83\xf7\x62\xe5\xe4\xb8\x25\xd5\x9b\xdd\xe8\x83\x80\x17\xdd\x92\x9c\x05\x5c\xd9\xaa\xa5\xb8\xfe\x98\x59\x4a\xcc\xac\x0a\x4a\xd6\xf7\x32\x2b\x75\xd9\x35\xb7\xcc\xea\x99\x64\x21\x4c\xb6\xa4\x95\x5d\x9b\x12\x96\x98\x84\x25\x13\x21\x90\x75\x4c\x44\x32\xa9\x67\x22\x66\x42\xe1\x9a\x70\x79\xe7\x5d\x13\xae\xa0\x0a\xd7\x84\x53\x8f\xdc\x35\xe1\x95\xca\xba\x26\x7c\x05\x48\x3d\x13\xbe\xd2\xa4\x9e\x09\x5f\xd1\xfc\x92\x09\x67\x14\xf2\x25\x13\xde\x94\x64\x9e\xc9\xe0\xc7\xc9\x59\x10\x23\xa4\x8e\x49\xf5\xb4\x32\x3a\xe7\x11\x8a\x35\x13\xe3\x7e\xee\x35\x78\xa4\xdc\x33\xb9\xaa\x4d\xc0\x0f\x0e\xc8\x7b\x26\xd5\xeb\xfb\xeb\x36\x17\x2e\xf7\x4c\x6c\x2f\x38\x7c\xc7\xe1\x54\x4b\x26\x0f\x1f\xaf\x86\x1c\x90\xf3\x4c\xfa\x86\x3b\xba\xdb\xe3\xc2\xe5\xae\xc9\x70\xbf\x0f\xce\x38\x9c\x6a\xc9\xe4\x7e\xdb\xe0\xd5\xca\xbb\x26\xd5\x60\xf6\xc1\xe0\xc2\xe5\xae\x49\xbf\x36\xbd\xbe\x3b\xe1\x75\x2a\xf7\x4d\x3e\xee\x0c\x23\x0e\x27\xac\x99\x54\xed\x6b\xf7\x28\x05\x64\x9d\x93\xea\x51\xed\xfc\x8c\x07\xc8\x9d\x93\xda\xde\xe6\x55\x9d\x07\xca\x9d\x93\xea\xf1\xfe\x59\x85\x03\x72\xce\x89\x71\x73\x72\xe2\x70\xc1\x52\xe7\xe4\xaa\x75\x78\x7a\xce\xc1\x14\xbe\x49\xff\xfc\xf2\x84\xc3\xf1\xbe\xc9\x87\xa3\xe0\x07\x17\xac\x58\x33\x39\x9e\x9d\xb5\x38\x9c\xdc\x39\xa9\x3e\xec\x83\x3e\x87\xe3\x17\x4d\x76\x02\x87\x4f\x4e\xe1\x9c\x1c\x6e\x02\x41\x99\xd2\x45\x93\xeb\x63\x73\xce\xc1\x78\xe7\xa4\x76\x3c\x1f\x26\x2d\x21\xcf\x39\x09\xf7\x06\xce\x5c\x04\xe6\xfb\x26\x23\x78\x63\x88\x31\x72\x7d\x93\xda\xfd\xf4\xbd\x25\xc4\x90\xf8\x26\xf7\xf6\xce\xec\x4c\x80\xe5\xfb\x26\x87\x67\xb5\x2d\x21\x42\xee\x9a\x89\xf1\xa6\x55\x19\x0b\x11\x64\xce\x49\x6d\x54\x9b\xf6\x05\x5c\xae\x73\x52\x7d\xfb\xe3\x44\x2c\x61\x9e\x73\xf2\x19\x0e\x1e\x3c\x01\x2f\xf1\x4d\x3e\xcf\xdb\xc1\x44\x80\xe5\xb9\x26\xdb\xe7\xfe\x9e\x2d\x56\x8d\xda\x33\xf1\x3f\xef\x5a\x07\x02\x7c\x05\xc7\x24\xd9\x22\x96\xed\x9e\x8a\xfb\xa0\x92\x3d\xcd\xec\xfe\x64\x76\xab\x31\xbb\x69\x98\xd9\xfe\xcb\x6e\xe4\x65\xb6\xe4\x24\x7b\x6b\xd2\x4d\x32\x61\xaf\x8b\xf7\x4d\x84\x40\xce\x37\x11\xc8\xe4\xbe\x89\x90\x09\xb9\x6f\xc2\xe7\x9d\xf5\x4d\xf8\x72\x2a\x5c\x13\x4e\x39\x72\xd7\x84\x57\x29\xbf\x6a\xc2\xe9\x5f\xbe\x9f\xc3\xd7\x9a\x62\xd9\x84\xab\x6b\xde\x3b\xe1\x0c\x43\xe1\x9d\x70\xe6\x24\x5d\x37\xa9\x55\xfb\x73\x18\x43\xe4\xee\x49\x1d\xee\xce\x79\x84\xc2\x3d\xa9\xb6\x6f\xcc\x1b\x1e\xa9\xda\xd2\x39\xdb\xbc\xf2\x39\xa4\xe0\x9f\x6c\x8f\x8e\xb6\xb8\x70\xd5\xca\xc9\xfb\xdb\xca\x01\x07\x54\x39\x28\xd3\xbd\xc3\x07\x0e\xc8\x3b\x28\xd5\x87\xdb\xf0\x9e\x0b\x97\x3b\x28\xe1\xdb\x70\x72\xc5\xe1\xe4\x0e\xca\x27\xa7\xb6\x6f\x72\x38\xce\x3f\xb9\x9c\x8c\x3e\xdf\x72\xc1\x0a\xf7\xa4\xfa\xe1\x70\x77\xc2\xeb\x54\xe6\x9e\x9c\x5c\x9e\x9e\x5b\x1c\x4c\xf4\x4e\xde\x1e\x1d\x5e\xa6\x80\x8c\x77\xb2\x6f\x5f\x3e\x04\x7c\xb8\xc2\x39\xb9\x99\xd7\x06\x3c\x50\xb1\x72\x72\xf0\xf9\x47\x85\x03\xf2\xce\x49\xf3\xc6\xb9\xe0\x82\xe5\x2b\x27\xd5\xa3\x93\xeb\x26\x87\x53\x78\x27\xbe\x11\x42\x0e\xc7\x79\x27\x55\x7b\x72\xff\x81\x0b\x96\x7b\x27\xb5\x69\x30\x6b\x73\x38\x85\x77\xe2\x6e\x7d\xba\xe2\x70\xbc\x77\xf2\x76\xb6\xc3\xab\x5b\xee\x9d\x54\xaf\x2e\xf7\x00\xaf\x4d\xa9\x77\xe2\x5c\x7c\x9c\x73\x30\xce\x3b\x99\x9d\x9c\x5e\x25\xbd\x66\xfe\xca\x49\x74\x79\x37\x10\x91\xb9\xde\x89\x51\xd9\x37\x02\x31\x46\xbe\x77\xf2\xf0\xf9\x54\x4c\x43\xb6\xa5\x53\xdb\xfb\x38\xb9\x13\x70\xf9\x5b\x3a\x93\xfd\x6b\x4f\x88\x90\xeb\x9e\x54\xab\x67\x9f\xb6\x84\x08\xf2\xb5\x93\xd3\xba\x25\xe0\x72\xdd\x13\xe3\xcd\xe5\x75\x24\x44\xc8\x5d\x3b\xa9\x6e\x3d\x3c\xbc\x11\x22\x48\xb7\x74\x76\x47\x77\x8e\x80\xcb\x5f\x3b\x39\xd9\xfb\xf1\x4e\xac\x9e\xbc\x2d\x9d\x1f\xe7\xd7\x62\x51\x33\x2e\x0a\x3d\xc5\x21\xef\x70\x2e\x7a\x3e\x59\xf2\x26\x6a\xe4\x4f\x3b\x2d\xe6\x1d\x15\xfa\x12\x20\x12\xa5\xaf\xd6\x76\xc5\x0f\xab\xa2\x71\xf6\x5b\x2b\xf6\x20\x09\xe6\x23\x6c\xe1\x9b\x6c\xc9\xbb\xe7\x6c\xcc\xd7\xf8\x14\x8f\xd7\x51\xf0\x3a\x1a\xeb\x7c\xc0\xc0\xb7\xef\xa5\x01\x43\xdf\x8f\xa4\x01\x09\x95\xad\xa2\x12\x03\x12\xaa\xe4\x35\x7f\xac\x09\xc5\xbb\xc7\xc2\xe7\xfd\x91\x3f\x55\x7c\x12\x6e\xdb\xb6\xb2\x94\xe2\x21\x01\x44\x33\xc2\x1b\xe5\xd5\x1c\x2e\x5a\xa3\x2f\x63\xce\x0e\xbe\x51\x9e\xbc\x9d\x9d\xd1\x89\xe5\xbb\xf8\x30\x94\xd5\xd0\x18\xc4\x23\x96\xd0\xe7\xe6\xc5\x5e\x2b\x2f\x62\xdd\x2c\xcd\x8b\xcd\x9c\x88\xd2\xa9\x2c\xb2\x55\xfe\x12\xff\xcd\xa2\x72\xf4\x5a\xe0\x4f\x46\x91\x9e\x80\x51\xee\x1b\xee\x17\xf0\x8e\x1e\x58\x62\xf9\x9e\x8d\x4f\x44\xe4\x8d\x58\x85\x61\xed\x59\x85\x61\x4d\x7b\x69\x5a\xf6\x0a\x69\xe5\x60\x24\xb6\x4f\x5f\x40\x95\x1c\xca\x22\x3f\x91\x45\x01\x16\xf4\x21\x87\x70\xea\x90\x43\x38\x6d\x2c\x49\xc8\x5e\x9e\x90\x1a\xc2\xa8\xe2\x97\x97\x33\xcd\x21\x7f\x02\x22\xed\x24\xab\xe4\xf3\x9f\xfb\xf0\xed\x11\x8d\x1a\x46\x81\x33\x65\x32\xde\xf1\xa2\x31\xf0\x87\x20\xba\x9f\xc2\xa2\x6f\xdb\x25\x99\x71\xb6\xd1\x1f\x3c\xcc\xd8\x8d\x2f\x94\x08\x7f\xab\x95\xd2\x90\xb3\x19\x25\x71\x1b\xe8\x0f\x19\x4b\x0a\x96\xef\x7e\xc5\x87\x63\xff\xd1\xd3\xd0\xe0\xa3\x7d\xcf\x1c\x0f\x41\x26\x89\xf8\x43\x26\xfe\xf0\x25\xcb\x77\x67\x13\x6f\x11\x9f\x14\x24\xf0\xe8\xf1\x89\x41\x4f\xe7\x87\xae\xab\xea\x56\xed\x72\xbf\x1a\xdd\x5e\x5d\x81\xf8\xcc\x0b\x45\xff\x9f\x0b\x4b\xcd\x20\x17\x96\x56\xfa\x6a\x89\xe6\xc3\x52\x23\x5f\x25\x51\x1e\xa3\x1c\xe9\x96\xc1\x68\xa2\xcb\x60\x8a\x44\x15\x23\xf5\x32\x98\x22\x51\xe9\x11\x52\xc4\x26\xf3\xec\x39\x53\x4b\xf4\xdb\xc4\xfc\x38\xe3\xb5\xe3\xc8\x22\xd0\xbe\x64\x49\x5b\x7b\x2d\x56\xe8\xda\xa9\xc8\x54\x03\x5b\xe8\x4f\x5e\x3b\x38\x1a\xbd\xab\xc6\x9f\xbd\xe6\x35\x84\x1c\x1c\xd7\x12\x72\x70\x5c\x53\x58\x25\xdd\x25\x38\xae\x31\x2c\x4f\x57\x00\xe5\x35\x87\x7c\x5c\x6a\x9a\xf9\x38\x55\xba\xea\x16\x91\x8f\x53\xa5\x2b\xab\x78\xfa\xcd\xf3\xf2\x36\xc1\x52\xad\xdc\x28\xd6\x8c\x24\x8d\xb1\x7a\xb3\xe0\xab\x76\xfd\x74\xa4\xfa\xa9\xc0\xb6\xd5\xcc\x6b\x18\xfb\xd1\x7e\x03\x7f\x9c\x9d\xd7\x2a\x54\x20\xae\x49\xa8\x40\x5c\x7b\x58\x9a\x5c\x1e\x88\x6b\x09\x4b\x92\x63\x11\x79\x6d\x20\x07\x94\x1a\x62\x0e\x48\x9a\x9c\xda\xf4\x73\x40\xd2\xe4\xa4\x95\x4a\x3e\xba\x5f\x6e\xf4\x09\xcf\xca\x16\xbf\x4e\x8c\x2c\x7c\x75\x5b\x67\x2a\x6f\xcd\x14\x64\x0a\xb1\xea\xb0\x36\xac\xa9\xad\xfc\x43\x70\x7a\xd8\x8a\xcf\x02\x50\xdb\x79\x1e\x8c\xb1\xf4\x3c\x18\x63\xeb\x2b\x25\x9a\x0f\x63\xec\x7d\x85\x44\x79\x8c\xda\xe6\x97\xc0\x62\x33\x5c\x02\x53\x24\xaa\xb2\xfc\x25\x30\x45\xa2\x52\x37\x88\x9c\xff\xb0\xcc\xfa\x39\xa6\x15\xed\x7f\xdd\x38\xb2\x08\xab\xb6\x01\xa1\x42\xd7\x4e\x45\xaa\x1a\x73\x58\xb5\x2c\x75\x3b\xd8\x9e\x6f\x8f\x3e\xd2\xc3\x29\xd4\xcd\x20\x07\xc5\xb4\x82\x1c\x14\xd3\x08\x56\x49\x31\x17\xc5\x34\x81\xe5\x29\x72\x10\x75\x03\xc8\x47\xc5\xa6\x98\x8f\x92\xa7\xa8\xb2\xfe\x7c\x94\x3c\x45\x69\x05\x93\x33\x48\x96\xd9\x3e\x4b\xb4\xa2\xe9\xaf\x19\x45\x82\x5f\xd5\xf0\xf9\x6a\x5c\x37\x0d\xa9\xf7\x3f\xb0\x2c\x0b\x9f\x69\xe4\xbf\xbb\xfb\x41\xb9\x98\x43\x45\xe3\xb3\x31\xc0\x1d\xfd\x08\x39\xfd\x56\xb1\x52\xae\x18\xbf\xc5\xbb\xda\xa1\x15\x40\x48\x6e\x1c\x29\xa6\x2b\xb6\x9b\xcd\x4d\xb2\xbf\xad\x60\x57\xae\xeb\xe2\xcf\x1e\x93\xb4\xef\xe3\xe3\x3a\xc0\x24\x4c\x4e\xeb\xa0\x5f\x97\x23\x11\xca\xd9\xd8\xc1\xcb\xcf\xa1\x15\xf8\xae\x3b\x30\x03\xf9\x81\xa3\xca\x82\xbe\xe6\x56\xeb\xb2\x07\xb9\xac\x10\x4b\x5c\xbd\x59\x25\x06\xbf\x68\xb5\x4a\x0c\x7e\x0d\x6b\xad\x5c\xd9\x6b\xe7\x6a\xe5\x18\xcc\x82\x97\xfc\xb4\x32\x05\x49\xee\xda\x60\x9e\xde\x97\xae\x9f\xb1\x4b\xbb\x39\xa5\x58\xba\x98\xf8\x3c\x9e\xb4\xb6\x9e\xc7\x93\xd6\xe1\x2f\x2a\xd7\x33\x79\xd2\xfa\x66\x79\x1e\xb9\x03\x46\x9e\x55\x7b\xcc\xc9\x31\xcf\xa9\xbc\xe7\xd0\x30\x75\xf7\x1c\x1a\xa6\xea\x7e\x49\xa1\x9e\x47\xc3\x54\x1c\x73\x06\x0f\x77\x68\xcc\x13\x2a\x2e\x1e\xa6\x52\xca\xfc\xfe\x29\x3f\x73\xcf\xa5\x91\xe5\xe6\xe9\xaa\xe2\x69\x84\x5b\x87\x2a\x0b\xf6\x14\x7e\xf9\x01\x5b\xc2\x60\xd2\x95\x9c\x44\x9b\x39\x2f\x52\xdc\x1a\x24\x27\x7f\x93\xbb\xa7\xfc\x99\x35\xc6\xeb\x7a\xbe\xd7\x99\x98\x9e\x33\x9d\xb9\xe4\x16\x3e\x75\x08\x7f\x6c\x32\x7b\xea\x9e\x33\x31\x47\xf4\x14\xb1\xcc\x80\xc9\x6c\xfb\x4a\x4e\xc4\x4a\xce\xd2\x6c\x4e\xef\x0a\x46\x95\x3f\x3f\x2a\xef\x78\x70\xe5\x91\xdf\xb3\x10\x06\x80\x9c\x03\x4f\x72\x84\xcf\xc4\x97\x48\xc3\xac\x50\x14\xb0\xd5\x42\xce\x92\xd3\x59\x09\x5d\x16\xcd\x04\x08\xcb\xa6\xb2\x70\x78\xb0\xbd\x03\x54\x8c\xca\x70\x9e\x98\x83\xfd\x05\x67\xc9\xb3\x19\x4b\x1c\x52\xb5\x3a\xf8\xec\x30\xe7\xf6\x4b\x8f\xce\xcf\x96\x5a\x5d\xd0\xf4\xea\x2e\x85\xd1\x49\x6e\x31\x71\xbc\x10\x46\x85\x4a\xa1\x36\xbd\x2b\xa0\xe2\xb3\x17\x17\x94\x8d\x6a\xa3\xd4\x5d\x07\xbc\xe0\xf3\xf6\xe9\x78\x62\x81\xf8\x92\x24\x36\xdf\xcc\x75\x4a\xf1\xf5\x36\xa9\xa8\xc0\x36\x72\xda\x98\x3c\x1f\x35\x59\xd7\x9f\xa3\xda\x9a\x9a\x96\x13\xdd\x77\xca\xcd\x46\x77\xe8\xb8\xf8\xac\x27\x77\x3a\x36\x8b\x34\xa0\xd7\x6c\x94\x54\x17\xb6\x88\xb7\xb4\xe0\x03\x21\x95\x39\x96\xe4\x8d\xc5\x3f\xd2\x26\x0e\xe0\x2d\xf4\xa2\x30\xae\xb0\x4d\x50\x87\x36\x0a\x07\xf4\x3a\xa8\xc7\x65\x97\x33\x74\xd3\xab\x3c\xf0\x2a\x11\x9e\x23\x64\x68\x12\x53\xca\x84\x28\x4d\x4a\x32\x05\x69\xa2\x3f\x42\x82\x2d\x0b\xfd\x91\xa6\x49\xf6\x1d\xd7\xa7\x34\x6d\xf4\x47\x4a\x99\x98\xb1\xac\x1c\xdc\x96\x8b\xe5\xf6\xbd\x6b\xe0\x4f\xa1\xf7\xba\xdc\xaf\x7e\xf0\xae\x76\xf1\x35\x2b\xb6\x3f\xf7\x40\xe4\x8f\x46\x2e\x5c\x5b\xdb\x4f\xcb\x6c\xd2\xae\x97\x64\x39\xc6\x3d\x29\xe3\xea\x44\xc4\x1e\x74\x59\x2e\x28\xee\x69\xb9\x50\x26\xa2\xe8\x75\x97\x64\x46\x80\x3f\x29\x4f\x6b\xd8\xb7\x5d\x47\x7f\x56\xb7\xef\xbf\xda\x18\xa5\x1d\xb1\x2c\x27\x62\xe7\xa3\xb6\x86\xb4\x37\xa2\x18\x69\x17\xba\x86\x79\x65\x92\x56\x56\x2d\x93\x34\xc1\xac\x98\x74\x9e\xad\x70\x49\x2f\x35\xae\x34\x21\x1e\xba\x5a\x46\x78\x43\x7a\x46\x5f\x5c\x28\xf7\x8d\xcf\x9f\x6b\x0f\x60\x60\xda\x23\xb8\xec\x90\xe5\xda\xa2\xdc\x37\x2e\x06\xc7\x07\x98\x62\x8d\x93\x99\x85\xcc\x54\x61\xd3\x36\xeb\x32\xb2\x44\xc3\x99\x10\x69\xdb\x91\xa7\x49\x8e\x70\x16\xd2\x34\xaa\xd5\x41\xbd\x22\x4d\x93\x1b\x1b\xd6\xa0\xac\x56\xea\xf6\xa6\xbc\x18\x49\x73\x94\x95\x63\xed\xe6\xb8\xa6\xce\x9f\x96\xd9\xa4\x5d\x2d\xc9\xf2\x3a\x63\x83\x42\xd7\xb2\x44\xc4\xb1\x61\x59\x2e\xd6\xe8\x87\xd7\xb0\x32\xc5\xd8\xb0\x24\x33\x4f\x18\x1b\x9e\x65\xdf\xb8\x2a\x57\xb7\xef\xbf\xda\x18\x55\x63\x83\x2c\x39\xf9\xd8\x90\x41\xae\x36\x36\xac\x61\x5e\xca\xb1\x21\x2f\xe9\x9c\x2e\x79\xbd\x9e\x2b\x77\x6c\xc8\xc9\xc1\x0a\x63\xc3\x12\x43\x7a\x76\x8f\x2c\x1f\x21\x62\x12\xd5\x0b\xaa\xc7\x95\xeb\x08\xb3\x08\x77\x2a\xc8\x0d\xba\x61\x0d\x5a\x0d\x4b\xc8\x52\xdd\x32\x61\xdd\x92\x91\xa5\xaa\x16\x43\xd6\x68\x44\xf5\x7a\xdb\xae\x8b\x8d\xa8\xda\x68\x34\xab\x0d\x69\x9a\x2b\x0c\x12\x52\xca\x5a\xbb\x55\xaf\xb5\xa5\x94\x69\xbb\x94\x94\x63\xfd\x76\xb9\x9e\xce\x9f\x96\xd9\xb4\x81\xe5\x67\x79\xad\x41\x42\xae\x6b\x59\x22\x99\x41\x62\x49\x2e\xd6\xe9\x90\x57\xb7\x32\xd5\x20\x91\x9f\x99\xa7\x0c\x12\xcf\xb0\x6f\x52\x95\xab\xdb\xf7\x5f\x6d\x8c\xca\x41\x42\x92\x9c\x62\x90\x10\x91\x2b\x0e\x12\xab\x9b\x97\x7a\x90\xc8\x49\x3a\xaf\x6f\x5e\xab\xe7\xca\x1f\x24\xd4\x39\x58\x65\x90\xc8\x37\xa4\x67\xf7\xc8\xf2\x41\x22\x26\x51\x0c\x12\xd5\xc6\xfd\xbc\x8a\x59\xd8\xfb\x70\x14\x23\xc4\xc0\xaa\xd8\x50\xcc\x4f\x73\xd0\xb2\xcd\x0c\x53\xa2\x64\x5e\xbc\x4e\xdb\x31\x06\x15\xbb\x21\x3a\x58\x83\xa6\xdd\x6a\x64\x53\x5b\x61\x60\x90\xf2\x55\x9b\x6d\x73\x60\x65\xf9\xd2\xfb\x12\xc5\xec\xaf\xdd\x0a\xd7\xd1\xf0\x13\xf2\x98\x34\xa4\xbc\x9c\xae\x33\x12\xc8\x34\x9b\xa1\x17\xc7\x80\xdc\xc4\xd7\xe8\x6c\x57\x32\x22\x45\xd7\x9f\x97\x87\x27\xf4\xfb\x4f\x36\x5c\x52\x5f\x2b\x1a\xee\x5f\x67\x68\xaa\xbe\x3e\x93\x90\xbc\xa3\xe7\x61\xab\xf5\xf2\x2b\x99\x8e\xb2\x7f\x57\xa6\x98\xd3\xa7\xae\xda\xe9\xe4\x76\xeb\xaa\x84\x57\xe8\xd3\xf3\x8c\xe4\x79\x1d\xa8\xa2\x37\xa7\x0c\xf2\xde\x1c\x44\xa3\xb7\x47\x98\x41\xb8\x7c\x4c\x6e\xa8\xc3\x8a\x69\xd7\xc5\xec\x40\x68\x56\x6b\x4d\x09\x57\xac\xdc\x4c\xc0\x1a\x4d\x03\x5a\xed\x4d\x43\x5c\x15\x6b\xb7\x1a\xc3\x8a\x2d\x4b\x71\x85\x5e\x5d\xca\x68\x37\x5a\x0d\xa3\x2a\x63\x8c\xdb\x93\xac\x10\xeb\x36\xb8\x35\xb5\xfd\xa4\x9c\xc6\x6d\x68\x49\x7e\xd7\xe8\xdf\x15\x5a\x96\x24\x21\xf4\xf1\xcb\xb2\xb0\x7a\xd7\xba\xba\x69\xc9\x7b\xfa\x25\x39\x59\xbf\xaf\x7f\x8e\x49\x93\x1a\x5c\xd9\xa4\xff\x5a\x03\x54\xf4\xf9\xb2\xc4\xa4\xbd\x7e\x06\xb8\x52\xbf\xbf\xba\x49\xa9\xfa\xfe\xbc\x74\xd5\xbd\xef\x5a\x5d\x54\xde\x08\x90\x93\xfc\xf2\x31\x60\x89\xf1\x3c\xb7\xdb\x55\x6c\x0e\x50\x0e\xd5\xd2\xcf\xf0\xe8\x72\x40\xb6\x18\xb8\xcb\x21\x15\x26\xdc\x6e\xd4\xea\x99\x9e\xa9\x5e\x1b\xd6\x4c\x09\x57\x3a\x7d\x12\x02\xd6\x68\x34\x56\xbb\x56\xa9\x8a\x2e\xd2\x66\xd3\xb0\x8c\xb6\x2c\xc5\x15\xc6\x01\x29\xa3\x69\x55\xdb\x64\xa6\x2d\x32\xa6\x13\xed\x6c\x21\xd6\x9f\x67\xaf\xa5\xed\x27\xe5\x34\x9d\x2e\xe7\xe6\x77\xad\x15\x1f\xa9\x96\x25\x49\x64\xd6\x7b\xf2\xb3\xb0\xce\xd2\xca\xaa\xa6\xa5\x5a\xec\xc9\xcd\xc9\x53\xd6\x7a\x9e\x6e\xd2\xa4\x06\x57\x36\xe9\xbf\xd6\x00\x95\xeb\x3c\xd9\xc4\x14\xcb\x3c\x02\x70\xc5\x55\x9e\x55\x4d\x4a\xbd\xc6\xa3\x4e\x37\x6f\x65\x65\x8d\x2e\x2a\x7f\x81\x47\x99\xfc\x2a\xeb\x3b\xb9\xc6\xf3\xdc\x6e\x57\x3e\x0e\xc4\x1c\xaa\xd5\x9d\xeb\xc6\xed\x27\x4c\xe2\x3a\xde\xb5\xb0\x6f\x20\x79\x9b\x91\x7f\xc3\xaf\x92\x61\xd0\x45\x01\x33\x7f\xe5\xe4\x99\xef\x9d\xf9\xe0\x65\xaf\x6c\x09\xf9\xce\x3b\xd6\x66\xe5\x97\xb3\x96\x97\x25\x99\xaf\xf2\xe2\x64\x9a\x28\x2b\xf9\x23\x57\x7d\xec\x71\x3b\x6b\xd1\x27\x17\x49\xd7\x1a\xd5\x4d\x2b\xf3\xf6\xde\xcc\xb3\x61\xe0\x3a\x9e\x64\xc4\xcf\x4b\x72\xc5\x59\xfb\x2a\xd9\x5c\x71\x3a\x9e\x53\xb4\xe4\x30\xa1\xec\x5b\x89\xf5\xab\xd6\xcd\x3e\x89\x36\x42\x29\xb7\xb7\xef\xcf\xf0\x23\x3e\x24\x06\xb8\xa3\xd7\xdc\x8b\x72\xec\x75\xa8\xe9\xed\xf5\xc9\x35\xfa\xc2\xeb\xab\xf4\x24\x25\xc1\xb6\x9b\xe4\x5d\xcb\xca\x91\x33\x25\x6b\xa8\x13\x94\x30\xdc\x3d\xda\x66\x12\x0e\x27\xd2\x84\x1b\x28\x5d\xe1\xda\xd5\x6a\x26\xdd\x86\x90\x62\x8d\x1c\xef\x71\xbd\x7d\xbb\x8b\x93\xb8\xc3\x1d\xce\x9b\xea\xf9\x1d\x93\xe2\x5d\x28\x2f\x2a\x79\x47\xf2\x29\x09\xd6\x26\x8e\x45\xfa\x11\xfc\x4e\xb3\x70\xd1\x2a\x77\x92\xa3\x00\x7d\x99\x8d\xcc\xdc\x25\xdc\x98\xde\x2d\x1c\x6f\x3a\x8b\xbe\x46\xf7\x53\xd8\xd3\xc2\xd9\x60\xe2\x44\xda\xf7\x4c\x24\x9d\x45\x05\x30\x84\x4b\x41\x83\x59\x14\xf9\x9e\x04\x25\x1c\x8a\x7d\x1f\x1c\x1e\xa5\xba\xd3\xcb\xf0\xe3\x87\xfb\x0f\x8c\x32\xe3\x37\xb1\x25\x17\xb7\x49\x5f\xf7\x96\xbe\xb9\x9d\x4d\x88\xad\x21\x75\xa2\x7c\x3d\x66\x32\xc0\x9f\xaf\xa9\x4e\x20\x6e\x8e\xab\x24\x93\x36\xdd\x1c\x3e\xda\x9b\xad\xc4\x97\xf4\x7c\x39\x7c\x71\x0f\xbf\x12\x61\x3a\x1c\xa8\x19\xc5\xa1\x63\x15\x62\xe1\xd5\xe5\x07\xe0\x78\x36\xbc\xeb\x54\xb3\xba\x65\xdf\x07\x7e\xc9\x55\xe4\x12\x64\xc6\xda\x24\x78\x41\xb2\x94\x3f\x8b\x17\x24\xc2\xbd\x9f\xb8\x41\x1b\xe7\x9f\x9b\x64\xdf\x27\xf2\x7d\x77\x60\x06\x8f\x92\x4b\xc4\x33\xa0\x02\x9f\x17\x59\x78\xb6\x84\x52\x94\x3b\x9a\x7e\x01\xb8\xb9\xd2\x3c\x72\xa6\x9c\x89\xf2\x7a\x49\xc2\xaf\x57\x4a\xf8\xb5\x2c\x61\xee\x54\x40\x7a\x78\x92\xda\x50\x3d\x3f\x2a\xb2\x9f\x3c\x95\x88\x24\xfd\x46\x84\x08\x14\x4e\x76\xe9\x51\xe2\x1b\xe5\x35\x33\xe6\xdb\x2a\xfe\xa8\xbe\x95\xe3\x3d\x2d\x7f\xf4\x48\x29\xfc\x41\x50\x92\x59\xe6\x70\x3a\x21\x64\x49\x76\xd2\xd4\xb3\xfa\x93\x36\x63\x69\xe6\xb2\x71\x85\xdc\x22\xd5\x48\x33\xcb\x05\x48\xf3\x2a\xb6\x98\x25\x1d\xab\x20\x59\xc1\x2a\xb8\x8e\x7c\x15\x23\x10\x93\xc8\xab\x52\x85\xb2\xe5\xaa\x5d\x8f\x57\x5a\x13\xbf\xd2\x4a\xc4\xfc\xe4\xd9\x8a\xb2\x6d\xfc\x1a\x3b\x28\xa8\x0c\x4f\x35\xe0\x30\xd3\x6a\x55\xdc\xf4\x03\x98\xfc\x36\xf2\x52\x15\x9f\xbb\x0d\xb6\x95\xb9\x0c\xb6\xa5\xe8\xb1\x58\x67\x78\x35\x6e\xec\x0b\x0a\x37\xcd\x56\x65\xec\xab\x94\xfa\x5f\xf1\x59\xcf\xfa\xd9\xca\x4c\x09\x57\x9f\xf5\x25\x15\x55\x28\x5f\x0e\x3f\x9f\x5e\x01\xcb\x0c\x60\x94\xe9\x94\x59\xb5\xf3\x48\x6a\x86\xf4\xb0\x70\x5a\xac\x4a\x57\x76\x84\x5f\x65\x51\xbe\x3b\x39\xac\x7f\xc6\xd9\xc7\x66\xb9\x22\x6d\xa5\x40\x89\x17\x2b\xf9\x3b\xb9\x5e\xd1\xca\x7e\x71\x16\xfb\x5a\xf6\x49\x24\x71\x8e\x99\xb3\xf8\x94\x47\xbc\xe6\x66\x3e\x2f\xa9\x94\x7c\xb5\xf2\xbf\x5c\xdb\x05\x97\x79\x70\xab\x67\x76\xe5\xf4\x56\xf6\xe8\xd0\x14\x0a\x39\x74\x5d\xc1\x0e\x57\x72\xa3\x97\x0f\x5a\xd9\x81\x6a\x35\x87\x3f\x67\x48\x79\x54\x8d\x0e\xf5\xe9\x9d\xaa\xab\x66\x82\x72\x47\x1c\x59\xf7\xbf\x62\x96\xf3\x46\x1d\x65\x96\xd5\x63\x4b\x5e\x76\xb3\xa5\x11\xca\xba\x8e\xfd\x3f\xdb\xf3\x58\x23\xa9\x27\x79\x20\xbf\x8e\xff\xe9\x9e\xc8\xda\x76\xf1\x2b\x7d\x92\x35\xac\x66\x51\x1e\x3d\x1c\xb6\x2d\x26\x4f\x3f\x66\x61\xe4\x0c\x1d\x68\x3f\x72\x27\x97\xb2\x7d\x27\xf9\x6a\xdd\x35\xef\xfd\x59\xd4\x19\x3a\x77\xd0\x66\xd6\x82\x5d\x73\x1a\xc2\x4e\x08\xa7\x66\x60\x46\x30\x87\x9f\x1f\x16\xf2\x60\x72\x4f\x59\x71\xba\x6a\x9c\xd1\xdf\xf2\x93\xce\x99\x33\x73\x8b\x35\xeb\x71\x34\xcc\x77\xed\x79\x6a\x30\x13\xe8\xcd\x98\xb3\xf3\xbf\xda\x66\x64\x52\x2b\x8a\x17\x8b\x42\xed\x3b\xab\x88\x02\xb7\xe6\x64\xda\x8e\xaf\x7d\xd7\xd5\xf1\x72\x86\xa6\x35\x99\xe4\xf1\xac\x31\xb4\xae\x07\xfe\xdd\x2f\xc9\x44\x4a\x96\x2e\x2b\x99\x83\xd0\x77\x67\x11\xec\x5a\xae\x33\xed\x04\xd0\x8a\x8a\x15\xbd\x40\xff\x5f\xea\xca\x3f\x2d\x36\x5a\x17\xb5\x43\xe0\x99\xb7\xe2\xa1\x39\x5d\xce\xbb\xac\x74\x5d\x27\x8c\xe8\x99\x3d\x62\xd4\xd7\xae\x93\xb3\xba\x86\x3d\x07\x01\xfe\xda\x5c\x16\xa1\xcb\x2f\xf0\xd2\x05\x0d\x8e\x22\xdd\x58\xe2\xc5\x64\xc1\x59\xb6\xca\x2c\x7b\x21\x07\x8a\x45\x11\xb7\x87\x5e\x9b\xcc\xe2\xf5\x52\xac\x34\x53\x12\xd8\x4a\xab\xe2\xb9\x0b\xfd\xdd\xec\x87\xec\x6c\xee\x0a\xec\xa6\xa1\xa9\x2b\x43\xb2\x19\x16\xc2\x55\x3b\x57\x10\x8a\xaf\x2f\x90\x2d\x25\x3e\x13\xfd\xda\x8f\xf9\x18\xa2\xdf\xc0\x76\x6e\x1d\x1b\x06\x8f\xf1\x5a\x75\xe2\xf7\x74\xda\xd8\x97\x8e\x4f\x86\x8a\x4f\x8b\x92\x24\xd9\x40\x7f\x32\x86\xf0\xda\x99\x8c\x1e\x53\x27\x34\xde\xe8\xb1\x9c\xab\x63\x9c\x70\x64\x0e\x42\xe1\xe0\x8f\xcc\xb1\x52\x3c\x1a\x59\x74\xba\x86\x20\x1c\x01\x42\x97\xe0\x32\x31\x5e\x9b\x71\x1b\xa2\x17\xc6\x2c\x3b\x44\x43\x71\x56\x47\xe6\x84\x8d\x02\xfa\xaf\x52\xa8\xc8\x13\x8d\x0f\xf2\x16\xde\x24\x81\x05\xf2\x97\xa2\x78\xc2\xa9\xcb\xc8\x40\x96\x62\xd2\xa5\xe5\xa5\x48\xce\xbc\x1b\x8d\x46\xee\x29\x05\x42\x6d\x08\xe3\xbe\xd2\xf0\xe9\x87\xb3\x99\xd2\x95\xfb\x35\xef\xe0\xfd\x1b\xfc\x9c\x8e\xc0\xcc\x98\x2b\x9e\x01\xb3\x12\x41\x6a\x12\x52\xfb\x52\xc5\x79\x6d\x3e\x66\x4f\x87\xe1\xed\xa9\x21\x31\x27\x39\x1d\x3e\x03\xe7\x62\x27\x19\x14\x15\xa3\x24\x9a\x56\xe0\xd3\xde\xd2\xe1\x52\x7d\xdb\xfb\xaa\x65\xcf\xf5\x0e\x56\x57\x86\x78\x34\xdb\x13\xe2\xd2\x63\x8e\xb2\xed\x64\x65\x2d\x2e\x31\xfd\xd5\xa2\x29\x5a\xc3\x8a\x91\x69\xaf\x2a\x3d\xe4\xee\xd9\x95\xf5\xda\xcc\xed\xee\xd6\xe8\x61\xfe\x2f\xd1\x20\xdf\x57\xe0\xf7\x1e\x16\xe5\x7e\xcd\x69\x5c\x5f\x62\x9a\xa9\xe3\xba\x42\xa7\x2e\x0d\x4f\x15\xc7\x59\x55\x16\xf8\xd2\x75\xf8\x5b\x80\x14\x38\x89\xa6\x96\x83\xd2\x33\x7f\x96\x43\x57\xf9\x86\x26\x1e\x96\x6b\xd3\xe1\xc5\x17\x4c\x17\x46\xa6\x75\x2d\xe9\xd3\x64\x08\xa6\xa8\xf4\xca\x14\x71\xb9\x62\x49\x7f\x2b\x07\x64\xd3\x7e\x5e\xff\xf9\x2f\xeb\x2f\x9f\xd4\x3f\xae\xd3\x1f\x1a\xad\xf0\xe4\x20\x69\x0d\x8c\x4a\xb3\xe3\x96\x0a\xba\x72\x7f\xa9\x24\x90\x18\xee\xca\x58\xc6\xa5\x5c\x35\xc6\x13\xbb\xc3\xfc\xf2\x3f\xb9\x0f\xfc\x4f\xd4\x8a\xb4\x8b\x7b\x3b\x1a\x1c\xef\x20\x1a\x60\xf9\x5e\x04\xbd\x08\x71\xdc\x18\x17\x6f\xb1\x6c\x6a\x7a\x30\xb1\x4b\xd2\xca\xa4\x11\xd8\x5d\x73\x71\xd6\xc6\x77\xcd\x8a\x06\x24\x2e\x67\x3e\x65\xed\xc4\x33\x1a\x87\x1e\x98\x9a\x23\xc7\xc3\x13\x20\xf9\x61\x7c\xc2\xa4\x94\xce\x1f\xaa\x15\x76\x31\x9e\x35\x70\x91\x95\x6d\xa9\x84\x58\x8a\x41\x35\x2b\x13\x87\x53\x33\xff\x3d\x8e\xec\xa9\x7a\x8a\x19\x80\x74\xba\xb7\xc2\x27\xfd\x0a\x97\x39\xfb\x8a\x80\x24\xfb\xdc\x75\x59\xf2\x12\x72\x10\x5c\x5a\xae\xaf\xcf\x5f\xf9\xcc\x59\x00\x96\xe7\x87\x39\x9a\x51\x91\x1d\x06\x81\x73\xb3\xda\xc2\xac\x6c\x75\x5a\x51\xcf\x71\xab\x54\xd5\x76\x5e\xb8\x19\xbf\x1b\xa3\x8c\x4c\x1a\x70\xf2\x26\x4a\x97\x7f\xc1\x6f\x95\x39\x35\x9e\xbe\x65\xf8\xb3\xfd\xd0\x32\x08\xca\xce\x72\x94\x5a\x1d\x12\xba\x55\xb1\x6a\x35\xc9\x48\x79\x95\xd5\xba\xeb\x9f\x69\x44\x85\xe2\x2c\x51\x96\x3a\xbf\x2a\xa3\xd6\x51\x16\x97\x57\x78\x09\x3a\x47\x03\xc2\xca\xd0\x4a\xa0\xd5\x53\x97\xac\x34\xad\x72\x48\x15\xea\x53\x64\xeb\x4b\x07\x8d\xfd\xfa\x88\x49\x10\xb8\x23\xda\x5d\x2a\x42\x48\x8f\xf9\xa4\x97\x35\x55\xa9\x89\xdd\xd8\x0a\x28\x49\xdf\xc1\x76\x50\x4d\x75\xdf\x85\x5f\x11\x55\xa4\xc0\x77\x5f\xcb\x41\xcb\x7a\xb0\x66\x4e\x0f\x46\x5f\x55\x0d\xae\xaa\x37\x6c\x1a\xe1\x84\xaa\x5f\x15\xc4\xe9\x7f\xc5\x77\x56\x95\x09\x89\x9a\x5f\x05\xb6\x44\xf5\x35\xb5\xea\x6b\x39\x45\x16\x74\xbf\x02\x6a\x99\xf2\x6b\x39\xca\xc7\x39\x19\xdc\xf6\x0f\x7d\xec\x53\xb9\x8f\xd9\x5b\x4c\x57\x1d\xad\x73\x17\xfa\x64\xaf\xb1\x57\x0a\x28\xb2\x21\xbe\x44\x51\xe1\x5f\xb8\x50\xa3\x90\x0f\x37\xbf\x3d\xf0\x48\xce\x01\xbe\x01\x33\x69\x8f\x74\xea\x64\x4c\xe6\x2d\x1a\x3e\x86\x26\x0a\x7a\xcc\x2c\xbf\x77\x55\xde\xb4\xa4\x44\x32\x05\x2e\xa9\xe5\x6c\x16\x64\xb3\x37\xf9\x26\x22\xd1\xb7\xe3\x8d\x61\xe0\xe0\xd7\x0f\x8d\xea\xc9\x09\x25\x8b\x9c\x28\xbd\x10\x00\x5f\x0c\x2a\x3f\xd0\x99\x34\x86\x66\x72\xed\x6d\x0e\x1b\x71\xfb\x33\xd2\x70\x62\xba\xae\x34\xa4\xdc\x37\xee\x6e\x76\x03\xa0\x46\xe0\x10\x05\x2f\x17\x3b\xd9\x8c\x60\xf2\x77\x13\x0d\x1a\x34\xc6\xd0\xf7\x23\x18\xc8\x2a\x4f\x71\xa5\x9e\xfa\xc2\xda\xbc\x3d\xf0\x5a\xee\x1e\xb8\xd8\x5a\x50\x09\x6e\x0f\x3f\x5c\x02\xbc\x85\x44\x5f\xbb\x10\xc2\x9b\xd5\x73\x87\x96\x21\xde\xf7\x94\x44\xcb\x5e\xec\x90\x9f\x4c\xa1\x1c\x8c\xbf\x1c\x7c\x64\x24\xc0\x89\xe0\xe4\x89\xa9\x2b\xd8\xf8\x77\x78\x0c\xc9\x4c\x64\x69\x3e\xd9\x0e\x53\x91\x0a\x7f\xde\xff\x93\xf2\xbf\x66\x2a\xdc\x6d\xba\x4f\x6e\xd9\x4b\x0a\x9e\x76\xd0\xaa\x1c\xb1\x6f\x23\x3c\xad\xd8\xeb\xa4\x21\xae\xb1\xfc\xd2\x56\x20\xe9\xe4\x5e\x3e\xd7\xfa\x9e\xfd\xce\x82\x2a\x57\xcf\x4b\x3e\x79\x13\x2e\x43\xf4\x52\xda\x63\xc9\xa2\x72\xca\x63\xae\x2b\x11\xed\x60\x85\x1b\x4e\x56\x33\x9d\xdc\x2b\x64\x54\xb9\x29\xd0\x3b\xaf\x9f\x90\x2b\x55\xd4\xa5\xb9\x2b\x88\xb7\xb8\x93\x37\x40\x1b\xd9\x37\x40\x1b\x19\x2b\x64\x68\xf2\xfa\x14\x79\xf6\x39\xcf\x4e\x45\xa5\x34\xc3\xb5\xfa\x0a\x05\xf9\xd2\x7b\xc0\x7f\x61\x41\xd6\x4d\x4b\x49\x83\x9c\xae\x7f\x55\x96\x97\xa4\xf5\xa8\xae\x80\x95\xdd\xdf\xa7\x2b\xac\x20\x5e\x5c\xf3\xaf\xab\xab\xfc\xa4\x9f\xaa\xce\xbf\xb2\x40\xcf\x4a\xfa\xe9\x6a\x1a\xff\xfb\x6a\x28\x2f\xe9\xa7\xab\xe9\xaf\x2b\xd0\x7a\x49\xe7\x34\xbd\x5f\xd8\xbc\xd4\xfe\xd2\x5f\xdd\xba\x94\x29\x3f\xc7\xc2\xff\xa2\xe2\x3c\x27\xe5\xe7\xd8\xf7\xbf\xab\x76\x72\x52\x7e\x8e\x75\xff\xbb\x6a\x67\x2c\x71\xd9\xd7\x1c\xb6\xd6\xce\x3a\xb3\x96\xa4\x20\x5a\x71\x79\x69\xa5\xc9\x82\x3c\x05\xaa\x1a\x56\x10\xfc\x15\x25\x59\x2f\x1d\x15\x07\xf2\xed\xff\x15\x79\xcd\x4d\x67\xb5\xb5\x47\x45\x95\xfd\x8a\x4a\x79\x9a\x8b\xf0\xec\x3a\x5a\xdd\x3d\x58\x59\x95\x7f\x5d\x49\x9e\x91\xec\x13\x95\xf3\x14\xa7\xe0\x17\xd4\xc9\xaa\x1e\xce\x1a\xca\xf9\xab\x4a\xb2\x4e\xb2\xf9\x8d\xec\x57\xb5\xa2\x7f\x7d\x47\xb7\xb2\x1f\xb0\x96\x31\xff\xeb\x3b\xc1\x27\x95\x63\xa9\x29\xff\x3b\xea\x63\x45\x47\x66\x2d\x43\xfe\x77\xd4\x87\xcc\x83\x59\x6d\x10\xca\x6c\xe7\xbc\xcc\x5b\xe9\xca\x82\x15\x85\x52\x2b\xf3\x65\x96\x65\x35\x3d\x49\x22\x3e\x2a\xf7\x1b\xd4\x9d\xc4\x2a\xfe\xa0\x3a\xf7\xab\xf8\xfa\xdc\x52\x77\x66\xd5\x56\x72\xbb\xe8\xaa\x8b\x7d\xcb\xee\x47\x5e\x9e\x92\xfa\x4e\xe4\x67\xe4\x60\x65\xd2\xb5\x2e\x57\x7e\x56\x86\x56\x24\x5d\xeb\x96\xe6\x67\x65\x68\x45\xd2\xb5\xae\x7b\xfe\x35\x55\x96\x4f\xba\xd6\xbd\xd1\xbf\xa6\xca\x9e\x90\x21\xf9\x05\xd4\xbf\xaa\xca\x72\x6f\xb5\x5e\xb3\xcd\xad\x3b\x38\x3c\x93\x73\x9d\x1b\xb1\x7f\x55\x83\x5b\x37\x3b\x8a\x9b\xb5\x7f\x55\x73\x5b\x37\x3b\x8a\x1b\xba\x7f\x55\x63\x7b\x72\x65\xfd\xc2\xec\xac\xc6\xb9\xfa\x8d\xe1\xbf\xae\xa1\x65\xbd\x98\xe4\x16\xf2\x35\xea\x8e\x1f\x9e\x7f\x51\xe5\x2d\x23\xcd\xbd\x7c\xfc\x97\x66\x68\x45\xd2\xd5\x0b\x23\xfa\x3b\xbf\x44\x43\x59\xd2\xd5\x0b\xf3\xdc\x0c\xc9\x49\xb3\x5f\x3c\xac\x93\x41\xee\xe2\xf7\x5f\x94\xbf\x7c\xce\xd5\xef\xa1\xff\x35\x0d\xf0\x29\xd9\x51\xdc\xf5\xff\xcb\xb5\xb3\xa2\x35\xc9\x4a\xf2\xdc\xec\x48\x39\x97\xda\x92\x34\x8d\xc4\x57\x17\xdf\x1a\x5b\x94\xfb\xd5\x8b\xd6\xe5\x29\x9d\xdb\xc8\x5e\x4f\xaa\x56\xc8\x27\x89\x22\xac\x90\xf3\x2a\xa1\xe2\xab\xc6\x5c\x8a\x97\x32\xbe\xf8\x74\x4e\x69\x64\xe9\x1b\x7f\x92\xaf\x62\x57\x8b\xa9\x7e\x87\x25\x3b\x6d\x7c\x3e\x67\xe6\x9d\xb0\x9c\x19\xa5\x34\xb5\xdc\xb7\x4f\x94\xe5\xce\xc6\x52\x64\xb1\xa0\x9e\xf4\x2a\xbf\x3d\xb7\x9d\xb7\x26\xc5\x27\xb7\x3c\x67\xbf\x02\x90\xe1\xe4\x6f\x15\x2d\xb9\x1e\x9e\x7b\xf3\xef\x69\x29\xac\x5e\xe7\xec\xf6\xcc\xd3\xd2\x52\x5c\x7e\x40\x4b\xa1\xb8\x1c\x59\xce\xbe\x72\x2d\xe6\x14\x84\xff\x0e\x8b\x94\xc5\xd8\x6f\x54\x23\x8a\x4d\xee\x62\x95\x7c\x94\x20\x87\xe6\x57\xe2\xda\x5f\x3e\xac\x93\xc8\xb3\xea\x71\xfd\xe4\x9e\x76\x85\xa9\x94\xfd\xd7\x57\x65\x5a\x9c\xc9\xee\xa8\x4a\xe1\xc9\xa5\x89\x7c\x6b\x69\xc2\xb6\xd5\x94\x43\x97\x34\x49\x6b\xb3\x59\xb3\x65\xf7\x6f\x0c\x87\x15\xbb\x25\xb6\xca\xb5\xd3\x79\x5e\xc3\x5c\x3b\x39\xc5\x81\xf4\xb4\x2c\x59\xab\xc5\xa5\x57\x26\xf0\x17\x34\xcf\xb8\x44\xd5\xe0\xd3\x36\x1d\x22\xe9\xcd\x68\x9c\xa2\x07\x16\x6c\x0d\x0d\x09\x6e\x49\x6d\x1a\x9b\x95\x96\xfc\x36\x15\x68\x0f\xc5\xe6\xb9\x66\x22\xcf\xaa\xca\x35\xd3\x52\x5d\x2c\x40\x4a\x21\xb9\xa2\x10\x95\x5b\xce\xfe\xeb\x2b\x31\x29\x8b\xe1\x3f\x5c\x7f\xa2\xf0\xe4\xb6\x23\x4e\xc5\x43\x13\x0e\xf0\xfd\xfa\x12\x68\x7e\x55\xb6\xcc\xa6\x5d\x1b\xc8\x3a\x21\x6b\xd8\x82\xb5\xee\x73\xd3\x79\x56\x6d\xae\x9f\x9c\x62\xd0\xa4\x65\xc9\x14\x92\x94\x5e\x99\xc0\xaf\xaf\xd3\xa4\x44\x35\xfb\x4b\x15\xc4\x03\x35\xbd\xb8\x84\x53\x35\xc2\xd9\x86\x14\x99\x5f\xa3\x66\xbb\x5e\xaf\x57\x65\x35\x5a\xb5\x61\xe6\x06\xbe\xb5\x93\x79\x56\x85\xae\x9d\x9a\xa2\x3e\x69\x49\xb2\x17\xd1\xe0\xb2\xab\xf8\x7f\x7d\x75\x26\xe5\xa9\x7e\x6a\x5c\x58\xc0\x72\xa1\x19\x0c\x9d\xbb\xce\x00\x0e\xfd\x00\x1f\xbd\xcb\xcb\xcd\x61\x44\x4e\xfe\xd8\xf9\xe2\x4f\x80\xed\x82\xb1\x1f\x38\x0f\xbe\x17\x99\x6e\xc1\xb6\x99\x68\xf2\x70\x1a\xfd\xdd\xf0\x62\x78\x85\xbf\x64\x37\x1d\x0f\x06\x49\x34\x51\x1e\xa7\x66\x9c\x8f\xf6\xce\x52\x39\x18\xba\x33\x87\x49\x4c\x1e\x1c\x47\xae\x7d\xfe\x31\xba\x00\x81\x3f\x4f\x23\xa4\xa2\x34\x05\xe1\xcc\x72\x96\x5d\x0c\xa2\x91\xd6\x38\xc9\x30\x26\x5b\x23\x4a\x92\xb3\xf8\xc8\x2c\x26\x47\x89\x28\xa9\x0e\xa1\xb2\x99\x8a\x10\x43\x70\x94\x47\x7a\x8a\x40\x47\x2b\x68\xfc\xf9\x81\x59\x53\x58\x52\xe5\x8a\x2a\xcd\xaf\xba\x6c\xdd\xa8\x2b\x62\x7d\x6d\x67\x55\xa7\xd4\x13\xd5\x06\x2a\x6b\x67\xe0\x47\x63\xd4\x93\xc2\xe1\xa7\x06\x20\x27\x96\x48\xaf\xdd\x60\xbf\xac\xc7\xc7\x8f\x70\xe7\x75\xe0\x63\x48\xca\x7d\x63\x67\xbe\x7f\x0a\xa6\x33\xd7\x25\x72\x7a\x66\x0a\xfe\x5d\x78\xe1\x4c\xa6\x7e\x10\x99\xf8\xd6\x97\xda\xe7\xcf\x97\x1f\x08\x12\x51\x32\x47\xdd\x70\xb8\x89\x05\xbf\x7c\x04\x63\xc7\xe6\x8f\x86\x10\xb8\xb6\x6f\x8e\xef\x40\x38\xf6\xe7\x7c\x9e\x79\x54\xf5\xcb\xe8\xc7\x67\xe0\x78\xb7\x4e\xe8\x0c\x5c\xf8\x88\xff\x75\x5c\x27\xba\xa7\x47\xb8\xa1\xfc\xdf\xb7\x2e\x27\x00\x9f\x76\x80\xd3\x1c\xfa\x5e\xd4\xa9\xbc\xaa\x14\xcc\x6e\xf6\x60\x2f\x0c\xe3\x0e\x72\xce\x3b\xff\x2e\xdd\x46\xed\x1b\x8d\xc9\xc4\x05\x24\x51\x75\xb1\xc0\xfb\x87\x0f\x3f\x80\x39\x1c\x3a\x77\xe9\x21\x0e\xf8\xcc\xcd\xc5\xff\x01\x93\x10\xdc\x3a\x70\x8e\xc0\xf4\xec\x1a\x1b\xde\x3a\x16\x24\xdf\xa3\x20\x9d\x1c\xff\x98\xdc\x01\x5a\x56\x7c\x71\xcb\xed\xdb\x91\x05\x13\x09\xbe\x3c\xa6\x76\x73\xfe\xa5\x9d\x88\x26\xb6\x5e\x8e\xae\x5b\x93\xcb\x44\xe2\x8e\x72\xb4\x6e\xec\x8c\xcd\x6b\x26\x05\x7a\x2b\x4a\xb9\x5f\xfd\xf8\x1e\x9c\xb3\x01\xe4\xe8\x0a\x64\x9a\xc1\x8f\xf9\x97\x6c\x48\x1a\xd3\x7a\x37\xfd\xc1\x64\x31\x09\x30\xea\x47\x5b\x77\x6c\x40\x4a\xb9\xbf\xb5\x0d\xb3\x21\x69\xcc\xc1\x66\xe5\x23\x53\xc4\x34\xad\xb7\x1f\x83\xcf\x6c\x40\x4a\x79\xb8\xfd\x51\x12\x12\xc7\xbc\x7f\xd8\x1e\x1d\x30\x2a\x4a\x93\x7a\x63\x37\xaa\x6c\x40\xc2\x58\x1d\x6f\x4f\x8e\xb2\x21\x42\x3b\x13\x55\x9c\x9c\x24\x93\x9c\x15\xb8\xd9\xdc\xa4\xa7\xfa\x08\xb5\xab\xb6\x7b\xdc\xbd\xe5\x44\xc0\xe1\x5c\x84\x60\x19\x1a\xf5\x5c\x5c\x8c\xb1\xc4\xdc\x22\x7b\x29\x8d\x05\x5d\x97\xe5\xc9\x2b\xb0\xdc\xd8\xd4\xc5\xce\xe3\x52\xd8\xa7\x70\xd2\xca\xca\x39\xcb\xb3\x69\xe9\xb1\x30\x72\x66\xe1\xb8\xa0\x82\xe9\xd9\x6c\x7a\xed\xb6\x81\xd3\x13\x5b\xf1\xb2\x9a\x57\xe2\x65\x15\x9f\x0f\x96\xd4\x7b\xa6\x4f\x89\xec\x65\x24\xea\x5a\x5f\x51\x03\x8a\x5e\x62\x15\x5b\x58\x35\x05\x45\x77\xb3\x92\x85\xac\x9c\x46\x5e\xc7\xf5\x14\xbb\x69\xb7\xab\x92\xf4\x0c\xa3\xdd\xa6\x7d\x86\xd0\xd9\x2f\xef\x33\x54\x11\xe4\x7d\x46\x2e\x5a\xda\x67\x88\xa3\x0f\xee\x33\xf2\x69\x56\xb0\x9e\x65\x7a\x90\x8f\x08\x6b\x99\xcf\xb2\x24\x14\x63\xcb\x7a\xf6\xb3\xb4\x1c\x79\xc3\xd4\x53\x0c\xc8\xa8\x56\x2a\x98\x5a\xe9\x04\x28\x2c\x45\x89\x97\x19\x4a\x3e\x58\x62\x27\x62\x04\x64\x26\x4b\x48\x56\xb0\x92\xa4\xb0\xf2\xe1\x7c\x2d\x73\x48\xb8\x54\x2e\xc0\x7a\x15\x9f\xd2\xad\xe6\x37\x2c\xaf\x62\xc9\xa8\x75\xe3\x7e\x7c\x4f\x5d\x50\x76\x64\x16\x7d\x90\x27\xf4\x6b\x9f\xac\xe3\x56\xcc\xcc\xf4\xfe\x2b\x30\x2f\xb1\xf8\xf3\xfd\x83\xeb\xdd\x98\x99\xe9\x19\x56\x60\x66\x54\x3a\xff\x6c\xee\xc4\x24\x39\xfe\x2d\x72\xa1\xdf\xec\xfc\x18\x24\xda\x9f\x06\x8e\x17\x2d\xf3\xd5\x08\x28\x3f\xaa\xb2\xbb\xcd\x89\x23\xef\x71\x97\x45\x90\x76\xba\xd9\x48\xa4\xdf\x5d\x4a\x96\x69\x54\xe5\x7e\x6d\x64\x5f\x5a\x7c\xa4\xd5\x7c\xda\x44\x4f\x4b\x09\xb2\x56\x5d\xee\x57\xf7\xb6\xdf\x0f\x85\x58\x42\x43\x5b\x92\xee\x0a\x0c\x92\xa6\x5a\xee\x57\xfd\xfb\x0b\x31\xbf\xeb\xf8\xf2\x49\xfa\xab\xf2\x2c\x6b\xdb\x94\xf0\xee\x87\x65\xbc\x8b\xad\x3a\xdf\x52\x17\xbf\xeb\x9a\xf6\xbd\xa4\x47\x65\xd7\xb7\x4c\x37\xec\x3d\x7a\xe6\xed\xc0\x0c\x3a\xda\xdd\x03\x30\xb7\x00\x79\xd2\xf4\x41\xe4\x75\xb4\xf4\x3c\x7f\x4d\xc7\x57\x00\x75\x34\xe6\x3e\x20\x4d\x27\x77\x06\x75\x34\xf6\x06\x21\x4d\x77\xcd\x01\x74\x51\xe4\xd1\xf5\xbb\x33\x80\x9f\x34\x9d\xbc\x73\xae\x31\xef\x3a\x6b\xba\xc6\xbf\x0b\xa1\xa1\x38\x92\x77\x24\x68\xe4\x2d\xfa\xa8\x44\x69\xce\x64\xc4\xbc\x0d\x81\xe8\xaa\x0f\xa7\x51\x08\x04\xb9\xee\x4c\x46\xa7\xe9\xfb\xf1\x2a\x14\xa1\x43\xd3\x79\x92\x35\xa3\x6e\xcc\x2f\x01\x2b\xc4\x44\xe4\xb7\x3c\x1c\x53\x44\xe3\xd9\x64\xe0\x99\x8e\x8b\xcb\x37\x39\xbe\xd8\x02\xbc\x18\xd1\x9c\xc7\x4f\x2a\x0c\xa6\xb2\x9c\xc0\x72\x71\xc1\x8c\xe9\xbb\x77\x13\xc0\xc8\x10\xc9\x36\xfe\x29\x0d\xd5\xc2\x00\xf8\x9e\x7b\x8f\x95\x32\xb5\xc1\x17\x10\x0b\xf4\x30\x38\xf2\xdc\xfb\xac\x3c\x8e\x42\x6e\x83\xc6\x35\x86\x40\xfe\xe9\x7c\x06\xb2\x41\x94\xe6\x6d\x2c\xc8\x83\x8e\x8d\x8e\xf6\xd6\xda\xd9\x6a\x83\xb1\xa1\xe9\xe3\x2a\xc2\x9e\x46\xbb\x2d\x30\xae\x6a\xfa\xb8\x86\x1e\xf7\x1e\xda\x57\x60\x5c\xd3\xf4\x71\x1d\x3d\x56\x3e\xed\x56\xc0\xb8\xae\xe9\xe3\x06\x52\x90\x33\xaa\x7f\x06\xe3\x86\xa6\x8f\x9b\xa8\xb4\xfb\x97\x03\x07\x8c\x9b\x9a\x8e\x4f\x1d\x42\x92\xf4\x0c\x22\x4d\x77\xa1\x69\x77\xb4\xad\x73\xff\x60\x02\xd0\x6f\x4d\x9f\x98\xc1\x35\x42\x6d\x7f\x1a\xba\x00\x3d\x20\x53\x84\x77\x11\x5e\x8a\xc2\xda\xb5\x77\xc7\xef\x40\x2a\xd2\xd1\xcf\x03\x38\x8c\x64\x61\x24\x2a\x5e\xe3\xc2\x71\x6b\xe0\xf4\x00\x30\x32\x1c\xf9\x14\x2f\x93\xc9\x42\x49\x74\xb2\xf2\x86\xe3\x57\x4f\xae\xaf\x01\x2b\xc4\x04\xdb\xf8\xb7\x3c\x9c\x50\x90\xa3\x59\x49\x0d\xef\x6f\xbd\xf7\x01\x27\xc5\x24\xef\xc9\x83\x02\x41\x68\x3c\x7f\x1e\x98\x53\xcc\xb2\xd3\xaa\x79\x80\x15\x62\x92\x8f\xf8\xb7\x3c\x9c\xaa\xd1\x9f\xc3\xc0\x32\x43\x6c\x30\xb5\xe9\xfe\xc1\x25\x10\xe4\x44\xa1\xf1\xa3\x12\x45\xe8\x66\xd3\x69\x42\xb7\xbb\xf7\xce\x7e\x0b\x04\x31\x66\xfb\x14\x3f\xaa\x40\x54\xd1\xe6\xd4\x89\x4c\xd7\x79\x40\x6c\x1f\x3f\x1c\xfb\x0f\x40\x94\x13\x7d\x27\xcf\x4a\x18\x21\x9c\xcc\x22\xdc\x3f\x9c\x1f\x1c\x3a\x14\x44\x44\x98\xe6\x10\xfd\x94\x05\x92\xc8\xf4\x0d\x01\xad\xa3\x4d\xc0\x45\x64\x00\x4e\x88\x09\x8e\xc9\x83\x1c\x40\x48\xe8\x96\x34\x36\x9e\xd1\xfb\x93\x1d\xc0\x49\x31\xcb\x19\x79\x50\x20\x08\x8d\xe3\x0d\x7d\x5c\x61\xde\x87\x77\xef\x41\x2a\xc2\x04\xfb\xde\xd0\x97\x85\x91\xa8\x74\xef\x0d\xe7\xe0\xa0\x75\x6c\x01\x4e\x8a\x09\x2e\xc9\x83\x02\x41\x68\xc8\x96\x0f\x36\xbd\x13\x78\x73\x07\x58\x21\x26\xd9\x21\x77\xe5\x4b\xc3\xb5\xc1\x88\x51\xe7\xc5\xbb\x56\xf3\x0c\x30\x22\x7d\x30\x4a\x54\x99\x0d\x44\x91\x53\x35\xbe\x1d\x57\xda\x26\x60\x44\xfa\x60\x94\x68\x30\x1b\x88\x22\x27\xca\xdb\x01\x0d\x1f\xc4\x02\x7d\x30\x8a\x15\xc7\xcb\x51\x14\x46\x69\xd5\xda\xd5\x47\x0f\x30\x32\x7d\x30\x4a\x15\x96\x0d\x45\xd1\x53\x65\x19\xa3\xe6\xe6\x1b\x90\x8a\xf4\xc1\x28\x51\x54\x26\x4c\x9b\x9a\x23\x88\xf7\xe9\x48\xe4\x9a\x79\x7f\x30\x06\xac\x50\x47\x0f\x7b\xf8\xb7\x3c\x5c\xc3\xef\xb3\xcd\x3c\x7c\xbf\x0e\x1e\x1a\xab\xd1\xa0\x79\x0a\x78\xb1\x8e\x1e\x3f\xd1\x27\x15\x86\x50\x11\x2f\x07\x13\x99\xc7\xce\x0c\xb0\x42\x4c\xb3\x8f\x7f\xcb\xc3\x35\x6e\x73\x86\xf4\x5b\x99\x3d\x1b\x4d\xb7\xdd\xbd\xe4\x49\x85\x71\x3c\x27\x72\x4c\xd7\x09\x27\xa8\xe0\xed\xf7\xd7\x3b\x20\x15\x21\xad\x23\x37\xec\x66\xe6\x47\x10\x04\xf0\x16\x06\xb4\x83\x3b\xba\xde\x3f\x00\x92\x30\x3d\x95\x9d\x12\x51\x2e\x5a\x4b\xf7\x4d\x70\xad\x0a\x7b\x29\x9a\x8e\x7e\x27\xe3\x48\x26\x34\xdd\x85\xd2\x84\x6d\x29\x4d\xd7\x84\xad\x28\xcc\x2f\xdb\xa3\x62\x68\xde\xe2\x3d\x2b\x25\x2e\xf0\xe7\xa8\x34\xf1\x56\x16\x4e\xc3\x05\x77\x21\x30\x10\x79\x7d\xdf\xb8\xc2\xbb\x20\x44\xa2\x5b\xbe\xfb\x39\x34\x24\x01\x38\x56\x38\xc1\xb1\xbe\xfc\xf8\x60\xd6\x40\x22\x40\x91\xce\x26\x46\x56\x8e\xe3\x4c\x6c\x92\x52\xf5\xe6\xe4\xb3\x05\x12\x09\x8a\x74\x68\x1b\x92\x00\x1c\xcb\x1d\xd1\x58\x95\x88\x52\x62\x09\x8a\x75\x30\x32\x24\x01\x71\xa9\xaa\xc4\xb0\xbc\xe9\x3d\x48\x24\xa4\x54\x55\x49\x40\x5c\x2a\x1c\xcb\x70\xaa\xb3\x11\x48\x24\xa4\x58\x55\x49\x40\x5c\x2e\x92\x56\x54\xdb\xbc\x04\x89\x84\x94\xab\x2a\x09\x88\xcb\x45\x62\xed\xb9\xb5\x7b\x90\x48\x48\xb9\xaa\x92\x80\xb8\x5c\x35\xad\xa3\x1d\x7d\x9e\xb6\xfa\x20\x11\x90\x62\xd5\xb2\xf2\xb8\x54\x35\x9c\xd2\xb6\xd7\x78\x0b\x12\x09\x29\x55\x4d\x12\x10\x97\x0a\xc5\x7a\x33\xa8\xc1\x3d\x90\x08\x48\xa1\x6a\x59\x79\x5c\x26\x92\x52\xf3\x5d\x78\x05\x12\x09\x29\x53\x4d\x12\x10\x97\xa9\x8e\x63\x9d\xbe\x89\x1e\x40\x22\x21\x85\xaa\x4b\x02\xe2\x52\xa1\x58\x43\xb3\xb2\x1f\x80\x44\x40\x0a\x55\xcf\xca\xe3\x32\xa1\x38\xe3\x69\x65\x77\x06\x12\x01\x29\x53\x3d\x2b\x8f\xcb\x84\x73\x67\x6c\xde\xb6\x6f\x40\x22\x21\x65\xaa\x4b\x02\xe2\x32\x35\x70\x99\xae\x6a\xf3\x53\x90\x48\x48\x99\x1a\x92\x80\xb8\x4c\x38\x56\x6d\xdc\xdf\x7a\x07\x12\x09\x29\x54\x43\x12\x10\x97\x0a\xc5\x0a\xc1\xe1\x75\x15\x24\x02\x52\xaa\x46\x56\x1e\x97\x8a\xa4\x34\xf5\xcf\xab\x20\x91\x90\x52\x35\x24\x01\x71\xa9\x9a\xb8\x54\x07\x07\x43\x07\x24\x12\x52\xaa\xa6\x24\x20\x2e\x15\x89\x35\xbb\x0d\xce\x40\x22\x21\xa5\x6a\x4a\x02\xe2\x52\x35\xc9\x68\x77\x39\x68\x80\x44\x42\x8a\xd5\x94\x04\xc4\xe5\xc2\xb1\x8c\xeb\x0f\x51\x13\x24\x12\x52\xae\xa6\x24\x20\x2e\xd7\x26\x8e\xe5\x5e\x19\x17\x20\x91\x90\x72\x6d\x4a\x02\xe2\x72\xe1\x58\xb5\xc3\x2f\xc3\xa4\x53\xd8\xa4\xe5\xda\x94\x04\xc4\xe5\x22\xb1\xac\xf7\x7e\x13\x24\x12\x52\xae\x4d\x49\x40\x5c\x2e\x12\xeb\xee\xe1\xb4\x02\x12\x09\x29\xd7\xa6\x24\x20\x2e\x57\x8b\xf4\x31\x77\x6f\xef\x40\x22\x21\xe5\x6a\x49\x02\xe2\x72\x91\x58\x23\xc7\xde\x05\x89\x84\x94\xab\x25\x09\x88\xcb\x45\x62\x1d\x9f\x9a\x67\x20\x91\x90\x72\xb5\x24\x01\x71\xb9\x50\xac\x83\xf7\x00\x84\x20\x11\x90\x62\xb5\xb2\xf2\xb8\x54\x6d\x32\xaf\x3d\x1a\xfe\x00\x89\x84\x94\xaa\x2d\x09\x88\x4b\x45\x62\x35\xb7\xf6\xeb\x20\x91\x90\x52\xb5\x25\x01\x71\xa9\x48\xac\x9d\xb1\xfd\x0e\x24\x12\x52\xaa\xb6\x24\x20\x2e\x55\x9b\x2c\x8d\x34\x83\x7d\x90\x48\x48\xb1\xda\x92\x80\x64\x24\xae\x68\x1d\x6d\x3a\x71\xb6\x3e\x83\x54\x42\x87\xe2\x8a\x24\x24\x19\x8b\x51\xbc\xb0\x71\x4d\xde\x63\xa0\x12\x3a\x1a\x57\x24\x21\xc9\x78\x5c\xc1\x66\xef\x7f\xdc\x3e\x04\xa9\x88\x8e\xc8\x15\x59\x50\x32\x26\x57\x88\x5a\xae\x2e\xbf\x80\x54\x44\x47\xe5\x8a\x2c\x28\x29\x23\x1a\xce\xef\x2f\x3d\x27\xe9\x28\x8c\xc4\xdd\x30\x24\x21\x49\x19\x51\x3c\xd7\xf9\xf8\x29\x19\xaa\x8c\xc4\xe3\x30\x24\x21\x49\x19\x89\xfb\xb0\x3d\xf9\x04\x41\x2a\xa2\x65\x34\x64\x41\x49\x19\x71\x4c\xe3\xe6\xc7\xd9\x0e\x48\x45\xb4\x8c\x86\x2c\x28\x29\x23\x19\xda\xed\x91\x05\x40\x2a\xa2\x85\xac\xca\x82\x92\x52\xe2\x98\xb5\x7b\x77\xbb\x01\x52\x11\x2d\x66\x55\x16\x94\x94\x93\x3a\x3c\xdb\x97\xa9\x0f\x15\x7b\x21\x24\x4d\x31\x28\x29\x27\x89\xf9\xf6\x63\xe0\x81\x54\x44\xcb\x59\x95\x05\xc5\xe5\xc4\xde\x2c\x8e\x3e\xbb\x6e\x1d\x9c\x00\x41\x4c\x0a\x7c\x3c\x73\x5d\x44\x23\x87\xf0\x54\x48\xe3\xde\xc9\x31\xf8\x0c\x04\x31\x43\x65\xa8\x20\x3c\x15\x36\xd0\xda\x65\x75\xaf\x0f\x04\x39\xc3\x55\x51\x62\x38\x32\xdc\x9a\x8d\xd6\xcd\xfe\x18\xf0\xe2\x94\xaa\xad\x42\x70\x44\xa8\xb3\xdb\xde\x1d\x4d\x5d\xc0\x4b\x53\x9e\x96\x02\xc0\xd1\x90\xb1\xe0\xd3\xc5\x21\x9f\xda\x26\xc3\xb3\xa9\x42\x70\x44\x64\xb0\x3c\xde\x1d\xdf\x02\x5e\x9c\x12\x35\x55\x08\x8e\x08\x79\x13\x17\x77\x6f\x6f\x2f\x01\x2f\x4d\x79\x1a\x0a\x00\x47\x53\x27\x95\x76\x33\x8d\x00\x2f\x4e\x79\xea\x2a\x04\x47\x54\x23\x0b\x73\x5f\xa0\x0d\x78\x71\x4a\x54\x53\x21\x38\x22\xd2\x1e\xa7\x20\xe2\x4d\x97\x35\xee\xaa\x0a\xc1\x1b\xa4\xd6\xd1\x4e\x77\x76\x6f\x04\xbb\x65\xad\x51\x01\xe0\x68\x48\xbf\xfb\xd6\xb8\xe4\x53\x63\xad\xba\xa2\x42\xa4\x44\xe1\x98\xb6\x7a\xe3\xcd\xf1\xed\x3e\x10\xe4\x31\x55\x38\xc6\xcd\x5f\x8e\xe1\xc9\x70\x57\x59\xdb\xde\xac\xed\x00\x41\xce\x90\x19\x4a\x0c\x4f\x86\xca\x38\x6e\x34\xf7\xee\x80\x20\x66\xb8\x2a\x2a\x08\x47\x45\x1a\xee\x71\xbb\x76\x0c\x78\x71\xca\xd4\x56\x21\x38\x22\xe2\xdb\x7c\x1c\xcc\xe7\x80\x17\xa7\x44\x2d\x15\x82\x23\x22\x8e\xe6\x87\x1a\x7c\x03\x78\x71\x4a\xb4\xa9\x42\x70\x44\xc4\xa7\xf6\xb6\xac\x73\xc0\x8b\x53\xa2\xa6\x0a\xc1\x11\xd1\x89\x00\x8c\x78\x0d\x34\x18\xa2\x86\x0a\xc1\x11\x91\x59\xdc\xfe\xcd\xcd\x25\xe0\xc5\x29\x51\x5d\x85\xe0\x88\x50\xe3\x3d\xdc\x8e\x66\x4d\xc0\x4b\x53\x9e\x9a\x02\xc0\xd1\x90\xa6\x7b\x7c\x68\x07\x80\x17\xa7\x3c\x55\x15\x82\x37\x48\x5c\x67\xf3\x4f\xed\x1a\x6f\x6d\xac\x3d\xaa\x10\x1c\x11\x71\xb7\x26\x27\x27\x53\xc0\x8b\x53\xa2\x8a\x0a\x11\x13\xf9\xc3\x61\x08\xa3\x78\xd0\x6e\xef\x7e\x49\x5c\xdd\x34\x84\xd0\x1d\xe1\x67\x32\x82\x2b\x70\x22\x29\xf1\x95\xc6\x43\xea\x75\x73\x21\x1c\xa9\x91\x83\x13\x49\x51\xa9\x8f\x3f\x39\xc7\x33\x11\x5b\xe1\x39\x2b\x6a\x98\x40\x49\x3d\xf9\xcb\xb7\xa7\x40\x0c\x60\x19\xdb\x6a\x94\x40\x88\x9b\xb7\x61\x6d\xef\x41\x20\x06\xb0\x84\x2d\x35\x4a\x20\x24\x23\xf4\xe7\xf3\xa8\x0d\xc4\x00\x96\x70\x53\x8d\x12\x08\xc9\x64\x38\x98\xdd\x18\x40\x0c\x60\x09\x9b\x6a\x94\x40\x48\xd6\x27\xde\xdf\xda\x73\x20\x06\xb0\x84\x0d\x35\x4a\x20\x24\x63\xf7\x81\x65\xec\x00\x31\x80\x25\xac\xab\x51\x02\x21\x19\xc3\x0f\xdf\x5d\x0c\x80\x18\xc0\x12\xd6\xd4\x28\x81\x90\x74\x08\x17\xbb\x07\x17\x40\x0c\x60\x09\xab\x6a\x94\x68\xda\x64\x82\x70\xbe\x27\xaa\x5b\x68\x2d\x6a\x94\x40\x48\x9c\xd6\x69\x7d\x72\x0c\xc4\x00\x96\xb0\xa2\x46\xc5\x13\x89\xd4\x35\xef\x57\xdd\x91\xf9\x11\x08\x72\x32\xa5\x88\x7d\x73\x05\x86\x27\xc3\xa5\x6d\xef\xec\x1a\xa7\x40\x90\x33\x64\x86\x12\xc3\x93\xa1\x92\x5e\xef\x3e\x5c\x03\x20\x88\x19\xae\x8a\x0a\xc2\x51\xa1\xce\xe0\x63\x54\xfd\x61\x03\x5e\x9a\x12\xb5\x15\x00\x8e\x06\x77\x01\xb5\x70\x7e\xbc\x0d\x78\x71\xca\xd3\x52\x21\x38\x22\xd2\xf4\xf7\x2e\xec\x4b\xc0\x8b\x53\xa2\x4d\x15\x82\x23\x42\x4d\x7e\x7e\xbe\xfb\xd6\x04\xbc\x34\xe5\x69\x2a\x00\x1c\x4d\x03\x6f\x75\xde\x6c\xdf\x03\x5e\x9a\xd2\x34\x14\x00\x8e\x86\xac\x82\x36\xfa\xef\xf9\xd2\xd7\x19\x9e\xba\x0a\xc1\x11\xa1\x66\x7d\xfd\x61\x36\xfa\x00\x78\x69\xca\x53\x53\x00\x38\x1a\xe2\xbe\x8e\x86\xf3\x2f\x80\x17\xa7\x3c\x55\x15\x82\x37\x45\xad\xa3\x7d\x3a\x78\xb7\x75\xcc\x9b\x19\x6b\x88\x0a\x00\x47\x43\x1c\xf3\xcd\xa6\xc1\x57\x2a\x6b\xd0\x15\x15\x22\x25\x8a\x1d\xf3\xdb\x8b\x9b\xf0\x16\x08\xe2\x98\x89\xf8\xe5\x72\x08\x4f\x45\xdc\xf2\x20\x78\xd8\x02\x82\x9c\xe1\x32\x94\x18\x9e\x8c\x78\x2f\x4d\x67\xef\x0b\x10\xe4\x0c\x59\x45\x89\xe1\xc8\xc8\xfa\xd8\xe8\xa8\xde\x07\xbc\x38\xa5\x6a\xab\x10\x1c\x11\x71\xcc\x9d\x73\xb6\xf7\x4a\x1c\x73\x42\xd4\x52\x21\x38\x22\xe2\x98\x37\x0e\x6f\xce\x01\x2f\x4e\x89\x36\x55\x08\x8e\x88\x8c\xd4\x97\x5b\xdb\x3b\x80\x17\xa7\x44\x4d\x15\x82\x23\x22\x8e\xf9\xbd\xd3\xbc\x03\xbc\x38\x25\x6a\xa8\x10\x1c\x11\x6a\xba\x7b\x4e\x3f\xb2\x00\x2f\x4d\x79\xea\x0a\x00\x47\x53\x23\x73\xbc\x9d\xea\x15\xe0\xc5\x29\x4f\x4d\x85\xe0\x88\xc8\xa8\xf4\xe1\xf8\x62\x06\x78\x71\x4a\x54\x55\x21\x78\x93\xc4\x75\x76\x1b\x44\x90\xb7\x36\xd6\x20\x55\x08\x8e\x88\x34\x5e\xe7\xdd\xd9\x01\xe0\xc5\x29\x51\x45\x85\x88\x89\x78\xc7\x7c\xb4\x75\x94\x2c\x47\xf2\x8e\xf9\xd9\x84\x75\xcc\x15\x38\x91\x94\x94\xd4\xbb\xbe\x01\x22\xd8\xe0\x49\x8d\x1c\x9c\x48\x4a\xbc\x0d\x6b\x70\x90\xc9\x41\x85\x27\xad\xe4\xe0\x04\x52\x32\xe3\xbe\xba\xf9\x74\x0d\xc4\x00\x96\xb2\xad\x46\x09\x84\xa8\x81\xbb\x8d\x03\xba\x1b\xc5\xca\x59\xbe\x96\x12\x24\xd0\x91\x66\xde\x77\xef\x2a\x40\x0c\x60\xf9\x36\xd5\x28\x81\x10\x35\xf7\x93\x61\x74\x7a\x07\x44\x39\xcb\xd7\x54\x82\x04\x3a\xdc\xe8\x8d\x6d\xe7\xcd\x1b\x20\x06\xb0\x7c\x0d\x35\x4a\x20\x24\x6e\xf9\x87\xa3\xfb\x43\x20\x06\xb0\x84\x75\x35\x4a\x20\x24\xdd\xc0\xe7\xd6\xd9\x19\x10\x03\x58\xc2\x9a\x1a\x25\x10\x92\x06\x33\xbb\xbb\x35\x80\x18\xc0\x12\x56\xd5\x28\xd1\xb0\xc9\x4c\x64\xe7\xe0\xbd\x68\xaf\xbc\x59\xab\x51\x02\x21\xe9\x1e\x6e\x76\xa3\x0f\x40\x0c\x60\x09\x2b\x6a\x54\xbc\x4a\x9f\xba\xe5\x83\x1f\x27\x67\x64\xd9\x81\x11\x93\xe5\xfa\xd8\x2b\x97\x43\x78\x2a\x32\x63\x3f\xad\x8c\xce\x81\x20\x67\xb8\x0c\x25\x86\x27\x23\x23\xfc\xfd\xdc\x6b\x00\x41\xce\x90\x55\x94\x18\x8e\x0c\x75\x04\x57\xb5\x09\xf8\x01\x78\x69\xca\xd4\x56\x00\x38\x1a\x32\xbe\x5f\xdf\x5f\xb7\x01\x2f\x4e\x79\x5a\x2a\x04\x47\x84\x1a\xbe\xed\x05\x87\xef\x00\x2f\x4d\x79\x36\x15\x00\x8e\x86\xac\x98\x3f\x7c\xbc\x1a\x02\x5e\x9c\xf2\x34\x55\x08\x8e\x88\x34\x74\x77\x74\xb7\x07\x78\x71\x4a\xd4\x50\x21\x38\x22\xfc\x1a\xc4\x7e\x1f\x9c\x01\x5e\x9a\xf2\xd4\x15\x00\x8e\x86\xcc\xb6\xef\xb7\x0d\xde\xe2\x6a\x0c\x4f\x4d\x85\xe0\x88\x48\x73\x0e\x66\x1f\x0c\xc0\x8b\x53\xa2\xaa\x0a\xc1\x9b\x23\x99\x0c\x5f\xdf\x9d\xf0\x96\xc6\x1a\xa3\x0a\xc1\x11\x91\xe6\xfb\x71\x67\x18\x01\x5e\x9c\x12\x55\x54\x88\x94\x28\x59\x33\xaf\xda\xd7\xee\x11\x10\xe4\x31\x15\x5d\x33\x97\x63\x78\x32\xd2\x74\x8f\x6a\xe7\x67\x40\x90\x33\x64\x86\x12\xc3\x93\x91\xb1\x7c\x6f\xf3\xaa\x0e\x04\x39\x43\x56\x51\x62\x38\x32\xb2\xbc\x76\xbc\x7f\x56\x01\xbc\x38\xa5\x6a\xab\x10\x1c\x11\x59\x56\xbb\x39\x39\x71\x00\x2f\x4e\x89\x5a\x2a\x04\x47\x84\x1a\xef\x55\xeb\xf0\xf4\x1c\xf0\xd2\x94\x67\x53\x01\xe0\x68\x88\x6b\xde\x3f\xbf\x3c\x01\xbc\x38\xe5\x69\xaa\x10\x1c\x51\x83\x0e\xaa\xc1\x0f\xc0\x8b\x53\xa2\x86\x0a\xc1\x11\x91\x35\xf3\xe3\xd9\x59\x0b\xf0\xe2\x94\xa8\xae\x42\x70\x44\xe4\xc5\xab\x87\x7d\xd0\x07\xbc\x38\x25\xaa\xa9\x10\x1c\x11\x59\x24\xdb\x09\x1c\x3e\xe3\xac\x85\x57\x55\x08\xde\x24\xc9\xf2\xdd\x26\x10\xac\x8d\x35\x48\x15\x82\x23\x22\xa3\xd2\xf5\xb1\x39\x07\xbc\x38\x25\xaa\xa8\x10\x31\x11\xe7\x9c\xd7\x8e\xe7\xc3\xa4\x97\xe7\x9d\xf3\x43\x9b\x71\xce\x55\x38\x91\x14\x95\x34\xdc\x1b\x38\x73\x11\x6b\xf0\x9c\x86\x1a\x26\x52\x92\xe6\x3c\x82\x37\x86\x08\xae\xf0\x9c\x95\x1c\x9c\x40\x4a\xe6\xdc\xf7\xd3\xf7\x16\x10\x03\x58\xca\xb6\x1a\x25\x10\xa2\xe6\x7d\x6f\xef\xcc\xce\x80\x28\x67\xf9\x5a\x4a\x90\x40\x47\x5c\xf3\xc3\xb3\xda\x16\x10\x03\x58\xbe\x4d\x35\x4a\x20\x24\x63\xf5\x9b\x56\x65\x0c\xc4\x00\x96\xb0\xa9\x46\x09\x84\xa4\xd9\x8f\x6a\xd3\x3e\x10\x03\x58\xc2\x86\x1a\x25\x10\x92\xe6\xff\xf6\xc7\x89\xa8\xec\x3a\x47\x58\x57\xa3\x04\x42\xd4\x0d\x7c\x86\x83\x07\x0f\x88\x72\x96\xaf\xa6\x04\x09\x74\xa8\xb9\x7c\x9e\xb7\x83\x09\x10\xe5\x2c\x5d\x55\x09\x12\xcd\x5a\xeb\x68\xdb\xe7\xfe\x9e\x2d\x1a\x2b\x6f\xd3\x4a\x90\x40\x87\x1a\x89\xff\x79\xd7\x3a\x00\xa2\x9c\xa5\xab\x28\x41\xf1\x0b\x30\xcc\x5a\x79\xad\xda\x9f\x93\xa5\x02\x46\x4e\x5e\x85\x49\xd6\xca\xe5\x18\x9e\x8c\x8c\xed\x75\xb8\x3b\x07\x82\x9c\x21\x33\x94\x18\x9e\x8c\xf8\x2f\xed\x1b\xf3\x06\x08\x72\x86\xac\xa2\xc4\x70\x64\x64\x7e\x7e\xb6\x79\xe5\x03\x5e\x9c\x52\xb5\x55\x08\x8e\x88\x38\xe6\xdb\xa3\xa3\x2d\xc0\x8b\x53\xa2\x96\x0a\xc1\x11\x91\xf5\xf2\xf7\xb7\x95\x03\xc0\x8b\x53\xa2\x4d\x15\x82\x23\x22\xcd\x7d\xba\x77\xf8\x00\x78\x71\x4a\xd4\x54\x21\x38\x22\xb2\x35\xf6\x70\x1b\xde\x03\x5e\x9c\x12\x35\x54\x08\x8e\x08\x35\xef\xf0\x6d\x38\xb9\x02\xbc\x34\xe5\xa9\x2b\x00\x1c\x0d\x6a\xd4\x9f\x9c\xda\xbe\x09\x78\x69\x4a\x53\x53\x00\x38\x1a\x64\xe0\x97\x93\xd1\xe7\x5b\xc0\x4b\x53\x9a\xaa\x02\xc0\x9b\x23\x59\xbd\x3b\xdc\x9d\xf0\x96\xc6\x1a\xa3\x0a\xc1\x11\x21\xb3\x3e\xb9\x3c\x3d\xb7\x00\x2f\x4d\x79\x2a\x0a\x40\x4a\x93\x3a\xe5\x6f\x8f\x0e\x2f\x81\x20\x8f\x99\x62\xa7\x5c\x8a\xe1\xc9\x50\xe9\xf6\xed\xcb\x87\x00\x08\x62\x86\xcb\x50\x41\x78\x2a\x32\x86\xdf\xcc\x6b\x03\x20\xc8\x19\xae\x8a\x12\xc3\x91\x91\xb1\xfb\xe0\xf3\x8f\x0a\xe0\xc5\x29\x55\x5b\x85\xe0\x88\x88\x4b\xde\xbc\x71\x2e\x00\x2f\x4e\x89\x5a\x2a\x04\x47\xb4\x49\x66\x2f\x27\xd7\x4d\xc0\x8b\x53\xa2\x4d\x15\x82\x23\x22\x4e\xb9\x6f\x84\x10\xf0\xe2\x94\xa8\xa9\x42\x70\x44\xa4\xd9\xda\x93\xfb\x0f\x80\x17\xa7\x44\x0d\x15\x82\x23\x22\x4b\x66\xd3\x60\xd6\x06\xbc\x38\x25\xaa\xab\x10\x1c\x11\x71\xca\xdd\xad\x4f\x57\x80\x17\xa7\x44\x35\x15\x82\x23\x22\x63\xd3\xdb\xd9\x0e\x6f\xbe\xac\x85\x57\x55\x08\xde\x28\x71\x8e\xae\x2e\xf7\x00\x6f\x6f\xac\x49\xaa\x10\x1c\x11\x71\xca\x9d\x8b\x8f\x73\xc0\x8b\x53\xa2\x8a\x0a\x11\x13\xb1\x4e\xf9\xec\xe4\xf4\x2a\x79\x97\x96\xf7\xc9\x0f\x46\xa9\x4f\xae\x82\x89\x94\x64\xf2\x11\x5d\xde\x0d\x44\xb0\xc1\x73\x1a\x39\x38\x91\x94\x94\xb9\xb2\x6f\x04\x22\xb8\xc2\x93\x56\x72\x70\x02\x29\x69\xd8\x0f\x9f\x4f\xc5\x0c\xb4\x39\xca\xb6\x1a\x25\x10\x92\x7d\xec\xbd\x8f\x93\x3b\x20\x06\xb0\x84\x2d\x35\x4a\x20\x24\xe3\xf3\x64\xff\xda\x03\x62\x00\x4b\xb8\xa9\x46\x09\x84\xe4\xcd\xb5\xea\xd9\xa7\x2d\x20\x06\xb0\x84\x4d\x35\x4a\x20\xa4\x6b\xe6\xa7\x75\x0b\x88\x01\x2c\x61\x43\x8d\x12\x08\xc9\x5e\xf7\x9b\xcb\xeb\x08\x88\x01\x2c\x61\x5d\x8d\x12\x08\x49\x47\xb0\xf5\xf0\xf0\x06\x88\x01\x2c\x61\x4d\x8d\x12\x08\x49\x87\xb0\x3b\xba\x73\x80\x18\xc0\x12\x56\xd5\x28\xd1\xb8\x31\xe1\xc9\xde\x8f\x77\xa2\xcd\xf2\xa6\xad\x46\x09\x84\x64\xf4\xfb\x71\x7e\x2d\xaa\x9b\x6f\x2b\x15\x35\x8a\x1e\x36\x61\xf9\x9e\x0d\xbd\x90\x1e\xe9\xe0\x7e\x01\xef\x80\x18\x40\x8e\x9b\xd8\x8e\x9f\xd5\x38\x4a\x19\x46\x81\x33\xa5\x1f\xc2\xde\x87\x6f\x8f\x00\x2f\x26\x74\x67\xe4\x49\x85\xa1\x54\x63\xff\x96\x7e\x96\x7b\x62\x37\xbe\x00\x56\x48\x68\xf6\xd0\x6f\x79\xb8\x69\x45\xf4\x38\x8b\xe8\xf6\xea\x0a\x90\x47\x4d\x0f\x93\x4f\xbf\xab\x47\xa3\x77\xd5\xf4\xb3\x65\x87\x7c\x99\x5c\xdd\x8f\xf6\x1b\xf4\xb3\xe4\x79\xfc\xcd\xf1\x87\xe0\xf4\xb0\x95\x7e\x6f\x6c\xd3\xcf\x89\xb7\xe7\xdb\xa3\x8f\xe9\xa7\xc4\xe2\xfd\x23\x64\xe8\x95\xdd\x4c\x42\x73\xcf\x9d\xba\xa1\x42\xe2\x83\x22\x50\xc6\xe0\xc1\xf6\x0e\x39\x36\x42\xd3\x6d\x07\x9f\x1d\x81\xf5\xf7\xe9\x78\x62\x81\x58\xa0\xe9\xda\x20\xf2\xe2\x5b\x13\x50\x0e\x36\x41\x1d\xda\x80\x15\xea\x83\xc8\xdb\x21\xbf\xe5\xe1\xfe\x14\x7a\x1d\xcd\x72\xfb\xde\x35\x40\xbf\x35\x5d\xb3\x03\x7f\x6a\xfb\x73\x0f\x44\xfe\x68\x44\x8f\xbe\xf8\xe0\x5d\xed\x02\x31\x40\x8f\x05\xe7\xf8\x59\x8d\xc3\x67\x21\x23\x63\x4a\x4f\x46\xa6\x99\x4f\x3f\x5b\xef\x1b\x17\x83\xe3\x03\xc0\x0a\x51\xe6\x93\x2f\xd7\x65\xe1\x98\x82\x3d\x03\xe0\xb8\x72\x4d\x0e\x93\x4d\x3f\x5f\x8f\x3c\xe6\x04\x80\x6c\x38\xa6\x88\x3f\x61\xaf\x36\xee\xe7\x55\x90\x48\x50\x64\xfa\x11\xbb\x18\x82\xa3\xa5\x9f\xb1\x83\x68\xf4\x96\x9c\x48\x9b\x7e\xc6\x1e\x79\xc9\x77\xec\x92\x60\x52\x75\xe9\x87\xec\xc3\xa3\xcb\x01\x60\x64\xb8\xe2\x92\x4f\xd9\x33\xa1\x38\xba\xeb\x78\xd7\x64\x57\xa7\x71\xfb\x09\x24\x12\x14\xf5\xc0\xf1\xae\x25\x21\x24\x1a\xfe\xf0\xbe\x7e\xd5\xba\xd9\x07\xf4\x19\x47\x19\x65\xa4\x5a\x7a\xfc\x2e\x89\x54\x6d\x6f\xdf\x9f\x01\x4e\x8a\xa2\xbe\x43\x0f\x38\xbe\x0c\x40\x6a\x69\x82\x9b\x48\xe5\xc8\x99\x02\xfa\x8c\xeb\x66\x92\x91\x32\x89\x92\x48\x55\xb8\x7b\xb4\x0d\x38\x69\x92\x28\x8e\x2f\x03\x60\x96\xbb\x90\xa8\x67\xfb\x76\x17\xd0\x67\x14\xf3\x73\x98\x91\x32\x89\x92\x48\xc6\x9b\xea\xf9\x1d\xe0\xa4\x49\xa2\x38\xbe\x0c\x80\x59\xf0\x07\xee\x64\xa8\x77\x2c\x52\x6b\x44\x84\xe2\x6f\xa1\x5f\xb2\xb0\x34\x03\xa4\x33\xe5\x4e\x38\x4e\x93\x96\x85\x69\xd9\x43\x92\xb5\x8e\xa6\x3a\x3f\x39\xe5\xba\xa0\x92\x3c\xac\xc6\x9c\xcc\x8c\xd5\x22\x1e\xd7\x8c\xe9\xce\xe9\xd1\xcd\xd2\x70\xcd\xf1\xa6\xb3\x88\x29\x9b\x3b\x9a\x7e\x01\xac\x50\xc7\x0f\x49\xf9\xb2\xe1\x4c\x09\xc9\x91\x2d\x0e\x1e\x73\x46\x0f\x87\x6d\x0b\xc8\xc2\x92\x32\xbe\x8f\x45\xb9\xe8\xb4\xcb\x9b\x40\x6f\x86\x32\xd9\x30\xdf\xb5\xe7\x80\x17\x27\xdd\xdd\x21\xf4\x66\x2a\x8c\x67\xde\xe2\x0f\x98\xe8\x49\xd4\x9a\xae\x79\xe6\x2d\xb0\x9d\x5b\x27\x3e\x75\xe2\xc7\x7c\x0c\x01\x2b\x44\x71\x76\xc8\x6f\x79\x38\xa6\x88\xcc\x01\xb1\x66\xcb\xb9\x3a\x06\x89\x04\x45\x3e\x37\x07\xa1\x24\x04\x47\x63\xf5\xd5\xaf\x79\x07\xef\xdf\x00\x5e\x8c\x08\x18\x2d\xc9\x31\x71\x21\xc9\xd8\x75\xb1\x93\x94\x9a\xa6\x32\x75\x5c\x17\xe7\xae\xe6\x34\xae\x2f\x41\x2a\x42\xec\xc7\xe8\x97\x2c\x0c\x47\x0d\x23\xd3\xba\xa6\xd9\x9b\x0e\x2f\xbe\x00\x56\x88\xa2\x9f\x91\xdf\xf2\xf0\x44\x35\x7c\x41\x8d\x56\x78\x72\x00\x24\x61\xb1\xba\xd8\x12\xab\xc1\x68\x98\x07\xf4\xe8\x74\xad\xa3\xbd\x1d\x0d\x8e\x77\x00\x2b\x43\x63\xfb\x76\x7c\xb4\xba\x24\x18\x13\x4c\x4d\x7a\xc2\xc7\x8d\x71\xf1\x16\x24\x12\x14\xf7\xd8\x24\x67\x7b\x08\x21\x53\x73\xe4\x78\x26\x3e\xf6\x5a\xf3\x8c\xc6\xa1\x07\x52\x09\x39\xc7\x84\x3e\x90\x6e\xf9\xa0\xb1\x5f\x1f\x01\x5e\xca\x70\xa0\x8e\x59\x0a\x61\x89\x68\xff\x1c\x5c\x55\x6f\x00\x2f\x66\x98\x48\x6f\x2d\xc7\x78\xd0\xed\x68\xcc\x05\x67\x98\x3e\x3e\x77\x1d\x17\x5f\x38\x8b\x9d\x46\xda\xf2\xed\x7b\x69\xa8\xc6\xdd\xab\x80\x73\x97\xbd\x6e\x81\x92\xec\x91\x27\x15\x86\x52\x45\x4e\x44\x8f\x10\x33\xaa\x27\x27\x80\x15\x12\x9a\x73\xf4\x5b\x1e\xae\xb1\xb7\x30\x90\xdd\x58\xf1\x6e\x06\x4a\xf2\x16\x3f\x28\x10\x5a\x7a\x21\x1a\x79\xe5\x8e\xbf\x24\x8d\x9c\xf9\x12\xf7\x84\xd9\x50\x8d\xbf\xf6\x81\x2c\x41\x65\xaf\x83\xa0\x39\xd9\xa6\x8f\x4a\x14\x93\x1b\xe0\x44\x10\x99\x40\x30\xfe\x72\xf0\x11\x88\xf2\x34\x5b\xfb\x11\x9c\x28\x51\x1a\x73\x17\x1b\xae\x72\xf1\x82\x36\x9a\xb1\xa4\xab\xcf\x86\x6b\xdc\x55\x60\x64\x21\x2a\x73\x43\x18\xa5\x49\x1d\x5b\x29\x46\xe3\xae\xa2\xc2\xba\xca\xde\x50\x45\xa9\x18\x37\x53\x86\xd1\xb8\x1b\x90\x30\x55\xf6\x62\x24\x4a\xc5\xb8\x9b\x32\x8c\x96\x5e\xc2\x43\xde\xef\xe0\x2f\xe6\xa1\x24\xb1\xdb\x99\x09\xd5\xb8\x2b\x5f\x70\x4e\xb2\x37\xc1\x50\x12\xe6\xe0\x29\x19\x46\x63\x6f\x1b\xc1\xed\x2b\x73\x07\x49\xac\xe8\xd8\x11\x95\x21\x92\x8b\x23\x34\xe1\x26\x09\x34\x93\x65\x6e\x55\xc0\x79\xcd\xdc\xb5\xa0\xe9\xe4\x29\x76\x8c\x64\x08\x72\x3c\x50\x7c\x42\x9d\x70\x7f\x02\x39\x1c\x88\x9e\x50\x97\x09\x1b\x3b\x36\xec\x68\xe9\x5d\x0a\x9a\x1e\x8e\xc9\xf1\x3d\xc9\xcd\x09\xc8\xfd\xa0\xe7\x51\xa2\x22\xf0\x77\x25\xc4\xe7\x73\xe1\xb8\xf8\xad\x1d\xee\x9a\x04\x72\x36\xd7\x1e\x4e\x24\x1b\x46\x4e\xa5\xc4\xef\x3a\xa7\xd7\x1e\x68\x3a\xbe\xd8\xa0\xa3\x31\xb7\x1c\x68\xba\x96\x1e\xa3\x4e\x36\xd6\xf9\xf3\xe3\x35\x9d\xfe\xc6\xae\x67\x36\x54\x4b\x4f\xd3\xd6\x3a\x9a\x78\x0e\x79\x12\x1b\xf5\xdf\xd9\x40\x2d\x3d\x49\x99\x2c\xb4\xf3\xe7\x50\x27\xb1\x0f\x6d\x69\xa8\x96\x9e\xd2\xab\x75\x34\xf1\x7c\xe2\x24\x36\x1a\x85\xb2\x81\x9a\x78\xb0\x3d\x56\xb2\xf4\xc8\x7b\x46\x07\x89\xb1\x28\x80\x5a\xe6\x50\x7a\xf2\xe2\x92\xf4\xf4\x7b\x86\x37\x3d\x6c\x4b\x05\xcd\x32\x33\xd9\xce\x3b\x0f\x3f\x93\x4a\x52\x86\xfc\x58\x9a\x78\xda\x3b\xf1\x01\x65\xe7\xc0\x33\xd5\x1c\x93\xab\x80\x5a\xe6\xf4\x75\x5c\x00\xf9\xe1\xef\x0c\x6f\xa2\x20\x25\x34\xcb\xcc\x28\x28\xef\xe0\xf7\x4c\x2a\x89\x82\xf2\x63\x69\xe2\x79\xe6\x38\x25\xe9\x49\xe7\x8c\x25\x27\xe4\x0a\xa0\x96\x39\x5d\x5c\xa3\xe7\x37\x48\x0e\x1e\x67\x78\x53\x0b\x52\x41\xb3\xcc\x4c\xb6\xf3\x0e\x36\xcf\xa4\x92\x94\x21\x3f\x96\x26\x9e\xf0\xad\x75\x34\xf9\xd1\xdf\x4c\x5b\xa5\xdc\x2a\x9c\x96\x39\x99\x9b\xcc\x99\xa5\x67\x80\x33\xb4\xa9\xfd\xa8\xa0\x59\x66\xc6\xec\xf3\x0e\x05\xcf\xa4\x92\xb4\x81\xfc\x58\x5a\x72\x14\x38\xf1\xea\xb8\xd3\xc1\xe3\x3e\x9c\xcc\xf8\xc5\x30\x2d\x39\xeb\x1b\x47\xe5\x8f\xff\x8e\xa3\x62\x9f\x39\x13\xa6\x25\x87\x79\x6b\x1d\x4d\x38\xde\x3b\x8e\x89\xfa\xdb\x4c\x90\x96\x1c\xe0\x4d\x7c\x6a\xee\x4c\xef\x38\x26\x59\x8a\x11\xc3\x34\xee\xd4\x65\xdc\xd5\x67\x8f\xbe\x4e\xd4\x78\x8c\x9e\x54\x18\x4d\x72\x70\x35\x79\xa5\x47\x71\xaa\x35\x4f\x9b\xac\x82\xa8\xe1\x9a\xec\x88\x68\x5c\x64\xe5\x01\xd6\x7c\x1a\x69\x4b\xcc\x89\x20\x4d\x85\x31\xb8\x25\x87\x55\xcb\x52\x4c\xcc\x6e\x69\x5c\x8d\x3d\xb5\x5a\xeb\x68\xd9\xa3\xac\xe3\xfa\xa4\x75\x21\x01\x2c\x16\xfa\x70\xe6\x59\x68\x1e\x56\x84\x7a\xa4\x7b\xa5\x47\x6d\x16\xc2\x42\x18\x05\x8e\x15\x69\xdd\x38\xb0\xe0\x17\x61\xe9\x31\x80\xd1\x2c\xf0\x0a\x70\x63\x03\x96\xfb\x7d\x18\x1e\xfa\xf6\xcc\x85\x7f\xc2\xce\x23\x75\x9a\x3b\x70\xb1\x48\xa2\x04\x88\xb1\xf4\xe8\x0c\x8b\x2f\x8a\xb0\xe0\x78\x61\x64\x7a\x16\xf4\x87\x85\xa8\x54\x8a\xc6\x81\x3f\x2f\x78\x70\x5e\x38\xbf\x9f\xc2\xdd\x20\xf0\x83\xa2\xb6\x6d\x7a\x9e\x1f\x15\x2c\xd3\x75\x0b\x66\xc1\x72\xcd\x30\x2c\x98\x61\xc1\x2c\xc4\x94\x5a\x29\x65\x77\x53\x76\xc8\xd0\x9d\xc2\x21\x0c\xa0\x67\xc5\x9c\xd1\xd8\x09\x0b\x63\x33\xf4\x7e\x8f\x0a\x03\x08\xbd\x42\x72\x2c\x25\xb4\x0b\xa0\x10\xce\xa6\x30\x28\x96\x38\x04\x4a\x1f\xda\x5a\xa9\x4b\x8a\xfb\x22\xfa\xf9\x53\xf3\x07\x3f\xa0\x15\x69\x2f\x7a\xd1\xfd\x14\x17\x61\x63\x43\x4b\x72\x95\x4a\xff\x84\x9d\x28\xcd\xa2\x99\x64\x51\x86\xdd\xd8\xf0\x66\xae\xfb\xa2\xd7\x8b\xe4\xda\x38\x43\x59\x2b\xc0\xbb\x69\x00\xc3\x10\xd1\x4d\x66\x61\x54\x80\x4e\x34\x86\x41\x61\x00\x0b\x28\x76\xc1\x0f\x18\xf5\xe8\x05\xa4\x3e\xed\x65\x9c\x42\xa9\x0b\xcb\xd3\xc0\x8f\x7c\x24\xe8\x1d\xe1\x22\x94\xad\x00\x9a\x11\x2c\x46\x1b\x1b\x51\x1a\xa8\x3f\x5a\xbe\x17\x46\xc1\xcc\x8a\xfc\xa0\xf3\x78\x6b\xba\x33\xd8\x81\x3a\xf4\x66\x13\x18\xe0\x33\xa9\x5f\x18\xfa\x3c\x70\xc8\x51\xe4\x2f\x2a\xba\xe5\x7b\x43\x67\x34\xa3\x61\x95\xc5\xa2\xa4\x47\x1b\x1b\x45\x9a\x46\x08\xa3\xe3\x98\xfa\x68\xf8\xa7\x54\x8a\x75\xd3\x41\x76\x84\x73\xd1\xef\xf7\xa2\xd2\x82\x22\x6d\x38\x74\x3c\x78\x1c\xf8\x53\x18\x44\xf7\xc5\x48\xd7\x52\x6b\xd3\x74\x9a\xbd\x17\x95\x45\xa9\x7b\x6b\x06\x05\xa7\x97\x98\x70\xe9\x31\x51\x3e\x24\xca\x1f\xfa\x41\x11\x81\xbc\x5e\xa5\xeb\xfd\x33\x2a\xbb\xd0\x1b\x45\xe3\xae\xf7\xf2\x65\xe9\x11\xc9\xfd\x5e\xf4\xd5\xfb\xde\xf5\xcb\x69\x59\x7b\xec\xc3\xcf\x9f\x2f\x0c\xdd\x2f\xb3\xe5\xed\xbd\xa8\xe8\x1a\xce\x83\xe6\x78\x05\x7f\x63\xa3\xe8\x97\x63\xdd\xf4\x5e\x54\x4a\xba\xbc\x14\x50\xf7\xcb\xd7\xf0\x5e\xf7\x4b\x8b\x05\x6d\x48\x49\xbe\x23\xdd\xd3\xfd\xa4\x7d\x79\x1b\x1b\xb0\xc8\xd6\x8e\x57\xd2\x7d\x2c\xd3\xfd\x92\x1e\x2d\x16\xc5\x92\x6e\xf5\xbc\xa2\x51\xd2\xc3\x9e\x5f\xb4\x4a\xfa\xac\xe7\x15\x2b\x25\xdd\xee\xf9\xc5\x59\x49\x1f\xa2\xb0\x76\x49\x9f\xf6\xfc\xe2\xb0\xa4\x0f\xd0\x63\xa3\xa4\x8f\x7b\x7e\x71\x50\xd2\x27\x3d\xaf\xb8\x59\xd2\x47\x3d\xbf\x38\x29\xe9\xfd\x9e\x57\x6c\x95\xf4\xdb\x9e\x5f\xec\x97\xf4\xfb\x54\x95\x90\xd1\x65\x54\x24\xca\x82\x28\x9b\xba\xd9\x0d\x8a\xa8\x59\xe9\x51\xa9\x1b\xab\xd7\xe9\x99\xc1\x68\x36\x81\x5e\x14\x52\x15\xeb\x56\xef\x4d\x10\x98\xf7\x45\x07\xe5\xb2\xd2\x0d\xff\xe9\x74\xc3\x97\x2f\x4b\xd6\xd7\xf0\x7b\x0a\xfe\x1a\x7e\xef\xc6\xa5\xee\xf9\x3d\x97\x10\x17\x61\x2f\x4a\x0d\xe3\xe7\x4f\xaa\xd0\x11\x6f\x40\x51\xa9\x54\x46\x6d\xb5\x6c\x4e\xa7\x2e\xd2\xef\x57\x14\xf9\x3b\xaa\x2b\xcb\x8c\x8a\x56\xa9\x54\xd2\xfd\x72\x18\x99\x11\xec\x3d\x86\xd0\x85\x56\x04\xed\xce\xd7\xef\xf8\x94\xde\x8e\xa1\x5b\xfe\xcc\x8b\x3a\x15\xfd\x87\x3f\x08\x3b\x5f\xbf\x2f\x74\xb3\xe7\xe9\x6e\xd1\xd7\xcd\x52\x5c\x41\x66\x31\xd2\x61\x49\x77\x8a\x91\xfe\xf5\xf1\x1a\xde\x77\xb4\x21\x8c\xac\xb1\xa6\x13\x13\x54\x2a\x2b\xee\x28\x69\xd6\x70\xa1\x92\x32\x27\xec\x51\x39\xf2\xcf\xa2\xc0\xf1\x46\xac\x05\x27\x71\xe3\xc0\x62\x69\xa1\x47\x8b\x22\x03\xc1\x75\xd1\x43\xac\x5d\xf4\x17\xb2\x94\x69\x58\xc6\x59\xfb\x74\x7a\xb0\xb1\x81\x7f\x15\x25\x41\x2f\xb5\x3f\x51\xe1\x7b\xda\x4b\x1c\x88\x75\x53\x46\x92\x52\x39\x1a\x43\xaf\xc8\x16\x28\xc9\xc7\x8f\x10\x25\xba\x10\x21\x51\xe9\x11\xa2\x36\x7d\x86\x48\x8a\x9c\x82\x89\x66\xa3\x32\xfe\x97\xe8\x37\x2a\xa3\x7f\x16\x25\xfc\x47\x27\xba\xb4\xfc\xc9\xd4\xf7\xa0\x17\x5d\x3a\xae\x7b\x88\xb0\x19\xc5\x96\x1e\x71\x46\x49\x81\x4a\x8b\x38\xe6\x6c\x6a\x9b\x11\x3c\x36\x47\x50\x56\x15\xa4\x6c\x49\xce\x70\x75\xc3\x85\x9e\x32\xa5\x44\xd6\x18\x92\x85\xe0\x2c\x4b\x5c\x49\xa9\xa2\xe2\x22\x96\x1d\xcf\x72\x67\x36\x0c\x8b\x50\x60\x92\xf1\xa0\xca\x8a\x7a\x72\x1a\x1b\xde\xa1\x9e\xb0\xd4\x8d\x5e\xf7\x2a\x7f\xca\x30\xe1\xd4\x75\x2c\xd4\xf2\x8d\x52\x47\x16\x3e\x9d\x85\xe3\x22\x2c\xe9\x42\x99\x93\xda\x90\xc4\x59\x08\x99\x7e\xe3\xba\x12\xbd\x8b\x36\x26\xa4\x4b\x1a\xf9\xeb\x38\xd3\x32\x33\x58\x94\x3a\x45\x26\x2a\xaa\xff\xf2\xc4\x9c\x8a\x16\x24\x29\x4f\x54\x5a\xac\x59\xa2\xb4\x48\x36\x74\x61\x04\x57\x2b\x13\x69\x1c\x49\x0c\x79\xdb\x61\x83\xf5\xc7\x09\x8c\xc6\xbe\xdd\xd1\xa6\x7e\x18\x69\x99\x36\x81\x0a\x94\xda\x66\xd1\x28\x2d\xc4\x8c\x9d\xd1\x2c\xe7\xe6\x4e\x8f\x7a\x5f\xbf\x4b\xd5\xce\xe9\xcf\x43\xc9\xb1\x99\xc4\x05\x88\x88\x0e\x49\x39\x32\xe1\x2f\xb5\x57\xda\x4b\xaf\x6c\x3b\xd0\xee\x9b\x11\x7d\x72\xec\x4c\xc1\x50\x0d\x1c\x07\xfe\xc4\x09\x61\xd9\x74\xdd\x62\x24\x2b\x6a\xdc\x2c\xd3\x42\x06\x30\x0a\xee\xd7\x51\x7e\x1c\x41\xae\x7b\x26\xf4\x39\xaa\xc7\x34\x7f\x91\xe6\x31\xb7\x5a\xf1\x71\xf0\x5f\xae\x77\x0f\x6f\xf5\xe5\x69\x9d\x76\x68\xc8\x18\xb0\xb3\x4e\x5d\xc1\x5d\x17\xa2\x61\xa9\xa8\xd9\xce\xad\xa6\x23\xaf\x52\xcf\x87\x3c\x62\xcf\xfc\xa3\x39\x81\x9d\x62\x45\xbf\x8d\xb1\xa5\xe2\x28\x89\x86\x57\x78\x75\xe1\x99\x2e\xb7\x97\x16\xab\xf3\x0b\x0c\x74\x9b\x66\xa1\x6b\x3b\xd0\xb4\x0b\xef\xfd\x41\xa8\x95\x9e\xcc\xb6\xe5\xdb\xf7\x39\x79\x99\x52\x65\x30\xc6\x40\xc6\x33\xad\xf0\xc3\x1f\x14\xc3\x52\xc1\x0c\x60\xc1\x86\xa6\x5d\xce\xc9\xc4\x34\x96\xeb\x64\x1c\x12\xc6\x5d\x3d\xf2\x23\xd3\xdd\x26\xe3\xa5\x98\xd0\x14\x06\xc8\x96\x3b\xd5\x8a\xfe\x63\x36\x99\x9e\xfb\x1d\xb6\xeb\x14\x7d\x48\x66\xc4\x66\xda\x41\x54\x5a\x2c\x16\xa5\x27\x29\x49\x78\xef\x28\x47\x55\xf4\x52\x22\x35\x4b\x5e\x5c\xb2\x5b\x97\x6f\x77\x51\xb0\x14\x31\x5e\x86\xc0\x6f\x04\x68\xfa\x23\xf2\x1e\xe9\xc8\x37\xf0\xef\x34\x9d\xba\x00\xd2\xd1\x35\x1e\xe5\x74\xdf\xdb\x1e\x9b\xde\x88\x6b\x5a\x89\xba\xe3\x51\x14\x39\x28\x79\xaa\x4e\xf2\xa8\x21\x1d\xe5\x59\x6e\x8a\x7c\x13\x7b\x8d\xab\xc1\xf1\xdc\x71\x35\xe8\x8e\x03\xed\xc2\x9b\x48\x2b\x95\xf4\x65\xa3\xf4\xb2\x9e\x03\x55\x0f\xee\x87\xa2\xb2\x63\xe7\xd5\xb5\xfd\xdc\x4a\xa2\xca\x86\x76\x31\x2a\xad\x50\x29\xd8\xfc\x73\xab\x24\xce\x51\x54\xf6\xcc\x09\x5c\x05\xf9\xfe\xec\xe8\x63\x39\xc4\x9e\xb9\x33\xbc\x2f\x46\x65\x33\x18\x85\x2b\xa6\x01\x83\x60\x15\xa0\x0a\x31\x4e\x3b\x93\x08\xb9\xd4\xd1\xa2\x84\x06\x0c\xf4\xbf\xa7\x34\xf0\xf8\x25\x17\x3d\xf0\x5d\xd8\xd1\xc8\x0e\x6a\x4e\xf5\x0d\x66\x51\xe4\x7b\x49\xed\xc4\x8f\xcb\x87\x83\x41\xe4\xe9\xdc\x53\x3c\x14\xa0\x2a\x74\x1d\xeb\x5a\x5a\x83\xbc\xc3\x84\xbd\x7f\x6d\x07\xcb\x0a\xb1\x70\xe9\x10\xf0\x2f\xce\x32\xe7\x68\x90\x1c\x9f\x22\xd1\x7f\x6c\x86\x13\xe7\x96\x53\xef\x1b\xd7\xfd\x4f\xcb\x68\xec\x09\xb2\x4a\x4d\xb3\x89\xfc\xff\xef\x25\x34\x4b\x4e\xf3\xbb\x1d\xcf\x2e\x4b\xdd\x7b\xec\x8c\x9d\xdf\x4f\x61\xd8\x7b\x8c\xe7\xc2\x1d\x3b\x81\x92\xd6\xac\x27\x4e\xb2\x2a\x88\x78\xa2\xd9\xd0\xd8\xc9\x53\x84\x28\xa2\x2d\xf4\x28\x96\xf4\xee\xff\x5e\x87\xfd\x7b\x1d\xf6\xef\x75\xd8\xff\xbe\x75\xd8\x4d\x6e\x19\xb6\xc5\xac\xc2\xfe\xb7\xae\xb4\xde\xcc\xe0\x0c\xae\xbe\xa0\xba\xd2\x22\xa0\x7c\xed\x60\x16\xb8\x92\x35\x83\x59\xe0\x3e\x7f\x35\x93\x16\x22\x5a\xac\x34\xc9\x5e\x69\x66\xcd\x4f\x9b\xc7\xe9\xd0\x38\x15\xa6\xcd\xd3\x67\x4f\x9b\xa7\xaa\x69\x33\x29\xd6\x3a\x73\xe6\xe9\x73\xe7\xcc\x24\xc9\xd8\x54\xb5\x02\x7e\x46\xb3\xe7\xb9\x13\x8d\x0b\x66\x01\x4f\x80\x0b\xfe\xb0\xa0\x91\x58\x38\xdc\xde\xa6\x33\x6d\x27\x82\x13\x04\x26\xd2\xb2\xb6\x8e\xa3\x3b\xfd\x25\x33\xd9\xe9\xbf\x6d\x26\xbb\xc6\x2c\x11\xab\x6b\x35\xe8\x81\x19\x41\xcf\xba\x2f\x14\x43\x68\xf9\x9e\x1d\x96\x84\x09\x20\xad\x2f\x6e\x0a\x08\xd7\x98\x02\xe2\x29\x64\x1f\x4d\xa6\x56\x99\x08\xa6\xe8\x55\xe6\x45\x74\x61\x64\x35\xa8\x4b\x0a\x1a\x4f\x90\x92\x56\xcc\x18\x98\xa6\x8f\x60\x94\xed\x67\x2a\xdd\xec\xda\xbf\x4c\x2d\xa8\xcf\x78\xd9\xa3\xdb\x1c\x8b\x92\x0e\x73\x9d\xd0\x09\xeb\x84\xce\x02\x37\xd7\x1f\x9c\xfc\xed\x0f\xfe\xed\x0f\xfe\xed\x0f\xfe\xf7\xf9\x83\x7f\xef\xcb\xf3\xde\xe2\x7f\xe9\x5e\xbc\xd2\x35\xfd\x0b\x77\xe0\xff\xef\xd9\x75\xff\x65\xbb\x55\xff\x09\x5b\x51\x64\x2d\xea\xdf\xbd\x17\x15\x5a\x63\x88\xba\x61\xbb\x10\xf9\x68\x14\x0a\x60\x14\x38\xf0\xef\xad\xa9\xff\xa4\xad\xa9\xff\x88\x6d\x1f\xba\x74\xba\x7c\xdf\x67\x3d\xa7\x7f\xb5\x7d\x1f\xf8\xd4\x5d\x16\xb8\xfa\x2e\x0b\xfc\xb5\xbb\x2c\x90\xdd\x65\x59\x7d\x95\x79\x99\x83\xff\xf7\x82\xef\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\xdf\x0e\xfe\x7f\xb4\x83\x7f\x96\xb8\xd6\xff\x31\x4e\xfe\xdf\x6e\xfd\xff\x13\x6e\x7d\x6a\x79\x6f\x91\x7b\xff\x5f\xea\xb0\xaf\xea\x87\x07\x33\xaf\x6f\xfe\x5b\x9c\xf1\x27\x79\x47\x7e\xcf\x2b\x36\x37\x4b\x5d\xaf\x6c\x23\xe8\xa9\x3f\xc3\x27\x78\x64\x5b\x8f\x5f\x36\x69\x94\xa0\xe7\x15\x6b\xf5\x24\xca\x01\x3e\x73\x2b\x1b\x21\x48\x22\xb8\xc8\xa5\x68\x24\x11\xf6\x3d\x1b\xde\xa9\x62\xb9\x49\x2c\x13\xc5\x6a\x26\xb1\xe6\x4e\x34\x56\xe7\xce\x4c\xa2\x39\x28\x5a\x8b\x4f\xec\x14\xda\x4e\x80\x1c\x74\x49\x4c\x27\x89\x89\x7c\xa6\xcd\xb6\x10\x13\xa5\x28\x8b\x66\x25\xd1\x42\xa4\x8e\xb4\x74\x79\x69\x85\x49\x24\xe4\x91\xb5\x2a\xbc\xda\x65\x31\x66\x49\x0c\xbb\xe7\x15\xd3\x54\x88\x0d\xe2\x68\xa1\x2c\x9e\x5d\x1e\xd0\x78\xc8\xdb\xab\x1a\x42\x05\xe3\xd3\x7c\xee\xa4\x79\x1c\x26\x29\x4e\x51\xcc\x5a\x12\xd3\xf5\x2d\x72\x3c\xce\xd8\x9c\x4a\xf3\x3a\x45\x31\x75\x8a\x0e\x70\x3a\x39\xd8\x38\x7f\xf8\x85\x83\x34\x7f\x13\x13\x3b\x53\xd9\x18\x83\x24\x5f\x63\xa4\xf0\xb4\x9e\x66\x21\xd1\x43\xb0\xe7\x84\x91\x1f\xdc\xcb\x22\x8f\x93\xc8\xc8\xbd\x35\x52\xc5\x0f\xfd\x60\x62\x46\xc7\x66\x14\xc1\xc0\x93\xc5\x9c\x24\x31\x47\x28\xa3\xa9\x3d\x62\x37\x8e\x24\x7c\xe8\xd8\xb6\x0b\xe7\x66\x20\x2d\xea\x28\x61\xc0\xce\x74\xda\xd6\x06\x81\x3f\x0f\x73\xb3\xdd\x4f\xa2\xde\xf6\xbc\x62\x3b\xcd\xf6\xd8\x0c\xc7\x39\xf1\x6e\x93\x78\xf7\x48\x57\x4d\xc1\x6c\x0e\xe1\xc4\x0f\xee\x73\xe2\xdf\xa3\xf8\x6b\x4c\xfd\xd1\x4c\x8a\xcc\xa2\x1e\x17\x89\xcf\xef\x17\x1c\xaf\x00\x4b\x51\xf2\x01\x91\x5f\x7a\xdd\xab\x24\xde\x7a\x32\x7d\x29\x8f\xcd\xf0\x68\xee\xc5\x1d\x16\xf6\xd9\xd1\x6c\xa8\xb4\xb1\x51\xf4\xbe\xfa\xdf\x7b\xf0\xab\xff\xbd\x94\x4c\x03\x16\x71\x17\x54\x2d\xe9\x6e\xcf\x2b\x7b\xc5\xa0\x84\xdc\x74\x34\xdd\x71\xf0\xb3\x49\xa6\x3f\x35\x34\xb1\x40\xcf\xec\x04\xa8\x88\x04\xb3\x92\xee\x15\x6b\x46\x89\x4e\x85\xaa\x68\x2a\x84\x1b\x09\x9e\x0b\xe1\xa9\x90\x57\xac\xd5\xd0\x5c\xa8\xe8\x15\xdb\xc9\x8c\xcd\x0c\x43\x67\xe4\xfd\xfc\xc9\xf9\xf8\xb4\xb4\x51\xcf\xe8\x46\xff\x14\x67\x39\xdd\x28\x9e\x48\x7a\xcc\xa4\x26\xfa\xce\x2b\xc9\x2b\xad\xa4\x13\x8f\xe8\x04\xa9\xa3\x87\x14\x93\x4c\x1a\xe0\x02\xcd\xd7\x1e\xc7\xa4\x42\x3b\xb3\x32\x59\x8e\xd0\xad\xb1\xe3\xda\x01\xf4\x3a\xc3\xb2\xad\xe3\x06\x19\x92\x9f\xd8\xef\xed\xcc\xca\xa8\x20\x3a\x37\x9c\xc5\x42\xdf\xc3\xcb\x0c\xe9\xe3\x27\xec\x3a\xc5\xcf\xb8\x95\xd2\x4e\x24\x49\x6f\xa1\xf7\x7b\x61\xb1\x54\x7c\xb4\x9d\x70\xea\x9a\xf7\xd8\xe1\x49\x46\x94\x64\xa0\xeb\x8c\xf4\x11\x8c\xa8\xeb\x8b\x4a\x18\x66\x5f\x52\x79\xa4\x59\x94\x78\x0d\x4e\xd9\xcc\xb8\x92\xa6\x0e\x91\x3b\x87\x78\xf7\xc9\xfa\x0d\xf6\xfe\x25\xbc\x71\x27\xd6\xc1\x63\x3a\xd5\x09\xfe\x3d\x35\x03\x73\x42\x7f\x27\x73\x13\xf2\xbc\x58\xe8\x63\xd3\xb3\x5d\xb2\xf4\xc2\x65\xca\x19\x16\x5f\x30\x33\x2b\xaa\x36\xba\x62\x03\xbb\xd9\x20\x52\x95\x78\xf6\x07\x4b\x0b\x9d\xe9\xc7\x03\x62\x04\xaa\xaf\xf2\x08\x0b\xab\xf8\xae\x83\x26\xb9\xc9\xc4\x91\xf4\xb8\x5d\x62\x6c\x4c\x14\x6a\x16\x71\x03\x22\xa9\x14\xc7\xe5\x41\xa9\xe8\x11\x57\x2d\x0a\x4c\x2f\x74\x50\x9a\x87\xa6\x67\x8e\x60\xc0\x64\xed\x5c\x0c\x93\xcf\x93\x14\xd9\x83\xa5\x74\xea\x2a\x12\x75\x33\x85\xa3\x39\xd5\xd9\xec\xeb\x7e\xcf\x23\x45\x0b\xf5\xa0\xe7\x95\x63\x9b\x4e\x5e\x7e\x40\xd3\xfe\xed\x59\x10\x40\x2f\x3a\xa0\x95\xfb\xf3\xa7\x5b\x2c\x15\x5f\x18\x71\xc3\x2d\xda\x65\xb3\x54\x8c\xe2\xa7\x01\x2a\xba\xff\xf3\x67\x50\x42\xa5\xcc\x4c\x43\xf3\xde\xe8\xca\x14\x82\xe4\x54\xa1\xaa\x22\x75\x85\x49\xcd\xb0\x50\xb6\xc2\x99\xaf\x0f\x29\xbe\x3f\xf3\x5c\x27\x8c\x20\xd5\x43\x26\xd1\x32\x09\x2d\xb2\xeb\x43\xa5\xc7\xe8\x4f\xd4\x6d\x24\x66\x5a\x8c\x4a\x9d\x62\x52\xd9\x66\xa9\x08\x69\x3e\x74\xaf\xa4\x33\xf3\x75\x4f\x87\x89\x85\x92\x46\x5e\xc2\xb3\x74\x4e\x31\xa7\xd0\x82\xce\x2d\x14\x5a\x2b\x2c\x3d\x0a\xb8\x4f\xde\x44\x54\x21\x5f\xa2\x8d\x0d\xfe\xb9\x58\x5a\xe8\x62\x5b\xe7\xec\x0a\xeb\x45\x8f\x7a\xb0\x1c\x37\x5d\xdd\xeb\xc1\xd4\x22\xd0\x74\x11\x35\x5c\xdd\xed\xa1\xe9\x61\xdc\x6e\x75\x93\xb5\x21\xa7\x27\xf4\x19\xba\xd5\x33\xcb\x24\x5d\xbc\x46\x66\xea\x5f\x35\x0e\xa0\xe9\xf1\xea\x00\x33\xf4\xcc\x5c\xb7\xd7\x8b\xfe\x44\xff\xc6\xaa\x2d\x5f\xc3\xfb\xb0\x38\x2a\x95\x87\x7e\xb0\x6b\x5a\x63\xd9\x3c\x87\xbc\x95\x5d\x08\xbf\xc2\xef\x8b\x92\x6e\x15\x27\xc5\xc7\x85\x1e\xea\x8f\xa4\x3a\x3a\x8c\x89\xe8\x49\xf7\x14\x25\x7d\x53\xdc\x31\x05\x6c\xaf\xe4\x0a\xbd\xb6\x83\xe7\x1b\x8b\x52\x37\x2a\x9b\xbd\xfe\x93\x16\xed\x85\x85\x79\x14\x4b\x5c\xaa\x27\x5d\x4b\x5f\x18\x9c\x8a\x51\xe9\xcf\xfe\xd7\xe8\x3b\xee\x2a\xbb\xf3\x6c\xe8\xc6\x86\x53\xd4\x8e\x2e\x76\x4f\x4f\xf7\x77\x76\xfb\x5b\x6f\xce\x76\xb5\x5e\xaf\xe7\x21\x57\xd9\xb4\xa2\x6d\x34\x37\xde\xf7\x22\x18\x0c\x4d\x0b\x76\x0a\x57\xfe\x0c\x7f\xb2\x86\x5c\xb2\xc9\x34\x72\xbc\x51\x21\xf2\x0b\xfe\x2d\x0c\x02\xc7\x86\x85\xff\xfd\x2d\xfc\xdf\xc2\x30\xf0\x27\x85\x7b\x7f\x16\xd0\x5d\x80\x70\x0a\x2d\x67\xe8\x10\xdd\x95\x0b\xbb\x5e\x38\x0b\x60\x21\x1a\x9b\x11\x41\x91\xaf\x17\x0b\x68\x92\x18\x16\x6c\x1f\xaf\x83\x23\x46\xd7\x9c\x92\x17\xfc\x70\x4e\x28\x2c\x2c\x6b\x7a\x54\xd2\x21\xce\xf6\xce\xee\xdb\xfd\x8f\xbb\xfd\xc3\x37\x1f\xaf\x70\xa6\x7f\xfe\x64\x45\xfd\xc3\xdd\xd3\x77\xbb\x3b\xeb\x17\x87\xcc\xd1\x48\x61\x7c\x8f\x16\x25\xae\xde\xc2\xc4\x27\xb9\xf7\x0a\xbe\x67\xc1\x72\xe1\x7c\xec\x84\x05\xcb\xf7\x86\xae\x83\x72\x69\xde\x17\x06\xb0\x60\xcf\x20\x62\x32\x0b\x13\xe7\xce\xf1\x70\x9e\xd3\x2a\x0c\x8b\x10\x55\xa0\x33\x2c\xa2\xbf\x65\xfb\x0b\x5c\x7e\x71\x36\x7f\xcf\xe4\x12\x19\x8d\xc9\xe4\x8b\x28\xdb\x0f\x0a\xe9\x16\x46\x98\x64\xa0\xb0\xef\x85\x11\x34\x6d\xbd\xf0\x63\x16\x46\x34\x6e\x00\x47\x33\x17\xb9\x39\xa4\xb1\x68\x25\xdd\x29\xbe\x88\x8a\x5e\xe9\x09\xa9\xaf\x9d\x16\x9d\xdf\x32\x9b\x1c\xba\xdb\xf3\xcb\xfd\x7e\x80\x92\x7e\x33\x8b\xfc\x2d\xc7\xb3\x8f\x4d\x27\x08\xbb\x9e\x68\xb6\x56\x69\x63\xe3\xb6\x8c\x93\xc3\xca\xa4\x3f\xd3\x25\x6d\x93\x78\x6e\x48\xc5\x62\x5c\xb3\xb4\xb1\x61\xbe\xe8\xf5\x2c\xd2\x64\xc2\x9e\xf7\xd5\xfc\xae\xcf\x7a\x7e\x16\x88\x06\xc9\xa0\x38\xd3\xcd\x92\x7e\x9b\x0d\x2d\xdd\x7e\x35\xbf\x17\xa1\x1e\x96\xba\xd0\x0d\xe1\x23\x99\x07\x66\x1a\xa0\x89\x1c\xd7\xb4\x8e\x7b\x71\x1d\x87\xfa\xb8\x37\xd8\xd8\x78\x61\x6f\x6c\xbc\x98\x6d\x6c\xbc\x30\x5e\xf4\x7a\x5e\xd9\x9c\x45\xfe\xc0\xf1\x6c\x94\xf4\xb8\xe4\x92\xcf\x81\x4d\x3d\x2c\xe9\xfe\x57\xf3\x7b\x2f\xc4\x49\x15\x9c\x61\x71\x46\xb2\x3f\xe9\xf5\xbf\x9a\xdf\xbb\x4e\xd1\xde\xd8\x28\x2a\xac\x7f\xc2\xb7\x0b\x2c\x12\xea\xf8\x93\x07\xef\xa6\xe4\xeb\x22\xd4\x5c\x0b\x53\xdf\x75\xac\xfb\xc2\x6f\x61\x61\xe8\x07\x85\x6b\x88\x7f\xce\xc7\xd0\x23\x75\x3c\x42\xea\x4d\x2b\x1f\x45\x41\x0d\x73\x82\x34\xa5\xca\xc4\x9f\xb8\x00\xc3\x22\xfa\x47\x0f\x4b\x9d\x4c\x96\x36\x36\x70\x58\x6f\x1a\x43\x4a\x0b\x5c\x58\x52\xf0\xc5\x02\x3f\x75\xd3\x66\x34\x4b\x76\xe8\xa2\x52\xb2\x53\x84\x32\x16\x71\xbb\x43\x28\x5c\xac\x13\xaf\x44\x20\x41\x0f\x47\xb8\xed\x3a\xc5\x17\x81\xfe\xbb\x60\xf5\xea\xae\x01\xd9\x73\x08\x83\x5b\x68\x17\xa6\x94\x53\xc7\xfd\x85\x4e\xfa\xb5\x70\xec\xcf\x5c\xdb\xfb\x86\x77\x25\x51\x1f\x12\x8d\x61\x41\x43\xa3\xa5\x63\x85\x1a\xd2\x67\xb9\xb0\x43\xa8\x1c\xd2\x76\xbc\x64\x8f\x35\x61\xc4\x12\x68\xda\x5d\x84\x99\x3b\xae\x5b\x08\x23\xf4\xf7\x00\x16\x4c\x7c\x90\xa7\x33\x70\x13\x72\x66\x0b\xb0\xfc\xbb\xee\x25\x4b\x3b\x78\x9a\x87\x8a\xe7\x66\x1a\xf5\xbf\xa8\xe7\x43\x0e\xcd\x57\xef\x7b\xcf\x5f\x30\xdb\xcb\x36\xad\xbb\x22\xdc\xd8\x88\x36\x36\xe2\x2d\xda\xa4\x71\x40\x89\x2c\xd2\xb5\x09\x0c\x46\x70\xdf\x8b\xfc\x4b\x27\x1a\x7f\xf4\x77\x66\x53\x17\x8d\x2b\xf0\x03\x1a\xe7\x4b\x9d\x02\xdd\x84\xc6\xb0\x82\xe7\x7b\x80\x70\x84\xa8\xbf\x11\x4c\x44\x62\x13\x1b\x1b\x45\xa7\x78\xeb\x3b\x76\xa1\xd2\xeb\xf5\x50\xa6\x97\xa7\x78\x1e\x38\xe4\x7d\x19\x92\x66\x34\xf7\x69\x17\x17\x92\xb1\x0b\xd5\x4d\x68\x4e\x20\xaa\xf3\x0e\x56\xec\x4a\x7a\xeb\xa2\x4c\x4e\xcd\x20\x72\x2c\xd4\x6d\xea\xf8\x35\xe2\x18\x6a\x99\xb3\x10\xda\x85\xc1\x3d\x4e\x4e\x98\x56\x15\x4b\x68\x0c\x10\xe6\x70\xc5\x52\x3c\x7c\x16\x88\x47\x81\xea\x9b\xcb\x27\x1a\x3d\xc6\x48\x8a\x5c\x26\xa6\xd6\x50\x13\x4a\x7c\x2c\xc6\x0d\x21\x5b\xc0\x92\xe5\x7c\xe2\x86\x28\x36\xd2\x74\xbf\x17\xc9\x43\x50\x33\x25\x2e\x9c\x17\xcf\x4a\xfc\x54\xe6\xc7\x32\x8f\xae\x71\x3e\x2e\xe2\x3c\xd9\x45\xec\x33\xa3\x7f\xfc\x92\x1e\x30\x36\x36\x55\x65\x51\x95\x39\x45\xd6\x18\xca\x01\xeb\x6a\x45\x65\xd4\x51\x17\x21\xb3\xfa\x91\x00\xc7\xfc\xea\x03\x94\x8e\x6b\x7a\x66\x5f\xbb\x57\x65\xbb\x2e\x3d\x40\xff\xbc\x34\xbe\x77\xf1\xaa\x02\x4a\x3c\x60\x73\x33\x49\x27\xa1\x3e\xe3\xda\xea\xbe\x1e\xc4\x9e\xbd\x24\x55\x9a\xda\xc6\x06\xd9\x75\xa4\xb3\x1a\xec\x8a\xf7\x20\x79\xb0\xc8\x1c\xb1\xe7\xd3\x19\x12\x1c\x86\x3d\x93\xfc\x26\x9b\x34\x41\x2f\xf8\xf9\xd3\x63\xb6\x12\x7a\xd8\xbd\x24\x7d\x0e\x96\x0a\x56\xf9\xa7\x4c\x58\x2c\x11\xaf\xd4\x29\x66\xda\xba\xbb\xb1\xf1\x02\xef\x40\x97\x9d\x90\xec\x44\xbb\x25\x5d\xfb\x4d\x46\x41\x5e\xad\x88\x57\x9a\x3d\x6a\xd6\xa8\x11\x20\x72\x4d\x8f\xca\xcc\x8a\xc7\xcf\x9f\xb4\x17\x44\x9d\x5a\xe8\x44\x30\x59\xee\xd7\xd8\x9d\x91\x9e\x8b\xbd\xf5\xf4\xfd\x0b\x0f\xce\x0b\xd7\x3a\xbb\x06\xc4\x74\xb8\xbd\x88\x0b\x91\x29\xbd\xf7\xf5\xbb\x3e\x4a\xa6\x21\x21\xb1\x1c\xb2\x53\x57\x2a\xe9\x61\x31\xd2\xef\xc9\x3f\x90\xfc\x73\x87\xac\x51\x68\xc4\x1b\x1b\xc5\x64\xbb\x01\x0b\x7a\x19\x48\xb1\x84\xf7\xbe\x99\xcc\xd0\x69\x14\x9d\x3f\xe1\xce\xbf\x58\x2e\x97\x51\x5f\x99\x75\xce\x89\x2a\x9d\xc9\x94\x4c\x5c\x0a\x66\xe1\x7f\x49\xfc\xff\xa5\xdd\x07\xdb\x8f\x06\xa8\x8b\xea\x97\x98\xc4\xbe\x06\xdf\x7f\xfe\x2c\xf2\x02\x6c\x18\x49\x0b\x89\x16\x64\xad\xf6\xeb\x77\xbd\xdf\x7b\x24\x4e\x1b\xef\x08\xe8\x74\x90\x14\xa4\xe9\xfa\x14\x2f\xa7\x96\x2a\x0d\x1a\x3b\xae\xbd\xad\x0e\x17\x97\xb9\x64\x7e\x4b\x66\xcd\x4a\x05\xda\x66\x12\x93\x83\xe8\x3c\x3a\x0e\x3b\xfa\xb8\xbd\xab\xc9\xd6\x37\xc4\xf2\x51\xc0\x8e\x63\xe7\x86\x67\xd6\x01\x04\xad\x62\x87\x24\x31\x76\xba\x5e\x98\x93\x19\x01\x91\xcd\x4d\x3e\x80\x5d\x6e\xe0\x21\xa4\x03\x49\x72\xd2\x11\xe6\x9e\x0b\xfd\xb6\xc7\x2d\x51\xb2\x93\x65\xd4\x75\x33\x61\xbd\x68\xa1\x53\x1b\xe2\x51\xbc\x4b\x98\x7d\x79\x08\xcd\x19\xf0\xc0\xb6\x90\x58\x89\x98\x60\x06\xd1\x73\x8b\x8f\x0b\x5d\x12\x80\x26\x79\xbc\x45\x66\xb8\x64\x34\x22\x83\x72\xfd\x95\x92\x08\xe1\x7f\x66\x24\xbd\x61\x31\x23\x23\xef\x6b\x89\xc0\x68\xc1\xb4\x2c\x31\x9d\x74\xf3\x93\xe6\x34\x11\xe0\x6c\xc6\x0d\x95\x8f\x46\x9c\xf2\x85\x1e\xcf\x63\xd8\x85\xa3\xc5\x42\xbf\xef\x3d\x66\x4d\x3a\xb3\x1e\xd5\x77\x42\x1c\x02\xed\xde\x8b\xca\x62\xa1\xdf\x31\xb1\x72\x17\xb2\xd8\x88\xc6\x62\xa1\xcf\x7b\x8f\x01\x9c\xba\xa6\x05\x85\x25\x67\x9c\x57\x76\x40\x2b\x43\x0f\x7f\x9e\x75\xca\xc0\xe9\x42\x30\x2e\x4f\x42\x9c\x5d\xb6\x7e\xf1\x42\x4c\x7c\xb1\xd0\xaf\xd9\x37\x84\x12\x67\xc5\x2d\x5e\x33\xf3\x5d\x76\xee\x3b\x2f\xe9\x93\x45\xbc\x1d\x6b\xd4\xe8\xa6\x49\x0b\xef\x9a\x14\xab\xad\x92\x6e\xf5\x34\x62\xeb\x5a\x17\x96\xe1\xdd\xd4\x0f\xa2\xb0\x17\xac\xb5\x0d\x84\x82\xe3\x2c\xc3\xf2\xd4\x44\x4e\x00\x5d\x83\x74\xcb\x83\x52\x91\xc8\x4a\xe5\xd0\x9f\x40\xd9\x52\x59\x44\x57\xf3\xbe\xc2\xef\x78\xae\x9a\x3c\x2d\x4a\x99\x17\x47\xa9\x1b\xb8\xb1\xc1\xac\x06\x46\xf1\x4f\xb7\x47\xbc\x6c\xdd\x8c\x7f\x38\xf4\x47\x97\x2c\x8a\x90\x0d\xdf\x17\x46\xd7\xed\x79\xe5\xa1\xe3\x46\x30\xe0\x0e\xce\x72\x86\x45\x8b\xba\x84\x2f\x2a\xd4\xed\x00\x46\xaf\xd7\x0b\x92\xfd\x2c\xaf\xf4\xf3\xa7\x5f\xf4\x70\xf5\x25\xea\xdf\xd8\x28\x5a\xf8\x2d\x3f\x77\x51\xd2\xdd\x72\x00\x6f\x61\x10\xc2\x22\xd2\xf2\xd7\xef\xba\x89\xfe\x0a\xa4\x8b\x85\xc4\xcb\xc2\x49\x78\xcc\x99\x7b\xba\xdf\x03\x68\xde\xee\x72\xe7\xf0\xfd\xfc\xe9\xff\xe9\xc4\xa7\xea\x75\xcc\xf8\xd7\x82\xce\x67\xdd\x24\x2d\xa7\x17\x74\xe3\xad\x0f\x68\xde\xd2\x1d\xe3\x8e\xab\x5b\xf8\x4c\x1a\xfa\x68\xea\xf8\x26\x02\xfa\xe4\x2c\x52\x33\xa9\x90\x25\xc5\xf5\xac\xe0\x2f\x7b\xa9\x37\xc8\xbc\xc1\x49\x5e\x8c\x24\x1e\x3b\xa4\x1d\xf0\x3f\x3d\xdd\xe5\xde\xf9\x4c\xba\xe9\xcc\x4b\x88\x3e\x7d\x09\xd1\x2b\xe9\x6e\xaf\xd2\x75\xff\xe9\x75\xdd\x97\x2f\x4b\xfe\x57\x97\x7d\x09\xd1\xc5\x53\xfc\xc4\xa7\xc7\xb3\x80\xd2\x63\xb1\xa2\xfb\x5f\x7d\xca\x04\x8c\xef\xa5\x62\x69\x91\x34\x43\xbf\x6c\xda\x36\xf2\x28\xdd\x05\xf3\x16\x60\xc4\x18\x7a\x00\xed\x99\x05\x85\x35\xfc\xd8\xcd\x2f\xfb\xde\x2e\xaa\x91\xe4\xb8\x36\x58\x4c\x64\xba\xa7\xd7\xf4\xb0\x54\xd2\xa3\x85\xfe\xf5\x3b\xa3\x1d\x6f\x2d\x7e\x72\x28\x91\x90\x00\x11\xea\x9e\x5e\xd7\x67\x92\x14\xfc\xcc\xca\x30\x59\x42\xee\xc1\x05\x79\xd1\x3a\xde\x7f\x46\x2d\xcd\x2b\xc6\xaf\x8b\xd0\x86\x97\x74\x04\x66\xa9\x08\x59\x7b\xf2\x74\xb7\xf4\x18\xe1\x69\x05\xdb\x22\xe0\xcf\x9f\xc1\x9f\x2e\x9e\x92\x74\xf0\x7b\x87\x0b\xdd\x13\xba\x01\x0f\x55\x44\x58\xc6\x77\x71\x14\xe3\x15\x89\x88\x99\x30\xf9\x45\x37\xae\x6b\xce\x80\x13\xa3\x61\xec\x24\x44\xf3\xf5\xa2\xfb\x15\x7e\x2f\xe1\x97\x76\x49\x75\xc7\xfd\x48\x3a\x89\x43\xd3\x95\x89\x7f\x0b\x09\xb4\xb4\xe8\xa2\x7f\x8b\x9e\x1e\xa1\xb9\x13\xfa\x2f\xc9\xa2\x83\xd3\x0a\x50\xf1\x66\x5c\x26\x51\xd7\xcb\x66\xd2\x94\x64\x32\xb1\x6c\xce\x98\x67\x38\x93\xe6\xd2\x4c\xce\xe2\x4c\x9a\x34\x93\xe8\xdf\x22\xca\x0c\xd2\xf6\x02\xfd\x97\x64\xd3\xca\xbc\x0b\xad\xfb\x49\x6b\xea\x7a\xff\xf4\xbb\x2f\x5f\x7a\x25\x34\x59\x2f\xfb\xde\x01\xea\x46\x36\x36\xd8\x27\xba\x65\x8f\xe6\x93\x51\x69\x41\x97\x3c\xe1\xbc\x60\xea\x33\xf2\x6f\xdc\x05\x05\x33\x62\xc2\x7b\xbe\x7f\x1d\x76\x02\x3d\x98\x51\x8b\x23\x02\x07\x09\x30\x23\x79\xb6\x16\x0b\xdc\xf9\x24\x2f\x11\x55\x2b\x68\xd4\x62\x7a\x00\x76\x87\xb4\xeb\xc7\xdb\xaa\x64\x9a\x35\x46\x1c\xa8\x0f\xc4\x4f\xa6\x6d\xf7\xa4\x03\x0e\x86\x25\x1d\x68\x3c\x1d\x45\xaa\xcb\xc1\xf7\xe2\x78\xe2\xc8\xc1\xb4\xc0\x17\xbd\x1e\x5c\xc4\x8c\x63\x33\x94\xd0\xe1\x9e\x3d\xa6\x4a\xfb\x77\x1a\x07\x1b\x8c\xe4\x35\xe0\x28\x29\xda\x62\x9d\x83\xd1\x69\xbf\x0c\x7b\xbd\x28\x1d\xd8\x92\xf5\x0f\xf8\xf3\x27\xdd\xdf\x8a\x03\x0d\x14\xc8\xcf\x90\x61\x29\x6e\xe1\xbc\x3c\x2a\xa1\x51\x98\xd8\x4b\x0f\x95\x28\x5e\x00\x80\x65\x34\xfe\xdd\xb3\x4b\x07\x69\x2f\xe4\x27\x3e\x32\x5e\x9b\x49\x67\xe8\x3d\x66\x89\xec\x4f\x6d\xe6\x91\x35\x43\x5b\xeb\x84\x28\x07\x8c\xa1\x92\x37\x4c\x9c\x61\x71\xc5\x77\x4a\xbc\x12\x02\x73\xeb\x6f\x58\x27\x44\x82\xea\x02\x49\xe2\xf2\xe3\xb1\x14\x0f\x65\x2b\xd1\x23\xed\x73\xba\x7b\xe1\xd3\x36\x81\x48\x53\xd6\x58\xf7\xf1\xdb\x1b\xf4\x8d\x6e\x58\xea\xf5\x7a\xf4\x77\x94\xf5\x76\x08\x58\x7b\xa5\x61\x8b\xb1\xc6\x66\xf0\x26\x2a\x56\x50\x1f\x10\xf5\xb4\x57\xda\xcb\xa8\xa4\x93\x40\x18\x07\xc2\x64\x68\xc2\x6f\x8d\xbc\x44\xb0\x18\x94\x30\x44\x1c\x28\xa2\xa0\x08\x29\x47\xf8\x96\x06\x77\xfb\xf1\x5b\x2b\x3d\xa8\x07\xa8\x69\x51\x47\x03\x79\x57\xc9\xf4\xc7\xfc\xa7\xd3\x7d\xf9\xd2\x8c\xf7\x3e\x22\xb2\xf7\x11\x62\xcf\xef\xe7\x4f\x4d\xc3\x75\xfd\x0a\x55\xf3\x8c\x2b\x07\x47\x5a\xd2\xe9\xd7\x2f\xfe\xc6\x06\xdd\x87\xb0\xe9\x27\x2a\x45\xab\x6c\x95\x8a\x33\xdd\xc7\x56\x63\xff\x59\xf4\x7b\x36\x6a\xb1\xa6\xe3\x39\xde\xe8\xd8\x8c\xc6\x9e\x39\x21\x54\xf1\xbb\xfe\x81\x6e\x13\x57\x12\xcd\xe9\xc2\x12\x4e\x21\x0e\x73\xe3\xb0\x0b\xd3\x9d\xc1\xb0\x54\xea\xf8\x3d\xf2\x5e\x2b\xca\x62\xb2\x2c\x18\x64\x2d\x39\x6d\xeb\xb4\xda\xdc\xaf\xff\x3f\x7b\xd7\xde\xe4\xb8\x8d\xdc\xbf\x8a\xc5\x4b\x18\xc0\x82\xb9\xd2\xec\xcb\xa6\x0c\xab\x7c\x8e\x7d\x77\x15\x27\x76\x36\xde\xba\x3f\xb4\x8c\x8b\xa2\xa0\x21\x73\x14\xa0\x80\xd4\xfa\xa6\x24\x7e\xf7\x14\x1a\x0f\x82\x24\x34\x8f\x8d\x9d\xec\x55\xf2\xcf\x8c\xf8\x02\xc9\x46\xa3\xfb\xd7\x4f\xb6\x99\x37\x8b\x5c\x49\xde\xce\x95\x73\xcc\x96\x93\xd2\x9f\x40\x60\x99\x52\x96\xba\x15\xa9\x57\xed\x58\x9f\x70\x22\x48\x65\x11\xac\x46\xd4\xf0\xc6\x0d\x85\x06\x10\x7d\xaa\x87\x26\x21\x8f\x63\xa4\x67\xbd\x18\xd0\xbb\x00\xbe\x29\x30\x99\xcd\x90\x44\x05\xe1\x6e\x24\x7c\xb9\xcc\xda\x38\xae\x51\x01\x77\xc2\x71\x9c\xa3\x86\x70\x3d\x38\xc6\x20\x94\x2b\x97\x32\xb9\x5c\x60\xd2\x84\xc2\x4f\xff\x76\x77\xd8\x8a\x3a\x8e\xa3\x06\x7e\x8c\x0f\x24\x55\xcb\x64\xde\x0a\xb9\x0e\xc9\x59\xe3\xa4\xef\x42\x29\x40\xcc\xaf\xae\x9a\xdc\x6e\xe8\x38\xa3\xd4\xed\x9f\xd9\xdf\xfd\x42\x5e\xdb\x67\x4b\xdd\x0d\x9f\x6c\xef\x40\xf4\xc7\x19\x8f\x97\x8b\xb7\xd1\x8c\x00\x91\xe9\xaf\x78\xed\x64\x13\xb0\xd4\x29\x2d\xfe\x39\xfe\x76\xa3\xb8\xde\xc1\x17\x61\x65\x8f\x12\x6e\x16\x61\xe5\x0a\x61\x49\x1c\xc7\xf2\x6a\x31\x87\x79\x16\xa6\x31\x15\x18\x0d\x0a\x62\x8d\xa5\xce\xd0\x76\x33\xb6\x95\x0f\x29\x15\xfe\xd2\x94\x50\xd8\xc7\x68\xff\x5e\x55\x73\xf4\xfc\xe6\x43\x2c\x08\xe0\x71\xa9\xf5\x95\xf6\x82\x68\xdb\xc4\xd0\x73\x63\xfb\xac\xf4\x47\x00\xa2\xcf\xc0\xff\xf0\xcd\xf4\xfc\xcc\x40\x88\x59\x6f\x07\x92\x82\x9e\xbd\x74\x0b\x93\x65\x91\x43\x96\x5d\x07\x6b\x69\x38\x14\x2a\xc8\xd4\x05\x44\x67\xcc\x19\xb8\x7b\x45\xa4\x16\x93\xda\x9f\x74\x54\xd1\x0d\x23\x6d\x86\x57\x9a\xa2\x1d\x1e\xe5\x61\x9d\xd4\x5c\x35\x38\x8e\x9b\xab\x73\x25\xf5\x5c\xf9\xb7\x61\x18\x90\x26\xa9\xe9\x6c\x49\xaa\x49\xea\x06\xa9\x95\x80\x00\xe2\x55\x2e\x8b\x19\x57\x66\xce\xfd\x7d\x2e\xd8\xcb\xb4\xdf\xdb\x1d\x30\xe2\xe5\x0a\x89\x14\x82\xdc\xd1\xd1\x35\x53\x0a\x21\x46\xa6\x04\xda\x2c\x40\xe3\xfb\xef\xbf\xc3\x71\xbc\xbb\xfa\xfe\x55\xe8\xfd\x61\x14\x52\x19\xde\x1d\xf3\xc9\xe5\x32\xe1\x84\xb3\x4e\x69\xf6\x83\x18\x9a\x44\x83\x35\x5a\x29\x58\xae\x11\xba\x98\x80\x3b\xf7\x48\xc6\xb7\xd1\xbf\x45\xa3\xde\x22\x04\xe2\x41\x52\x4b\x00\xe1\x66\x62\x86\x06\x99\x62\xa2\xcb\x85\xdb\xe5\xbc\x51\x27\x3a\xd5\x34\x04\x59\x1c\xaf\x79\x0a\xb1\xb2\x0a\xc1\x72\xd3\xa1\x6b\x6d\x18\x8d\x09\x6f\x1c\xff\xea\xd0\x96\x0a\xc7\x15\x47\xbc\xda\xc6\xf1\x71\x68\x35\x6c\x2d\x11\xd1\xb8\x7e\xd6\x03\x6b\x53\x83\x72\x60\x46\xf1\x38\xe6\x1b\xe1\x8a\xf5\x46\xb0\x51\xe9\xc5\xb5\xfa\xab\x71\xb6\xc4\xa9\xda\xa0\x2d\xe0\xb7\xf5\x46\x6d\x10\x99\xa5\x92\xb0\x4e\x89\xa4\x2b\x6a\x52\xfb\x08\x83\x0a\xb1\x26\x15\x31\x53\x7c\x32\x2a\xf1\x3e\xa8\xc1\x69\xdb\xab\xcd\x5a\xfb\x49\x3c\xcc\xc1\x01\x73\xb4\xf2\xee\xac\x13\xe8\x5d\x16\x21\xe0\x0e\x8e\x57\xfb\x35\xe2\x74\x1f\x00\x1d\x43\x60\xb1\x1f\x80\x8e\xca\x3b\x56\xd9\x63\x0e\x74\x70\x00\x1d\x5d\x91\x43\x5b\x76\x7c\x86\xaf\x36\xa8\xc7\x87\xe4\x22\xcb\xbd\x67\x93\x15\xa6\xd8\xc9\x2d\x47\xb5\xd8\x9d\xe3\x01\xe6\x54\x8b\x01\x45\x94\x89\xc8\x62\x58\x8d\xbc\xb2\xd0\x76\x8c\xe3\x8d\x5b\x6d\x85\x38\x3d\x1a\x79\x8f\x61\xd2\x0c\xcb\x70\x45\x7e\xe0\x96\x56\xf1\x91\x3e\xc3\x7e\x0e\x61\xd5\x68\xbe\x3b\xe2\x4e\xc1\x1e\x63\x5b\xcc\x28\x57\xcb\xb1\x18\xaf\xc5\x6d\xbf\x16\x41\xd6\x8b\x75\x83\x04\x4e\xe5\xba\x40\x92\xb4\x64\x9c\xdc\xd8\xa0\x16\xa7\x7c\x8d\x6c\x36\x68\x72\xe2\x4d\x59\xed\x95\xb1\x4f\xcc\x7d\x39\xc6\x69\x83\x94\x4a\x03\x01\xa8\x7f\x97\x86\xfd\x15\x35\xb6\x78\x55\xc6\xf1\x76\xc8\xfe\xa5\x79\xa1\x06\x8d\x4d\x62\xdf\xb9\x34\x76\x1d\x7d\xf5\x22\x8e\x9d\xd9\xd0\xbb\x8a\x5e\x64\x6b\x7f\x23\x05\x38\x3b\xb9\xf6\x65\xf0\xda\x97\xfe\xb5\x2f\xd5\xb5\x2b\x67\xaa\x08\x07\xe2\x7a\xce\x1d\x59\x01\x47\xb5\x3c\xa0\x9c\x4e\x1f\x4e\xc1\x2c\x70\x67\x77\x58\xc7\xc6\x1d\xc8\x23\xbe\xec\x62\x13\xd9\xc5\x49\x4e\x0a\x90\xe1\x60\xc3\x28\x7d\xec\x4b\x2f\xed\xc1\xbf\x5c\xda\xb5\xf6\x1e\xa4\xb9\xf3\xd0\x28\x75\x5f\xb8\xd2\x18\x05\x04\x4e\x1a\x08\x90\x9d\x81\x8b\x7b\x93\x57\xcf\xd1\x4b\x8c\xc9\x91\x7e\xf4\xf9\xf5\x1f\x5a\x0b\xe1\xb2\x9f\x15\xd5\xd7\x36\x1d\x57\xfa\xae\xf0\x90\x2f\xf8\x91\xf6\x26\xd3\x25\x12\x2c\xa3\xad\x36\x35\x08\xc7\x69\x5f\x20\x61\x9d\xb7\xe2\x81\xa7\xb7\x65\x60\x4b\x0c\x19\xd6\x1c\x09\xa8\xac\x40\x3a\x44\x90\x70\x54\xeb\x18\xc1\xf3\x17\x98\x14\x1f\xff\x5c\x91\x86\xe6\x93\x2a\x04\xaf\xe6\x6c\x9a\x6e\xdc\x17\xac\x8d\x2a\x0b\xaa\x24\x27\x05\x2c\xab\x3e\x97\xf8\x2c\x78\x7d\xf7\x75\xd1\x56\xef\xd9\x0f\x1c\x86\x85\x62\x3a\x97\x7d\xdb\x7c\x50\xf6\xed\x30\x83\x40\xd7\x33\x5e\x2e\x51\x9f\x3b\x70\x25\xfc\x01\x7d\x1a\xa0\x34\x8e\xed\x89\xa4\xa7\xc9\x8b\xff\xd9\x2b\x9b\x33\x91\xcc\x8d\x61\xc3\xa3\x62\x43\x53\xa8\x15\xe1\x6c\x18\x57\xb4\xd9\xc9\xdb\x64\xeb\x47\xf0\x06\xbb\x6f\x59\xfb\x67\x99\x1f\x8f\x6c\xf7\x27\xe3\xe8\x0f\x50\x95\x5f\x2e\xb9\xc9\xc6\x07\x2a\xfe\x32\xbc\xe2\x5a\xfa\xb7\xf6\xe9\x29\x89\xe5\x7d\x67\x05\xee\x7d\xb9\xf8\x19\x24\xb6\xec\x01\xfc\x2d\xb8\x2f\xce\x1b\xcd\x24\xf3\xa6\xd0\xba\xa5\x45\x9f\x3a\x2e\xfa\xec\x72\x05\xfc\x8c\xa1\x53\xd1\x72\x3c\xf9\xe6\xf5\x45\x9f\x95\xed\x00\xb2\x2b\x27\xc9\x7b\x90\xaf\x6c\xef\x2a\x91\x6c\x3f\x70\xfe\xb5\x63\x22\x50\xd6\x61\x12\x7a\xe8\x0a\xd8\xca\xf1\xa7\x1f\x99\xf6\x0a\x22\x51\x34\x57\xcc\x34\x8f\x70\x44\x64\x62\xa6\xc4\xb1\x0e\x65\x64\x8f\x30\x82\xa2\x8e\x91\x3b\x75\xb4\xc0\x97\x50\x38\x95\x70\xdd\x93\x01\x04\xc0\x09\xb6\x1b\x2d\xba\x5f\xbf\x86\x8a\xa9\x84\xa3\x9d\x29\x99\xba\xd1\x25\x53\x37\xcf\x31\x29\x3f\x7e\xc9\xf0\x28\x39\x78\x9e\xc6\xeb\x75\x43\x93\xc1\xb6\x9f\x24\x03\xdb\xde\x9a\x9b\x2d\x26\x01\xf6\xd9\xc2\x2e\xbe\xd9\xc2\x5b\x4d\xb3\x05\x81\xbe\xf4\xb3\x45\x47\x24\x3d\x73\x73\xb5\xa6\x8a\x39\x55\xbf\x2a\x3c\x43\x5e\xd7\x4c\xaa\x5f\x8e\x48\x7a\xa3\x6a\xef\x60\x88\x3a\xe4\x85\xe9\x9b\x63\x78\xa4\xd2\x7e\x90\xc6\x8b\xf3\x8e\x29\xa3\xa0\xa7\x2e\x41\xf6\x7a\xd2\xe8\x49\xca\x69\x70\x4c\x80\xb9\x0a\x05\xd6\x71\x8c\x72\x9a\x5b\xac\x7b\xdf\x03\x28\xc4\xe9\x77\x09\x59\xac\xaa\x2f\xad\x19\xb5\x9a\xcf\x2b\x0c\x81\x44\xb1\xc9\x37\x55\x96\x5d\x2e\xd2\xfe\x00\x73\x03\x7e\x63\xac\x70\x3a\xd3\x1b\xb4\xd5\xff\x7b\x18\xdd\x3d\x71\xf6\x81\xe9\x07\x5a\x10\xfc\x18\xc8\xac\x12\x83\x5a\x6e\xf0\xb8\xd6\xf0\xa5\x5e\x31\xcb\x1b\xb5\x64\x64\x58\xfd\xf4\x95\xc1\x36\xb1\xe1\xec\x55\x03\x7d\x27\xc5\x01\x12\xc7\x6c\x4d\xc7\x28\x93\x00\x72\xb3\x7a\xcb\x9d\xf6\xe2\x22\x78\x39\xb8\x08\x06\x92\xbb\x15\x69\x6d\xaa\xca\x93\xaa\x79\xc3\xfe\xf3\x54\x49\xb6\x23\xe0\xd4\x4b\x6b\x5b\x33\x08\xc9\x69\xfd\xa6\x89\x39\xa6\x4d\x52\xf4\xe5\x84\x4d\x52\x84\xc4\x76\xa5\xc5\xbc\x55\x84\xa7\xdf\x92\xe0\x8f\xa2\xb7\x2e\xaa\xfe\x35\x88\xed\x3c\xcf\x39\x9e\x12\x56\xe1\x38\x4d\x20\x97\x72\xd4\x28\xe0\xd0\x97\xeb\x34\xc9\x96\xf8\x3e\xbc\xb4\xd6\x65\x94\x03\xbf\x9e\xd9\xf9\x3f\x47\xd9\xa7\x12\xf5\x29\xf4\x2c\x92\x7c\x4a\x23\xcb\x7e\x1f\x0d\xa1\xae\xe2\xf6\xdf\xba\x86\xf9\x4a\x2e\x8b\xab\x80\x94\x7d\x89\x5b\xee\x97\xbe\x1d\xc1\x98\xdd\x44\xa5\xad\xdd\xd6\xf0\xad\x89\xfa\xf2\xfc\x28\xc3\x2b\x85\xb9\x2e\x17\x43\x0c\xc2\x95\x01\xee\x73\xf0\xd1\x56\xde\xfb\x16\x20\x27\xce\x23\xb7\xc5\x48\x62\xbc\xca\x69\xbe\xe6\x46\xc6\xd8\xd2\x4a\x94\xe3\x94\x07\x4a\x2e\x11\x26\x5b\x5d\xfd\x89\x72\x32\x4e\x14\xd6\x4a\xa3\x4f\xbf\x31\xbb\xaa\xde\xb1\x02\xb5\xa8\x5b\x22\xd5\x4d\xf7\x0a\x73\x49\x07\xb5\xaa\x61\xb1\xf1\x79\x52\x0d\x99\x6e\x89\x3d\xb5\xeb\x70\x07\x79\x04\x71\x3c\x79\x70\x41\xea\xe4\xcd\xb7\x3f\x7e\xff\xf5\x37\xdf\x62\x92\x2b\x5b\xd5\x25\xb9\xbc\x7a\x60\x5d\x3c\x7f\x65\xf0\xd0\x52\x9b\xb2\x2f\x35\x1c\x7a\xfe\x5c\xc1\xa1\x8f\x1e\xf5\x3c\xd9\xfd\x8e\xcf\xcd\x2f\x95\xfe\x3a\x60\x2e\x65\x7e\xf7\x1d\xf4\x48\xc0\xe7\x22\x6f\x58\x04\x0b\x21\x4a\x1f\xe8\x50\x06\x01\x2c\xca\xd7\x1b\x6d\xa6\x92\x68\x13\x11\x41\xa2\x2c\xca\x92\xff\x10\x15\x47\x51\x84\x53\xff\x58\x8d\x04\xfc\xcc\xa8\xfa\xcd\x09\xc3\xfd\x89\xdd\x0a\x6e\xbc\x95\x79\xf1\x17\xd6\x06\x6f\x3d\xbd\xb1\x1e\xdb\xbb\x47\x78\x64\xdb\x97\xf0\x83\xc6\x0c\x8d\x38\xec\x6f\x68\x4c\x99\xd5\xd3\xe9\x69\xd2\x3f\x20\xc6\xf0\xec\xdd\x06\xbd\xdb\x7d\x8a\xdf\x65\x7f\xf7\x2c\x61\x7f\x65\x05\x62\x98\x30\x25\x21\x74\x26\x21\x7a\xf6\x6e\xf3\x6e\xf7\xa9\x3a\x4c\xa2\x08\x93\x59\x3b\x88\x40\x88\x0d\xcb\x28\xc7\x9e\x2f\x69\xc3\x32\xa8\x84\x62\x19\x3d\x77\x98\xa8\x1f\x9b\x76\xb3\xcc\x32\xca\x1f\x22\x36\x1b\xcc\x73\x4b\x9f\xa1\x77\x9b\x77\x19\xbe\xe7\xc1\xec\x53\xb5\xeb\xc1\x03\xac\xfb\x67\xdb\xf0\x0c\xa7\xde\xa6\xf3\x92\xaa\x4d\x70\xea\xf9\xef\x71\x7d\xd2\x7a\xe4\xea\xee\x04\x21\x58\x9f\x18\xda\x5b\x82\x57\x7c\x78\x27\x0e\xfe\xf9\xc1\xf4\xd5\x03\xdf\x73\x9b\x30\x5e\x88\x1d\x5b\xeb\xc6\x3c\x45\xbb\xae\x10\xc3\xa9\xde\xf9\xf6\xcd\x9f\x9c\x76\x82\xbd\x03\x0f\xb6\x1b\x63\x9c\x4b\xb1\x66\x49\x23\x64\x8b\x70\x3a\x2d\x4e\x5a\xe7\x83\x02\x63\x86\xb1\x3e\x37\x18\x7e\xfe\x97\xd3\x61\xcb\x14\xbb\x7d\x66\x7e\xb5\xb8\xc3\xd7\x3e\x8f\xc7\x36\x6d\xd6\xa9\x67\xb4\xcd\x72\x3e\x7f\x6e\xda\x05\x3e\x57\x4a\x93\xfd\xb5\x95\x79\xd1\x86\x72\x5f\x18\x7c\xe6\xb7\x45\xd1\x3a\xc2\x9b\x65\x76\xb9\x44\x51\x47\x20\x63\xb3\x61\x74\x04\xa0\x68\x81\xce\x1e\xb3\xa7\x11\x17\x9c\xa9\xd3\xb1\x69\x50\x20\xe1\xd3\x78\xa3\xae\x93\x7e\x26\xff\xc4\xfa\x60\x6b\x91\x22\xc5\x5d\xad\xac\x0e\x08\xf7\x4c\xf6\xef\xe8\xdd\xfa\xf2\xbb\x4b\x8c\x81\xd1\xf0\x1a\xb9\x07\x8d\xa3\xb0\x7f\xcd\x96\xcc\xf4\x7c\x3a\x7f\x76\x4b\xa2\x4f\x22\x6c\xaf\xa4\x11\x86\x74\x52\xed\x62\x56\xf0\xa9\xf5\x3e\x26\x6c\xd6\x3b\x8d\x34\x6b\x7e\xb2\x58\xd9\x7c\x53\x4a\x69\xad\x0b\xc4\x77\x6c\xc2\x1a\xa0\x5d\x02\xfb\x25\x26\x35\x11\xb8\x73\x8d\x46\x60\xce\x85\x99\x72\x1c\x8a\xbc\x58\xc5\x21\x94\xc2\x30\xf3\xf3\x7b\x21\x6a\x96\x73\x28\x33\x9b\x70\x14\x9f\x94\xbb\x70\x1d\x92\xa1\x39\xe2\x26\x1e\xc3\x09\xeb\x48\x60\x46\x30\x4e\x85\x9a\x69\xd7\x37\x2b\x34\xdb\x7a\x29\x28\x83\x54\x2f\x10\x6d\x9a\xde\xc3\x01\x02\xf5\x69\xb3\x6c\x3d\xe4\x76\xfb\xe6\x03\x1e\xee\x13\x3d\x37\x02\xc2\xbd\x8e\xe4\xd2\xac\x71\x1d\xf1\x31\x02\xdb\xee\xfc\x44\x29\x97\x16\x4f\xb3\x99\x24\xb6\xd0\x64\x93\xf5\x3e\x96\x06\xbe\x64\x7d\x85\x6f\xac\xd3\x9e\xc5\xb1\x49\xba\x55\xc0\x82\x11\x6b\xb3\xc2\x87\x71\x73\xc3\x1e\x71\xe4\xb4\xb0\x7e\x84\x79\x44\xa3\x79\x8d\x24\x04\xe6\xae\x47\x17\x3f\x61\x8e\xd5\x3a\xdc\x8f\x95\x46\xd1\x43\x36\x6c\xc8\x9c\xef\x47\x0d\xc9\xaa\x7e\x09\x6c\x66\xff\x80\xf0\xa7\xd9\xb3\x5b\x32\xbd\x36\xfa\xfb\x68\xae\xc3\x0d\xdf\x88\x1d\x83\x90\x43\xdf\x8a\x72\xf9\x4a\x6d\xbc\x3d\x1e\x99\xfc\x26\x6f\x98\xf9\xe0\xef\x07\xf8\x40\xef\x6d\x1b\xdc\x7a\x87\xa8\xc9\xc8\x96\x1f\x3f\xf8\x02\xbb\xeb\x05\xe0\x4b\xe1\xa3\x4b\x61\xb1\xe5\x72\xa9\xb1\xe5\x2b\x0d\x2d\x6f\x5e\x0e\xdb\xb4\xbe\xc2\xe4\x18\x96\xc5\xf7\xb1\x0f\x18\xf1\xdd\x15\xc1\xd1\xeb\x01\xb5\xda\x32\x25\xe4\xa0\xc7\x06\xeb\xc8\x59\xc7\x8b\x47\xae\x58\x36\x0d\x60\x2d\x82\x01\xac\x85\x1f\xc0\x5a\x64\xe9\xb9\x5b\x4d\x75\x1e\x3d\x33\xde\xca\x8a\x35\x29\xeb\x52\x2b\xe4\xfd\xaa\x5c\xe4\x9d\x02\x99\xff\xa6\x51\xcc\xe4\xa9\xca\xcd\x21\x23\x2d\x45\x0b\x62\xdb\xaa\xfc\x98\xb7\xa5\x32\xd0\x09\xb7\x49\x1e\xc2\x9a\x1d\x4c\xbd\x2c\x84\x7e\x99\x6e\xb3\x4b\xdf\x23\x8e\x6d\xca\x30\x8c\x01\x8a\x4c\x0f\xe1\x24\x13\x5a\x90\x62\x64\x49\x60\x24\x95\x85\x52\x93\xb3\xf6\x95\x88\x0e\x13\x73\x37\x8e\x3b\xc2\xe9\x54\xd3\x1c\xe6\xcc\xd5\xa7\x7d\x45\x17\x71\xdc\x7e\x59\x1a\x52\x76\x44\x0c\x2e\xa8\xf6\x88\xc5\xb1\xfa\x89\xcf\x87\x39\x65\x36\x27\x08\xe1\xd5\xc9\xeb\x04\xf3\x93\xd0\x4f\x21\xc8\x39\x87\x6b\xd3\x7d\xf2\xe3\x0f\x3f\xea\x4f\xcc\xd7\x83\x11\x0f\x73\xba\x24\x07\x77\xc3\x38\x2e\xed\xe7\xfa\x0f\x98\x94\xee\xd3\xfc\x3f\x23\x4d\x18\xc3\x3d\xb8\xf3\x32\x6d\xd5\x30\x8a\xda\x94\x05\x4e\x3b\x29\xea\xed\xfa\x9e\xa7\x52\x87\xe9\xcf\x53\x23\x31\x6d\x89\xba\xdb\xf7\x9e\xc7\x5c\x4b\x1f\xb7\x27\x27\xb7\x42\x11\x14\x93\x2d\x65\xa4\xa4\xdb\xc4\x70\x02\x39\xd0\x6d\x52\xe8\xe1\x56\x13\xae\x29\xd7\x25\xdd\x94\x59\x3a\xe4\xb6\x12\x5f\x2e\xa8\xa4\x9b\xe8\x59\x94\x61\x52\xd2\xf2\x4a\x23\xca\xe0\x1c\x33\x08\xa7\x81\x26\x39\xac\x0f\xb4\x74\x79\x92\xe9\x01\x66\xb0\x27\xe8\xe5\x82\x16\xa4\xea\xdf\x7f\xb6\xb4\xfd\xe2\x8e\xa8\xc4\xe4\x67\x1a\x5c\x7e\xb7\x00\x44\x3b\xf2\x3e\xb4\xba\xd5\x41\x17\x3c\x04\x7a\x9e\xc8\xb9\xc8\xf9\x1f\x44\xca\x3b\xac\x2c\x3a\xdb\x13\x72\xfb\x80\xa4\x1d\x8b\xcd\x36\xa9\x85\x38\x7e\xdd\xdc\xf1\x62\xe2\xf2\xd5\x9c\xb6\x20\x92\xce\x96\x3a\xb3\x28\x87\xf4\xa2\x3e\x61\xca\x5b\x82\x56\x4e\x4e\x85\x03\x69\xa9\x5d\xed\x44\xd0\xc5\x4a\x7c\xc9\x56\x62\x3e\xc7\xad\x12\x8f\xbd\x7c\xd0\x2a\x5c\xd2\xd9\x62\x92\x31\xa5\xf0\xf9\x30\x46\xdf\xe2\x6e\x35\x73\x5a\xa3\x41\xba\xc2\x44\x82\xa3\x79\xb6\x20\xb3\xda\x64\x05\xd7\xea\x1d\xd5\x7e\xf1\xa5\x52\xd1\x2b\x0c\x6f\xd0\x22\x31\x9f\x93\x86\x14\x00\x02\xe0\xcd\xe4\x30\x33\x6f\x78\xb7\x0a\xaf\xc4\x57\xa0\xe3\xe3\x58\x3f\x21\x47\x6a\x61\xa1\x07\x15\xdb\xb5\x50\xea\xcd\x52\xbb\x18\xfe\x36\x9a\xc9\x81\xc7\xe0\xc9\x73\xad\xab\x20\x19\x94\x3f\xb6\x1b\xee\xcf\x35\xcf\x4c\x6b\xce\xf6\x5a\x2f\x58\x5b\x46\x3c\x68\x4f\xd9\x83\x24\x03\x6d\x95\xca\x7c\xdc\x10\x0e\xe3\x04\xc6\x68\x7e\x35\x05\x27\x86\x01\xbb\xd5\x7d\x6e\x84\xc2\x68\xe3\x37\xd5\x6d\x79\xc5\x8e\x6b\x21\x45\xbd\x23\x4c\xe7\x92\xf7\xd5\x44\xa1\xa4\xb8\xf0\x70\x03\xc7\x05\xf8\x29\x3a\x32\x8d\x6b\xd7\x49\x4e\x72\x23\xa6\x87\x8d\xa4\x1a\xc4\x86\xe7\x62\xdd\x59\xea\x91\x7c\xff\xf9\xe7\x23\xc6\x7f\xb1\xd4\xdc\xe4\xd7\xfe\xc8\x24\x7f\x4a\x73\x49\xab\x13\x7d\x70\xe8\xda\xca\x19\x6c\x70\xee\x56\x2e\xdb\x88\xe1\x51\x7d\xc0\xa3\x8b\x03\xe2\x18\x01\xe3\x32\xbf\x23\x45\x2f\x6b\x19\x69\x47\xde\x9d\x0f\x46\xae\xf5\xdf\x00\x72\xcd\x75\x84\xb8\xa2\xd2\x62\xd5\x1b\xe3\x07\xbd\x79\xad\xc0\xaa\xb0\x61\xe1\xcf\xbf\x50\x60\x55\xd8\xa0\xf0\xf2\xb5\x09\x0a\x43\x23\x4d\x39\xfa\x8c\xec\x7f\x1f\x52\x16\x49\x91\xf3\xb7\x0d\xfb\xc7\x1f\xfe\xf9\x9a\xea\x55\xb6\xfd\x5e\xc8\x82\xbd\x61\x7b\xc9\x9a\xf2\x72\x99\xa1\x05\x39\x26\xcd\xe9\x08\xe6\x91\x69\x7d\x8a\x91\x92\x5a\xed\x7a\x9f\x9e\xa0\xcd\xe0\x2d\x6b\xdf\x36\x20\x7d\xf6\x95\xb2\x59\x2b\xc1\x81\x9b\xa7\x48\x06\xe2\xe6\x3e\x96\x81\x56\xa3\x23\x34\xa3\x88\x93\xdc\x0a\xb2\xa5\xc3\x2f\xa9\xd6\xe8\x1c\xb8\x93\x32\xb1\xc3\xa8\x49\x0e\x51\x53\x3e\x41\x4d\x8d\x42\x4d\x3b\x85\x9a\x0e\x74\x41\x6e\xad\xbe\x1e\x23\x8f\x25\xa5\xf3\xf9\x21\x8e\xd1\x2d\x3d\x29\xf4\x26\xdb\xef\xa1\x07\x1f\x93\x68\x3b\x80\x96\xd8\x9a\xe8\xed\x7a\x6b\x7a\x0d\xfe\x9e\xed\x85\x64\x88\xe1\xd4\xee\xf1\xea\xd7\xbc\xc9\xe5\x08\x93\x05\xa5\x9f\x7d\x76\x88\xe3\x5b\xbf\x20\x12\x92\x37\xb7\xe4\xec\x0f\x17\xca\xed\xff\x19\x31\x32\x5b\xe0\x8e\xe8\x13\xaf\x9e\x02\x41\x1e\x1f\x09\x3d\xf4\x2d\xca\xd1\x32\x24\xed\x78\xba\x68\x3b\x98\x51\x1a\xea\x2c\xa9\x76\x9a\x04\xfd\xf1\xfc\x59\x43\xc3\x8a\xc2\x9b\xd7\xf8\x7a\x47\xee\xc0\xf5\x11\x39\xfb\xdf\x53\x59\x8c\x3f\xfa\xe9\x0a\x4b\x03\xd7\x76\xdd\xb5\xaf\x8a\xa8\x7b\x89\xc7\x0f\x2d\x3a\xaf\xc7\xb7\x32\x4d\x6b\x30\x4d\x57\x41\x5a\x4c\x46\x40\x0b\x22\x27\x40\xfa\x97\x8a\xef\xc4\x2f\x2e\x84\x85\xc1\x65\xe8\xd3\x39\x30\xc3\xa3\x6b\x92\x52\xb2\xbd\x5a\x44\xf5\xd8\xce\x9b\x2d\xbb\xc0\x44\x3e\x62\x44\xeb\xf6\x08\x0c\x0a\xa3\x3e\x52\xdf\x7d\x31\x06\x7a\xbf\x8a\xbe\xfb\x3f\xed\x10\xb1\x4a\x66\xf9\x5a\x7b\x44\xbe\x80\xbc\xd1\xa9\x89\xfc\x51\xc0\x01\xeb\xa7\x51\xba\xce\x7d\x4e\x27\xe0\x3f\x83\x1c\x74\x2f\x61\x77\xad\xb3\x73\x59\x47\x4a\x7a\x2e\xf3\xa6\xdc\xe6\xfc\x36\x35\x3e\x54\xc5\x88\x01\xb9\x17\xcd\x02\x83\xcc\x60\x10\xed\x53\x7e\xfc\x75\x49\x73\xda\x36\xc6\x7b\x87\x15\x33\x11\x2e\x9a\x3a\x6f\xca\x87\x1e\x61\xfa\x1e\xe3\xa1\xfc\x67\xd9\x76\x64\x3a\xea\x76\x78\x46\xf7\xbf\x02\x0e\x20\xe9\xe5\x9f\xd8\x1d\xf4\x92\x55\xf4\xff\xe9\xee\xc8\x56\xd3\x84\x27\x5d\x5e\xf9\xf3\x5f\x22\xeb\x0b\xe0\xe0\x42\x8a\xe0\xb5\xd4\x4e\xc5\x52\xe5\xe5\xe2\xed\x33\xb2\xa1\xdc\xf0\x8c\xd4\x74\x17\x04\x15\x79\xa0\xaa\x77\x17\x8a\xaa\x0b\xe8\x0c\x72\x0a\x49\xb4\xdd\x40\x8a\x42\xa8\x5d\x9d\xbb\x0f\x9f\x3b\x12\x91\xee\x74\xc0\x26\xc7\x81\xf3\x26\x84\x4d\xea\x6b\xd8\x24\x1f\x62\x93\xd3\x04\x9b\xec\x01\x9b\x28\xcd\x72\x2f\x3c\xe1\x03\x78\xb2\xbb\x17\x9e\xc0\xa3\x3b\x35\xf5\x64\x88\x22\xc7\x10\x25\xec\x83\x71\x40\xe4\xee\xea\xd1\x25\xee\x56\xe0\x39\xb4\xb8\xf2\x0f\xd0\xc3\x4f\x9c\xda\x37\xac\x16\xf9\xee\x6d\x53\xf1\xdb\x3f\xe6\x4d\x89\x11\x1e\x78\x73\xc6\x38\xe8\xbd\x45\x3b\x77\x8a\x5a\xfe\xed\xb6\xc9\xad\x40\x7d\x37\xee\x3f\x4a\xb6\x0f\xad\xcb\xdf\x45\x73\x91\xf4\x8b\x0c\x6d\x93\xfe\x7c\x9d\xb0\xf4\x5b\x63\xa5\xc1\x84\xfd\x3f\x76\x7a\xa1\xe0\xc0\xc0\x4b\x31\x52\x59\x1d\x92\x4a\xf9\x2d\x97\xd8\x34\xe2\x79\xad\x73\x70\x5f\x2c\xb4\x1a\x7c\x15\xf4\x53\x84\x80\x11\x34\xc7\xb6\xf9\x49\xd1\xef\xdc\x87\x09\xa1\x95\x4c\xbb\x8e\xa2\xd4\x17\xd2\xed\x7c\x79\x4d\xa2\x4c\x06\xcf\x9b\x92\x2a\x89\x1e\xf0\x68\x87\x1e\x64\xf8\x10\xd7\x10\x57\xf0\x4a\x1d\x74\x5b\x90\xf6\x2b\xba\x58\xb7\xe9\x02\xcf\x15\x4b\x33\x90\x67\xf7\x63\xcf\x41\xfa\x54\xaf\x59\x10\x34\xaa\x13\x14\x7c\xbb\xb7\xac\xfd\x57\x25\xf0\x75\xc0\x0a\x4a\xae\xbe\x93\xe2\xa0\x01\x1f\x27\xad\xc2\x70\x86\x03\x05\xc8\x77\xb8\x4a\x11\xec\xf8\xe0\x75\xa0\x63\x24\xcb\x77\xd0\xcf\x09\x23\x6b\x3c\xe5\x7a\x10\x2f\xa6\xc0\x9d\x0c\xc8\x4d\x0b\x40\x49\x3c\xf8\xd9\x23\xe6\xdc\x86\x13\x04\xee\xc8\xd1\x8a\xcb\x2d\x45\xe3\x55\x16\x76\xe0\x8e\x98\x46\xd0\x06\xe9\x58\xb6\x27\x1f\x74\xed\xbc\x98\x51\x2a\xf1\x0e\x49\xaf\xbd\x70\x4d\xf7\xe0\x44\x52\xc7\x8f\x71\x5c\xeb\xb0\xc9\x51\xfd\xa3\x94\xc2\xa6\x41\x5b\xab\x23\xad\x09\x43\xb5\x92\xa0\x12\x6e\x52\x0f\x6f\x22\x7b\xa1\x37\xa3\xb4\x8e\x63\x68\x88\x83\x16\x24\x4f\xf2\xdd\xee\xdb\xf7\x6a\x3e\xcd\xab\x58\x33\x41\x7f\xd9\x44\xf7\x26\x8a\x88\xc0\xd3\x6f\x93\xc0\xe5\xba\x3f\xc7\xa3\x46\x98\x20\x79\xbf\x5f\x90\x0e\x7c\xd5\x26\x24\x94\x0f\x1f\xdf\x0b\x0b\x38\xd3\x60\xe5\x50\x88\xf6\x3d\xc3\x39\xf9\x6e\x37\x66\x93\x9f\x84\xbe\x24\x87\x6e\x2b\x04\x98\xa4\xc9\xdf\x33\xc3\x24\x35\x91\x50\xf7\xc4\x88\x82\xbd\xba\x11\x64\xd8\x24\x1a\xd4\x60\x6e\xcd\x1b\xf8\xcb\xb1\x41\x58\xc7\xa3\x4f\xba\xff\xd3\xbd\xe6\xd0\xe3\x47\xdb\xe9\xd1\xba\x0c\xaf\xfe\x2b\x00\x00\xff\xff\xc0\x84\x45\x27\x5f\x3d\x04\x00")
+var _workJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xbd\x6b\x97\xdb\xb8\xb1\x28\xfa\xfd\xfe\x0a\x89\x7b\x0e\x07\x88\xd0\xb4\xd4\x9e\x27\x65\x8c\xe2\xb1\x7b\x32\x4e\xec\xe9\x8e\x1f\x33\xd9\x91\xb5\xfb\xb0\x49\x48\x62\xcc\x06\x14\x10\x6a\xbb\x23\xe9\xbf\xdf\x55\x78\x11\xa4\xd8\x6d\x3b\x3b\xfb\xac\xdc\xb3\xee\x9a\x59\x6e\x11\xcf\x42\xa1\x50\x28\x54\x15\x0a\xc3\xe5\x96\xe7\xaa\x14\x1c\x31\xbc\x73\xbf\x07\x0a\x09\xbc\x2b\x97\x88\xcf\xc5\x02\x4b\xa6\xb6\x92\x0f\xe0\x77\xc2\x3e\x6c\x84\x54\xf5\xf4\x26\x93\x03\x49\x21\x89\xee\xca\x54\x90\x2a\x1d\x4e\x88\xcd\x4c\x77\x87\xc3\xd4\x56\x62\x50\x29\xcf\xaa\x0a\x49\x57\x97\x48\xd2\xfc\x56\x98\xc8\xa4\xa2\xc3\x71\x93\x76\x80\xb6\x39\xdd\x1d\xa6\x2a\xb9\xa6\x8c\xa8\x24\xa7\x9c\xa8\xa4\xa0\x0d\xa8\x84\x13\x81\x77\x2a\x11\xf0\x13\xef\xf7\xe7\x57\x7f\x63\xb9\x4a\x0a\xb6\x2c\x39\xbb\x90\x62\xc3\xa4\xba\x85\x3c\xb2\xcb\x05\x5f\x96\xab\xad\xcc\xae\x2a\xa6\x81\xe4\xdb\x6b\x66\xbf\xc6\x64\xc5\x54\x2a\x0e\xf8\x40\x54\xc2\x83\xf6\xf1\xce\x00\xc1\xe2\x98\x25\x97\x97\xac\x7e\x21\x8a\x6d\xc5\x66\xbe\x04\xde\xb9\x01\x42\xa7\xd9\xb6\x52\x87\xb4\x27\xd3\xe3\x41\x25\x05\xe2\x24\xca\x22\xc2\x31\xe1\xd0\x9d\x08\x87\xa3\x7c\x15\x3b\x92\x8d\x14\x4a\xa8\xdb\x0d\x4b\xd6\x59\x7d\xfe\x9e\xbb\x31\x19\x5c\x42\x05\x68\x63\x43\xa3\x88\x28\xa4\x92\x9a\x4e\xc6\x5f\xe3\x03\x9a\x87\x6d\x12\x8e\x77\xcc\x61\x95\x72\xf4\xd5\x57\x18\xe1\x03\xf9\x7f\xba\x65\xa2\x6d\xcd\x06\xb5\x92\x65\xae\xa2\x69\xab\xc2\xc3\x8f\x16\x07\x34\xb5\x47\x02\x53\x43\x24\xa9\x48\x46\x4a\x4d\x43\x43\x8b\xcd\x7c\x5a\x2e\xd1\x8d\x28\x8b\xc1\x98\x52\xaa\x70\x4e\x39\x7b\x3f\x38\x93\x52\x48\x14\xbd\x28\x79\xb9\x2c\x59\x31\x60\x1f\x72\xb6\x81\xb6\x06\x22\xcf\xb7\x52\xb2\x62\x3a\x00\xf8\xd4\x9a\x0d\xb8\xe0\x27\xd7\xae\x60\xc1\x6e\x06\x8c\xdf\x94\x52\xf0\x6b\xc6\xd5\x60\x29\xa4\x2e\xb4\xdc\x56\xd5\x80\x41\xab\x83\x6b\x56\xd7\xd9\x8a\x0d\x32\x5e\x0c\xb2\xa2\x28\xa1\xd9\xac\x1a\xac\x59\xb5\x59\x6e\xab\xc1\xfb\x4c\xf2\x92\xaf\xea\x24\xc2\x53\x56\xd5\x4c\x43\x59\xd3\x79\x30\x80\x05\xd9\xd2\xf1\x34\x84\x54\x25\x92\x6d\xaa\x2c\x67\xe8\xc1\xff\xaa\x1f\xac\xc8\xf1\xa4\xd7\xf3\xed\x68\xb4\x38\x60\x4c\xf2\x84\x67\xd7\x8c\x46\xcf\xf8\x4d\x26\xcb\x8c\xab\xc1\xaf\xa5\xa8\x32\x28\x1e\x1d\xd4\x5a\x8a\xf7\x83\x3c\x59\xca\xec\x9a\xd5\xaf\xc5\x85\xd8\xd0\x09\xc9\x0f\x87\x60\x0a\xc4\xa7\xa1\x9f\xa3\x09\x26\x92\x72\xf4\xcd\x77\xb8\x85\x64\x81\x4d\x2f\x16\xc9\xb9\x64\x99\x62\x27\x92\x65\xb9\x3a\xc9\xab\xac\xae\x07\xb9\xd8\x56\xc5\x80\x0b\x35\x58\x96\xbc\xd0\x08\x7c\x09\xd9\x03\x61\xe8\x70\xf0\x6c\x39\xb8\x15\xdb\x41\x26\xd9\x60\x5b\x97\x7c\x35\xa8\x73\x59\x6e\xd4\x40\x65\xab\x9a\x0c\xae\xb3\x77\x6c\x50\x6f\x25\xcc\x4f\xa6\x6c\xd5\xb2\x1e\x5c\x31\x28\x5a\x89\xac\x60\xc5\xe0\x8a\x2d\x85\x64\x83\xe3\xde\x01\xf1\x30\x82\x8a\x22\x40\xb0\x48\x9e\x88\xeb\x8d\xe0\x8c\x2b\x9c\x6c\x37\x45\xa6\x98\x0c\x90\x21\x51\x50\x80\x88\xa4\xac\x7f\xcd\xaa\xb2\x38\xab\x18\xcc\x3f\xa9\x3e\x97\x56\xf1\xee\xb3\x50\xed\x32\x07\x02\x31\x3f\xd7\x7c\x5b\x55\x94\xb2\xfd\x7e\x9b\x64\x1d\x80\x10\xc3\x07\x5f\x47\x06\x75\xa0\xfe\x7e\xff\x58\xca\xec\x36\x29\x6b\xfd\x17\x31\x0c\x8c\x86\xdd\x30\x79\x8b\x44\x50\xaf\x6a\xf1\x86\x02\xed\x0e\x04\x12\x9a\x02\x99\xe3\x55\x8a\xb2\x04\xf8\x05\xe1\xb4\x42\xca\x31\x25\x60\x1b\x35\x61\xc9\x06\xfe\x6a\xda\xe0\x49\xbe\x2e\xab\x42\x32\x6e\xea\x09\x5a\x06\x69\x84\xe3\xa9\x48\x2a\xc6\x57\x6a\x1d\xc7\x2e\xe3\xa5\xd8\x2a\x56\x53\x81\x49\xc1\x2a\xa6\xd8\xa0\xa9\x70\xb0\xa0\xf1\x06\xa4\xd2\xc0\x0c\x8d\x73\x3a\x5f\x38\x0e\x08\x18\x7a\x62\x6b\x25\x4b\x21\xcf\xb2\x7c\x8d\x58\xb3\x7e\x98\x66\x16\xbd\x78\xc4\xe5\x12\x99\xd1\x25\x86\x88\x34\x40\x3f\x49\x71\xad\x09\xce\x16\x74\xe3\xf9\x78\x49\x0d\xe0\x54\xc4\x31\x4f\x36\xdb\x7a\x0d\x18\x87\xf5\x3f\xb0\x9f\x80\x53\x7c\xc0\x24\x18\x53\x1e\xcc\x1f\xcc\xe5\x8c\xd1\x12\x31\x9c\xb2\x38\x1e\x1e\xcf\x24\x62\x74\xce\x16\x98\xb0\x03\xec\x5d\x92\xa8\x24\xa3\x19\x51\xc9\x15\xcd\x35\x11\xd6\x66\xc5\x6e\x29\x4f\x38\xaa\x31\x29\xa8\xe5\xfa\x59\x5d\x97\x2b\xbe\xdf\x87\x48\x59\x0a\x89\xa0\x92\xa2\x93\xa9\x7a\x94\xc9\xd5\x16\x86\x50\xdb\x49\x9a\xaa\xd1\xc8\xe1\xda\xe7\xcd\xd5\x62\xea\xaa\x89\x41\xc9\x07\x1c\x7f\xd2\xb6\x02\x9b\x2a\x40\x0f\xbb\x3a\x6c\xed\xd8\xcd\x2e\x3b\x7c\x6c\x75\xa8\x60\x97\x84\xdd\x5c\x59\xfc\x5f\x64\x6a\x4d\x55\xb2\xc9\x64\xed\x7e\xaf\x98\xfa\xf3\x96\xc9\xdb\x57\x4a\x96\x7c\xf5\x6b\x56\x6d\xf5\x04\xd9\x5c\x68\x70\x73\x4f\x7e\x56\x14\xdd\xdc\xd7\x42\xe7\x19\xae\xe7\x59\xe2\x57\xc0\x12\x51\x88\xc9\xce\x66\x7e\x40\x02\x93\xfb\x1a\xec\x0e\xd8\xb4\x5c\x21\x06\x2d\x8b\x64\x93\xa9\x35\xf0\x77\x52\x52\x91\xd4\x2c\x93\xf9\x9a\xe4\x54\x00\x66\xd7\x8e\xe8\x33\xb4\x73\xc5\x52\x49\x4c\xa1\xb4\x1c\xa1\x93\x09\xa5\xb4\x4c\x4a\x5e\xb0\x0f\xe7\x4b\x14\xcd\x22\x3c\x8b\x66\x51\x1a\xc5\x11\x1e\xa9\x51\x44\xa3\x11\x27\xd0\x50\x9a\x1b\xe1\xe4\x7e\xb4\x84\x90\x3a\x6a\xd0\x70\x0a\xca\x1b\x38\x25\xe5\x0e\xce\x92\xf2\x3b\xe1\x14\x0e\x4e\xe9\xb7\x3a\xe0\xce\x2f\xd9\xea\xec\xc3\x06\x45\x68\x3e\x8b\x17\x38\xd2\x50\xce\xb3\x93\x7f\x3c\x3e\xf9\xeb\xf8\xe4\xfb\xc5\x08\xc5\x33\x1c\xe1\x60\x3d\x1b\xa4\x99\x0e\xa2\x59\x04\x7b\xfe\x4c\xa5\xfc\x80\xcd\xc0\x4a\x33\xb0\xfb\xa8\xe1\xde\x61\xd9\x91\xc0\x4c\x5c\x67\x2a\x5f\xb7\x80\x04\x18\x0d\x88\x28\x84\x11\x47\x18\xbb\x21\xcb\x38\x96\xf3\xc9\xe2\xd0\xe2\x40\x8e\x93\x9a\x16\x1f\xfc\x17\x5a\x2b\xb5\xa9\x67\x29\x9e\xbd\x7d\xf0\xf6\xc1\xfc\xbf\xde\x3e\x58\xfc\xee\x81\x6f\xc2\x70\x7f\x35\x63\x29\x4b\xea\xed\x15\xcc\x11\x5f\x21\x35\x1f\x2f\xec\xea\x04\x2e\x52\xb5\xc8\xff\xb8\x33\x60\x27\x84\x83\x20\x27\xe0\x1f\x28\xee\xc9\xe2\x3f\x22\x3c\x3d\x99\x0c\x29\xad\xe2\x18\x09\xaa\x82\x5e\x2a\x4c\x54\x2b\x61\x4c\x2a\x6c\xb6\xd3\x2c\x6c\x62\x16\x39\x70\x75\x43\x19\x70\xf5\x56\xbd\xec\xb8\xa1\x0c\x63\x12\xe9\x09\x8b\x63\xa4\x68\xf4\x20\xc2\xa4\xa1\x0f\xe5\xe8\xc3\x52\xa8\x38\x1c\x48\x46\x5b\x0b\x3e\x1c\x25\xec\x39\x6e\x97\x8c\x4c\x9f\x11\xa5\xc0\x83\xc4\x72\xc0\xdc\xe1\x82\x4d\x1d\xea\xaf\xb2\x9a\x01\x1d\x12\x4e\x59\x43\xbb\xc0\xd6\xfd\x8c\x6b\xa1\x78\x4d\x2a\x8a\xd4\x7e\x1f\x45\x78\xc4\xdd\x8c\x88\x38\x8e\x66\xd1\x90\x52\x11\xc7\xa8\x1a\xc1\x96\x25\xcd\x2f\x89\x49\xd5\xc7\xc5\x1c\xbb\xf8\x66\x82\xa7\x47\xe0\x41\x2b\x82\xce\xe7\x2c\x29\x89\x20\x51\xb4\x58\x60\x7b\xf2\xd9\xad\xaf\x65\x3a\x1c\x1f\xa6\x32\x51\x32\xe3\xf5\x52\xc8\x6b\xc7\x81\x38\x7a\x38\xc6\x48\x10\xa9\xf7\x52\x91\x67\x55\x0d\x5c\xb5\x11\x35\x6c\x62\x57\x60\xc1\xbb\x8f\x71\xd9\x86\xb7\x4d\x61\xeb\x10\x1f\x15\x79\x7a\x85\x16\xe6\xd7\xf5\x83\x79\xf2\xbb\xd1\xec\xbf\xbe\xd8\x1d\x10\xde\xcf\xdf\x2e\xde\xbe\x5d\x3c\x58\x91\xe8\xed\xdb\x2f\xe2\x28\x10\x32\x64\x7b\x1f\x8a\x22\x02\x1b\x3b\x91\xf0\x4f\x65\x47\x4d\x32\x3a\x26\x25\x7d\x90\xba\x05\x77\xf9\xc5\xa2\x59\x7a\x97\x5f\x2c\x7e\x87\xf7\x6f\x7f\xf7\xf6\x77\x7b\xf8\x1f\xed\xdf\xe2\xfd\xdb\xb7\x6f\x11\xfc\x83\x1f\xac\xa6\x15\x2d\x13\xf6\x81\xc1\x36\x3b\xc5\x95\xa1\x5e\x4b\xad\xd2\x6c\xca\x2c\xa9\xab\x32\x67\x28\x23\x36\x1b\x63\xa2\x46\x54\xf4\x65\x60\x52\xcd\x27\x8b\x19\x52\x23\x1a\xa1\xf9\x7f\x3d\x80\x85\x4f\xec\xe6\x0e\x39\x18\xa7\xd1\xef\x7e\x07\x04\x5e\xcd\xc7\xae\x5c\xf2\xbb\xa6\x50\x54\x6f\xaa\x4c\x45\xba\xdc\x51\xb1\x59\x6f\x39\xe4\xcb\xe9\x62\xb3\x34\x4a\x23\xdc\x4a\xc3\xc0\xdf\xdf\xbe\x6d\x17\x84\x6f\x48\xf5\x45\x61\xc5\xe9\x74\x60\xa6\x76\xec\xd0\x04\x26\x19\x2d\x93\x2a\xab\xd5\x33\x18\xa4\x23\xf8\x6c\x48\x29\xb3\xdc\xa6\x0f\x59\x2e\xef\x18\x5b\x4d\x8e\x59\xdc\x8a\x49\x9e\x32\x22\xd9\x8a\x7d\xd8\xbc\x12\x5b\x99\xc3\x52\xdf\x64\x32\xbb\xfe\x05\x4e\x28\x29\x27\x4a\xbc\x63\xbc\x4e\xe5\xa1\x21\x8d\x2a\xa0\xab\x62\xce\x16\xfb\x3d\x82\x3f\x14\x48\x06\x13\xf8\xd9\x94\xcd\x80\x44\xf1\x2e\x7a\x00\x0b\x94\x25\xf9\x3a\x93\x8f\x15\x1a\x63\x58\x1c\xc0\x64\x46\x0c\x4f\x3b\x4c\x3e\x84\x06\x4e\x38\x49\x03\x0f\xc9\x28\x4f\x0c\x44\xd3\x4e\x93\x6e\x68\x27\x13\x68\x5b\x8c\x68\xf4\x60\x16\x61\x12\xe9\x29\xcf\xe6\x99\xcf\x5e\xd8\xec\x2f\xec\x41\xa4\xa4\xaa\x67\x33\xf9\xaf\x68\x24\x48\x54\xc2\xde\xe1\x99\x59\xe9\x18\x17\xec\x01\xba\x6e\x4e\xcb\xf9\x78\x41\x6a\xcf\x4b\x51\x6e\xfb\xd1\xd5\x6a\xcd\x09\x0d\xa0\xb9\x03\xd4\x95\x38\x99\xe0\x56\x7b\xb5\x46\x47\x6d\xc5\xaf\x9d\x64\xd7\x59\x09\x47\xd5\x0b\xc7\x83\xeb\x70\x62\xa4\xf9\xd0\x7b\x67\x9d\x96\x76\x86\x27\x38\xb9\xce\x36\x2d\x01\xc8\x76\xc1\xe2\xb8\x60\xb9\x28\xd8\x9b\x97\xcf\xfc\x81\x0a\x8e\x2b\x38\x98\xd8\x32\x98\x58\x98\x8e\x00\xf3\x4d\xa1\xdc\xcc\xa8\xa2\x6a\xbf\xdf\x1d\xbc\xcc\x19\x4c\xa0\x99\x20\x22\xe9\x98\x64\xb0\xbd\x95\x74\x4c\x72\xe0\x1b\xb5\xe3\x1b\x85\xfb\xb1\x74\x3f\x36\x74\x4c\xae\xa8\x3b\x86\x4c\x37\x8f\xae\xa6\xa3\xd1\x06\x0e\x01\x66\x06\x51\x4d\xc5\x7c\xb3\xc0\xfb\xbd\x5d\xc5\x35\x5e\xd2\xb6\x14\xae\x12\xbd\x82\xf1\xcc\xfe\x98\x97\xa3\xd1\x22\xb5\x1f\x04\x70\x3c\xa4\xcb\xfd\x5e\xfe\x30\xde\xef\xb7\x08\xa3\xe1\x04\xbb\xd4\x38\x46\xd9\x88\x32\x6e\x11\x84\x96\xd8\x68\x07\x06\xd0\xbf\x5e\xbc\x35\xce\xe7\x72\x01\xa3\x91\x23\x3a\x69\x32\xb1\xc9\xd4\xf2\xd7\x9a\xe6\xc9\x46\x6c\x10\x9e\xca\x13\x3a\x21\x72\x96\xcf\xe5\xc9\x64\x31\xa2\xeb\x34\x1b\xd1\xf5\xc1\x57\xb2\x0c\xa1\xc6\x19\xb0\x8d\xa8\x69\xcd\xf2\x04\x93\x81\x83\x8c\x54\x77\xe3\x48\x68\x8c\x01\x31\x05\xad\x83\x1d\x7c\x82\xc9\x92\xaa\x79\xb1\xb8\x67\xa4\x94\x2e\x35\x45\x4a\xbc\x33\xa0\xd1\x28\xf2\x13\x78\x4d\x85\x97\x20\x6a\x4c\x56\x20\xe0\x6a\xa2\xba\x26\x6e\x5a\x30\xb9\xa4\x27\x13\x72\x43\xc7\xd3\x9b\x47\x2b\x37\x57\x37\xa3\x11\x76\xa8\x58\xcd\x6f\x16\x78\x77\x49\x6f\xa6\x57\x92\x65\xef\x0e\x97\x21\x08\x1b\x7a\x3d\xba\x3c\x99\x1c\x0c\x22\x1a\xf4\x30\x7e\x44\x98\x4b\x9c\x66\x77\x64\x4c\x3b\xb5\x6b\x28\x59\x3b\xde\x28\x1f\xd1\xa0\xc7\xac\xd9\xf5\xde\x3e\x18\xc1\x36\xf7\x20\xc2\xfa\x80\x66\x8e\x66\x25\x9c\x05\x82\x03\xda\x69\xdf\x01\xcd\xc8\x38\x9a\x07\xfc\x93\x7b\x6f\x47\xa1\xc8\xd2\x9d\x3d\xaa\xa7\xec\x70\x38\x3e\x45\x81\xa4\x00\x3d\xd4\x8f\x25\x3b\xfb\xfb\x36\x03\xf1\xb0\x56\x99\x62\x61\x82\x81\xea\xb9\x2d\xea\x13\xf4\xb1\xc6\x2e\x2a\x2b\xb6\x44\x0e\xa8\x46\xc6\x79\x75\x7b\x7d\x25\xaa\x38\x8e\x6a\xfd\xa3\x9b\x91\x94\x8a\xc9\x4c\x09\xd9\xe8\x3e\x9b\xd1\xd8\x92\xec\x90\xf6\x64\xb2\x38\xbe\xa7\x3b\x96\xe4\x82\xd7\x4a\x6e\x73\x25\x24\xa5\xd4\xa7\x0f\xdd\xef\xe6\xb0\x3a\x73\xb0\xa5\xbe\x43\x52\xfd\xfb\x9f\x99\x61\x87\x42\xa7\x18\xce\x87\x28\xc3\x24\x37\x87\xd2\x9a\x22\x81\x72\x4c\x38\xfa\x06\x6b\x12\x43\x93\x6f\x40\x05\x80\xda\xd3\xd6\x83\xd0\x0a\xf5\x50\x21\x61\xfa\x18\xd5\x21\x01\x5f\xdb\x8c\x93\xd1\x2e\x0e\x7e\x18\xc7\xb1\xa1\x8c\x21\x6d\x32\x41\x2a\x09\x3f\xd2\xe8\x41\x44\xd4\x71\xe5\x49\x6f\xe5\x49\x58\x79\xb2\x48\xb7\xc9\xc5\xf9\x05\xe1\xc7\xd5\x4f\x7b\xab\x9f\x86\xd5\x4f\x17\x29\x0c\x8f\x08\x7a\x7c\x66\x98\xa1\x31\xa9\x9b\x33\x15\xd6\x8a\x18\xbb\xe8\x83\x63\xac\x3f\x3c\xec\xf7\x30\x0c\x7b\x68\x71\x47\x75\x38\x38\xd8\xf3\x0b\xcc\xab\xf9\xd6\x2b\x2b\x15\x89\xfe\x4b\x32\x4d\xba\xa9\x22\xef\xd8\x6d\xca\x0f\xed\x93\xa2\x3d\xd7\xce\x8d\x9e\x74\xf0\x34\x53\x6c\x01\x8c\xf9\x88\x68\x94\x30\x47\x5b\xab\xb9\x87\x23\xe1\xf2\x78\x11\xbb\xa6\x07\x0c\x69\xb1\xbf\x5c\x22\x45\x29\xe5\x56\x2c\x18\x3a\xbd\x46\xa3\x3f\x9f\x45\x5b\x6e\xec\x1d\x45\x94\x4a\xa4\xb4\x94\x21\x86\x94\x36\xea\x5f\xde\x29\xc3\xb1\x13\x33\x86\x13\x28\x1d\x2e\x4f\x7d\x62\x1a\x93\xd2\x69\x0e\x0d\x9b\x8e\xcc\xf0\x60\x60\xc6\x20\x54\x20\x85\xe3\xb8\x40\x1c\xf7\x15\xef\xe8\xc2\x14\x36\xe4\xe7\xd7\xea\x3b\x76\x5b\x23\x05\xa2\x6c\x98\xc0\xfd\x81\xba\xb2\x14\x02\x62\x9a\xfd\x19\xc7\x95\xd5\x8b\x3a\x60\x91\xf0\x2b\x82\x21\x35\x17\x0b\x62\xd6\x9d\x5f\x79\x6d\x20\x00\x52\xd5\xb4\xcb\x7d\xbb\xaa\xdb\xae\x22\xad\x96\x3b\xcd\x0e\x27\x87\x69\x1f\x3f\xee\xb5\xe2\x30\x18\x19\xcc\x12\xfc\x05\x96\xef\x68\x51\xa7\xb9\x0f\xc8\x30\xd4\xa8\x93\xcd\x4f\x48\x04\x82\xd4\x49\xf0\x23\x8e\x41\xfb\x69\x48\xd2\xd2\x0d\x3e\x7c\xce\xe6\x43\x1c\x45\xb1\xb9\x6a\xac\x79\x8d\xed\xe5\x51\x34\xe2\xa3\x2f\x7f\x18\xd4\x6b\x6f\x0c\x58\x67\x37\x6c\x90\x0d\xa2\x2f\x47\x6a\xf4\x65\x34\x00\xf5\xf1\x97\x66\xb3\x14\x84\x27\x05\x52\xda\x94\xe5\x21\xf0\xe3\xae\x40\x6d\x6a\xf2\xaf\xfa\xf2\xb3\x26\xbf\xe8\xcb\xcf\x0f\xee\x80\xcd\xd1\x18\x74\x27\x08\x76\x60\x89\x89\xa1\x17\x24\x93\x7a\x9d\x6d\x18\x46\xbb\xaa\xac\x15\xe3\xa9\x4c\xa0\x91\xa4\xac\x5f\xb2\xbf\x6f\x4b\xc9\x0a\x02\x27\xa6\x9e\x64\xbb\xf9\xf7\xe4\xac\x44\x6f\xe2\x8f\x59\xfe\xae\x37\xe3\x27\x21\xdf\x67\xb2\x38\xce\x3b\x04\x60\x0a\xce\xce\x97\xaf\x6f\x01\xd4\xb9\x29\x48\x64\x62\x38\xd9\x02\x37\x0b\xa0\x53\xb2\x22\x32\x31\x2b\x6e\x01\xdb\xc6\x1d\xad\x99\x12\x60\x30\x35\xea\xec\x05\xec\x2d\xbd\x65\xcb\x06\xa0\x0c\x16\xc5\xf9\x12\xa3\x12\x2f\x3e\x53\x74\xf1\x87\x1e\x50\xe1\x78\xe6\xc2\xac\x6d\x09\xd4\x6e\xd0\x9d\x25\x26\xbb\xb0\xcd\xae\x3c\xc8\x33\x0e\xb6\xa5\x2b\x36\x00\xee\xc7\x8a\xc1\xfb\x52\xad\x07\xc0\xd9\x07\x42\x0e\x1a\xe6\xe4\x39\x80\x85\x97\x61\x6d\x05\x96\x76\x58\xc9\x8a\xa9\x60\xe7\x35\xf2\x41\x4d\xaa\x63\x6e\xdb\xde\xa2\x49\x76\x5c\x62\x63\xf3\x9e\xd5\x67\xde\x12\x1c\xd8\x81\x3c\x5e\xf0\x4e\xc9\x5b\x60\x79\x43\xdb\x84\x19\x52\xc3\x40\x01\x40\xa6\x2d\x83\x86\xc1\xa3\x28\xbb\xca\x23\xcd\x86\xd9\xfc\xeb\x05\x8d\x0a\x16\x91\xe8\xeb\x60\x53\x68\x8f\x42\x9f\xa5\x10\xc3\x70\xb8\xf7\x8d\x36\x92\xcb\xee\x40\x38\x1d\x4f\xf9\xa3\xc9\x78\xca\x47\x23\xac\xe6\xd1\x65\x34\xb2\x7b\xc9\x52\x8a\xeb\x27\xeb\x4c\x3e\x11\x05\x43\x1c\x2f\x28\x87\x6e\xa3\xf1\xe4\xf4\xe1\x57\x5f\x7f\xf3\xed\x77\xdf\x47\xc3\x7b\x7b\x55\x77\x1e\x0e\x15\x1c\xd9\x71\xf2\x37\x51\x72\x14\x45\xc1\x7e\x01\x50\x09\xb0\xca\xdb\x9d\x2f\xbb\xca\x0b\xb6\x5c\xad\xcb\xbf\xbd\xab\xae\xb9\xd8\xfc\x5d\xd6\x2a\x82\x03\x56\xa9\xa0\xa2\x37\xf1\x84\x7d\x08\x50\x0d\xb0\x03\x26\xfd\xb5\x1b\x4c\xe9\x7d\xc1\xfe\x36\x78\x07\xd3\x97\xc0\x0d\x60\x87\x5c\x9f\xd3\x3d\xe0\xc3\xc9\xe1\x80\xf0\xac\x55\x27\x90\x4a\x81\x37\x3b\xdc\x72\x52\x82\xa2\x1e\xf4\xae\x35\x9d\x4c\xeb\x63\x01\xb1\x06\x01\x91\x5b\x60\x50\x23\x95\xd4\x0b\xec\x85\xc4\xad\x11\x12\x2b\x27\x06\x6e\x61\x47\xcc\xe7\x5b\x30\x9d\x6c\x17\x9a\x0c\x24\xde\x95\x54\x22\xde\x54\x2a\xe8\x78\x5a\x3c\x2a\x5d\x3f\xc5\x68\x84\x33\xd7\x40\x39\x2f\x16\xa6\x0d\xf8\x05\xcd\xe8\xbf\xf8\xe0\xf6\xb5\xfc\xb3\xb8\xbe\x9f\x51\x5f\xc3\xa7\xb0\x83\x5d\x5d\x41\x96\xd6\x64\xae\x33\xf5\x52\xf7\x55\x53\x41\x5a\xdf\x3f\x65\x55\xcd\xa8\xd0\xbb\x7c\x2b\xe3\xb5\xdc\xea\xf4\x71\x27\xfd\x17\xe0\x18\xc2\xca\xa8\xed\x1a\xeb\xb2\xa6\xc7\x40\xa9\x75\x59\x1f\xda\x6d\x3c\xb6\x88\x0f\xf6\xd8\x60\x0c\xa4\x59\xb4\xf2\xb3\x35\xa9\x9d\x93\xd6\x54\x25\x17\x6f\x5e\xfd\x4c\x23\xf8\x37\x22\x2a\x79\x79\x76\xf1\xfc\xf1\x93\x33\x1a\xd9\x1f\x90\x76\x71\x7e\x41\xa3\x8b\xf3\x8b\xe8\xf3\x1b\xcf\x8a\xe2\xec\x86\x71\xf5\x5c\xef\x5a\x4c\xd2\x6e\x7d\x37\xaa\xa3\x92\xb3\xe3\x24\x10\x4d\xc8\x70\x82\x53\x96\x64\x4a\x65\xf9\x5a\x57\x40\x91\xe0\xd1\x08\x80\x81\xf3\x80\x64\xd7\xe2\x86\x7d\x62\x9f\x3d\x85\x67\xbd\xa9\x41\xcf\x05\xbb\xa3\xe7\x7a\xbb\xd1\x93\xf2\x73\x59\x2b\x21\x6f\x8f\x8f\x22\xef\x4b\x5e\x88\xf7\x09\xcf\x6e\xca\x15\x1c\x2c\x93\x6d\xcd\xe4\xe3\x15\xe3\xca\xb2\x16\x63\x1d\x63\x8d\x0d\xe3\x31\x2f\x24\x6c\x3b\xa7\x49\x84\xe3\xf8\xae\xdc\xaf\x92\x71\x84\xf7\xfb\x6e\xf6\x0b\x71\x55\x56\x6c\xf0\x2a\x5b\x66\xb2\x34\x05\x86\xad\x02\x4f\xd6\x52\x5c\xb3\xbe\x9c\xdf\x34\xa4\xf5\xe0\x62\x2d\x38\x8b\x30\x2c\x4e\x0b\xfc\xda\x0c\x2e\x8e\x23\x10\x37\x5e\x81\x6c\x16\x95\x7c\xd0\xce\x6d\xa1\xe3\x0f\xe2\xb7\x52\xad\xc5\x56\xbd\x64\xe0\x16\xf1\x06\x7c\x29\x7e\x06\x31\x2f\xc0\x8f\xb3\xd3\x50\x7a\x0f\x92\x1a\xf0\x7e\x2a\x25\x5b\x8a\x0f\x51\xab\x9f\x0b\xb1\xd1\x22\xe2\x39\x87\xd6\xf3\x75\xc6\x57\xec\xbf\xd1\xc7\x6b\x59\x16\x8c\x2b\xd3\x47\x59\x9f\x7d\x00\x6b\x07\x13\x5b\xdf\x8f\xa6\x00\xda\xb3\x91\x34\x82\x82\x91\x59\xed\xc4\xdd\xdb\xdb\x13\x59\x9e\xbf\x8a\x3e\x2e\xdd\xfa\xbd\xe8\x6e\xff\x8d\x8f\xb3\x80\x63\x11\x39\x48\xd1\xf2\x72\x06\x3a\x18\xaf\xe9\x85\x01\xa1\x9a\xce\x17\xa0\xb9\xc8\x33\x85\xf4\xf9\x22\x90\x2c\xb4\xde\xd5\x70\x73\xbf\x65\x60\x8c\xa7\x3c\xc9\x36\x9b\xea\x16\x01\x87\x23\x4d\x4e\xa3\x46\x95\xa6\xbb\x21\x18\xe7\x72\xe8\x73\x58\x62\xb3\x5d\x95\xc0\x3e\x20\xbd\x7a\xc4\xe2\x38\x9f\xe2\x9c\x0e\x27\x70\xdc\x87\x4e\x74\x7b\xd5\x68\x44\x24\x11\x7a\xbf\x29\x21\x33\x0b\xe1\x1d\xb4\xfa\xae\xf1\xb4\xfa\x81\x42\x43\xa0\xe4\x84\x8e\x38\xc2\xd8\x6c\x06\x95\xd6\xd0\x0e\x27\x44\x37\xa2\xbb\x71\x2a\xda\xa9\x44\x6d\x63\xd0\x11\xb6\x00\xbd\x02\xef\xb2\xfd\x1e\xa9\x99\x6b\x59\x61\x9c\xa2\x0a\x76\x7c\x41\x50\x46\x47\xa3\x92\x52\x2a\x71\x1c\x73\xbd\x2b\x80\xb9\xd2\x49\x79\x4e\x61\x4f\x2a\x70\x18\x29\x97\x08\x4e\xaf\xd2\x0d\xc4\x97\xb7\xd6\x4d\x0d\xe4\x78\xca\x7a\x44\x0c\x80\x4b\x69\xbf\xbf\x20\x4d\xe1\x9d\x40\xdc\x38\xce\xc0\x7f\xa0\xe7\x13\x5a\xe7\x27\x3f\x46\x23\xce\xe0\x76\x0a\x26\x7f\x63\x72\x23\x95\xf1\xde\xc8\xf4\x77\x05\x72\x3a\x47\x0f\x41\x04\x87\xef\x12\x64\x0a\x7d\x76\xd9\x52\x64\xb5\x87\x1c\x7d\xfb\x15\x06\x15\x0f\x47\xa7\xa7\x98\x2c\x29\x47\x5f\x83\x06\xf4\xdf\x5f\x81\x75\xf5\x7f\x91\xc2\x70\x4d\x73\x84\xd1\xae\x28\xc1\x06\xa0\xc5\xe0\x34\xd2\x7e\x44\xf2\x89\xe0\x8a\x7d\x50\x11\xb9\x2e\x3f\x94\xbc\x4e\xe7\x56\xee\x2b\x92\x0c\xa3\x48\xea\x32\x11\x5e\x10\x38\x39\xc0\x49\xa7\x4e\x77\x26\x31\xad\xed\x69\x2d\x38\x04\x12\xa7\x21\xe8\xcd\xd4\xf5\xea\xb4\x4e\x32\xab\xa3\xf0\x39\xda\xbc\x52\xf7\x56\xca\x9d\xc6\xbb\xb7\xa2\xd1\xfc\x59\xcf\xa6\xb4\x3e\x3a\x94\x82\x8b\xeb\xd3\xc0\x1b\xac\xc1\xbc\x43\xfc\xae\xdd\x44\x96\x64\x49\x2b\xe5\x70\x20\xda\xdd\xcb\xe2\xe9\xa3\x28\xd0\x5d\x3e\x09\x6a\xf4\x74\x69\x6b\x03\x5b\x82\x69\xdb\xd4\x89\x49\x81\xbe\x5a\xd0\xf4\xaa\x57\xac\x43\xc1\x0c\xfe\x86\x6d\xb4\xaa\xea\x1a\x07\x22\x19\x2f\x98\x0c\x41\x80\x15\xc2\x28\x54\x03\xcf\x07\x5f\x9b\x70\xda\xe8\x77\x08\x78\x5b\x68\x90\x6a\xe7\xc1\x91\x5d\xd7\x04\x0c\x84\xcd\x74\x90\xdc\x15\x92\xb0\xea\xc1\x12\x68\x01\x2c\xe3\x18\xd5\xb4\x4c\x24\x2b\xb6\x39\x7b\x59\xae\xd6\xaa\xe1\x52\x8a\x48\x92\x05\xc7\x6a\xcf\xe8\x94\x35\x42\x8a\x79\x06\x06\x32\x4b\x86\x5b\x20\xc3\x92\x54\xc0\x41\x76\x9e\xba\xb8\xa3\x98\x8a\x68\x30\xd3\x92\x58\x9c\xe6\xe6\xc7\x85\x25\x28\x47\x73\xe2\x00\x5b\x84\x6d\x73\x99\xe4\x18\x29\x8c\x0b\xef\xc8\x47\x95\xb7\x30\x29\xec\xf8\xc8\x1a\xce\x38\xea\xd3\xf8\x88\x22\x6b\x10\x90\x8a\xf9\x7a\x41\xd5\x7c\x6d\x4e\x40\x81\xfe\xb0\x51\x4b\xca\x96\x5a\xf2\x0a\x49\x6c\xf5\x84\xd7\x70\xbc\x74\x7d\xaf\xa0\x6f\xf9\x69\x7d\x4b\xb2\x82\xbe\xaf\xe7\xab\x05\x65\x1d\x2a\x90\xf3\xd5\x82\x6c\xd0\x0e\x94\xb8\xab\x03\x29\x70\xe3\x02\x74\xed\x59\x5d\xb7\x0e\x29\xf0\x81\xd4\xd8\x99\xca\x68\xbd\xdf\x0f\x41\xae\xac\xf7\xfb\xec\xd8\x43\xb1\xc6\xfb\xbd\xb4\x66\xa6\xfa\x70\xc0\x53\xd8\x61\xd6\x1f\xdb\x5b\xfa\x0e\x6b\xd1\xef\x7f\x9f\x9b\x35\xf3\x6a\x7b\x05\x9e\xb6\x57\x4c\x82\x61\xbc\xb5\xfd\x5a\xcf\x21\xc2\xc1\x0d\x0d\x4e\xb1\x15\x95\xa3\xe8\x41\x65\x85\xf4\x3a\x22\x99\x4e\x60\x20\x9a\x69\x6f\x01\xb0\xbb\x42\x4a\xed\x1a\x8d\x1c\x0a\x38\xdd\x1d\xaf\x6d\xa4\xb5\x0f\x0a\x8c\x9c\x21\x2b\x21\x0a\xdf\xb7\xb2\x01\x28\xe6\xda\x65\xd0\x02\x83\x16\x76\x0d\x18\x7a\xa9\x6a\xda\x76\x70\x98\x94\x72\x71\x20\xec\xd0\xf0\xb9\xdf\xca\xaa\x7a\x21\xb6\xe1\xea\xc7\x3b\x5d\xb2\x5a\x80\xed\xd8\x36\x43\xc7\x9d\x3a\x2f\x59\xce\xca\x1b\x76\xc4\xe6\x6c\xf9\xd1\x28\x28\xff\xb4\x2c\xde\x68\x17\xe3\x7e\xce\x30\xb5\xdd\x1d\xcb\x18\x0d\x17\x52\x88\xcd\x33\xad\x21\x3e\x10\x3e\x2f\x17\x2d\x29\x18\x9a\x32\xdc\xc5\xa1\xc4\xb5\x08\x27\x06\x98\x35\x5f\x1a\xef\xd4\xbc\x82\x45\x53\x2d\x92\x65\x59\x29\x26\x7b\x7b\x83\xb3\x89\xe9\xab\xa1\x86\xea\x6e\x6a\xc8\x6a\xf5\x52\xb3\x3e\x56\x9c\x05\x84\x60\x48\x63\x9d\xf1\xa2\x62\x76\x12\x0d\x1e\x1c\x8d\x6c\x79\x3f\x95\xdc\x45\x20\x96\x2a\x9e\xf1\x52\x95\x59\xf5\x4a\xf5\xa1\x34\x44\x41\x62\x5b\x9a\xcb\xc5\x0c\x59\x32\x81\xd1\xb7\xb3\x92\x86\x6a\x08\xc3\x70\xa3\xa4\x35\x75\xbd\xd4\x11\x54\x07\x2f\x1a\x40\x77\x79\xdc\xb0\x1f\x1d\xb2\x64\x81\xf1\x27\x92\x11\x4c\x29\x9b\x1e\xf5\xa4\x13\x6a\xa6\xf4\xd0\xd1\xa7\x0e\xa9\xdb\xe9\x1b\x7e\xdd\x4b\xf1\x65\x30\x16\x84\x89\xfd\xa5\x37\x1b\x4d\x0b\xb0\x0e\x7c\x1d\xa3\xac\x05\x89\x08\xca\x99\x73\xd7\xbc\x5a\x58\x12\x99\x76\x20\xb5\x93\x58\x2d\xe0\x92\x0d\x9c\x03\x08\x3f\x00\xe7\x02\x87\xe5\x2b\x5a\x59\x69\x5b\x0b\xb3\xe0\x2f\xca\x8d\x11\xb3\x4c\x32\xa3\x84\x47\x3b\x8f\xca\x14\x12\x3b\x82\x07\x69\x86\xab\xb3\xf9\xf6\xfa\x8a\xc9\xa0\xc0\xe1\xa3\x6a\xe8\xfb\x2c\x09\xf2\x80\x3f\x66\x89\xb0\xc7\x44\x3d\x00\x69\xa4\x71\xe1\x55\xf4\xc2\x5b\x12\x60\x3d\xa6\xe2\x4e\x83\xc1\x71\xce\x4a\xa4\xe2\x2e\x83\x81\xb8\xc7\x60\x70\x9c\x57\x33\xa5\x45\xd0\xe7\x2c\xbb\x61\x3f\x0b\xd1\x57\xbf\xac\x1f\xe7\xaa\xbc\xe9\x01\x04\xae\x98\x38\x15\x78\x38\x1e\x6b\x5d\xd2\x76\x4c\xad\x2d\x0e\x5a\x6b\xac\xa0\xc7\x59\xd6\xf8\x69\xcd\x0b\xd6\xfa\xd9\x57\x12\xb6\x52\x97\x7e\xc0\x9f\xab\x0d\xfb\x3c\x07\xed\x6e\xd3\xd6\xc7\x1f\x34\x51\x78\xea\xce\x8d\x8f\x4e\xe1\x54\x29\xb0\xde\x7a\xad\x45\x92\xca\x7f\x16\xb0\xef\xbe\xb6\xb7\x69\x00\xbd\x1c\x9d\x7e\x05\x87\xbd\xa6\x25\x4f\x62\x1d\x70\x3b\xbe\x1c\xee\x68\x08\xd6\x7d\x30\xfd\x4f\x40\x38\xa0\x5d\x76\x42\xbb\x67\xbb\x4f\x34\xcc\xc3\xca\xa5\x0c\x74\xfb\x4f\xe0\x92\x14\x57\xce\xf4\xaf\xdd\x66\x57\x4c\xbd\xa9\xf5\xc1\x66\x59\xca\x6b\xed\x15\x42\x2a\x70\xa7\xdd\xd6\x6b\x5f\xb0\xa6\xde\x11\xd4\xa7\x6d\xa1\xb2\x20\x05\xd5\x06\xcc\xe7\x1a\xa2\xd0\x31\xcb\xfe\xb8\x82\x5d\x78\x0d\xff\x5c\xc3\x3f\x2b\x7a\xbc\xfe\x36\x71\xbc\x49\x0c\x0d\x69\x57\xf7\x8b\xf3\x8b\xd9\xb5\xd7\xf4\x6c\xa0\x79\x9c\x2e\x83\xa4\xa5\x49\x3a\x99\x1c\xc8\x65\xcf\x4e\xba\x42\x78\xba\xa4\x8c\x2c\x5b\x8d\xbe\x79\xf5\xf3\xec\x3a\x50\xc9\x5c\x5b\x3f\xa5\x31\x51\xa3\x09\x26\x73\xdd\xe8\x02\xa7\xad\x5a\x56\xa5\xab\x05\x46\xb5\xa0\xba\x0c\x26\xeb\xe3\xad\x3e\x98\x6a\xb4\x84\x7d\x9e\xdc\xd0\x9e\xcc\xab\xbe\x2d\xdd\xe5\xd1\xab\x4f\xda\xd3\x7b\x1d\x3e\xd6\xf7\x34\xbc\xa6\xeb\x4f\x6a\xf8\x43\xd0\x30\x9c\xa0\xd0\x18\x5c\xb8\x84\xd8\x3c\xae\x6f\x79\x8e\xd1\x95\xa5\xbb\xa6\x0b\xd0\xf1\x0a\x5d\x30\x6b\xac\xfb\x57\x73\xb5\x20\xed\xdb\x3a\xb6\x2b\xd8\x89\x86\x94\xcd\xc0\xe4\x90\x72\xa4\xd1\x14\x16\xe3\x71\xdc\xe3\xc2\xc1\x51\x6f\x63\x0a\x0d\xc1\x4b\x1d\x7c\x11\xd3\xe0\xf7\x81\xbc\x6f\xe1\x67\xa9\x9d\x0f\xf2\x63\x6b\x3c\x46\x4b\x02\xb7\xab\x36\x77\x17\xd8\xe8\x02\x68\x43\x19\xf9\x10\x02\xa1\xf4\xde\xb9\x01\x85\x25\xb8\xb0\x6d\xf4\x16\x0b\x18\x03\x7b\x5e\x48\x3e\x60\x22\x70\xda\x17\x04\xf7\x56\x1b\xc7\x77\x8c\x96\x98\x88\xe3\x54\x86\xa7\x02\x74\x9f\x16\xaa\xb6\xef\x07\x46\x4b\x6b\xdd\xb7\x9a\x52\x38\xca\xf8\x1e\x3d\xb9\xe2\x43\x1b\x8a\xf3\x8b\xd9\x25\xa0\xbc\x9d\x0a\x0b\x42\xa3\x0d\x24\xc3\x38\x3e\x2e\xe1\x89\x5f\x97\xaa\x7d\x29\xef\x91\xb8\x8c\xe3\x56\x85\x8b\xf3\x0b\x33\xda\x8c\x76\x17\x2b\x29\x82\x24\xcd\x31\xec\x75\x85\x2c\x8e\xf5\xdf\x22\x8e\xb7\x28\x3b\x29\xf0\xe1\x00\x52\xd6\x3b\xda\x33\xe5\xef\xd1\x73\xc4\x88\x45\x2b\x3e\x90\xb3\xfb\x0a\x39\x5c\xe0\x03\x79\xd2\x94\xf3\xc5\xb6\xe8\x64\x82\x0f\xe4\xbc\x37\x0b\x72\x2e\x7a\x72\x5e\x64\x6a\x9d\xc8\x8c\x17\xe2\x1a\x61\xef\xae\x83\x1e\x7e\x83\x9d\x23\xef\x29\x29\xf6\xfb\x6f\xf0\x81\xbc\xe8\x81\xad\x67\xb2\x0f\xe4\x79\xef\xdd\x95\x23\x5e\xff\x69\x8e\x50\x17\xc8\x9d\x58\x35\xf5\xb4\x5d\xbd\xb0\xdd\xda\x9c\x5d\x76\x77\xbc\x25\xa4\x8a\x98\xb3\xe1\x8f\xfa\x22\x66\x7a\x63\x3f\xd3\x5b\x02\xda\xfc\x5a\xdf\x8b\x7d\x2d\xd2\xf7\xc6\x77\xe2\x9d\x17\x7d\xce\xc8\x4a\xa4\x5b\x27\xda\x3c\x09\x44\x99\x73\xab\xa5\xf9\x13\xbb\x4d\x2f\xec\x6f\x20\xf5\x34\x44\x85\x4d\xff\x59\xb2\x65\xfa\x82\xb4\xc1\x4e\x9f\x1f\x0e\xc1\x5e\x5d\x7f\xee\x5e\x0d\x2a\x70\xfe\xa6\x66\x4f\xcf\x5f\xd0\x21\x0a\xf4\x09\x9e\xc7\x18\x0b\xca\x7e\x3f\xb4\x66\x8e\x42\xe4\x1a\xa5\xc7\x29\xed\xc3\xff\xe7\xca\x33\x44\x25\x2b\xad\x2c\x6a\x6f\xa5\x54\xb5\x76\x5b\x70\xef\x51\x99\xf4\x96\x34\xaa\xfa\x36\x69\xaa\x7a\x76\x74\xbb\xe9\x7a\xf1\x64\x62\x2f\xfb\x4e\xbe\x35\xf2\xc9\x57\x63\x90\x4f\xb4\xb8\x02\xf2\xc6\xa9\x96\x37\xca\x06\x3f\x71\x3c\xd4\x34\x7a\x9f\x85\x08\xa3\x96\x70\xe2\x77\x5d\x2d\xe2\xbc\x63\xb7\x0d\xfd\x09\x8b\x2d\x07\x5d\x28\x70\x5a\xbc\x3a\xa6\xeb\x9d\x9e\xdc\x85\xa1\x6e\xbe\xbd\xd0\x03\x4e\x4e\x47\x79\x90\x68\x45\x52\x05\x0e\x7f\x55\x22\x59\x56\xe8\x33\x16\x68\xae\x52\x83\x94\x03\xb1\x12\x09\xdc\x87\xdf\xf6\xa3\xef\x48\xe4\xb2\xf8\x04\xf7\x0e\x46\xdb\x26\x3b\x98\x24\xc5\xc0\x99\xdd\xfa\x17\x28\xbc\x83\x03\x9d\x53\x14\x01\xbf\x3c\x18\x67\xcd\xbe\xe9\xeb\x55\x56\x2a\x67\x34\xd4\x91\x08\xe4\x35\xdc\x8c\x38\x90\x2e\x3d\x1c\xe3\xde\xa7\x98\x5d\x5b\xde\x6d\x7e\x03\x84\xec\xf7\x0c\xd5\xc8\xf9\x87\xe1\xc3\x54\xcf\x79\xd7\x74\x8c\x2d\x2c\x24\xda\xd8\x06\x22\xb8\x0a\x0b\x1d\xb6\x10\x65\x41\x67\x68\x8b\xb0\x67\x2d\x83\x3c\x8e\x3f\xd2\xea\xda\x53\x94\x0e\x74\x10\xb4\xa8\xc1\xe9\x31\x29\xf7\x43\x44\x5c\x57\xf7\xd6\x68\xf7\xd6\x72\xd0\x6c\x98\xae\xdd\x54\x89\x30\x32\xed\xd4\x33\x5c\xae\xbb\xa8\x92\x3a\xbb\x61\x96\xae\x04\x00\xad\x8c\xae\x50\x1c\x88\x16\x81\x1a\x7e\x06\xfb\xb8\x39\x62\xb4\x56\x76\xd3\xa9\xc7\x5b\xd1\x92\x6e\x02\x5a\xe8\x50\x9b\x37\x1e\x83\xc9\xca\x88\x1b\x07\x6b\xbe\x6f\x33\x93\xff\x46\x1f\xb6\xa9\xde\x6e\x56\x61\xe8\x06\x7d\xfe\xea\x54\x5e\x81\x54\xf7\x51\x73\x6c\xc7\x12\x08\xf1\x13\x74\xf4\x04\x92\x6b\xe9\x09\x9c\x53\x89\x8b\x02\x51\x77\xa2\x40\xd4\xff\x96\x51\x20\xb6\x74\x1e\x8c\x62\x41\xc0\x6f\x27\x84\xf4\xe3\x51\x20\xb6\xf3\xc2\x46\x81\xa8\x3f\x1e\x05\xa2\xee\x44\x81\xa8\x8f\x1c\x74\xd8\xfd\xd1\x0a\x02\x6b\x6c\x9b\xf8\xe7\x93\x85\xf6\x6c\x96\x94\xcd\x1f\x6a\xd3\xea\xb0\xb1\xab\xc2\xa7\xea\x35\xba\x5d\x29\x91\x39\xdf\x5d\x81\xa4\x93\x3f\xe6\xdc\x9f\xb4\x64\x52\xeb\x3b\x62\xf5\x1d\x8e\x64\xd1\x83\xdf\xfd\xc7\xc0\x14\x79\xf3\xf2\x39\x8d\x46\xae\xc2\x4b\x21\xd4\x88\x8d\xa2\xc1\xef\x1e\x44\x07\x8c\x5d\x7b\xa0\xa6\xb2\xce\x5d\x6f\x79\xe4\x8c\x90\xd0\x61\x90\xd8\xab\x32\x6f\x3a\x7a\x91\x6d\x36\x25\x5f\x41\x7f\x45\xa6\xb2\x14\x4c\xdf\xa5\x59\xa5\x0f\xfe\x56\x0b\x3e\x85\x3b\x33\x35\x53\x74\xab\x96\x27\xdf\x4d\xe1\xa6\xe9\x37\x5f\x91\x68\x04\xa3\x45\x5b\xce\xea\x3c\xdb\x30\xd4\x73\xd3\xe4\x8f\xaf\xce\x7f\xb1\xfa\x8e\x72\x09\x77\xfc\x31\xc6\x76\x04\xcd\x9c\x84\x38\x00\xcc\xa9\x20\xf8\x81\xf2\x72\x65\x53\xcc\x0d\x60\xa0\xd5\x72\x2d\x2c\xda\xf9\x03\x65\x84\x22\xcc\x61\x7f\xa0\xe6\xa7\x8b\x59\xf4\xfb\x6b\x56\x94\xd9\x20\x1a\xc1\xe7\x28\xda\x45\x23\x31\x8a\x0e\x11\x84\xb1\xb1\xb8\xb2\xde\x1a\x01\x44\x7a\xc9\x1e\x9d\xc2\xe0\x9c\x41\xe7\x73\xcd\x12\x98\xbd\xde\xea\xcc\x2f\xe0\x5e\x41\x24\x1d\x4f\xe5\x23\x0d\x9f\x91\x58\xa7\xd2\x59\x9e\x2b\xad\xde\x9c\xcb\xc5\x7c\xbc\x98\x46\x46\xb9\xd7\x34\xad\xef\x2b\x83\x52\x7b\x38\xc6\x07\x68\xd2\xb4\xc4\x8e\x9a\xc9\x28\x18\x09\x8e\x1b\xc8\xf4\x55\x48\x31\x87\xbf\x8b\xfd\x1e\xf1\x38\x1e\x66\x30\x7c\xf8\x87\xf2\x14\x98\xb7\xfe\x19\xa1\x68\x04\x3f\x46\x11\xd6\x2b\x1c\x81\x33\x73\x04\x97\x27\x95\x8d\xe6\xa0\x35\x9b\xaa\x8f\x89\xb9\xef\x81\xe8\x38\x08\x6a\xbf\x4b\x0f\x2c\x77\xc0\x0a\xca\xe6\x7c\x41\x24\xbd\x9a\x8b\xa4\x2c\x16\xd6\xc7\x0f\x76\xa9\x65\x3d\x1a\x79\xd3\x55\x45\xc7\xd3\xea\x91\x84\x2b\x0a\x5e\xd6\x9f\x56\xa3\x11\xb6\x49\xf3\x6a\x81\x84\xff\x69\xbc\x03\xa7\xd5\x23\x71\x67\x05\x33\x92\x6d\x50\x49\x2b\x6c\x35\xbb\x72\x9d\x66\xa0\x89\x31\x3d\xf7\x34\x94\xf5\x37\x31\xb5\x23\xa1\xbb\x12\xf4\x93\x65\x41\x60\x28\xe9\x04\x8c\x8b\xaa\x4e\xb3\xc3\xe1\xd0\x32\x3d\xb5\xb1\x34\x5f\x90\x80\x4c\x8e\x27\xb7\xd2\x93\xab\x2f\x87\xc3\x5a\x9b\xc1\x3d\xd6\x91\xf9\x9d\xc2\x6f\x52\x52\xb8\x8e\x4b\x72\x5a\xcd\x4f\xc1\xe2\x59\xcd\x1f\x42\xc4\x9d\x5d\x5e\xd7\x69\x49\x34\x9d\xa7\x39\xf1\x8b\x3b\xad\x0f\x53\x31\xcf\x16\x33\xf8\xa7\x85\x19\x9c\xda\x1b\xb9\x90\xa3\x07\x93\xd9\x21\xcc\xb7\x0b\xef\xc2\x3f\x68\x5b\x4e\x1a\x36\x79\x8d\x58\x52\xf2\x9a\x49\xf5\x8c\x2b\xe3\x4b\x33\xe4\x81\x7f\xb3\xdd\x9e\x9e\x80\x7f\x3c\xff\xd2\xc6\xca\xc9\x06\xb5\xba\xad\xd8\x40\x65\x72\xc5\x54\x32\x78\xbd\x2e\x6b\xf0\x94\xbf\xca\xae\xaa\xdb\xc1\x35\xcb\x78\x6d\xa2\xe2\xc0\x7e\x74\x03\x97\x33\xfd\xee\xf4\x65\xd3\xd9\x97\x03\x6d\xc7\x65\x8a\xc9\x41\x59\x0f\x4a\x7e\x93\x55\x65\x91\xd8\x0b\xa9\x82\xde\xcc\x6f\x2c\x5e\x4f\x26\x9a\xe0\x22\x25\x36\x91\x75\x71\x83\x46\x1e\x2b\x2c\x66\x22\xe1\xec\x83\x7a\x55\x5e\x55\x25\x5f\xcd\xb8\xcd\x32\xe7\x3d\xa4\x48\x2b\x1b\x70\x95\x6d\x36\x8c\x17\xda\x92\x07\xc2\xf4\x51\x05\x9e\x2c\x4b\x59\x2b\x5d\x00\x93\x1b\x83\x5b\x15\x5c\x84\xbc\x12\x4a\x89\xeb\x0e\x1c\xdd\x76\x75\xf1\x5d\x60\x06\x1e\xba\xe5\xdd\x54\xda\xef\x87\xcd\x47\x72\xa5\x21\x3e\xc6\xfc\xfc\x95\x46\xf5\x73\x08\x28\x24\x17\x6f\xf9\x5b\x3e\x80\xa0\x4a\x55\x59\x04\x98\x6d\x10\x69\xf1\xfb\x58\x7d\x39\x40\x5f\x0a\x1d\x4e\xaa\xf6\x9d\x7c\x89\x07\x4b\xb1\xe5\x45\xf2\x96\x0f\x5e\x6c\x6b\xed\x9d\xfe\xa5\x12\x9b\x2f\xc9\xe0\x4b\x33\xac\x2f\x09\x78\xa7\x5b\xcb\xf3\x5b\x3e\x30\x51\x20\xd2\x07\x0f\x56\xa5\x5a\x6f\xaf\x92\x5c\x5c\x3f\x78\xcf\xae\x36\x59\xfe\xee\x04\xec\x3b\xb2\xbc\x7a\xa0\x69\xe1\x04\x5c\xfb\x98\xfc\x0f\xd3\x55\xa6\x30\xec\x5a\xf6\x42\x43\x9b\xc6\x46\xd1\x20\x1a\x1d\x0f\x7c\x7a\x34\x13\x32\xbc\x94\x9b\x75\xdc\xf1\x61\x0d\x30\xae\x7e\x11\x05\xb3\x9b\xfa\x70\x32\x0d\x53\xad\xfc\x6c\x26\xc4\x5e\xaf\x56\xf4\xa6\x51\xda\xe0\xa9\xfa\x81\x82\xca\x39\xa9\x61\xaf\x84\xdb\x47\x93\x60\x9f\x2d\x9b\xcd\xac\xff\xb4\x8c\x22\x3d\xf0\xc6\x81\x5f\xfb\xa4\xca\x5a\xc7\xe0\xa1\x11\x18\xdd\x1e\xe4\x75\x1d\x91\x1a\x29\x62\xf3\x30\x31\x61\x8d\x88\x6a\x3a\xca\x3f\xde\x51\x55\xf2\x77\x1f\xef\xc7\xa5\x4b\x56\xc1\xd5\xb1\xdb\x8a\xd5\x6b\xc6\xd4\x47\x01\xa8\x75\xca\xae\x7d\x51\xe9\x58\x25\xac\x43\xaa\xd5\x4c\x3d\x56\x4a\x96\x57\x5b\xb8\x0b\x4a\xd4\x9c\x6b\x2b\xb0\x6f\x6b\x1b\xf0\x16\x13\x4b\x0c\x16\xae\x6a\x22\x45\xc0\xa9\x3a\xaf\xcd\x95\xf0\x21\xaa\x68\x90\x85\x4c\x8e\x73\xbc\x1f\xf8\x9e\x8d\x00\x98\xd7\x35\xad\x0e\xba\x35\xf0\x20\xad\x98\x12\xd6\x28\x52\xd3\xcb\xd1\x68\xca\xe9\x6a\xbf\x47\x2b\x5a\x82\x77\x07\x11\xb4\x48\xae\x4a\x5e\x68\x47\x13\xc2\x49\x0d\xce\xbb\x44\x1e\xa7\x8e\x6d\x04\x24\x66\x85\xb4\x17\xd9\xa6\x57\x2e\x7c\xf3\xf2\xf9\x5d\xe9\x76\xba\x0c\x02\xef\x2b\x27\xd9\x8d\x78\xf7\x91\x72\x3f\x56\xe2\xaa\x37\x03\x44\xb5\x19\xe2\x34\x87\x33\x85\xa0\x9b\xd6\x40\x20\x98\x60\x28\x5f\x65\x08\x82\xed\x25\x6b\xc9\x96\x71\xdc\xd3\x35\x32\x79\xa0\x6c\x46\x5c\xa3\x8c\x08\xba\x0c\x9b\xec\x69\xf0\xe0\x49\x50\xb4\x34\xf3\x46\x53\x6c\xff\x85\x39\xd4\x0b\x34\xaf\xeb\x38\x56\x89\xde\xca\x74\x82\xfe\x05\x49\x1e\xd1\x3a\xd9\x7f\xd9\x79\x9f\x0a\xc4\xa8\xb2\xb3\x02\xfe\x97\x0d\xa5\x16\xee\x90\x65\xe6\x5d\x52\x3e\x8b\xa2\x54\x40\x97\x40\x66\x70\xde\xbd\xad\xd8\x2b\xa0\x7a\x1c\x7e\x40\x81\xd7\xec\x83\xa2\x1f\x34\x5b\x69\x0e\x3b\x55\x77\xc9\x41\x29\xe0\x1e\x48\x82\x66\x09\xc2\x2a\x94\x55\x01\x09\xf5\x34\x9b\xab\x45\x1c\xb7\xf9\x0a\xa4\x61\xe2\x2e\xec\xcd\x58\x9b\x85\x55\x44\xe7\xa7\xac\xb5\x39\x54\xe1\x88\x96\xe1\x5e\xac\xe1\xd4\xee\x57\x1a\x55\x30\x24\x11\xc7\x9d\x45\x17\xe9\xbc\x88\x08\x4c\x3e\x3e\x5e\x3e\xf5\x82\xd2\x94\x05\x1b\xdb\x14\x77\xf8\x63\xb8\xe9\x4d\xdb\xf0\xde\x85\x21\x8e\xc3\x81\x6c\x9c\x64\x69\xa5\x77\x3d\x14\x70\x1c\xf5\xd3\xeb\xe3\xa5\xc0\xe1\x17\xce\x3e\x37\x4c\xaa\xd7\xe2\xf1\x55\x2d\xaa\xad\x62\x6f\x24\xc4\x8a\x91\x53\x74\x47\xde\x7e\x5f\x81\x75\x40\x50\x08\x13\x67\x62\xdb\x40\x78\x8b\xb7\xfc\x33\x0e\x41\xff\xf4\xb1\x47\x36\xc7\x1e\x17\x68\x08\x4e\xc4\xb0\x56\xd1\x5c\x2c\xc8\x0e\xd6\x68\xda\xf0\xe2\x03\x18\x40\x99\x5e\x62\x53\xf3\x87\xf6\xf0\x09\xf0\x2a\x28\xfb\x97\x67\x89\xf5\x31\xf8\x0a\x64\xcc\x76\x50\x21\x48\x56\xd3\x63\x16\x69\x53\x1a\x14\x83\xf3\x04\x65\xfd\x0e\xd8\xc0\xff\x0f\xe8\xb8\xb6\xd1\x81\xc4\xb1\x9b\xf3\xe6\x57\x92\x55\x55\x1c\x3b\xfd\x71\xa6\xc4\xd5\x01\x93\xeb\x63\xc8\x9a\x6b\x52\x0d\x70\xe6\x52\x6f\x03\x19\x6c\x1a\xee\x54\x11\xf8\x73\x73\x2d\x7d\x82\x03\x6f\xad\x32\x9e\x37\x9a\xec\xe4\xe7\xd7\x2f\x9e\x3f\xfb\x09\x44\x1c\xbb\x25\x62\xd0\x60\x0a\x2a\x80\x54\x14\xb8\x34\x39\x28\xd7\x2c\x2b\x9a\xfb\x51\x42\x5b\xb2\x0e\xb0\x4b\x51\xe1\x24\x61\xf8\x3a\x1c\x7a\xce\xed\x03\x3f\xd6\xbf\xeb\xa8\x68\xac\x62\x70\x43\x1f\xd4\x40\x10\x08\x02\x9a\x22\x97\x74\x4c\x6e\xe0\xb8\x71\x4b\x39\xfa\x66\x8c\xfb\xae\xcf\x31\x6b\x3a\x0b\x54\xf3\x5e\xfa\x7b\x7a\xf6\xe3\x9b\x3f\xc4\xb1\xfd\x73\x24\x1c\x3a\x08\x8e\xc5\xc0\xd7\x6b\x36\x08\x05\xad\xe0\x7e\xe1\xb6\x66\x05\x78\x0c\x66\x5a\x3f\x74\x25\xc5\xfb\x9a\xc9\x50\x35\x14\xe1\xa9\x8d\x53\x02\x81\x1e\x94\x92\x35\x75\x3d\xfb\xdd\xc5\x66\xcc\xec\x5f\x6d\x65\x6f\xf6\x59\xf0\x6e\x6f\xbe\xe8\x1a\x41\x50\x1d\xcb\xec\x40\xa6\xd3\xf9\xcd\x27\x8d\x60\x1e\xa2\xa6\xcc\x63\x15\x94\x78\xac\xa8\x13\xa2\x9d\xfe\x55\x02\xf7\x08\x76\x17\x0e\x22\x4a\x38\x43\xee\xe4\x05\x5e\xf2\x10\x6f\x69\x9a\x3d\x72\xd7\x99\xa7\x99\x3b\x74\x95\x94\x83\x8b\x5d\x4e\xaf\xe6\xa5\x3e\xa4\xe6\xfa\x78\x7a\x72\x42\x2a\x23\xc6\xe7\x18\xe4\x07\x06\x4e\xf1\xd2\x8a\x41\xe6\x8c\x0e\xb5\x4d\xab\xd5\x51\xab\x70\x44\xcb\xbc\x6b\xbe\x69\xb2\x01\xa8\x06\xf5\xd8\xa3\xbc\x7d\xec\x84\x4b\x74\x36\x69\x5e\x2f\x10\x9e\xda\xe0\x91\x57\xf3\x1c\xe0\x3a\x1c\x0e\x07\x3d\xf0\x0f\xc7\x1a\xfa\x46\x71\xe2\xb3\x5a\x37\x96\xe6\x6a\x41\x39\x61\xce\x00\xfe\xa3\x10\x15\xcb\x78\x4b\x7f\x74\xf8\x78\xa8\x5b\x97\x39\x10\x21\x72\x15\x50\x11\xc3\xde\x57\xf6\x23\xae\xa7\x80\x40\xbb\x67\x0f\xc7\x16\xea\xe1\xe4\xf8\xd0\xec\xbe\xdb\x23\x51\xde\x5d\xd5\xa9\x7a\x91\xf2\x2e\x4a\x25\x38\xfe\xc2\x4e\x7f\xe3\xcd\x22\xe4\xc6\x39\x25\xdf\x58\x97\xe4\x40\xec\x84\xae\x38\xde\x9d\xc5\xf1\x99\xaf\x00\xc2\xc5\xac\x40\x67\x84\xe3\xd4\x36\x5b\x9b\x66\x03\xed\xb1\xbe\xbc\xa1\x66\x20\xc3\xa4\x62\x56\xa0\xad\xbe\x6c\x49\x1a\x67\x63\xb8\xb2\xc9\x9d\x75\xdf\xf7\x57\x1c\x0d\x8d\x13\xb8\xf2\xb8\x44\x7c\xbf\xf7\x5a\x46\xb8\x7a\x21\xf1\xd4\x76\x9e\x43\xe7\x41\xd7\x5a\x8c\xe1\x33\x85\x38\x4e\x95\x15\xba\xe0\x9f\x1b\xaa\x81\x60\x10\xbf\xd9\x6e\x47\xe0\xc2\x8c\x5b\x00\x80\xda\x06\x04\xf2\x99\x42\xac\xa9\xde\xbe\x3a\x8c\x2a\xe8\xf1\x86\x30\x10\x65\x64\x52\x81\xa7\x95\xf6\xb9\x02\x67\x6e\x09\x51\x74\xf8\xca\x25\xd4\x54\x26\x8c\x2b\x26\xcd\xf7\xf4\x1d\xca\xc8\x0d\x48\x36\x5d\x47\x0b\xcf\x2d\xf5\x95\xa6\x3a\x38\x4e\x1d\x9a\x33\xc3\x35\x26\xef\x51\x49\x6e\xda\x98\x36\x08\x52\x2d\x04\x41\xea\xf4\x03\xaa\x09\x0c\x27\x1c\xe0\xb2\xd9\x51\xfc\xb6\xf5\xb1\x18\x1c\x71\x1c\x7e\xb9\x75\x04\x41\x67\xca\xe2\xf2\x72\xbf\x57\xa0\xf5\xb3\x5f\xf4\xc9\x68\x14\x74\xb7\x09\xb6\x01\x76\xd7\x05\xe1\xf3\x39\x40\x05\x77\x84\xef\xc4\xca\x80\x85\x83\xb8\x32\xb3\x74\x3f\xf5\xf9\x43\xad\x70\x68\xd1\x3b\x25\x47\x78\x7a\x46\xfb\xe8\xd1\x2b\xdb\x24\xdd\xa0\xf6\x54\x9f\xe1\x70\x9a\x61\xde\xad\x91\xd0\x04\x9f\x92\x8e\x41\x01\xb9\xe8\x18\x73\xe5\xa3\x7c\x3a\x1a\x95\x38\xa3\x12\xbc\x2c\xe1\x18\x8e\xb2\xd6\x44\xac\x91\x06\xd1\xad\xbe\x86\x5b\x30\xba\x69\x52\x89\x72\x3d\x71\x3a\xd6\x06\x27\xdb\x93\x73\x80\xf1\x5b\x9c\x8a\x63\xfe\x48\x4c\x47\x23\x8e\x95\x56\x2a\x7a\x13\xff\x40\x05\xf2\xe4\x75\x33\xff\x80\xf4\x29\xcc\x9d\xe5\xa2\xe7\xe0\x90\x23\xd0\x39\xf8\xb2\x5c\xc4\x31\xba\x40\x98\x5c\xe8\x9d\x1e\x93\x17\x71\x8c\x5e\x20\x4c\x5e\x98\x6f\x1c\x0c\x64\x85\xbc\x7c\x2a\xe9\x10\xea\x93\x8a\x2e\x91\x82\x23\xa0\x83\xe0\x1c\x14\xb7\x5c\x0b\x97\x17\x30\x86\xc0\x77\x00\x5d\x61\xd2\x4e\x79\xc3\x61\x2b\x86\x0e\x3b\x45\x4d\x06\xd2\xf1\xe5\x5c\xf7\x08\xef\xae\x91\x0a\x25\xe6\x4b\xd4\xe1\x20\x78\xd7\x70\x3b\x70\x99\x99\xd9\x65\x7d\x83\x21\x80\x49\xd3\x12\x98\x67\xa4\x67\x1d\x62\xd6\x04\x35\x14\x38\x95\x71\x6c\xab\x49\x98\x46\x27\x65\x19\xb8\x83\x78\x23\x4d\x57\x41\x37\x1c\xf5\xf9\xd5\x21\x38\x4e\x6b\xce\x72\x03\xe2\xe8\xad\x63\x2f\x19\xd0\x33\x26\x1f\xe8\x6d\x22\xb7\xfc\x0c\xb8\x07\x38\x72\xd6\xe4\xbd\x49\x79\xa2\x19\x8c\x49\x7a\x67\x92\xbc\xb7\x67\x4d\xce\x1c\xc1\x3c\xa1\x13\x72\x6e\xdb\x6c\xc7\xf7\xb9\x70\x45\x5e\xd8\x1f\x16\xf8\x9d\x77\x0c\xe5\x44\xc7\xac\x4b\x65\xcb\xcd\x03\x7a\x29\xf9\x4a\x2f\x82\x74\xe5\x5c\x3e\x2e\x21\xc8\x54\x46\xa5\x8f\xbb\x8d\xbe\x87\x0b\x6a\xdf\x7c\xaf\x83\x52\x70\xf4\xad\x71\xf6\x45\xdf\x4e\xc0\x8b\x80\xa3\x6f\x4f\xc1\x31\x80\xa3\x6f\x1f\x42\xac\xa2\x7f\xfb\x1b\x6b\x9f\xb7\xe5\xbb\x6a\xbd\x9a\x06\x96\xa8\x35\x33\x0e\xd1\xe2\x73\x9a\x6d\xdd\xad\x96\xa8\xb2\xdb\xd8\xb1\xab\xaf\x4a\xee\x9a\xae\xc6\xe7\x17\x2e\x8f\x99\x59\xd6\x3b\xf0\x91\x58\xe1\x8e\xcc\x8e\x8c\xf5\xb9\xd9\x08\x06\x44\xfa\x1b\x4c\x8e\xdc\x99\x2f\xa7\xa5\x27\x53\x8e\x0a\xc2\x6c\x39\x2a\x75\xc8\xea\x2b\x2a\x48\x48\x24\xff\x57\xcd\x7a\x83\xb5\xe0\xae\x91\x86\x83\xe1\xe6\x26\xb2\xc0\x3f\x40\xec\xb8\x4f\x02\x8c\x99\x08\x60\x00\x13\x85\x30\x60\x78\x7a\x6c\x6d\x08\xe3\xbf\x8f\x41\x26\x4b\xae\xb6\x4a\x89\xb6\x3d\xc2\x95\x18\x0e\x11\x4b\xae\x99\xca\xfe\xc4\x6e\xf7\x7b\x96\x64\x95\xb2\xbf\x72\x25\x2b\xfb\xb3\x5e\x97\x4b\x48\x0e\x78\x7b\xf6\x2f\x12\x63\x27\x76\x00\xc3\x71\xd3\x76\x19\xba\x18\xf4\xad\x96\x19\x43\x0d\x19\xe2\x94\x69\x56\x09\x1c\x44\xbb\x3d\x83\xef\x7d\x0e\x1c\x44\x5f\x95\x2d\xf4\x6d\x82\xad\xb9\x0a\x3b\x86\xab\xb0\xda\x39\x7f\x09\x9c\xe8\x14\x63\x72\xa5\xf3\x37\x98\xac\x29\x47\xa7\x0f\xe1\x88\x6d\xae\xce\xae\xfe\xfd\x69\x91\x5c\xd2\xe2\xe8\x9e\xe9\x73\x50\x5d\x77\xaf\x97\x5e\x27\x57\xad\xeb\xa5\x79\xdf\xf5\xca\x75\x72\x75\x08\x2f\x9e\x2a\xe1\xc4\xf8\x65\x2b\xd2\xcf\xd2\xea\x69\xc8\xd2\xde\xc7\x24\x4b\x7d\xdb\x60\x81\x75\x18\x33\xf0\x70\xb9\xad\x58\xea\x73\x4d\xe2\x13\x78\x85\x41\x83\xe8\xeb\x0b\x5e\xdd\x1a\x8e\x73\xce\xf5\x5d\x95\x74\x99\x5c\x09\x51\x85\xfe\xff\x82\x3f\xa9\xca\xfc\x5d\x6a\xba\x20\xc6\x00\xe6\x9b\xf8\x94\x1b\xa7\xc7\xbd\xc0\x7d\x73\x50\xdd\xc1\x55\x9f\x03\xb1\xf7\x93\x4c\x2f\xc1\x1c\x83\xe2\xbb\xb9\xee\x69\xe1\x88\xe3\xe3\x34\x50\xcd\x0e\xfd\xdb\x2c\x17\x92\x81\x17\x14\x2b\x9c\x34\x15\x5e\xcd\xb1\x37\x38\xa7\x6a\xbf\xbf\xb2\x97\xf8\x86\xc6\x7b\x16\x56\x6d\x1c\x87\x3d\x9a\xa1\xee\xf7\x08\xe2\x08\xe9\x36\xed\x40\x91\xb7\x37\x97\xad\xf2\x02\x8e\x87\xa0\x23\xbc\xf7\x1e\xaa\xbd\x80\x0a\xa7\x41\x25\xb4\x07\x7f\x67\x7e\x74\xd0\xeb\x60\x22\xb5\x37\xff\x11\x12\x4d\x54\x1b\x32\x8f\x94\x88\x48\xd4\x69\xc2\xa7\xe8\x06\x22\x12\x1d\x55\x8f\x16\xb0\x42\xfb\x50\x03\x0f\x33\x68\x01\x78\xa8\x9c\x78\x5e\x77\x6f\x09\x23\x88\x08\x96\x1b\x5d\x46\x01\xca\x74\xb2\xc5\xd3\xdc\xa8\xfd\xb6\xb6\x28\xb8\x82\xa2\x02\x13\x38\x20\x82\x74\x33\xa4\x12\xec\xfa\x70\x03\x34\x8e\xb7\x7e\xaf\x43\x05\xd1\x6a\x4e\x1e\xc7\x28\x4f\x72\x37\x82\x59\xf0\x7b\x44\xc1\x80\xc6\xd3\x20\x89\x72\xa3\x10\xcd\x13\xad\x22\xa2\x2b\xd8\x79\xed\x07\x01\xdd\xe5\xe1\x5e\xd0\x4d\x71\xb2\xb3\x04\x94\x6a\x3c\x04\x74\x78\x80\x59\x04\xe7\xaf\x8c\x5e\x7e\x6c\xc3\x71\xce\x1b\x0f\x3b\x61\x03\xb4\xa7\x26\xb2\x71\x03\x0c\xaf\x0b\x6e\x56\xe9\xb0\x00\x20\x6c\x4d\xc6\x36\x30\xe4\x29\xdc\xf9\x95\xc7\xd7\xd6\x59\x51\x4a\x50\x5c\x69\x07\xc9\x32\xaf\xd3\xdd\x3d\xaf\x5d\xb4\x16\x10\x40\xa6\x2c\x17\xb5\x87\x71\xbf\x5f\x29\x1d\x56\x0a\x08\x5e\x7b\x6e\x52\xf3\x0d\x84\x2d\x8c\x60\x1b\x6a\xf7\xbc\x92\xbb\x11\x2a\x34\x91\x5a\xe1\xc3\xa9\xb9\xa7\xe5\x12\x45\x0f\xc0\x4e\x0c\x2e\x30\x41\xd0\x5a\x7f\x71\xc9\xa8\x23\x12\x25\x9c\x4d\x02\xec\xcb\x50\xd8\x3b\x88\x58\xe9\xc4\x6f\xce\x0a\xb0\x56\x80\x8c\xae\xa5\xa5\x0b\x13\xbb\x1a\x39\x29\x86\x64\x27\x5a\x78\x2d\xfd\x89\xe0\xc1\xdb\x07\xbf\xfb\xe2\x01\x81\x38\xf6\x23\x68\x79\xda\xee\x3c\x27\xd2\x1a\x5a\xaa\xe0\x59\x87\x29\x0f\x3c\x5b\x2b\xa2\xb5\xa1\xa9\xd5\x8a\xee\xf7\xc2\xfc\x70\x1e\xaa\xce\x6f\xd4\x86\x8e\xd4\x4e\x7c\x07\xd2\x81\x30\x98\x88\xb6\x1f\x85\x8e\xfd\xaf\xa6\xe2\x07\x3a\x9e\x8a\x93\x13\x77\x3c\x83\xed\x85\x54\x14\x9c\x46\x94\x8e\x4f\x09\xc8\xe4\xb4\xea\x1d\x17\x27\x20\x57\xd8\x10\xe8\x10\x1d\xe6\x41\x84\xb1\x09\xbc\x6b\x37\xed\x07\xd1\x88\x1f\x5a\x9b\x09\xb4\x9b\x56\x96\x6d\x13\x98\xec\xe6\x4b\x89\xb4\xea\xb9\xfa\xa5\x47\x9d\x56\x6e\x1b\xd1\xa3\x6d\x3e\x2d\x9d\xa4\xdb\x24\x27\xee\x62\x39\x7c\xf4\x71\xbf\xd2\xf0\x5a\xb7\xa6\x8a\xcf\x11\xe2\x1c\x1d\xd7\x08\x7c\x32\x09\xa7\x47\x3b\xcc\x40\x79\x0d\x7c\x85\x30\x82\xce\x38\x86\xc2\x46\xa0\xf7\x41\x06\x1f\x1a\x27\x6a\x13\x64\x10\xbc\xa8\x1f\x7e\xd7\x59\x93\xdf\x7d\xd5\x08\x30\x9f\xe9\x1a\x6e\xf9\xc0\xbf\xbf\xcc\x02\xee\xe4\xdf\x9d\xfe\x6b\xae\xbb\xd9\x83\xe3\x37\x5d\x87\x72\xef\x41\xee\xe2\x20\x96\xcb\x5b\x98\x90\x86\x9a\xff\xd7\xe9\x18\xc2\x32\x8f\xc0\x7d\x6d\xab\xc9\x5e\xd6\x8c\x14\x7d\xb0\xf8\x24\x47\x09\xff\xfc\x35\x3a\x4e\x19\xe8\x5c\xa5\x7d\x7b\x46\xc3\xa5\x43\xf0\xba\xb8\x0f\x35\x0b\x1e\x28\x99\x36\xb2\xaf\x57\xe6\xc0\xa6\x23\x69\x8d\x49\x4f\x5e\x15\xc7\xa8\xa2\x5b\xb7\x2d\xf6\x0c\x85\xcd\xac\xe6\x8b\x19\x96\x02\x73\x64\x7e\x41\x14\x75\xeb\x23\xdf\x0a\xee\x8d\x31\x5c\x89\x66\x07\xb2\x3c\x36\xb7\x98\xa6\xfc\x2e\xcd\xac\x69\xa1\xe7\x06\x96\xbe\x4b\xd2\x09\xa2\x0b\xdc\x06\x85\x26\x08\x08\x42\x48\x76\xd6\x7d\xbf\x82\x27\x6a\x46\x55\x1a\x45\x07\x7c\xe8\x96\x39\xd6\x98\x84\x8b\xdd\x16\x2e\x10\xef\x57\xad\x1c\x5a\xaa\x8b\xb4\x07\x4d\xbc\x75\x5a\x6e\x94\x8e\xc1\x69\xbb\x7d\x63\x8d\x11\x70\x41\x82\x43\xb3\x6f\xfd\xbe\x76\x83\x16\x7d\x1e\x43\xd0\x84\x6e\x00\x2c\x27\xfd\xd5\x21\x07\x81\x05\xdb\xce\x1a\x0c\xc6\x92\x74\x7f\x05\x47\xef\x9d\x3a\x8d\x9f\x79\x7f\xb5\x26\xbf\xbf\x26\xc8\x56\xf7\xd5\x84\xfc\xfe\x9a\xc7\x13\xd6\xe2\x4c\xdd\xa5\x45\x04\xb5\xe1\x7d\x7f\x98\xcc\xd4\xc9\x24\xd5\xd7\xab\x27\xe0\x28\xaa\x5d\xff\x84\x09\x27\xdf\xac\x33\xb9\xb0\x8a\x04\x9e\xb4\x3b\xb4\x66\x59\x4e\xe6\x21\x5c\xde\xc5\x58\x34\x91\x3a\x6c\x1e\x2c\x26\xbb\x36\x74\xf0\xe1\x20\x60\x36\x76\xab\x06\x22\x2d\x69\xf7\x02\xdc\xba\x61\x54\xfc\xdf\xcc\xbb\x81\x67\x57\xb4\x67\xf2\x3f\xc2\xb3\xed\x9e\xa7\x6f\x0e\xf5\xd4\xfe\x9f\xe1\xb2\xfe\x81\x9f\xde\x3e\xd9\x0c\xc9\x38\x76\x1c\xd1\x95\x85\xab\x1a\xd6\xe1\xcd\x48\x64\x89\x12\xcf\xc5\x7b\x26\x9f\x64\x35\x43\xd8\x4b\x3d\xb2\x9d\x8e\x67\xa8\xa9\x12\xd6\x6e\xd8\xa9\xb3\x06\x60\xd2\xf4\x46\xa5\x79\xfe\xa8\xa9\xa0\x99\xb2\xfb\x80\xc7\x39\x30\x4e\x83\xf2\x51\xe4\x58\x72\xde\x1a\x53\xcb\xdd\xde\x3d\x71\xd4\xcf\x8b\xb3\x6e\x40\x73\x1d\x8e\xc8\x75\x49\x2a\xe8\x14\x82\xa2\xd9\x0b\xce\x27\x13\x3c\x93\x29\xc4\xeb\x88\x48\x69\xf3\x78\x23\x5e\xcf\xb8\x2d\x37\xc1\x69\xf3\x38\x12\x9c\x70\x14\x09\xa4\xda\x51\xf9\xcf\x72\xf2\xf2\x7f\x9a\x93\x57\x21\x27\x2f\xff\xfb\x9c\xbc\xfc\x44\x4e\x0e\x8e\x88\x9f\xca\xc1\x6d\xd9\xcf\xe0\xdc\xad\x1a\x9f\xc4\xb1\x5b\x35\xfe\x0f\x70\x6a\x0b\x43\x89\xee\x66\xd6\x00\x52\xc8\xa4\x8f\x78\x6d\xf9\x31\x5e\xdb\xab\x86\xef\x99\x36\x27\xba\x33\x0c\x57\xdc\x0e\x9f\x23\xbb\x7f\x26\xbb\x27\xaa\xb9\x5f\x48\x55\x73\x27\xcc\x1d\x63\x3f\x2f\x68\xc5\xee\xcf\x5b\xa1\xb2\xb3\x0f\x39\x63\x05\x2b\xb4\xf7\x78\x3a\x1c\x93\x3f\xbf\x39\x7f\xfd\xf8\xf2\xec\x2f\x4f\xce\xce\x9e\x9e\x3d\xbd\x3c\x7b\xf9\x12\x9e\x10\x83\x41\xec\x5e\xb1\x7c\x2b\x4b\x75\xeb\xca\x1e\x7a\x65\xf0\xe8\xf7\xbf\xb7\x61\x55\x75\x50\xa8\x69\x08\xe8\x91\x28\x68\xdd\x86\x6a\x56\xd7\xa5\xe0\xaf\x94\x90\xd9\x8a\x69\x9f\x21\x2b\x24\xce\x7a\x4b\x58\x97\xb8\x67\x8a\x5d\x23\xd0\x28\xe3\xb4\xbf\x58\xcd\x94\x2f\x43\x3a\x9e\x62\x0a\x07\xf1\x99\xcb\x25\xaa\xe6\x4c\x5f\x82\x72\x81\xae\xe1\x20\x2b\x5d\x5a\x1c\x8f\x9b\x60\xa4\x9d\x4e\x2c\x6f\xb6\xb5\x8c\x3f\x10\x3c\x46\x19\xce\x56\x88\x26\xeb\xdf\xdc\x5c\xf0\x54\x77\x34\xbc\x0a\xa0\xbf\x1f\xd6\x03\xa8\x23\x34\xda\x2c\x51\xea\xb1\x6a\x66\x8d\x54\x50\xf5\xf0\x59\x66\x88\x0e\xa8\x8e\xde\x41\xf9\xe2\x74\x13\x12\x74\x13\x0c\x83\xe1\x51\x75\x29\xff\x7b\x20\x9a\xff\xd9\x7b\xcf\x9f\x17\x40\xf5\x3e\x36\x74\x32\x81\xf7\x0d\xfd\xdd\x3e\xad\x9b\xb2\x77\xf3\xfe\x23\x82\xab\x60\xd3\xc1\x4d\x59\x97\x6a\x00\x6e\xf6\xe9\x83\x07\xcb\x2c\x67\x57\x42\xbc\x4b\xac\xbb\x7d\x29\x1e\xe8\x27\x8b\x1f\x14\x22\xaf\x1f\xe8\x8a\x27\xe6\x01\x2b\x99\xac\xd5\x75\x35\x2b\xdd\xcd\x3a\x1a\x8d\xe0\xa9\xc0\xf1\x54\x3c\x52\x53\x31\x1a\x61\x3e\xa2\x11\xf8\x43\xd4\xf3\x05\xe4\x1d\xbb\x38\x36\xbc\x4f\x8c\x26\x0b\x6c\x49\x4c\x51\xbd\x0e\x11\x1f\x45\xed\x1b\x85\xee\x2e\xa1\x90\x9f\x7e\x1d\xb1\xb9\x8a\x58\x7f\xc2\x1d\x44\xa2\xf4\xaa\xe8\xbf\x2e\x48\x54\xe7\xa2\xa0\xea\x89\xcb\xda\x28\x94\x21\xae\x53\xa0\xcd\xa5\xca\x7c\x82\xef\x16\xbd\x31\xbf\xed\x33\xcb\x94\xef\xf7\x2f\x9a\xb6\xaa\x7f\x61\x5b\x19\xc2\xbb\xe6\xab\xfc\x17\xb6\x9c\xbb\xb6\x80\xec\x04\x91\x60\x97\xaf\xb4\xcb\x03\xec\x06\x10\x8d\xd1\x1e\x88\x87\xd4\x84\x34\xd4\xea\x12\x2f\xaf\x02\x0f\x59\xea\x40\xb8\xfa\x17\xb6\x57\xc9\x87\xfe\xd5\x0e\x54\xd1\x28\x1a\xe9\x60\xf4\x98\x28\xfc\xca\xc5\x39\x04\x3d\xca\xf0\x75\x47\x50\x47\x90\x8a\x24\x68\x57\xe0\x4d\x12\xf7\x9e\xdc\xd1\x5a\x38\x05\xde\x37\x81\xc8\x1a\x58\x36\xc1\x17\xb9\x0f\xbe\x38\x79\x54\x36\x6b\x29\xb7\xfb\xb7\x8e\x2a\x3b\x9e\xd6\x8f\x4a\xe3\x55\x37\xaf\xc3\x5d\xbb\x1e\x9d\x2e\xa6\x41\x63\x39\xb0\x2c\xbd\x2d\xd9\x0d\x19\x14\x41\x75\x83\x81\x92\x76\x72\xbc\x53\x2a\x80\xef\x46\x51\x06\xa6\xcc\xdd\x17\x5f\x18\x59\x35\x7d\x4f\xe0\x47\xca\xf4\x9b\x38\x15\xdc\x16\x4b\x33\x6d\x9f\x82\x67\xe8\x2e\xc5\x7b\xce\x64\xfa\xb7\x44\x3f\xdc\xce\x43\x4f\x96\x3a\xd8\xc4\xba\x5e\x97\xcc\xc8\xfb\x10\xeb\x05\x80\x76\x7d\xc1\xa6\xd0\x34\xb0\x6d\x78\xe6\x2e\xa2\x51\x1a\xd1\x71\x44\x22\x78\x65\x91\x9e\x46\x4e\x86\x8d\xbe\x88\x46\x28\x8a\x46\xa1\x56\x69\x4e\xd3\x45\x78\x43\xd7\xc3\x61\x9f\x3a\x68\xba\x08\x1c\xeb\xcb\x25\xfa\xc5\xce\x98\xd3\xc6\xfe\xe2\xde\x73\xb3\x96\xfd\x44\xb2\x7a\x5b\x29\xca\x88\x04\x0a\xb9\x90\x6c\x59\x7e\xa0\x8a\x98\xd7\x3e\x28\x27\xd2\x53\x34\xc4\xd2\xcf\x21\xba\x1b\x1d\x13\x69\x8f\x6e\x3b\x53\xdd\x60\xd2\x54\x4e\x95\x76\x6f\x49\x39\xb1\x15\x53\x41\x74\xb5\x74\x1c\x60\x12\x1c\x81\x76\xcc\xf5\x0e\x88\x23\x2c\x00\xc0\x26\x40\x71\xf7\xdb\x81\xe1\x3f\x0d\x24\x93\xf1\x0f\x6e\x8c\x71\xfc\x8b\x0b\x35\x74\xe4\xcc\x4e\xa4\xbf\xf0\x69\xa7\x6b\xda\xf2\xe9\x85\xb7\x69\x23\xb0\x10\xb2\x0c\xf4\x5e\xb4\x02\xeb\x98\xee\xda\x39\x89\x9b\xc7\x86\xdc\x9d\x25\x0c\xe1\xa1\x0d\xb9\xd7\xef\x4b\xd8\x3c\x2b\xbc\xcb\xb3\x9a\xb9\x73\x51\xaa\x3f\xec\x85\xd0\x54\x97\xd6\x7a\xec\xa9\x4e\xb7\xc4\x93\xda\xba\x0d\xb9\x98\x46\x06\xef\x75\xf5\xc1\x3b\xf3\xe7\xcc\xfc\x79\xa2\x9b\x39\xc0\xba\xf0\xe1\xb1\x39\x92\x84\xd9\x67\x6e\x67\x51\x12\x8d\xc0\x4f\x6d\x8c\x53\x85\x89\x86\x37\xa3\x63\xa2\x68\x93\x9f\xaa\x51\x94\x46\xa4\xfd\xb8\x10\xc3\x3e\x56\x6a\x49\xc7\xd3\xb2\xb9\xe1\x5a\xc2\xf1\x1f\x2e\x40\x96\x0b\xfb\x3c\xa4\x1a\x5d\xa1\x8a\x94\x78\x9a\x8d\xe8\x06\x55\x24\x27\xdc\x1b\x1e\xee\x78\x62\x65\x96\x6b\x44\xa6\x28\xa7\x17\x71\xcc\xe6\x17\x8b\xfd\x9e\xcd\xa3\xdf\xff\xde\xc5\x58\x8e\x16\x24\xef\x8b\xd1\x9c\xcf\x72\xfd\x7e\x16\x26\x7d\x99\x1a\x66\x46\x73\x6b\xfa\x87\xd3\xff\x78\x0a\xf7\x90\x98\xbe\x27\x88\x30\x4e\x0a\xc1\xd9\x14\x57\xb4\x4a\xf4\xfd\x3a\xe2\xc1\x1f\x8d\x30\x69\x0d\x40\x4f\x65\xb3\xa6\x81\x1c\x10\x98\x34\x60\x27\x46\xd1\xc3\x49\x44\xfc\x23\x59\x46\xb0\xd1\xcf\x64\xf1\x99\xad\x62\x5e\x84\x81\xf7\x3e\x06\xbb\x68\x64\xb5\x21\xf0\x09\xaa\x61\xe3\xcf\x4b\x06\x11\xd6\x37\x9c\x39\x89\x82\x07\x9c\xb3\x86\x54\xaf\x5a\xde\x09\x1e\x96\x3e\xfe\x62\xc3\x55\xc1\x8a\x99\x6d\x91\x5e\x39\x38\x6d\xae\x67\x43\xd8\x9f\xa6\xdd\xb5\x69\x97\xe9\x25\x6d\xb1\xe5\x96\x13\x51\x6e\x2d\xb5\x7c\x28\xaf\x5b\x9b\x12\x75\x0b\x55\xdb\xc6\xfc\x2a\x9d\x32\xfa\xf1\x36\x8f\x48\x6d\xb6\x42\x8c\x08\xc2\xc9\x6d\xdf\x23\x19\x38\xb5\x43\x8b\x63\x54\x5b\xc3\x35\x95\x23\x34\xd4\x63\xd4\x7e\x9f\xca\xbe\x4a\xa5\x53\xe0\xd2\x90\xe6\x95\xf0\xd1\xf0\xcb\x9f\x49\xf4\x45\x0c\x06\x25\x6b\x55\x62\xf4\x98\xf9\xeb\xcb\x7d\xc0\xb7\x52\xa5\x77\x00\x18\xe4\xd2\xee\x02\xcc\xda\xb2\xed\x5e\xc0\x12\xf3\xe3\x80\x89\x70\x2c\x26\xc0\xd6\xca\x60\xcb\xf8\xf2\x01\xc6\x60\xcb\x9d\x9a\x81\x80\xf5\xb7\xa2\x00\x22\xbf\x03\x3c\xa2\x28\x04\x88\xac\x74\x75\x62\x75\x46\xfb\x3d\x30\xae\x28\x22\xd7\xda\x7d\x1e\x64\x74\x68\xf8\x12\x6c\x13\x0f\x31\xb9\x81\xbf\xdf\x61\x7d\x75\x61\xf2\x15\x38\xee\xf5\xac\x10\x17\x5b\xdc\xc6\x15\x5f\x0a\x49\xde\xd3\x0f\xb3\xe6\x13\x45\x5a\x22\x75\x2f\x30\x45\x38\xfd\x66\x3c\x19\x3f\x24\xef\x7a\x4b\x01\xe1\xd8\x22\x5f\x91\xb3\xde\x22\x96\x74\x4d\xa1\xaf\xc9\x93\xde\x42\x70\xc5\x22\x73\x2d\x7d\x43\xce\x7b\x0b\x2d\x65\xb6\x0a\x60\xfa\x96\x5c\x7c\xc2\x08\x1d\x3f\x21\x2f\xe8\xae\xac\x75\x74\x55\x56\x34\x5a\x06\xb7\xb4\x86\x93\x03\x61\xfc\xef\x5b\xb6\x65\x3f\x09\x99\xb3\xe3\x08\xba\x3e\xff\x65\x10\x76\xa4\xb7\xc0\x2b\xa6\x8e\x33\xe1\x15\x9a\x46\x15\x0a\x76\xc7\x2c\x57\x5e\x5a\x07\x01\x2f\xcc\x77\xa1\x4c\x3b\x67\x5f\xc7\x00\xbc\x11\xa6\xe5\xe0\x17\xa6\xfa\x15\x23\x50\xf4\xdd\xd7\x11\x6e\x49\x9b\x49\x07\x50\xed\xd7\x01\xaf\x08\x90\xc8\x75\x1c\xe1\x36\x40\xcb\x06\x29\xad\x63\x69\x5f\xb3\x01\x02\x5d\xcb\x51\x50\x1f\x9a\xce\x9a\xa6\x69\xd0\x8d\xde\x4f\x9e\xd3\x20\xde\xbd\x8e\x4c\x92\x4d\x9f\xb7\x42\xe4\x57\xe4\x12\x3d\x0f\xc1\xc3\xe4\x79\x52\xd6\x17\x5b\xc9\x3a\x68\xb5\xda\xee\x97\xb4\x3c\x6a\xf2\x65\xab\xc9\x92\x5c\xa2\x97\xed\x26\x5f\x26\x5b\x5e\xab\xec\xaa\x62\x97\x65\xad\x63\x0a\x1e\x35\x4e\x5e\x26\xc6\xfc\x4b\x8f\x08\x6a\xd0\x1c\x03\xbc\xfc\x6a\x6e\x85\xfc\x8d\xee\xac\x14\xa9\xf9\xda\x81\xbc\x72\xba\xf8\xbb\x54\xe5\xe4\x35\xd5\x81\x7b\x86\x63\xcd\x94\x86\x63\x72\x79\x59\xb3\xca\xfd\xd2\x37\xf0\x40\xdf\x42\x7e\xa6\xf6\x05\xd8\x5f\xe0\x1e\xcd\x1b\xba\x7b\x62\x7b\x4e\x77\xd7\xd9\xa6\xa1\x47\xcb\xc8\xfd\xde\xdc\x79\xac\x5e\x04\xf1\x3d\x2c\x67\x06\x01\x0b\xea\x10\x71\x20\xf6\x1a\xc0\x27\x35\x07\x7c\xac\xb9\xfb\x00\xc5\x8e\xb8\xd9\xda\x73\x33\x2b\x13\x36\xed\x7a\x64\xda\x3a\xb3\x71\x6a\x2b\xb5\x36\x8a\x5f\x5c\x07\xe0\x9c\x20\xf4\xe6\x12\x00\xe7\x84\xeb\xf6\x98\x94\xae\x70\xc7\x86\x43\xd4\x81\x80\x13\x51\x1f\x28\xb0\x03\xed\xf7\x02\x45\x93\xaf\x1e\x82\x72\xfc\x70\x20\x9e\x24\x52\x49\x80\x08\x9b\xef\x8a\x78\x1a\xd2\x14\xd4\xe4\x94\xe4\x27\xcb\xce\xd2\x73\xd2\x52\x57\xa4\x39\xc9\x2b\xc1\xfd\x67\x03\x43\xb8\xfd\x5e\x6a\xef\x5b\xa0\xd7\x4d\x8d\xdd\x1e\xac\x9d\xa7\x60\xc7\x02\xdf\x12\xb3\x3d\x85\x27\xc5\xe6\x9a\x5e\x70\x48\xac\xcc\x21\x91\x64\xd4\x9f\x6e\x7a\x0e\x8c\x32\x38\x30\x9a\x5d\x12\x0e\x34\xf0\xb7\x73\xe0\xd2\xf2\x62\x4f\x8e\xb6\x0e\xe5\x70\x48\x0b\xce\x9b\x79\xef\x79\x13\x52\x91\x98\xe7\x0b\xea\xe5\x45\x35\xcf\x17\x81\x41\xa6\x9c\x95\xf3\x7c\x91\x42\xaa\xd9\x03\xf3\x7b\x8e\xa3\x39\x16\xfd\xc7\x51\x88\xe1\x64\x8f\xa1\x39\x9e\x76\x6e\x7c\xe9\xe3\x68\xd9\x73\x1c\x0d\x1a\x2b\xdd\x89\xe7\x1e\x39\x42\xea\x25\x5b\x59\x19\x42\x38\xe9\x21\x3b\x38\xcd\xf7\x4f\x19\x30\xa0\x36\xa9\x01\x1c\x8a\xe6\xc1\x7d\xed\xd0\x97\x09\xda\x06\x05\xc8\x81\xb4\xa3\xf5\xa7\x35\xb9\x61\x12\x14\x9e\x69\x34\xf9\x26\x39\x4d\xc6\x11\xb9\xbc\x7c\x75\xf6\xe4\xe5\xd9\xeb\xcb\x67\xbf\xbc\x3e\x7b\xf9\xcb\xe3\xe7\xaf\x2e\x9f\x9e\x5f\xfe\x72\xfe\xfa\xf2\xcd\xab\xb3\xcb\xf3\x97\x97\xff\x79\xfe\xe6\xf2\xb7\x67\xcf\x9f\x5f\xfe\x78\x76\xf9\xd3\xb3\x97\x67\x4f\xd3\x9d\x61\x73\x86\x45\x9d\x03\x0d\xa5\x7f\x23\xc6\x4c\x98\x5e\x1e\x0e\xe4\x99\x63\x57\x4b\xc9\xd8\x3f\x18\xf2\x76\xb7\x37\x07\x4c\x9e\xd2\x67\x71\xfc\x66\xbf\x7f\x16\xdc\x9c\x7c\xea\x08\x61\xe6\x7f\xa5\x4f\x3f\xa6\x82\x73\x6a\x69\x90\x64\x40\x25\x7e\xfa\x9d\x8d\x09\xf8\x75\xef\xa5\xcc\xe0\xaa\x05\xf3\x92\x57\x46\x4a\xbc\x2b\xe1\xe4\x06\x1e\x8f\xc3\x09\x89\x9e\x64\x55\x55\xf2\xd5\xe0\xc2\xb9\x0a\x0d\x74\xa8\x0d\x10\x10\xea\x81\x71\x3d\xab\x6e\x21\x5a\x09\xdc\xbd\xb4\x61\x05\x59\x31\xb8\xba\xd5\x0a\xb1\xff\x0d\x93\x78\x02\xe8\xaf\xff\xf7\x60\x93\xe5\xef\x40\xa9\x3c\x78\x53\xb3\xa6\xbd\x24\x5f\xb3\xfc\x9d\xff\x44\x78\xa0\x84\x7e\x21\x12\x1a\xb8\x4e\x06\x2f\x59\x56\x0c\xae\x85\x64\x83\xac\xd1\x0a\x5e\x25\xd7\xec\xc1\xb6\x66\x27\xba\xf2\x49\xd3\x4b\x18\x18\x4a\x35\x5b\x0b\x3b\xb0\xc0\x73\x89\x3a\x57\x88\x9d\x7e\x41\x25\x65\x04\xce\xac\xa9\xb9\x87\x04\xd6\x36\x7d\xde\x4c\x19\x31\xc2\x43\xca\x08\xe0\x98\xaf\xe0\x87\x96\xb1\x52\x46\x32\x7e\x0b\xff\x42\xfd\xf3\x65\xaa\x88\x95\xfe\x52\x46\xdc\x6d\x5d\x9d\xcc\x45\xc1\x7c\x43\x3a\x45\x7b\xea\xba\xbf\x30\xe4\x54\x11\x1d\xae\x1b\x1a\xf9\x90\xe5\x2a\x6d\xdc\x95\x78\x07\x39\xfa\xc5\xd5\xe6\x8b\x13\xfe\x51\x45\x73\x33\xf3\x91\xa5\x6b\x4b\xcd\x17\x8f\x5f\xbd\xba\x7c\xfd\xf3\xb3\x57\xbd\x34\xfd\xd1\x77\xed\xba\xef\x63\xb5\xf4\x00\x56\xaa\xba\xbc\x7c\x79\xf6\xf8\xc9\xeb\xcb\xa7\x67\xbf\xbe\x3e\x3f\x7f\xfe\xea\xf2\x0f\xcf\xcf\x7f\x7c\xfc\xfc\xf2\xe7\xf3\xf3\x3f\x5d\x5e\xf6\xde\xb4\xb8\xbf\x8a\x41\xc7\xd3\x27\x67\x5a\x07\xff\x89\x65\x91\x08\x34\xf3\x20\xc1\x88\x8a\x25\x5a\x73\x0c\x2e\x60\x07\x81\x70\xf0\x7a\x20\x47\x5f\x7d\x8b\x3f\x63\xec\xff\xbf\xb2\xfb\xdf\x43\xd9\xed\x56\x3a\x62\xb1\xc2\xa0\xa6\x39\x8a\xee\x54\x2e\xd1\x73\xde\xdd\x38\x41\x30\x39\xf5\x8a\x9a\x38\x46\x91\x00\x8d\x04\x9b\x8f\x21\x54\xde\xb9\xfb\x0d\xdb\x6b\x04\x54\xea\x82\xe8\xfd\xe2\x7e\x07\x17\x25\xbc\x30\xe7\x5d\xae\x86\xe3\xa9\x55\x4d\x59\xea\x56\x56\xbb\xe5\x54\x64\xa9\x5d\xe7\x7d\x80\xcd\xc0\xf3\x24\x45\x8a\x6a\xb3\xd4\x8c\x99\x17\xa0\xed\xf5\xe6\x5f\x41\x11\xb3\xdf\xeb\x24\xa3\xac\xe8\xc9\x38\xbf\x61\x12\xee\xdd\xb1\x22\xcc\x4c\x11\x28\x1c\x5a\xae\x11\xd6\x3f\x60\x4c\xbe\xc6\x84\xd1\x08\xe2\xe5\x9d\xe8\x01\xee\xf7\x11\x4c\x85\xf9\xc0\x84\x19\xb5\x5b\xb3\xd2\xdb\xea\xb9\x63\xc5\x9d\x3d\x7c\xd9\x61\x0e\xc7\x53\xb7\x99\xd9\x84\x49\xa0\xcb\xcc\x02\x89\xf1\x6f\x7d\xf8\xf8\x1b\x9f\x33\xf3\x12\x7c\x53\xa9\x0c\x2a\xc1\x74\x24\x4a\xbc\xd9\x6c\xdc\xb8\x8e\xcc\x03\xa0\x1c\x70\x81\x1c\xf1\xee\x37\x9e\x5c\xae\xb3\xfa\x49\xb6\x5d\xad\x95\x36\xf2\xc0\x4b\x77\x90\x9a\x07\x49\xd0\xa1\xde\x2e\x6a\x23\xf9\x04\x67\x8e\xbe\x47\xfe\xc8\x43\x3c\x05\xe6\xa4\xbc\x8d\xbc\x0e\xd8\x4f\xb7\x71\x46\xfa\x80\x08\x55\xbc\xb5\x61\xaf\xb6\xd8\x4b\xa6\x8d\x52\xba\xb2\xbb\x34\x00\x59\x32\x48\xb7\x4b\xb9\x9b\xac\xb5\x87\xa4\xa7\x1d\x18\x34\x0b\x7a\xdc\x9a\x1e\xff\xc2\xbd\x3a\x93\x81\x10\xfa\xab\x15\xa4\x15\xfd\x15\x26\x82\x70\xfa\x17\xee\x7d\x6d\x98\x8e\x54\x71\x32\x79\xc4\xb5\xa0\xff\xfd\x37\x11\x5c\xb2\x1e\xfe\x89\xeb\xc8\x16\x2a\x61\xf0\x56\x64\xae\x74\xec\xd6\xda\x14\xf9\x56\x17\xd1\x25\xa8\xd2\x0e\x2e\xfa\x0e\x85\xde\xd9\xbc\x68\x29\xa1\x67\xdb\xb1\x73\x23\x07\x83\x0e\x84\x92\x2b\xa9\x22\x39\x95\xd3\x3f\x1c\x11\x0b\x88\xc3\xd0\xc5\xf7\x11\xc9\x31\xf9\x03\x07\xc1\x38\xb3\x73\x98\x25\x9b\x75\x56\xb3\xe2\x25\x5b\x95\xb5\x92\xda\x96\x06\x9e\xf4\x3a\x16\xa9\xbd\xcc\x5b\x41\xaf\x35\xae\xbb\xed\xc2\x45\x84\x02\xe9\x78\x79\x40\x40\xd3\x0a\xa6\x4a\x4b\xc7\x59\x22\x3b\xed\xcd\x50\x81\x8e\x53\xe1\xb5\x61\x90\xca\x86\x63\x9c\x56\x74\x38\x99\x56\x06\x19\xdf\x45\x44\x12\xd8\x8d\x0e\x47\x26\x08\xbc\xfb\x11\xf0\xad\x47\x34\x19\x8f\x35\xd6\x74\x0a\x55\xe4\xcf\xe6\x6f\x80\xb9\x39\x5f\x24\x05\x83\x58\x35\x8c\xe7\x25\xab\x9b\xe6\x60\x92\x76\x7f\xe1\xb6\x9d\x49\x84\xc9\x5f\xf8\x7d\x14\xcd\x30\xd9\x86\x4b\x68\xe3\xc5\x6c\xc2\x01\x72\x98\xa2\xe6\x62\x59\xf7\x00\xee\xdf\xfb\x07\xd7\x75\xb5\x98\xfe\x7a\x34\x49\x0a\xc7\xf1\xaf\x1c\xa2\x37\x50\x2a\xf7\x7b\xa4\x7f\x5b\xe8\x4e\x75\xdc\x5f\x9d\x42\x25\x74\x37\xc6\x07\x1e\xc7\x2d\x78\xae\xdc\x92\xc6\x3b\x7d\x57\xe6\x76\xc3\xf6\xfb\x68\xcb\xdf\x71\xf1\x9e\x9f\x68\x8c\x40\x04\x30\x7b\x40\x7b\xad\x2f\xeb\x50\x26\xc0\x25\xe3\x37\x20\x5c\x08\x2f\xf3\x87\x6d\x26\x0b\x56\x80\x8c\x7b\x95\xe5\xef\x1e\xf3\xe2\x09\xdc\x9e\xfd\xa9\x94\xb5\x59\x8b\xe0\x88\x64\x8f\x76\x80\xf7\x6e\x73\x6d\x66\x64\x35\xc4\x96\x1d\x59\xc7\x0a\x3d\xa2\x87\xe3\xc8\x9f\xe2\x67\x2a\x3d\x52\xe6\xb6\x13\x94\x71\x55\xdb\xd6\x6b\xcb\x43\xa0\x59\xc2\x70\x6a\x53\xc1\x01\x8a\xe1\x4e\x2b\x0a\xcf\xe6\xcc\x7b\xe2\x28\x9c\xce\x19\x51\x8b\x1e\x5d\x74\xbb\x1a\xec\x33\x3e\x4a\x01\xe4\xa7\x0c\x02\x52\x59\x4f\xc3\xd0\x04\xb4\xf2\xdb\xa8\xa5\x03\xb8\x9a\x74\x09\x57\xdb\x00\x65\x2e\x20\x73\x4d\x44\x98\xfc\xcc\xca\xc2\x7a\x75\xb5\x7b\xe6\x8d\xb9\xc4\xc4\x8c\x74\xe1\x4b\xe2\x18\xa2\x00\xd6\x40\x28\xd9\x4a\xaf\xa7\x57\x4a\x6c\x36\xac\x00\x83\xdb\x68\x84\xed\xc4\x03\x0b\x10\x73\xb9\xb0\x17\x45\x78\x1c\x37\x14\x31\xed\x83\xcc\xd9\xbc\x8e\x81\x73\x39\x65\x7d\x01\xa7\x41\x18\x89\x42\x18\xae\x5b\x06\x0a\xaf\x44\xb2\x8a\xc1\x76\xc9\xda\x77\xd6\x9b\x0d\x08\x30\x34\x1c\x07\x28\xbb\x09\x76\x27\x9d\x19\x86\xd3\xbb\x0d\xef\x9f\x42\xe0\xaa\x4c\x31\x88\xab\x65\x03\x4e\xda\x6a\x7e\xf7\x91\xf4\x8f\x10\x37\xa8\x13\xb5\x17\x72\x39\x95\x70\x0f\x80\x39\x33\x98\x13\x32\xec\xe5\x25\xb7\x15\x9b\xaf\x27\xd9\x46\x6d\x25\xf3\x89\x4f\xc5\xf6\xaa\x62\xed\x82\x41\x5a\xb7\xf8\x0b\xb1\xad\xd9\x53\xf1\x9e\x1f\xa7\xf4\x16\x7d\x21\x6e\x7a\x52\x7a\x8b\xbe\xd9\x74\xbf\x7d\x31\x24\xe9\x50\x26\x45\x59\x83\x5a\xa8\x80\x28\x07\xcc\xae\x78\x22\x21\xe2\xbf\xb9\x3f\xeb\x24\x96\x92\x6f\xb6\xca\x7d\xd4\x3a\x66\x91\xfb\x02\x5b\x4d\x26\x59\xa6\xbf\xc1\x75\x9e\x0e\xa5\x31\x28\x0e\x98\x97\x4d\x18\x1d\x4e\xac\x9a\x62\x60\x9f\x95\x44\xbc\x57\x6b\x6c\x98\xe9\x29\xd8\xb5\x14\x71\x69\xed\xbb\xd9\x1f\x1a\x2e\xe5\xa9\xdd\x46\x66\xfd\x93\x8f\xd7\x53\x39\xf7\xe0\x8c\xfe\x89\xcf\xab\xc5\x34\xd3\xae\x08\x59\x7b\xdb\xf4\x2d\x81\x34\x2a\xe9\x1a\xc1\xcb\x91\xde\xfb\x57\x36\x7d\xbe\xb7\xae\x68\x48\x09\xba\x46\x4a\x90\x96\x99\xe5\x9d\xe7\xe3\x54\x89\xa9\x12\xf6\x6d\x13\xf0\x6a\x9d\x5d\x23\x45\x2e\x71\x0a\x7f\x6e\x30\x51\x42\x8f\xef\x7b\x50\x86\xff\x06\x9e\x8e\x5a\x66\x08\x24\x15\x14\xb6\x7b\x66\x7d\xa6\xd8\xbc\x12\xce\x5f\x6a\xa0\x3f\xfc\x6e\xae\x35\x8a\x43\x93\x66\x63\xe3\x59\x33\x10\x19\xf6\xc4\xaf\x1c\x00\x64\xda\x3c\xd6\x64\x69\xdd\x15\x77\x92\x00\x04\xe1\x85\xc6\xca\x25\xfa\x9a\x52\x2a\x12\x95\xad\xf6\xfb\x6f\xdc\x4f\xd7\x8e\xd0\x10\x4c\x01\x25\xb6\x06\x9e\x82\x3c\xad\x8d\xf8\x98\x53\xe1\xcf\xdb\xcd\x70\x9e\xd8\xe1\x40\xbb\xac\x69\x97\x85\xed\x86\xab\x16\xb8\xfc\xc3\x50\xfb\x70\xde\x12\x4d\x33\xb1\x30\xd7\x1c\x9b\x02\x17\x50\xa0\x10\x3b\x18\xa0\x75\x2f\x7b\xbf\x2e\x2b\x86\x58\x1c\x7f\x3d\x74\x5d\x39\xd0\x58\xb7\xfa\x0b\xc7\xd0\x1d\x76\xb5\x0e\x9a\x4d\xb1\xb7\xad\x11\x46\xa1\x0f\x3d\x78\x46\x85\xa3\xb6\xf1\x23\x76\x72\x32\xc5\x0a\x09\x10\xe3\xa2\xdc\xac\xb2\x02\xc2\xe6\xdb\xa2\xe3\x29\x7b\xe4\x8b\xb3\xd1\xc8\x97\xbd\xda\x5e\xc1\x0a\x8c\x5a\x24\xfe\xdc\x90\x38\xde\x21\x45\x81\xa9\xf1\xc4\x31\x58\xfd\x9c\xc5\xea\x2e\x81\x0b\x22\x01\x02\x29\xf3\x3e\x5e\xbd\xee\x4d\x86\x7d\x90\xf7\x31\xf0\x35\xea\x4b\x6e\x13\xfe\x4b\xef\xa1\xf9\x69\xf0\xc5\xf1\x0b\xc4\x92\x4b\x73\xcd\x17\x36\x32\xf2\xbc\xb5\x21\xfe\xcd\x11\xfc\xa7\x37\xe9\x16\x5e\xab\x59\x08\x45\x36\xbb\x40\xd6\x68\x4b\x5e\x20\xdb\x51\xd3\xd3\x2b\x87\x61\xf0\x8e\x89\xe3\x23\x04\x77\xa5\xcc\x38\xbe\x6b\x2a\xba\x25\xff\x8f\x4e\xc0\xeb\xbb\x26\xe0\x18\xfe\x57\x1d\xcc\x5b\x8d\x6e\xd3\xd6\xcf\xd0\x16\xc8\x34\x2f\x83\x0e\x7e\x69\x98\x2d\x1c\xc6\xe3\x58\x60\x96\xfa\xf5\x21\x29\x27\x15\x15\x10\x2c\x0d\xc2\x3d\x4d\xcb\x69\x49\x2f\x50\x89\x71\x36\x1a\x4d\xc1\xeb\xc1\x15\xcc\x69\x35\xcd\xa7\x39\xbd\x40\x39\xc6\xa5\x8d\xe5\x3d\x1d\x3f\xca\x4e\xca\x29\x96\xf4\x42\x5f\x0f\x3c\x39\x71\xc9\xe5\x49\x06\xde\x11\x17\x70\x8f\xb7\x74\xc9\x19\x2c\x31\x60\x71\x12\xdc\x20\xf6\x7b\xfd\x07\xa2\x37\x30\xc9\xe1\x79\x09\xb7\xe9\xd8\xe6\x4c\xf5\x83\xd4\xcc\xd8\xdc\x3f\xb5\x27\x4f\xe8\xa4\xa2\x92\x48\x58\xdd\x3c\x8e\xf9\xd0\xba\x55\x68\xf1\x92\xa2\x8c\xf2\xa0\xd9\xfd\x3e\x83\x7c\x3c\x85\x68\xe1\xc0\x5a\xc1\x7a\x44\x2f\x90\x5d\xda\x10\xa6\x7b\x0a\x5c\xfd\xa8\x11\xd1\xdb\x88\xf5\x83\x17\x10\x5e\xf5\x02\x09\xd3\x88\x51\x33\xf9\xc8\x49\xa0\x6d\x7a\xa5\xdd\xc4\x02\xfe\xe0\xb9\x0e\xef\x70\x9d\x57\x88\x77\xb8\x8e\x0a\x26\xf0\x8d\xd7\xd8\x0e\x6b\x11\xc7\xb7\x3c\x7c\xb9\x06\xd5\xc2\x04\xf7\xd5\x4f\x95\x72\x15\x95\x41\x80\x42\xf7\xc3\x2a\xf6\x67\xad\x82\x69\x54\x72\xce\xe4\x6b\xf6\x41\x45\x98\xd4\xa2\xe9\xf0\x99\x39\xeb\x6e\x45\x72\xb9\xb4\x87\x00\x28\xe5\x18\x7c\x37\x5d\x4b\x62\x9a\xc6\x28\x64\xe9\x57\x5b\x20\x9d\x40\x7c\x4f\xeb\x67\x2f\xe9\x53\x04\x47\x3c\x8f\xa0\x80\xa7\xc6\x31\x8c\x5e\x3b\xd7\xb1\x85\xe6\xac\xd6\x2d\x4a\x9c\x30\x8d\x30\x73\x7f\x08\xe2\x50\xf1\xb9\x38\x31\x67\xa3\x79\x75\xa2\x16\xfa\x0a\xd1\xf4\x0e\xb0\xfc\x4d\x10\x46\x26\x8f\xd4\x6c\x72\xa2\xec\x93\x38\x98\x74\x8b\x36\x43\x7f\xea\x71\x1d\x69\xb7\x9e\xa8\x34\x0d\x4b\x21\xd4\xcc\xfd\x30\x1e\x3f\xa9\xfb\x9c\xbf\x41\x38\x38\x53\x3c\x6e\xd6\x9b\xb6\xdd\xb6\x97\xb5\xf3\xe5\x0c\xd6\xb1\xf3\xe7\xe4\x19\x84\x1a\xd0\x62\x0d\x44\x9f\xf1\x71\x06\xbc\xac\xfd\x0c\xae\x98\x83\xef\xaf\x5f\x94\x5a\x13\xc0\xf0\xd1\x39\x53\x02\x07\x83\xb8\x9e\x70\x14\x98\xd9\x77\x08\xa8\x8e\x21\x17\x99\x8e\x41\xd8\x93\x3a\xc7\x86\x6c\xa0\x22\x75\xe5\x38\xd4\x72\x68\x85\xc4\xa4\xac\x9f\x76\xe2\x44\x50\x6b\x0d\xe4\x49\xd1\xc9\x99\x1d\x27\xa5\xc3\x09\x78\x34\xd9\x0d\x5d\xab\xe9\xf0\xec\x3d\x0f\x6d\xa6\xaf\xe5\x96\xa5\xed\xa4\x9f\xb2\xaa\xb6\xd8\xea\x3b\xf8\xd0\xbb\x4a\x37\x53\xf1\x9f\x2d\xd6\x07\x79\x46\x29\x70\x01\xe1\x3a\xda\xce\x8b\x9d\xcc\x96\x27\xa3\xce\xb3\xcf\x68\xbb\x06\xbd\x9b\xe2\x80\xb3\xf7\xda\x48\xef\xfb\x6a\xfa\xff\x3b\xf0\x63\x16\x46\x2a\x85\x82\x5a\xb9\x71\x7a\xaa\xad\xbe\x49\xc1\xdc\xf4\x22\x4c\x26\xe3\x1f\x7a\x81\x8c\xe3\x4e\xb2\x95\x65\x9a\x9e\x7e\xd2\x3d\x35\x25\xc0\x6e\xaf\x83\x74\xc1\x07\x2b\xe8\x7f\x42\x08\x23\x73\x54\xa3\x7f\x6f\xaa\xfd\xa3\x41\x90\x1d\xcd\x63\x6b\x53\x75\x9e\x1c\xed\x01\xfd\xf6\x99\xe5\xff\x02\xe5\xf1\xce\x9e\xc1\x98\x3d\x83\x29\xb1\xf9\x13\xbb\x85\xa3\x8d\x8b\x11\x38\xa4\xf4\x4a\x78\xbd\x99\xb6\x0f\x3f\x81\x90\xed\xd3\xa0\x3c\x1c\xa7\xbc\x6e\xf8\xf4\xf4\x7b\x67\x55\x86\x82\x61\xb9\x0b\xc9\xea\xda\x9e\x9a\x94\xd8\x74\x0f\x67\x4a\x6c\x7e\xac\xb6\xf2\xd3\xd4\xaf\xbf\x86\xe2\xaa\x76\x0a\x56\x59\x59\x11\xe7\x3f\xe3\x0d\x34\xe0\x3f\x03\x0a\x62\x60\x17\x6c\xc6\x12\xf8\xdd\x51\xca\xfe\xe9\x2e\x54\x68\x5b\x7e\xad\x1d\xde\xcf\x78\xe1\x47\xf8\x2b\x5c\x33\x3e\x1e\x96\xcd\x7d\x78\xaa\x87\xff\x7e\x5d\xe6\x6b\x7b\x1a\x3b\x13\xe0\x49\xf2\x5e\x34\xb5\x80\xb5\x3d\xd3\xe7\x3e\x57\x0d\xe4\x7b\x80\x8d\x30\xf0\x1d\x16\x71\x7c\x26\x4c\xed\xe6\xa0\x67\x4b\x42\x6a\x80\x88\x3f\x78\x3d\xc7\x13\x17\x7f\xb0\x07\x76\x38\x15\xec\xf7\xc3\xb5\x88\x63\x33\xf3\xf0\x7e\xf5\x33\x64\x18\x2e\xb0\x4a\xbd\x7b\x93\xd6\x2e\xd1\x24\xb5\x98\xb7\x4e\x7d\x22\xb4\x1a\xd6\xc8\x80\xd3\x63\xd4\x5d\x64\xb5\x62\x1e\x27\xba\xd0\x31\xc6\x40\x37\x80\x54\x13\x19\x4a\xf9\x70\x51\xca\xc5\x90\xc2\xfb\xbd\x2f\x10\xc7\xae\x80\x0b\x54\xbe\xce\x64\x1c\x4f\x1e\x99\x5f\x8e\x6d\xd8\x3e\x4d\x22\xe8\x1f\xec\x64\xb8\x0c\x63\x7f\xd0\xf1\x41\x9e\xac\x33\x09\x44\xea\x8b\x1c\xfa\x00\xbe\x83\x0c\x3e\xd8\x09\x32\xf3\xf6\x91\x59\xfa\xd1\x09\xdf\xf4\x0b\x70\x35\xc1\xbb\x0b\xd1\x6b\x4f\xbc\x10\xe0\x74\xa9\x04\xb8\x98\x70\x25\x45\x55\x31\x73\xb1\x4b\xb3\xa6\xc9\xf7\x5f\xd9\x37\x19\x14\xa8\x57\x82\xf3\x1c\x9e\xde\x59\x33\x2c\x46\xac\x1a\xa2\x15\xc7\xf0\xcf\x00\xdb\x0b\x31\x7b\x0e\xff\xbb\xc3\x58\xfa\x5c\x50\xb0\x5f\xbc\x10\x34\x78\xc1\xff\xaf\x46\xfe\x78\x61\x03\x9c\x30\xfa\x42\x10\x45\x9f\x0b\x40\xf3\x73\x41\x5f\xd8\xd3\x39\x8c\x96\x28\xeb\xaa\x0b\x92\x84\xb2\x93\xa3\x65\x88\x1f\x11\x78\xaf\x87\x10\xfc\xb1\xa5\x89\x84\x98\x06\x4d\xde\x17\x9e\xbe\x5f\xf9\xf8\x9a\xa6\xfc\xf4\x15\xac\xab\x69\x78\xbd\x49\xa7\x1f\x96\x25\xcf\xaa\xea\x76\x07\xf9\x13\xf2\xd7\x56\x04\x7a\xc6\x1a\x7d\x82\x96\xed\xc1\xe2\x6d\x84\xf8\xe6\x77\xdb\xdc\x64\x37\x9b\x46\x51\xa3\x66\xc3\xe1\x6b\x31\x37\xb8\x5c\xa4\x2d\x35\x4d\x20\xb1\x28\xd6\xe1\x51\x2e\x02\x13\x4b\x6a\x99\x5b\xc9\x6f\xbf\xb7\x2f\xc2\x81\xf2\x4e\x4a\x56\x6f\x04\x2f\x20\x06\x7a\xed\xdc\x83\x40\xd3\x41\xef\xcc\xc5\xe4\x21\xac\x6d\x0d\x3a\x68\xd4\x67\xa1\xf6\x21\x0d\xa6\x8e\x33\x8f\xc8\x61\x28\xa8\x6a\xef\xda\x21\x8a\xba\x0f\xe3\x85\xe2\x6a\x60\x35\x54\x34\x12\x3c\x1a\x39\x8f\x04\x35\x08\x8a\xb9\x5d\x99\xc3\xa3\x47\x77\xbe\x85\x51\x94\x37\xa0\x5d\x6e\x07\xc5\x57\x24\xb2\x17\xe5\x20\xaf\xcf\xeb\x13\x94\xeb\x98\x0c\x39\x38\x09\xc5\x71\xf4\x7e\xcd\x58\x05\x93\x01\x5a\x92\xa0\xb3\xf2\x7a\x63\x10\xe3\x9f\x62\xfc\x89\x65\xa0\x30\x40\x91\x96\xdf\xea\xc4\xd4\x24\xd1\xc3\x64\x1c\x61\x4c\x82\x38\x76\x22\xa4\x0d\x3d\xb9\x76\x44\x7a\x02\x1c\x71\xe0\x38\x0e\x74\x76\x6d\x52\x01\xbb\xab\xb6\xf9\x5f\x89\x0f\x90\xad\xf6\xfb\x48\x66\x45\xa9\xad\xb4\x21\x55\xcb\xa0\x2b\xdd\xed\xcc\xd4\x63\x45\x94\x5a\xf9\x96\x70\xe7\x86\xb3\x62\x2a\x10\x21\x9f\xb2\x3a\x97\xe5\x46\x07\x36\x6f\x09\xa1\xde\x2c\x02\xa7\x5e\x01\xee\x5c\xda\x94\x01\x5c\xb6\xc7\xdc\xd1\xcb\x7e\xf4\xc5\xe8\x3b\x72\x6a\xe6\xcf\x19\x16\x2e\x63\x56\xf5\x6d\x82\x00\xb2\x63\x7c\x7b\xcd\x24\x28\x3e\x53\x9e\x34\x1f\x70\x2d\x64\x59\xae\xb6\x26\x67\x38\x86\xf8\x42\x8d\x43\x94\x5f\x25\xba\xff\x46\x6e\xc1\x07\x52\x87\xe5\x20\xf0\xb7\x1e\x97\x21\x9f\x50\xc0\x81\x8b\xbc\x3a\x92\x87\x96\x61\x7b\x9a\x16\xba\xad\x4e\xae\x6f\xf0\x40\x6a\x25\x36\xaf\x65\x96\xbf\x2b\xf9\x2a\xac\xce\x92\x4b\x3d\x1f\x3a\x8f\x59\xb3\xa4\x0d\x64\x0b\xf8\x0d\x4d\x60\xa0\x0c\x3b\xaa\x01\xea\xdf\x4e\x1b\x7a\xf6\x03\x6a\xc8\x98\xdd\x1e\x86\x4e\x97\x38\x9c\x58\x36\xdf\xa9\x3a\x0d\x03\x99\x59\xdf\x57\xfb\xf4\xaa\x1e\x1a\x32\x33\xef\x56\x22\x2c\x0e\x61\x09\x0c\xde\xc2\xd0\x14\x36\x8b\x94\xdc\xb2\x28\x8d\x96\x20\xc6\x47\x29\x33\xa7\x26\x4c\x10\xa3\x02\xdb\xd7\x25\x55\xe2\xb0\x05\xbc\xbc\x65\x26\x28\xad\xff\x95\x47\x2d\xa3\x8f\x1b\x81\x16\xfd\x2c\x6c\x64\x6a\x23\x75\x3a\x6f\x42\x1a\xb9\xe7\x2e\xff\x0c\x4f\x9b\x80\x42\x84\x04\xec\x09\x4e\x83\x78\x07\xaa\x60\xf2\x4e\xc7\x56\xf2\x39\xb5\x43\x4e\xc6\x10\x68\x39\x1d\x33\x1a\x04\xb5\xb7\x0d\x73\x03\x11\xc3\x04\xae\x85\x35\xe7\x75\xb3\x01\x5f\x2e\x18\xc2\xbb\x5f\x44\x1c\xa3\x5f\x84\x96\x1b\xf3\xb5\x66\x0d\x28\x12\x7c\x63\x89\xd9\xc1\xba\x64\x98\xbc\x11\xf4\x17\xb3\xb1\x05\x50\x2d\x35\x54\x76\xa9\x42\x47\x89\xab\x0a\x3c\x22\x8e\x6b\x86\xde\xe8\x6b\x75\x8c\x96\xf0\x13\x90\x01\x55\x30\xf9\x02\xe5\xac\xad\x68\xda\x78\x84\x02\xf0\x3f\x89\x7c\x5b\x6b\xd8\x67\x08\x40\x25\xbf\x08\xaa\x00\x08\x4e\x7e\x11\x10\xdc\xff\x23\xf0\xe2\xd4\x4b\xd2\xd0\x48\x1c\x17\x2d\xd7\x82\x2b\x87\x4e\x28\xf5\x4a\x9b\x1b\x4a\x61\x43\xfd\x7a\xbb\x83\x3b\x06\xb4\xbe\xb5\xa8\x1e\xe2\xd4\x8c\xb1\x69\x7a\xdd\x99\x05\x30\xe0\x74\x2b\x84\x4c\xf0\xba\x5d\xfe\x99\x67\xaa\xfb\xfd\x1d\xb3\xd8\x69\x60\xc5\x3e\xf3\xd4\x73\x19\xb0\xdd\xee\xc9\xde\xad\x19\xbd\x98\x5e\x88\x02\x1c\x85\xa4\x96\xa0\x66\xc7\x49\x88\xe1\x14\x62\x9d\xd2\xc7\xa0\x95\x06\x27\xd7\x21\x08\x35\x4d\x4f\x37\xac\x61\x3d\x97\x01\xa5\xde\x7e\x2e\xc8\x1f\x8e\xa4\x08\xe0\xf0\xce\x6f\xc5\xf3\x67\x88\x8f\xd2\xc3\xb6\xf5\x91\xa7\x89\xbf\x07\xb2\x07\x5c\x10\xef\x9c\x80\xde\x07\x68\x61\xc0\x62\x58\xa0\x5e\x03\x29\x6e\xea\x6e\x79\x4c\xb1\xa2\xfe\xb7\x7b\x22\x6d\x3c\xa4\xe8\x34\x56\x09\x5b\x2e\x59\xae\x5e\x67\x2b\xbf\x44\x27\xd3\x4e\x6d\x10\xc7\x7d\x03\xe4\x9e\x8a\x4e\x12\x07\xe9\x46\x81\x65\x61\x76\x9a\x3e\x6c\x20\x7e\x17\x60\x45\xcf\x03\x03\xef\x91\x2c\x87\x47\xf0\x40\x2d\x58\xfd\x54\x5e\x31\xd0\xb5\x9c\xc2\x71\x0a\x0a\x37\x75\xcf\xe0\x73\x07\xc7\x34\x3d\x6e\x6d\x34\x9a\x7c\xf7\x5d\x68\x0c\x79\x12\x22\xa4\xc1\x45\x8b\xfb\x0e\x94\x69\x58\x0b\x60\xaa\x69\x85\x80\x46\x45\xb9\xc3\x9b\xd3\x07\x71\xb8\x6c\x49\xd5\x74\xea\x9c\x43\x9c\xce\x85\x64\xb4\x9a\x55\x4d\x1f\xa9\xbb\xfd\x3b\xac\xf6\xfb\x61\x66\xe2\xe0\x41\xc7\x95\x71\x4b\xa6\x94\x66\xe6\x57\x63\x4a\x29\xa9\xcd\x9c\x96\x46\x8b\x5b\x82\x52\xc7\xc1\x79\xc6\x50\x05\xce\x50\x36\xdd\x5b\x63\x4d\xba\x9a\x96\xb4\x4c\x6a\xf3\xfc\xde\xc1\xa3\x02\x14\xd2\x16\xc2\x21\x84\x6e\x31\x3f\x31\xa7\x15\x91\x34\xb3\x53\xef\xfc\x2a\xac\x16\xda\xc2\x90\x1b\x18\x72\x0d\xc3\xae\x84\xb3\xaf\xab\xa6\x07\x73\xb0\x99\xd2\x66\x4a\x5a\x11\xee\x33\x73\x9a\x7b\x68\x00\x0b\xf6\x4a\x71\xee\x46\xdd\xdf\x7c\x46\x24\xad\xee\x6a\x3e\x23\x9c\x56\x7d\xcd\x9b\x43\xd5\x77\xdf\xc3\x9b\x1b\x81\x5a\x1a\x06\x6c\xe6\xf3\xfb\xb1\x7f\xce\x75\xf0\x10\x76\x46\xa0\xc4\x60\xaa\x79\x73\xb4\x72\xae\x16\x00\xd6\x8c\xa5\xc1\x7e\x73\xee\x38\x2e\xd0\xa9\xa6\x2c\x4f\xe6\x5e\x65\xee\xd6\xde\xd4\x1b\xf1\x54\x63\xc4\x53\xa1\x11\x4f\x4d\xed\xd9\x17\x5e\x7a\xb2\x7f\xed\xec\x80\xba\x92\xda\x24\xbf\x38\x15\x30\x54\x4b\x45\x30\x57\xd3\xa1\x72\x08\x98\xda\xb8\xa8\xb6\x3a\x1c\xb5\x6d\x43\x01\xcb\xd5\x20\x36\x6b\xf6\xe0\x6b\xbb\xb2\x2e\x47\xf7\x6d\xf3\xfc\xa3\xe3\x6d\x3e\x73\xf1\x3f\x86\x89\x38\xfe\x6a\xe8\xb2\x6d\xd2\xff\x57\x90\xf2\xa2\xc5\x6b\x1a\xe5\xf3\xb4\x10\x36\x6c\xed\x8e\x25\xa0\xa4\xac\xc1\x87\xdd\x3f\x67\xa9\x81\x37\xe6\x65\x6b\x2b\x51\x53\xb7\x62\xa7\x98\x7b\xfe\x02\x48\x1a\x22\x4e\x3d\xf9\x1a\xce\xd4\xa2\x5c\xc1\x55\x56\x72\x26\x9f\xf1\xa5\xb0\x91\x37\xa7\x3d\x7d\x12\x45\xcf\xe0\x15\x39\x63\xf6\x55\xce\x46\x63\x1e\x9c\x6d\x4a\xdb\xa3\x3e\x07\x43\x2c\x0d\x32\xe0\xfd\xd9\xbf\x08\x04\xce\x9c\x9b\xe7\xec\x86\x55\x70\x5e\xd5\x57\x51\x83\x5d\x58\x4b\x49\xe1\xb6\x1c\x0a\x4a\xcf\x35\xaa\x7e\x13\x74\x38\x0c\xb6\xd4\x97\x76\x4b\xf5\xbb\x24\x9f\xbd\xf3\x01\x95\x38\x51\xe4\x15\x6b\xdd\xb3\xc0\x9d\xdd\xef\x6f\xbd\xf5\xad\xc5\xe7\x53\x1a\x78\xd5\xc8\x31\xbf\x59\xcd\x08\xa7\x4a\x63\x08\xd8\xa8\x46\x9a\x75\xa7\x82\x60\x15\xce\x01\xb5\xf1\xcb\x00\xb2\xdd\xef\xed\x56\xc5\xc1\x61\x84\xdb\xe7\x30\xfe\xe1\xec\xda\xee\x56\xd0\x3f\x84\xbd\xcb\x2f\x5a\x68\xa4\x8c\x88\x10\x6b\x54\x11\x61\xf5\x0d\x40\x4b\xda\x6a\x21\xec\x3b\x89\x74\x17\xd6\x84\xfb\x03\x4d\xbd\x54\xd9\x90\xd8\x50\x2b\xe5\xc4\x4f\x5e\x3a\x5f\x1c\xb4\xaa\xe5\x0b\xf4\x02\xc4\x57\xaf\x64\x69\x4f\xa7\x73\x4d\x0a\x41\xb1\x49\x21\x34\x26\xa5\x4b\x32\xe6\x62\xbf\x1f\x72\x1c\xff\x43\x78\x7d\xfa\xa1\x41\xf7\x6b\x8b\x6e\x7b\x27\xa2\xb9\x7d\x30\xef\x28\x6e\x16\x54\xb5\x13\x08\x9f\x47\xbf\xb1\xab\x77\xa5\x8a\x46\x6c\x41\xa3\xf7\xf6\x37\xb8\x67\x45\x2f\xc4\x3f\x4c\xea\x35\xfc\xd0\x49\xd7\xb5\x49\x79\xf1\xca\x26\x9c\x9b\x6f\x11\x8d\x8e\x5a\x6e\xe0\xfb\xd9\x71\xb9\x3f\x68\xd9\xd0\x42\xa7\x3f\xf4\x62\xfc\x53\x98\x6c\x23\xab\x11\x4e\x75\x72\xe3\xa6\xc8\xc1\x4d\xb1\xd7\x0b\x51\xeb\x5c\x7e\xf4\xca\x30\xdd\x30\x05\xf5\x88\x45\x44\x14\x35\xb0\xfc\x12\x4a\x8f\xf6\xd8\xde\xb8\x4f\xb6\x1b\xb7\x7e\x94\xe4\x0b\x01\x14\xc8\xe6\x5f\x88\x05\xfd\xa3\x18\x8d\xc8\x5f\xc5\x5c\x7f\x2d\xe8\xee\x80\x9b\xaf\xa6\x97\x37\xcc\x5d\x2c\x00\xa7\x95\xce\x3b\x85\xb4\xf5\x6d\x41\x09\x96\xef\xb3\xd6\x74\xea\xa6\xa6\x2c\x30\x6f\x8b\x29\x37\x1b\x15\xc8\x59\xdc\x2b\xba\x74\x92\xa0\x6c\xc4\x93\xc0\x76\x6a\x49\x87\xb0\x47\xf0\x7e\x9e\xf8\xc1\xfb\xb6\xef\xa0\x5e\xca\x89\x58\x2e\x41\xa5\xa0\x4e\xd8\x61\xaa\x97\x84\x31\xb8\xbb\x3e\x78\xeb\xb5\xe1\x1d\xa7\xad\x04\xe7\x7b\x75\x80\xf4\xc0\xc9\xc7\x39\xf8\x1c\x38\x7d\x03\xef\x2a\x06\xc4\xfa\xf4\x9f\xd6\x37\x0e\xe0\xc5\x9c\x40\xe9\x18\xc7\x5a\xd1\x68\xb5\x4e\xb7\x9b\xae\x83\x18\xe8\x9a\xf4\xc1\x5e\x17\xb0\xef\xea\x9d\x15\xa5\xbe\xe0\x1a\x30\xd1\xc7\x16\xdf\x20\x52\x4a\xe3\xa5\x43\x29\x87\x5f\x72\x48\xe9\x19\x84\x34\xed\x3a\xf3\x71\xca\xa5\x85\x2a\xaa\xdd\x91\xf1\x15\xd8\x09\x40\x4b\xc8\xe3\xf8\x29\x8c\x7a\xc6\xe9\x4e\x9b\x98\x53\x9e\xb4\x4b\x11\xc6\x8b\x30\xf1\x8c\x17\x07\x17\x9a\x6b\xc5\x94\x3f\x85\xc2\xeb\xa8\x3d\xc9\xb0\x70\xe9\x2e\xe3\xf9\x5a\x48\xc0\x37\x3c\x02\xed\x3f\x80\x45\xad\x85\x3c\x37\xf3\xea\x72\xcc\x27\x59\xc2\x59\xda\x56\xf1\xbf\x4d\xaa\xaf\x10\x7c\x1d\x70\xea\x7d\xb5\xa4\x8c\xe3\x27\x1c\x49\x49\x60\x64\xb0\x65\x22\x09\x2e\x19\x6d\x65\x87\x92\x76\x54\x44\x40\x48\x0e\xd5\x68\x3b\xac\x27\x9f\x67\x7c\x94\x4b\xab\xf4\x08\xe6\xe2\x3f\x3f\xf7\x34\xf8\xf7\xcf\xad\xf0\xd3\xe7\x56\xf8\x47\x4b\x1c\x71\x56\x3c\x3b\xf9\xb9\x35\x98\xc0\xb4\xb3\x19\xdc\xdb\xd4\x47\x2f\x97\x8c\xe3\x78\x62\x4f\x43\x88\xd1\xc9\x43\x9c\x32\xaa\xc8\xc3\xd3\x47\x70\xa4\xd7\x39\x70\x4c\x0d\x9e\xe6\xf8\xed\x73\xa1\xfb\xcb\xe7\x56\xf8\xf5\x73\x2b\xfc\xe9\x73\x2b\xfc\xe1\x73\x2b\xfc\xa8\x31\x3c\xfe\x61\x03\x6e\xe4\xcd\xb9\x61\x29\xe7\x1b\xb9\x20\xe6\x8f\xd9\x22\x37\xf2\xe4\x24\xa8\xf8\x67\xbb\x72\x37\x72\x34\x72\xe5\x7c\xfd\xc6\xd9\x3b\xbc\x4c\xf4\xd7\x90\xff\x7f\x01\x1f\xb3\x6b\x99\x5e\x49\x57\xb6\x29\xf9\x47\xd6\x76\xf0\x05\xd9\xc4\xc5\xf6\x80\x9d\xbd\x6e\x3b\xfa\xbe\xe0\xf6\x02\x67\x60\x5d\xb2\xef\xdd\x8a\xe4\xb2\x7d\x16\x7f\xc1\xae\x45\xf9\x0f\x56\xbc\xe1\xd7\x59\xfd\x8e\x99\x67\x74\x9f\xd8\xc8\x3e\x41\xc4\xe7\x3b\x2b\xbe\x38\xaa\x36\x75\x9e\xa9\xf6\x25\x1c\x7b\xf1\xa2\x02\x27\x09\x15\xc4\x82\x14\xd6\x7a\xe2\x61\x24\xec\xb3\xa0\x53\x84\x7d\x3a\x50\xb4\xc2\x24\x10\x0b\xbf\x08\x71\x7f\xea\x9c\x31\x7d\xe4\x06\xcd\x27\x92\x3c\xa8\xaf\xd1\xdc\xd4\x67\x0a\xea\xeb\x56\xe2\x18\xfd\xc8\xd0\x1a\xee\x5c\x90\x1f\x19\xba\x92\x6d\x05\xa2\x52\x4e\x82\x35\x6d\x9b\xf9\xad\x85\x3d\xc5\x7e\xf3\x5d\x84\xc9\x9f\x75\x35\x05\x2d\xfc\x59\x37\xd5\x76\x97\xe7\xaa\x3d\xfd\x0d\xc2\xe4\x9d\xa0\xc2\x7c\xf7\xb9\x19\x03\xf3\x0e\xf1\xe2\xe6\x57\x4d\x39\x3d\xca\x44\xcd\xc5\xec\xca\x4e\x22\xfc\x91\xe6\x6c\x3e\xfe\x2e\x22\x5a\x03\xb6\xdf\x47\x6f\xcc\x5d\x89\x88\x54\x7e\x8b\xfc\xc0\x4d\x5c\xd8\xd0\xbf\x53\x28\x77\xc8\xd4\xa8\x3b\x56\xa8\xfb\xa1\xb9\x56\x14\xc8\x0a\xea\xce\x79\x66\x72\xd5\x9e\xe7\xfd\xfe\x05\x27\xd7\xd2\xa2\x19\x96\xd1\x31\x76\xd7\x4d\x9e\xd6\x9f\x37\xf0\xc9\xbb\x30\x0d\xe8\x34\xf7\x91\x26\xdf\x7c\x0f\x17\x0c\x9d\x07\x8c\x9e\x9b\x6b\x89\xa7\xfc\xd3\x61\xa4\x92\x1c\x51\x8c\x85\x12\x7e\x9a\xa3\x80\x2b\x31\x35\x30\xab\x16\x45\x54\x9e\xa8\xac\x2f\x92\xf7\x90\x82\x48\x3c\xd6\x33\xca\x03\x6f\x7c\xa2\x94\x3f\x02\xe8\x4f\x7b\xf0\xb5\xfe\x52\x30\xe9\xe6\xa7\x3d\x24\x37\x05\xb5\x7f\x09\x1d\x9b\x8f\x6b\x3b\x28\x1b\xd0\xb4\x89\x3f\xf2\x67\x88\x3d\x42\x5b\x65\x40\x76\xad\xed\x3b\x34\x4c\x1b\x4e\x83\x14\x78\xbe\x25\xec\xc3\xe0\xcc\x22\xe8\x75\xb6\xa2\xdc\xe4\x78\xf5\xa3\x83\xa0\xca\x6a\x75\xa6\x13\x4d\xd3\x5a\x9e\x0d\x13\x40\x26\xb4\xdf\x4d\x07\xec\xc3\xa6\x34\x4e\xa1\xaf\xcb\x6b\xe6\xda\xf2\x5a\x25\xda\x3e\x34\x66\x1e\xbd\x8e\x8f\xfa\x92\x8e\x2e\xed\x91\x51\xcc\x90\xd0\x21\x4d\x60\x46\x60\x1e\x4c\xd0\x34\xc2\x7a\x86\x04\xa1\x8a\xf4\x24\x58\x3d\xb1\x7d\xd4\x03\xc8\x2b\x24\x35\x12\xf8\x4f\x52\x46\x82\xbe\xa9\xc0\x29\x12\x2d\x94\x88\xa3\xe1\x8a\x16\x46\x6c\x52\x80\x34\x48\x01\x48\x3a\x18\xe1\x44\x74\x66\x89\xd8\x58\x0b\xee\xa1\x76\x22\x3a\x33\xcb\xda\xdf\x41\xbe\xa1\x8e\x26\x5f\x7f\x13\xd1\x22\x15\x16\x7e\x11\xe1\x14\x31\x80\x09\xf3\x8b\x58\xd7\x26\x0a\xb8\x84\x57\x88\xc0\x89\x62\x69\x43\x6c\x04\x7e\x97\x65\x6b\xb2\xa4\x93\x11\x2b\xcb\x1a\x75\x34\x8e\x77\xec\xd6\x4e\x5c\x8f\x1e\xbe\x9a\x21\x19\x46\xbd\x89\xe3\xe0\xe3\x28\x58\xd0\xcc\xce\xf6\x29\xc9\x08\xf8\x59\x9b\xaf\xb1\xfe\x22\x52\xcf\x2c\x28\x54\xdb\xc8\x74\xa1\x42\xd2\x23\xdb\x80\xee\xdc\x36\xf2\xf5\xa7\x36\xd2\x75\xa4\xaa\xdc\xc6\x05\x9e\xb8\x4e\x85\xd1\xe4\x02\x5d\x42\x37\x77\xb7\x08\xec\xfc\xe1\x38\xb2\x8a\x90\x6a\x56\xa5\xae\x2e\x84\x46\x23\xf2\x98\x5a\x82\x3b\x22\xb9\x3a\x12\xb2\x94\x5b\x13\x93\x31\x81\xa7\xaa\x88\xea\x74\x4c\xd4\x71\x93\x81\xc0\x53\xfb\x39\xed\x36\xf8\x8d\x8d\x65\xf3\xd9\x4d\x6e\xef\x6c\xf2\x5b\xbb\x66\x35\x98\x76\x81\x9a\x07\x9a\xe4\xe7\x76\x52\x74\x3b\x61\xae\x93\xef\x3d\xdc\xec\xb8\x85\xe0\xc4\xbd\xbc\x13\xcc\xaf\x42\x30\xdb\x50\xf9\xa0\x28\xfb\xfd\x7c\xd1\x0b\x62\xc0\x6a\x76\x2d\x95\x62\xda\x51\x31\x12\xdb\xb2\x8f\x5d\xa4\xc1\x6e\x7b\x6e\xa4\x2c\x69\x27\x1c\x42\x1c\x6c\x54\x20\x5a\xb9\x54\x08\xbe\x1d\x38\x03\x1d\x85\x38\xf6\xb5\xaf\x9c\x84\x10\x5c\x0d\xff\xc4\x88\x0e\x5d\x69\xe2\xfe\xd2\xb0\xa3\xc3\x33\x98\x4f\xed\x55\xb0\xfd\x1e\x94\xef\x26\xe8\x48\x6d\x2c\x54\xb6\x3d\x13\xf1\xd9\x6a\x0e\x93\x92\xc3\xda\x03\x45\xc8\x4a\xd2\x8d\x6a\xc5\xec\xb6\xa3\x83\x87\xad\x9e\x88\xeb\xeb\x52\xe9\x76\x5e\x0a\xa1\xcc\x05\x48\x4c\x2e\x3f\xb1\xce\x1b\x7e\x2d\xb6\xdc\x55\x0b\x50\xe5\x60\x6a\xf0\xbd\xd6\x18\xeb\xe1\x6b\x2b\x19\xc7\x2b\xd9\x32\xaf\x5d\xdf\x55\xf6\x52\xc6\xf1\x65\xbb\xec\x2a\x98\xc7\x1d\x3c\x49\xa0\xb9\x78\xca\x48\x9b\xba\xd2\x31\xd1\x1b\x8e\x56\xdc\x92\x2a\x73\xbf\x72\xeb\x97\x08\x3e\x4b\x26\x05\xfc\x7d\x9a\x40\x64\xe9\x70\x42\xca\xfa\x19\x2f\x55\x99\x55\xb0\x49\xa4\x2d\x1f\xd2\x4b\x2b\x89\xd9\x7d\x96\x25\xd0\xf2\xcc\xaa\xab\xe0\x49\xe0\xac\x56\x54\xc1\x35\x56\xc8\xd0\xbb\xa0\x3e\x10\xc0\x17\x55\x98\xd8\x77\x1d\xda\xb0\xee\xf7\xdd\x94\x1f\xba\x8b\x45\xbb\x05\x74\xd2\xe8\x51\xa1\x06\xce\x9b\x8e\xc4\xe8\x37\x6b\x22\xda\x5b\xdc\xd4\x49\x0c\xda\xed\xa3\x95\x45\x57\xe6\x95\x60\xf0\x78\xd2\x67\x04\xca\x67\xb6\xb4\xbe\xf6\x10\x14\xd5\xe0\x51\xde\x5f\x3b\x75\x2a\x5e\xca\x86\x94\x8a\x19\x4b\x7d\xf0\x2f\x40\xc6\xec\x52\x21\x60\xc6\xa9\x4d\x10\x1a\x89\x4e\xcb\x64\x71\x3a\x43\xb6\x14\xb1\x33\x80\x53\x9f\xe2\xb1\xdb\x0c\xff\xf6\x98\xf7\xc3\xc6\x0f\xaf\xf3\x97\x59\xa5\x29\xa6\x2f\x54\x28\x98\xbe\x6d\x14\x2a\xa8\x1a\xfa\xc2\x7d\xf0\x2d\x42\x7c\x05\x77\x6a\x32\xfa\xb9\x70\xd4\xc6\x15\x06\x56\x64\x98\x1a\x10\x2a\x4f\xfc\xef\x2e\xc9\xf2\xce\x7c\x3a\x0a\x36\xd4\x65\x89\x58\x0f\xb6\x43\xa2\x3c\x69\x7d\x7f\x0a\x91\xc3\x03\xbb\x9d\xee\xe8\x98\x74\x1a\x9a\x31\x1a\x80\x9b\xa2\xd6\x27\x55\x1d\x41\xaa\x53\x19\xae\xb4\xfb\x73\x1a\x04\xa4\xd5\x8f\x15\x98\xa1\xe4\x60\x06\xb6\x28\x2c\xad\x7d\xbb\xa6\x65\x07\x20\xe0\x85\xf5\x0f\x95\xc9\xde\xd2\x2e\xbc\x53\xbd\x98\xb6\xfb\xfd\xf6\x87\x1a\x08\xf0\x68\x3c\x35\x26\xf9\x7e\x8f\x72\xe8\x3b\x04\xdc\x9d\x65\x20\xd3\x42\x44\x4b\xbd\x56\x1d\x51\xda\x54\xdd\x28\xe0\x9b\xda\x75\x50\x6a\xb9\x4b\x87\x7a\x04\x6f\xe8\x5b\x85\x4a\x22\x08\x83\x78\x9c\x30\x42\x9c\xa2\x3a\x4c\x04\xa8\x18\xcd\x66\xe6\xcc\xc9\x48\x8d\xd3\x0f\x10\xc3\xa8\xd6\xc5\x27\xa6\x3d\x3d\x2f\x85\xee\xaa\x3d\x4b\xd0\xa0\x5b\x79\x65\xe2\xe6\x14\x6e\x09\x51\xee\x3f\x81\x8f\x39\xa8\xeb\x9e\x3c\x3a\x5f\x60\x62\xcd\x71\x25\xc6\xda\x72\x0f\x23\x0d\x0d\x8b\x43\xda\xa9\x34\x0b\xfc\x2b\xf6\xf4\xe1\xa9\x8d\xb5\xea\xd0\xb2\xdf\x77\x41\xdd\xef\x51\x9b\xe0\xa1\x82\xc1\x7e\x1b\xf1\xa1\xff\xd6\xfb\x0e\x8f\x0a\x21\x68\x22\xd4\x51\x13\xa4\xa3\x9d\xed\x18\x0a\x38\x0a\xfb\xfb\x57\xcc\xdd\x31\x96\x14\xee\x20\xe9\x0b\x4a\xae\xd2\xb4\xf9\x69\xea\xf6\x28\x21\x2a\x6b\xc0\x9f\x80\xba\x80\x54\x96\x13\x84\x9a\xf9\x77\x8e\x09\xc8\x20\xb4\x18\xd8\x3f\x40\x8c\xb0\xe3\x07\xdf\x81\xe0\xd8\x04\x06\xf6\x3e\x3f\x13\x6a\x9e\x0c\xce\xc2\x00\xa4\xef\xd8\xdd\xd1\x42\x35\xe3\x31\xd6\x85\x9e\xd6\x88\x3b\x5f\x80\xb3\x86\x66\xd2\xa9\x7b\xce\x1a\xee\x09\x4d\x6f\x60\xcb\xde\xb5\x97\x47\x5a\x91\x90\x1f\x42\xf4\x6d\x8b\xa1\x54\x12\x4f\xe6\x66\x3f\x34\x34\x0a\xbf\x81\x76\x5c\x30\x09\xcb\xc7\xd9\x07\xc3\x64\x0e\x98\x30\xc4\x49\x85\x7d\xd0\x53\xdb\xc6\xbf\xcd\x50\xc6\xff\xfc\x50\x02\x62\x6f\x8d\xe4\xee\x71\x88\x66\x1c\xd6\xed\x5f\xd8\x30\x07\x77\x8f\x43\xb6\xc7\xd1\x92\x58\x52\x71\xd7\xac\x8c\x3f\x61\x28\x12\xde\x07\xb3\x52\x53\x56\x88\x8d\xd2\x2f\xe5\xba\x6b\xac\x69\x45\xbc\x5b\x70\x3b\xc3\x8f\xb5\xb5\x54\x61\xc5\x10\x41\xff\x0a\xda\x32\x22\xe9\x9d\x9a\x4a\xab\x64\x00\x25\x25\xc9\xa8\x9c\x69\x8d\xb1\xc0\xe9\x0b\xff\x4a\x93\x39\x47\xc0\xc3\x76\x19\x26\x66\x29\x11\xf9\xdf\xd4\xc0\x8a\xcf\xd2\xc0\x66\xf0\xe0\x08\xd1\x72\xed\xa7\x0c\xbd\x91\xa4\x64\x0b\x44\xe0\x36\xf6\x59\x54\x40\x3b\x81\x67\x07\xc2\xe3\xd0\xd4\xba\x0e\x7d\xfd\x9d\xbd\x8f\x91\x1b\xf4\x99\x10\xc0\x9b\x9a\x96\xc4\x36\xd0\xd5\x50\xe8\x23\xb7\x7e\x1f\xe2\x45\x18\x66\x5f\xe3\x32\x77\x3b\x84\x8b\xbf\xd9\xc2\x7f\xa0\x3b\x18\x8e\xbd\x11\x30\xd0\x22\xdc\x1f\xcc\x16\xa6\xa1\x47\x61\xb4\xa7\x93\xde\x40\xeb\x00\x9a\xad\xf9\x5b\x59\x55\x9a\xab\xc5\x31\xf2\x88\x21\x7d\x05\xe0\x22\x29\x38\x4f\x99\xa1\xc7\x71\xe6\x02\x06\x5b\x4a\xd7\x0b\x01\x49\x87\x1b\x3d\x5a\xbf\x2b\xea\x18\xee\xc1\xb6\x03\xfb\xad\x2d\x48\x3f\xc0\xfa\x62\xa4\x22\x92\x94\x20\x2f\x7e\x0c\xe4\xa7\x65\xe1\x20\x66\xe1\xee\xf7\x15\x3c\xcf\xa9\xbb\xb8\x9b\x3a\x88\x95\x51\x4a\x6a\x9f\xc6\x05\xa2\x9d\x96\x76\x62\x1b\x39\x49\x9f\xd6\x49\x99\xd4\x7d\xf2\x93\x8b\x9b\xdf\x29\x0d\xf5\x5b\x74\x54\x3b\xd1\x18\xd5\xd4\x86\x81\x9a\x7c\xfd\xbd\x25\xaa\x2d\x2d\x1d\x81\x90\x02\x08\xcc\x38\x94\x14\xf4\x8f\x70\x61\xa2\xc0\x7d\xfb\x5e\xd9\x9e\x96\x97\x2c\x67\xe5\x0d\xd3\xb0\xee\xf7\xe0\x16\x57\xc7\xf1\x96\x52\x5a\xec\xf7\x08\xda\xd7\xd0\x93\x7b\x6a\xa1\x9a\x14\xd8\x0d\x73\x48\xe9\xf6\xae\x69\x2d\x5d\x21\x62\xe5\xab\x6d\x17\x29\xf6\x85\x13\xed\xa8\x15\x4c\xf4\xcc\x8a\xe3\xad\x44\x52\x92\x9a\x54\x38\xdd\x92\x21\xca\x87\x94\xd6\xfb\xfd\x16\x74\x4f\xfb\x7d\xa3\x59\xdf\xef\xfb\x9a\x8b\xe3\xd6\x67\x47\xb8\x71\xc6\xed\xfb\x51\xf7\xb4\x2c\x9c\x2c\x04\x38\x6b\xd6\xb0\x46\xa4\xc1\x60\x93\x68\x2f\x68\xa1\x96\xa0\xf5\x15\x26\x56\x4b\xb0\xa4\xb5\x93\x80\x20\x58\xed\x3f\x05\xf5\xd2\x3d\x3c\xa1\xb9\xf6\x26\x24\x4d\x72\x05\x6e\x2e\x70\x59\x65\xd9\x77\x6f\x66\x93\xd4\x6b\xb1\x05\x0e\x69\xc7\x66\x5a\x9c\xdd\x91\x8e\x96\xa4\x22\x05\x4e\x87\x57\x0d\x67\xd9\xef\xc3\xaf\xde\x38\xdc\xfb\x3d\x1a\x3e\xe1\x28\x27\x4b\xbc\xdf\xc3\xaf\x2d\xa9\x9a\x60\x2f\xcb\x19\xea\x81\xac\x43\x74\x06\xae\x38\xee\x4d\x46\x35\xa9\xda\x14\x4f\xef\x9b\xb6\x38\xee\x4e\x06\x4e\xfb\x4c\x55\xff\xf2\x29\x87\xbd\x0f\xa2\x35\x23\x05\xe3\x27\x8e\x6b\xd4\x6e\x6d\xd0\x8a\xf8\x35\x4d\x0b\xb2\x0c\xd5\x52\x67\xa1\x52\xab\xf7\x49\x0d\x48\x84\x83\xdb\x13\x09\x2f\x6a\x3c\x91\x3d\x4f\x6a\xf4\x20\x88\xd9\x43\x7a\xa0\x51\x78\xd2\x92\xd6\xc1\xc3\x71\x19\x0a\xe9\x71\xdc\x83\x2b\x6e\x6f\x5d\x9a\xf8\xc6\x10\xc5\xcc\xfd\xb6\x92\x90\x7d\x0f\x0c\x98\xee\xa9\x73\xc6\x34\x1c\x6d\x32\x8e\xdc\x2b\xc5\x96\x68\x31\xb1\x06\xbe\xaf\xbe\xd5\x81\x5c\xdc\xfb\x09\x23\x2f\x4b\x58\x58\xfc\x2e\x68\x94\xf2\x70\x42\x97\x6c\x09\xb1\x0c\x64\xc9\x57\x2f\xd9\x12\x02\x55\xcc\x74\x22\x60\xc6\x81\xdd\xf8\x30\xd8\xdd\x96\xd2\x17\x7c\x66\x7f\xef\x0e\xa9\xf9\xe5\xf4\x25\x6c\x66\x2f\xf4\xa8\x79\xb5\x48\xe1\x1f\xca\x20\xf6\x76\xd0\x0b\xf8\xfd\x1d\x9c\x66\xbd\xc1\x89\x19\xdf\x57\xdf\x81\xb9\xce\x62\xc3\x8e\xeb\x7b\x18\x97\x3f\x98\x35\xa8\x3f\xb7\xa8\x6f\x7c\x77\x86\x6e\x2f\x8f\xe3\x7b\x5e\x1d\x39\x72\xde\x72\xcf\x7e\xb8\xa3\xcd\xc7\x5f\x25\x51\x47\xaf\x92\x28\x12\x85\x6e\xef\x17\x9a\x02\xfd\xa7\x42\x2e\xea\xbb\xc5\xa6\xa0\x2a\xb0\x8d\xb9\x33\x3f\xd8\xa7\x5a\x26\x22\x12\x96\xa2\x1c\xde\x28\x69\x59\xd0\x5a\xb9\x84\x1f\x99\x97\x78\xb3\xa8\xe8\x77\xc1\x02\xb1\x32\x7a\x78\x4f\xaa\xf1\x1b\xf6\xd0\x4c\xb1\xd2\xe5\x88\xa0\xde\xe2\x13\x12\xd5\x51\x24\x56\x1b\xf9\x83\xbd\x1f\xbc\xc8\x36\xae\x19\x35\xc5\xee\x17\x20\x6f\xa6\x5f\x6c\x40\xca\x6a\xc7\x53\xf7\x69\xac\x46\x0a\x87\x7e\xbe\xae\x33\xd6\xf3\x28\x99\xcb\xa2\xde\xfc\x47\xac\xe9\x89\x8e\x89\x37\x4b\xd9\x13\x71\x53\x3f\xb3\x4a\x2c\x57\xdf\x76\x4c\x05\x31\x11\xbf\x40\x7c\x82\xc9\xf1\xf2\x2c\x06\x9b\xa1\x35\x6e\x11\xf1\x88\xcf\x42\x4e\x45\x4f\x21\x70\x9e\xc0\xe9\x71\x62\x70\x1b\xb2\x0c\xdf\x20\x75\xcf\xda\x86\x7d\xb4\x99\x2c\x3d\xc5\x44\xf5\x44\x30\xf5\x6d\xb8\x06\xf6\xfb\x6f\x1c\x77\x98\x21\x45\x6b\x98\xad\x3e\xc9\x14\xa6\xd0\xb9\x51\x83\xe5\x05\xc0\xa5\x4e\x9b\xd7\xce\x69\xba\xad\x7b\xbb\x85\xfe\x60\x83\x85\x75\xa3\x9d\x08\xe1\x07\x60\x48\x37\x67\x5f\x7d\x81\xa8\x31\xc0\x15\xe8\x13\x43\xf7\x44\x34\x5d\x00\xae\x04\x2d\xef\x03\xf5\x9e\xba\x0d\x78\xdb\x7b\xb0\xf2\x6d\x88\x95\xed\xbf\x14\x2b\xc5\x3d\xdd\x7e\x1f\x76\x5b\xdc\xdf\x2d\xa0\x8d\x72\xfb\xac\x52\x3f\x14\x40\xb8\x1f\x29\xdb\xc0\xb5\xbc\x07\x2e\xef\xcf\xbf\xdf\xab\xbb\x7c\xd5\x61\xaf\x6a\xa7\xb4\x0b\xb7\x2d\x48\xba\x74\x3b\x09\x50\xbd\xfc\x7c\x54\xb7\x6d\x61\x77\xe3\xdd\x3d\x43\xa6\xb5\x3d\x47\x23\x9c\x8c\x43\xd4\xe7\x77\x83\x41\x64\xa7\x07\x0f\xc8\x3d\x7d\xdb\xe8\x92\x9a\x7d\x1f\x59\x84\x55\xe3\x85\xde\xa4\x39\x96\xaa\xd7\x24\x3c\x06\xd1\x0f\x0e\x6f\x77\x09\x72\x83\xdd\x79\x82\xf6\xfd\xae\xdd\x04\xd6\x50\xdd\xe7\xce\x6e\xa5\x0b\x96\x60\xa9\x85\xd2\x33\x69\x51\xa1\x3a\x0f\xab\xdc\x01\x0a\x71\x2f\x1c\x86\x38\x48\x11\x87\xa5\x7a\x37\xf8\xdc\x2d\x55\x67\x2e\x75\x77\x25\x28\x03\x59\x04\x02\x55\x0c\x3e\x34\xc0\xd1\xad\x42\x9f\x88\x0b\x5d\xf5\xbd\xaf\xca\x69\x71\x3f\x1c\x7a\xd8\x36\x6a\x51\x0b\x0a\xd3\xd2\xbb\x00\x88\xe5\xa7\x02\x01\xf7\x9f\xce\x25\x52\x78\xbf\x3f\x53\xc8\xdf\xdd\x1f\x18\xbc\xde\xd1\x84\x3d\x8a\xb7\x06\x63\x25\x95\x43\xef\xde\xb9\x6e\x96\xae\xd5\xd2\xda\xf9\x9e\xe9\x29\xf1\xb7\xd8\x8e\x48\x2f\xb8\x00\xd1\xa4\x39\x18\x2d\xd1\x58\x55\xa1\x89\x82\x0d\x42\x21\x11\xb8\x97\xce\xb8\xa7\x33\xee\xe9\x8c\xdf\x4d\x67\xdc\x3e\x22\x26\x67\x16\xf5\x9a\xe2\xec\x2a\xed\x52\x1c\x2c\xda\xb4\xd9\x4f\x52\x1f\x13\x64\xf0\xa1\xa7\xc1\x6d\x5f\xc1\x80\x12\xcc\xaa\x97\xb3\xa2\xaf\xdc\xbb\x9e\x06\x1b\xde\xa8\x0b\xda\x59\xe5\x66\x56\x39\xee\xc7\x58\xc3\x70\xe0\x13\x9b\x29\xe4\x77\x4c\xe1\xb5\x9b\x42\x22\xfb\xb9\x84\xe8\x99\x2a\x7f\xed\x00\x4c\x70\x2b\x06\xda\x4f\xab\x23\xcb\x81\xb8\x48\x14\x8d\x00\x71\xbd\xb3\x25\xfc\x6c\x09\x3f\x5b\xe2\xee\xd9\xf2\x3d\x38\x53\x22\xc8\x61\x3c\xd5\x7f\x5d\xa7\xa2\x35\x8f\x00\x80\xe8\xce\xa3\x24\xa6\x46\x5a\x6b\x00\x35\x74\x9d\x69\xfc\x94\x9e\xb6\x9d\xda\xef\x8f\x6b\xbb\xa2\x45\xa7\xe8\xbb\xe3\xa2\xf7\x74\xb4\xf4\xb5\xed\x9c\x0b\x33\xe7\x02\xe3\x6e\x2b\xae\x8a\x1d\x39\x91\xcd\xb4\xc3\x53\xaf\xfd\xd3\xbe\x42\x82\x54\x3a\xb0\xb7\xbf\x3f\x6a\x23\x0a\x6f\x6d\x70\x03\x5a\x91\x25\xad\xe8\x98\x6c\x74\x82\x13\x89\x8b\x38\x5e\x3e\x2a\x9d\x81\x66\x09\x06\x9a\xc2\x48\x98\x3f\x2c\x67\x68\x43\x0b\x52\xe8\xf2\x38\xdd\xd0\xc2\x0b\xc3\xc0\x1d\x56\x74\x8d\x04\x29\x48\x39\x5f\x2e\x20\x10\x79\xa3\x0d\x59\x79\xeb\x3b\x18\xcd\x0a\xba\x71\x17\xdb\x58\x1c\x17\x5e\xe8\x5c\x85\x42\xa7\x82\xa6\x20\x62\x5d\x86\x56\xa4\x22\x4b\x17\xa9\x9a\x6e\x67\x35\x5d\xa5\x5b\x2f\x44\xaf\xc8\x96\xae\x48\x41\x37\x80\x47\x38\x27\x3a\xd8\x1d\x1e\xb9\x69\x29\xd4\xce\xd8\x4b\xb5\xd3\xce\x40\x51\x41\xaf\x90\x70\xf0\x83\x66\x12\xba\x2f\x8e\xba\x2f\x82\xee\x0b\xb2\xa5\x85\xf7\x54\xad\x0f\x80\xec\x82\x4a\xdd\xe7\x51\xfb\x1b\x7a\x8d\x0a\x22\xc8\x32\xec\xa3\x39\xfb\x6e\x42\x04\x14\x89\x39\xad\x3a\x98\x37\x40\xa6\xb3\x65\xaa\xff\x1a\xc4\x6c\x8e\x20\xdb\x04\x90\x6d\xc8\x96\x6e\x3c\x64\x80\x69\x1f\x52\xdb\x51\x49\xa0\x89\x00\x7c\x6b\xbf\x92\x20\x7c\xdb\x25\x3c\xa7\x49\x72\x62\x23\x84\x6e\xe9\x99\x42\x39\x9e\xf6\x68\x0d\xb6\x56\xa7\xd9\x44\x14\x47\x39\xdd\x9a\x33\xab\x1e\xa5\xce\x9d\x44\x8d\x75\xb9\xa0\x96\x0e\x97\xb4\x24\x1b\x5a\xd2\x31\x59\x99\x84\x4b\x9a\xeb\xb3\x22\xc2\x8e\x24\x97\x71\x3c\xbc\x34\x0f\x7e\x6e\x46\xa3\xa0\x00\xde\x2d\x2d\x69\x6e\x66\x68\x45\x97\x64\x69\x49\x73\x45\x97\x0e\x0f\x5a\x6d\x71\x43\xd7\xa8\x22\x4b\x72\x69\x37\xe1\x3a\xa4\xce\x1b\xbc\x5b\xee\xf7\x68\x49\x57\x01\x59\x2e\x3d\x59\xde\xb4\xc9\x52\xa3\xbc\xa4\x19\xba\x21\x25\xd9\x78\xec\x17\xb3\x2d\xbd\x49\xfd\x82\xa0\x37\xa4\xa0\x37\x64\x49\x57\x40\x96\x06\xf8\x86\x20\x75\x1b\xdb\x00\x84\xa5\x25\xc8\x3b\x86\x69\xf1\x80\x2e\xe9\x15\xaa\x82\x41\x00\xf9\x00\x28\x97\x47\xa0\x5c\x06\xa0\x5c\x92\x82\x5e\x7a\x42\xd8\x6a\x12\x5d\x52\x09\x08\xc1\x1f\xef\xf1\x5a\xeb\x0a\x37\x9d\x5e\x1b\xa2\xbd\x0c\xd1\xb3\xec\x10\xed\x25\x10\xeb\x6c\x93\xea\xbf\x98\x7c\x2e\xac\x30\x0f\xf7\x13\x6d\x65\x88\x76\xeb\x98\x60\x53\x88\x48\x92\x01\xf7\x3b\xda\x9f\x32\xbf\x3f\x65\xa0\xe4\xf6\x3b\x8b\x9f\xf0\x0c\x80\xb5\xb1\xae\xdb\xbb\x0c\xb6\xcf\x35\xdc\xd7\x26\xcc\x6a\x8d\xed\xbe\x97\xf5\xec\x7b\x2c\xb5\xab\x49\xf7\xa3\x17\x44\x4d\xa5\xa3\xf5\xda\xdc\x50\xab\xad\x84\xb0\xd5\x5f\x13\xb0\x04\xd5\xfa\x0c\x11\x00\x9c\x5a\x4f\x6c\x08\x15\x00\x3f\xf0\x4e\x3b\x2a\x38\x5c\x82\x2e\xae\x42\x35\x09\x6a\xcc\xba\x03\x4a\x6d\x02\xbc\xff\x20\x9d\xbc\x5c\x93\xcc\x7c\x59\x19\x91\x51\x1f\x8e\xfc\xa0\x7b\x70\xcb\x04\xc4\x8e\x1a\x1e\xfe\xf6\x3e\xe0\x87\xb0\x33\x24\x41\x16\xed\x76\xd9\x2f\x9a\xe6\x44\x63\xa3\xdb\x2f\x86\x87\x85\x4b\x85\xb2\x3b\x6a\x61\x92\x3b\xa8\x21\xee\x38\xc9\xc3\xda\xb9\xdf\x1a\x4b\x64\x43\x19\x0e\x3e\xc8\xd4\x06\xdf\xad\xa9\xee\xd1\xe1\x5d\x1a\xbc\x4b\x8b\xf7\x5a\x7f\x7d\x0b\x42\x1f\xa0\xdd\xa0\x56\xb6\x51\x6b\x08\xec\x3e\x4c\xc9\x10\x53\x12\x6a\xf9\x26\x0e\x92\x6e\xef\x1b\x57\xbb\xd5\xe3\x81\xbc\xd7\x03\xb1\x1c\x04\x84\x69\x0d\xf0\xf7\x1f\x01\x18\x06\x6b\x5a\xd7\xd3\x94\xd9\x35\x7d\xef\x10\x0e\x92\x16\xf7\x43\x7a\x4f\x5b\xc7\x80\xbf\xfb\xac\x19\xf8\xca\x0d\x28\x8e\xe5\x5d\xfa\x01\xbd\x64\x5b\x29\xed\xc2\x6d\x65\x80\x2e\xdd\x4e\xba\x6b\x76\x3d\xcd\x6a\x67\xd8\xff\xce\x54\x2f\xff\xb9\xa9\x3e\xf4\xc9\xeb\x59\x8f\xbc\xee\xdf\x0c\xcf\x20\xce\x55\xe6\x8c\xad\x32\x8e\xbf\x71\x08\x9c\xa1\xfe\x41\x92\x1c\xe3\xd4\xe4\x01\xd4\xf5\x3d\x90\x76\x41\x25\x00\x23\xf0\xbb\x73\x89\x32\x2f\xb7\xae\x3c\xef\x85\xac\x33\x15\x64\x5d\xb6\xb2\xea\x38\xd6\x27\x97\xcc\xbd\x53\x09\x53\xe3\x38\x27\x4b\x54\xb6\xc2\xbb\x3c\xfb\x7f\x99\xfb\xd7\xee\xb6\x71\x1d\x60\x14\xfe\xfe\xfe\x0a\x5b\x6f\x97\xb6\xb4\xc3\xb8\x76\xd2\xab\x5c\x8e\x57\x9a\x4b\xdb\x99\xb6\x69\x9b\x74\x3a\x9d\x34\x3b\x4b\xb1\xe9\x44\x13\x99\xf2\x50\x72\xd2\xd4\xf6\x7f\x3f\x0b\x20\x48\x51\xb2\x9c\xb6\xb3\xf7\xf3\x9c\xf3\x21\x31\x05\xde\x6f\x20\x00\x82\x40\x2e\x5a\x5b\x68\x39\xb4\xd5\x8b\x86\x24\x42\x07\xbf\xdd\xbd\x87\x5b\x1e\x1b\x56\xcd\xde\x0c\xd1\x59\xd7\x62\xe1\xd9\x1b\xab\xd2\xb0\x07\x2d\xe6\x92\xba\x79\x43\xb2\x59\xad\x1c\x68\xc1\xb1\x91\xa8\x18\x51\x78\x55\xdf\xa3\x4f\x46\x26\x38\x1d\x16\x62\xf0\x46\x19\x89\x18\xb2\x71\xef\xe0\xd3\x3c\x74\xa8\x3e\x09\x4c\x56\xaf\x63\xa8\x14\xb9\x58\x08\xba\xf4\xd0\x5f\xae\xcc\x75\xc1\x7b\x5b\x4f\xc2\x46\xa1\x2b\x18\x7b\x81\x2f\x30\x99\x67\x06\x39\xf3\x7d\x05\x9c\x41\xbb\x07\xbe\x72\x20\xb2\x2f\x2b\x37\x89\x07\x99\xb9\x5a\xe5\x05\x5d\xea\x48\xf2\x7f\xeb\x3c\x4b\xae\xd4\xcf\xf4\xa8\xa0\x84\xaa\x72\x39\xc6\xc9\xc2\x84\x03\xc7\x9b\x34\x78\x39\x0d\xbf\xcc\xb6\xa6\x0b\x79\x71\x54\xca\x9e\xe4\xee\x23\x54\xdb\xc0\xbe\xbd\x3d\xa7\xd5\x37\x28\x70\xaa\x6a\xd0\x15\x00\xc8\xa1\xcc\xad\x5b\x18\xd9\xa0\xef\x53\x76\xfa\xc6\x81\x99\x58\x88\x45\x1a\xce\x08\xcf\xec\xdb\x69\xda\x48\xda\xfd\x0c\x34\x1e\x3e\x8c\x31\x12\x5c\x85\xdb\x44\xf2\xea\xda\x21\x22\x9c\x0b\x6b\x8a\x04\x86\x57\xea\x6b\x80\xaa\x6e\x09\xab\xeb\x0f\x6b\xd2\x98\xf2\x71\x59\xca\x89\x0a\x83\x20\xed\xc5\x81\x7e\x6e\x4f\x1f\x0c\x94\x99\x28\xfc\x63\x15\x55\x8a\xb6\x59\xa1\x12\xb3\x57\x56\x26\x6a\x54\x31\x9e\x8b\xd2\x4c\x22\x68\xb6\x81\xe1\x26\xdc\xa7\x11\xfc\x56\x94\x15\x35\xd0\x03\x74\xde\xe1\xca\x6c\x6b\x23\x4f\xf5\x62\x1b\x60\xc8\xe0\x71\x8e\xbe\xb8\x3e\x12\xc5\x71\xf9\xf0\x9f\x4d\x41\x7b\x24\x17\x47\xb7\x72\x78\x34\xbc\x14\xa3\x19\xb4\x9d\x9d\xdb\xe4\x7b\x62\xaa\x92\x4c\x25\x45\xf2\x4d\x1c\xcd\xce\x0b\x25\x04\xbb\x04\x75\x8c\x59\x7e\xf9\x32\xcb\x0b\xb3\x76\x26\x35\x18\xb6\x05\x38\x90\x0e\xd8\xd0\x56\x2f\x6f\x47\x8a\x6c\x5d\xc3\xe2\x3e\x43\x3b\x2b\xb9\x28\x6a\xf0\x6b\xb8\x64\x50\xb7\xc7\xd9\x6e\x1a\x27\x93\xb7\xe2\x2b\x25\x00\xfd\x1c\xa3\x75\xd2\x17\xfc\x0a\xa8\x93\x94\x99\xd1\x34\x2e\xfb\xab\x1b\xa6\x58\xae\x4d\x40\xba\xbb\x4b\x4d\x7e\xde\x82\x6e\xf8\x8a\x3a\x18\xfb\xca\x1d\xc7\x40\xd5\xa8\x1b\xb8\xd2\x5e\x51\x96\x62\x57\x56\x13\xa7\x02\x27\x0c\x30\x3f\x17\x17\x89\xfc\x94\xa9\xab\xaa\xde\x8c\xbe\x20\x06\x82\xb4\x8e\x1a\x17\x8b\x3a\xe4\x17\x8b\x94\xf4\x02\xea\x37\x2c\xa0\xae\xd1\x54\x15\xb4\xa1\x1e\x92\x5e\x8c\x22\x85\x07\x96\xd6\xd4\x69\xd8\x3e\x69\xc9\x50\xd9\xfb\x5a\x53\x66\x1f\xe9\xab\x20\x65\xfb\x21\xab\x61\xaf\x15\x7a\x5d\x59\x7a\x5d\x35\xda\xc1\x54\x84\x0f\xe1\x42\xad\x80\x5b\x2f\xf0\xd1\x00\xab\x9a\xdd\x06\x88\x06\x6f\x00\xe7\xc3\x7d\xa0\x96\x5e\xb6\xbb\xa0\x08\x10\x51\xea\x46\x74\x89\x4d\xe7\x69\x69\xa6\x28\x34\x92\xe4\x1e\x51\x72\xa9\xe9\xb0\xac\x77\x58\xad\xa8\x18\x95\xea\x31\xa1\x39\x42\x40\x1d\x99\x2b\x72\x3c\x45\xa8\x4b\x1f\x26\xe0\xe6\x01\x1e\x78\x72\x3a\x0e\x0c\xdd\xa2\xf4\x38\x32\xa5\x07\x50\x81\x54\x21\x45\xc5\xc3\xfa\x00\xc6\xa4\x32\xb5\x8a\xe6\xcb\xfe\x58\xe4\x61\xd0\x00\x7d\x9b\x91\x33\xaa\x03\x86\xf5\x13\x03\x07\x21\x0c\x0c\x2e\x8d\x82\xaf\x88\x2a\xdc\xfe\xdb\xe1\x56\xa0\x5f\x1d\x29\x7e\x45\x2b\x91\xe9\xd1\x87\x03\xbc\x4f\xd8\x88\x2a\x05\xa4\xc4\xd2\xaa\xf2\x8d\x41\xd3\xf8\x50\x6e\x55\x69\x89\xf4\x93\xc8\xc5\xb9\xfe\x07\xad\xc6\x0c\x67\x01\x4c\xf6\xcc\xbc\x63\x80\x57\x7e\xe4\x5b\x97\xed\x57\x0e\x57\x33\xec\xa4\x53\xc8\xed\x71\x11\xfa\xfe\x7e\xe7\x12\xd1\x83\xf0\xfd\x8b\xa0\x08\x2b\xb7\xb5\x0b\xbe\x65\xce\x47\x5e\x52\x14\x60\x96\x02\x9e\x4d\x04\xa1\x5d\x52\xee\x1c\x18\x5d\xc3\x72\x4d\x85\x51\xb5\xa9\x66\x8d\x3d\x8c\xd0\xb4\x93\x69\x92\xef\x5f\x9b\x01\xb2\xfe\xfa\x77\xeb\x8b\xcc\x90\x02\xaa\xbe\x1c\xa9\x14\xda\x44\x60\x1e\x42\xf1\x5d\x23\xe4\x79\xe0\x85\x6c\xdf\xdc\x13\xc0\xeb\x8c\x4a\x99\xf4\xb4\x61\x55\xb9\x0b\xec\x70\xc0\x76\x1c\x04\xbb\x5c\x59\xc2\x9b\x8d\x83\x94\xa9\x70\xb0\x8b\x05\x46\xfb\xbe\x0f\x80\xfd\xb0\xae\xf9\xd3\x7b\x14\x32\x22\x84\xb6\x7a\x0f\x1e\x3f\x78\xb2\xfd\xe8\x01\xdc\xb4\x4a\xdf\x6f\x4f\xc1\x07\x1b\x96\x12\xd4\xf1\x13\x2f\x13\x33\x7a\x30\x10\x05\x7a\xa0\x77\x57\xd7\xba\xaa\x8c\xb3\x19\x62\x33\xc2\x8f\xcc\xd2\xab\x8d\xb1\x58\x33\x7a\x60\xb4\xd5\x55\x28\xa4\x95\x5e\xaf\x54\xb0\xf2\x02\xe1\x49\xa4\xf1\xcb\x63\xfd\xf9\xd8\xd4\xb8\x82\x20\x4b\xec\x30\x20\xec\x40\xef\x3a\x61\xae\x52\x68\x5a\x6d\x5a\x9c\xc9\x33\xe8\x38\xf5\xfd\x5a\x5b\xb4\x62\x5e\x90\xae\x36\x5a\x19\xab\x80\x30\x65\xce\x6e\xa8\x11\xc9\xee\x3e\x81\x95\x1d\xbd\x6b\x82\xae\x8c\x7b\xea\x26\xd1\x5d\x7f\xea\x0e\xb6\xa1\x31\x34\x16\xbd\x9b\xd2\x60\x77\x8c\x55\x58\x1f\xab\xef\x0d\x55\x1d\xd5\xa6\x8b\x45\xbd\xed\xe0\xf6\x66\x15\xf1\x52\x7a\xc0\x80\x38\x6c\xfc\x9d\xdd\xf1\x29\x8c\xcb\x5a\x74\x9b\xae\x45\xb7\xbd\x2e\xf5\x5f\x7e\xbf\x83\xe6\xb9\x53\xa5\xec\xa6\x83\xa3\x5e\x7d\xf3\x31\x62\x38\xa6\x1f\x3b\x1c\x8c\x05\x7d\x1c\xc4\x47\x5e\xb8\x5c\x32\xa4\x34\x0e\xe2\x24\x15\xa3\x46\x7a\xa3\x81\x6a\x68\x20\x32\x2b\x94\x68\xa5\x96\xc7\x25\x17\x58\xc1\x1a\x8f\x1e\xb8\x67\x51\xc9\xd6\x45\x0d\xb4\xbe\xa5\xcc\x0d\x3a\x67\x3f\x4a\x02\xe9\xcb\x40\x50\x2b\x58\x7d\x4f\x5f\x55\xa1\xd2\x90\xf5\xec\x25\xc4\x9b\x5d\x43\xcd\x30\x40\xb6\x65\xec\x26\x12\x5a\xb1\x1b\x60\x65\x56\xe8\xa9\xb6\x03\xa7\x97\x3e\x7a\x93\x59\x36\xad\x62\x8d\xed\xb5\x51\x64\x2a\x19\x65\x45\x2e\x4f\xca\xf1\x7c\x80\xef\x63\x40\xc1\x5b\x1b\x83\xb7\x94\x66\x6c\x41\x40\xcb\x5b\x30\xe8\xdb\xc7\x53\x58\xac\xf4\x06\x0d\x9f\x04\x30\xe0\xf2\xd1\xee\x5c\xf2\x4d\xb8\x11\x80\x5c\xf4\xdb\x75\x01\x56\xb9\xb4\x1e\x27\x9b\x01\xc4\x38\xc8\x1c\x0a\x36\xe2\x68\xc7\x17\x9e\xca\x56\x89\xfb\x31\x6c\x8c\x6c\x6a\x80\xc0\x09\x82\x86\xed\x85\xb0\xe9\x00\x74\x5e\x4d\xa5\xb3\x5e\x22\x3b\x8b\xb5\x1e\x67\x9a\xc4\x17\x90\xc2\xf6\x64\xb2\x26\x41\xa5\xbb\xc0\x5d\x4c\xb3\xe9\x0a\x6f\x41\x64\xd1\xb5\xa1\x8f\x6e\x29\x60\xce\x5f\xd1\x99\xcc\x8c\x22\xcb\x59\xa9\xfb\x18\xce\x97\xec\xda\x51\x85\x34\xee\xee\x5c\x92\x87\xcb\xd0\xf7\x41\x4b\x60\xc9\x6e\x6b\x49\x41\x7c\x00\x6e\xbb\x32\x4a\x10\x46\x59\x30\x1b\x78\x5b\xdb\x0f\xbd\xc8\xdb\xda\x7e\xe4\x85\x6c\x0e\x6a\xb3\x70\x07\xd5\xb8\x2b\x61\xae\x67\x35\x64\x63\x08\x7c\x5a\xbd\xb3\xc1\xac\x8e\x66\xa2\xf2\xc8\x6d\xd8\x3f\xbe\x5f\x46\xb7\x8d\x20\xc4\xad\x80\x1b\x0d\x86\x12\x15\xf4\x56\xcf\x02\x4b\x7b\x8a\xc2\x50\x3d\x35\xfa\xf0\x1c\xe0\xda\x30\x8a\x0e\x9c\x63\x60\x56\xd9\x39\x33\x53\x35\x2d\x0e\xdf\x0f\x66\x46\xaa\xc0\xeb\x91\x2b\xa9\xa9\xa9\x74\x9c\x96\x17\x26\xb4\x73\x17\x8b\x00\x88\x40\x97\xd2\xf6\xf9\xe6\x76\xc8\xce\xaa\x4d\x7e\x18\x8d\x11\xc0\xc1\xd3\x2c\x8c\xf9\x57\x43\xb4\x11\xaa\x76\x0b\x77\x5a\xaf\xe5\x4c\x37\x75\x15\x67\x76\x55\xe9\xe2\x3e\x07\x3f\x4b\x57\x3c\x0f\xae\xd8\x57\x76\xc3\x66\x4c\x02\xff\x74\x8d\xbb\xdd\x82\xc0\xde\x99\x12\x63\x14\x71\x28\x31\x5e\xa1\xbe\x40\x3c\x65\x8c\xae\xb6\x67\x86\xe7\xa3\x45\xe0\x54\x47\x86\x8a\x1e\x91\xc8\x04\xe4\x83\x02\x1a\xc0\x60\x24\xc2\x4b\x38\x03\x99\xa0\x15\x8b\xc7\xd1\x5c\xf0\x34\xf8\x8a\xad\x02\x5a\xab\x2f\x22\x10\x92\xdc\x98\x53\xc5\x48\x46\x6e\x4a\x33\xb2\x37\xa5\x19\x59\x0c\x86\x40\x16\xde\x94\x4d\x28\x8f\x39\x50\x3d\xbb\x71\x5f\x40\xf1\x1b\x42\x7c\x73\x0a\x18\x31\xc9\x0d\xbb\x31\x71\x7d\x98\xfe\x44\xce\x04\x88\x66\x6f\xa0\x77\xae\x7d\x59\xea\xf1\x8d\x11\xaa\x90\xb5\x41\x03\x35\xb6\x66\x4d\xa8\xcc\xde\x12\xfd\x1b\x9b\x60\x69\xf3\x9b\x74\x26\x86\xdd\x94\x65\x2f\x81\xf7\xc1\xa1\xa1\x01\x73\xa9\x1e\xae\x69\x8c\x3b\x27\xcc\x99\x25\x43\xc1\x26\xe3\xf2\x36\xda\x29\x2d\xbc\xc5\xe5\x50\x5f\x49\xb3\xd0\x5a\xda\x35\x42\xea\xf2\x42\xf8\xc7\x17\x81\x80\x95\xcd\xa4\x5d\x07\xe0\xef\x95\xba\x14\x39\xd9\x78\x1c\xcc\x98\x60\xb2\xa6\xab\x64\x48\xe1\x60\x05\xd3\x84\x5a\x45\xfb\x11\x38\x8a\xc5\x93\x91\x3f\x61\x5f\xc1\xd5\xa0\x5e\x44\xc1\x4d\xa5\x8b\xbe\x1f\x98\x51\xe6\x45\xf8\x03\x0b\x6b\xb1\x78\x60\x82\x21\xf8\x13\x7b\xf0\xd8\x21\x08\x9f\xda\xa8\xaf\x28\x66\x0a\x6e\x70\xcb\x96\x09\xfe\xc9\x8a\xfb\xbf\xbf\xc4\x68\x9c\x6f\xf8\xcc\x9a\x31\x99\xf1\x9b\x60\x46\x14\xc4\xd7\x90\xad\x10\xb0\xc4\xf4\x21\x14\x59\x09\x5c\xa1\x26\x9d\xe1\x63\xa8\x60\x62\x67\x1c\x6c\xf7\x94\x44\xfd\xdd\x68\x65\x8e\x1f\x18\x10\xe2\xee\x1a\x96\xec\x46\x38\xd7\x30\x07\xab\x04\xa6\x43\xc7\x7c\x20\xbd\x7c\x0b\xb0\x6f\x08\xe0\xa1\x60\xed\x81\x44\x08\xd6\x3a\x24\x7e\x1a\xc3\x19\x40\xff\x6a\x65\xab\xb2\x4c\x24\x84\xe8\xe0\x6b\x90\x25\x4d\x0a\xdf\x47\x6b\x19\xac\x7a\xa7\x01\x35\x37\x5b\xd7\x6b\x28\x24\x2b\x9f\xb0\xc0\xd3\x2d\x32\xea\x81\x0d\x24\x55\xa8\x15\x44\x4f\x46\xa1\x1a\x8c\x27\x35\x15\x15\xac\xf6\xd0\xa1\xad\x1f\xea\xd6\x3a\x90\xc7\x51\xea\xba\x9c\xaa\xc4\x3d\x88\x72\xdf\x4f\xaa\x2e\xc9\x53\x63\x3b\x16\x7a\xac\x6d\xa0\xc3\x65\x9f\x99\x45\x6e\xa5\xe7\x8b\x45\xee\xfb\x0f\xac\x15\xf0\x46\x3b\xde\x36\x47\xd3\x26\x30\xda\x8d\x8d\x36\xbd\x7f\xde\x9c\x37\xee\x58\x6a\x9b\x4d\xe8\x30\x36\xb6\x87\xb1\xa3\x98\xe0\xf8\x67\xde\x36\xfe\x99\x35\xc2\xc0\x60\x39\x2c\x49\x6d\x58\x98\x04\x67\x56\xb1\x21\x05\x13\x43\x0a\x92\xbd\x72\x19\xce\xcb\x66\x12\x22\xeb\xf7\xed\xea\xa3\x11\xa0\xf7\x2a\x8f\x50\x1b\xc7\x59\x73\x0f\xa3\xd8\xdc\xd7\xc0\x9c\xb1\x04\x4c\x3a\x18\xe4\x40\xf4\x11\x4d\x60\x25\x61\x95\x87\x86\x6c\x5d\xcb\xfc\x95\x26\xc8\x97\x92\xb7\xbb\xcb\x75\xd6\xdc\xe1\xed\x0a\x4b\x06\xd7\x41\xec\x9e\x53\x61\x74\x56\x03\x58\x0c\x69\x97\xc0\x20\xe6\xc5\xba\xb6\x44\x76\x01\xb9\xd7\x2f\x14\x68\x98\xad\xca\xf1\xfd\x7f\x66\x59\x31\xdb\x70\xe4\xb2\xc1\xc9\x8d\x2d\xd0\x64\x6c\x5c\x68\xc8\x3c\x01\xef\x03\x76\x67\x67\xe7\x69\x32\xb4\x9c\x43\xce\x4b\x1e\xa0\x2f\x6a\x0c\x4f\x0e\xe7\x9c\xa8\x50\xee\xf4\xae\x14\xbc\x97\x82\x1d\x20\x7c\x24\xcb\x46\xf6\x9b\x38\xa7\x31\x07\x1b\x7d\x79\xfd\x82\xc5\xa4\x02\x28\xa5\x3c\xe7\x39\x31\x6a\x9a\x43\xbb\xac\x7e\x1f\x67\x25\xab\x34\xe1\x60\x83\x2f\x17\xaa\x78\x2e\xc6\x99\x12\xec\xc2\x02\x5e\x49\x9b\x8c\xe2\xce\xb0\x05\x13\x70\xbe\x0f\xe5\xb0\xeb\xea\xf7\x01\x38\xef\x33\x59\x88\x1b\x02\x9e\x64\x92\x14\x1f\x6a\xed\x76\xb8\x93\x70\x3e\xae\x60\xa6\x25\xd3\x59\xde\xa5\xf1\x50\x4c\xea\x69\x45\xe4\x6e\x3f\x9a\x17\x73\xfc\x17\x7a\xdb\xc5\x40\x9d\x9a\x6b\x5b\xbb\xf2\x1d\x4c\x52\xee\x36\xda\xaf\x4b\xba\xc0\x30\xdb\xd7\xec\xcf\xca\x66\x2c\x2a\x9b\x51\xd9\xcd\x58\xdb\x8a\xc5\x1d\x5b\x51\xd9\xad\x58\x39\xf6\x1e\xf5\xbc\x70\xd9\x7b\xe4\x3b\x4f\xac\x7c\x3f\xd0\x7c\x84\x03\xe2\x9b\xbd\xc7\x61\x5f\x44\x05\xe2\x11\x89\x48\x99\xec\x64\x13\x22\x69\xdc\x09\x66\xc3\x2f\x16\xb1\xf5\x1e\x12\x02\x62\x82\xf8\x26\xc4\x00\x45\xda\x3b\x4a\x02\xda\x58\xf7\xfe\xb2\xff\xb0\xf4\xbe\xf1\xc8\x04\x75\xdd\x5b\x6e\x67\x42\xb3\x8d\x5b\xd6\xea\x08\xb6\x8b\x0e\x90\x07\x9c\xb2\xba\xe9\x28\xd6\x56\x8f\xf5\x22\x08\x70\x56\xbb\x56\x01\x76\xa7\x1c\x76\xdb\xab\xa5\x59\x2c\x69\xc5\x79\x45\x5a\xa2\x3b\x0c\x86\x72\xa0\x06\x17\xf0\x3c\xb4\x2c\x03\x04\x7b\x93\x55\x90\x1a\x5c\x56\x81\x61\x74\x5e\x03\x94\x38\xb1\x4d\xe5\x5b\x96\x85\xe4\xae\xe1\x3c\xad\x76\x2f\x65\x69\xe9\xa9\x85\x06\x01\xfa\x99\xae\xc1\x79\x69\xe3\x4c\xa7\x54\xdc\x62\x91\x36\xe0\x3c\xa8\x81\x66\xd8\x66\x37\xc9\x4c\x0c\x4b\xcb\xa2\x97\x66\x27\xee\x81\x8e\x60\x92\xc9\xca\x46\x84\x93\x90\x99\x0d\x48\x4f\xe3\x88\xb8\x34\x8e\x04\x4a\x3d\xc3\xc0\xf9\x5a\x93\xc8\x2d\xc9\xe2\x80\x15\x41\x86\x3d\x3a\x5d\x89\xc2\x56\xe4\xec\xc3\x87\x91\xd1\xd7\xb0\x13\x52\xd2\x88\x24\x06\x59\xb9\x9f\xeb\x8b\xf5\x37\x20\xd6\x34\x8a\x31\x2a\x59\x91\xd8\xf4\x6b\xf2\x1b\x23\xf8\x03\xd5\x42\xdf\x1f\x05\x92\xc5\x2c\x05\xf3\x45\xc0\x07\x39\xcd\x7c\x64\x6c\x71\xad\x72\x5a\x5b\x88\x98\x6a\x2d\x64\xd3\xc0\x49\xc9\xd6\xb6\x56\xc2\x5b\x19\xa7\x9e\xed\xa8\x01\xdd\x6c\x7b\xa1\x9d\xdc\xd7\xc9\x58\xec\xde\x0e\x53\x91\xff\xc8\x40\x37\x8e\xed\x83\x8a\xb3\xa8\x72\x39\x8a\x90\xb4\x4a\xea\xc3\xcd\x08\x43\x36\xd8\xb7\x5a\x31\x16\x11\x10\xd7\x0a\x35\xab\x3a\xd1\xdc\x5f\xb5\x35\xfa\xdf\x55\x49\xef\xda\xc1\xfc\xee\xb2\x68\xbe\x8e\x2c\x7c\xff\x06\x34\x64\xea\xe3\x2c\x9b\x93\x4b\x4c\x2e\x6d\x6e\xbd\xfc\x8d\xfc\xba\x1c\x48\x5c\x0f\x95\x22\x1f\x46\x95\x91\xb6\xb2\x6f\xdf\xaf\x0c\xb8\xef\xcf\xc0\x1d\x8a\x5e\x9d\xf5\x5e\x57\x45\xee\x8f\xcc\x19\xf5\x9d\x35\xb1\x83\xee\xec\x3e\x88\x71\xb4\xfa\x72\xbb\xce\x75\x59\x05\x29\xe7\x0c\xef\x37\x28\x85\x3d\x8c\x8a\x60\x18\xc8\x30\xec\x57\x2b\x87\x17\x1a\x4b\x5b\xf3\x9e\x68\xaa\x99\xaa\x42\x8b\x35\x4a\x8c\x43\xdf\x17\xc4\xe5\x39\x1c\xcb\x5f\xf5\x67\xd2\x25\x79\x2f\x38\xe7\xaf\xc9\xec\xf6\x63\xb8\xf0\xd4\x96\xa1\x80\x85\x34\x76\xaf\x8d\x00\x19\x64\xca\xb0\xcc\x6a\x22\x69\x00\xa7\x7c\x4e\x17\x33\xd1\x6b\xb5\x64\x71\xf5\x33\xa9\x7c\x1a\xea\xa7\x2a\xad\x2e\x3b\x65\x9b\x56\x04\xa9\xbd\xee\x59\xb2\x26\x41\x78\x63\xa6\xc4\xc9\x54\x17\x7f\x97\x19\x44\x38\x7f\x2e\x82\x94\xac\x4d\xc7\xf4\x9b\xc0\xe2\x76\x73\x55\x5a\x26\xc2\x79\x6c\x0a\xa7\x6b\xcf\x5a\x19\x90\xb9\xae\x8a\xe3\xe4\x07\x65\x99\xf7\x22\x48\x40\x02\x05\x97\xf0\x48\xf2\xbf\xc7\xac\x64\xed\x3a\xc5\xa8\x6a\x29\xf5\x36\x90\x36\x9f\xd3\x51\x30\x55\xe0\x0c\x56\x3f\xe3\x12\xfc\xcb\x93\x82\x61\xc8\xc0\x4f\x06\x18\x96\xaf\x55\x05\xcf\x24\xc2\x25\x43\xc2\x79\x4d\x93\xf1\x34\xa6\x1e\xbf\x56\xcc\x56\xc9\x5f\x2b\x77\x81\x1d\xad\x2c\x30\x66\x17\x3f\x59\x6a\x7d\x88\x9b\x94\x75\xc3\x3e\x3d\x65\xf3\xf6\xf6\x5f\xef\x1f\xef\xef\x79\x4c\x56\x0c\x96\x19\x6a\x0a\x38\xc8\x72\x2b\xf3\x27\x06\x4d\x08\xe7\xa2\x69\x40\xa6\x2e\xf5\x57\xf5\x4d\xbf\x1b\x03\x6f\xfa\xc9\x4c\x26\x01\xaa\xb1\x65\x4f\xaa\x18\xbe\xba\x4b\x69\x89\x99\x2d\x57\x70\x78\xe5\x4e\xa3\x5c\xd5\x54\xd3\x0f\x0b\x2a\xdd\x02\x7b\x84\x84\x69\x56\xca\x49\x82\xe2\xc7\x0a\x30\x98\xa1\xc1\xbf\xbc\x32\xec\xb7\x58\xa1\xfd\x85\xef\x3f\x6c\x5b\x1b\x59\xdb\x26\xd8\x0f\x9d\xa4\x63\x32\x09\x97\xae\x51\x57\xeb\x93\x13\x34\x41\x8a\x1f\x49\x66\x9f\xf4\xcd\x1b\xf4\xcc\xdc\x15\x64\x1b\x4b\x2b\x6d\x6d\xc2\x25\xfb\x9e\x06\x5a\x35\xf5\x5d\xf7\x58\x6e\x4a\x40\xe2\x8f\x1f\x7a\xe1\x92\x7d\xef\x62\x6b\x25\xd7\x23\xcc\x55\xbf\xe9\x6a\xec\xdd\x12\x89\x21\xbc\x1f\x8c\x25\x95\x6f\xca\x45\x7b\x5c\x25\xb8\x72\x97\x46\x7c\x72\xb5\xc3\xe4\xbf\x07\xd9\xe5\x0b\x51\x1c\xc0\x6d\x6b\x19\xab\x59\xd7\x99\x9d\x8c\xf2\x7a\x72\x54\xc2\x2a\x95\x90\x5d\x79\xfd\x3e\x8e\x9d\x03\x83\xf6\x23\x93\x57\x9e\x13\x53\x9e\x07\x62\x1d\xf3\x16\xb2\x31\x17\x50\x6a\xd7\x60\x93\xb5\xc3\x35\xe5\xd4\x14\x68\xc3\xcf\x4c\x38\xd0\xd5\xe3\xe0\xdc\xa0\xbf\x29\x2c\x48\xb2\xad\x01\x7b\x36\x0b\xc9\x4f\x5f\xc6\x87\x41\x16\x82\x51\x1f\x02\x53\x07\x2a\xd7\xbb\x5b\xd0\xd8\x1e\xea\x6a\x07\x63\x2e\xc2\x7e\x11\x8c\xd9\x34\x5c\x42\x37\xa0\xa7\x19\xd9\xe8\x6c\xca\x04\x3b\xe5\x47\xd7\x1e\xdd\x2f\x52\x13\x50\x79\xa2\x1c\xc3\x12\x75\xd4\x08\x13\xb8\x22\x82\x7b\x2a\x87\x64\xe2\x56\x24\x5d\xfc\xcc\x2a\x2e\xe7\x6f\x54\xab\xb9\x5a\xa5\x39\xf5\xd6\x2f\x83\x64\x1c\x80\xfd\xdc\x31\xed\xf9\x76\x0f\xc6\xbf\x7d\x4e\x9f\x88\x7d\x60\x78\xba\xc6\x86\x94\x24\xfd\x76\x48\x66\x91\xcf\x62\xe1\x5d\x8a\x78\xe4\x69\xda\xcf\x3b\xcf\x46\xb7\x14\x6e\x83\xb2\x5e\xad\x55\x21\x9a\xde\x94\x7c\x0a\xbe\xae\xe0\x48\x91\x40\xfc\x03\xa1\x44\x6b\x57\xd7\x3a\xe5\xe3\xc1\xd0\xed\x9d\xf6\x76\x07\x2e\x2c\x1c\xf4\x78\xdc\x40\x02\xa5\x82\x3f\xe7\xe4\x93\xba\xaa\xb6\x0d\x22\x35\x7b\xd4\x59\x7d\xce\xc7\x8f\xf1\xfe\x05\xec\x20\xc6\xa3\xdb\x83\x4c\x69\xdb\xd4\xb0\x28\x1c\x45\x74\xac\xbc\xf7\xcc\x59\x3a\x61\x49\x12\xba\xc7\x4e\x38\x77\xbf\xdc\xc3\xcb\x38\xab\xaf\x1c\x59\x7a\x45\x82\x68\xc3\x04\xdc\x58\xbc\xb5\xfb\x14\x84\xec\x1e\xb7\x78\xff\x1e\xd9\xb6\x05\x79\x0c\x4b\x8d\x00\x07\x04\xed\x96\x5f\x76\x52\xc5\xfc\x5e\xd9\x64\x98\xb6\xde\x23\x3f\xf6\xfd\xe3\xe0\x5e\xc8\x7a\x5b\x4f\xfc\xd8\x58\x99\xbb\x57\x72\xa6\xa6\x8c\xc4\xf7\x77\x82\x24\x5c\xd2\xb1\xb9\xb9\xf5\x60\xcb\x8f\x2d\x67\xf4\x12\x8a\x70\x0a\x87\xcb\xe1\x2a\xed\xdd\x94\x82\x7d\x0c\x9c\x9a\xd8\xbd\x0a\xb9\xfe\x20\xba\x2b\xf6\x49\x14\x0b\x58\x8b\x6f\xa1\x58\x08\xf6\x96\xf7\xf8\x3d\x67\x84\x97\x74\x43\x00\xfb\x03\x12\xa6\x5c\x2c\xc1\xee\x23\xf1\x11\xf7\x68\xbe\xc1\x4a\xd2\x30\xb8\xc7\xca\xbb\xef\x7b\xbe\x1f\x54\x8b\x02\x77\x8f\x99\x0a\xfe\x00\x55\x45\xbb\x06\x44\x7d\x1a\x50\x16\xae\xbe\x37\x05\x79\x7d\x0a\xb6\x1f\xf9\xb9\xef\xbf\xaa\x75\x15\xa7\x23\xf7\xfd\x3d\xe8\xde\xa3\x07\xbe\x7d\xa3\x97\xf2\x7b\xa5\xc0\x9d\xca\x16\xa0\x9d\x17\x73\x81\xe7\x08\xf8\xcf\x15\xc2\xbc\x6c\xa4\x6e\x21\x3b\x4e\xa9\x1d\xbf\xbe\xf8\x66\xd8\xf9\x66\xeb\x0a\x21\x0b\xdd\x58\x0c\x0c\xdb\x13\x60\x23\x52\x87\x72\xda\x8a\x1c\x11\x50\x85\xa7\xdc\xc5\x59\x88\x3b\x42\xa9\x4c\x69\x25\x0d\x64\x37\x8f\x0a\x30\x25\x1a\x97\x69\x11\xb0\xac\x4c\xf2\xb6\x35\xd9\x0d\x3d\xcc\x04\xa7\x62\xea\x5c\x94\xd5\x9b\x82\xd5\x3b\xab\xcc\x5d\xdf\xfd\xd0\xc7\xd2\x3d\x3e\x73\x16\x07\xdc\x03\x30\xc5\xc5\x52\xae\x5f\x1c\xea\xee\xc5\x41\xa8\xea\x39\x4f\x61\x1d\x36\xd9\x83\xbb\x2c\x7c\x1f\xec\xd5\x97\x63\x14\x32\x09\x7d\x92\xc2\xbe\x18\xbd\x04\x50\x55\x0d\x23\x83\x24\x82\x67\x82\x65\x24\x5c\xb9\x02\xef\x41\x28\x88\xa7\x75\x58\x53\x49\xd1\x7a\x5e\x70\x83\x2e\xb8\x30\xa5\x39\x26\x90\xa4\x21\x21\x8d\xd3\xe7\x82\x1f\xb9\xe2\x29\x26\xd8\xaf\x80\x82\x0d\xd1\xc8\x32\xe7\xed\x86\xe2\x88\x36\x2b\x3a\x31\xbf\x2e\x16\x15\x25\x98\xba\xcb\x0c\x3c\x56\xb6\xda\xf6\x5d\xdc\xb6\x09\x86\x50\x7b\xca\xb5\x05\xc1\x16\xd8\xf0\x74\xc5\x07\xa9\x51\x29\x4b\x07\xdd\x28\xad\x95\xe9\x18\xfd\x26\xe5\x5b\xb3\xcb\xe2\x7e\x08\x06\x6c\xe2\x5a\x06\xdf\x47\x4b\xde\xe9\x62\x91\xfe\x52\x8f\xa3\x87\xf3\x75\x28\x8b\x79\x6c\x3a\xde\x5f\xf1\x03\x92\x2e\x5d\x11\x00\xcd\x7f\xc5\xa6\x74\xb9\x98\xc8\xa6\xb5\x5e\x81\x00\x76\xbf\xab\x18\x3e\x64\x0d\x67\x08\x95\x04\x87\x68\x0d\xea\x7c\xba\x8b\xbc\x5e\xa4\x93\xac\x52\x70\x58\x3d\xc2\x9a\xeb\x19\xac\xad\x25\xaa\x75\xa4\x56\x11\xa1\x0c\x60\x4b\x0d\x05\x91\x99\x01\x02\x89\x77\x38\x77\x76\x43\xc3\x81\x4b\x37\x05\x4b\xc1\x65\xb3\xcd\x08\x55\x4a\x65\x3e\xac\x2c\x61\x43\x3e\x50\x6d\x30\x5c\x05\x87\xc5\x1f\xae\x9c\xe3\xce\xee\x48\xcb\x22\xff\xfa\x1f\x15\x19\x13\x65\x45\x63\x21\xc8\x0d\x18\xae\xc7\x5f\x17\x8b\x5f\x7f\x11\x21\xd0\x0e\xbf\x3e\xe3\xbf\x85\xee\x79\xf1\xbe\x1f\xbe\xe7\x79\xf0\x3e\x1c\xa4\xc1\xfb\x30\x52\xc1\x7b\x52\xc7\xa8\x24\xf2\xfd\xf6\x4d\x10\xae\x26\x5d\x1a\x19\xfc\x0f\x56\xf4\xdd\xe2\x21\xc1\xca\xd3\xc0\x64\x1c\x3c\xc7\x63\x74\xeb\x01\xbc\x2b\x03\x42\x8b\x35\x4d\x65\x8f\x01\x5d\xf9\xe7\x62\x51\xb4\xb1\x2d\x34\x82\xef\x09\x13\x6d\xf6\x9e\x4d\x55\x3f\xac\x3b\x19\xec\x4f\x14\x7f\x23\x59\xe9\xd0\x0c\xbe\x4a\xfd\x67\xc0\xb4\x6f\x82\x90\xfd\xc9\x05\xfb\x95\x17\xec\x3d\x3c\x27\xfb\xd3\xc4\x1a\xaf\x33\x24\xe9\xc2\x93\x19\x40\x78\x2e\xc3\x0d\x59\xc3\x01\xe0\xfa\x5c\x55\x22\x9c\x67\xf6\xd5\x2c\x96\x92\xf0\xf7\xce\xfa\x4d\x42\x25\x2a\xf6\x4f\x73\x3e\x0c\x12\xb2\x32\x43\x69\x72\x3a\x2c\x61\x70\xda\x50\xa2\x21\x0a\x24\xcf\x41\x9e\xc3\xf2\x92\x82\x48\xca\x7b\xf1\xa4\x72\xae\x8a\x22\x48\x2a\x87\xe2\xc3\xe8\x5d\x0d\xb2\x1d\x1d\xd6\x20\x0f\x10\xb2\x24\xef\xfa\xf9\x62\x91\x94\x8b\x19\x1a\x46\x77\x2a\x60\xab\x9f\xee\x44\xde\xf3\x14\x3c\x42\xc7\x81\x6a\x18\x9a\xf2\x52\x06\xf3\x2d\xad\xd7\xf0\x02\xce\x26\x85\x34\x76\xcf\x9e\x51\xd4\xa3\xc2\xf7\xaf\x40\xf8\xb5\xba\x22\x06\xd6\x2d\x64\xd9\xaa\xa8\xba\xb9\x87\xae\x78\xa9\xbe\xbd\x32\xa8\x8d\x28\x5e\xa3\x99\x67\x15\x17\x42\x78\xef\x3c\xd3\xfe\x09\x71\x8a\x9c\x85\x6d\xb8\xf7\xaa\xec\x84\x48\x23\x40\x4a\x74\x7b\x07\x4f\x7b\x61\x12\x06\x0d\x47\x79\xec\x60\xad\x15\x3a\x07\xa8\x14\x18\xb3\x94\x7f\x15\x41\x0c\x07\x69\x8c\xb7\x9e\x61\xb4\x6d\xca\x04\x34\xcb\x63\x78\xec\x1a\x6b\x7e\x36\xa6\xc7\x37\x80\xe0\x89\x24\xb8\x8c\xf3\x20\x26\x43\x3a\x78\x9c\xd9\x61\xf2\x7d\x13\x5d\x0e\x5d\xcd\x61\x3d\xf9\xb0\x83\xf1\x59\xc2\x21\x46\x13\x4c\xcb\x12\x6a\xb1\xf6\x6d\xf0\x72\xaa\xd0\x76\x26\x8f\x04\xa8\x77\xc2\x63\xbd\x11\xb0\x5f\x30\x54\x09\xf7\xbc\x7e\xcc\x05\x78\x7d\x17\x11\xad\xce\xd8\x59\x9d\x5d\xfb\xd4\xd9\x79\xf8\xac\xaf\xa0\x86\x3c\xee\x9c\x8d\xc4\xf9\xec\xe2\x10\xcc\xb1\xb2\xdc\x7e\x1f\x65\x33\x85\x9a\xcf\x7a\x88\xc6\xd8\xdc\xfe\x10\xae\x7c\x61\xd4\x86\x61\xc8\x86\x3c\x67\x23\xee\x7d\x91\xad\x56\xab\x95\xc8\x96\xb7\x11\x8c\x1c\x67\x8b\xe1\x46\x30\x1c\x78\xad\x20\x2e\x5a\xde\xc6\xb0\x33\x4e\x52\x01\x2f\xaa\x3b\x4a\x9b\x7e\x0e\xee\xff\xa7\xf3\xef\x93\x2f\x5f\xbe\xdc\x3f\xbd\xcf\x3c\xb0\x2a\x1a\x41\xb2\x34\x91\xe2\x2d\xbe\x44\xdf\xf0\x42\x2f\x1a\x43\x09\x5a\xcd\x7b\xd4\x3a\xbf\x6d\x79\x1b\x63\x84\x7b\x1e\xed\xa4\x96\xb0\xf4\xe5\x88\x7b\xde\x32\xd9\xe0\x23\xe6\x0c\xa8\xf6\x38\x1f\x87\xfd\x98\x27\x4c\x40\xd3\x85\xa1\x92\x35\x45\x2e\xac\x01\x4f\x10\x04\x97\x94\x2f\xb4\x35\x12\xac\x4e\x09\x33\x24\x6d\xa3\x42\xff\x3e\xcf\x66\x72\x14\xab\xdb\x28\x1b\x38\xb2\x53\xdc\x25\xd5\x04\x07\x90\x30\xca\xaa\x40\xac\x22\x65\x37\x68\x6d\xbb\x50\xb7\x91\x5a\x02\x3d\x0f\xf6\x41\x41\x25\xd1\x3a\x76\x02\x95\x72\xcc\xd8\x9f\xfa\xfe\x14\xdd\x40\x29\x91\x03\x02\x1f\x16\xfb\x00\x7f\x9d\x5d\x5c\x24\xf2\x62\xb1\x18\x66\x32\xcf\x52\xa1\xe9\xef\x60\xea\x20\x09\x7c\x9f\xf3\xc3\x39\x85\x25\x97\x53\x31\xb0\xc4\x00\x0c\x97\xd4\xc3\x85\xeb\x50\x9a\x75\x18\x46\xa0\xe3\xc5\x2a\x24\x80\xc3\x0f\x40\xd2\x0a\xde\xc8\x1d\x79\x08\x2d\x77\x9a\x0b\xdc\x35\xa2\xdc\x54\xce\xf1\xee\xfb\x26\xda\xdd\x54\x65\xa1\xb3\xd5\x42\x69\xe7\xdc\x59\x68\x71\x67\xa1\x23\x2b\x58\x6c\x6d\x75\xff\x1d\xf4\x36\x82\x60\x12\x84\x1b\xbd\x6e\x37\xbc\xbf\xd5\x5d\x74\xdd\xb4\x63\xa7\x01\x40\xdf\xbe\x18\xbc\x88\x9e\x0f\x52\x31\xe8\x45\xbf\x46\xed\x6f\x8b\x45\xcf\x6f\x32\x82\x30\x18\x05\x61\xd4\x2b\x8b\x41\x4b\x6c\xb6\x20\x6d\x8c\xb1\xdd\x73\xea\x39\x2f\x8d\xcf\x1a\xe4\x28\x34\x4a\xfc\x61\xb7\x52\xcd\x7e\xa4\x42\xd6\x34\x40\x54\xa8\x85\xd4\xb2\x2d\x16\xeb\xe3\x4c\x45\xeb\xe2\x4d\x95\x58\x01\x29\x7d\x40\x3f\xac\x64\x9c\x4e\x40\x23\x84\xb1\x3b\xac\xdf\x7e\xee\xfb\x92\x73\xfe\xa7\xef\x17\xcf\x7e\x85\x1b\x15\xfe\x27\xa2\x28\xf6\x2b\xef\x9a\xb7\xbe\x12\x5e\x66\xc1\xb1\x73\x23\x7e\xb9\x25\xd1\xd1\x93\x87\xa4\x23\xcc\x81\xc3\x01\x7a\x9c\xde\x7c\x8b\x11\xdc\x6a\x85\xf0\x4a\x77\x12\x27\x32\x91\x17\xfb\xd5\xb6\x5a\x57\x55\xe0\xf4\x27\x11\x7c\x28\xb8\x62\x0d\x45\x68\x0b\x33\x10\xdf\x14\xc7\x1a\xc1\x09\x29\x64\x90\xfc\x67\x6d\x23\xfa\x38\x19\xf1\x62\x91\x3e\x8b\x61\x68\xef\x68\x6d\xb8\x1c\x81\x65\xf9\x33\x31\xb8\x86\x5d\x30\x86\xd6\x4e\x05\xef\xb1\xaf\xc1\x58\xb0\xa9\x08\xc3\xa8\x07\xdc\xd6\xe0\x36\xe8\x31\xb2\x70\x05\x32\x04\x76\xf7\xd0\x2e\xcb\xab\x0a\x47\xa8\x87\x28\x06\xd6\x65\x8f\xb5\xbb\xce\x52\x9d\x94\xdb\xe7\x37\xbe\xb5\x11\x04\x9f\x83\x70\xf3\xf7\xf0\x7e\xaf\xbb\x70\x93\x5d\x10\xf9\x0d\xbb\x26\x27\x19\xe7\x2f\xb9\x55\x0a\x39\x08\x66\x22\x44\xea\xae\xe0\x58\x40\x3f\x17\x40\x3e\x08\xfe\x77\x70\xcd\xe6\x45\x32\x11\xd9\xac\x88\x7a\xdd\x7f\x07\x62\x73\x2b\xdc\x2c\x96\x4e\xd9\x67\x81\x66\xa1\xd1\xad\xaa\x25\x44\x68\xa1\x0f\x45\x68\x28\x0e\xc9\x87\xe0\xcb\x23\xb1\xa2\x34\x45\xbc\x77\xca\xd7\x8e\x72\x9f\x5e\xad\xa7\xd8\x64\x8b\x27\x2d\xed\x3c\x14\x21\x51\xde\x20\x89\x51\x6b\x96\xdc\x9c\x16\x53\xc3\xba\x28\xb5\x9f\x80\x4a\x84\x02\x12\x11\x26\x82\xa7\x4d\xa9\x9b\x97\x56\xda\xb8\x46\xb1\x60\xa3\x69\x0f\xe5\x0e\x45\x38\x1f\x0a\x2e\xd7\xad\xcf\xf5\xa5\xe8\xe6\xc9\x86\xe8\x86\x2c\x6b\x8b\x59\x2a\xde\x50\x84\x7d\x01\x82\xeb\x5e\xc0\xba\x87\x21\x0d\x60\x37\x16\x1c\xad\x1f\x61\xd3\xb1\x0d\x7d\x09\x1b\xb2\xa9\xd6\xe5\x52\xf2\xb1\xab\xed\x00\xeb\xbb\x18\xdc\x88\x8d\x8d\xe8\x06\x16\xc6\x18\xee\xf5\xc0\xb5\x66\xb9\x6e\xae\x61\x4d\xde\x06\x5d\xe6\xfa\xd3\xbb\x2d\x51\xef\x05\x64\x39\x73\x0c\xca\x09\xdf\x87\xf5\x3b\xb5\x28\x73\xb1\x98\x8a\x67\x1c\xda\xdb\x3e\x17\xfd\xd0\xec\x3b\xcc\x54\x2e\xc1\x0b\x48\x9f\x43\x23\x66\x82\x6f\xf6\x42\x66\x0a\xb9\x08\xa6\x22\x64\x17\x44\xae\x9f\xa3\xd0\x0a\x1b\x7b\x29\xc2\xe2\x52\x65\x37\x2d\xc1\x27\x82\x4d\x28\xc1\x25\x26\x70\x3a\xf0\x15\x05\xf5\x30\xbf\xa4\xb5\xb3\xf5\x00\x70\xdf\x08\xa8\x6d\x26\x9f\xf1\x49\x40\x0a\x88\xa0\x4a\x30\x4e\x64\x92\x5f\xea\xb7\x92\x76\x03\x0c\x82\x6a\x84\xd1\x87\x5a\x87\x73\x8a\x40\x81\xc1\x82\xa6\x4c\x54\x64\xa0\x38\x70\xa5\x92\x6e\x55\xef\x2c\x88\xd8\xe3\x7a\xeb\x4c\x51\xff\xa7\x5b\x77\x13\x84\x83\x5a\x5a\x15\x7d\xaf\xc9\x7d\x18\x5d\xe7\x2c\xbf\xb1\xf8\xaf\x6d\xb0\xc3\x85\x58\x2c\x2e\x44\x07\x90\xd6\x07\x53\x56\x10\xfe\x72\x05\xeb\x24\x80\x59\x76\x11\xe3\x95\xd1\x2c\x01\x7b\x7e\x66\x16\xe1\xa9\xd3\x78\x7d\x4b\xba\xec\x12\x10\x3f\xac\x87\x2e\xac\x0e\x42\x9d\xfb\x1c\x95\x4f\xd8\x2e\x47\x1d\x01\x76\xc8\xf7\x57\x1f\x25\xbe\xab\x02\x41\xa3\xe4\x0d\xdf\x27\x3b\x25\x95\x94\xaf\x39\xda\x3a\xda\x67\xbb\x6c\x0a\x96\x07\x3f\xf0\xd7\x1d\x6b\xdd\x83\xfd\x65\xbe\xca\x17\xb8\xec\x88\xbf\xa6\x1c\x61\xc7\x7d\x04\xd8\xdf\xe7\xf8\x82\x62\xa8\x8f\xed\x63\xbe\xdf\x69\xd6\xc7\x65\x2f\x6d\x94\xd5\xbb\x65\x6f\x2d\xcc\x68\x00\xb2\x8f\x16\x84\xf5\xbe\xb2\x9f\xa5\x1a\x19\xdb\xb3\x40\xab\x47\xc4\x76\x2c\xcc\x6a\xf8\xb0\xcf\x5c\x74\x64\x76\xc3\xfe\x06\x01\x2b\xa1\x94\x3d\x31\x16\x4a\x89\x91\xf1\xb4\xc5\x0e\xf4\x9d\xf2\x50\xa4\x2b\x51\xdf\x1a\x6d\xcb\x7c\x2a\x5f\x9c\x5a\x76\x9b\xfd\x81\x07\x6b\x2e\x8a\x9d\x71\x21\x0c\xf0\x77\x38\xf0\xd8\x6f\x7c\x8b\xbd\xe0\x5d\x86\x0c\xfc\x7b\xbd\xd0\xcb\x33\x99\xdd\xa3\xa5\x4f\x98\xa0\xa0\x5f\x92\x53\x5b\x8e\x1f\xf8\xec\x1e\xd3\xd2\x6f\xbc\x8b\x61\x09\xc5\x0c\xe9\xb7\x44\x43\x0c\x17\x32\x1b\x53\xc4\x14\x22\x60\x71\xf6\x08\xcf\x18\xac\x63\xd0\xd3\x19\x42\xaf\xf1\xff\xad\xe0\x3d\xb1\xad\x71\xd5\x95\xe0\xf6\x82\x1b\x26\x7d\x56\x08\xf4\x30\x55\xae\xd9\x68\xc4\x28\xa2\x84\x1d\x64\x0a\x9d\xa7\x45\x63\x66\x06\x1d\xa6\x32\x9a\xb2\x73\x90\x07\x09\x52\x81\xab\x6b\x03\xc2\xf2\x91\xfc\x4c\xf4\xa1\x35\xfa\x7e\x8d\x88\x0f\x30\x49\xb2\xc4\xf7\xbf\xe9\xed\x3c\x38\x13\x1c\x6c\x0f\x03\x7d\x64\x89\x9f\xe5\x92\xcd\xe4\xda\xd2\xf1\x7c\x3f\x03\xff\x59\xd7\x22\x9c\x5f\xaf\x16\x5f\x96\x0e\x91\x3d\x2b\x7a\x11\x41\xb8\x64\xe3\x74\x96\x5f\xc2\x12\xa8\x94\x48\x6e\x80\xdd\xd6\x92\x19\x47\xc9\x5f\xf4\x5f\xf0\x9e\xe5\xff\x14\x17\x81\x65\x77\x6d\x45\x2f\x40\xe4\x4a\x77\x4c\xa6\x3a\x65\x63\xcf\xe0\x78\x32\xea\x9a\x4f\xe0\xbe\xd4\xed\xea\x88\xd6\x69\x53\x4f\x75\xbb\xa0\x05\xf0\x3a\x74\x4d\x2f\x5f\xf0\xa2\xf2\x06\xea\x65\xc3\xad\xae\xc9\xc6\x0f\x2b\x4c\x37\x9a\x8f\x88\x1c\x62\xde\xd5\x73\xab\x3e\x52\xe8\x0b\x8e\xd7\xc5\x44\xcf\x8b\x4e\xf3\x12\x62\x69\x19\xb5\xba\x88\x58\xec\xec\x5d\x58\x46\x76\x3d\xa2\x58\xc1\xa2\xb4\xc6\xc5\x44\x3a\x53\xdb\x56\x67\xca\x76\xca\x6a\xd2\x49\x56\x51\xc1\x88\x44\xb3\x93\xea\x35\xe8\x3a\xea\xb2\xba\xe0\x2d\x6a\xf7\x98\x7b\xee\x68\x71\x02\xbd\x11\x8e\x68\x3f\xba\xaf\x82\xc9\x00\x0a\xea\x0c\x88\xa8\x60\x2b\xf4\x0f\x26\x58\x56\xd4\xbb\x84\x75\x67\xd6\xd8\x7f\x26\x59\xa2\x97\xc2\xb0\xbc\xc5\x02\x72\x57\xae\xf5\x74\x88\x93\x94\xf7\xe9\xf1\x03\xd8\x0e\xb8\x11\x01\xbc\x15\xdf\x32\xaa\xf3\xf0\xa8\xc4\xeb\x3d\x86\x27\x44\x20\x53\x05\x2e\x2f\x2f\xb5\xf1\xef\x89\x20\x0f\xc3\x39\x5a\xfe\x34\xcd\x5c\xeb\xbf\x4f\xa8\x8b\xaa\xff\x3e\xbb\x3d\x02\x28\x80\x78\x49\xaa\x10\x5e\x2e\x54\x8b\xa5\xc1\x5c\x4a\x7e\x0f\xda\x38\x40\x5f\xd6\x79\x18\xe5\xe6\x6e\xff\x8d\x34\x5a\xec\xd6\xc6\xdb\xc0\x86\xb8\x8c\x8a\x4e\x75\x0a\xd0\x2e\x45\xc2\xcc\xe3\x0c\xc8\xa7\xd7\x79\x01\xee\x6e\x91\xbc\xb0\xaf\x60\xff\x4f\xf8\xe8\x1b\xa8\x20\x8c\xc0\x2e\x32\xbb\x2e\x82\xe1\x8a\x43\xc9\xa4\xea\x50\x72\x4e\x76\x86\x22\xb1\x2c\xfd\x4a\x16\xff\xd4\xdb\x67\x1c\x0c\x59\x12\x2e\xeb\xb8\x59\x74\xaa\x80\x55\xfc\x2a\x3a\x75\xd0\x0a\x62\x12\x9d\x1a\xc4\x41\xa6\xa2\x63\xc3\xcc\xa2\x0e\x58\xee\x8d\xea\x37\xf8\x2e\xdc\x8a\xb4\x59\x9b\xde\x93\x56\x84\xb2\x24\x3c\xa5\xa8\x46\x95\x43\x1b\x69\x17\x53\x5d\x19\xb0\xb5\x92\x62\xb9\x84\xfd\x3c\xaa\x68\x26\x15\x2b\xa0\x4f\x49\x71\xf9\x36\x7b\x97\xa9\x22\x4e\xab\x18\xd9\x94\xcb\xdf\x7d\x07\x91\x32\xed\xfe\xfd\x95\x2c\xb2\x3d\x71\x7d\x9c\x65\xb5\x82\x0c\xa2\x85\xaa\xf1\x80\x7d\x7e\xeb\xb6\x80\x10\x63\xeb\xbc\x08\x8c\xab\xe2\x79\xbd\x95\xcf\x6f\xe9\x64\x5e\x6d\x1f\x60\xea\x25\x5b\x53\x76\x53\x06\x39\x80\x2f\xbd\x8c\x96\x61\x45\x51\xfa\xad\xb5\x0d\x02\x6d\xce\xf8\xf6\xb3\x58\x5d\xcc\xe0\xa5\x55\x4e\xd6\xcf\x7d\x5f\x6f\x35\x90\xbc\x9b\xa8\x93\xed\xd3\x81\xfb\x81\x45\x1b\x74\x6f\xac\x26\x47\x57\x8a\x19\x3f\x13\xd6\x27\x2b\x3a\x1f\x30\xe6\x86\x50\xec\x4b\x58\x11\x51\x7a\xc1\xaa\xc6\x5d\x23\x57\xf6\xf1\xd1\x39\xec\xda\xed\xe7\x0a\xc4\x89\x87\x37\x12\x34\xa7\x84\x2a\x6e\x51\xf0\xd8\x7e\xd1\x00\xf6\xfd\xe0\x37\xd5\x29\x44\x0e\x05\x0c\x9e\xab\x13\x71\xca\xdb\xdd\x28\x78\x41\x21\x30\x2a\xe9\xf0\x02\xaf\x6a\x43\x02\xf7\x63\x80\x91\x33\xdf\x4f\x51\xd5\xdf\x70\x73\x99\x7d\xd7\xf7\x46\x14\x97\xd9\xa8\xaf\x06\xe0\xaf\x4a\x1a\xd7\xea\x72\xb1\xc8\xa0\x35\xcf\xb3\x2c\x15\xb1\xfc\x1d\x4c\xec\xfa\x7e\xdb\x80\xdf\xce\x26\x42\x25\x43\x02\x27\xf9\xdb\xf8\x2d\xfa\x0b\xc0\x3c\xef\xb2\x3c\x29\x92\x6b\x51\x4d\xd4\xfb\xc5\x64\x3e\xbc\x16\x2a\xcd\xe2\x91\x18\xd5\x4a\x07\x59\x93\x1c\xec\x60\x1f\xc2\x08\x9a\x98\x17\x1f\x73\x61\x86\x63\x20\x4e\xf4\x9b\x63\x18\x1b\x90\x8b\x9f\x72\x09\xbe\x6a\xb2\x4e\x5c\x14\x2a\x39\x9f\x15\x78\x79\xc0\x02\x55\x07\xe5\xd3\x78\x28\x80\x55\x03\xca\xd9\x46\x1c\x05\x8a\x1c\x80\x84\xd1\x4a\x67\xbf\xd7\xd6\x2e\xb6\xb5\x5a\x62\x00\xa5\x85\x51\x03\x70\x43\x1a\x56\x75\x8f\xac\xbd\xe1\x6c\x0c\x64\xdd\x75\x1e\x45\xcb\x70\xfe\x11\x4c\x88\xd0\x4d\xbe\xae\x4a\xbf\x50\x74\x0a\x5e\x5f\x95\x2d\x6f\xa7\x70\x29\x15\x5c\x0e\xe0\xad\x8b\xcb\xda\xfc\x87\x03\x48\xa8\x77\x4b\x18\xc9\x86\xa1\x97\xb5\xa1\x6f\xcb\xd5\xf5\xe1\x79\xd1\x6a\x33\x65\x75\x2e\xc2\x86\x24\xae\x67\xa1\xcf\x95\x16\x1b\xef\x35\x60\x10\x77\x78\x29\x86\x57\x62\x64\x30\x10\xa0\x1f\xd8\xad\x11\xa9\x50\xe5\x85\x98\x9a\xf0\x24\x91\x36\x18\x7f\xa5\xe0\x92\x15\x6c\x4e\x78\x78\x57\x97\x65\x12\x11\x14\xbb\x61\x60\x58\x31\x59\x25\x93\x03\x19\x89\xce\xd9\x8d\x82\xd7\xa8\x0a\x0f\xc8\x4e\xa2\x4d\x04\x61\x1e\x46\x6d\xa3\xe4\xd9\x20\x5b\x97\x9c\x2a\x76\xa5\x8f\x7f\xd7\x7a\xec\x36\xa6\x5f\x2b\x86\xcf\xab\xe5\x50\x85\x76\x70\x06\x36\x14\xd9\x82\x28\x29\xa3\x9c\xbf\x3b\xfd\xa2\xe1\x1d\xd0\x2f\xd1\xa9\x2a\x4b\x53\x31\x8a\x3c\x2c\xf3\x3c\xfb\xea\x01\xa9\x82\xb4\xc6\x62\xe1\xa9\x78\x94\x64\x25\x64\x50\x6b\x40\xb5\x60\x07\x05\x1e\x50\x2f\x75\x7c\x50\x94\x59\x42\xdf\x47\xbc\xe5\xd1\xb7\x57\xf1\x34\xf5\x8d\xf2\x51\x7e\x52\xb6\xa4\xf2\x49\x0c\x25\x07\xb8\x1d\x7d\xdf\x83\x86\x09\xea\x14\xfd\x72\xaf\xeb\x45\xa5\xe5\x6a\xd3\xee\xa0\xe0\xd3\x58\xe5\xe2\x20\xcd\xc0\x7a\xad\x4e\x1b\x2e\x16\x5d\x16\xc8\x36\xb8\x2e\x93\xa8\x19\x42\x11\xf0\xe4\x0e\xc5\x51\x54\x24\x6e\xb3\xc8\x46\x82\xf5\x6b\x59\x8f\x8f\x68\xef\x52\x5b\x0d\x31\x57\x9d\x60\xb8\x72\x73\xbf\xdb\x1c\x32\xd7\xd3\x04\xd5\x44\xab\x49\xcc\x89\x6f\x07\x75\xa5\x36\x5a\x05\x6e\x59\x04\xe2\xed\x76\x3d\x95\x8b\x42\x3e\xd1\x04\x10\xe1\x53\x90\xcd\x7f\xa0\x79\xbc\x7c\x76\x3e\x49\x0a\x0f\x2f\x8f\x3d\x94\x00\x79\xf4\x26\x0b\x21\xc3\x2c\xcd\x14\xc5\x02\x65\xe6\x04\x41\xb6\x55\xfb\xdc\x4c\xb3\x61\x9c\x12\x70\x92\xc9\xe2\x92\xc2\x4e\xda\x1b\x21\xae\x3c\x33\xee\xdc\xf3\x98\x09\x56\x07\x88\x34\x28\x08\x64\xd3\xd3\xef\x12\x34\xab\xc0\x62\x37\xf3\x40\xa3\x19\xf4\x7f\xf4\x37\xf7\xe0\x4d\x55\x6d\x2c\x78\xbb\x0e\xf9\x91\x24\xf5\x92\xdd\x25\xfd\x47\x51\xf2\xd0\x9e\x67\xf0\xd9\xb5\xec\x18\x6e\xd0\x6a\x12\x0a\xc7\x36\xaf\x11\xf2\x89\xc5\x62\xc5\xec\x0d\xe0\x5e\x5a\xe1\x25\x0c\xac\x79\x6f\x70\x74\xae\xe2\xa8\x31\xfd\x4e\xd3\x49\xb5\x0a\x0e\x78\xd4\x52\x35\x16\x53\x86\x2c\x28\xf8\x1f\x85\x31\x3f\xa7\x84\x91\xc7\x9a\x4f\xb8\x97\x73\x24\xc9\xbf\x15\x15\xeb\xe0\x30\x21\xd9\x14\x62\xe0\xed\xde\xbc\xe0\xf3\xa5\xd5\xf5\x53\xbc\xdb\x57\xcf\x24\xd1\x68\x7d\xb5\xb1\x11\x16\x27\xde\x3d\x6f\x43\x9e\xa8\x53\x20\x6f\x30\xa5\xe4\xdd\xbe\x7c\x26\x4c\x2a\xb9\xb1\x11\xc2\x73\xd7\x1a\x85\x04\xd9\xc4\x89\x3c\xa5\xed\xcb\x30\x9c\x8b\x54\x0c\x0b\x31\x82\x0b\x1a\x98\x01\x17\x86\x17\x02\xbe\x9f\x19\x38\x4d\xdb\x91\x89\x86\xab\x29\x38\xa8\x49\xbb\x07\xf6\x35\x5d\x09\x31\xdd\x6e\xdb\x22\x68\x37\x76\xf5\x44\x51\xf5\x80\x83\x0c\xaf\x20\x4e\x94\x53\x69\xbb\xcb\x60\x64\x03\x5d\xad\x6a\xac\xd6\xde\x12\x14\xe0\x77\x17\xd2\x24\x39\x30\x73\xe0\x4b\xbb\xe0\x00\x09\xad\x71\x25\xb8\xb2\x76\x4b\x77\x2d\x9e\xbc\xa8\x1d\x27\xd7\x77\x9e\x23\xee\x69\x00\xa7\x9c\xc5\x04\x18\xc1\x6e\xe2\xfc\xcd\x2c\x2d\x92\x69\x2a\x22\x40\x13\x13\xfa\x70\xea\x7b\x5e\x5d\x50\x16\xed\xc4\xf2\x42\xa8\x6c\x96\xa7\xb7\x47\xa2\x78\x25\xa5\x50\x2f\x8f\xdf\xbc\x46\x61\xd3\xd3\x9e\x17\x32\x58\x77\x78\x24\x5f\xbb\xa7\x6e\xd3\x49\x6c\x96\x5c\xe4\x79\x1b\x6b\x8e\x55\x6c\xad\x7b\xa8\xbe\x6f\x1c\x05\x22\xa6\xc8\x0e\x65\xa5\xa7\xd6\x56\x07\x98\xfb\xa3\x4e\x10\xf9\xd5\x86\xeb\x20\x68\x36\xbc\x68\xde\x51\x2a\xbe\xed\x24\x39\xfe\x6a\x12\xad\xf7\x0b\x78\xae\xc5\x75\x8a\xd2\x84\xa7\xa0\x26\x56\xf0\xe2\xa4\x7b\x0a\x8a\x43\x80\xac\x0d\x7e\x86\x43\x02\x20\x61\xc8\x6a\x3d\xa9\xcd\x08\x2c\x3c\x67\x90\xff\x5c\xdb\x9d\x36\x75\x07\x32\x30\x30\xac\x47\x48\xce\x39\x8d\x64\x79\x3a\xdc\x7d\xac\x48\xab\xea\xf9\xbd\x94\xd5\x78\x67\xdc\x7f\x75\x70\x9b\xe8\x14\xce\x9b\x34\x34\x7b\x72\xc7\xec\x39\x0d\x76\x91\xe5\xbd\xc2\x31\x73\x64\xcf\x9d\xeb\x0b\x8f\x58\x69\xef\xb2\x28\xa6\xd1\xfd\xfb\x37\x37\x37\x9d\x9b\xed\x4e\xa6\x2e\xee\x6f\x75\xbb\xdd\xfb\xf9\xf5\x85\x87\x0f\x0c\xbc\x49\x5c\x5c\xde\x91\xba\xf7\xf4\xe9\x93\xfb\x6f\xe2\xe2\x12\xff\xbd\x79\xed\xd5\x78\xf5\xe6\x2c\x4f\xef\x7f\xbd\x2c\x26\xa9\xe7\xcc\x91\x90\x2b\x1b\x41\x63\xeb\x3b\x0b\x80\x51\x19\xdc\x03\x62\x3f\xba\xab\x2b\x90\x0c\x4c\x86\x67\x4a\x24\x17\xf2\xd0\xd8\x15\xe7\xc5\xe0\xee\xe2\x23\x07\x47\x17\xd4\x40\x78\x30\x63\x96\x12\xa9\x18\xe3\xc9\x03\x9c\x22\x5d\x7c\x6a\xed\x62\x84\xfa\x3e\x68\xbc\xc9\x8e\xcc\x46\xe2\x18\x8c\x89\x51\xff\x60\xc3\x06\x1a\x4c\x6b\x22\x5c\x56\x66\x9c\x3b\xa7\x8e\xa4\xaa\xe1\x5c\xc8\x8b\xdb\x54\xd8\xd3\x40\x82\x5a\x58\x01\x0a\xad\x2b\xc8\xdd\xb0\xab\x19\x47\xf2\x4e\xbb\xdc\x3a\x1c\x07\xde\xe6\x26\x5e\x95\xa3\xee\xc6\x89\x3c\xed\x2b\xd2\x31\x4f\x17\x0b\xef\x5c\x73\x24\xa5\x9a\x1f\x00\x61\xa4\xd2\x81\xe7\x45\x8e\xd3\x41\x7b\x50\xa6\x8b\x05\x94\x9f\x2e\x16\xf7\x56\x38\x70\x15\xfa\xfe\x3d\x75\xa2\x4e\x07\x81\xe7\x6d\xa4\x61\xa7\x50\xc9\x04\xc4\x68\x1b\xde\xf4\xab\xc7\xbc\x31\x50\x8f\x9e\xb5\x6d\xeb\x0d\xf3\x1c\x09\x4a\x2f\x64\x99\xe6\x37\xcb\xde\x30\x15\x46\x70\xe6\x70\xe5\x2c\x9a\xf2\xdd\x19\x90\x21\x45\x7a\x22\x4e\x2d\xd6\x29\x11\xd2\x62\xf1\x1d\xb4\x4a\xd6\x81\xb7\x1f\x7b\x60\xab\x2e\x70\xf7\xf1\x3a\x3c\xbc\x52\x09\x16\xf1\x08\x24\xaf\x2b\x76\xeb\xef\x28\xc6\x3b\x3b\xc3\x75\x9c\xc8\xf5\xa9\x10\x2b\x82\x71\x98\xb2\x59\xb8\x08\x7c\xdf\xd4\x64\x27\xc3\xc6\x40\x8e\x2d\x0f\xfb\xe2\x60\x03\x55\x2e\xe1\x4d\x10\x17\x08\x67\x51\x78\x46\x7b\xd2\x90\x47\x4e\xf3\x93\xbc\x5f\x43\x21\xb1\x94\x99\x96\xd4\x6c\x7e\x9d\x18\xaa\x13\x09\xd6\xcd\xa9\xca\xc6\x49\x6a\x48\xce\x71\x26\x8b\xcd\x71\x3c\x5c\xf9\xde\xcc\xd5\x70\x05\x36\x53\xc9\x0a\x6c\x9c\xa9\x49\x5c\xac\x80\x81\xe2\x24\xe0\x24\xc9\xf3\x44\x5e\x6c\x5e\xa4\xb7\x53\x8b\xab\xda\xbd\x1a\x26\x6a\x77\x9d\x85\x93\xda\x1d\x05\x46\xff\x84\xdd\x9e\x8b\x45\xaf\x57\x01\x0c\x44\x24\x3a\xe8\x3b\x7e\x2f\x1b\xa2\x0c\x8c\x78\xa8\xb7\x20\x29\xec\x17\xfc\xbd\x3c\x29\x4e\xed\x7e\xcc\x78\xb7\x9f\x3d\x33\xe7\x59\x3f\xdb\xd8\xb0\xb6\x46\x4e\xb2\xd3\xbe\x6c\xda\x21\x40\xb8\x2d\x16\x81\x57\x64\xd3\xa3\x21\x70\x8f\xb0\x23\xd4\xe0\x2f\xe1\x82\x98\x97\x53\x40\x84\x11\xc0\x0f\xb2\xe1\x2c\xc7\x94\x8b\x05\x7c\x3f\x4f\x67\xc8\xa0\xa9\x41\x40\x39\x75\x0a\xe6\x8d\xf5\xaf\x08\x19\x45\x60\x52\xe6\x9d\xe3\x0f\x88\x3b\x3b\x04\x04\x91\x98\xec\x98\xac\x70\x51\x8e\x55\xed\x82\x58\x92\x5a\x15\x48\x11\x78\x43\x0d\x00\x4d\x24\xdf\xa7\x42\x29\x11\xb3\x91\xa6\x60\x1d\x51\x16\x96\x66\xb9\x70\xcb\xc2\xef\x6a\x51\x1a\x64\xa2\x6c\x41\xf0\x89\xe5\xbc\xcf\x9a\xc6\xf1\x83\x08\x14\x7b\x9f\x9d\xa8\x53\x78\x53\x0a\xa3\x5a\xa3\xed\x62\xb9\xea\xd3\x9a\x3f\xad\xa0\x67\x90\x29\x55\xa7\x9b\x65\x90\x20\x45\x8d\x66\x3c\x4d\x43\x82\x0c\x60\x46\x92\x29\xec\x73\x2e\x06\x01\x38\xd4\xd6\x6a\xb4\xfb\x5a\xf4\x1f\x78\xa3\xe4\x1a\x39\xa2\xc4\xec\x64\xee\x3d\xd3\x79\x7e\x79\xf6\xe5\x3e\x85\x3c\x06\x58\xdd\xb1\x9f\x15\xb8\x87\x4a\x18\x46\x82\x37\xee\xc8\x41\xbd\x3a\xc1\xe6\x49\x1e\x15\x9d\x24\x5f\x86\xd1\x4a\x24\x94\x53\x03\xbe\x3d\x42\xef\x91\x2e\x1f\x32\xa4\x6d\xa1\x31\x41\x00\x63\x53\x94\x63\x53\x44\x45\x75\x6c\x42\x2a\xf0\x58\x7c\x2d\xde\x66\x23\xd8\x11\x65\x59\xb9\x33\xda\x7a\x0b\x28\x89\x72\x3b\x83\x4b\x0a\xc2\x25\xc9\x58\x95\x7b\x99\x30\x5a\xf4\x41\xaf\xaa\xd7\x59\x3c\xf2\x98\x97\xe2\x0f\x5d\x53\xa6\x5c\x3a\x3a\xfe\xde\x75\x32\x12\x19\xe5\x8e\x67\x20\x5e\x41\xc3\x58\x29\x9c\x8c\x2a\x0d\x55\x5a\x5f\x2b\xa9\x5e\x2b\x29\x53\xe9\x49\x0a\x6b\xa5\x5f\x2b\x31\x47\xcd\x6c\xdb\x06\x54\x00\xf6\x98\x87\x2a\xbf\xb8\x1c\x6b\xe9\x93\xc9\x05\xd5\x9f\x4c\xe2\x8b\xbb\x32\x36\xf7\xaa\x5e\x1e\x60\x3c\x5b\x08\xda\x4c\xf3\x18\xc9\x07\x9c\x42\x8e\xb4\xf4\x80\x19\x31\x42\x43\x41\x23\x51\xc4\x49\x9a\xdb\xb2\x8e\xb3\x8b\x8b\x54\x78\xcc\x2b\x28\xb0\x9a\x25\x91\xd3\x59\xe1\x45\x7f\xd3\x23\xe4\x94\x7f\xa6\x10\x15\xf1\x4a\x5e\xc7\x69\x02\xad\x4f\x4c\x08\x0a\x01\x77\xad\x5e\x26\x77\x2f\xe1\x08\x33\x9a\xe3\xba\x44\xcd\xc8\x7a\x51\xca\x91\x79\xae\x18\xcc\xf1\x34\x17\xe6\x45\x2f\x6c\x7d\x9a\xb1\x91\x55\xc6\x66\xf9\x5f\xd4\x0f\x34\x55\xac\x44\xec\x45\xef\x6d\x25\xcf\xff\x8b\x4e\x99\x53\x25\xe5\x72\x99\xc1\x8a\x4e\x59\x96\xea\x95\x19\xb3\x84\xa7\x78\x16\xc4\xb0\xfa\x12\xa0\xcb\x92\xfa\xf2\x8b\x89\x2e\x1b\xf2\xe4\x24\x3e\xed\x7b\x78\x62\x03\x12\x89\x07\x12\xb6\xcc\x10\x8a\x8b\xbc\x35\xc4\x00\x25\x44\xe2\x23\x18\xf2\xe1\x60\xd8\xd1\x24\x04\x8d\x54\xe8\xfb\xbf\xc2\x5d\xc4\x30\x04\x09\xa4\xa6\x7f\x74\x9e\x15\x2c\x32\x1c\x04\xe5\xe0\x68\xce\x19\xe5\x2e\xc3\xd0\xf7\x91\xd8\x85\x32\x88\xdc\x2b\x33\x51\x9c\xe7\x6d\x40\xb4\xd1\x77\x27\xa2\x75\x7f\x94\x14\xc0\x75\x7f\x8a\x15\x68\x5d\x41\x69\xb1\xef\xdb\x54\xf6\xd1\x7d\x2d\x3e\x9e\x15\x19\x1e\x39\x04\x08\x9e\xaf\x1c\x94\x71\x48\x9d\x1e\xfa\x3e\x4e\x4c\x1c\x46\x6a\x80\x02\xff\x98\x0d\x43\xe2\xc1\x87\x24\x0c\x05\x50\xb8\xac\xe3\x19\xbd\xb4\x53\x40\x53\xec\xd3\xea\x5a\x2c\x17\x8a\x4e\xf2\xeb\x6a\x12\xb3\x96\x89\x75\x34\xbc\x62\xed\x22\xc1\x43\x30\x18\x7b\xc1\x40\xe3\x82\x17\x56\x18\xc0\xdb\x6d\x69\x3f\x40\x5a\x42\x42\x7b\xa2\xf5\x06\x28\x1b\xaa\xa6\x81\x7b\x2b\xdb\x86\x2a\xab\xb9\x9a\xba\x9a\x02\x0e\x5a\x6a\x0f\x81\xa3\x86\xc7\x3d\x69\x27\x93\xbb\x69\x32\xbc\x42\xb6\x32\x93\x43\x08\xf3\x9b\x8a\x01\xdd\x99\xc5\xef\xe0\xa3\x9c\x10\xb3\x73\xc7\x5b\x1b\x76\x69\x31\x49\xa6\x43\xe8\xd6\xe3\xe4\xb4\x71\x78\x0d\xaa\x60\x19\xff\x7d\x4d\x5a\x33\x90\x72\x2d\xc6\xc8\x28\x26\x5b\x89\xa9\x97\x55\xce\xbc\xb4\xa8\x21\xe3\xcf\x57\x6b\x5e\x1d\x32\x4b\x7a\xcb\x72\xc8\x1a\x06\x34\x5b\x3b\xa0\x88\x42\x32\x17\x85\x90\x19\xba\x12\x8f\x48\xc0\x23\xed\x15\x9a\x07\xf4\xd3\x1b\xf6\x89\x91\x5d\xcb\x93\xf8\x14\x32\x3a\x08\x06\x55\xb1\x13\x28\xb2\xd0\xd1\x2b\x4c\x63\x02\x12\x19\x50\x6e\x14\x7c\xbe\x0c\x99\x38\x49\x4e\x41\xde\xd2\x07\xa1\xde\x5a\x94\x44\xbb\xd8\xa2\x9b\xda\xae\xff\xbf\x81\x1b\xb4\x8b\x9b\x93\xd3\x30\x82\x97\xfc\xe0\xf9\x33\xd4\x26\xbc\x63\xe4\x99\xc2\xb0\x1c\x4d\x22\x47\x86\x3c\x03\xf4\x0b\x9c\x33\xa9\x8f\xc8\x01\x8c\x09\xad\x12\xd6\x38\xdc\x43\x12\x1f\x12\xb6\xb1\x8c\x65\x58\x1f\x68\x28\x56\x3f\xeb\xd4\xc3\x1d\xb6\x1b\x86\x7a\xb1\x18\xfa\xfe\xb0\x09\xde\x34\x05\xb6\xb0\x61\xd8\x90\xc7\xf7\x8b\x93\xe4\x14\xb0\xf7\x49\x72\xba\x3a\x87\xc3\x93\xe4\x94\xee\x50\x21\xc6\x0e\x17\x4b\xcd\x30\xc1\xfb\x5d\xc1\x87\x77\xcf\x34\x1e\x24\x4d\xc7\x0e\x3c\xc5\x1f\x14\x35\x98\xc5\xc9\xf0\xde\x15\x1e\xb6\xd5\xa7\x06\x0f\x91\x95\x83\x0a\x44\x5d\xc3\x06\x31\xfd\xb0\x41\x4c\x3f\x5c\x2c\x9a\x4b\xfd\x1f\x1d\x4d\xcd\xab\x8d\x98\x7f\x7d\x14\xa1\x0b\x63\x70\x22\xa4\x9b\xad\x97\x61\xc3\x3a\x1c\x96\x16\x12\xc4\xca\x58\xd0\xda\x41\x1a\xae\x44\xb1\xa3\x0a\x8a\x25\x4c\x0a\xcc\x87\xef\x97\x37\x89\xaa\xa2\xec\xa4\xf0\xc2\xc4\xf7\xf1\xc2\x0f\xa4\xf5\x32\x00\x0a\x9c\x65\x1c\x43\x2a\xb4\xac\x2a\xd8\x1c\x48\x4b\x56\x35\xdd\xe0\x5b\xc6\x3a\x4a\x01\x74\x71\xc2\x8b\x93\x74\xa3\xd7\x40\xa1\x24\x3f\x40\xa1\x20\x11\x92\xac\xce\x2d\xf4\x28\x09\xa3\x8c\x4e\xf3\xc4\x1c\xe1\x49\xd3\xcd\x76\x6c\x4e\xb9\x64\x40\xc7\x7a\x12\x46\x78\x29\x1f\xdb\xd3\x5d\x56\x8f\x99\x6f\x85\xe3\xd6\xb7\x8e\xdd\xff\x5c\x8d\x2c\xcf\xe3\xf5\xb2\x55\x6e\xd7\x78\x3d\x95\x23\xe0\x67\x77\xc4\xf1\x76\x5b\x39\x07\x32\x57\x95\x23\x5e\x9a\x23\xde\x4d\x83\x47\x3c\xec\x1b\x17\x6c\x31\x8f\xaa\x9c\xe9\x0d\xf9\xab\x09\xe0\xd0\x8f\x9a\x12\x99\xe0\xe0\xe4\x34\xf2\x3c\xa8\xd3\x3d\xe1\xc7\x95\xe5\xf7\x5f\x70\x6d\xdf\xe7\xd6\x60\xdd\xfd\x0c\xc7\xf6\x13\xdc\xda\xff\x92\x53\xfb\x9f\x70\x69\x3f\xc9\xa1\xb9\xc9\x6b\xdc\xd9\xf7\xd8\x17\xb5\x8e\x27\xaa\xb3\x5d\xff\xb8\xa0\x55\xe6\xea\xa7\x8a\xd2\x0c\x94\x04\x7c\xc2\xb2\x92\xea\x81\xd5\x40\x94\x4f\x03\xfa\x45\xe4\x09\x47\x35\xfb\x1e\x87\x93\x0e\x2a\x22\x75\x30\xfa\x83\x72\x9c\x93\x32\x23\x4b\x4f\x1b\xb8\x9c\xd4\xf7\xeb\x39\x41\x80\xbd\x92\x19\x80\xa7\x61\xf4\xfc\x0e\x32\x2c\xb5\x87\x44\xd8\xaf\x6f\xa1\xff\x9e\x21\x31\x13\x59\x21\x9f\xbf\x4b\xde\xcb\xb5\xd4\x28\x1d\x50\x59\x89\x04\xa6\x15\x91\x50\x4b\x94\x57\x16\x40\x0b\x95\xe9\xce\x1d\xbd\xc3\x76\xd0\x16\x8b\x45\xaf\xed\x0a\x52\x7d\xff\x69\xed\xbb\x57\x4f\x10\x3c\xa9\x00\x16\x0b\xaf\x85\x4a\xd0\x9b\xe8\x36\x63\x73\x9a\x25\xb2\xd8\x34\x5a\xba\x2d\xcf\x26\xc6\xd6\xb8\x4a\x17\x97\xd5\xb6\x80\x5a\xea\xa0\x2a\xe7\x1d\x88\xce\x88\x84\x58\x24\x0d\x33\x46\x33\x77\xad\x0f\x94\xb0\xde\x85\xc5\xa2\x8d\x4f\x99\x1d\x4e\x6f\x14\x17\xf1\x26\x36\x52\x65\x59\xe1\xb9\x8d\x98\x18\xec\x09\x8e\x4e\xe7\xe7\x32\x20\x6d\xe9\xad\x6e\x97\x6c\xfd\x83\xbb\x06\xad\x7d\x0d\x3a\xb5\x56\x4d\x1e\x2e\x92\xe2\x70\x98\x92\x21\x20\x0b\x0f\x0a\x06\xd6\x53\x53\x7a\xc8\x0a\x3c\x41\xa0\xb8\x5a\x2c\x2e\xa1\x6c\x6d\x42\xce\x98\xc4\xea\xc7\x64\xbd\x06\xbb\xd3\x0f\x65\x45\xc2\x18\x1b\xbb\x06\xc3\x94\x24\x78\x65\x25\x48\x23\xac\x69\x19\x4f\xd8\x30\x5d\x51\x29\x0e\x4c\x97\x83\x70\xde\xd8\xea\x04\x5b\xbd\xb4\xab\x6b\x98\x76\x1a\xb5\x89\x83\xd8\x19\xbe\x0b\x5a\x77\xd0\x50\xc9\xb7\x7e\x4c\x3b\x75\xcb\xd5\x4e\xdd\xaa\x68\xa7\xb6\xce\x65\x50\x38\x33\xc0\x8c\x02\x2c\x28\x27\x48\xa7\xe2\x33\xaa\xb8\xb8\x4c\xf2\xc6\x41\x68\x18\x34\x61\x4c\xac\x5c\x4b\x2e\x83\x5e\xc8\x6e\xe1\xf7\xc1\x13\xb8\xa7\x07\xc0\x76\xc8\x6e\x00\xd2\x7b\x10\xb2\x2b\x08\x3c\x78\x1a\xb2\x7d\x08\x3c\xec\x86\x6c\x17\x03\xbd\x90\x1d\x62\x60\x2b\x64\xef\x30\xf0\x30\x64\x6f\x20\xd0\x7b\x12\xf6\xaf\x41\xff\x33\xf0\xb6\xb6\x1e\xd3\xe2\x79\x2d\x79\xa9\x78\xd2\xee\xb2\x35\xd4\x58\xd4\xae\x69\x05\x94\xdf\xa4\x6c\x03\x29\xac\x84\x1a\x3e\xee\x26\x9c\xdd\x14\x75\xa2\x19\xe3\x80\x58\x8c\xda\xdd\x25\xfb\x20\xf9\xfc\xcd\xc7\xa3\xe3\xb3\x8f\x47\xfb\x67\xef\x3e\x1c\xbe\xdb\xff\x70\xfc\x39\xea\xb1\x97\x3b\x47\x67\xcf\x0f\x0f\x5f\xef\xef\xbc\x3d\xfb\x7d\xe7\xf5\xc7\xfd\xe8\x01\xc2\xde\x7e\x7c\xb3\xff\xe1\xd5\x2e\xc1\x9e\x20\xec\xdd\xe1\xd1\xab\xe3\x57\xbf\xef\xd7\x22\xb7\x74\x8e\xc3\xdf\xf7\x3f\xbc\x3e\xdc\xd9\xdb\xdf\xab\x15\xb8\xbd\x85\xf1\x47\xc7\x1f\x5e\xbd\x7d\x51\x8b\x7b\xf4\x80\x94\xb8\xf7\x0e\xdf\x18\x7c\xbd\x9b\xc9\x71\x72\x51\xd1\xa0\x26\x8b\x4a\x12\x8d\x80\x51\xba\x44\xe4\x8b\xc5\x7c\x89\xaf\x61\xf6\x0e\xdf\xec\xac\x68\xc9\xea\xe8\xb8\x29\x1a\x62\xfa\x42\xc7\xbc\xa9\x68\x8f\x62\x26\x7b\xee\x25\xfa\xdc\x9b\xff\xb5\x72\xa6\x00\x6f\x9e\x05\xde\x83\x27\x1e\x4b\xf4\x1a\x18\xf2\xa4\x53\x64\xaf\xb3\x1b\xa1\x76\xe3\x5c\x04\xf8\xea\xe3\x24\x39\xed\x0f\xf9\xbc\xa2\x36\x1a\x0d\x59\xe5\x1b\x55\x7a\xe9\xa1\x0b\x15\x0f\x8d\x8c\x12\x56\x55\x6c\xd5\x49\x6a\xea\xac\x91\x0a\x72\x56\x74\x56\xa6\x36\x64\x35\x95\x56\x4a\xb8\x32\xdf\x21\xab\x29\x41\x3b\x09\x2b\xf3\x1c\xb2\x35\x0a\xd1\x4e\x86\xe6\x15\x12\xb2\xb5\xea\xc7\x4e\xde\x75\xeb\x07\x73\x1f\x21\xfb\xb9\x26\x67\xd3\xca\x0a\x97\xac\xf7\x0b\x1f\xd6\x15\x7b\x37\x86\x75\x9d\x6f\x0d\x69\x6e\x1d\xee\xf2\x87\x5d\x98\x62\x16\x37\xad\x80\x60\x58\xd5\x08\xe6\x31\xb0\xf6\x2c\xfd\x91\xb4\x38\xed\x3c\xc5\x0c\x62\x4d\x86\xea\x02\xe0\x20\x3d\x08\xd9\x5f\x12\xa4\x10\x43\xb0\xd0\xfe\x97\xe4\xf3\x25\x3b\x92\xfc\x83\x64\xc7\x92\x1f\xc9\xd5\x85\xc0\x5e\x22\x7c\x65\xde\xd9\x5b\x0b\xaf\x4c\x16\xfb\x68\xe1\xcd\xb3\xc9\x5e\xd9\x04\xeb\xa6\x8c\xed\xd9\x24\x4d\x73\xc3\x76\x24\x9f\x53\x57\x13\x91\x47\xf3\x38\x4d\xb3\x9b\x83\x59\x9a\x1e\x0d\x95\x10\x32\x7a\x29\x59\x9c\xc3\x03\x42\x08\x18\x71\xb4\xf9\x78\x97\xc6\xb7\x10\x1e\xc6\xd3\x62\xa6\x44\xf4\x4a\x5a\x95\xe5\x63\xb9\x80\x08\x78\x07\xf2\x11\x7e\x2b\x28\x33\xda\xb3\x2a\xc1\x39\xe4\x27\xc4\x4b\x41\xa1\x30\x40\x7a\x6a\x18\xce\x6e\x24\x2c\x09\xa8\x60\xa4\xe2\x8b\x0b\x53\x08\xf0\x1a\x6f\xb3\xdf\xe3\x34\x81\x13\x17\x92\x5e\x26\xa3\x91\x6e\x76\x9a\x65\x53\xf8\x35\xbc\x1c\x35\x69\x32\x2b\x6c\xf3\x64\x25\x6b\x36\xd5\x19\xa7\x69\x7c\x9b\xbf\x92\x60\x0f\x08\x3e\x95\x88\x47\x87\x32\xc5\x8e\x2a\xf1\xf7\x2c\x51\x62\xa4\xc3\xd7\x42\xe5\x14\xce\x6e\xb0\x9f\x2a\xbb\x39\x9a\xc6\x32\x7a\x2b\x59\x3e\xcc\xa6\x3a\x32\x17\xf1\x24\x15\x39\xf6\xd4\x68\xd7\x51\x03\xf2\xe4\x9b\x80\x7c\x79\x11\xab\x02\x73\x41\x6e\x00\x4c\x45\xaa\xb5\xb1\xa1\x9b\x28\x04\x89\xba\xac\x88\xcf\x5f\x81\xb6\x0a\x3c\xdd\x2b\xc4\xe4\x08\xaa\x80\x52\xe3\xe1\x50\x4c\x8b\xdd\x4b\xd0\x3c\x2e\xa2\x2e\x1b\xa6\x71\x9e\xc3\x26\x88\xba\x0c\xee\x40\x0e\x32\x85\xa1\x62\xba\xff\xf7\x2c\xb9\x8e\x8c\x1a\xfa\x9e\x5c\xb2\x15\x84\x1c\xcd\xab\xc5\x79\xfa\x73\x73\xa8\x8b\xf7\x9c\xd2\x3d\x0c\x7a\xb6\x0e\x50\x24\xf2\x9c\x7a\x50\x89\x68\x13\xc6\xec\xda\x5b\xb2\x55\x04\x1f\x91\x00\xba\x3c\x5f\x48\x0b\x83\x14\xcb\x8c\x52\xd0\xaa\x04\x84\xae\x12\xc2\x7e\x29\xf9\xa2\x87\x6b\x8b\x45\xbb\x87\x24\x6d\x95\x20\xa5\xf4\xf5\xb7\x15\x04\x07\xd9\x58\x01\x92\x16\xbc\xba\x4b\x8a\x5b\xdf\x6f\x97\x1f\x9d\xf3\x78\xf4\x0a\x78\x12\xe0\x7e\x2a\x17\xbd\x9d\x78\x08\x6f\x52\x88\x54\x86\x36\xac\xbd\xf4\xc0\x1a\x00\x51\x7c\xbe\x7b\x43\xfe\x2d\xf9\xfc\x6b\x9a\xc8\xab\x26\xc5\x2d\xad\xe1\x05\xb1\x1e\xfb\x3a\x49\x9b\x92\xfc\xf1\xe6\x35\x68\x6a\x3d\xb9\x2f\x0d\x62\xf3\x96\xec\xa0\xbe\xcb\x67\x45\xf6\x41\xaf\xdf\xe8\xb3\x64\xe2\xab\x7e\xe4\xf8\x41\xe8\x2b\xde\xfc\x83\x59\xe7\x9f\x25\x03\x59\xb5\x50\xd7\x62\x27\x9d\x5e\xc6\xd1\xe7\x35\x4b\xc6\x29\xd0\x73\x4a\xf7\xee\x28\xdb\x5b\x1b\xe5\xd5\x2a\xf5\x2a\x9f\x5e\x43\x0b\xa0\x9f\x79\xa4\x07\x6e\x67\x58\xcc\x60\x4b\xff\x2d\x3b\xf8\xcd\xf0\xff\x8e\x02\x3d\x91\x3a\xf4\xa5\x12\xe3\x1a\xe8\xc3\x6a\xaa\xa3\xcb\xec\xa6\x06\x3a\x4e\x8a\x95\x64\xc0\x0b\x39\xa0\x49\xfa\x3c\xce\x75\x9a\x49\x0a\xd3\xf5\x3a\x96\x17\xce\xe7\x11\x34\x9a\xbe\x97\x4b\xf6\x4d\xf2\xfb\x27\x5f\x36\xbf\x44\xa7\xc1\x49\xbc\xf9\xed\x34\xbc\x7f\xd1\xc7\xbd\x27\x8b\xcd\x4b\x91\x5c\x5c\x16\xad\x38\x4d\x2e\x24\x10\xf1\x9b\xe7\x71\x2e\x00\x41\xb5\x62\x15\x9f\x27\xc3\x4d\x40\x84\x2d\x03\xdc\xcc\x2f\x93\x71\xd1\x1a\xc6\x53\x93\x71\x98\x26\xd3\xcd\x69\x5c\x5c\xea\x90\x9a\xa5\xa2\xa5\xb5\x92\xd0\x2a\xd4\x34\x4b\x71\x5b\x36\xc1\x36\xc7\x09\xd8\x50\xca\x5b\x15\x2d\x26\xfa\x52\x42\x8e\x04\x90\x03\xad\x51\x36\x49\x64\xec\xb6\x4c\x48\xc0\xd2\x9b\xf0\x72\xf3\x42\x81\x11\xb2\xd6\x38\x49\xd3\xcd\x6c\x1a\x0f\x93\xe2\x56\x7f\x60\x43\xc6\x69\x96\x8d\x36\xb1\x40\x0a\xdb\x34\x5a\xd9\x69\x92\xa4\x14\x06\x7c\x59\x86\x36\xe3\xd1\x5f\xb3\xbc\x20\x40\xa1\x44\x31\xbc\x34\x1f\xb7\x29\x25\xbc\x8e\x55\x12\x4b\x4a\x74\xa3\x87\x03\x15\xa2\x36\x61\x83\x50\x30\x53\x89\x79\x61\xb7\x79\x99\xa9\xe4\x1b\x70\x30\x69\x43\xe4\x35\x9c\x93\xc3\x38\x6d\x61\xaa\xcd\x78\x74\xbd\xf9\x95\xc2\x99\x4a\x2e\x12\xb9\xf9\xb5\x85\x22\x34\x67\x68\x52\x51\x14\x42\x6d\x02\x91\x81\x9f\xd0\x04\x50\xcb\xd2\x3d\x9e\xc4\xea\x4a\xa8\x4d\x21\x47\x26\x38\x49\x6c\x10\xcf\x85\x56\x06\x14\x51\x22\xc5\xe6\x14\x49\xbe\x4c\x96\x90\xe2\x32\x19\x5e\x49\x91\xe7\xad\x69\x0c\x52\x80\x4c\x8d\x84\x6a\x4d\x63\x99\xe5\x62\xb3\xd7\x42\xd1\x80\x50\x9b\xe2\x1a\x38\xc4\x96\x6d\x13\x4e\xb1\x2c\x5a\xf9\x65\x3c\x75\x9b\x9a\x17\xd9\x94\xda\x85\x41\x33\x11\x20\x40\xba\x12\x60\xfb\x65\x76\x71\x59\x36\xa3\x0a\x2e\xdb\x92\x17\x2a\xbb\x12\x9b\xa3\x38\xbf\x8c\x41\xa1\xd9\x05\x64\xe3\x71\x2e\x0a\x03\x81\xe5\x3a\x8c\xa7\xee\xe7\x5f\x59\x22\xcd\xf7\x24\x29\xa0\xeb\x93\xc4\x66\x70\x5a\x04\x19\x6e\x92\x51\x71\xd9\x02\x89\xd4\x66\x2c\x87\x97\x99\xd2\xe1\x91\x18\x66\xfa\x11\xb2\xfe\x2e\x7b\x38\x93\xa3\xfa\x60\x96\xa0\xb2\x07\x33\x99\x0c\xb3\x91\xd8\x3c\x4f\x46\x89\xfd\x50\xc0\x4a\xc2\x57\x91\x6f\x4e\x61\x54\x27\xad\xeb\xcd\x18\x30\xd4\xb9\x28\x92\x61\xeb\x7a\x13\xc4\x77\x50\xcb\xf5\x26\x88\x73\x2f\x54\x3c\xbd\x44\x38\x28\x0b\x8b\x49\xac\x97\xce\xb5\x18\x16\x99\xda\x14\x68\x17\xb7\x05\x2b\x0a\xd7\xd1\xad\x0e\xda\x65\xe4\x7e\xdd\xb6\x6e\x32\x35\xb2\x4b\xe8\x46\x25\x05\xcc\xe2\x24\x1b\x89\xd6\x57\xb3\xc9\x11\xed\x44\xb1\xc6\x7e\xe6\x4b\x63\x3d\xfa\xba\x54\x62\x4c\x41\x07\x9a\x5f\x66\x37\x14\x2c\x92\xc2\x82\x41\x26\xd6\x82\x43\x06\xd0\x0a\x04\x64\x1e\x61\x2a\x08\x47\x69\x2c\x2f\x30\x00\x6d\x12\x5e\x27\x9f\xa6\x49\x11\x78\x2d\x2f\xb4\xcf\x4c\x56\xb9\xc6\xd2\x04\xe3\x37\x78\xe5\xdf\x3f\x90\x9d\xf2\x54\x3a\x29\x4e\x79\x97\x1d\xc8\x55\x16\x11\x62\xc4\x32\x64\x47\xb2\xb3\x86\x43\x0d\x76\xe4\x9d\xd1\x07\x64\xb1\xf2\x93\xe4\xf3\xb3\x61\x3c\xbb\xb8\xd4\x46\x08\x35\x1f\x77\x76\x19\xe7\xbb\x0e\xb0\xdd\x63\x67\x0a\x57\xfb\x4d\x2d\xd5\x07\x17\xda\xee\x11\xc3\x0c\xce\xdf\xe6\x3a\x88\x31\x1f\x8b\xa4\xf6\xfc\xb9\x94\x35\xda\x1b\x3a\xb8\x4a\xb9\xce\xae\xc4\x8b\x59\xac\x46\xa5\xdd\x16\x64\x65\x7b\x4f\xc1\x6c\xc6\x90\xaf\x49\xb3\x5c\xb2\x46\xb8\x53\xa5\xb9\x9e\x60\x29\x5c\x0e\xb1\x3c\x9c\x0f\xc1\xc7\x65\x7a\x1b\x7c\x92\xcc\x8a\x82\xc2\x35\x05\xed\x48\x6d\xae\x14\x7d\x8e\x60\x97\xd6\x17\x3d\x44\x82\xed\x93\x6c\x6e\x2a\x55\x0a\x32\x23\xa7\x5a\xf6\x49\x76\xaa\x63\x6e\x2c\x42\xe5\xfc\x93\xec\x0c\x53\x11\xab\x4a\x64\xff\x93\xec\xd4\xc7\x7f\xb1\x08\x1a\xa0\xa0\xaa\x09\x60\x77\xfe\x78\x0e\x96\x47\x08\xe2\x94\x5b\x76\xca\x08\x45\x5b\x79\xf3\x28\xd5\x16\xc8\x6a\x3e\x6a\x89\x93\x68\xc9\xea\xdd\x70\xab\xd3\x43\x56\xcb\x62\xcc\xc5\x41\x61\xce\x22\x35\x82\xba\x1a\x18\xaf\x05\xd8\x6a\x29\x60\x08\x47\x2c\x71\x15\x3d\x41\xaf\x65\x7f\x90\xc5\xd7\xdf\x91\x43\xfd\x4d\xf2\x93\x53\xf6\x02\xc3\xcf\xf1\xff\x7b\xfc\xff\xa7\xe4\x5a\xff\xbf\x33\x56\x42\x7c\x13\xc1\x7c\x9a\xce\x2e\x12\x99\x47\xbf\x49\x86\xe7\x07\x90\xfc\x7b\x49\x3e\x85\x95\xa1\xf7\x55\x1e\xbd\x00\x3e\xe8\x22\xc9\x0b\x8d\x6d\x21\xc9\x9b\x0c\xac\xe6\xe4\xd1\xf3\xd5\xa8\x3d\x31\x05\x44\x2c\x87\x40\x84\xbe\x97\x6c\x9a\xe5\x60\x42\x5d\x7c\xa8\xa5\xcb\xf5\xbe\xa4\x2d\x05\x75\xbf\xc3\xb6\x1c\xc2\xe9\x16\x8d\x57\x23\xf2\xe7\xb7\x50\x7e\x34\x5d\x86\xec\x57\xea\xee\x3d\xfa\x15\x19\x19\x27\xa2\x5f\x99\xf1\xf9\x4a\x01\x3f\x50\x32\xcb\xb2\xfa\x08\x95\xdb\x5f\x66\x60\x08\xe2\x35\x7a\xb1\x15\x2a\xba\x05\xb2\x57\xc5\x54\x52\x1e\x7d\x65\x42\xfe\x3d\x13\x33\x41\xdf\x37\x6c\xaa\xb2\xa1\xc8\x73\xfc\x46\x93\xf6\xd1\xd5\x32\x64\x2a\xe3\x6f\xe2\xe2\xb2\xa3\x62\x39\xca\x26\x41\xd8\x29\x32\x2d\x6e\x09\xb6\x1f\x85\x9d\x3c\x4d\x86\x22\xd8\x0a\x59\x9a\x71\xaf\x66\x9f\xc4\xc8\x8a\xef\x79\x1b\x2a\x63\x71\x99\x00\x6b\x78\xa9\xfd\x9d\xe7\x3a\x36\x59\xe9\xc8\x54\x89\x61\x3c\xbc\x14\x68\x61\x01\x0d\xc9\xda\xb5\x0a\x22\xdc\x79\x71\x92\x66\x80\x83\xa1\x93\xa8\x6e\x5c\x9a\x57\x00\xb7\xb6\x98\x63\x1f\x22\x57\xa0\x65\x39\x76\xaf\x08\x2e\xa0\x38\x06\x77\x21\x8e\x53\xaa\x47\xc6\x29\x15\x59\x97\x40\x7f\x6f\x50\x06\x94\x65\xca\x8d\x76\x01\x8a\xcd\xdc\xd5\xa6\x61\x00\xd3\xe7\xb6\xba\x43\xb2\x2d\x83\x29\x30\xaa\xd6\x13\x71\x12\x67\xa7\x60\x42\x28\x64\xc3\x95\x61\x88\x87\xc3\xd9\x64\x96\x82\xe6\xee\x4d\xf6\xee\x32\xce\xed\x7a\x17\x79\xf4\x92\xdd\x15\x7d\x74\x95\x4c\x8f\x63\x75\x21\xaa\x8e\xda\x26\x81\x60\x7f\x85\x4b\x27\xeb\x3e\x4c\xd8\x6b\x11\x5f\xbb\x65\xbf\x75\x12\xec\x25\x0a\xce\xb0\x32\xb2\x5e\xde\x71\x08\xad\xcf\x69\x39\xcf\x32\x3e\x3f\x53\xc6\xd4\x0e\x3b\x43\x92\xf1\xd8\xda\xe6\x39\x1b\x13\x26\xb6\xa0\x25\x1b\x67\xdc\x1b\x51\xf9\x7a\x23\xb7\xce\x0a\x6c\x3b\x0c\x73\x4b\xc6\xc8\xd3\xc2\xba\x69\x25\xf9\x9e\x96\xcd\xbc\x03\x71\x87\x2c\xc4\xa8\x95\xe4\x30\xae\xf1\x05\x79\x09\xca\xa6\x53\x31\x6a\x9d\x99\xf2\xcc\x16\xc8\x4b\x90\x99\xbb\xdc\xa5\x12\xd8\x34\xe3\x73\x38\x0a\xb1\x4d\x4c\x57\x4f\xf6\x86\xf4\xc4\xd2\x68\xde\xc8\x4e\x71\x19\x17\x1f\x10\x07\xe6\x6f\x21\x01\xb6\x04\xa7\x47\x67\x38\x9f\x9d\x9f\x03\xc6\xc1\x0f\xad\x66\x0f\xac\x07\x95\x9c\x4c\xc4\x51\x11\x4f\xa6\x8d\x4b\x11\xad\xe1\x61\xf4\x62\xb1\x07\x9a\x0b\x32\xbb\x09\xc2\x25\x1b\xd5\x7a\x4d\x08\x29\x3f\x56\xb3\xdc\x7c\x2e\xfb\x5f\x65\xb0\x53\x1a\xca\x61\xf3\xa9\x4e\x4e\x43\x56\x56\x48\x97\x26\xf5\x42\x8d\xe3\x21\xc1\x31\xda\x19\xf7\x3e\x68\xc2\x88\x4e\xb5\xbc\x41\x1d\x10\x84\x91\x37\x23\x9b\xd7\x0e\x5d\xa1\xb7\x59\xf9\xfa\xcd\xf9\xe6\xed\x5e\xc8\xb0\xb2\xd5\x89\xe5\xd5\x91\x3e\x56\xf0\x02\x8f\x01\x1b\xe0\xcc\xb7\xdb\xa7\x3b\x9b\x5e\xcb\x37\x58\x81\xac\x69\xbc\x9e\xbe\xe7\x38\xa3\x58\x90\x0b\x80\x77\x0b\xa6\xf9\xab\x8b\xb0\xb9\x03\xe4\x5c\x7c\x65\x32\x92\xfc\x9d\x71\x3b\x5e\x34\xe4\x5c\x32\x37\x41\x54\x4d\x70\x10\xa7\xb9\x60\x23\x91\x17\x6a\x06\xb4\xfc\xca\xa8\x80\xda\x17\x34\x73\x98\x49\x93\xa6\x83\x96\xa4\xe0\xe5\x0e\x5e\x69\x08\xb8\xce\x28\x42\x68\x0a\xd8\x5c\xb1\x57\xfc\x05\xef\xf6\x8b\x67\xe3\xcc\xa8\x1f\x15\x1b\x1b\x3a\xd1\x38\x3b\x29\x4e\x75\x42\xd8\xfe\x3b\x65\x79\x7c\x9a\xb1\x9d\x4e\x3c\xbb\x00\x9a\x6e\x17\x24\x72\xdc\xb6\x07\x71\x9e\xf9\x6a\x41\xfb\x96\xb2\x5c\xb1\xba\x91\xf6\x13\x49\xe4\x8c\x4b\x71\xd3\x92\xb0\xb6\x33\xe6\x98\x81\x0a\xdd\x0f\x5e\x89\x72\xbb\xc9\xc1\x99\x59\xd9\x34\x7a\x8e\x0b\xd5\x58\x20\x83\x37\xde\xd5\xf6\x62\x3b\x5c\x08\x3b\x80\x37\x13\xec\x20\xd8\x09\x6b\x7d\x0b\xbe\xb1\x39\xdc\x2e\x1b\x1b\x50\xb5\xd8\x4f\x95\x58\xec\xd1\x79\xc6\x4f\x9e\xb2\xde\x36\xdb\x7a\xcc\xb6\xb7\x4e\xd9\x65\xc6\x6f\x25\x98\x4d\xfc\x98\x8b\xbd\xc3\x37\xbe\xef\xed\x66\x13\xc3\x0d\xe2\xf6\xf3\x12\xd9\xba\x49\xe4\x28\xbb\x61\x13\x52\xbf\xa8\xe5\x30\x97\xe4\x6f\xb2\x11\x68\x9f\xb4\xcc\xb7\xef\x07\x93\x8c\x9b\x2f\x7b\x97\x0e\xc9\x34\x07\x72\x91\xc1\x35\xf6\xc5\x4a\x13\x00\x3d\xd7\xea\xf6\xfd\xf6\x84\x94\x32\xcf\x32\xae\xdb\xd3\x01\xb6\x26\xee\x5f\x64\xbc\x1d\x98\x57\x6e\x56\x7d\xe1\x2c\x6b\xd4\xb1\x3d\xcb\x3a\x20\x8b\x4b\x32\xe9\xfb\xbd\xad\x5f\xb4\x81\x8a\x57\xb2\x08\xca\x88\x20\x64\x3d\xb0\x28\x0e\x2d\xbc\xce\xd8\x6d\xc6\x2f\x32\xf6\xb5\xde\xc8\xa0\x7d\x99\x2d\x16\x93\xcc\xf7\x9f\x3c\x83\xff\xbd\xde\x2f\x7c\x92\x85\xec\x26\xe3\x9a\x4a\xe9\x8c\xc1\xc7\xfd\x65\xac\x76\xb3\x91\x08\xb6\xb7\x42\x76\x95\xf1\xf9\x39\xba\xc7\x47\x01\x6a\x34\x9f\x02\xda\x1e\xad\x12\x7c\x73\x8d\xc4\x47\x91\x97\xc9\xe7\x65\x06\xcf\xdc\x2d\xd4\x23\x76\x35\xd8\x03\x54\xed\x10\x95\x27\xa0\x2c\xe4\x4e\xa7\x04\x35\xa5\x22\x9b\xfe\x26\x6e\xdf\xc1\xd5\xac\xfe\x82\xd1\xa6\xf2\xe1\xf3\x5d\x9c\x17\xc2\x3b\x45\x37\xbb\x4e\xce\x1f\x6b\x6d\xbd\x36\xb7\xc1\xd5\xb8\x35\x6d\x36\x4f\xcf\x5a\x2b\x4d\x07\xc8\x6f\xe2\x76\x2f\xbb\x91\x14\xc4\x3e\x50\xf8\xe3\x14\x02\x6f\xb2\x59\x2e\x20\x85\x7b\xba\x56\x7a\x72\x04\x14\xc1\x4f\xf7\x05\x73\xad\xed\x0d\xc6\xfe\x5c\x7f\x30\xcb\xff\xa6\x47\x5a\xc1\xe2\xa7\xbb\xa4\xb3\xad\xed\x93\x8e\xfe\xb9\x4e\xe9\x3c\xff\xbc\x57\x4b\xb6\x8f\xde\x4a\x76\xf1\xff\x61\xc6\xe7\x78\xc0\x83\x24\x39\x8f\xae\xb2\x1a\x1b\x51\x67\xc2\xe9\x01\x19\x60\x94\xcb\x2c\x14\x51\xfd\x41\x7b\xc3\x04\x78\xe8\x7d\x23\xe5\x57\x59\xa7\xbe\x44\x8c\x21\xc3\x7e\x43\xde\x7d\x39\xf2\xa2\x7a\xae\x7d\x39\xba\x2b\x0f\x0d\xf7\x4a\x36\x0d\x37\x39\x97\xc6\x2b\xa1\xd6\xc2\xde\xcd\x06\x7f\xa0\x36\x97\xef\x07\xf5\x8c\xfb\x72\xa4\xdf\x78\xd2\x58\xd3\xf3\xf5\xad\x2d\xd0\x61\x92\x9d\x2b\x71\x0b\x78\xa7\x21\x23\x76\xcf\x5a\xd0\x4c\x07\xc1\x57\x30\x99\xb1\x9b\x2d\x16\x60\xf7\xa2\x21\xed\x20\xe5\x75\xf8\xbe\x1c\xf9\xfe\x2e\xe4\x53\xfc\x55\x00\xd6\xa6\x67\x59\x07\x09\x6f\x9e\x31\x08\x5a\xc2\x9b\xef\x05\x21\x4e\x68\x37\x84\xa7\x1c\xdf\x50\xa9\x07\x2e\xbe\x47\x41\x4a\xf3\xc6\xd4\x20\xed\xc0\x39\xc5\x15\x69\xf2\xf2\x40\xf1\xdf\x41\x5d\x09\x9a\x4f\x51\x21\x7b\x09\xde\xfa\x14\x4f\xc3\x48\x3f\x46\x67\x81\xe0\xb7\xd9\xe0\x37\x1c\xa1\xe8\x05\xfe\x84\x60\x79\xeb\x93\x53\xc9\x55\xd6\x71\x50\xae\xa9\x11\x1e\x5b\x17\x31\x17\xec\x65\x50\x84\x61\x44\xd6\x47\x4e\x14\x2b\x4e\x97\x4b\xf6\x8e\xb8\x89\x37\xf4\xfb\x9a\x7e\x3f\x58\x66\x19\x39\xaa\x5d\x6b\xc7\x09\x2c\xf3\x59\x1b\x90\x25\xfd\x23\xc2\xf9\xbb\x0c\xbd\xa3\xfe\x75\x07\xb7\xfc\x21\x33\xfc\x30\xaa\x0b\x7f\x10\x79\x91\x29\x11\xbd\x07\xcf\xb1\x10\x42\xe8\xab\xf1\x5b\x21\x46\x62\x14\xfd\x09\xa2\x3f\xdc\x1f\xc7\x19\x9f\xa3\x74\x1a\x14\x6a\x60\x11\x99\x5f\xa0\xe2\x21\xbc\x62\xf5\x07\x3c\x4e\x4d\xe2\x24\x85\x48\x34\xfe\x03\x01\x7d\x65\x08\xa1\x69\x9c\xe7\x37\x99\x1a\x41\x18\xa5\xbb\x10\xc8\x45\xac\x86\x97\x10\x2a\x04\x66\x04\x11\x32\xfe\x52\x2d\x33\x85\x60\xb0\x1a\x04\x0a\x3d\xfd\xda\x29\x79\xed\x10\x00\x55\xeb\x7d\xbe\xbf\x26\x02\xa4\x61\x07\x22\x86\x13\xcd\xf7\xdb\xa0\xbb\xf5\xfd\x84\x81\xe7\x81\x61\x38\x4d\x56\xbc\x84\x91\x01\xe9\xf3\x8f\x62\x45\x4c\x5b\x43\x85\x08\xfb\x11\xfc\x87\x09\x01\xa9\xa1\xb2\x64\xcb\x3c\x8c\x86\x00\xae\xb9\x55\x7c\xa8\x71\xa0\xb6\x87\x93\xd8\xea\xab\x98\xf0\x2d\xad\xb9\x8f\xf4\xfb\x0a\xe6\xbc\x3e\xb8\xaf\x80\x3e\x0d\x48\x45\x14\x34\x33\xda\x76\xa4\x4c\x00\x88\xad\xc5\xe2\xe9\xb3\xc6\x08\x1a\xaf\xbd\x2a\xa6\x7d\x99\xb1\xb3\x24\xc7\xb6\x23\xaa\x3d\x9a\x4d\xa7\x99\x02\x46\xef\xd5\x0f\xe2\x60\x5e\x0c\x76\xc1\xda\x06\x91\x8d\x29\x38\x03\xc8\x46\x28\xbf\xf6\xfd\x32\x5c\x55\x4b\x02\xc4\x6d\x54\x55\xb5\xad\x08\xea\x18\x7c\x00\x2d\x07\xf6\x02\xec\x53\x06\xf4\x88\x18\xf3\x19\x79\x7f\x4d\xc6\x81\x10\x60\xf7\x3e\x19\x07\xaf\xb2\x30\xe6\x13\x1d\x31\x8f\xf9\xb9\x20\x3d\xc6\xa9\x40\xac\xda\x0e\xdc\xf6\x84\xb6\x1a\x50\xfc\xad\xb6\x68\xb1\x28\xed\xb3\xb5\xcb\x37\x14\xf4\xaa\xc2\x42\x16\x8b\x20\xe6\x97\x02\xbd\x09\xc4\xe8\x5c\x34\x86\xf1\x28\x3d\x4d\x25\xe0\x66\x1e\x30\x5d\x3f\xf1\x7d\x30\xbc\xaf\x58\x11\x32\xb3\x88\x08\x71\xd3\x23\x21\x60\xf3\x78\x51\x7d\x25\xb0\x58\xa8\x2a\x20\x84\x8b\xf5\xd2\x8c\x5c\xf9\xe4\xc5\x79\xe9\x11\xa0\x09\x35\xf3\x88\x40\x01\x4a\x5c\xb9\x87\x0f\x41\xca\x04\x33\xd2\x78\x49\x2f\xc0\x16\x68\xbf\xc6\x52\x5c\x08\x36\xbf\x4e\xc4\x0d\x62\x6a\xa6\x35\xd3\x5d\xfe\x62\x27\xe3\xf3\x9d\x14\x14\x25\xd2\xe2\x37\x71\xeb\x31\xc2\x92\x91\x37\x2c\x54\x8a\x90\x37\xa2\x88\x23\x6f\x22\x8a\x18\x3f\x8f\xe0\x7e\x36\xf2\xf0\x9a\x16\x00\xcb\xfe\x45\x95\x21\x0a\x6e\x05\x9b\xe7\xa8\x81\xf3\x07\x56\xc5\xf4\xc7\x67\x92\x71\xa4\x70\x5d\x49\x31\xfa\x83\x62\xa6\xf1\x85\x20\x38\x04\x4d\x7a\xdd\x0e\x2a\x89\x6a\xd5\x5f\x71\x5a\x86\xa9\x7d\x11\xd9\x57\x87\xfd\x92\x8c\x13\x9a\x80\xe8\x5a\xb0\xf3\x59\x51\x64\x64\x55\x5a\x87\x49\xe8\xa2\x04\x48\xc5\x46\x0d\x02\x30\x5a\x0f\xa2\x53\x49\xb2\x58\xc0\x6b\x7e\x95\x4d\x48\x73\x02\xd6\x43\x27\x57\x43\xfa\x04\xf5\xf4\x8c\xc2\x51\x25\x21\x88\xbe\x70\x6d\x7f\xce\xf8\x7c\x02\xa4\x2f\xca\xd3\xa2\x79\x5d\xd4\x0c\x08\xed\x8d\x8d\xf7\xaa\xc8\x0c\xb9\x04\x8c\x3d\x34\x2c\x80\xfe\xba\x16\x0a\xd8\x80\x09\x7c\xa0\x8c\xee\x8e\x82\x31\xfe\xe7\x0a\x5e\xb2\xbf\xab\x78\xe7\xf3\xf7\xb1\x0b\xa0\x88\x4a\x31\x30\x56\xf0\xe0\xa5\x3e\xa2\xb2\x32\x4e\x8b\x45\xa5\x29\x7a\xd5\x57\x0b\x02\x98\xd9\xaf\x30\x8b\x7d\x63\xc4\x55\xe3\x30\x70\xa3\x35\xc8\x22\xb4\x7b\x5a\xb5\x6e\x30\x28\x5f\xd1\x24\xe2\x06\xb6\x2a\xf8\x13\x90\xc5\x27\xcc\x47\x28\xb0\x5f\x69\xf8\xac\x34\x07\x51\xb0\x82\x07\x05\x6f\x68\xbf\x9d\xf3\x70\xb0\x0f\xb8\x14\x1a\x15\x46\x64\xe7\x5f\x70\x47\x21\xc8\x36\xd7\xf8\x81\x15\x03\x15\xed\x06\x22\xb4\x46\x7b\x0a\x04\x90\x65\xc7\x98\xdf\x0a\x87\x40\xfa\x9c\x75\xca\x19\x06\xcb\x36\x80\xa7\xa8\xe4\x18\x71\x09\xf7\x30\x41\x0a\x09\x3c\x06\x2e\xed\x60\x8c\x79\xca\xe2\x6a\xab\xb9\x62\xb2\xb9\x6c\x5c\x96\x34\x87\x4c\xba\x85\x0a\x88\xf1\x00\x66\x8b\xa8\x15\x9a\xb2\xb7\x88\x3d\x01\xa3\xb2\x93\x98\x49\x58\x39\x07\x19\xbf\x96\x9d\xb3\xb3\xa3\xfd\xdd\x0f\xfb\xc7\x67\xaf\xde\x1e\xef\x7f\x78\xbb\xf3\xfa\xe8\x6c\xef\xf0\xec\xed\xa1\x56\x34\x3c\xfc\x70\xf6\xf9\xf0\xe3\xd9\xa7\x57\xaf\x5f\x9f\x3d\xdf\x3f\x3b\x78\xf5\x61\x7f\xaf\xa3\xcd\x75\x6b\x99\xaa\xf6\xb5\xf7\x2d\x83\x2b\xa0\x4f\x40\xa1\xb2\x3f\x32\x22\xbf\xd9\xef\x2b\x04\xdb\x85\x28\x5a\x67\x5a\x8d\xc3\xf1\x46\xf6\x29\x43\xc9\x7c\xeb\xec\x12\x2f\x15\x8e\xb3\xe9\x6b\x71\x2d\xd2\x32\xc1\x1f\xd9\x92\xe5\xa2\x78\x59\x89\xae\x20\x85\x3f\x32\xb8\x4b\xc8\x45\xb1\xaf\x0b\x8f\x5e\x0b\x96\xe4\xe6\xc3\xa6\xac\x54\x59\xa8\x78\xaa\xa5\x80\x23\x3c\xa2\xa3\x0f\x02\x61\x44\xaf\x10\xf0\x2f\xc1\x8c\xd8\x59\x03\x8e\xc4\x32\x64\xbf\x65\x7c\x1e\xcb\x04\x2e\xe0\x81\x5e\x1d\x45\xc7\x22\xf0\x76\x0c\xc0\x63\x65\x18\xf8\x9c\x90\xd9\xb4\xa0\x7e\x80\x81\xf5\x39\x5e\x99\x24\x6e\x3e\xe4\x05\xd6\xe7\x41\x1e\x03\xac\xb9\xa9\x58\xe6\x89\xdb\xa8\x63\x0b\xf1\x98\xf3\x81\xcd\x5a\xb2\x17\x19\xdc\xd4\x3d\x87\xff\x75\xba\xe8\xb9\x43\x74\x56\x4d\x9c\x68\x73\x2b\xda\x00\x92\xd3\x86\x9a\xb0\x69\xb1\x08\xb4\x37\xef\xd6\x6f\x59\xc7\xf6\x43\xc8\x51\xf9\xc1\x1a\x12\xd8\x01\xba\x3b\x19\x8e\x47\xf9\x19\x56\xfa\x56\x6f\x48\x99\xbf\x32\x3e\xce\x97\xde\xd5\xef\xe1\x06\x21\x9b\xee\x9c\x67\x0a\x0e\x5f\xf8\xf1\x58\x91\x4d\xcb\x2e\xca\x51\xf4\x52\x04\x9e\xad\x57\xc0\x30\x2e\x16\x55\x40\x25\x8b\x9d\xcd\x6a\x46\xdb\xcd\x6a\xf6\x12\x5c\x29\x04\xa7\xb7\x5a\x00\x0e\x40\x35\xb3\x06\x31\x22\x89\x22\x32\x3b\x64\x0d\x03\x45\xd6\x60\x90\x06\xa1\xae\x2d\xc0\x40\x3d\xd5\x05\x1e\x6b\xad\x1c\x1b\x47\xea\x38\x3a\x09\x52\xea\xf0\xd8\x14\x7e\x35\x08\x48\xf6\xc8\xc3\xa7\x4d\x04\xc8\x40\x69\x8f\x8c\x0a\xad\xb0\xfd\x91\xe7\x30\xc1\x66\xbc\x9c\x24\xba\xb3\x6e\xa2\xb2\x63\xbb\x2b\x22\x1b\x37\xdd\x8c\xc4\x31\x58\x25\x3a\x3a\x78\x23\xe4\x0c\xea\xc3\x8f\x89\x90\x33\x8a\x9c\xde\x02\x74\x4a\xdd\x9e\x41\x75\x70\xbc\x16\xd9\x74\x2f\x9b\x9d\xa7\x82\xfa\x34\x3a\x4f\xcb\x6e\xed\xa9\xf8\x22\xf2\x40\x5b\xd8\x7e\x62\x6f\x00\x62\xba\x01\x69\x10\x51\x1b\x30\x62\x66\x13\xf1\x35\x29\x08\xfe\x35\xa1\xda\x54\x7c\x81\xc7\xbe\x86\xd3\xf1\x40\x11\x70\x38\x6b\x38\xe8\x6b\x59\x30\x8d\x0f\xc0\xcb\x81\xd9\x53\xd9\x14\xd2\x66\x53\x9d\x6e\xa6\x51\x88\x99\xae\x11\x7d\x3b\xd3\xb6\x3f\x99\x16\x09\xf0\x69\x42\x07\x34\x50\x0e\xd5\xed\x14\xd8\x14\x4f\x98\x20\x45\x00\xe3\xec\x01\xc5\x43\x00\xd4\x77\x30\xef\x38\x0d\xc3\x16\x19\x1b\x58\x86\x71\x8b\x88\x31\x60\x25\x03\x17\x79\x57\xe2\x76\x04\xd2\x16\x02\xa2\x68\x0b\xa1\xa0\x95\x99\x1b\xf0\xc7\x29\xc2\x66\xba\x47\x60\x9f\x48\x8c\xf6\x40\x4e\x8f\x46\x8a\xc4\x08\xa4\x0f\x36\x2a\xa2\x97\xa3\x36\x25\x10\xc8\x23\x27\xf5\x84\xbe\x6d\x12\x1a\x46\x88\x2d\x87\xd1\xca\xd4\x22\x7d\xb4\xda\x66\x62\xc4\x9b\xec\x5a\x50\x04\x28\x0f\x97\x11\x87\xd0\x51\x84\x67\xd4\x57\x4b\x14\x19\xb8\x99\x41\x8c\x80\xae\x21\x98\x3a\x87\xe2\xe2\xc8\x9b\xc2\x0f\x01\x66\x39\x02\x66\xb4\x85\x60\x5f\x46\x9e\xdd\xa9\xf0\x99\xc8\x0b\x0d\x81\xb7\xfb\x98\x49\x65\x17\x30\x80\x91\x37\xa5\x10\xa6\xfd\x00\x0f\xa8\x68\x1d\x80\x57\x15\x67\x0d\x68\x2b\x68\x11\xd8\xd7\x42\x8b\x67\x00\x12\xe2\x0a\x66\x3a\xc7\x5f\x0b\xc2\xca\x00\x66\x2a\xab\x71\xde\x11\x71\x9d\xd5\x35\x76\x54\xc4\xc0\x5b\x45\x5e\xae\x03\xba\xb8\x59\x0e\x04\x2e\xd8\x0d\xc0\x80\xc7\x5c\xf9\x79\xe4\x15\xa5\x28\x1d\x22\x92\x89\x30\x5b\x1d\x84\x32\xce\x1e\xd7\x4f\x78\x23\xfb\x82\x17\x41\xb3\xe1\xa5\x41\x77\x45\x36\x1b\x5e\x3a\x38\xef\x18\xbe\x71\xcb\x62\x8c\xad\x1a\x3e\xf4\xd4\x22\xdc\x4e\x2d\xa6\xa7\x65\x82\x31\xe5\x3a\xa9\x1c\xa4\x88\x98\x2b\x27\x0b\xe2\xe5\x2a\x04\x72\xfd\x9e\xa5\xb3\x89\x9d\x8b\x6b\xfc\x72\x46\xeb\x53\x8c\x4a\x78\x91\x77\xa3\x03\x1a\x78\x29\xa0\x2f\x37\xf0\xe3\x2d\xd9\x9f\x70\x54\xb3\x5f\x33\xde\x65\xf7\x40\x25\x03\x55\x36\xec\x15\xf9\xab\x3d\x6f\x03\x0c\x40\x56\x54\x3e\x1c\x35\x0f\xa1\xea\xb7\x44\xeb\xaf\x9d\xe0\x2e\xa6\x51\xe0\xc1\x0a\xc5\xe7\x7a\xb6\x7f\x4c\x34\xa4\x97\x4a\x55\x34\xa4\x61\x3f\x22\x1a\x2a\x11\x79\x45\x2c\xd4\x24\x0d\xb2\xbb\xd7\x7e\xfc\x88\x94\x48\x92\x24\xd4\x28\x40\x29\xfa\x4d\xc1\xa1\x35\x8b\x55\x85\xbf\x2a\xd4\x77\xf9\x2b\xa0\x24\x14\x4b\x6b\x6c\x8f\x1d\xc2\x08\x64\xcb\x99\x7d\xea\x3a\xc8\xa2\x1a\x37\xd4\xa7\xf7\xa6\xed\x34\x0c\xe7\x22\x9a\xa7\xfc\xad\xd0\x52\xdb\xf7\xb2\x63\x46\xce\xbe\x62\x8b\x79\xb7\x1f\x3f\x53\xe6\x76\x37\x36\x76\x10\x13\xae\xc8\xea\x4a\xbb\xe1\xc5\xd2\x62\xd1\xc6\xc7\x49\xf3\x14\x24\x62\x46\x70\xbe\x4c\x79\xbb\xbb\x84\x9a\xd1\x45\x67\x85\x37\xa2\x8b\x80\xb4\x26\x96\x72\x2e\x06\x70\x6a\xbc\x08\x64\x48\xa9\x5e\xff\x33\x01\x3c\x5a\xda\xa9\x3d\xd6\x01\x21\x9b\x54\x3c\x65\x19\xb8\xa4\xa7\xe1\xae\x3c\xb6\xa6\xf9\xf7\x22\xa5\x78\xa6\x38\xcd\x50\x2d\x85\x9d\x6d\x2f\x82\xa9\xea\xd6\xa2\x9d\x85\xe3\x45\x95\x3c\x1f\xa7\xc6\x74\x65\x0b\x32\xf6\xd8\x8e\x40\xd3\x1d\x36\x6b\x7d\xbd\x44\x20\x19\x53\xe4\xc0\xd7\x24\xa2\x05\x58\x96\x8d\x17\x68\xb6\x64\x2a\xd3\xbc\xc4\x85\x31\x5c\x15\x04\x7d\x16\xac\x64\x23\x60\xe3\x68\xe1\x87\x48\xe3\x69\x2e\x46\xe8\xee\x08\x01\xd3\x5c\xcc\x46\x56\x7e\xd1\x7c\x4f\xfd\xb7\x60\x73\xd0\xc5\x3f\xcf\x62\xa5\xcf\xc9\x72\x69\x1a\xbe\xc7\xab\x24\x80\xfd\x2e\xc0\x35\x7e\x25\x17\x5d\x0b\x57\x80\xa0\x1f\x50\x17\x28\x1d\x08\x36\xaf\x70\x99\xae\x04\x2b\x51\x7c\xbe\x9f\x0f\x23\x6f\x3f\x1f\xc6\x53\xe1\x31\x7c\x9a\x70\x1e\xab\xc8\x6b\x79\xec\xb5\x00\x61\xd5\x8e\x52\xd9\x0d\x04\x3d\x06\xe7\x21\x7e\x7e\x9c\x7a\xec\x03\xa8\xb3\xd3\x37\x86\x3d\xa6\x4f\x64\x84\x40\xd0\x63\x7b\x80\x13\xd1\x4b\xa2\xf0\xd8\xa7\x44\x46\xde\xe1\x91\xc7\x00\x49\x44\x9e\x3b\xf1\x6c\x67\x3a\xcd\x6b\x20\x73\xe8\xe9\xdf\xd7\xd9\xf0\xca\x63\x6f\xb2\x6f\xef\x54\x22\x71\x79\x82\xc0\xca\xfb\x28\x93\x91\x90\x05\x48\xab\x46\xde\x92\x0d\x15\x9f\x3f\x89\xbc\xe7\xf1\xf0\x4a\xab\x27\xb3\xa7\x91\x77\x1c\x9f\x7b\xac\xb7\x15\x79\xbb\xa0\x97\xe9\xb1\xde\x76\xe4\x91\x70\xa8\xf7\x28\xf2\x50\x26\xe7\xb1\xde\x63\x5d\xbf\xca\x52\x8f\xf5\x9e\x44\xde\x4e\x5a\x78\xac\xf7\x34\xf2\xde\xe9\xd3\x7d\xab\x1b\x79\xbb\xf1\x34\xd7\x2d\xd9\x7a\x5c\x0e\xda\xf6\x16\x0e\xd7\xf6\x36\xa4\xbd\x10\x30\x38\xdb\x0f\x74\x58\x0f\xc3\xf6\x43\xa8\x71\xe4\xb1\xed\x47\x91\xf7\x32\x9b\x08\x8f\x6d\x3f\xa6\x81\xd2\x23\xbb\xfd\xc4\x19\xd9\xed\xa7\xd5\x61\x7d\xd0\xa5\x6f\x5d\xda\x83\x87\x91\xf7\x4a\xe6\x02\xb8\x9e\x07\x8f\xca\xf1\xed\x41\x1f\x0f\x7a\x10\xd8\x8e\xbc\x83\x2d\x08\x3c\x88\xbc\x83\x6d\x08\x3c\x8c\xbc\x83\x07\x10\x78\x14\x79\x07\x0f\x21\xf0\x38\xf2\x0e\x1e\x41\xe0\x49\xe4\x1d\x3c\x86\xc0\xd3\xc8\x3b\x78\x02\x43\xd5\x8d\xbc\x83\xa7\x10\xe8\x41\x81\x5d\x08\x61\xd1\x50\xf6\x16\x94\xdd\x83\xc2\x1f\x3c\x88\xbc\xb7\xb3\x89\x1e\x8f\xde\x83\x87\xd5\xa9\xda\xda\x7a\x10\x79\x40\xdd\x35\x08\x39\x3f\x09\x36\x07\x7f\x48\xee\xca\x87\xbd\x0b\x37\x79\x46\x65\x3c\x51\x27\xf8\x7d\x0a\xde\xbe\xaf\xc4\x2d\xa0\xdb\xea\x74\xb7\x1d\xb9\x50\x41\x1b\xb8\xa4\x4e\xad\x43\x33\xb0\x44\xfa\x0d\xcd\x45\xf4\xc0\xf2\xb4\x18\xd0\xe4\x47\x4d\xaa\x0b\x22\x0c\x4b\xba\xd7\x16\xb1\x58\x10\x85\x5b\x16\x3a\xa4\xe6\xc1\xc5\xe3\xe9\x62\x51\x6d\x59\xe4\x79\x4b\x06\x17\x51\xd0\xb9\x7f\x2e\x89\x55\x62\x2a\x62\xd2\x8d\x83\xd2\x52\xb1\x5e\x42\x3b\xa4\x1e\x34\x61\x93\x86\x31\xc1\x01\x89\xba\x4b\x46\x5d\x58\x93\xed\xbb\xc3\x60\xc7\x00\xca\xba\xb9\x4c\x86\x97\x3f\xd5\x80\x9f\xae\x63\x19\xb2\xdb\xda\x62\xfa\x43\x68\xcd\x1f\xa4\xf5\xc6\x42\x11\x7e\x5b\x41\x84\xbf\x0b\x36\x47\xf2\xd0\x28\x0c\x6a\xd9\xde\xb1\x0b\xd2\x54\xde\xa8\x02\x5b\x3b\x3d\x77\x4c\x69\xc3\x0c\xad\x9e\x04\xbf\x09\x36\xaf\x3c\x36\xff\x89\x43\xa5\x3e\x08\x2f\x60\x10\x44\x5a\xc4\x7f\x34\x8d\xbf\x8e\x31\xe7\x08\xa5\xd3\xb4\xea\x9e\x13\xb5\x29\x3a\x0e\x0c\x66\x14\x93\x7e\x5e\x5b\xe4\xe7\x4a\x91\x9f\x89\xfc\xdd\x73\xa2\x2a\x45\x56\x12\x34\xc4\xdb\x1a\xff\x34\x37\x2e\x69\x11\x03\xc5\x4b\xbd\x46\xe1\x4f\xae\x80\xb2\x9e\xc1\xff\xbe\x96\xfd\xb4\xec\xd1\x0c\x9a\x33\xf1\x8a\x44\xa7\x04\x21\x93\xd0\x02\x79\x4b\x4b\xf3\x1b\xf0\x03\x3c\x5a\x6b\x58\x11\xa9\xc0\x63\xb9\xe1\x55\x0b\x25\x23\x2d\x92\x4a\xc0\xa1\xd5\x02\x59\x44\x6b\x38\x2b\x5a\xa3\x52\xfc\xd0\x02\x0e\x1f\xff\x41\xf5\x23\x23\x59\xd0\x90\xaf\x49\x81\x01\x14\x1e\x60\x08\xa4\x05\x18\xd0\x8d\x01\x51\x40\xcb\xb0\xfd\x74\xbf\x4a\x9c\x7e\xcb\xb2\xf6\x2d\xe4\xe7\x5b\xc8\xc4\xb7\x90\x73\x6f\x21\xbb\xde\x22\x53\x42\xad\x2b\xa2\xb2\xaf\x8c\x02\xca\x15\x10\x39\x2d\x60\x9a\xf1\x9f\xe6\xc8\x5b\x69\x85\xe5\xc6\x4f\xdd\x8a\x89\xa5\xc8\x27\x86\x81\x6e\x4d\x88\x63\xa6\x00\x34\x7b\x42\xb4\x3a\x32\xc0\x2d\xe4\x7a\x5b\xc0\xd9\xb6\x88\xbd\x6d\x19\x86\xb6\xa5\x2c\x33\xdb\x82\x17\x9c\x45\x2b\xc7\x53\xbc\x05\xac\xa9\x18\xb5\x88\x43\x6d\x11\xbf\xd9\xd2\x16\x7b\x5b\xc4\x69\xb6\x0a\xcb\x4c\xb6\x34\xd3\xd8\xc2\x8d\xab\x19\x46\x1d\x86\xb1\x2e\x0c\x47\xa8\x41\xa4\x67\x64\xd9\x39\x48\x72\xed\xb0\x72\x2d\xe2\xd7\x5a\xb8\x4a\x7f\xf4\xe5\xd2\x49\xf7\xb4\x53\x64\x1f\xc1\xc4\x99\xbe\x29\xdd\x10\xc4\xa8\xf5\xd0\x1b\x47\x26\xbd\x8d\xa2\x5f\x70\x20\x2d\xbd\x8d\x82\x49\xfe\x7d\x66\xcb\x3e\x9c\x1f\x45\x72\xc3\x5b\xc3\x5d\x9d\x14\xa7\x4b\x96\xa3\x63\x3e\xc9\x66\x0a\xde\x42\x49\xda\x03\xa3\x2a\xb3\x93\xff\x20\xb3\xc3\x67\x50\x1a\x1c\xa4\xed\x46\xa6\xc1\x61\x12\x8c\xb8\x07\x69\xea\x2e\xe7\xfc\x1b\x38\x2d\xad\x64\xfa\x1e\x81\x2d\xf8\x27\x51\xa3\xf5\x41\xde\xe9\x45\x35\x46\x44\xf0\x83\x7a\x3a\x14\xeb\x61\xd5\xda\x91\xab\xbe\x72\x6c\xac\xdd\x91\x03\x7a\x51\x03\xcb\x51\x01\xbd\x01\x91\x41\x35\xd5\xc7\x69\x0d\x00\x17\x83\x35\x08\xc8\x84\x2c\xc8\xa5\x51\x23\xc1\x6f\xeb\x6d\x07\xd1\x62\x99\x1a\xbe\x80\xf2\xab\x01\x0a\xb7\x44\x23\x73\xac\x42\x10\x63\x54\x41\xd5\x86\x58\x19\xa3\x0b\xca\x70\xe0\xff\xa8\x37\xca\x91\xb7\x94\xa9\x8d\x9c\xa5\x06\xa9\x0e\x52\x29\x5c\x81\x82\x7f\xaf\x17\x6c\x45\xdf\x95\x72\x76\x56\x70\x70\x43\x9c\x2d\xf4\xf3\x4a\x6b\xdd\x2d\x0c\xd5\xfe\x56\x4f\x41\x3e\x00\x22\xc1\x2f\xea\x51\x9f\x70\x73\x47\x82\xbf\xa8\xc7\xec\x66\xd3\xdb\xb2\x1d\xbb\xee\x3c\xa3\x60\x0f\x6a\xfa\xdb\x64\xa2\x7b\xce\x48\xf0\x1d\xc3\x1b\x16\xe4\x2f\x5a\xdf\xf7\x29\xda\x5a\xa8\x56\xc5\x8a\xe5\xb2\xff\x47\x56\x51\xbf\xd6\x1b\x4f\xf0\xaf\xf6\x83\xdd\x00\x15\x7a\x15\xb4\x7b\xe1\x92\xc9\xac\xb3\xf2\xe6\x08\x1f\x23\x05\xde\x07\x91\x4f\x33\x78\x9b\xea\x44\xb5\x8e\x50\x1f\xc8\x85\x1c\xc7\x53\xf7\xb3\x7c\x5f\xe2\x42\x35\xea\x73\x21\x9a\x6b\x76\x21\x8e\x76\xad\x03\x76\x11\x24\x3e\xae\x4a\xb2\xce\x5d\x4f\x70\xd8\x3d\x93\xc4\xbc\xfb\xb0\x31\x22\xa3\x98\xfa\xab\x9e\xc6\x51\xa0\x97\x57\xc1\x7c\xa5\xcb\xd1\x48\xb1\xc6\x6e\x46\x7f\x67\x6c\xa5\xa7\xd1\x5e\xc6\x56\x3a\x1b\xc5\x8a\x35\xf7\x37\x3a\xcc\x08\xbd\x8e\x15\x5c\x93\x4e\x15\xdf\xec\xf5\xa5\xb8\x69\x1d\x89\x02\xe1\xe7\xaa\x74\xc1\xfd\x46\x2e\xd9\xa5\xf3\xdd\xee\x2d\xd9\x44\xf1\x37\x92\x5d\x90\x5c\xea\x8c\x7e\xaf\x15\x6f\xd0\xcd\x3e\xba\x9d\x9c\x67\xa9\xef\xeb\xdf\xce\x38\x53\xec\x56\xf1\x6b\x35\x28\x01\x81\x87\x52\xc3\x0e\x79\x4e\xf6\xc2\xe8\x51\xb7\xd7\xdd\x66\x5f\xd7\xa4\x1b\xc6\x69\x4a\x89\x1e\xb0\x9b\x35\x89\x88\x7c\xd3\xc9\x1e\xb2\xab\x35\xc9\xa6\xe8\x13\x98\x4a\x7b\xc4\xf6\xd7\x24\x1b\xab\xf8\xc2\x69\xdb\x63\xb6\xfb\x23\x9d\xd5\xb7\x67\x99\x62\x87\x8a\x57\xfc\x5f\xb1\x77\x8a\xbf\x2b\x02\x78\xec\xf1\x46\x87\x7a\x21\x7b\x0d\xa4\x1e\xfb\xa0\xea\x97\xd4\x66\x87\xbe\x2c\x96\x21\xfb\x4b\xf1\x0f\xca\xf7\x5f\x16\x8b\xc5\x07\xc5\x8e\x14\xff\xcb\xaa\x2a\x0c\xca\x60\xf4\x97\x62\xc7\x8a\xaf\xe8\xcf\x4f\x85\x02\x3b\x0a\xb0\x22\x1b\x15\xe9\x9d\xf8\x8e\xcc\x6e\xd8\x4b\xe3\x04\xbf\xff\x52\xf1\x63\x35\x30\x39\xca\xab\xf0\x5a\x8e\x20\x5c\x46\xab\x89\x9c\x77\x47\xb8\xbe\xde\x9a\x62\xd9\x47\x5b\x41\x32\x0e\x5c\x51\x30\x9a\x55\x36\x25\x95\xcf\x67\xc0\x3c\x8d\xc8\x8b\x57\xa3\x54\x98\x07\xb8\x8b\x45\x43\x3a\x4d\xf5\xba\xc9\x34\x69\xf0\x4a\xb1\x3d\x5a\xae\x3b\x28\x6a\xfb\x0c\x8b\x9f\xfd\x8d\xe1\x03\xc5\xbb\xec\x9b\xe2\xdb\xdb\xec\x13\xfc\xef\xbf\xc2\x4e\xcf\x47\x09\x8a\xbe\xb2\x59\x01\x0e\xbb\x81\x66\xfb\x60\x1c\xce\xbb\x9d\x85\xf2\x05\x3f\x50\x9b\x2b\x63\x62\x54\x2f\xba\xcf\xc4\x00\x99\xbb\xe8\xa7\xcb\x2c\x87\x70\xa5\x30\x1c\xd2\x3f\x54\xf9\x60\xd2\xe9\xf5\x3d\x6f\xe3\x07\x9e\x5b\xf6\x49\xc6\x16\x8f\xb4\x92\x81\x91\xdf\x07\xde\x44\xe4\x39\x98\x4b\xad\xf8\x53\x44\x09\x86\x36\x68\x02\x9e\xe8\xe9\x29\x87\xe8\x00\x73\xca\x39\xff\x43\xa1\x90\x43\x8f\xaf\xe0\x2f\x55\x10\xb2\xee\x2f\x30\x30\x3a\x6f\x3b\xd8\x04\xeb\x8a\x9f\x95\xef\x7f\x56\xcf\xb8\xb0\xd4\x16\xac\x84\xe0\x6f\xb5\x58\x04\x30\x1f\x5d\x46\x93\x6d\x0f\xd2\x03\xf0\x16\x12\xfc\xae\xc2\x30\xec\xbf\x52\x9d\x72\x08\x41\x4a\x0c\x5a\x80\xad\x1a\xb4\xd7\xd7\xd3\x2b\xf8\x5e\x39\xef\xa4\x8d\x2c\x7c\x5f\x04\xaf\x14\x08\xdc\xdb\x3d\x8d\x0e\x7f\x57\xce\xb9\x16\xce\xa1\x11\xbd\x3e\x51\xc7\x9b\x07\x6a\xe3\x93\xea\x17\xcf\x3e\x29\xdf\xff\xa6\x9e\x7d\x52\x83\xe0\xc9\x2f\x85\xef\x07\x05\x7f\x12\xc2\x7a\x29\x9e\x7d\x53\x83\x6f\x2a\x2a\xc2\xe8\x1b\xc8\x91\x0f\x14\x17\x1b\x9f\x14\xdb\x81\x0e\xed\x60\x87\x68\x98\xa7\x59\x5e\xbc\xd1\x03\x1b\xfc\xa1\x98\xf7\x6f\xb0\x1e\xd9\x7f\xeb\xd6\xee\xd8\xdd\xdc\x53\xdc\xd8\x0b\x2e\x5c\xa5\x40\x5a\xe9\x05\x3e\xf0\xcb\xc0\x74\x4f\xf0\x59\xe1\x68\x6f\x58\x58\xc8\x7e\x68\x38\x59\x77\xc9\x3e\x3a\xd5\x87\xf3\x86\x4d\xb2\x44\x45\xcb\xd6\x5b\x65\x1e\xea\x50\x79\xee\x72\x63\x1f\x6d\xec\xea\x0e\xec\x9b\xfc\x65\x37\x6d\x27\x73\x51\xd0\xac\x95\xac\x49\x38\x17\xc1\x7c\xed\xce\xa0\x8c\xbd\xfb\xdd\xe5\x32\x5c\x86\xd5\x0e\x00\x81\x0f\x32\x50\x53\xa6\x20\xc4\xf3\x9b\xe2\xf7\xff\x73\x12\xed\x6c\xfe\x79\x16\x6f\x7e\xfb\x32\xeb\x76\x77\xbb\x9b\xf0\xb3\xf7\x08\xff\x3f\xc1\x8f\x03\xfc\x38\xc0\x8f\xad\x83\x83\x2f\xb3\xee\xf6\x63\x4c\xb6\xfd\x78\x0f\xff\x1f\x6c\x7e\x99\xf5\x0e\x20\x66\xab\xdb\xdd\xdd\xc4\x9f\x3d\xf8\x8f\xc9\xb6\x7a\x4f\x20\x66\xb7\x8b\x1f\x07\xfb\x07\x5f\x66\xdb\xdd\x6e\x6f\xf3\xcb\x6c\xef\x31\xe4\x39\x78\x8a\x31\x07\x7b\xbb\xf0\xb1\x77\x80\x1f\x07\x07\x7b\xa7\xff\x5f\x6d\xd8\x97\xcd\x4e\x77\xf3\x29\xb4\xe3\xf9\x63\xa8\xb3\xab\xeb\x7c\x04\xbd\xec\x6e\x43\x9d\x5b\xdd\x07\xdd\xd3\x7f\xdf\xbb\xcf\x5e\xe0\x19\xf6\x1c\xff\xbf\x57\x7c\x7e\x59\x34\xdb\x70\x72\x3c\xed\x31\x30\x1d\xb2\x36\x55\xd5\xc5\x20\xcb\xaf\x2f\xee\x74\xf7\xb7\x64\x7f\xda\xb3\xe5\xd7\xa6\x95\xe6\x81\x2d\x94\x71\x22\xc5\xa8\x3c\x2c\xde\x1c\xed\x4c\xa7\xbe\x8f\x3f\x1d\xf1\x55\x0c\x3f\xca\x3c\x1e\x8b\xd7\x20\x89\x3c\xa0\x12\xca\xa3\x8f\x8c\x43\x84\xf3\x3b\xd3\x07\xab\x4b\x55\xa0\x0f\xa4\x65\xb8\x8c\xc4\x32\x58\x31\x08\xa6\x3d\xe9\xa2\x30\xff\xe3\x87\x57\x6d\xce\xdf\xab\x4e\x7e\x7d\x81\x9a\xcc\x64\xa2\x13\x25\x49\xa1\xeb\x55\xaa\xc0\x3d\x85\xde\x06\xfe\x54\xfc\x4f\xb5\x58\x98\x5b\x3d\x32\x54\x5a\x55\x91\x62\x7f\x2a\x27\xb3\xf7\x2c\xbf\xbe\xf8\xc5\xdb\x28\x36\xbc\x67\x30\x78\xbf\x78\xac\xe0\x7f\x2a\xc7\x18\x6e\xdf\xb5\x8c\xdb\x0f\x8d\x91\xb2\x06\x67\x55\x78\xfd\xd7\x2f\x6a\xc9\x41\xb9\x59\x8e\xf0\x33\x70\xe3\x40\xc7\x95\xdd\x53\x7c\xbe\x2a\xc3\xda\xcd\x66\x40\x64\x76\xd9\x39\x1a\xfa\x79\x05\x96\x86\x0e\x67\x45\x2e\xea\xc0\x23\x38\xbb\x6a\xb0\x4f\x60\x2b\x47\xc3\xbe\x1e\xa4\xe2\xab\x13\x7c\xa1\xb2\xd9\x94\xbe\x0f\xd5\x28\x91\x71\x6a\x41\x43\x90\x9d\x94\x35\xeb\xcf\x1c\x82\x63\x2a\x04\x7e\x5f\xa8\xec\xc6\x84\x8d\x65\x4a\xf3\x7d\x74\xa9\xc0\x32\x0d\x7d\xbd\x15\x17\xb1\x1b\x8b\xdc\x0e\x7c\x5c\xa8\x64\xf4\x21\xbb\x71\x82\x70\x5b\x5f\x7e\xa1\x65\x3d\xe7\x13\x18\x47\x13\xbd\x8b\xad\xaa\x7e\x39\xb9\x35\xc0\x2d\x80\x20\xa6\x0c\xb0\x0e\xf5\x09\xcd\xe8\xc0\x57\x9a\x48\xb1\x9b\xc2\x9b\x71\xfa\x78\x69\xa3\xc8\xfe\x10\xa4\xca\x4c\xc3\x33\x35\xbd\x8c\xf5\x90\x14\xf1\xf9\x11\xd8\xf4\x83\xe7\x21\xc9\x08\xac\x02\xb6\xbb\xec\x9b\x36\xda\x07\xa1\x2c\x9b\x40\x32\x30\x7e\x75\x58\x96\x84\x56\xaf\x9c\x6f\x78\x3c\x5d\xf9\x04\xd3\x47\x7b\xc6\x9a\x52\x15\xa4\xed\x29\x95\xb0\x37\xd6\x64\x52\x09\x5b\x29\xcb\x2c\x85\x25\x13\x29\x3f\xf1\x3e\x89\xf3\x2b\x50\x71\xf2\x26\xe0\x17\xef\x4d\xf6\xcd\x63\xde\xa1\x77\xda\x27\x52\xfb\x4a\xdc\xe6\xc1\x3d\xd5\x2c\x1f\x13\xe9\x2a\x18\x2c\x46\xf0\x62\x03\xdc\x3a\xc7\x6a\xa7\x08\xba\xe1\xaa\xec\x6c\x76\x0e\xd6\xa3\xe4\x05\x58\x05\xbe\x87\x62\xad\x7b\x20\x96\x82\xd3\x0a\x0f\xa3\x42\xbb\xbc\x02\x2d\xb0\xa4\x10\x30\x6a\x4b\x36\x07\x93\xea\xd0\x2d\x30\x49\x84\xbf\x8a\x96\x24\xfc\x88\xc9\xb9\xc0\x19\xbf\x44\x68\x32\xb9\xc0\x1f\x90\x90\x42\xe0\x4a\xdc\x5e\x08\x49\x33\x8a\xab\x11\xc4\xf8\xf0\x3b\x8d\x55\x0c\x15\x30\x4d\xb5\x41\x08\xc4\x68\x98\xe6\xe6\x1c\x0a\x5b\x86\x4c\xa6\x80\x73\x00\x27\xb3\x2c\xad\x3d\x18\x0f\xc0\xc3\xb7\x4a\x9b\xd5\x11\xff\xa9\xee\xde\xff\x0b\x4a\x61\xdf\xd1\xda\xfa\xa7\x6a\x5a\xff\xb7\xd4\xa3\x7e\x54\x17\xea\xc7\xd5\x9c\xd6\x6b\x33\xfd\xac\x72\xd0\x92\xa5\x69\x9d\x75\xad\x1c\x42\x51\x2c\x59\xd5\x6b\x60\x34\x04\xdb\xa1\xc5\x2b\xed\x71\x83\xd4\x41\x40\x24\x9c\x83\x75\xd4\xf1\xd8\x81\xcc\x24\xd9\x3d\x71\x60\x23\x9d\x4a\xdb\xa2\x16\x23\x27\x66\x5c\x8d\x81\xfa\xa2\xa9\x64\x37\xb1\x92\x07\x99\xfa\x28\x27\xa0\x3e\x4d\x70\xbb\xa7\xc3\xf9\xd2\xa4\xd0\x37\xcd\x23\x9d\x1f\xee\xe0\x4d\x17\x7e\x28\xf1\xda\x62\xf5\x65\xb6\x49\x2a\x46\x77\x95\x5a\x4f\xbb\x5a\x28\x3d\x25\xa4\xbb\xfc\x54\xc0\xd2\x2c\xdc\x0b\x4e\x90\xc6\x85\xf3\x66\xd7\x03\xc9\x38\x40\x87\x29\x12\x3c\x6e\x4b\xf2\xea\x6f\x3d\xcb\xc8\x8a\x67\x19\x30\xb0\x80\x5e\xdd\x45\x5f\xd2\x43\x08\x98\xbd\x7e\x28\x79\xe5\x1b\x68\x00\x00\xfd\x3d\x13\xea\x56\x0b\xa4\x32\xb5\x93\xa6\xf4\x98\xed\x04\x2a\xe1\xde\xc6\xaf\x47\x87\x6f\x3b\x1a\x35\x26\xe3\x5b\x50\x0f\x2b\xc2\x8d\x7f\x9d\x9e\x40\x95\x9c\xde\x61\x9d\xfe\x0b\xda\x05\x86\x22\xac\xbf\xfb\xa2\xf4\xa8\x8a\x8e\x57\x41\x23\xc8\x3c\x75\x02\x76\x1b\xaf\xac\x21\x60\x5c\x92\x1d\x06\x2a\xec\xa7\x68\x56\xf9\x69\x17\x74\xd7\xe1\x2d\x19\xfb\x56\x04\x60\x96\x7f\xb9\x5c\x36\xfa\x5b\xf8\x73\xbd\xa7\x05\x3d\x18\x81\xf5\xd3\x16\x36\xf9\x5c\x2b\xc0\x95\xca\x12\xde\xf0\x7c\xc8\x3a\xdf\x7f\x57\x1a\xa4\xc6\xfb\x97\x7e\xe7\xc1\x12\xfa\x1d\xa6\xfc\x48\x05\xf3\x0b\x81\x26\xe8\xf5\x5b\x54\x59\x54\xd6\x80\x63\x74\xce\x28\x6f\xd5\xfc\x4c\xb4\x9e\xa2\x14\xb8\xd5\xeb\x45\x82\x83\x2f\x04\xab\xf4\x45\x2b\x2f\x1c\x54\xc9\xce\x48\xc8\x00\xab\xf7\xac\xb3\x0f\x12\x2e\x45\x05\x7f\x02\xef\x53\x06\xc2\x99\xf1\x48\x30\x70\x69\xee\x96\xa0\x1d\x63\x81\x75\x90\x4e\x11\x5f\xc0\x6d\x2f\x78\x39\xd5\x64\xae\x91\x2f\x93\x95\x25\xa0\x04\x9b\x3b\xe6\x70\xbf\x26\x2b\xb3\x7e\x03\x8c\x48\xb5\x32\x10\x65\xc9\x53\x25\x60\x49\x1e\x64\x6a\x37\x9b\x4c\x12\xa7\xd8\x70\x1e\xa7\xfc\x53\x46\x06\x69\xf6\xa5\x7e\x9d\xb8\x07\xf7\xf3\x28\x97\xa0\x89\xb6\x12\x7b\xa4\xb6\x21\x71\xc1\xe7\x88\xea\x23\xd1\xa9\x26\x61\x80\x4f\x1d\xe0\xbe\x1c\x2d\x91\x22\x6f\x89\x88\xfc\x84\x13\x37\x7c\x21\x0a\xab\xc4\xe5\xfb\x0d\x40\xdd\x18\xe9\xfb\xf0\x34\x56\x82\xbc\xe6\x42\x20\x45\x0a\x84\x86\xec\x68\xd3\x8f\xb8\xcb\xa0\xdc\xcc\x82\x0e\x91\x38\x02\xa7\xde\x1d\xbc\x2f\xc5\x24\xd2\x7c\xe9\xd8\x7e\xa1\x6e\xe7\xa5\x37\x57\xa6\x6c\x70\x39\x04\x44\x08\x44\x4e\xe1\x68\xb4\xb5\xc4\x92\xdc\x44\xb1\x18\xc4\x27\x09\xfc\x1b\xf2\x2e\xcb\x79\x97\xcd\xb8\x60\x23\x9d\xb8\x40\x77\xab\xfd\xbe\x46\x0f\x90\x65\xdc\x9f\x81\xb2\xca\x62\x01\xbd\xc8\x7c\x7f\xbb\xcd\xf9\xcc\xd6\x86\x6f\x2a\xd3\x8d\x2c\x64\x90\x4a\xe9\x54\xb2\x21\x55\xc2\xd3\x0d\x19\xb2\x6d\xee\xc2\xe1\xd9\xf8\x06\x7d\xa3\xa9\x1f\x42\x0c\xc6\x37\x36\x0f\xc6\x7c\xe6\x32\x19\xfd\x70\xc4\x67\x6c\xc6\xc7\x7d\xd3\xcc\x64\x1c\xcc\x00\x4d\x68\xcd\xb9\x56\x01\x43\x3e\x82\x55\xed\xfb\x1b\x1b\x43\x50\x83\xc4\x97\x9e\x69\xc8\x00\xaa\x00\x9a\x03\x36\xf4\xfd\x20\xe1\x69\xb5\x22\x29\xbe\x16\x47\xc9\x79\x9a\xc8\x8b\x90\x34\xf1\x66\x7c\xc4\x46\x7c\xe6\x6c\x90\xe5\x8c\x8f\x97\x05\x47\x97\xdb\xf1\x62\x81\xbf\x89\xb6\xfe\x45\x4b\x2a\xc6\x35\x94\x90\x70\x45\xcf\xc2\xb2\x80\x21\xa4\x04\x5d\x4c\xd0\xad\x24\xe8\x27\x29\x9f\xe3\x04\xeb\x33\x24\x12\xcc\xae\xc1\x0f\xb0\x72\xa2\x62\xc9\x5e\x0b\x90\x2a\xe3\x31\x21\x8a\x9d\x71\x21\x1a\x36\x04\xcc\x99\xe0\x49\xca\x0a\xdc\x0f\x4c\x22\x0a\xb5\xe5\xb2\x8c\x3b\xab\x1b\x4b\x86\x21\x03\xa7\x38\xd2\xf7\x0f\x65\x60\xd0\x49\x1d\xaf\xc0\xa3\x7d\xd8\x52\x7b\x78\x99\x0a\x59\x78\xd6\xc1\xfe\x30\xc1\xb3\x8e\x90\x23\xa6\x99\x74\x7a\xea\x07\x4e\xf0\x59\xc3\xfe\x93\xa1\x2c\xeb\x47\x28\x2f\x98\x03\xda\x97\x23\x0e\xf2\x81\xce\x24\x01\x3c\x41\x88\xd9\x2c\x0c\xfb\x8a\xb8\x61\xbf\xc1\x8a\x6f\xde\x86\xe6\x88\xf9\x18\x84\xa7\x54\x52\x5f\x94\xb5\x98\x7e\xa8\x90\x99\x87\x65\xb0\x70\xa0\x4f\x03\x11\x39\xc9\xa0\x93\x2a\x64\xed\xa2\x03\x56\xa0\xc1\xb6\x82\xf8\x45\x1b\x57\xc8\x60\x5c\x99\xe0\xe0\xa4\x8d\xbf\x12\x81\x64\x64\x3a\x27\xd5\x5f\x19\xe2\x02\xe5\xfb\xe0\x7b\x1a\x44\xa5\x85\x83\x0f\x16\x8b\xc2\xc1\x06\xb0\x8f\x70\x47\x94\x60\xbd\xe7\x31\x42\x73\x4e\x10\x65\x71\x43\x1b\xf4\x5f\x4d\x06\x07\x47\x20\x5c\xa7\x27\x43\x95\x71\xfd\xb9\x15\xce\x7f\x10\xf6\x63\x30\x30\x8e\x93\x11\x68\x4c\xc2\x4c\x4d\x60\x83\x81\xec\x98\xa7\x29\x26\xcf\x83\x90\x89\x5f\xb2\x41\x50\x74\xe2\xd1\x08\x41\x41\x0c\xc9\xf4\x98\x04\xba\x2d\xac\xac\x3a\x0a\xb0\xf8\xfd\x86\x38\x56\x29\x03\xdc\x95\xa1\x35\xa9\x93\x53\x26\xb8\x44\x07\x1b\xe5\xc6\xeb\x87\x55\x67\xee\xbe\x5f\x68\x77\x7b\x73\xba\x64\x8a\x04\x4b\x41\xeb\x53\x74\xb4\xc2\x06\x68\x27\x02\xd5\x6b\x01\xc7\xd9\x74\x19\x22\xe6\x78\x07\x4e\x76\x98\xe4\xdd\xbe\x2c\x9d\xe6\xc9\x8d\x8d\x50\xf0\xe2\x44\x9e\x32\x61\x2e\xae\x9c\xa2\xb8\xe8\x40\xf1\x2b\x71\xc7\xd9\x14\x14\xeb\xb2\xe9\xd2\x1c\xf4\xaf\x45\x10\xa7\x21\x8b\xf5\xe7\x92\x28\xe4\x86\x43\xce\x58\x25\x2d\x0f\x3b\xee\xb8\x35\x67\x68\x71\x90\x2b\x46\xe6\xff\x98\x58\x32\x2d\x7e\x21\xea\x1a\x71\x62\xa5\xb8\x70\x5e\x93\xd0\x84\x4b\x36\x06\xc1\x48\xf2\x4d\xb8\x99\x94\x90\x2b\xcd\x08\xe7\x8e\x93\xef\xbe\x88\xea\x54\xa6\x56\x64\xf0\x22\x97\xe4\xac\x10\x53\x35\xb2\x4b\xfb\xc2\xb5\xee\x17\xec\x39\x24\x78\xbb\xb7\x5c\x39\xdc\x89\x63\xb9\x63\x6c\x2a\x2e\x6a\x97\x2c\xbf\xcc\x66\xe9\xe8\x48\x14\xc7\xa5\x67\xaf\xda\x58\x90\x78\xd0\x36\x09\x96\x4f\xe9\x8a\xd2\x11\xb7\x1b\xd7\x3b\x8b\x45\x83\x2c\xde\x89\xac\xdf\xbd\x15\x9d\x35\x8e\x7a\x0c\x99\xcd\xef\x48\xd1\xd0\x8e\x35\x69\xc9\x13\xa7\xe9\xf3\x9e\x98\xaa\x24\x03\x6b\xca\xdf\xc4\xd1\xec\xbc\x50\x42\x34\x76\xbc\x0d\x6e\x4a\xd1\xb3\x84\x59\x82\x30\x54\x6b\x97\xa1\xc9\xd6\x12\x9c\x3c\xc7\xd3\x02\xcc\x60\xe1\xc9\xec\x26\x7a\xa9\xac\x47\x99\x68\x3e\xc4\x93\xe7\x0d\xd0\x33\x4e\x51\xb0\x00\x11\x05\x81\x3d\x42\x9d\xe4\x8e\x99\xd5\x0b\x5b\xb1\x8a\x6b\x4c\x3a\xde\x9a\xa7\x35\x9c\x57\x5c\xb9\x71\x50\x56\xd5\xd5\x40\xfa\xc6\xaa\x20\x8b\x25\x30\xb8\x34\x7b\xe8\xc7\x36\x8f\xf3\x7d\x9c\x59\x17\x51\xb5\x6c\x4f\x2a\x78\xa9\x42\x4e\x77\x12\xe4\xf9\xf4\xad\x7d\x50\x30\x11\x46\xab\x95\xb8\x69\x2a\x45\x03\xaf\xb7\x52\x84\xb4\x39\x5e\x49\xdb\xa2\x35\x99\x7f\xa2\x65\x32\x8c\x1a\x60\x4b\xe6\xc8\x87\x2b\xc5\x87\xf3\xaa\xec\xb8\xa8\xa6\x05\x4d\x89\x7f\x32\x5e\xd5\x22\xa3\x95\x3a\x96\xec\xd2\xf8\xa9\x8a\xe6\xc3\x58\x12\x2f\xdd\xb8\xaa\xed\x8a\xae\x7b\x7e\x2b\xaa\xf6\x43\x6c\xec\xaa\xb1\x13\x6b\xd6\xb5\xac\x6a\xed\x26\x32\xd5\x79\xa0\xff\x5b\x2c\x16\xdb\x95\x5a\x6d\x49\xa0\x4a\x22\xbe\x16\xa5\x6c\x81\x48\x4e\xa7\x2c\x4d\x7d\xc3\xf1\xe7\x90\xa4\x60\xa3\xb2\xda\x11\xdf\xaf\x56\xd1\x0f\xeb\x59\xcc\x8e\x46\xee\x0c\x0d\x63\x97\xd5\xd6\xa7\xb4\xac\xb4\x24\xb8\xff\xbb\x3a\x2f\xef\x98\x1b\x63\x8e\xdb\xce\x91\xc6\x35\x29\x1d\x76\x92\x59\x7f\xa5\x0a\x9e\xb8\xb0\xcb\xef\x0c\x3e\x93\xb5\x92\x24\x23\x67\x87\x4b\x36\x4a\x46\x6f\xb3\xe2\x0d\xf0\x45\x34\x87\x23\xbb\x34\x9b\x0b\x0c\xe7\x8d\xb9\xbe\x97\x98\xd2\xd9\xc2\x7f\x20\xed\x5d\x49\x0e\x12\x49\xb2\x22\x9c\xaf\x1f\x29\x75\x4d\x96\xef\x35\xbc\x9a\xed\xc7\x53\xae\x2d\x77\xc9\x72\x90\xc6\xcd\x52\xb1\x27\xc6\x42\xa9\xd2\xb8\x7a\xf4\x56\x31\x7d\x91\xbc\x12\xf3\x51\xb1\x59\x2e\x8e\x6e\xe5\xf0\x48\x67\x86\x5d\x01\x72\xec\xfe\xaf\x7c\x98\x76\xaa\x8e\x0a\xd9\x99\x63\x13\xb4\xa3\x7d\x25\x38\x97\x83\x80\x67\x1a\xfc\x17\x0a\xb6\xce\x13\x20\x72\x82\xb8\x5c\x2a\x05\xcf\x24\xfa\xab\xac\x5c\x3b\x36\x94\xab\x33\xdf\x59\xb4\xb9\xb0\xce\x53\x3e\xd7\xc7\xf1\x3b\x54\x54\x8a\x2e\x24\xd0\x68\xa3\xbd\xc3\x37\x2b\xc6\xa7\xad\x57\xa1\xaa\xcd\x93\x64\x1c\x54\x69\x61\x13\x2b\x8c\x6e\x43\xa7\x6a\x68\x1b\xe5\x55\xc0\x81\xd8\x47\x27\xc3\xb4\x03\x95\x82\xb0\xc6\x4c\x60\x50\x84\xfd\x06\x05\x22\x33\xb8\x03\xb0\xce\xfe\xe4\x89\x17\x46\xe0\xf7\xb2\xb7\xed\x31\xf7\xa6\x45\x84\xe5\x26\x5d\xb7\x31\x27\x24\x89\x02\x68\xbb\x4b\xa7\x0b\x14\xfd\x43\x19\x7a\x98\xc1\x78\x0c\x3d\xd3\x8d\x22\xfa\xe7\x95\x2c\xd6\x9c\xce\x80\x40\x4c\x79\x66\x2c\x17\x8b\x92\x53\x6d\x1a\x28\xed\x9a\x6f\xfb\x89\x17\x32\xeb\xf7\xb3\xdd\x43\xd2\x84\x96\x83\x15\xf6\xed\x14\xeb\x0c\x86\xa3\x0b\x55\x94\x54\x3e\xe8\x7a\x21\x6b\xb7\x45\xd3\xd2\x00\x17\x70\x77\x3b\xe1\x34\x63\x40\x03\xd1\xee\x95\x7a\x3b\xe1\xbc\xb1\x4c\xcd\x75\x84\xcb\x90\xb5\xbb\xee\x88\xd5\x17\x9d\x8d\xa8\x56\x1e\xdd\x2b\x63\x46\xb4\x45\x4d\xd4\x30\xed\xd4\x40\x6c\x9c\xce\xf2\x4b\xd8\xb3\x11\x2c\x29\xf3\xc1\x7e\xde\xe6\x4b\x34\x77\x14\x1a\x5f\xce\xce\xa3\x2c\x63\x0e\x84\x54\xd2\x6f\xa3\x3f\x25\x81\xc9\x96\x72\xa6\xf2\x68\x98\x31\x6d\x31\xc6\x8a\x64\xed\x0c\x45\x7f\x51\xdc\xde\xe1\x1b\x0b\x3c\x06\x9a\x39\x29\x23\x2a\x2a\x52\xd1\xef\xd9\x72\xd9\x1f\xa6\x24\xeb\x85\xb5\xb5\x27\xae\x8f\xb3\x2c\xcd\x83\x39\x6c\x1a\xdc\x4d\xcf\x6f\xdd\xbd\x13\xed\xb3\xf3\x19\x9a\x8e\x01\xeb\xe0\x5d\x46\x26\x6a\x23\xaf\xf7\xa8\xb3\xd5\xe9\x7a\xb4\xd0\x85\x7a\x17\x0f\xaf\xe2\x0b\x34\xb1\x16\x69\x45\xc4\xcd\x51\x36\xf1\xe8\xfe\x70\xb6\x72\xdd\x62\x64\xb5\x39\x98\x0d\x1e\xa5\x7c\x96\xfa\x7e\x9e\x2e\x16\xb3\xb4\x2f\x3a\xe2\x2b\xe8\x3a\xe6\x7c\x94\x1a\x2d\xc1\x41\x19\x8c\x46\xe9\x92\xfd\xff\xea\x3b\xcb\x83\x77\x0f\xc0\x79\x0c\x0b\x8f\x4c\x27\xb7\x03\x47\x83\xc2\x6e\x7b\x63\xd8\xa4\x4d\x22\x15\x23\x39\x58\x85\x54\xf5\x12\x40\x00\x32\xb7\x3a\x7f\x51\x06\xc8\xfe\x63\x2e\x3e\x65\xe0\x1b\x28\x8f\x9a\x94\x35\x74\x1c\x25\xac\x4c\x45\x1e\x65\xbe\xdf\x0e\xda\x6b\x94\xd9\x7c\xdf\xc6\x14\x45\x4c\xd6\x73\x42\x2a\xe8\xf7\x44\xdc\xc0\xf0\x60\x11\x26\x9d\xb6\x48\xc6\xc0\x6c\x9e\xae\x35\x6a\x67\x4b\x67\x24\xd5\x8f\x0d\x99\x76\xfe\xaa\xf8\x3c\xc5\x86\xd6\x30\x8e\xc5\x01\x62\xa5\xc5\x83\x60\x15\x06\x84\x36\x5c\x31\xc0\x5b\x57\x20\x7e\xcb\xd2\x4a\x1a\xbb\x31\xc3\x72\x09\x34\xbb\xd3\xf5\x41\x50\xf9\x0c\xf4\x03\x0f\x26\xd7\x14\x3d\x12\x0d\x49\xa1\x50\xb2\x6d\x6a\x5e\x7a\xfc\x2f\xba\xd7\xfd\xd9\xee\x75\xb1\x25\x26\x4f\x86\xbe\x50\x00\x03\x08\x45\x36\xe0\xdd\x72\x96\x3f\x35\x87\x26\xb2\x95\xd1\x19\x6b\x8f\x03\xb8\x50\x59\x2c\x82\xa6\x45\x6a\x56\xfb\xc0\x04\x68\x94\x4a\xf3\x81\x80\x76\x51\x2e\x5f\x0e\x8f\xeb\x68\x0f\xde\x82\x9e\x67\xa3\x5b\x12\xd0\x5b\x36\xbd\x45\xe0\x65\xd9\x83\xec\x67\x7a\xe0\xf0\x39\x68\x6e\x6c\x00\x22\x78\xa1\x25\xf1\xc5\x62\xd1\xbb\x2f\x38\xef\xdd\x2f\x22\x01\x60\xdf\x2f\xaa\x9e\xbe\x95\xd5\x56\xca\x30\x44\x9d\x69\x77\x81\x5a\x30\xca\xc6\x76\x10\x84\xbe\x0b\x22\x41\x4a\x3d\xb6\xb0\xb1\x86\xcc\x20\x65\x4b\xc9\xab\xa4\x02\xb3\x0a\xd1\xa8\xa5\x51\xa0\x70\xd4\xdc\x0a\xa2\x98\x93\xc4\xbd\xb6\x94\xaa\x29\x02\x7b\x81\x08\xa6\x08\xe0\x49\x50\x8a\x0a\xe4\xb0\x70\x4e\xe2\xd3\x70\xb1\x68\x67\x81\x38\x01\xaf\xf2\xa7\xac\xd0\xbf\x66\x96\xda\x3d\x62\x50\xda\x5d\xba\x11\xa1\x96\x94\x24\x5f\xd5\x92\xc1\x3f\x5d\x58\xe5\xb4\x68\x07\xea\xed\x02\x6c\x12\xe0\x0c\x2d\x16\x6d\x15\x08\xf8\x54\x41\x11\x0e\x70\xe0\x1d\x1e\x38\x04\xbb\x41\x78\x96\xe7\xe6\x8d\xa2\xf9\x0e\x8a\x30\x02\x4a\x02\x0c\x12\xc5\x4a\xec\xd1\x52\x7c\x47\xc6\x89\x00\xd1\x05\xbd\x47\xfe\xda\x04\x60\x45\x97\x2c\xa6\x2b\x70\x4a\xbd\x1d\xf6\xff\xe1\xaa\x33\x9d\x6b\xe9\x9e\x6c\xbb\xd4\x68\x59\xfe\x83\xff\xa2\x7c\xa2\x64\x07\x35\xef\x95\x8b\x85\x88\xcc\x16\x64\x92\x17\x55\xab\x7e\x64\xc6\xaf\x32\xf0\x41\x03\x51\x2b\x3b\x40\xb7\x0d\xc0\xa1\x81\x3e\xc0\x2d\x2c\x32\x8b\xde\xa6\x15\x0d\x2a\xba\x65\x67\x1b\x04\x79\xa5\x30\x21\x0c\xff\xe9\xae\x0e\xe7\x80\x49\x4a\x61\x9a\xbd\xd5\x5b\xde\x51\x62\x38\xff\x1e\x00\xc6\xdc\x1c\x61\x0f\x9f\x86\xfd\x95\xb6\xc3\x75\x46\xc6\x4f\x4e\x44\x27\x61\x19\xf3\xbc\xd3\x53\x73\x7d\x32\xbf\x9c\xa0\x56\x51\x5f\x69\xa3\x66\x70\x2f\x4f\xb7\x25\x7d\x19\x6c\x77\xc3\x20\x03\x6f\xf3\x59\x07\x9f\x7b\xe7\xb0\xd8\xcb\x86\x12\x30\x6c\x68\x4e\xc1\xcb\x74\x32\xd8\x7a\x1a\x06\x84\x58\x19\xc9\xf7\xb1\x2d\xff\xea\x9c\x6d\x6d\x6d\x27\xfb\x9b\xb3\xb4\x35\xff\x22\x5b\xad\x51\x92\x83\xf2\x51\xd4\x4a\x24\x68\xbe\xf5\x01\x06\xa7\xf1\x26\xda\x8c\x8b\x5a\x32\x23\xe0\x34\x1e\x8d\x80\x91\x6c\x75\xfb\x5f\xe4\xf2\x8b\xfc\x22\x3b\x67\xbd\x07\x37\xdb\x37\x9b\x69\xb2\xae\xa8\x5a\xb2\x28\x86\xbb\x36\x9d\x98\x0c\x8d\x44\x2d\x8f\xb5\xbc\x86\xa4\x69\x9c\x17\x9b\x43\x10\x9e\x44\xe7\x28\x94\xab\x67\x8b\xe5\xa8\xe5\xdd\x99\xaf\xb1\x36\xaa\xeb\x5f\x30\x27\x70\xdb\xa2\x07\x94\xcf\x67\x69\xe4\xd9\xa1\xf1\x58\x9a\x44\x9e\x2d\xd4\x5b\xd6\xc6\x3b\x9c\x97\x63\xbd\xaa\x74\xd0\x74\xec\x99\xa7\x00\x44\x3f\x19\xc3\x00\x80\xb0\xdb\xe0\x09\x44\x65\x37\x2d\x29\x6e\x5a\xa8\x96\x15\x78\xe3\xe4\xeb\x47\x95\xe6\x2d\xf2\x39\x9c\x53\x7e\x9b\xcf\x43\x54\xdf\x76\xc4\xf0\xb6\xa6\x1a\x13\x0b\x5b\x1b\x71\xf2\x30\x4b\x37\xbc\xfb\xf7\xbd\x8d\xa2\x73\x99\xe5\x05\xcb\xb8\xdc\x28\x3a\xe0\x17\x14\x94\x14\xac\x73\xbe\xfb\x5f\xee\x9f\xfc\xe7\xcb\x7d\x54\x59\xf6\xee\x7b\xf6\x4d\x85\x93\x62\xa6\xd2\x2f\xf9\xbf\xbf\x04\x41\x30\x88\x4e\xfe\x13\x06\xa7\x8b\x2f\x36\xb8\xb1\xf8\x12\x60\xe0\xdf\x5f\xc2\x10\xff\xc2\x2f\xe1\xfd\x8b\xc4\x79\x25\xc1\x0a\x6b\x36\xb9\x53\xa8\x04\x9e\x5e\xd8\xa2\xff\xe3\x05\x9d\x7f\x87\xde\xbd\xfb\xb5\xf4\xd4\x88\x62\xe9\xa6\xfd\x17\xa4\xfd\xd7\x1d\x69\x61\x8c\xee\xff\x27\xf8\xff\x2f\x40\x4d\x2d\x5a\xa0\x72\xf4\x97\xfb\x5f\xee\x63\x28\xd7\x41\x70\x68\x8a\xa1\x2f\xf7\xc3\xfb\x49\xa7\x10\x79\x01\x76\x95\xa9\x10\x2d\x08\x48\xcd\x20\xa4\xbc\x0b\xb7\xdf\x9d\x04\x74\x3a\x0f\xc7\x01\x8c\x67\x38\x50\x51\x1d\xea\x85\x03\xb9\xa1\xa2\x6c\x43\x75\x94\x98\xa6\xf1\x50\x04\xf7\xff\xf3\xff\x50\xf7\x2d\x5c\x6d\xe3\xfa\xbe\x5f\x25\xbb\xb3\xba\x76\x3b\x8d\x53\x3f\xe2\x3c\xd7\x70\x4f\x0b\x14\x28\xd0\x02\xa5\x3c\x3a\xd3\x9b\xe5\xd8\x4e\x6c\x70\x62\x13\x3b\x10\xc8\xc9\x77\xbf\x4b\xb2\x64\xeb\x69\x3b\x01\xce\xbe\x67\x33\x7b\x06\xa4\x9f\xfe\x6f\x49\x7f\xcb\xb2\xf4\x4f\xe3\x9f\x8f\x1f\xc1\xa9\xdd\xf5\x37\xf3\x59\xf0\x8e\xdb\xed\x13\xbc\xff\xf0\xe6\xfd\x9b\xd5\xfb\xd5\xe6\x9d\xfb\xe3\x9f\xff\xfa\x67\x5a\xfb\xb3\xf6\x39\x0c\x13\x70\xc8\x53\x54\xbb\x37\x1a\x46\xc3\xac\xbd\x43\x1b\xc3\xc7\x6e\x32\xc4\x75\x60\x72\x7b\x0f\xe1\xe0\xab\xc8\x19\xd8\xe4\x5a\xd3\x55\x4d\x53\x74\x55\x6b\xd5\xce\x1f\x7c\x70\x73\x69\xbd\x76\x30\xb5\x1b\x10\x75\xe4\xdb\xee\x34\x76\x9d\x1a\x08\xef\x59\xed\xf8\xe0\x3c\x25\x1b\x03\xba\x7e\xe2\xcd\x87\x80\xe2\xc7\xe4\x61\x18\x7f\xcc\x98\x7c\x1c\x06\xe1\xf0\xe3\x04\x7c\x5b\x39\xfb\x78\x74\xb0\xbd\xfb\xed\xc7\x2e\x64\xfa\x11\xf4\x77\x2c\xef\x1e\xc8\x57\xc1\x7a\x62\x6d\x1e\x83\xaf\xb3\x13\xcf\x25\x74\xd8\x9e\xc7\x49\x38\xf1\x9f\xdc\x99\x54\x8f\x8f\x36\xc6\x7c\xfc\x3f\xbe\xf3\x97\x63\x75\xdd\xa6\xab\x9b\x1d\xdd\xe8\xe8\x6d\xd3\xea\xd8\x2d\xd7\xee\x3a\xba\x31\x1c\x0e\x75\xb7\xab\x63\xb5\xe1\xf5\x57\xb1\x75\xef\x82\x0f\xc9\xc1\x20\x31\xf2\xc7\x8d\x9b\x18\x9e\x17\xe0\xd4\x72\xe5\xe2\xa4\x41\x68\x58\x46\x1d\x10\xff\x28\x71\x45\x6b\x6d\x57\x98\xaf\xef\x8a\x8f\x7f\xfe\xab\x36\x05\x9f\xaa\x05\xfe\x93\xdb\xb0\xe3\x18\x84\x8d\xda\x30\x6a\xff\x0d\xbd\x8c\xb8\xd5\xfe\xbb\x46\xd0\x9e\xba\x76\x18\x58\xf1\x47\xba\xdd\x9f\x1f\xc1\x26\xd9\x25\x71\x77\x6f\x2f\xb6\xa6\xb1\x12\xbb\x33\x7f\xd4\x57\x26\xb1\x02\xde\x3a\x91\x17\xf8\xf6\x34\x55\x7d\xdb\x57\x1e\xe0\x2e\x64\x71\xed\x0a\xe4\xf5\xcb\x89\x35\x1b\xfb\xd3\x9e\xba\xb2\x66\x89\x6f\x07\x6e\xdd\x8a\x7d\xc7\x45\x47\x72\xc7\xf5\x91\x3f\x06\x8f\x59\x7e\x08\x56\x01\xc7\xf3\x99\x5b\x1f\x85\x21\x08\x60\xcf\xb5\x1c\xf0\x1f\x70\x0f\x71\x54\x07\x9f\xcb\xd4\xc1\xbe\xe2\xfa\xd4\xba\xaf\xc7\xe9\xf6\x89\x7a\x3c\x9f\x4c\xac\xd9\xe3\x12\xcf\x60\xc3\x20\xb4\x6f\x57\xd6\xdc\xf1\xe1\x73\xf7\xbd\x15\xd7\xf1\x46\xd4\xfa\x3d\xb8\xdd\x35\x43\xa6\xb3\xa6\x02\x1b\xf4\xf1\x05\xc1\x0a\xbc\xab\xb9\x87\x6f\x43\x4e\x09\xf5\xa6\x61\xf2\xee\x6f\x7c\xf3\xfd\xef\xf7\x19\x09\x38\xc3\xa6\xd7\xb8\xf6\xd4\xd5\xdf\xe9\x25\xf6\xbf\xeb\x89\x3b\x89\xc0\x91\x4d\x14\x6e\x65\x2d\xf3\x3b\x95\xd3\xcb\x7a\x7b\x30\x8b\x00\x59\xea\x34\x59\x59\xe0\x0a\x58\xff\xde\xad\x5b\x3d\x0f\x9c\x44\xb9\x0c\xe7\x09\x10\x01\x98\x6d\x38\x9c\xfd\x0d\xef\x78\xfd\xbd\x4c\x3f\x0c\x50\x86\x61\x92\x84\x93\x9e\x16\x2d\x6a\x4e\x98\x24\xae\xb3\x1a\xc2\x3d\xe2\xd3\xf1\x92\xb8\x31\xb9\x37\x0c\x03\x67\xe5\x8c\xa6\xcb\xfc\x7a\xe5\x9e\x9f\x58\x81\x6f\xaf\x3c\x0d\x15\x82\x7d\xef\xba\x3b\xe9\x63\x2f\x35\x5a\x6d\x77\x52\x53\x57\x13\x6b\x76\x4b\x88\xdc\xfb\x63\x34\x52\xfb\xa9\xdc\x7f\xa8\xaa\xba\x8a\x27\x56\x80\xe2\x05\xb8\xbd\xd7\x51\xdf\xae\xe2\xf9\xb0\x1e\xcf\x23\xa2\xb4\x6d\xbe\xed\x03\x35\xd0\x6d\xb7\x3d\xb5\x8f\xcf\x11\xed\xc1\x73\xad\xfc\x7b\x57\x6a\x7c\x40\x09\xec\x5e\x50\xd4\x86\xe9\x4e\x00\xf1\x25\xd2\x5b\x51\x1b\x3a\x28\xf2\x27\x63\x64\x91\x9e\xba\x02\x9f\xd1\x00\x4f\xf5\xc0\xed\x1e\xef\x97\xc0\x88\xa3\x20\x7c\xe8\xa1\x37\xc0\x69\x6c\xe1\x60\xd4\xdc\x49\xad\xa9\x46\x8b\x95\x37\x5b\xe2\x10\x1e\x86\x0b\x10\xdf\x20\x55\x42\x59\x87\x32\x0c\x17\x7d\x65\x12\x3e\xc9\xea\x24\xc5\x59\x4c\x44\x33\x37\x17\x04\xec\x04\x58\x81\x2b\x89\xeb\xb7\x43\x07\xbc\xf6\xaf\xc7\xd6\x24\xa2\xba\xdc\x24\x9c\x86\x70\xd7\x61\xbd\x96\xfd\xda\xcf\xad\xa9\xb9\x93\x55\xba\x0b\xa1\x0e\x77\x9f\xd6\xc3\x28\x01\x11\x15\xa1\xad\x52\x75\xfc\xa6\x1f\xdd\xb7\xe1\x4f\x3d\x77\xe6\x27\x90\x42\xf6\x07\x76\x34\xa2\x94\xcb\x77\xef\xc3\x6b\x3d\x31\x87\x94\xe4\x12\x90\x54\xb2\x7c\x37\x8d\x65\x84\x00\x23\x46\x7a\x96\x09\xda\xe7\x8a\x76\x48\xfc\xae\x93\x85\xf0\x65\x36\x53\x96\x1e\x19\xf2\xe6\x77\x66\x7b\xf0\x46\xd8\x9a\x81\xe7\x90\x5e\x4a\xa4\x6f\xcf\x67\x71\x38\xeb\xa1\xbb\xb0\x11\xcb\xbf\x1d\x3f\xb6\xc0\x91\x87\xbf\x49\xe6\x59\xe1\x12\x35\x42\x4f\x44\xa8\x51\xaf\x07\x5d\x08\x1f\x24\x14\xf8\xa9\x10\xfa\xbc\x80\x2b\xcf\x82\xa9\x8f\x73\x66\x75\x05\xa1\x4b\x32\x86\xd3\x81\x73\x45\xea\x93\xdd\xbe\xc0\xa8\x9e\xee\xfb\x15\x45\x58\xd6\x91\xf9\x00\x23\xaa\xc4\xa5\x8c\x70\x88\x19\x7a\x42\xfb\xdd\xeb\x61\x76\x50\x27\x25\x8e\xfc\xa9\x82\x3c\x56\x8c\x0f\xe7\x09\x8d\x5f\x22\x8d\x61\xe4\x92\x6d\xd3\xcb\x56\xc4\xfe\x03\x01\x33\xf2\xdd\xc0\xc9\xe6\x06\x49\x2f\x59\xbf\x63\x09\x65\xc8\xe5\x4f\xa5\x52\xd2\x97\x6b\x22\x95\xa5\x4d\xf2\x9b\xc7\x45\x1a\xc1\x98\x87\x2a\xc5\x6e\x82\x63\x04\x8c\xbd\x71\x08\xce\xef\xf9\xc3\x56\xc1\x4f\xd6\xb1\x6a\x7a\x44\xf8\xa8\x61\x98\x60\x3c\x6d\xb4\xc0\x88\x55\x53\x1b\x6d\x30\x70\x05\xee\xd8\x9d\x3a\xa2\x70\xcb\x7a\x30\x3d\x6c\xe0\x8e\xce\x0f\xef\xf0\x3d\x05\xa2\x04\xe6\x15\x78\x02\x55\x0f\xff\xd2\x47\x15\x60\x24\x41\x0c\x9c\x7a\xe2\x2d\x73\x86\x20\x85\xf8\x91\x7e\x41\x53\x13\x64\x22\x9e\x39\x8c\x60\x6a\x60\x2a\xc3\xd0\x0f\xdc\x19\x9c\xda\xa8\x8c\x24\x9e\xd9\x1f\xed\x38\xfe\x08\x66\x68\x94\x4b\xfc\xd7\xc4\x75\x7c\xab\x16\x81\xe3\xfe\x96\x7f\xd6\xff\x44\x4f\x82\xf5\x3f\xd3\x07\x49\x72\x5e\x21\x26\xc1\xda\xbf\xfc\x09\x78\x34\xb3\xa6\x09\x31\xd3\x90\xa5\xd8\x39\x30\x3c\x3c\xcb\x09\x1f\xe0\x80\x44\x42\x0a\xaa\x80\x6d\x25\x75\x2b\xab\x6e\xc1\x01\x30\x71\x9d\x25\x73\x19\x7d\x2f\xbb\x6a\x7e\x65\xfd\x0d\x2e\x63\xff\x8d\xb4\x40\x91\xd9\x7b\x53\x7b\xf7\xa6\x66\x25\xc9\xec\x1d\xa8\x7d\x5f\x03\x0f\x03\xc4\xa4\x2d\x45\xc3\x39\x1d\xc1\x21\xe1\xff\xfb\xd7\x9b\x3f\xde\x20\xea\xf5\xac\xe8\xc6\xba\xb7\x62\x7b\xe6\x47\x49\xef\x0d\x47\xec\xcd\x0a\x4c\x24\x30\x8b\xb9\x9b\x87\x89\x2b\x08\xcf\x6e\xb7\xdb\x07\xd7\x8a\x28\x70\x03\x9a\xe2\x4f\x41\xfa\xd5\xb3\xc0\x22\xc3\x2a\x01\x49\x56\x96\xa8\xc0\x58\x52\x40\x91\x3b\x53\xe0\xc4\xb2\x4a\x66\x75\x30\xcb\xca\xda\x83\xba\x89\xb5\x50\x1e\xe0\xa7\x69\x20\xe7\x23\x8d\x1a\xd5\x3d\xbd\xee\x19\x4b\xfc\xa9\x9d\xd1\x47\x9f\xd7\x19\xab\xb4\x82\x20\x0b\x95\x46\x54\x1b\x8b\x27\xc5\xfa\xac\x4c\xad\xfb\xa1\x35\xa3\xd3\xa8\xc6\xc0\x50\xae\x7e\x06\xca\x30\x99\x6e\x35\x2e\x47\x57\x67\xd7\x8a\x6d\xcd\xdc\xa4\xde\x58\x9c\x1e\x37\xaf\x14\x70\x24\xd7\x3c\xda\x92\xc1\x70\x47\x49\xc2\x48\x11\x86\xd8\xaa\x31\x30\xc6\xb7\x7b\x3f\x94\xc0\x1a\xba\x81\xc0\x9a\x20\xf7\x69\x8c\xf6\xf7\xcd\x40\x29\xee\x7a\x14\x51\xa2\x41\x2d\x71\xea\x24\x81\x5a\xe2\xf1\xa9\xe1\x1f\xa3\xd1\x88\x91\xea\xee\xe7\xed\x59\xda\x42\x49\x59\x82\x67\x1f\xaf\x2e\xab\x71\x04\xb2\x3b\x8e\x43\x12\x5d\xfd\x99\x8d\x76\xc4\x78\xfb\x8c\x99\x69\xc5\x75\xf4\x17\xa6\x9f\x3f\xa3\xc0\xec\x52\x53\xa3\x45\x36\x26\x24\x56\xa4\x78\xfe\xd8\x0b\xc0\xd8\x88\xcc\x38\x1b\x0f\xad\x77\x6a\x1d\xfe\xbc\x4f\x9f\x46\xc8\x74\xeb\xcd\xbe\x1b\xdc\xbb\x20\xf9\xac\x7d\x73\xe7\xee\x9b\x7a\xf6\x77\xfd\xd3\xcc\xb7\x82\x3a\xf1\x08\x44\x70\x6d\x46\x0b\x2a\xa9\xd5\x1a\x4d\xbd\x63\xb6\xb5\xa6\x81\x87\x2d\xc3\x30\xfa\x42\x9f\xa6\x33\x58\x9d\x4a\xb1\xf2\xac\x8d\x94\x8d\xcc\xdd\x52\xbe\xb8\x84\x64\x8d\xca\x56\x38\xe3\xfb\xc3\x30\xda\xd6\xb0\xdd\x67\x87\x30\x30\xdc\xad\xd0\xa3\x45\xdd\xea\xc1\x9c\x07\x37\xd1\x0d\x53\x6f\xdb\xfd\x82\x51\x0f\xe1\xf1\x23\x49\xe2\xc1\xbb\x53\xc1\x73\x47\x1f\x97\x99\xd1\xa2\x06\xd2\x84\x1a\x76\x07\x6c\xa2\x80\x0f\xa2\x52\xf5\x31\x52\x49\x77\x3e\xf7\x14\x3d\x5a\x30\x49\xb9\x0a\x87\x13\xe6\x71\x60\xe2\x3b\x4e\x00\xfa\xbd\xfe\x74\x96\xc4\x8a\x3f\x19\x2b\x33\x78\xc4\x52\xec\xdf\xe7\x8f\x58\x70\x00\xec\xd3\x23\x51\x1f\xd9\x08\x88\xb5\x02\x0b\x94\xda\xc3\x65\xda\x1e\x38\xc5\xc5\x1d\x44\x01\x5f\x67\xcd\xe3\x5e\x2b\x5a\x80\xce\x3f\x39\xb9\xf8\x0c\x51\x89\x37\x9f\x0c\xa7\x96\x1f\x64\x93\x65\x81\xdf\x85\xce\xee\x0b\x7b\x60\x9f\x66\xdb\x24\x43\x38\x3b\x3b\xab\x67\x05\x41\xad\xa1\xc7\x35\xd7\x8a\x5d\xc5\x9f\x2a\xe1\x3c\xe9\x2b\x61\x19\xa2\xa4\x5a\xf8\xec\x5b\x6c\xb4\x68\x6f\x6f\x02\xcd\x61\xfb\x33\x3b\x70\x19\x9b\x99\xea\x5b\xf0\x04\x95\x66\x3e\x0a\x78\x58\xd3\x41\x97\x44\x7f\xa3\xa7\x35\x58\x84\x4c\xa1\x62\xed\x01\x96\xb0\x8b\xeb\x42\x17\x47\x8e\xf2\x4b\x89\x67\x4a\x38\x0d\x1e\x97\xd9\x93\xa2\x35\x8c\xc3\x60\x9e\xb8\x7d\x24\x66\x94\x3d\x61\x69\x19\xb3\x9e\xa2\x91\x59\x57\x3f\x4b\x9f\xd2\xc7\xbf\x3e\x38\x6a\xb9\x07\xae\x68\x7f\xa7\xd6\x6b\xe8\x9f\xf7\x48\x98\x9e\x0a\x78\x87\x67\x0f\x73\xcc\x3b\x8d\x5e\x6b\x18\xb8\xf8\xb1\x5c\x0e\x80\xbf\xe5\xc2\xc6\xe0\xd2\x40\x1b\x89\x0a\xac\x48\x5a\x14\x0b\xab\xf6\xd9\xa7\xae\x54\x40\x80\x59\xfd\x3d\x0b\x03\xe2\x61\x6a\xc9\x3c\x09\x79\x5a\x3a\xab\xd6\xbd\x66\xdd\x33\xeb\x5e\xab\xde\xf8\x62\xef\x7c\xee\x2a\x9e\x56\x6f\x0c\xf4\xb3\x64\xb7\xa3\x78\x3a\xf8\x75\xff\xa9\x7b\xad\x78\x06\xf8\x55\xfd\xb9\xab\x2a\x5e\x13\xcc\x16\xfe\xb8\x79\xa5\x78\x66\xbd\x31\xd0\x0e\x2e\x87\xbe\xe2\xb5\xe4\xe3\x0e\x4a\x34\x4d\x55\x65\x02\x5f\x43\x23\x1d\x1e\x7f\x3c\xad\x06\x17\x04\xea\x9e\x8e\x7f\x31\xf0\x2f\x4d\xfc\x8b\x89\x7f\x69\xa1\x5f\x32\xc1\xf1\xdf\x99\xf8\x79\x01\x52\x22\x2f\x40\xaa\x64\x05\x58\xa1\xac\x00\xab\x85\x0a\x3c\xad\xd6\x18\x68\x8b\xbb\xdd\x99\x82\x0a\x74\xb6\xc0\x60\x0b\x9a\x6c\x81\xc9\x16\xb4\x98\x82\x5c\x15\xa6\x3c\x57\x89\xab\xc0\xaa\x71\x15\x58\x45\xb6\x22\x53\x95\xad\xc8\x54\xa6\x2a\xa8\x87\x85\xf4\xa9\x95\x76\x23\x9e\xae\xda\xed\xf6\xca\xd3\x72\x1d\x40\x80\x11\xa1\xe4\x19\x84\xb4\x25\x9d\x1d\x4c\xc9\x79\x34\xe4\x56\x61\xa2\x83\xf3\x34\xe9\x61\x6c\x17\x89\xff\x72\xa2\x74\xb9\xc8\xc4\x98\x49\x89\xc3\x09\xa6\x54\x05\x91\x6b\xb4\xcc\xb7\x2b\xaf\x49\x04\x20\xe8\x7c\xb9\x4b\x60\x3f\xcc\x7b\x14\x61\x24\x4d\x66\xa4\x66\x8d\x73\x38\xd3\x51\x08\x8f\xb3\x3d\x27\x77\xb9\x24\x64\x09\xaa\x25\xb1\x4c\x70\x29\x0b\xf2\x9c\xab\xcc\x4c\x6d\xf3\x2d\x15\x4a\x44\x95\x01\x66\x58\x2a\xb2\xc8\x4a\x68\x12\x2a\xd0\xf2\x4a\xbd\x19\x2d\x68\xe3\x13\x2d\xb5\x0e\xa8\x24\x7d\x41\x56\xc2\x96\x94\x6b\x88\x4a\x90\x82\x44\xc8\x57\x3d\xb5\xa6\xd6\x80\xaf\x56\x8d\xcf\xe7\xe1\xd1\x44\x09\xc0\x93\x11\xed\x37\x18\xef\x44\xfb\x56\xb4\xa0\xc6\x49\x83\x1b\x27\x9b\x2b\xf4\x28\xfc\x6e\xe2\x4f\xd1\x34\xdb\x6e\x75\xa2\xc5\xfb\x25\xc9\x26\xa7\xa9\x6b\xd1\x62\xb5\xca\xcc\x2d\xb4\x71\xc7\x7c\x0b\x97\x67\x81\x56\xdb\x3f\x47\x81\xc2\xac\xd5\x66\x29\x88\x3d\xea\xb8\x46\x36\x29\x36\x74\x77\x02\xa6\x74\x67\xd7\xdb\x4b\xd7\xeb\x03\x77\x84\x16\xf8\xd2\x5c\x0b\xfc\x0d\x10\x86\x72\x76\x94\x22\xe0\xcb\x25\x12\x02\x0b\x00\x46\x3f\xbd\xbd\x4d\x31\xb6\x0b\xf6\x8e\x92\xa0\xb4\x04\x4c\xaa\x07\x9f\xbf\x86\x29\x0a\xbc\x32\xf0\x47\x8f\x24\x0c\x15\x01\xdc\x4e\xc7\x98\xa6\xb8\x69\xf8\x30\xb3\xa2\xe5\x83\xe7\x27\x2e\x5c\xb6\x00\xeb\x2f\xa0\x08\x24\x67\xd1\xc1\xd1\x25\x12\x1d\x7c\xa2\x00\x3e\xb8\x62\x17\x28\xb3\x8a\x55\x63\x77\x7f\xcf\xf9\x92\xc2\xe7\x51\x24\x86\x67\x15\xab\xc6\xb7\xc3\x93\xf0\x09\xa9\x64\x45\x70\x9d\xfc\x89\xc3\xe7\x35\xab\xc6\xf9\xd1\xb1\x8f\x1a\x4c\xe6\x60\xe1\x80\x18\x4e\x1b\x13\xe5\x22\xd1\xd2\xca\x68\xe6\xc3\x97\x14\xa8\x3a\x4d\xd1\x57\x96\x00\x82\x13\x74\x51\x15\x9d\xb3\x77\x5a\x6a\x17\xa4\x2d\xda\xf8\xeb\xe9\x0e\x7a\xfb\x32\xb7\x6d\x37\xce\x20\x86\xdd\x6e\x19\xce\xca\x12\x61\x32\x3e\xa2\x3a\x9a\xd1\xd0\x6c\xea\x36\xb0\xfd\xf4\x70\xef\x6b\x0a\xf4\xa7\xa3\x30\xe3\xa2\xb5\xd5\xce\x68\x65\x71\x80\x9c\x05\x5b\x41\xd3\x6f\x9a\x7a\xab\x0b\x14\x39\xea\x9c\xd8\x29\x7d\x70\xb2\x84\x3f\x1d\x63\x48\xc7\x6a\x39\xc6\x70\x65\x89\x30\x84\x22\x7c\x1d\xc5\xa8\xd5\x32\x35\xa8\x88\x7e\xea\xde\x2d\x52\x60\xfa\xb9\x19\x46\x58\xdd\x66\xb3\xa9\xaf\x2c\x01\x24\x67\xc3\x57\x51\x5c\x3a\x4d\xc3\x34\x9a\xab\xc6\xc5\x5e\xa7\xf5\x43\x19\x8e\xb1\xf7\x70\x3d\x7c\x2a\xe0\x3a\x6a\x16\x11\x6c\xb3\x8c\x2f\x57\x91\x72\xe5\xfb\x3c\x0e\x8c\x2f\x9e\xda\xb5\x80\x00\xc8\xab\x02\xa8\x33\x1a\xa9\x4e\x67\x65\x71\xd8\x8c\x2b\x57\x21\xe3\x6a\x6b\xae\x3e\x34\x40\x94\xec\x28\x66\x08\x48\xc1\x18\x11\xf0\xec\xba\xce\xa8\xbd\xb2\x18\x64\xc6\x91\x29\x96\xf1\xb3\x46\x4e\x37\x7d\x62\x30\xae\xbf\x4d\x01\x1c\xf9\x5c\x80\x4d\x47\xc1\x95\xc5\x83\x73\xae\x5c\x8d\x8c\xf1\xa8\xed\xda\x43\x13\x84\xeb\xb8\xd5\xfe\x04\x18\xa3\x18\x12\x40\x75\xc7\x75\xdc\x95\xc5\x61\x73\xb6\x6c\x85\x8c\xab\xdb\x1c\x76\x87\xa0\x93\x18\xd6\xe3\x91\xa7\xc0\x55\xb3\x74\x95\x0e\x3f\x9b\xe2\x19\xaa\x9b\xe5\x1a\x3d\xf0\x7a\xab\xa6\xd6\x88\xa7\x2f\x0c\x62\x9e\xbb\xe6\x41\x3d\x0c\xf0\x4c\x07\xd2\x3a\xbc\x94\x9d\xe1\xc1\xac\x38\x0f\x6a\x10\x08\xfe\x3d\x0f\x6a\x21\xfc\x3d\x6f\x87\xa0\x60\x48\xd2\x93\x61\xeb\x4c\x81\x5b\x91\xc0\x3e\xfa\xc7\xc0\x75\x32\x31\xc1\x0c\xd3\x53\xfb\xc4\x3e\x25\xb4\xaa\xa7\x5b\x27\xfe\x3c\x6d\x94\x3e\x9d\x96\x34\xc1\x22\x42\x82\x8a\x09\xa6\x6d\x9e\xc6\x56\xe0\x2f\x85\xcf\xbc\x14\x6d\x33\x7f\x70\x54\xe0\xf4\xd6\x03\xe4\x9c\x42\x93\x00\xab\xae\x9c\xa4\xee\x38\x4b\xf1\x8a\xc0\xca\x49\xf8\xb5\x7a\x27\x4b\x28\x20\x63\xb5\x20\x39\x18\xe8\x3b\xbf\xc2\x89\xe2\x04\x8a\x17\xce\xfc\x27\xb0\xfd\x31\xa8\x01\x9a\x41\x68\x25\x3d\xd0\x1c\x3f\x0d\xb7\x80\x83\xe1\xc9\x90\x69\x31\x31\xc1\x42\x6d\xb8\x47\x61\x08\xc8\x0a\xdd\x20\xf0\xa3\xd8\x8f\xfb\xc2\x19\x57\x28\x05\xad\x85\xd6\x01\xb6\x20\x17\xbc\xeb\xf0\x77\xb0\x75\x47\x09\x67\xfe\x18\x7c\x7e\xad\xa0\xf7\xd7\xe8\x59\xd6\x73\x83\x48\x10\x94\xe9\xbb\xec\x5a\x3a\x81\x0e\x8c\xee\xd7\xdb\x1d\xc5\x4f\xbf\xda\xf6\xe3\x09\x91\x01\x75\xd5\xb7\x7d\xe9\x44\x4e\x2c\x86\x23\xb7\xc2\x84\xbb\x46\x3c\xad\xc0\x64\x8f\xcd\xe6\xda\x0d\x33\xef\x2a\x38\x32\xc8\x8e\x92\x13\xae\x45\xc4\x76\x35\x62\xf5\xbd\x36\x0f\x24\x15\x21\x59\xb1\xa4\x83\x49\x25\x29\xa3\xcd\x0f\x44\x49\x9a\x0c\x12\x05\x74\x5e\x88\x03\x1c\x02\x08\x7d\x3a\xea\xdb\xbe\x38\x36\xc9\xc7\x3e\x82\x6c\xca\x19\xaf\xe0\x12\x15\x90\x8f\xa0\x9c\x92\x03\xd5\x67\x6f\x26\xfe\xf9\xf7\x3f\xff\xe8\xaa\xd6\xac\xd5\x6a\xff\xfc\xf3\x49\xfd\xe7\xdf\xc0\xa3\xdf\x6f\x0f\x8e\x94\x9c\x82\x32\x73\xc1\x77\x22\x24\xd1\xc6\x40\xdb\x79\x38\x38\x53\xa2\x79\x10\xa0\xec\x93\xee\x9b\x1a\xd9\x5d\xd1\xd8\x80\x7c\x96\x75\x5e\xc2\x69\x94\x3f\x55\xae\x73\x14\x08\x85\x3c\xc1\xeb\xcd\x8b\xc8\x40\x0b\x68\x4a\x4c\x29\x20\x49\x21\x0b\x28\x8a\x9c\x50\x4c\xb9\xcc\x6d\xff\xae\x60\x14\xf8\xb6\xa6\x92\x4d\x52\x64\x01\xc5\x54\x8c\x0a\x04\x49\x60\x65\x83\x54\xa0\x2b\x68\x40\x45\xf1\x27\x15\xc6\x30\x08\xe6\x7f\xfe\xbd\xb2\x1c\x07\xdc\xad\x21\x7f\x32\x44\x53\x14\xbf\xda\x92\xcf\x0d\x8d\xbd\xd1\xc5\xe8\x5a\x41\x9b\xda\xdd\x6c\x01\x75\xc6\x2e\x14\xa6\x23\x2c\x2c\xa0\x42\x9e\xea\x04\x79\xbf\x28\x98\x4f\x58\x8e\xa8\xd6\x84\x63\x37\xdf\xac\xdb\xd5\x0b\x9a\x75\xdb\x92\x66\x9a\xae\xaa\x05\xed\x34\x2d\x6d\xd8\x18\x68\xe7\xe3\xfd\x1f\x79\xbd\x32\x0a\xe6\xbe\xf3\x62\x66\x68\x0c\x8c\xab\x9b\xf1\x85\x32\x0b\x1f\x30\x4d\x68\x36\x05\x36\x40\x25\xa9\xd9\x14\xd4\xa0\x79\xa0\x5d\xc3\x94\x51\x59\xc4\x8a\x56\xaf\x35\x7e\xdd\x1c\x5a\x06\x2c\x88\x27\xb0\x60\xa0\xdf\x9d\x5e\xd9\xb0\x64\xe2\xa0\x12\x35\x41\x98\x60\x8c\x4a\x76\xa6\xd1\x23\x26\xa3\x83\x12\xcd\xd7\xe7\x63\x4c\x07\x96\xe8\x89\xd1\xbe\xc4\x74\xd2\x92\xfd\xc0\x78\xc4\x74\x40\xc9\xf7\xab\xa8\x33\xc0\x64\x0c\x08\xd9\x9e\x9a\x5f\x30\x19\x50\xf2\x69\x68\xb8\xfb\x98\x8a\x01\xa9\xb4\xf6\xe2\x6b\x4c\x25\x2d\x39\xfb\x94\x3c\x61\x32\xcd\x7a\xad\x31\xb2\xd4\x83\x19\xa6\x02\x0a\xbc\x48\xdd\x9d\x63\x2a\xa0\x60\xa0\xb5\xef\xbb\x77\x98\x0a\x2c\xd1\xaf\x8d\x87\x33\x4c\xc5\x04\x25\x86\x37\xf8\xbc\x87\xc9\x80\x92\x58\x39\xbe\xd5\x31\x19\x50\x30\x30\xa2\xf0\x5c\xc7\x64\x60\x89\x7e\x74\x34\xf2\x31\x99\x16\x2c\x99\xdf\xcf\x7e\xc0\x92\x78\x92\x96\x18\xd6\xe5\xd0\xc4\x74\x60\x89\x76\x7b\x98\xb4\x30\x9d\xb4\x24\xb8\xd6\x2e\x30\x9d\x36\x28\x31\x8e\x7f\x8d\x32\x13\xa7\x25\xf6\xd7\xb0\x85\xe9\xa4\x25\x8b\xa7\x33\x15\xd3\x81\x25\xfa\xfe\xe2\xcb\x02\xd3\xe9\xc0\x92\xb1\xef\xec\x62\x3a\x69\xc9\xc9\x99\xf5\x03\xd3\x01\x25\x47\x5f\x15\x25\xc6\x64\x3a\x90\x4c\xf8\x7d\x74\x83\xc9\x74\x61\x49\xeb\xf3\x41\x13\x93\x49\x4b\x76\x3c\x67\x0f\x93\x81\x25\xc6\x5d\x6b\x76\x80\xe9\x80\x92\x68\xe2\x7f\xbe\xc2\x64\x34\x15\xd8\xd4\xbc\x4d\x9f\x00\x00\x19\x58\x32\xd0\xc2\x6f\xdb\xc7\x98\x4e\x5a\xa4\xef\x5c\x5f\xfe\xc2\x84\x60\xd1\xe3\xe5\xd4\xcf\xec\xac\x81\xa8\x0c\xfc\x6f\x3f\xb3\xd8\x81\x25\x03\x7d\x7b\xf2\xd3\xcd\x28\xc1\x22\xed\xee\xe6\xc7\x4e\x46\x09\x16\xe9\xce\xd8\x56\x32\x52\x20\x30\x07\xc6\x63\xb0\x6d\x66\xb4\x60\x91\xbe\xb3\x7d\x99\xf7\x8b\xb4\xe8\xcb\xb7\xd9\x34\xa3\xa5\x2f\xf9\x4d\x7f\x60\xa8\xc1\x03\x63\xb4\xa8\xda\xad\xb9\x5e\xca\x77\x38\x41\xd7\x61\x7b\x81\x20\xa2\xf9\xe0\xe4\xc3\x4c\x10\x30\xac\xef\x79\x2f\xf2\xde\x60\xad\x4a\xa4\xf4\x2b\xbe\x12\x0d\x9c\xaa\xfa\x76\xc5\xd2\xc2\x83\xb1\xd6\x68\xa5\xff\x6b\xbf\x5d\xb1\x12\x20\x4c\xc7\x68\x18\xe8\x7f\x6f\x57\xac\xdc\x08\x03\x56\x6e\x59\x25\x51\x55\xab\x45\xb2\x60\x4c\x83\x30\x66\x87\x66\x41\x19\x14\x63\x80\x16\x8c\xf5\x51\x55\x93\xd2\x82\xf1\x19\xc2\x18\x94\x16\xb4\xa3\x11\x44\x4f\x95\xa0\x82\x02\x55\x69\xb4\x12\x74\x28\x21\x0c\xa5\xc3\xfc\xb6\x73\x74\x8a\x11\x30\x5f\xd0\xf4\x25\x0a\x47\xe8\x8f\xe9\xe9\x89\x92\xd9\x3a\x05\x68\x08\x40\x3b\x65\x60\x5c\xea\xfb\x99\xac\x29\x52\x45\x48\xc6\x35\x5a\xe7\xee\xc0\xa3\x90\x5d\x04\x84\xfe\xd9\xde\x1d\x47\x01\x55\xdd\x41\xd5\x8c\x8f\x8c\x9f\x17\xc7\x34\x9d\x36\x02\x32\x8e\xd2\x4e\x76\xbd\x7b\x0a\xd8\xc2\x40\xa0\xe3\xc5\xe2\xcb\xfd\x25\xa5\xa3\x89\xaa\x19\x8f\x19\x97\x77\x51\x42\x01\x9b\x08\x48\xbb\x6d\xa0\xe9\xbf\xdc\xcc\xf0\x10\x68\x20\x60\xea\x3c\x23\x52\x12\xda\xec\x99\xd5\x29\x15\xcf\x76\x76\xef\x18\xeb\x23\x1c\xad\xa0\xfe\x45\xbb\xa4\xe9\x61\xd3\xe3\x77\xdc\x9f\x4e\xee\x0f\x72\x40\xec\x29\x9a\xbe\x84\xf9\x02\x78\x21\x0e\x54\xdb\x6e\x1b\x3b\xb9\x6a\x00\xa0\xa5\x00\xda\xcd\x9e\xd9\xda\xcf\xfa\x0e\xf8\x72\x0b\x78\x19\xe2\x58\x27\x9f\x74\x8d\x13\x0a\xd8\x4d\xe9\xa1\x3e\xf8\x6d\xf8\xf0\x40\x55\x77\xd2\x6a\xb6\x1f\x1e\x1a\xee\x27\x0a\xd7\x4e\x71\x6c\x5f\x9c\x7e\xb6\xcf\x29\x5c\x0b\xe1\x52\xf5\x22\x37\xa1\xa5\x31\xd3\x6a\xb6\x4f\x1e\xdc\xdd\x11\xa1\x10\x7b\x4a\x33\xc5\xd1\x0e\x3e\xde\x4e\xe6\x2d\x0a\x66\xa4\x30\xe4\xde\x93\x63\x67\x96\xbb\x37\xf6\x14\x6c\x6c\x46\xb9\x87\x9f\x5d\x83\x52\x0e\xd9\x9c\xd6\x4d\x9b\x9c\x9e\x46\x94\x6e\xc8\xe4\xc8\xb9\x7a\x77\xf7\x57\x36\xd4\xa5\x5b\x4f\x80\x7b\xc9\xac\x10\x79\x59\xf7\x46\x4a\xcc\x22\x35\x0a\x49\xbb\xfb\xe4\xa7\x7f\x32\x67\x1b\xa8\x54\x03\xc6\xef\x7a\xeb\xf2\xcb\x19\xd3\xa2\x4b\x35\x48\x03\x40\xb3\xb7\xf7\x5d\x06\xd7\xa1\x70\x6c\x6f\xbf\x3a\x4f\xba\x4c\x83\x36\xd5\x80\x09\x09\x63\x36\xbf\xd3\x98\x06\xd9\x5b\x4b\x22\x36\xf4\xaf\xf7\xce\x03\x83\x33\x29\x1c\x3b\x0c\x1c\xd9\xda\x0e\xd3\xa0\x49\x35\x60\x87\x83\xe3\xbd\x8b\x21\xd3\xc0\xa0\x1a\xa0\xb8\xb9\xd8\x3d\xba\x60\x70\x8c\x1f\x99\x00\xba\x3b\xdf\x67\x55\xa4\xdd\xc9\x98\x24\x6a\x4e\x4e\x18\xbc\x5a\x79\xbd\x8e\x7f\x5c\x10\x24\xfe\x6c\x0e\xcf\xa5\xe3\x7c\x66\x2d\x4a\x92\xb9\x74\x57\x90\xb8\xb2\x39\x28\x9f\x4d\x0a\xb2\x42\x26\xb9\xa3\x33\x13\xb6\x92\xcc\x4c\x18\x5a\xa8\x8e\xee\x2e\x8c\x04\x08\xc3\xf5\x10\x52\x6e\x3a\x33\xa1\x94\x44\x55\x6c\x3f\xa0\x4c\x83\x30\xec\x68\x48\x19\x14\x63\x80\x16\x8c\xf5\x51\x15\x1d\xe0\xb4\xcb\x10\x84\x09\x69\xda\xcf\x08\x93\x46\x31\x1d\x14\xc2\xcc\x84\x8e\x24\x04\x61\x54\x08\xc6\xd6\x37\x0c\x11\x65\x26\x83\xee\xce\xae\x76\x46\x23\xc4\xa9\xc9\xed\xee\xd3\xad\x42\x03\xc5\x99\xc9\xb7\x44\xbf\xc9\xdc\xc7\x27\x26\x03\x23\x7e\x38\xd9\xa6\xea\x65\x99\xc9\xfe\x85\x73\x49\x01\xc5\x99\xc9\xc3\xf9\xee\x17\x8b\xc2\x51\x89\xc9\xf9\xd1\xdd\xf6\x23\x55\x2d\x49\x4c\x34\x73\xf0\x95\x16\x4c\x9c\x98\xdc\x1e\xce\xc7\x87\x14\x8e\xce\x4b\xb4\xf1\xe8\xe1\x17\x55\x2f\xce\x4b\x7e\x1e\xed\x7d\x3e\xa1\x70\xd8\xf2\x8c\x13\xdb\x2d\x8d\xb6\x03\x9d\x97\xdc\x5f\xdc\xc5\xf7\x79\xbd\x20\x2d\x99\xcd\x9e\x3e\xd3\x00\x61\x5a\x32\xd0\x5a\xfe\x3e\x21\xb9\x3c\x2f\x31\xc6\xdf\x9b\x03\x0a\x48\xe7\x25\xfe\x39\x19\x76\xf2\xbc\xc4\x3c\xbe\x3b\xa7\x70\xe2\xbc\xc4\xb8\xfc\xbc\xbd\x43\xe1\xe8\xbc\xe4\xd1\x6f\x2d\xa8\x6a\x61\x5e\xb2\xef\x0f\x12\x9b\x82\x09\xd3\x92\x81\xb1\xbd\xa3\x5f\x53\x38\x2a\x2f\xd1\x0f\x4f\x2e\xe6\x54\xb5\x24\x2f\xb9\x9f\x25\x2e\x85\x13\xe6\x25\xba\xbf\xf7\xe3\x88\x82\x31\x79\xc9\xf8\xf3\xf7\x6c\x8c\x28\xcc\x4b\xb4\xe9\xed\x9d\xc2\x22\x8b\xf2\x92\x81\x61\x0f\x8f\x38\xda\x85\x89\x89\x76\x7d\xf7\xf3\x96\x69\x21\x48\x4c\x02\xf3\xe8\x5c\x67\x60\x85\x79\x89\x36\x08\x16\x2a\xd3\xa0\x28\x2f\x39\x1d\x25\x67\x0b\x06\x2f\x4a\x4b\xb4\x6d\xff\xd3\x27\x06\x57\x9c\x96\x1c\x7e\x7f\x3c\x66\x1a\x14\xa6\x25\xc6\x55\xe7\xc7\x0f\xa6\x81\x28\x2d\xd1\xe7\x8b\x7b\x8d\xc1\x15\xa6\x25\xc6\xd1\xce\xd1\x57\xa6\x41\x51\x5a\xa2\xdf\xed\x26\x87\x0c\x9e\x4d\x4b\x0a\x16\x70\x45\xab\x96\xec\xfa\x23\xb7\x94\xc8\xad\x0a\x0a\xd6\xf7\xb8\x95\x3a\x7e\xcd\x8d\x5b\x3d\x13\x2c\x84\x89\x96\xb4\xf8\xb5\x29\x66\x89\x89\x59\x32\x61\x2a\xc9\xc4\x84\x25\x86\xa6\x53\x76\x80\xa4\x85\xc0\x73\x2e\x1d\x11\xb4\xec\x74\x6a\x42\x29\x8a\xaa\x98\xae\x40\x9b\x07\x61\xd8\x01\x91\x32\x2a\xc6\x00\x3d\x68\x07\xa0\x1a\x3a\xc6\x69\xa7\x21\x08\x1d\xd5\xb4\xa3\x11\x04\xc5\x31\x15\x14\xa8\x8a\x09\x5d\x3a\x94\x10\x86\xd2\x60\x78\x73\xfa\x63\x86\x11\xc2\xc4\x44\x3f\x53\xc7\xe7\x34\x42\x9c\x98\x0c\xb4\xc7\x87\x69\x66\x8c\xa2\xcc\xe4\xda\x98\x28\x37\x14\x90\xce\x4c\xf4\xdb\xc7\xdb\x2e\x55\x2f\xce\x4c\x9c\xe9\xec\x38\x73\x70\xe1\x92\xc9\xd3\xb7\xeb\x11\x05\xa4\x32\x93\x81\x16\x8c\x17\x99\x99\x0b\x52\x93\xd1\xc1\x40\xc9\xc2\xa6\x70\xc9\xe4\x71\x5b\xa3\xcd\x4a\xa7\x26\xfa\x6c\x7e\xa8\x51\xf5\xe2\xd4\x64\x60\x44\xb7\x8b\x53\x0a\x28\xc9\x4d\xbe\xed\x8c\x12\x0a\x47\xe7\x26\x03\xdd\xb9\x0d\xbe\xe7\x00\x3e\x39\xd1\xbf\x1b\xe7\x84\x6e\xf2\xe4\xc4\xd8\x6f\x5f\x37\x69\xa0\x38\x39\xd1\x4f\x0e\x7e\xa8\x14\x90\x4a\x4e\xb4\xbb\xd3\x53\x9f\xaa\x16\x26\x27\xd7\x9d\xe3\x33\x22\xfe\xe4\xb9\xc9\xe0\xfc\xf2\x94\xc2\xd1\xb9\xc9\xe1\xf7\x19\x11\x73\xf2\x35\x93\x93\xf9\x8f\x0e\x85\x13\x27\x27\xfa\xd3\x81\x32\xa0\x70\xf4\xa2\xc9\xce\xcc\xa7\xd9\x49\x92\x93\xe3\xb6\xc2\x18\x53\x94\x9c\x68\xb7\x27\xd6\x03\x05\xa3\x93\x13\xe3\xe4\x61\x94\xf5\x84\xa2\xe4\x24\xde\x1f\xfa\x0f\x2c\xb0\x38\x37\x19\xbb\x77\x1a\xdb\xa2\x30\x37\x31\x1e\xa3\xaf\x36\xd3\x42\x90\x9b\x3c\x3a\x3b\xf3\x1f\x0c\xac\x38\x37\x39\xfe\x61\x7c\x66\x1a\x14\xae\x99\x68\x9f\x3a\xaa\xc7\x34\x10\x25\x27\xc6\xd8\x88\x06\x0c\xae\x30\x39\xd1\xbf\xdc\x9c\xb2\x1a\x16\x25\x27\x57\xee\xf0\x69\xca\xe0\x05\xb9\xc9\xd5\x43\x77\x36\x61\x60\x45\xa9\xc9\xf6\x79\xb8\xef\x30\xf8\x82\xcc\x24\xbc\xda\xb5\x8f\x18\x78\x85\xc4\x24\x7b\x45\x2c\x7a\x7b\xca\xbe\x07\x15\xbc\xd3\xe4\xdf\x4f\xf2\xaf\x1a\xf9\x97\x86\xdc\xeb\x3f\xfe\x45\x1e\xf7\x4a\x4e\xf0\x6e\x4d\xf8\x92\x8c\x79\xd7\x45\xe7\x26\x4c\x25\xb2\x01\xca\xec\x69\x62\x68\x42\x65\x3a\x0c\x23\x04\x9e\x75\x99\x3e\x42\xc9\x8e\x30\xb0\x5b\xd0\x7a\xa2\x1a\xa6\x27\xd0\xc6\x41\x18\x36\xf8\x29\x93\x62\x0c\x5a\x3f\x26\xed\x8f\xaa\x98\x10\xa7\xbd\x86\x30\xec\x18\x48\xf9\x9a\xce\x4e\xa8\xc0\x40\x55\xcc\xd8\x47\x87\x13\xc2\xd0\x4a\x18\xfa\xe0\xc1\xc5\x4a\x88\xd3\x93\xa6\xbb\xfb\x40\x23\x24\xe9\x89\xde\xbd\xb3\x32\x8d\x8a\xd2\x93\x81\xf6\xa3\x7d\x1d\x52\x48\x26\x3f\xd9\x1e\x7f\xff\x4c\xd5\xcb\x56\x4e\xbe\xde\xab\x47\x14\x50\x96\xa0\x44\xfb\xc7\x4f\x14\x90\x4e\x50\xf4\xa7\xfb\x38\x33\x67\x41\x82\x12\x7f\x89\x27\x99\x47\x0a\x12\x94\x9f\xbe\x71\x60\x51\x38\x2a\x3f\xb9\x9c\x8c\xaf\xee\xa9\x6a\x49\x7a\xa2\x1f\x1e\xef\x4e\x28\xa0\x30\x3d\x39\xbd\x3c\x3b\xb7\x29\x18\x9b\x9d\x7c\xf9\x7e\x7c\x99\x03\xb8\xec\xe4\xc0\xb9\x7c\x9a\xd1\xf5\x92\xe4\xe4\xee\xc1\x18\xd2\x40\x71\x72\x62\x1c\x5d\xdd\x64\x5d\x48\x90\x9c\xb4\xee\xfc\x0b\xaa\x5a\xbc\x72\xa2\x7f\x3f\xbd\xcd\x7a\x59\x51\x76\x12\x6a\x31\x11\xc8\x6c\x76\xa2\x3b\x93\xc7\x43\xaa\x5a\x9c\x9d\x18\xd1\x6c\xde\xa5\x70\x92\xec\x24\xf8\xfc\x93\x88\x03\x2e\x3b\xf9\x32\xdf\xa1\xcd\x2d\xce\x4e\xf4\xeb\xcb\x7d\x85\xc2\x21\xab\xd3\xca\x69\xfe\xc5\x37\xa2\x0f\xb2\xd9\xc9\xfc\xf4\xec\x3a\x1b\x35\x8b\x92\x93\x81\x96\x5c\x2e\x86\x2c\xb2\x30\x3b\xd1\xd4\x03\x6d\xc6\xb6\x28\xce\x4e\x9e\xae\xce\x58\x1e\x82\xec\x64\x60\xec\x7f\x9b\x2c\x18\x5c\x61\x7a\x62\x4c\x0e\x6e\xa7\x4c\x83\xc2\xf4\x44\xd7\x7f\xfc\xfc\xcc\x34\x10\xaf\x9d\x9c\x35\x6d\x06\x57\x98\x9e\x68\x9f\x2e\x6f\x13\xa6\x41\xe1\xda\x89\xfe\xf9\xe9\xe9\x13\xd3\x40\xb4\x76\x62\xec\x8e\x17\x3e\x83\x2b\x4a\x50\x06\xc6\xe9\xfe\xcd\x1e\xd3\xa0\x20\x43\x19\x18\x37\xe7\xb7\xac\xaa\x5c\x8a\x82\x4e\x71\x28\x3a\x9c\x0b\x9d\x4f\x96\x6d\x49\x4f\xc2\xa8\xd7\x21\xf6\xa8\xa0\x4d\x80\xa0\x28\xdf\x5a\x4b\xee\x35\x05\x51\xbe\x4a\x3c\xf2\xe3\x27\x58\x44\x1d\x24\x91\xe7\x06\xec\x87\xec\xfc\x5e\x43\xea\x44\x89\x2d\x78\x8a\xc7\x56\x32\xdb\x4a\x3c\xea\x68\x89\xad\x04\x1c\x79\x20\xac\x00\xbb\x33\x85\x15\x19\x29\x47\x46\xca\x91\x91\xca\xb6\xf9\x43\xe3\x48\xf6\x1e\x33\x9f\xf7\x27\x61\x24\xf9\x24\xdc\x71\x1c\xa9\x96\xec\x21\x01\xa9\x03\x30\x21\x6c\xa7\x02\x5a\xc8\xa3\x1f\x30\xcd\x1e\xbc\xf2\x25\xdd\x9d\xcd\xd9\xc4\x0e\x03\xf0\x3d\x46\x54\x0d\x0d\x41\x34\xa2\x84\x7c\xa1\x2c\xce\x5a\xb2\x38\xeb\xca\x92\x9d\x82\x00\x62\x9a\x3e\xd9\x24\x75\xf9\x07\xf8\x6f\x12\x55\x60\xd7\x1a\xf9\x87\xe0\x43\x96\xd1\x68\x04\x06\x94\xe0\x97\xb2\x87\x0e\x2c\xb1\xc3\xa9\x03\x4f\x44\x24\xdd\x5b\x97\x62\xc8\x78\x96\x61\xc8\xd0\x2e\xe5\xe5\x54\xe0\xe5\x54\xe0\x95\xc7\x3e\xda\x80\x2a\x38\x94\x05\x19\x91\x0b\x72\x21\x98\xb1\x87\x18\x42\x99\x43\x0c\xa1\xac\x51\xc2\xc8\x29\x67\xe4\x94\x33\x7a\x05\x3d\x73\x09\x97\x54\x1f\x47\x83\x24\xf8\x8e\xb7\x31\xd0\x1f\xe3\x2f\xdf\x51\x53\x70\xaa\x73\x44\x08\xde\x9b\x26\x9e\x12\x8e\x94\xe4\x31\x72\xdf\x85\x8e\xf3\x5e\x14\x9c\xdd\x51\x77\x04\xbf\xb2\x3a\x75\xcc\x5f\x88\x10\xfc\x56\x2b\x27\x03\xff\x14\xb5\x35\x47\xe6\xc8\x5c\xc1\x36\x35\x3b\x0c\xfe\xb6\x03\x2b\x8e\xff\xfc\xeb\x0d\x98\x7c\xde\xfc\xe6\x8e\x87\x48\x1f\x12\xc1\xb7\x4f\x7d\xfc\xfd\x06\x8e\xad\x60\x3e\x99\x22\x42\x89\xc3\xd0\xa9\xa3\x72\x6f\x73\xfa\x6e\x10\x30\xbd\x3c\x37\x2e\x58\x06\xbe\xbf\xbe\x56\xf0\x99\x17\x14\x0c\x99\xa0\x0c\x96\x87\x41\x21\x2c\x63\xea\x55\x63\xea\x55\x63\xea\x55\x61\x4a\x63\xf8\x41\x13\x31\x2d\x83\x21\xa6\x65\x30\x09\x53\xaf\x1a\x53\xaf\x1a\x53\xe1\x11\x52\x69\x4c\x16\xc5\x33\xe7\x25\xf4\x6d\x62\x71\x1b\x6f\xed\x36\xa2\x06\x68\x2c\x91\xb6\x41\x28\xba\xe9\x06\x5c\x44\xa6\x71\x3b\xe0\xa7\xa8\x1f\x7c\x1f\xef\xe9\xf8\xb3\x57\xb1\xa7\x4a\x71\xc8\x55\xa5\xb8\x8c\xaf\x57\x91\xaf\x57\x91\xaf\x57\x89\x2f\x03\xe2\x03\x18\xf1\x2d\xc5\x21\xbe\xa5\x38\x19\x5f\x79\x8f\x28\xc6\xc9\xf8\x7a\xf2\x6f\x9e\x8b\xa2\x88\xf7\x57\xe5\x4e\xb1\x66\x23\x61\x8b\xea\xdd\x82\x6c\xbb\x09\x1f\xa1\x7d\x54\xb7\x6b\xb7\x8a\x3a\xc6\x41\x72\x60\xc2\x6f\xb6\xc5\xde\x2a\x06\x21\x57\x15\x83\x32\x76\x5e\x15\x76\x5e\x15\x76\x5e\x39\x3b\x12\xc1\xc7\x2c\x62\x57\x0c\x42\xec\x8a\x41\x42\x76\xf2\xd0\x2f\x00\x09\xd9\x09\x9d\x9a\x7e\x74\x5f\x14\x22\x8c\x47\x2a\x47\xfc\x3a\x2d\x78\x78\xf5\x58\xcf\x1a\xae\xcd\x41\x64\x10\xbb\xe9\x1a\x23\x43\x1e\xe5\x87\xb3\xb3\xe3\x0e\x3e\x0b\x40\xec\x9a\x32\x18\x72\x4e\x19\x2c\x63\xea\x55\x63\xea\x55\x63\xea\x55\x61\x4a\x63\xf8\x48\x45\x4c\xcb\x60\x88\x69\x19\x4c\xc2\x54\x16\xf9\x25\x30\x09\x53\x4f\x7e\xfe\x43\x51\xdc\x70\x5e\xaa\x18\xff\xeb\xb6\x11\x31\xa9\xda\x07\xa8\xa6\x1b\x70\x11\x9a\xc6\x1a\xe9\xb6\x2d\xef\x07\xdb\x0f\xdb\xe3\x6f\xe8\x70\x0a\xb1\x9f\x4a\x50\xc8\x4d\x25\xa8\x8c\xa3\x57\x89\xa3\x57\x89\xa3\x57\x81\x23\x05\xe1\x43\x16\x71\x2c\x41\x21\x8e\x25\x28\x31\x47\x59\xf4\x17\xa3\xc4\x1c\x85\x0e\x4e\xcf\x20\x29\x8a\x16\xd6\x37\x15\x43\x7f\xcd\x26\x02\x16\x55\x03\x9f\x6c\xb9\x3e\x0f\x91\x55\xdc\xa1\x6d\x83\xb0\x1f\x18\xe1\xde\xe2\x06\xd1\x22\x0e\x15\xc5\x67\x63\x28\x0b\xf4\x11\x72\xfe\xad\xa2\xda\x50\xb5\xb7\xf8\xad\x76\x7a\x97\x22\xbc\x71\xe4\x5d\xbe\x62\xdb\x6e\xb5\xd3\xf7\xdb\x12\xea\xd2\x75\x5d\xf8\xd9\x63\xc6\xfb\x11\x1f\xd7\x01\xee\xde\xc8\x4a\xe1\x71\x2a\x3d\x50\x04\x24\xf3\x7c\xb8\xfc\x1c\xdb\xb3\x10\xdc\x20\x3c\xeb\x4b\x17\x5e\x84\xa2\x6c\x91\x61\xb5\xa4\x85\x51\xab\xb5\x62\x57\x6f\xaa\xb4\x40\xee\x5a\xa3\x05\x0a\xf8\x35\x5a\x64\x52\x39\x6b\x4b\xe5\xac\x2d\x95\x23\x39\xad\x4c\x42\x44\xb8\xc6\x85\xd7\xcc\xd4\x75\x1b\x92\x1e\x20\x97\x76\xeb\x6b\xd3\xc9\xfd\xf2\x3c\x3a\xb9\xb7\x9e\x47\x27\xf7\xe1\x0b\xe9\xe5\xbc\x90\x5e\x14\x1d\xbc\x20\x89\x5e\x27\x6d\xae\xa5\x47\x9e\x1c\xb3\xb9\x92\xcf\x23\x43\xf8\x2e\xb0\x5e\xc4\x75\x2f\xa2\x94\xf3\x32\x4a\x91\x64\xb0\xdf\xd2\x8d\x03\xea\x6a\x53\xe1\x08\x92\xc5\xe3\x53\xb1\x70\xcf\x25\x23\x92\xc6\x79\x19\x69\x98\x35\xf7\x9e\xba\x22\x4f\xe1\x17\x1f\xb0\xc5\x4c\x26\x7d\xc1\x49\xb4\xc4\xbb\xd0\xf4\xbc\x48\xf6\x22\xa0\xf4\xe4\xef\xf4\xee\xa9\x70\x6e\x7b\x70\x5d\x2f\x9c\xf6\x26\xd6\xd4\x8f\xe6\xe0\x1c\x81\x70\xda\x97\xd7\xd0\xc7\x26\x93\xa7\xee\xf9\x13\x6b\x8c\x4e\x11\xe3\x26\x4c\xe2\xb5\xaf\xe0\x44\x2c\xfc\xc6\x17\x1c\x16\x5e\xd3\xf4\x68\x51\xf1\x58\x78\xf9\x91\xdf\xf3\x18\xdc\xce\x01\xaf\xda\x49\x25\x82\x67\xe2\x0b\x4a\x63\x1e\xca\x16\x90\x6e\x49\x8f\x6c\xaf\x93\x25\x68\x59\x94\xab\x60\x96\x4d\x45\xf5\xee\xd1\xf6\x8e\xc2\x55\xa0\xb3\xb1\xa5\xf5\x34\x61\x0a\xf6\x0a\x67\xc9\x93\x82\x65\x09\x69\x56\x22\x12\x8e\x10\x07\x65\x86\xe0\xdc\x7e\xe1\xd1\xf9\x24\xa9\x7c\x25\x5a\xac\x68\x7e\x75\x97\x24\xe8\x04\xb7\x98\xf8\xd3\xd8\x4d\x6a\x6a\xcd\x88\x16\x35\x70\x80\x15\x79\x71\x41\x43\xd3\xcd\xf7\xfd\x75\xc0\x64\x20\x34\x06\xfa\xcf\x93\x89\xad\xe0\x4b\x92\x48\xb9\x89\xeb\x94\xf0\xf5\x36\x79\x51\x8d\x00\xe2\x33\xc8\xa7\x21\xd8\xbe\x00\x8e\x58\x75\xfa\x21\xb8\x52\x26\x79\xec\x35\x5a\x66\x7f\xe4\x07\xf0\x54\xa8\x20\xf2\xac\x77\xa8\xe2\xaf\x96\xf9\x5e\xa4\x2a\xea\x77\xd4\xdf\x2b\x8b\x60\xc6\x49\x2c\x90\x8d\xc4\x2f\x51\x17\x57\x5c\x70\x89\x76\x8c\x1d\xd6\x56\x9a\xae\x03\xea\x15\x74\x1d\xd4\xb2\xec\x72\x06\xdc\x4d\x51\x49\xfa\x8c\xc0\x91\xc9\x7a\x16\x57\x23\x0d\x29\x9e\x9b\xdb\x02\x3f\x0c\xc3\x8e\x0d\x7e\x84\x3c\x61\x40\x6f\x40\xd2\x72\xc0\x8f\x90\x64\x16\xc6\x5c\x15\x1d\xce\xf5\x86\x1d\x0c\xa6\xb7\x4a\x18\xb9\x53\xb0\x14\x77\x38\xbd\xde\x85\xd7\xac\x38\xe1\x03\x38\x2f\x72\x3c\x0e\x5c\x01\x91\xd7\x10\x36\xeb\xd7\x25\x22\x63\xdc\x46\x82\xcb\x99\x60\x29\x8a\x02\x80\x94\x02\xe1\x36\x93\x42\xca\x04\x49\x41\x05\x5b\xb9\x30\x0c\x7c\x23\x99\x28\x1a\xc5\xfe\x75\x9a\xe0\xa7\x7a\x7c\xbf\x76\x30\x0a\x07\x62\x91\x24\xec\xe0\x23\x8f\x86\x7c\x34\x42\x18\xe1\x10\xca\x35\x93\x13\xe4\x58\x4b\x5d\x4b\xb0\x4e\x31\x15\x59\x4b\x09\xb2\xac\x99\x68\x29\x92\x80\x86\x56\x13\x84\x6a\xb3\x7c\xc6\x58\x0c\xbe\x07\xb8\xba\x32\x9e\x94\xa1\xe5\x8c\xdd\xb2\x43\x96\xc1\x01\xc5\xda\xc5\xf0\xe4\x08\x92\x40\x27\x29\x97\x35\x02\x27\x33\x33\xc2\xe8\x6e\xcb\xb1\x9a\x22\x62\x59\xaf\xe5\x6a\x68\x95\x0b\x79\xa6\x47\x38\x33\x3c\x35\x5d\x1f\x36\x55\x21\x4f\x6a\x6e\x58\x83\xa4\xae\x36\x9d\xb6\x58\x8d\xac\x3b\x72\x55\x9b\x74\x47\x8e\xc8\x6b\x08\x9b\xf5\xab\x12\x91\x31\x6e\x23\xc1\xe5\x4c\xb0\x14\x45\x01\x40\x4a\x81\x70\x9b\x49\x21\x65\x82\xa4\xa0\x82\xad\x5c\x18\x06\xbe\x91\x4c\x14\x8d\x12\xff\x42\x57\x56\x8f\xef\xd7\x0e\x46\xd9\xdc\x20\x62\x47\x0f\xd0\xd2\x68\xc8\x87\x3f\x84\x11\x8e\x8b\x5c\x33\x39\x41\x8e\xb5\xd4\xb5\xd5\xe6\x86\x35\x62\x8a\x65\xcd\x44\x4b\x91\x04\x34\xb4\x9a\x20\x65\x73\xc3\x9a\x23\xb2\x78\x86\xc0\x44\x38\xea\x68\x83\xea\x89\x7a\x9b\x40\xa1\xd0\x96\x89\xe2\x80\x36\xed\x61\xc7\xb4\x19\x91\x9a\xb6\xe5\x36\x6d\x11\xb1\xdc\xd4\x6c\x0d\xad\x7b\x21\xcf\x66\xb3\xeb\x34\xd9\x04\x4b\x37\xcd\x96\x6e\x0a\x79\x56\x98\x24\x84\x24\x8d\x6e\xa7\x69\x74\x85\x24\xf3\x7e\xc9\x56\x6d\xd4\x2f\x59\x22\xaf\x21\x6c\xde\xc1\x8a\x45\xc6\xb8\x8d\x04\x97\x33\xc1\x52\x14\x05\x00\x29\x05\xc2\x6d\x26\x85\x94\x09\x92\x82\x0a\xb6\x72\x61\x18\xf8\x46\x32\x51\x34\x8a\xfd\x9b\xc6\x5d\xf5\xf8\x7e\xed\x60\x94\x4e\x12\x02\x76\xf4\x48\x2d\x8d\x86\x7c\x1c\x44\x18\xf1\x00\xc9\x36\x93\x13\xe4\x58\x4b\x5d\x5b\x71\x92\xa8\x1e\x53\x2c\x6b\x26\x5a\x8a\x24\xa0\xa1\xd5\x04\x29\x9b\x24\xd6\x1c\x91\xc5\x93\x04\x26\xc2\x51\x4f\x27\x09\xdd\x7c\x7c\xd0\xa1\x50\xe4\x7d\x38\xe2\x68\x36\x87\xb6\xea\x64\x97\x31\xa3\xc2\x66\x6b\xd8\x71\x2c\x8e\x52\x66\x64\xba\x98\x56\xb9\x90\x9b\xa1\x0d\x55\xc7\x64\xb8\x69\xc3\x96\xd3\x31\x79\x6e\x15\x26\x06\x21\x3d\xbd\xd5\xb5\x86\x36\x4f\x2f\xeb\x88\x74\xf9\x26\xbd\x90\xa6\xf0\xe2\x32\x66\x1d\xa9\x48\x52\x0c\x5a\x5f\x5e\x09\x79\xcc\x5c\xea\x65\x92\x39\x02\x6d\xc0\x5c\x4c\x1e\x31\xa7\x62\xa9\x44\x06\x06\xbb\xbe\x28\x14\x81\x62\x27\xa6\x31\x55\x31\x70\x5f\x2f\xd0\x64\x63\x3d\xc7\x88\x1e\x6d\xc5\xfe\xce\x07\x32\x04\x10\x8e\x70\x74\x1b\x09\x29\x8e\xa3\xd8\x7f\xd5\x06\x77\xba\x8d\x84\x14\xcb\x91\x09\x06\x29\x63\x1a\x57\x81\x7f\xe9\x80\xbe\xc6\x00\x2a\x19\xcd\x11\x05\x8e\x34\x1c\xcd\x95\x64\xfc\xe5\x3b\xa4\x80\xb6\xcd\x15\x07\xea\x48\xb5\x9c\x26\x2b\x8e\xeb\x5a\xba\xd1\x12\xd0\xc2\xc6\xe5\x2a\x68\xad\x0b\x39\xba\x76\xb7\xad\xb1\xab\x62\xdd\x8e\x39\x52\x1d\x11\xc7\x0a\xa3\xba\x90\xa2\x63\x76\x4c\x4d\x17\x51\xc4\xfd\x89\xab\xd9\xa0\xc3\x71\x34\x5e\x41\x52\xdc\x87\x4a\xe4\xc5\xb0\x4d\xa4\x96\xb2\xc0\x22\x14\xf8\x9d\x14\x01\xc1\x36\x12\x41\xc6\x02\x89\x40\x45\x58\xa9\x24\x0c\x7a\x13\x81\x28\x12\xc5\x6e\x4d\x63\xad\x72\x48\xbf\x6e\x00\x4a\xc6\x7c\x11\x33\x7a\x0c\x96\xc5\x40\x3e\xd8\x21\x88\x68\x14\xe4\x5a\x49\xc9\x71\x7c\x65\x1e\xad\x34\xfa\x73\xad\xa4\xe4\x58\xbe\x4c\x88\x14\xb0\xa7\x91\x95\xa4\x28\x9b\x05\xd6\x1b\x76\xc5\xf3\x00\xa6\xc1\x13\x07\xf3\xc0\x40\x1b\x7d\xbf\x1c\x42\x2a\xe8\x62\xbf\xe2\x10\xee\x9a\x46\x93\x1b\x99\x9a\xc6\xc8\xb0\x04\xb4\xb0\x91\xb9\x0a\x5a\xef\x42\x8e\x76\xd7\x50\x75\x36\x45\x6a\xb7\x34\x5b\xeb\x8a\x38\x56\x98\x07\x84\x14\x2d\x5b\xef\xea\xa6\x88\x22\xee\x65\x5c\xcd\x06\xdd\x90\xa3\xf1\x0a\x92\xe2\x1e\x55\x22\x2f\x86\x6d\x22\xb5\x94\x05\x16\xa1\xc0\xef\xa4\x08\x08\xb6\x91\x08\x32\x16\x48\x04\x2a\xc2\x4a\x25\x61\xd0\x9b\x08\x44\x91\x28\x76\x6b\x1a\x6b\x95\x43\xfa\x75\x03\x50\x32\x0f\x88\x98\xd1\xe3\xb1\x2c\x06\xf2\x01\x0f\x41\x44\x23\x21\xd7\x4a\x4a\x8e\xe3\x2b\xf3\x68\xa5\x79\x80\x6b\x25\x25\xc7\xf2\x65\x42\xa4\x80\x3d\x8d\xac\x24\x45\xd9\x3c\xb0\xde\xb0\x2b\x9e\x07\x30\x0d\xd9\xea\xce\xad\x79\xff\x13\x8a\x14\xf8\xd3\x5b\xe6\xbd\x81\x60\x37\x23\xbd\xc3\x4f\xe5\x28\xd4\xd9\x02\xd4\x31\xb9\x72\x36\x8a\x99\xea\xdc\x6e\x62\x53\xd2\xe8\xc2\x63\x6d\x2a\x6f\xce\x62\xa9\x72\x42\x65\xd1\xca\x14\xe3\xe0\x60\x8a\xd1\x36\x39\xca\x7d\x84\x5c\xab\xb5\xc8\x63\xd7\xe8\x86\xa9\xb7\x6d\x6e\xf7\xde\x7c\xea\xb8\x33\xb0\x1b\xaf\x5f\x64\x8a\x95\xdc\xcc\x88\x79\x05\x63\x4b\xc4\xac\xd6\x11\x8b\x54\x6b\xb7\xdb\x9c\x5e\xc8\x31\xcd\xeb\xce\xdd\x41\xca\x6a\x0c\x38\x77\xb7\x1f\x7f\xc0\x3f\x41\x87\x89\x94\x60\xbc\x45\x6d\x94\x43\xbb\x57\xe1\x75\xa8\xf9\xed\xf5\xd9\x35\xfa\xcc\xf6\x55\x74\x92\x12\x13\xdb\xe0\x16\xff\xc6\xc0\x50\xbf\xfb\x11\x20\xaa\xc4\x13\xc0\xd8\xdd\xfd\xbe\x4d\x30\x8e\x27\x42\xc6\x60\x6b\xa3\x96\x5f\x95\x88\x2f\xe1\x67\xf8\x9a\x0c\x47\x03\x72\xd4\x6f\xb7\xef\x77\x01\x35\x65\x01\x62\x4a\xfb\xa4\x9f\x2f\x08\x8e\x8b\x58\xac\x6a\xba\x47\x72\x13\x86\xc6\xc4\xb7\xd3\x71\x04\xee\x69\x66\x2e\x5a\xcd\x3f\xe3\xe1\xa1\x1f\xf8\xc6\x68\x43\x34\x38\x3f\x07\x1c\x10\xe3\x4f\xa3\x79\xf2\x77\xf2\x18\xb9\x7f\xbd\x89\xe7\xc3\x89\x9f\xbc\xf9\xcd\x35\xaa\x93\xa8\x99\x1b\xbb\xa5\xa0\xe1\x3c\x49\xc2\xa9\x00\x85\x8f\x4c\x44\xa7\x1c\x3e\xce\x8e\xbf\xe7\xb6\xab\x37\xdc\x6f\x87\x8f\x87\x84\x31\xf1\x4e\x6c\xc1\xc5\x6d\xc2\xed\xde\xc2\x9d\xdb\x3c\x23\xd2\x43\x72\xa6\xb4\x1f\x39\x01\xe8\xf3\x35\xe5\x0c\x70\x77\xac\xc2\x26\xef\xba\x05\xf4\xd0\x68\x56\x89\x5e\x36\xf2\x15\xd0\xc3\x23\x7c\x25\x82\xf9\x74\x20\xa7\xc8\x4e\x1d\x55\x08\xd3\x6d\x96\x4f\x8a\x3f\x75\xdc\x45\x4f\xe7\x6d\x4b\xee\x07\xfe\x40\xfc\x5e\x2f\x43\x32\xb5\x42\x3c\x53\x52\x4a\x9f\xc7\x33\x25\xcc\xbd\x9f\x70\x04\xd1\xce\xaf\x5a\xe9\x7b\x9f\x24\x0c\xc1\xf7\x72\x34\xc8\x14\x83\x48\x6d\xea\xe2\x7a\x86\xb7\x0c\x15\x8c\xa3\x5f\x0a\xec\xd3\x48\x46\x2a\x94\xb9\x26\x5b\x25\x8c\xb7\x2a\x31\xde\x12\x31\x26\xf5\x46\x77\xa7\xd3\x94\x48\xde\xbd\x69\x98\xbc\x23\x3f\x79\x7a\x9f\x96\xe4\xdf\x88\xa4\x05\x92\x24\xfb\xfd\x52\x90\x1b\x15\x70\x23\xbf\xad\x22\xe5\x5c\xa3\xdd\x66\xf2\xa1\x23\xa5\xe0\x07\x41\x99\xb0\x78\x66\x48\xc2\x88\xa9\x29\x11\x27\xe7\xce\xdb\x8f\x0f\x69\xd9\x13\x0a\xdf\x96\x91\x16\x04\x8f\x50\x58\xaa\x42\x28\x2b\x53\xc2\x5c\xaa\x50\x06\xaf\x10\x15\xa4\x3d\xb0\xd8\xeb\x48\x54\xe8\x52\x89\xb1\xeb\x2f\x40\x57\xe8\x89\x97\x8c\x12\x56\x9e\x9c\x3d\x6f\x55\x4a\x4f\xa2\xe2\x85\xe2\xa0\x26\x0b\x3c\xd9\x84\x43\x3c\x56\xcb\xda\xe6\x1f\xc0\x08\xb5\xcf\xd4\xf9\x20\x6b\x8f\x92\x37\x74\xa4\x27\x77\x19\x6c\x47\x32\x62\x91\xc9\x70\x35\xda\x9a\xce\x11\xd7\x74\x11\xf5\x2a\x5a\xff\x4f\x7c\xd6\xb3\xbe\x58\xec\xa3\xc5\xb2\xfa\x53\x5f\xe6\xa8\x5a\xe3\x72\x74\x75\x76\xad\xd8\xd6\xcc\x4d\xb8\x41\x99\x34\x3b\x8d\x44\x61\x88\x0e\x0b\x47\x6a\xa9\x7d\x3a\x72\xd3\x5a\x75\xd5\x58\x9c\x1e\x37\xaf\xa0\xf8\x70\x8a\xaf\x48\x56\x85\x44\xe1\x24\x56\x25\xdf\x29\xcc\x8a\x18\xeb\xae\x83\xdd\x12\x7d\x12\x09\x53\x6f\xf2\x2c\x3e\xe9\x11\xaf\xab\x4d\x59\xe5\xc4\xab\xe9\x4f\x67\x56\x15\x5b\x3c\xc3\x2e\x95\xf9\xf1\xed\x98\x12\x1c\x77\xe0\x11\x4a\xd1\xf2\x7b\xdc\x71\x1c\x56\xd1\xa5\xc2\xa4\x85\xa3\x2b\x1f\x32\x2b\x11\x2e\x9a\x52\x96\xb2\xd9\x01\x7c\x08\x2a\x19\xaa\x89\x2a\xd4\x4f\xe8\x79\x85\xa9\x64\x46\xf9\x4a\x22\x17\xcd\x3a\x52\x91\x55\x99\xc0\x6a\x91\xb8\xbc\x36\x64\xd3\x66\x49\xe7\x65\x02\xa1\x82\x13\x49\x3d\xb1\x2a\x95\xac\xc3\xb2\x2a\x72\xab\xc4\x98\xf5\x17\xa4\x2f\x9e\x68\x0a\x0c\xad\x16\x98\x79\x3d\xcd\x8b\xa2\x83\xd2\x9c\xa8\xd8\x20\x6a\x56\x8d\xf1\xd3\x71\xd7\x26\x64\xba\x99\xc7\x89\x3f\xf2\x5d\x27\x1b\x46\xe1\xd7\xeb\xe4\xd8\x09\x0b\x94\xc0\x7a\x0c\xe7\x49\x6f\xe4\x2f\x5c\x07\xd3\xb7\xc3\x20\xb0\xa2\xd8\xed\xc5\x6e\x64\xcd\xac\xc4\x2d\xa0\x4f\x6a\x51\x2f\x84\x89\x33\x65\xc9\xe9\xaa\x58\xd0\xb7\xab\x75\x68\x92\x4f\x99\xd4\x62\xcd\x7a\x34\x4c\x6b\xaf\xfb\x90\x07\xcc\xc4\x9d\xce\x89\xb3\xf3\xff\x76\xac\xc4\x42\x51\x84\x17\x8b\xe2\x37\xbf\x49\x43\xd4\xa8\x35\x27\xcb\xf1\xc3\x37\xbf\xeb\xf2\x76\x8c\x04\xcf\xa0\x24\x6e\x67\x7b\xae\x7d\x3b\x0c\x17\x2f\x22\x44\x4e\x2c\x5f\x56\xb2\x86\x71\x18\xcc\x13\xb7\x6f\x07\x7e\xd4\x9b\xb9\x76\xf2\x4e\xad\xd7\xd0\x3f\xef\xfb\xe2\x4f\x8b\xb5\xce\x85\x71\xac\x4c\xad\xfb\x25\x7b\xce\x01\x95\x5d\xaa\xfd\xc0\x8f\x13\x74\x66\x0f\xdb\x74\x2b\xf0\x05\x8b\x5b\x38\x9c\x60\xe6\xc0\xc0\xb7\xac\xb2\x06\x38\x95\xed\x81\x35\xd6\x9a\x86\x16\x34\x28\x12\xd9\x4a\x17\x4d\x19\x2d\x38\x8b\x56\x99\x05\xaf\x48\x5c\x97\x55\x85\x7d\x3d\xb4\x65\x11\x8b\xd7\xa5\x58\xa1\x50\x02\x58\xa5\x55\xf1\xc2\x85\xfe\x3e\xff\x21\x3b\x29\x5d\x8d\x48\xa8\xb7\xac\xba\xb4\x86\x17\x98\xa9\x97\xbd\xb9\x72\x5d\x76\xfb\x42\xfa\x4a\x89\x16\x62\x60\xdc\x3c\x78\x2e\xf8\x5d\x71\xfc\x7b\xdf\x71\x67\x4b\xbc\x56\x9d\xe5\x3d\xbd\x2e\xcc\xa5\xf1\xc9\x50\xf8\xb4\x28\x01\x4b\xd3\x35\x5d\x93\x64\x00\x03\x61\xcb\x9f\x8c\x97\x79\x12\x8a\xc2\x53\xb7\xfd\xeb\x13\xc8\x38\xb1\x86\x31\x3d\xd3\xf0\xc7\x4a\xd1\x68\x10\xd1\xf9\x1a\x42\x9f\xee\x1a\x68\x09\x8e\x6b\xb1\x65\xe1\x3e\x84\x2e\x8c\x29\x3b\x44\x43\x72\x56\x07\x92\x14\x4d\x2b\xcd\x68\x51\x03\xff\x57\x6b\xaa\x98\x29\xda\x20\x47\xbb\xc2\x75\xdd\x5a\xfa\x2f\x89\x7a\xf4\x62\x29\x0c\x90\x52\x4c\x16\x2a\xe5\x48\x2a\xbc\x4d\xd3\xec\x17\x7d\x19\xcb\x78\xa3\x4f\xb9\x4a\x1e\xf8\xe8\xc3\x59\x4e\xbb\xc6\xc0\x98\x1e\x7d\xfd\x04\xff\xce\xa6\x18\x62\x22\xa2\xe9\xf7\xd4\x6a\x04\xf2\x90\x10\xc6\x97\xac\xcd\x96\xb5\xe4\x4f\x87\xa1\xe3\x09\xad\x5a\x56\x20\x07\xcf\xc0\xb9\xd8\xc9\x26\x45\xc9\x2c\x09\x1e\x2b\xc0\x24\xd9\xcf\xa7\x4b\xf9\x6d\xef\x15\xf5\x58\x16\x66\x07\x15\x89\xe4\x3d\x24\xb3\xfd\x06\x6d\xd3\xde\xa5\xf2\xfd\xa4\x22\xad\xd2\xd0\xaf\xd6\x4c\xd2\x1b\x2a\x36\x46\xa3\xaa\x28\xfe\x9f\xef\xac\x2d\xab\x70\xb8\x5b\x63\x84\xf9\x5f\x62\x41\x7a\xac\x00\x43\x0b\x3c\xd8\xc9\x37\x6f\x2f\xa1\x0c\x91\x1f\x04\xcc\xa0\x2e\xac\xcf\x0d\x47\x45\x15\x0f\xfc\x10\xf8\x38\x1c\x61\x27\xd3\x25\x38\x56\xea\x7a\x15\x50\x66\x97\x0a\xd0\x0a\x9b\x92\xb2\x69\xd9\x88\x46\x17\xbf\x20\xe7\x38\xb1\xec\x5b\xc1\x98\x26\x42\x10\xaa\xa2\x2b\x53\xd8\xe5\x8a\x92\xf1\x56\x0c\xe0\x79\x3f\x6f\xfc\xfc\x1f\x1b\x2f\x37\x1a\x1f\xcb\xc6\x34\xa4\x8a\x0a\xc2\x56\xeb\xc4\xa7\x47\x59\x6f\xc8\x1b\xd0\xb1\xde\x53\x0b\xa0\x95\xc7\x4b\x29\x01\x36\xd0\xea\x6b\x60\xb3\xf8\xad\xde\x62\xc3\xe1\x50\xca\xe0\x79\x63\xe0\xff\x8f\x56\x11\x0e\x71\x5f\xc6\xc3\x93\x1d\xe0\x0e\x70\xd9\x4f\xe2\x4e\x13\x40\xe3\x4e\xbb\xf8\x02\xcb\x22\x6b\xea\x66\x71\x99\xf6\x32\x61\x03\x82\xe9\x92\x7d\x6a\xa3\x87\x66\x49\x07\x62\x97\x33\x37\x59\x3b\x99\x6a\xe6\xf1\x54\x89\xac\xb1\x3f\x85\x0f\x6c\x4b\xe1\xee\x0c\xe6\xa1\x14\x3d\x3f\x80\x6b\xc4\x6a\xc2\x00\x67\xa9\x92\x3d\x35\xdd\xf6\x21\xc4\x80\x78\x17\x15\xc7\x91\x55\xbc\x8f\x83\x3f\x55\x4f\xf2\x04\x20\x7c\xdc\xa3\x06\xec\xb5\x52\x66\x7e\x8b\x80\x40\x7c\x72\xe1\x4b\xa2\x21\x05\x81\xda\x92\x94\xd5\x3e\x1d\x8b\x92\x55\x5e\xd6\xbb\x32\x4f\x10\x0b\x73\x32\x71\x08\x04\x94\x86\xe6\x2f\x5b\x98\xe5\x02\x4f\x26\x41\xde\x2b\x45\x95\x80\x63\x51\x3d\xea\xa0\x05\x8d\x61\x7d\xbe\x13\xa5\x4f\x6f\xf0\xab\xf2\x4c\x0d\x06\x40\x9e\x3e\x3b\x52\xd4\x4b\x21\x40\x9c\x72\x94\xdc\x1c\x02\x72\x55\xb1\x72\x33\x89\x88\xd2\x26\x33\xfa\x15\x52\x1b\xc6\x64\xa8\x90\x7d\x4a\x14\x71\xa7\x57\x65\xe4\x36\xe2\x71\x45\xca\x0b\xd0\x05\x16\xa0\xd1\x56\x25\x50\x75\xee\x4c\x86\x08\xf6\x5f\x16\x8d\x2b\xb8\x04\xcc\x90\xa2\xf5\xa5\x23\xf3\xa0\x39\x26\x18\x82\x6d\x99\xa0\x1b\xd5\x65\x35\x40\xfb\x0d\x37\x6b\xca\xb8\xb1\xc3\x58\x05\x94\x60\xec\x20\x07\xa8\x96\x7c\xec\x82\x5b\x44\x25\x1c\x02\xab\x82\x18\x6b\x8c\x60\xad\x82\x11\x0c\x6d\x55\x9d\x5d\xeb\x77\x24\x8f\x78\x82\xcc\x2f\xab\xa2\xec\x5f\x71\xcf\xaa\x94\x11\x6b\xf9\x2a\xb0\x12\xd3\x1b\x72\xd3\xa3\xad\xab\x62\x16\x81\x55\x45\x90\x35\x8c\x6f\x14\x18\x1f\x4a\x32\xbc\x1f\x1c\x87\x30\xa7\x0a\x98\xac\x1d\xa4\x1f\x55\x67\xeb\xc2\x85\x3e\xd1\x36\x76\xb5\x06\x1a\x6b\xec\x26\x0a\x95\xde\x70\x21\x47\x81\x1c\xee\xe1\xfe\x08\x8c\x12\x53\x37\x50\xc0\xe1\xca\x79\x7f\x44\x8f\x4e\xda\xe4\xa1\x83\xea\xc1\x71\xd7\xe0\x23\x42\x6e\xf9\xbd\x2f\xcb\xa6\x05\x1a\x89\x0c\x58\xe2\x65\x5e\x04\xd1\xd3\x9b\xf8\x25\x62\x6a\x6f\x7f\xea\xb9\x33\x1f\x6c\xf8\xd2\x34\xfd\xf4\x14\x11\x4b\xfc\x24\xbf\x10\x00\x3c\xbf\xaa\x7d\xda\x79\x2a\xd9\x19\x40\x0f\x2c\xa5\x06\x03\x8e\x2f\x8d\x27\x56\x10\x08\x6b\x1a\x03\x6d\x71\xb7\x3b\x53\xe4\x08\x58\xb3\x65\x95\xb7\xde\xb2\x78\x6d\xef\x92\xa1\x89\x5a\x80\xe3\xc1\xdd\x99\xc8\x79\x7c\x74\xc2\x2b\xf5\x08\xaf\x48\x1e\x90\x4a\xfa\x0a\xaa\xe6\x3c\x4a\xf4\x16\xa0\xc1\xfd\xf1\xe1\xa5\x02\x5f\x21\xa1\x6d\x17\x4c\x7d\x4b\x3f\xf7\x91\x0e\xf8\xbd\xa7\xa0\x19\xd3\xef\xd4\x32\x36\xb5\xc6\xcc\xfb\x75\xf4\x8d\x28\x51\xfc\xc4\x9d\x6c\xc8\x5d\x42\x0d\x0f\x2a\xe8\x69\x5f\xf0\x24\x52\x2a\x27\x39\x60\x4a\xb8\x90\x90\x0d\xe5\x5f\x93\x0b\xd6\x0b\x84\x86\xba\x71\xcf\x2e\x51\x3c\xb0\xca\x24\x0a\xac\xe7\xaa\xbd\x0e\x0f\xac\x74\x36\x38\xbc\x64\x2f\x10\x0c\x72\x1f\x2a\xab\xb1\x9e\xc7\x68\x49\x55\x99\x8b\x54\xf1\xd0\xfb\xe1\xd9\xec\xb3\x9d\x70\x1c\xa1\x0f\xc2\x11\x4b\xd4\x94\x32\x1e\x71\x5d\x09\x1b\x07\x15\x6e\x38\xa9\x16\x3a\x44\x83\x92\x91\x86\x40\xd6\xd0\xfd\xdb\x1b\x48\x25\x6b\x5a\x2a\x1d\x6e\xc8\xec\x00\x35\xf9\x1d\xa0\x26\x17\x85\x04\x19\xd2\x79\xd5\xc4\x27\x5b\x48\x49\x49\xc3\x70\xad\xb1\x42\x42\xbc\xf4\x1e\xf0\x17\x54\x64\x5d\x5e\x52\x32\x20\xe9\xaa\x4e\xe6\x79\x22\x97\xf0\x5a\xca\x1d\xd0\x2f\xf0\xdb\x0b\x39\xa7\x96\x38\xaf\xa6\xf8\xb3\x58\x6f\x6a\xce\xd7\x54\xe8\x59\xac\x37\x37\x93\x57\x40\xf5\x95\x3d\xe4\x6d\xa2\x50\xa9\x99\xbc\xff\x9c\x87\xbc\x8a\x5d\xef\x05\xbb\x57\x60\xbd\x8a\xaa\xcf\xe1\xbc\xb9\xfd\x5e\x4d\x9d\xe7\x70\xde\xdc\x46\x9e\x9c\xe8\x2b\x7b\xc7\xdb\x40\x9d\x52\x1b\x79\xff\x31\xef\x90\x9c\x97\x9b\x4d\x5b\x6b\x8b\x1e\x58\x62\xc9\x79\x49\x5e\xe2\x61\x41\xcc\x01\x99\x86\x2c\x98\xbd\x86\x26\xeb\xf1\x91\xd1\x00\xab\x11\x55\x69\x3c\x47\xd6\x42\x3e\xcb\x42\xab\xf7\x8b\x5d\xf6\x12\x4e\xd9\x2c\x45\xa8\xa2\xf7\x33\xd8\x6e\x64\xca\xd7\xd3\xe4\x19\x6c\x37\x34\xce\x26\x49\x41\x15\x4d\x9e\xc1\x76\x43\xe3\x78\xff\x19\x9f\x50\x6c\x97\xaf\x3e\xb4\x6d\x94\x0b\x54\x51\x73\x73\xae\x1b\x1a\xee\x95\xf4\xd8\x9c\xeb\x86\x96\xd9\x60\xf6\xaf\xa2\xc7\xe6\x5c\x37\xb4\x8c\xf7\x1f\xf1\x87\x27\x9f\xa2\x4a\x26\x21\xee\x75\xce\x07\x52\x86\x7a\x29\x58\xa2\x14\xd3\x90\x20\xf9\x81\xa7\x52\xcd\x4e\x82\x86\x4b\xe9\xfb\x06\xf9\x20\x81\x62\xa2\x30\x1f\xac\x3f\xa7\x75\xe6\x00\x20\x15\xbb\xd4\x27\xbc\x5d\xb4\x9a\xfe\x92\xc6\x88\x5b\x35\x4e\xd4\xad\xba\x84\xd4\xcf\x92\xa0\x32\xd1\xa2\xfb\x59\x5f\x56\xa0\x8a\x44\x8b\x6e\x7a\x7d\x59\x81\x2a\x12\x2d\xb6\xae\xf3\x82\x02\x55\x24\x5a\x6c\x5d\xe7\x35\x5c\xe6\x3c\xc3\x65\xce\x6b\xb8\x8c\x22\x8a\x3b\x37\xde\xad\xbc\x96\x03\xd7\x9f\x1c\x9e\x49\xb3\xd8\xd2\x2f\x28\x4e\x35\x9a\xc5\x76\x7e\x41\x71\xaa\xd1\x2c\x36\xac\xf3\x72\xe2\x54\xa3\x59\x6c\x58\xe7\x15\x9c\xe5\x6c\xee\x2c\xe7\x15\x9c\x45\xd2\xc4\xfd\x0c\x6d\x37\x5f\xab\xa3\x91\xfd\x75\x2b\x79\xae\x78\x15\x89\x16\x59\xfa\x65\x05\xaa\x48\xb4\xba\x32\xde\x6b\x58\xc8\xab\x26\x90\x50\x19\xef\x35\x2c\xe4\xb1\x6f\xe3\x57\x6b\x09\x18\x58\x45\xc6\xde\x4c\xbe\x62\x9a\x45\x9d\xe5\x45\xc5\xa9\x46\xb3\xb2\x26\x2f\xe5\xbd\x62\x9a\x95\x35\xf1\x5e\xc1\x3a\xe5\xb1\x24\xe4\x81\x5a\xf1\xbb\xc6\xc0\xb6\xba\x8b\xce\xe5\x19\x7a\xb6\x11\x6d\x4f\x02\xdb\x02\x45\xb0\x1a\xc9\x99\xdd\x68\x80\x17\x24\xe9\xaf\x1a\x0b\x49\x7c\x10\xd1\xc3\xa7\x73\x0a\x1b\x8b\xf6\x7c\xf0\xf6\xa9\xda\x52\xbe\x87\x85\x7f\x6c\x7c\x3e\x4d\x76\x3f\x49\xd1\x13\xa5\x90\x5b\xe1\xee\x93\x9e\x5a\xb9\x95\x44\xc4\x9a\xfc\xa1\x17\x99\x96\x97\xd2\xf1\xbf\x58\x08\x8f\xb6\xab\xe3\x26\x68\xb3\x9e\x14\x27\xde\x55\x54\x7c\xa1\x35\xbd\xf3\x6f\x33\x0e\xd5\x7d\x8e\x35\x01\xaf\x67\x36\xe3\x25\xb9\xfc\x00\x69\xc1\xa9\x07\x77\x8e\x4b\xa8\x57\xf6\x62\x81\x22\x68\x95\x06\x71\x4b\x3d\xa3\x1d\x98\x7a\x82\xb0\xe8\xb6\x4e\x0c\x67\x3f\xc2\x14\x40\x8b\x9d\xb8\xf6\x97\x0f\xeb\x30\x79\x96\x1f\xd7\x67\x27\x76\x25\xd6\x85\x53\x12\x7c\xc7\x2b\xa3\xfe\xf2\xae\xcc\xd5\x99\xec\x8e\x75\x04\x47\xd7\xea\xe1\x16\x08\xea\xb4\xdc\xae\xdd\x12\x43\x8b\xbd\x69\xd8\xed\x96\xe1\x08\x74\x75\x46\x23\xd5\xe9\xf4\x9f\xcb\xe7\x59\x0e\x5d\x9f\x9d\xd8\xa1\x58\x17\x4e\xc9\x54\x7b\x29\x83\x57\xe8\x9e\x58\x23\x7d\xf6\x73\x1b\x4d\x91\xf0\x66\x2d\x0c\x47\xb8\xa1\xed\x76\x46\x9a\x00\x57\xe2\x4d\xad\xad\x76\x44\xdd\xd3\xe9\xba\xce\xa8\xdd\x7f\x16\x93\x67\xb9\x72\x4d\x5e\x12\x3f\x22\x2d\x78\x3f\x42\xbd\xc5\xd4\x5f\xde\x89\x99\x2e\x5a\xf8\x74\xfb\x13\xc1\xd1\xcd\x38\xb8\x05\x82\x8e\x2c\x77\x68\xdb\x62\x68\xb1\x2b\x3b\x56\xcb\x31\x86\x02\x5d\x47\xf6\xa8\xe3\x1a\xfd\xe7\xf2\x79\x96\x37\xd7\x67\x27\x76\x28\xd6\x85\x53\x32\xd5\x5e\xca\xe0\xe5\x7d\x9a\x69\x64\x38\xbf\x74\x05\xc1\xf1\xc5\x25\x94\xa9\x01\xce\xd1\x84\xc8\x62\x8f\x5a\xdd\x66\xb3\xa9\x8b\x3c\xaa\x3b\xae\xe3\xf6\x9f\xc9\xe6\x59\x0e\x5d\x9b\x9b\xc4\x9f\x48\x13\x4e\xc5\x54\x77\x19\xfd\x97\x77\x67\xa6\x8f\xfe\xd3\xbc\xb0\x15\x3b\x70\xad\xd9\xc8\x5f\xf4\x86\xee\x28\x9c\xb9\x75\xae\xdc\x1a\x25\xe0\xfb\xc5\x81\xbe\xf3\x2b\x9c\x28\x4e\xa0\x78\xe1\xcc\x7f\x0a\xa7\x89\x15\xd4\x1c\x87\x68\x26\xae\x47\xcd\xf7\x46\x17\xa3\x6b\xf8\xe9\xbb\xe5\x4f\xdd\x59\xd6\x8c\x2d\xc7\xdc\xb4\xf3\xf1\xfe\x8f\xbc\x5c\x19\x05\x73\x9f\x60\x26\xae\xc6\x8d\x8d\xab\x9b\xf1\x85\x32\x0b\x1f\xf2\x06\x79\x51\xce\x81\x39\xb3\x9c\xa4\xce\x56\xa1\x46\x6b\x9c\x64\x88\x89\xad\xd1\x24\x93\x0c\x1f\x99\x45\x48\x94\x15\x61\x10\xeb\xec\x1c\xcb\xd5\xc0\x26\x4b\x74\x8a\x40\xef\x4d\xed\x0d\x7d\x7e\x20\x1f\x0a\x25\x2e\x97\xb8\xb4\xd8\x75\xbc\x6f\xe4\x8e\x58\xdf\xda\xbc\xe9\xa4\x76\x42\xd6\x00\xba\xf6\x86\x61\xe2\x81\x91\xd4\x1d\xfd\x34\x95\xf4\xc4\x27\x74\x73\x05\xb6\x10\xfc\x0b\xaf\x28\x64\x87\x8e\xe0\x82\x74\x01\x15\x1c\xe0\x04\xa8\xec\x3c\x1c\x9c\x29\xd1\x3c\x08\xd2\xcd\x49\xe8\xcc\x14\xf8\x7b\xed\x5f\xfe\x24\x0a\x67\x89\x35\x05\xdf\xa9\x19\x57\x57\x97\x87\x29\x12\x90\x44\x40\xf0\x2b\x85\x9b\xd8\xee\xaf\x6f\x8a\xe7\x3b\xf4\xd1\x10\x0c\xad\xed\xbb\x13\x70\xf2\x73\xf8\x90\x81\xa0\xcc\x34\x4a\xff\x35\xbe\xb9\x52\xfc\xe9\xbd\x1f\xfb\xe0\x9b\x09\xf8\x5f\x3f\xf0\x93\x47\x74\x84\x1b\x90\xff\xb1\x73\x39\x51\xe0\x69\x07\x90\x27\xf8\xd8\xae\xa7\x7e\x54\x6b\x16\xfa\xd0\x8e\xfc\x7c\x10\xc2\xd0\x17\x8d\xe5\xe7\xdf\xe5\xaf\x51\x07\x9a\x39\x99\x04\x40\x29\xc7\x9d\xca\xd5\x52\xbe\x3e\x1d\xde\x28\xd6\x68\xe4\x2f\xf2\x43\x1c\xe0\x99\x9b\xab\xff\x52\x26\xb1\x72\xef\xbb\x0f\x00\x8c\xce\xae\x71\xdc\x7b\xdf\x76\xd3\xef\x51\x80\x7d\x4f\x6e\x26\x0b\x05\xe9\x0a\x2f\x6e\xb9\xff\x32\xb6\xdd\xac\x04\x5e\x1e\x63\xdc\x9d\xff\xea\x66\x45\x13\xa7\xde\x48\x6e\x3b\x93\xcb\xac\x24\x18\xcb\xc5\x1b\x68\x3b\x9e\x75\x9b\x41\x17\x31\xba\x15\xa5\x31\xd0\xbf\x7d\x55\xce\xc9\x8a\xf4\xe8\x0a\x30\x64\xcd\x6e\x1e\x7e\xf1\x35\x79\x4b\x7b\x2f\xba\x51\xee\xfd\xd8\x1f\x06\xae\x12\xff\x3f\xf6\xfe\xbc\xbb\x6d\x1c\xd9\x1f\x87\xff\xef\x57\x41\xf3\xce\x55\x93\xd7\xa0\x5a\x94\xbc\x52\xcd\xf6\x49\x6c\x27\x71\x62\x27\x8e\x9d\xb5\xdd\x7e\xd4\x94\x04\x49\x8c\x29\x52\x21\x29\x2f\xb1\xf9\xde\x9f\x53\xd8\x08\x90\xa0\x97\xbe\x33\x77\xfa\xfb\x3b\x33\x7d\x26\x16\x81\xaa\x0f\x0a\x40\x55\xa1\xb0\x10\x9c\x8b\x0c\x77\xed\xdd\xf3\xb2\x36\xd9\x5c\x82\x3c\x78\xbe\x8b\xeb\x39\x25\xe7\x70\xb3\xf3\x56\xe4\xcf\xc7\x22\xa3\xfb\xe2\x6d\xfa\x45\xce\x28\x21\x8f\x76\xdf\x6a\x72\x38\xe7\xcd\x8f\xdd\xe9\xa1\xc8\x8e\xa6\x02\xd1\x7d\x36\x5e\xef\xca\x19\x02\xb1\x3b\xdb\x9d\xbf\xab\xe7\x54\xec\xac\xda\xc4\xe2\x26\x19\x71\x57\xe0\xe6\xc6\x26\xbb\xd5\xa7\xd2\xbb\xcd\x7a\x4f\xdc\xdb\x3d\x0c\x24\x5f\x61\x48\x1f\xa2\x86\x51\x45\xe1\x98\xd5\x39\x50\x3e\x7e\x10\x66\x84\xa3\x48\xc6\xb9\xaf\xc2\x7a\x65\x6b\xae\xf6\x7d\x58\x0d\xfa\x29\xc0\x68\xe7\x3c\x5a\xb2\xfb\x74\xba\x82\xe9\xdc\x23\x67\xe5\xba\x20\x23\x88\xc7\x72\x79\xdb\xdb\x2e\x29\xaf\x6a\xc5\xcd\x4d\x40\x7a\xb6\x99\x5e\xd7\xf1\xf7\x13\x6b\xfa\xbd\xca\x00\xdd\xfe\x00\x48\x73\xaf\x3f\xb2\x05\x1a\xbc\x44\x73\x43\x3c\xbd\x84\x06\x77\xf3\x28\x0d\x79\x74\x19\xf7\x39\xae\xbf\xa2\x37\xdb\xdb\x5d\x4d\x79\xae\xbb\xbd\xcd\x7c\x46\xc5\xd9\x37\x37\x18\xf7\x19\x4d\x0c\x7a\x9f\x71\x2f\xb5\xd6\x67\x54\x38\x40\x79\x1e\x82\x79\x84\xf6\x3c\xd4\x0e\xfa\x11\xa1\xb9\x35\xfe\x42\x11\x0d\x63\xcb\xd3\xf4\xe7\xc1\x7a\xdc\x37\x4c\xfd\x15\x05\x72\xbb\x9d\x0e\x81\x6e\x0c\x02\x6a\x28\xa4\x53\x9a\x83\x06\x9d\xa2\xdc\x4f\xac\xd1\x93\x2a\x03\xa8\xc9\x03\x20\x8f\xd0\x12\x51\x59\xfd\x70\xde\x5c\xe5\xfb\xb0\x9a\x42\x80\xa7\x75\x7c\x09\xf7\xb8\xb8\xe1\xe1\x2e\xd6\x8c\x5a\xdf\xa3\xb7\xaf\x59\x08\x2a\x8f\xcc\x71\xf2\xa0\x78\x0f\xf9\xb5\x8f\xa3\xe3\x2d\x8e\x9c\xcd\x9f\x82\xfc\x80\xc6\x7f\x38\x38\xbc\xd8\xe7\xc8\xf3\xf1\x53\x90\xa5\x26\xbd\xfa\x12\xec\x71\x90\x68\xda\x0c\xd2\x1e\xf4\x9e\xed\x7d\x1b\x8a\xd6\x5f\xa4\x61\x9c\x37\x92\xb3\x22\x29\xd1\xfd\xac\x8d\xee\xf6\x1e\x1e\xbd\xc7\x7d\x88\x41\xeb\x74\xeb\x4c\x60\x50\x8f\x00\xab\x19\x55\x7b\xd0\x9b\x8e\x3f\x8f\x54\xa6\x8a\x6e\x3e\xd4\x4e\x0f\x02\xd4\xb5\xba\x3d\xe8\xbe\xda\x7d\x3d\xa9\x70\x55\x0c\xed\x81\x72\x1f\x81\xa0\x31\xd5\xf6\xa0\x9b\xdc\x7c\xaa\xca\xab\xb5\xc9\x87\xca\x7f\x2c\x8e\x9c\xa8\xc8\xa2\x02\x5e\x7f\x1b\xb9\x2f\xb9\x56\xab\x9d\x57\x95\xa4\xf8\x19\x99\xe6\xb9\x8d\xf2\x76\x94\x8c\x82\x28\xf3\x6f\xe3\xe0\x12\x56\x1c\xcc\xeb\x1f\x4e\xf0\x1c\x16\x58\x86\x41\x6a\x22\xf8\xb2\x9b\x59\xde\xe7\x6f\x22\xf2\x09\x20\xcf\x94\xbe\x07\x64\x22\xb8\xdb\x66\xb9\xf0\x4c\xf9\x0b\x42\x26\x8a\x82\x21\x8e\x3c\x73\xd0\x9b\x5e\xbc\x3c\x75\xc8\x93\x89\x88\x0a\x7b\xa6\x74\xd6\xd9\x44\xa6\x7a\x16\xc2\xf4\x4c\xed\x19\x09\xc6\xfc\x9c\x3d\x36\x52\x99\xe1\x7c\x2a\x9d\x86\x00\xb8\xee\x8f\x93\x3c\x73\x2a\xe9\x28\x9c\x4f\x4f\xc4\x63\x23\x15\x85\x83\x7d\x12\x2a\x9a\xbb\xe6\x5e\x7d\x76\xe4\x44\x02\x44\x7f\xeb\xf3\x09\x44\x3e\x5b\xce\x87\x71\x10\x46\x00\xd2\x9b\x1f\x7f\x7a\xee\xa8\xc9\x00\xf3\x81\x3f\x35\xd1\x10\xa8\x51\x98\x8e\x22\x0c\x38\xee\xe2\xe5\xcb\xb9\x23\xa5\x01\xc8\x2e\xf9\xa9\xcd\x35\xb3\xd4\x49\xe2\xe8\x06\x78\xbb\x8b\xb1\xf3\xbb\xc3\x13\x50\x96\xbe\x8b\xa3\x9b\x7a\x3a\x67\x71\xc8\x9d\x6c\xd0\xd6\x84\x39\x39\xb9\x5a\x3a\xf5\x2c\x06\xf3\x82\x27\xdc\x47\x3a\x73\x3d\xf3\xc5\x68\xef\xf9\xb6\x33\x73\x4d\x34\xeb\x02\xed\x49\xbe\xbf\xe5\xcc\xba\x26\x9a\xf5\xe0\xf1\xd5\x8f\xed\xaf\xce\xac\x67\xa2\xd9\x1a\x3c\x76\x3e\xee\x77\x9c\xd9\x9a\x89\x66\xeb\xd0\x40\xe1\x74\xed\x8b\x33\x5b\x37\xd1\x6c\x03\x6a\x7b\xf0\x79\x18\x3a\xb3\x0d\x13\x91\x9b\x89\x3c\x53\xbe\x83\xc8\x44\x11\xbc\xe8\x6a\x3e\xff\x90\x1c\xc2\x6b\x8c\xc1\xd8\x44\xf3\x20\xbd\x00\xaa\xdd\x8f\x93\xc8\x81\x07\x50\x45\x58\xcd\x81\xf5\x27\xa8\xa4\x3b\xde\x9f\xbd\x74\xca\x24\x04\x3f\x0f\x61\xe9\x4b\x93\x47\x59\xc9\x1a\x17\xe1\xed\x39\x27\x87\x8e\x94\x46\x98\x4f\x20\x5b\x9b\x4b\xd9\xe9\xca\x1b\xe1\xef\xbe\xbf\xb8\x70\xe4\x44\x02\xb0\x4b\x08\xf4\xf9\x14\x82\x5e\x71\x4b\x7b\xf8\xe0\xf9\xeb\xc4\x51\x52\x09\xc8\x6b\x4a\xd2\x40\x41\x61\xe2\xe4\x2a\x0d\x16\x04\x65\x6f\xab\x17\x3b\x72\x22\x01\x79\x4b\x08\xf4\xf9\xac\x19\x93\x2b\x9c\x8e\x82\x8c\x68\x6a\x6f\x71\x70\xf8\xd9\xa9\xa4\x13\xa0\x43\xfe\xd8\x48\x45\xe1\x96\x8b\x05\x4b\xf0\xcc\xfd\x57\x2f\xc7\x2f\x9c\x4a\x32\x41\xfb\xc8\x1f\x9b\x88\x58\x43\x07\x8b\x30\x0f\xa2\xf0\x07\x08\xf7\xf6\xcd\x71\xf2\xc3\xa9\xa6\x13\xb8\x5d\xf1\xdc\x48\x46\x01\xe7\xcb\x9c\xf8\x87\x0f\x87\x47\x21\x23\xa2\x49\x04\xe6\x08\x7e\xea\x32\x29\x33\x3b\x21\x60\x7a\xe6\xdc\xf9\x94\xbb\x8e\x92\x48\x00\x8e\x29\x85\x9e\x80\x82\xb0\x2d\x69\x68\x6c\x77\xfa\xfa\xfd\x9e\xa3\xa4\x12\x94\x53\x4a\xd2\x40\x41\x61\x60\xa3\x19\x30\x7a\xf1\x9b\x97\xaf\x9d\x32\x89\x00\x1c\xc4\x93\x44\x97\x47\x59\xd9\xf6\x26\x70\xbb\x87\x5b\xc7\x23\x47\x49\x25\x00\x9f\x29\x49\x03\x05\x85\xa1\xfb\x64\x80\xd2\x7d\x8f\xbf\x5f\x3b\x72\x22\x01\xd9\x23\x04\xfa\x7c\x73\x38\x15\x0d\xe3\x99\x9f\x5e\x6e\x6d\x9c\x3a\x52\x12\x1a\x4e\x45\x53\xd6\x33\x81\x99\x37\x87\x67\xbe\x98\x75\xb6\x03\x47\x4a\x42\xc3\xa9\x68\xc1\x7a\x26\x30\x8b\xc6\xdb\x73\xd6\x13\x87\x27\xa0\xe1\x94\x37\x9c\x9a\x0e\x2c\xbc\xf2\x50\x9d\xde\xd7\xb7\xb1\x23\xa5\xa1\xe1\xb4\x6c\xb0\x7a\x2e\xb0\xb3\x7a\x43\x83\x4e\x37\x36\x9f\x39\x65\x12\x1a\x4e\x45\x43\xd5\xf2\xcc\x45\x30\x85\x8f\x7c\x04\x63\xca\xdc\x0b\x6e\x0e\x67\x8e\x9c\x88\xe0\xe1\x15\xf9\xad\xcf\x37\xc9\xfd\x48\xcb\x98\x7c\x5f\x07\x54\x7f\xd0\xcd\x87\x1b\x27\x8e\x9a\x8c\xe0\xf1\x23\x7b\x6a\xa2\xa1\x50\x34\xca\x21\x40\xc1\x71\xb8\x74\xe4\x44\x02\x73\x40\x08\xf4\xf9\xa6\xb2\x39\x43\x40\xea\x7b\x36\x26\x1a\x47\xaf\xc4\x53\x13\x4d\x18\x87\x79\x18\x44\x61\x36\x87\x8a\x6f\xbf\xbe\xd8\x73\xca\x24\x68\x75\x08\xc3\xbe\x2f\x93\x1c\x8e\xbd\x5e\xe2\x94\x39\xb8\x77\x17\x07\x87\x8e\x26\x0f\x95\x69\x27\x34\xe9\x5e\x6a\xb3\xdc\x37\x01\xd8\xea\x5e\x8a\x89\xe0\xb7\x18\x47\x6a\xb9\xe5\x2e\x94\x59\xd9\x96\x32\x91\x29\x7e\xd3\x5d\x44\x82\xaf\xdb\xa3\x92\x60\x5e\xc0\x73\x33\x1d\x6c\x5f\x99\xe5\x56\x16\x29\x23\x82\xf5\x4f\x17\xc0\xd7\x0e\xdc\xaf\x64\x17\x84\xa6\xa0\x51\x12\x7d\xc9\x5c\x4d\x06\xe1\xca\xe6\x84\xeb\xf7\x6f\x6f\x82\x9e\x23\x12\x80\xe9\x74\xee\xd6\xd3\x09\xcf\x7c\x4c\x78\x06\xdd\xef\xef\xbf\x8c\x1c\x91\x02\x4c\x47\x63\x57\x93\x41\xb8\xa2\x29\xe3\xea\xe4\x0c\x92\xa4\x00\xd7\xe1\xd4\xd5\x64\xf0\x5a\x75\x09\xd7\x5e\xbc\xb8\xe1\xc2\x77\x59\xad\xba\x9a\x0c\x5e\x2b\xc2\xe5\x86\xdd\xe5\x94\x8b\xdf\x65\xd5\xea\x6a\x32\x78\xbd\x08\x57\x37\xef\x6d\x7e\xe6\xe2\x77\x59\xbd\xba\x9a\x0c\x5e\x2f\xca\xf5\x2a\xea\xdd\x70\xf1\x29\xd7\xe1\xb4\xab\xc9\xe0\xf5\xea\x99\x9e\xf9\xee\xcb\x62\x6b\xc0\xa5\xef\xb1\x6a\xf5\xea\xe9\xbc\x56\xc0\x33\xe8\xee\xc6\xeb\x2f\xb8\xf0\x3d\x56\xab\x9e\x26\x83\xd7\x0a\xb8\x9e\x0d\x7b\xf8\x15\x97\xbd\xc7\x2a\xd5\xab\xa7\xf3\x3a\xf5\x48\x9d\x36\x5e\x66\x5f\x79\x9d\x7a\xac\x4e\x3d\x4d\x06\xaf\xd3\x1a\xe1\x3a\x79\x96\xff\xe0\xc2\xaf\xb1\x4a\xad\x69\x32\x78\xad\x80\x6b\x12\x74\x0e\x52\x2e\xfb\x1a\xab\xd4\x5a\x3d\x9d\xd7\x09\x78\x66\x8b\xce\xfe\x92\xcb\xbe\xc6\xea\xb4\x56\x4f\xe7\x75\x02\x9e\x81\xbb\x79\xb9\xfd\x9d\x8b\xbe\xc6\xea\xb4\xa6\xc9\xe0\x75\x5a\x07\xae\xee\xd7\xde\xd5\x09\x17\x7d\x9d\xd5\x69\x5d\x93\xc1\xeb\x44\xb8\x7a\xb3\xc1\xf3\x97\x5c\xf8\x75\x56\xa9\x75\x4d\x06\xaf\x15\x70\x65\xce\xd1\x45\x97\x4b\xbf\xce\x6a\xb5\x5e\x4f\xe7\xb5\x02\x9e\x41\x6f\x91\x7c\xe8\x72\xe1\xd7\x59\xad\xd6\x35\x19\xbc\x56\x1b\xc0\xd5\x3d\x3c\x9c\x84\x5c\xf8\x0d\x56\xab\x0d\x4d\x06\xaf\x15\xe5\x5a\x5e\xa6\xa7\x84\x2b\x9b\x33\xae\xd3\xf9\x86\x26\x83\xd7\x8a\x70\xf5\x82\xcf\xc3\x75\x2e\xfe\x06\xab\xd6\x86\x26\x83\xd7\x8b\x70\xb9\x17\x6f\xf2\x0d\x2e\xfe\x06\xab\xd7\x86\x26\x83\xd7\x6b\x93\x70\x45\x5f\xdd\x4f\x5c\xfc\x4d\x56\xaf\x4d\x4d\x06\xaf\x17\xe1\xea\x1d\xfd\x3e\x11\x4e\x61\x93\xd5\x6b\x53\x93\xc1\xeb\x45\xb9\x46\xaf\x93\x0d\x2e\xfe\x26\xab\xd7\xa6\x26\x83\xd7\x8b\x72\x5d\xff\x38\xe9\x70\xf1\x37\x59\xbd\x36\x35\x19\xbc\x5e\x5b\xc0\xd5\x7d\x75\xfd\xe2\x9a\x8b\xbf\xc5\xea\xb5\xa5\xc9\xe0\xf5\xa2\x5c\xd3\x70\xbc\xcf\xc5\xdf\x62\xf5\xda\xd2\x64\xf0\x7a\x51\xae\xe3\x93\xe0\x94\x8b\xbf\xc5\xea\xb5\xa5\xc9\xe0\xf5\x02\xae\xc3\xd7\x8e\x93\x71\xe9\xb7\x58\xb5\xb6\xea\xe9\xbc\x56\xdb\xa4\xa4\xe4\xdd\xe4\x1b\x17\x7e\x9b\xd5\x6a\x5b\x93\xc1\x6b\x45\xb9\x36\x9e\x1f\xac\x71\xe1\xb7\x59\xad\xb6\x35\x19\xbc\x56\x94\x6b\x6f\x36\x7e\xc9\x85\xdf\x66\xb5\xda\xd6\x64\xf0\x5a\x11\xae\xde\xf7\x8d\xf4\x80\x8b\xbf\xcd\xaa\xb5\xad\xc9\xe0\xf5\x72\x3b\xa6\x67\x2e\xe6\xe1\xf3\x2f\x5c\x7c\xb7\xc3\x2a\xe6\x76\x34\x39\xbc\x66\x84\x2f\x5b\xbf\xa0\x07\x44\x59\x0a\x1b\x8d\x3b\x9a\x1c\x5e\x37\xc2\x37\x70\x93\xb7\xbb\x47\xbc\x0e\x8c\xf1\x68\xec\x76\x74\x59\xbc\x7e\x94\xb3\xbb\xf7\xf5\xf3\xef\xbc\x1e\x8c\xf3\x70\xea\x76\x74\x59\xa2\x8e\x30\x9c\xdf\x7c\x8e\x43\xe1\x28\x5c\x11\x6e\xb8\x9a\x1c\x51\x47\xe0\x8b\xc2\xb7\x1f\xc5\x50\xc5\xf8\x4e\xe7\xae\xab\xc9\x11\x75\x04\xbe\x41\x77\x77\xfe\x11\x8b\x8a\x88\xa8\xc3\xd5\x65\x89\x3a\x12\x4e\xf7\xfb\xb7\xd3\x3d\x51\x11\x11\x79\xb8\xba\x2c\x51\xc7\x2e\x70\x76\xc7\xd3\x91\x23\xaa\xc2\xa3\x0f\xb7\xab\xcb\x12\xb5\x24\x9c\xbd\x9b\x68\x77\x5d\x54\x86\x47\x20\x6e\x57\x97\x25\xea\xc9\x02\x9e\xdd\xcf\x65\x0c\xd5\xe5\xf5\xec\xea\xb2\x44\x3d\x29\xe7\x8b\xb7\x69\x2c\x2a\xd3\xe5\xf5\xec\xea\xb2\x78\x3d\x21\xbe\x85\x04\xcf\x5c\x5e\x6c\x1d\xbe\x77\x2a\xc9\x00\xf1\x25\x3b\x5e\x46\x91\xdb\x6d\x22\x51\xa1\xa0\xc5\xe3\xf7\xc7\xce\x17\xa7\x92\x2c\x41\xb9\x4d\x24\x2a\x14\x51\xd0\xde\xe7\xee\xab\x81\x53\x49\x97\xb0\x3a\x8d\x34\x0a\x18\xb1\x66\x77\xeb\xfb\xc1\x4c\xa1\xe3\x4e\x07\xa0\xb6\x9b\x28\x14\x20\x70\x76\xbb\xfb\xd3\x45\xa4\x50\x71\x97\x0c\x38\x5b\x0d\x04\x0a\x0c\x1d\x0b\x3e\x7e\x3a\x52\x4b\xe3\x43\x16\xe0\x6c\x36\x51\x28\x40\x74\xb0\x3c\xde\x9f\x5d\x2a\x64\x7c\x4c\x07\xa0\x8d\x26\x0a\x05\x68\xdd\xf4\xcc\x4f\xd7\x2f\x2e\x3f\x2b\x54\x3c\xe2\x01\x9c\xf5\x06\x02\x05\x86\x84\x5a\xbd\xcf\xdf\x17\xb9\x42\xc6\xa3\x41\xc0\x59\x6b\xa2\x50\x80\x48\x1c\xea\x76\x7f\xc7\x63\x47\x4d\x2e\x81\x7a\x4d\x14\x0a\x10\x28\xf7\xa0\xb7\x70\x72\x55\x75\x65\xe5\xee\x36\x51\x28\x40\xa0\xda\x27\x7b\xfb\xdf\x2b\x7a\x2b\xe1\xb8\x0d\x04\x0a\x0c\x51\xeb\xee\x0b\xf7\xb3\x5a\x9a\xac\xd5\x9d\x26\x8a\x12\x28\x9b\x31\xab\x77\x9f\x1d\x5f\x1e\x94\x74\x34\x9d\x43\x65\x33\xb0\xdb\x06\x1a\x15\x0c\x6a\x37\xe8\xed\x6e\xf6\xf6\x54\xc2\xd2\x72\xb3\x99\xeb\x36\xd2\xa8\x60\x50\xc7\xd9\xfa\xc6\xab\x6b\xa7\x92\x2c\x61\x75\x9a\x48\x14\x28\x6a\xb8\xc7\xdb\xbd\x63\x85\xae\x34\xdc\x6c\xb6\xdd\x44\xa1\x00\x81\xe1\x0e\xba\x6f\x87\x57\x57\x0a\x59\x69\xb9\xd9\x6c\xab\x89\x42\x01\x22\xa6\xeb\xbe\xe9\xe1\x67\x0a\x59\x69\xba\xd9\x6c\xb3\x89\x42\x01\x22\xa6\xdb\x8d\x9f\x8f\x3e\x28\x64\xa5\xe9\x66\xb3\x8d\x26\x0a\x05\x88\x4d\x04\x70\xae\xb6\x40\x69\xbb\x19\x5d\xc2\xd7\x51\x28\x40\xc4\x78\xbb\x07\xdf\xbf\x4b\x36\x4e\x92\x4b\xa0\xb5\x26\x0a\x05\x08\x8c\xf7\x68\x37\x5f\x6e\x28\x54\xa5\xed\x66\xb3\x5e\x03\x81\x02\x43\x14\xbc\x77\x7c\x34\x4e\x15\x05\x97\xf5\xbb\xdb\x44\xa1\x00\x11\xe5\x76\xaf\x3e\x6e\xf7\x54\xe5\x96\x80\xdc\x26\x0a\x05\xa8\x43\x80\xe6\xef\xdf\x2f\x14\x20\x59\xb1\x3b\x4d\x14\x1c\x28\x99\x4c\x32\x9c\xf3\x41\x7b\x7b\xff\xf7\x6f\x4e\x2d\x87\xc2\xbd\x23\xcf\x6e\xf7\x1e\xba\x2a\x28\xa9\x69\x77\x36\x71\xb2\x2a\xb1\xab\x82\xba\xf7\xd0\x55\x41\xa1\xd6\xc7\x1f\xc3\xe3\x65\x95\xb6\xa3\x62\x76\x9a\xc9\x2a\x90\x2c\x92\xff\xfc\xe2\xc4\xa9\x66\xc8\x88\xdb\xcd\x54\x15\x40\x62\xde\xee\x68\xf7\x15\x76\xaa\x19\x32\xe0\x56\x33\x55\x05\x90\x98\x79\xef\xcb\x87\x7c\xbb\x42\xca\x0d\x9d\x02\x6e\x36\x53\x55\x00\x89\xb9\xf7\xd2\xe5\x77\xb7\x42\xca\x0d\x9e\x02\x6e\x34\x53\x55\x00\x89\xd9\x77\x5f\x5f\x8e\xaf\x9c\x6a\x86\x0c\xb8\xde\x4c\x55\x01\x24\xe6\xdf\x3b\x1c\xb9\x7b\x4e\x35\x43\x06\x5c\x6b\xa6\xaa\x00\x82\x1b\x18\xb8\x47\x2f\x3f\x0d\x2b\xa4\xdc\x13\x50\xc0\x5e\x33\x55\x05\x90\x98\x4c\xef\xd3\xfe\xe1\x27\xa7\x9a\x21\x03\x76\x9b\xa9\x2a\x80\x6c\x82\xf0\xe1\x55\xb5\xb9\x2b\xd6\xd2\x4c\x55\x01\x04\x53\x19\xf4\x16\x6b\xf3\x63\xa7\x9a\x21\x03\x76\x9a\xa9\xf8\x44\x82\x07\xd8\xd0\x7d\xd1\x34\x78\xeb\x54\xd2\x01\xee\x74\xce\x63\xf3\x06\x1a\x15\x8c\xd4\x76\x7b\x6f\xdf\x3d\x51\x09\xf9\x34\x8c\x47\xe7\x0d\x34\x2a\x18\xd4\xf4\x62\xff\xc7\x85\xa3\xd2\xf1\x69\x2b\x8f\xce\xf5\x24\x0a\x14\x38\x83\xb7\x79\xf7\xdb\xd8\x51\x53\x4b\xa0\xed\x06\x02\x05\x86\xb8\x80\x5e\x76\x75\xbc\xab\x90\xf1\x85\x0f\x16\x9b\xeb\x29\x14\x20\x6a\xfa\xaf\x3e\x8d\x3f\x2b\x64\x7c\x65\x48\x04\xe7\x3a\x0a\x05\x08\x4c\xfe\xea\xc3\xfe\x8b\x40\xa1\xe2\x2b\x67\x2c\x36\xd7\x12\x28\x30\x60\xe8\x1f\x0e\xbf\xef\xde\x28\x54\x7c\x59\x91\x85\xe6\x5a\x02\x05\x86\x98\xb7\xbb\x3e\x78\xad\xd6\x9e\xaf\xb9\xf2\xd0\x5c\x4b\xa1\x00\x81\x59\x5f\xbc\x59\x4e\xdf\x28\x54\x7c\x41\x9a\x45\xe6\x5a\x02\x05\x86\xa8\xb6\x3b\x9d\x5c\xfd\xae\xa8\xb6\xac\xd9\xdd\x26\x0a\x05\x08\xd4\xfa\xe3\xe1\xcb\xe7\xc7\x0a\x95\xa2\xd4\x0d\x04\x0a\x0c\x28\xf4\xa0\xbb\xb9\xe1\xaa\x9d\x2a\x2b\x74\xa7\x89\xa2\x04\xe2\x81\xf9\xe5\xa7\xef\xd9\x65\x49\xc6\x62\x6e\x86\x44\xe3\x72\x3d\x89\x0a\x05\x75\x1b\xf4\xd2\xf4\xc7\x73\x95\xb0\xac\x1d\x0f\xcb\xb5\x34\x2a\x18\xa9\xa1\xbb\x11\xbe\x92\xda\xb3\x8c\xcb\x19\x58\xa7\x91\x46\x01\x03\xa3\x1d\xf4\xa6\xef\xd6\x06\x0a\x5d\x69\xb5\x34\x30\xd7\x52\x28\x40\xc4\x6c\xbb\xe1\x07\xd9\x7b\x89\xc0\x9c\x02\x6d\x35\x51\x28\x40\xc4\x6c\xdd\xf5\xa3\xef\x1f\x4a\xb3\x15\x81\x39\x05\xda\x6c\xa2\x50\x80\xc0\x6c\x07\xbd\xcf\xcf\x77\xf7\x14\xb2\xd2\x6e\x69\x60\xae\xa5\x50\x80\xd6\x09\xd0\x4d\xb8\x71\xad\x00\x95\x96\xcb\x02\x73\x1d\x85\x02\x04\xa6\xfb\x2a\x1c\xe4\x23\x85\xaa\xb4\x5c\x12\x97\x6b\x09\x14\x18\x30\xdc\x41\x6f\x77\xaf\xfb\x55\x21\x2b\x2d\x97\xc4\xe5\x7a\x0a\x05\x08\x14\x7c\xd0\x7d\x73\xfc\x69\xa9\x90\xc9\x0a\xde\x6d\xa2\x50\x80\x88\x7a\xbb\x97\x69\x8e\x55\xf5\x96\x80\xdc\x26\x0a\x05\x88\xa8\x76\x37\x7c\x79\x7a\xa8\x90\xc9\x9a\xdd\x69\xa2\xe0\x40\x6a\x60\x3e\x7d\xfe\xee\x85\x53\xcb\xa1\x70\x2c\x2a\xe8\xde\x43\x57\x05\xa5\x35\x8d\x2f\xbe\x3b\x4e\x2d\x47\x01\x75\xef\xa1\xab\x82\x92\x5a\xf7\x46\xc3\xc3\x9a\x04\x1d\x15\xb4\x73\x0f\x5d\x05\x94\x18\xb6\xfb\xf5\xfb\xc7\x8b\x0a\x2d\x37\xed\x32\x34\x6f\xa0\xaa\x00\x82\x81\x47\xeb\x87\x1f\xba\x15\x4a\x6e\xe1\x22\x32\x6f\x20\xaa\xc0\x51\x33\x1f\x44\xd7\x1d\xa7\x9a\x21\xe3\x6d\x36\x53\x55\x00\xc1\xdc\xdf\x4f\xf2\x93\x6b\xa7\x9a\x2e\xe3\x6d\x34\x12\x55\xe0\x88\xd1\xbb\xbb\xe1\xb3\x67\x4e\x35\x43\xc6\x5b\x6f\xa6\xaa\x00\x82\xf1\x0f\x7a\x6f\xde\xdd\x1c\x55\x48\xb9\xfd\x4b\x61\xb9\x9e\xaa\x02\x48\xdd\xc0\x97\xad\xd3\x53\xa7\x9a\x21\x03\xf6\x9a\xa9\x2a\x80\xd4\x60\x96\xd7\x97\x6e\xc5\x60\x54\x7b\xe9\x36\x53\x55\x00\x89\xb1\xf4\x0e\xf7\x0e\x5f\x57\x48\x2b\xb6\xd2\x4c\x55\x01\x24\x86\xd2\xfd\xbe\x9f\xbf\x71\xaa\x19\x32\x60\xa7\x99\x8a\xaf\xd2\xf3\xd0\xda\x33\x87\xdf\xde\x9f\xa6\x4e\x25\x19\xd0\x8e\xc6\x3c\x2a\xd7\x93\xa8\x50\xa4\xae\xdd\x93\xce\xf4\x83\x4a\xc8\xb7\x38\x44\x50\xae\xa7\x51\xc1\x48\x3d\xdd\x9b\xab\x78\xdd\xa9\xa4\x4b\x60\x9d\x46\x1a\x05\x0c\x1c\xc1\xd7\xde\xdc\xf9\xa6\x90\xf1\xbd\x33\x16\x96\x6b\x09\x14\x18\x30\xff\x41\xf7\xe2\xe6\x62\x5b\x21\xe3\x3b\x8b\x3c\x2c\xd7\x52\x28\x40\x60\xf8\xe3\x38\x3d\x7a\xe9\xa8\xa9\x25\xce\x66\x03\x81\x02\x03\xe6\x3e\x70\x7f\xbc\xfd\x3a\x51\xc8\xf8\xce\xb4\x58\x31\xd7\x51\x28\x40\xd4\xd0\xa3\xe9\xf5\x2b\x47\x4d\x2e\x81\xd6\x9b\x28\x14\x20\x30\xf0\xc9\xc1\xc0\x39\x55\xa8\xf8\xb9\x06\x16\x97\x6b\x09\x14\x18\x62\xd6\xee\xcd\xae\xab\x6a\x1c\x3f\xf3\x21\x56\xcc\x75\x14\x0a\x50\x17\x80\xba\xe9\xf2\x8d\xab\x00\xc9\xca\xdd\x6d\xa2\x50\x80\x88\x6a\xf7\x16\x17\xd7\xef\x55\xd5\x96\x80\xdc\x26\x0a\x05\x88\xa8\x75\xf7\xed\xde\x24\x57\xd4\x5a\xd6\xea\x4e\x13\x45\x09\x24\xd6\xcc\xbb\xe3\x8b\xe8\x5d\x49\x57\xc6\xe6\x47\x63\xb1\x66\xae\xa7\x51\xc1\x48\xfd\xba\xef\x7a\x1f\xa4\x8e\x29\x83\x73\x06\xe6\x36\xd2\xa8\x60\xa4\x8e\xbd\x57\x9b\x5f\xd7\xca\x3a\x96\xc1\x39\x03\xeb\x34\xd2\x28\x60\x60\xba\x83\xee\xf1\xc1\x69\x47\xa1\x2b\x6d\x97\x06\xe7\x5a\x0a\x05\x88\x18\xaf\xfb\xfd\xfd\xfb\xb0\x34\x5e\x11\x9c\x53\xa0\xad\x26\x0a\x05\x08\x8c\xf7\xeb\xd6\xd1\x89\xe4\xc0\x44\x6c\x4e\x71\x36\x1b\x08\x14\x18\x62\xbc\xbd\xc1\x87\xcf\xef\x15\xb2\xd2\x78\x59\x68\xae\xa3\x50\x80\xd6\xd9\xa0\x9a\x4a\xce\x4b\x84\xe6\x14\x68\xbd\x89\x42\x01\x02\xe3\x1d\x74\x8f\x97\xa7\x5b\x0a\x59\x69\xbd\x6c\xcd\x5c\x47\xa1\x00\x11\xf3\xed\xfe\x38\x70\x06\xa5\xf9\x8a\xe0\x9c\x02\xf5\x9a\x28\x14\x20\xa2\xe2\xbd\xbd\x34\x54\x05\x97\x35\xbc\xdb\x44\xa1\x00\x11\xf5\x76\x8f\x36\x9d\x8a\xb6\x49\x40\x6e\x13\x85\x02\x44\x54\xdb\xbd\x38\x0e\xae\x14\xd5\x96\x35\xbb\xd3\x44\xc1\x81\xd8\x48\xec\xb2\x3d\x81\xab\xc9\x4b\xa7\x96\x43\xe1\x58\x6c\xd0\xbd\x87\xae\x0a\x0a\x35\xcd\x5e\x0d\xc3\x2b\xa7\x96\xa1\x60\xba\xcd\x64\x55\x48\x52\xe7\xde\x14\x7f\x77\xab\xc4\x1d\x15\xb3\x73\x0f\x5d\x05\x94\x98\x75\xef\x66\xf1\x7a\xe4\x54\x33\x64\xc8\xed\x66\xaa\x0a\x20\x98\xf7\xcd\x78\x6f\x79\xea\x54\xd3\x65\xbc\xad\x46\xa2\x0a\x1c\x18\xf9\xc0\x3d\x3a\xed\x3d\xaf\x90\x72\x3b\x97\x42\x73\x3d\x55\x05\x90\x98\xbb\xfb\x6c\xab\x33\x73\xaa\x19\x32\xe0\x46\x33\x55\x05\x90\x9a\xfd\xb4\xb7\x18\x38\xd5\x0c\x19\x70\xbd\x99\xaa\x02\x48\xcd\xff\xc5\xb7\xf7\xd5\xc6\xe6\x0e\xa0\x0c\xce\x1b\xa8\x2a\x80\xe0\x06\xbe\xe0\xe1\x8f\xb8\x42\xc9\xfd\x80\x88\xcd\x1b\x88\x2a\x70\x60\x2e\x5f\xae\xb6\xd3\x79\x85\x52\x35\x96\x6e\x23\x51\x05\x0e\x0c\x65\xf7\x43\xf2\x6a\x5c\x35\x14\x05\xce\x6d\x24\xaa\xc0\x81\x91\x24\x5f\xf6\x47\x87\x15\x4a\xd5\x44\x3a\x8d\x44\xfc\x00\x0c\x0f\xad\x3d\x73\xd0\xeb\x0e\xae\xb0\x53\x49\x07\xb4\xc3\xa9\x58\x2b\xd7\xd3\xa8\x60\x50\xd3\x41\x77\x0d\xef\x5f\xa9\x84\xfc\xfc\x50\x19\x96\x6b\x69\x54\xb0\x0e\x01\xdb\xfe\x1e\x7c\x77\x2a\xe9\x12\x58\xa7\x91\x46\x01\x23\x4e\xc0\x3d\xdd\xfc\x9a\x28\x74\xfc\x74\x1a\x8b\xcb\xf5\x14\x0a\x10\x18\xff\xa0\xbb\x3b\x7d\xf7\x5c\x21\xe3\xa7\xf7\x44\x60\xae\xa3\x50\x80\x88\xd9\xf7\x5e\x5f\x76\x0e\x15\x32\x7e\xba\x51\xac\x97\xeb\x28\x14\x20\x6a\xee\x8b\x57\x47\x3f\x14\x32\x7e\xfc\x53\x84\xe6\x3a\x0a\x05\x88\x98\x79\xf7\xc7\x65\x76\xe3\xa8\xc9\x25\xd0\x7a\x13\x85\x02\x04\xe6\x9d\xbd\xc8\xe6\x5f\x1d\x35\xb5\xc4\x59\x6b\x20\x50\x60\xc0\xa8\x3f\x86\xbd\x83\x40\xa1\xe2\x07\xab\x59\x64\xae\x25\x50\x60\x40\xc1\x3f\xcf\xa7\x5f\x2e\x15\x2a\x59\xbd\xbb\x0d\x04\x0a\x0c\x55\xed\x37\x47\xfb\x73\x55\xb5\x25\x1c\xb7\x89\x42\x01\x02\xb5\x7e\xff\xf9\xe4\xc3\x48\xa1\x92\x95\xba\xd3\x40\x50\xc2\x94\x41\xf9\x8b\x77\x47\x9f\x4b\xba\x32\x28\x3f\x9c\x96\x41\xb9\x96\x46\x05\x83\xda\x1d\x8c\x3f\xff\x48\x55\xba\xd2\x6e\x69\x4c\xae\x27\x51\xa1\xa0\x7e\x83\xde\xf7\xab\xde\x50\x25\x2c\x6b\xc8\x43\x72\x2d\x8d\x02\x46\xcc\xb6\x77\xf8\xe5\x5b\xc7\x51\x93\x4b\xa8\xed\x26\x0a\x05\x88\x98\xad\xbb\xf1\x3d\xfc\x54\x9a\xad\x08\xc9\x29\xd0\x56\x13\x85\x02\x44\xcc\xb6\xfb\xee\xfd\xc5\x86\xa3\x26\x97\x40\x9b\x4d\x14\x0a\x10\x31\xdb\x5e\xe2\x66\x92\x4f\x15\x41\x39\x05\xda\x68\xa2\x50\x80\xa8\xd9\x8e\xe7\x37\x6f\x4a\xb3\x15\x41\x39\x05\x5a\x6f\xa2\x50\x80\xc0\x6c\x07\xbd\x45\xba\xdc\x56\xc8\x4a\xbb\xa5\x41\xb9\x96\x42\x01\x02\xc3\x1d\x74\xa3\xe7\x1f\x25\xfb\x16\x41\x39\x05\xea\x35\x51\x28\x40\x44\xc5\x7b\x2f\x96\x7b\xaa\xfa\xca\x1a\xde\x6d\xa2\x50\x80\x40\xbd\x07\xdd\xaf\x9f\x5f\x39\x0a\x99\xa2\xde\x4d\x14\x0a\x10\x51\x6e\x37\xfc\xf4\x56\x1a\xbc\x44\x50\x4e\x81\x3a\x4d\x14\x1c\x88\x8d\xc4\x24\x28\x5f\xbe\x3f\xf9\xba\xe7\xd4\x32\xa8\x58\x2c\x2e\xe8\x36\x93\x55\x21\x49\x3d\xdd\xfc\xf3\xf5\xd0\xa9\xe5\x28\x98\xee\x3d\x74\x55\x50\x5a\xe7\xce\x81\x9b\x56\x89\x3b\x2a\x68\xe7\x1e\xba\x0a\x28\x35\xec\x1f\x5f\x4e\xaa\x02\x70\xd3\x96\x82\x72\x3d\x55\x05\x90\x18\x78\xef\xd5\xdb\xf9\xb5\x53\xcd\x90\x01\xb7\x9a\xa9\x2a\x80\xc4\xd0\x7b\xf3\x83\x8b\xd8\xa9\x66\xc8\x80\x9b\xcd\x54\x15\x40\x62\xf0\xdd\xee\xe9\xc7\xe7\x4e\x35\x43\x06\xdc\x68\xa6\xaa\x00\xb2\x35\xf3\x93\xb5\x91\x53\xcd\x90\x01\xd7\x9b\xa9\x2a\x80\xc4\x01\xb8\xcf\x3e\x5f\xe4\x4e\x35\x43\x06\x5c\x6b\xa6\xaa\x00\x52\x47\xf0\xfc\xc7\x8f\x67\x4e\x35\x43\x06\xec\x35\x53\x55\x00\xc1\x64\x06\xbd\xfd\xe9\x75\x58\x21\x55\x4d\xa6\xdb\x4c\x55\x01\x24\x06\xd3\x7b\xff\xea\xdb\xcb\xaa\xc1\x28\x80\x6e\x33\x55\x05\x90\x18\x4b\xef\xdb\x87\x8b\x6a\x73\xab\xb6\xd2\x69\xa6\x62\x97\x4d\x8c\x92\x78\x8c\xe3\x8c\x5d\xe9\x10\xfd\xee\xbc\x74\xaa\x19\xf4\xba\x89\x5d\xfe\xdc\x4c\xc7\x20\xb3\x3c\x0d\x17\x14\xb0\x7b\x93\xbd\x78\xe7\xa8\xc9\x14\xee\x94\x3e\x35\xd1\x30\xa8\x59\x72\xc9\x5e\xcb\x7d\x3f\x5e\xff\x9d\x11\xd1\x44\x0a\xf3\x0a\x7e\xeb\xf3\x83\x51\xce\xae\xb3\xc8\x2f\xbf\x7e\x75\xe8\xa3\x89\xd8\x6b\xca\x50\xf0\xbb\xe9\xcb\x6e\xf9\xda\x32\xbc\x89\x0c\xa9\x07\xf9\xc1\x3a\x7b\x2d\x99\xbd\x60\xec\x99\x6f\xd2\x93\xa3\xad\xf2\x7d\xe3\x31\x7b\x9d\x78\xf7\x6a\x77\xfa\xb6\x7c\x95\x98\xdd\x32\x53\xde\x99\xe1\x99\x0d\x5f\x26\x61\xd2\x2b\xb7\x6e\x34\x51\x92\xeb\x26\x40\x30\x7c\xb8\xbb\x47\xaf\x8d\x30\xd1\x38\xcc\x00\x72\x0c\xe9\x1f\x8f\xe7\x23\x87\x27\xc0\x6b\xb9\x79\xcc\xbf\x9a\x00\x4d\xb7\xe9\xac\xe1\xb1\x23\x27\xc2\x65\x26\x7b\xf4\xb7\x3e\x3f\x59\xe0\xd8\x33\x47\xd1\x20\xbe\x70\xe0\x37\xbc\x55\x9c\x26\x8b\x71\x72\x05\x37\xb2\x4e\xa7\xec\xea\x8b\x37\xf1\xd7\x7d\xa7\x9a\x81\x78\xc2\x07\xf2\xdc\x4c\x47\xee\x42\xf6\x4c\xf9\x66\x64\x26\x3c\x7f\x0d\x1d\x32\x3f\x0d\x8f\x0f\x1d\x39\x11\x84\x17\x6f\xae\xeb\xf2\x09\x04\xef\x55\x80\x38\xee\x5c\xe4\x8e\x9c\x08\x10\xd2\x0d\x00\xf5\x7c\x02\xc1\x5f\x61\xef\xae\xdf\x5c\x75\x1d\x91\x02\xcc\xec\x25\xf6\x6a\x0e\x61\xe3\x4a\xe2\x99\x4e\x3e\x7d\xf1\xce\x91\xd3\x80\x57\xbc\xc7\xae\xc9\x26\x00\x4c\x9d\x40\xf2\xc9\xbb\xcf\x43\x47\x4a\x03\xfe\xf2\x55\xf6\x5a\x2e\x61\x8f\xc2\xf8\x82\x88\x7d\xb1\x7e\xf9\xd1\x11\x29\xc0\x7a\x18\xc6\x17\x9a\x1c\xca\x06\x12\x0f\xd6\xbe\x6e\x7d\x3f\x70\xd8\x33\x61\x99\xd6\x52\xcd\xf2\xfa\x5d\xca\xd4\xdd\xde\xbd\x39\x95\x2e\xe5\x65\xac\x2f\xe1\xe1\x70\xda\x40\x40\x50\xb2\x39\xf0\xf7\x3a\xef\xc2\x85\xc3\x9e\xa1\xd0\xd3\x79\x2d\x55\x2a\x94\x32\x75\xf1\xfe\xbb\x5d\x09\x93\xb1\x92\x42\x4f\xe7\x0d\x04\x04\xe5\x9a\x68\x45\xf7\x62\xf7\x72\xdf\x61\xcf\x50\xe8\x97\xac\x96\x2a\x15\x4a\x99\xdc\x67\xdd\x0f\xd7\x12\x26\x63\x25\x85\x7e\xc9\x1a\x08\x08\x0a\x79\xf5\x1d\x20\x7a\xf3\x70\x44\xfb\x94\x26\x41\xd1\xcf\xe1\x97\x2e\xaf\x14\x00\x58\x2b\x37\x1c\x97\x45\xeb\xf2\xcc\xfa\x25\xc9\xa6\x67\x36\xdd\x9f\x5c\x62\x7d\x62\x29\xf7\xd1\x9a\xd2\xcd\xcc\x20\x58\xed\xba\x66\x02\xf7\x81\x5d\xdd\xac\xcd\x37\xc3\x78\xb1\x64\x1f\x01\x62\xa7\x28\x17\xbf\x3b\x72\x22\x22\x0f\xa2\x7e\xf5\x7c\xa9\x86\xf4\xca\x96\x90\x8c\x39\xd3\x1f\x47\xdb\x23\x47\x97\x27\xea\xf8\x9a\x27\xdd\x4b\x5d\xba\xbc\x39\x8e\x97\x20\xe4\x7a\xf0\x72\xfb\xaa\x74\x64\x24\x59\xb8\xbb\x23\x1c\x2f\x9b\x68\xe0\xf6\x69\x78\x81\x89\xdd\x44\x6d\x22\x33\x0e\x2e\x9d\x71\x78\x19\xf2\x5b\x27\xbe\x5d\xcd\xb0\x23\x27\xa2\x38\xb8\xdc\xa3\xbf\xf5\xf9\x04\x22\x0f\x86\x44\x31\xbb\xa3\xf0\xeb\xb1\x23\x52\x80\xf9\x43\x30\xcc\x34\x39\x84\xad\xac\x25\x60\xc7\x87\xaf\x9f\x39\x6a\x32\x00\x48\xad\xa4\xa7\xe1\x95\x04\x8c\xe4\xe5\xa7\x3d\x51\x6b\x56\xca\x22\x8c\x22\x22\x5d\x2f\x5c\xbf\xf8\xec\x94\x49\x80\x7e\x0c\xbf\x74\x79\x84\x35\xcb\x83\xd1\x05\x13\x6f\x31\xf9\xf4\xbb\x23\x27\x02\xfb\x29\xfd\xad\xcf\x17\x4d\x23\x49\x0b\x6a\xba\x95\xbd\x3f\x74\x34\x79\xbc\xb9\xe4\x1a\x37\x13\x43\x68\xe2\xb0\xab\xd3\x4d\xcf\x7c\x31\x1d\x1e\xef\x39\x72\x1a\x8c\xed\xbb\xfc\x6a\x75\x4d\x36\x01\x80\xeb\xc7\xa1\x7a\xdd\xef\xee\xa7\x17\x8e\x48\x01\xde\xe3\x20\xc6\x9a\x9c\x45\x30\x0d\xe3\x80\x5c\x7b\x6d\xc6\xee\xfa\x51\xec\x94\x29\x26\x32\xcb\x07\xf0\xdd\x9e\x79\xb8\x7e\xb0\x36\x95\x48\x20\x55\xc2\x38\x9c\x36\x90\xc8\x40\xcc\x3f\xa7\x5f\xbb\xdf\x65\x32\xf0\xb5\xe5\x23\xf1\xb6\x0d\x34\x31\x5c\x7c\x26\x7d\xe0\x8c\xc8\xc9\xef\x5d\x07\xec\xea\x5d\xec\x8c\xe9\x39\x7c\x47\x5c\x97\x6b\x2a\xdf\x55\x00\x04\xcd\xe7\x16\x18\x08\xdc\xd9\x42\x46\x5a\x3d\x0d\x83\xca\xc3\x9c\x5d\x21\xe6\x76\xdf\xbf\x67\x44\x34\x91\xc2\x7c\x80\xdf\xfa\x7c\x06\x41\xbf\xc2\x40\x30\x6a\xdf\x66\x60\x20\x2f\xc8\x43\x03\x85\x59\x7e\x10\x8d\x80\x54\x3e\x92\x46\xef\x7c\xe1\x9e\xb0\xfa\x09\x35\x21\x05\xff\xec\x03\x81\xd0\x7c\x0e\x82\x49\xb2\xcb\x1e\x1b\xa9\x24\x69\x9c\x30\xc7\xa0\x02\xe9\xec\xf7\xc3\xb7\x52\x99\x34\xbd\x14\xeb\x20\xc7\xf3\x46\x2a\x26\x5e\xe9\xea\xab\x1f\x68\x63\x82\x09\x57\x5f\xcf\x67\x10\x3c\x3e\x05\x90\xfa\x17\xc2\x18\x4c\x19\xd8\x6a\x69\x18\x14\x8f\x16\x3d\x53\xf7\x85\x2a\x06\x25\x85\x99\x3a\x1a\x06\xc5\xa3\x46\xcf\xd4\x7d\x18\x89\x41\x49\xe1\xa6\x8e\x86\x41\x89\x90\xb3\xf2\x61\x1e\x06\xc2\xc3\xce\x5a\x2e\x63\xe7\x71\xa4\x67\xea\xbe\x04\xc3\x40\xca\x7b\x94\xb4\x34\x0c\x8a\x85\x94\x9e\xa9\xf9\x06\x09\x03\x12\x81\xa8\x8e\x42\x7c\x38\xc2\xac\x7c\x49\x02\x56\x54\xa4\xaf\x2a\x40\x6d\xeb\xdf\x5a\x30\x11\x7d\xe2\x81\x91\x8e\xc2\x14\x1f\x4b\x00\x8c\xca\xf7\x13\xe8\xe5\x40\xec\x86\xba\x5a\x1e\x7c\xcb\xc0\x33\xcb\x6f\x29\x98\x08\x3e\x96\xe0\x99\xd2\x97\x13\x60\x3a\xc8\xee\xa3\xf4\xcc\xea\xb7\x12\xf8\xfd\x5c\x84\xd7\x33\xab\x9f\x49\xa0\x77\x73\xbd\x22\x85\xd4\xf3\xe8\xad\x94\x9e\xa9\x7c\xf6\xc0\x44\xe4\xc3\x06\x9e\x29\x7d\xe5\xc0\x44\x26\x2b\x0f\xc2\x48\xcf\xac\xdd\x1f\x6f\x22\xf6\xfb\x4b\xa6\xcd\x15\xec\xc4\x8d\x57\xef\x21\x17\xdc\xa7\x73\x5d\xa6\x60\x9e\xb3\x7b\x28\xd5\x7b\xa8\x05\xf7\xd1\x58\x9b\x2b\xd8\xc9\x58\x54\xbd\x9f\x58\x70\x1f\x4e\x75\x99\x66\xf5\x62\x7b\x90\x40\x7f\xe5\xbd\x40\xfa\x92\x09\x65\x69\x20\x94\x51\xd9\x65\x59\x9e\xd9\x74\xfb\xbd\x84\x5b\x5e\xb6\xd5\x44\x5a\x47\x66\x65\x7a\xe6\xfd\xf7\xe1\xd7\x4a\x11\x75\xb8\x9f\xcb\xac\xde\xf6\x0e\x0d\xa4\xbf\x07\x5e\x14\x71\x3a\xe7\xe0\x4d\x84\x32\x2a\xab\x9a\x67\x36\x5d\xfe\x2e\xe1\x8a\x06\x6a\x24\xad\x23\xb3\x32\x81\xe9\xbe\x8b\xdf\x6b\xa5\x88\x06\xba\x9f\xcb\xac\xde\x67\x0e\x0d\xa4\xbf\xe9\x5c\x14\x71\x34\x16\xe0\x0d\x84\x32\x2a\xab\x9a\x67\x36\xdd\x6e\x2e\xe1\x96\x1a\xd4\x44\x5a\x47\x66\x65\x7a\xe6\xfd\x17\x9b\xd7\x4a\x11\x75\xb8\x9f\xcb\xac\xde\xf0\x0d\x87\x4d\xb4\x57\x7f\x8b\x12\x0e\xa7\x0c\xbb\x89\x4e\xc6\x64\x15\xf3\xcc\xa6\x3b\xc0\x25\xd8\x52\x7f\x9a\x48\xeb\xc8\xac\x4c\xcf\xbc\xff\x52\xf0\x5a\x29\xbc\x79\x1e\xe0\x32\xd9\xdd\xc1\x6c\xda\xaf\xde\x0e\xce\x7d\x38\x71\xbb\xb5\x3c\xce\x4a\x9c\x6e\xf5\xfa\x6f\xce\x7a\x3a\xd7\xe5\x71\x56\xe2\x72\x2b\xd7\x7b\x73\xce\xa3\xb1\x26\x8b\x33\xb2\xf5\x18\xf5\x4e\x6f\xce\x79\x38\xd5\xe5\x89\xa6\x25\xd7\x24\x03\xbb\xe6\xea\x6b\xd1\x8c\xc7\x40\xd4\x44\xa3\x42\xb1\xa6\xf4\xcc\xe6\x5b\xad\x55\x58\xde\x35\xf7\x90\x57\x4a\x60\xea\xe1\x99\xf7\x5c\x60\xad\x96\x51\x5a\xe2\x3d\x0c\xda\x52\x98\x08\x9e\xf9\xe0\x65\xd5\xba\x12\x85\xda\x3d\xc8\xcb\xbb\x92\x77\x47\xfd\x2a\x6b\xde\x9f\xac\x2f\x34\x04\x45\x81\x7e\x9a\x2c\xe3\x11\xcc\xce\x2c\x8c\x72\xfb\xf6\x7f\x9f\x80\x62\xfb\xd6\x5c\x66\xd8\x80\xd5\xfd\x51\x6e\xf6\xdf\x0d\xbf\xe1\x51\xde\x1e\xe3\x49\x18\xe3\xe3\x34\x59\xe0\x34\xbf\xb1\x72\x64\x0e\x06\x38\x3b\x4a\xc6\x4b\x88\x93\x6e\x2f\x83\x68\x89\xbd\x95\x4e\x61\xf7\x2f\x83\xd4\x48\xfc\xd8\xda\xd8\xb4\xfb\x71\x7b\x0c\xa4\x27\xc9\x92\x4c\x80\x44\x41\xf6\x6d\x8a\xf3\x65\x1a\x1b\x49\x3b\x60\x2c\xa9\x1f\x5b\xbd\x35\xc1\x02\x6b\x98\x3a\x86\x54\x30\x44\x7e\x6c\x6d\xae\x0b\x86\x83\x78\x8c\xaf\x9b\xb8\x22\xc1\x15\x00\xd7\x86\xe0\xba\x0a\xf3\x59\xb3\x74\x81\x60\x0b\x81\x6d\x4b\x2d\xec\x04\x8f\xc3\x14\x8f\x72\x1d\x67\x28\x38\x47\xc0\xb9\x5d\xe1\x84\x12\x75\x6c\x23\xc1\x96\x41\x73\x94\xb5\xbb\xaf\xac\x4c\x30\x2d\xfd\xd8\xda\xea\x94\x4c\x4d\xc5\x2c\x05\xc7\xd8\x8f\xad\xb2\x94\x51\x8a\x83\x1c\x93\xf6\xc8\x74\x7c\xe3\xf6\x90\xf1\x4d\xfc\xd8\xea\xba\x6a\x49\x29\x59\x0c\xb9\xd6\xca\x38\x11\x25\x2e\x80\xb3\x27\x38\xe1\x36\x76\x28\xe4\x74\x16\x2c\xb4\xb2\x2e\x80\x13\x31\xea\x94\x94\x73\x0f\x2d\x97\x6f\x08\x2d\x51\xca\x37\x0f\xf2\xd1\x4c\xc7\x31\x14\x72\xcd\xa0\xc1\xcb\x7e\x5a\x66\xb4\x1d\xd2\x57\x61\x96\x27\xe9\x8d\x8e\x79\x26\x98\xe7\x7e\x6c\xb9\x65\xc3\x4f\x92\x74\x1e\xe4\xc7\x41\x9e\xe3\x34\xd6\x71\xce\x05\xe7\x14\x04\x2d\xf5\x31\x58\x2c\xa2\x1b\x5a\xf0\x51\x38\x1e\x47\xf8\x2a\x48\xb5\x55\x9d\x0a\x84\x01\x20\x94\xb6\x36\x4c\x93\xab\xec\x5e\xb1\x07\x82\xf5\xd2\x8f\xad\xed\x52\xec\x59\x90\xcd\xee\xe1\xbb\x14\x7c\x37\xd0\x56\xa5\xd0\x54\x6d\x8e\xf0\x3c\x49\x6f\xee\xe1\xbf\x01\xfe\x87\xdc\x0d\xcf\x34\x12\xf0\x46\xf6\x2d\x74\x66\xec\xdf\x16\xfd\x49\x92\x5a\xf0\x90\x18\x61\x6c\x60\x3b\x6f\x87\x60\x49\xef\x26\x56\x62\xff\xe6\x77\xee\xee\x98\x9f\x5a\xa4\x49\x9e\xe4\x37\x0b\xdc\x9e\x05\xd9\xbb\xab\x98\x7b\xac\xf6\x28\x88\x22\x0b\xa3\xc4\x6e\xb5\xac\xf8\x2c\x39\xf7\xf1\x59\x72\x6e\xf7\x99\x6c\x71\xc1\x7d\x50\xd7\x46\x91\x1f\xb7\x63\x2b\xb5\x11\x38\x0b\xd7\x46\x21\x79\x0e\x6c\x04\xb6\xdc\xb3\x51\x46\x9e\x47\x36\x02\x7b\xeb\xd8\x68\xec\x5b\x90\xb0\xb4\x51\x6c\xf5\x5c\xdb\x46\x60\x1d\x6e\xd7\x46\x44\xd7\x5d\x1b\x81\x36\xae\xdb\x88\xa8\x58\xcf\x46\x73\xdf\x8a\xad\x6d\x1b\x31\x91\x83\x2c\x0b\xa7\xf1\xdd\x5d\xd9\x2e\xf6\x2d\xaf\x6d\xee\xbb\xfd\xfc\xd7\x20\x9d\x2e\xe7\x38\xce\xb3\x76\x84\xe3\x69\x3e\xeb\xe7\xab\xab\xbc\x69\x44\xde\x59\x7e\xae\x36\x52\x6c\x3f\xaa\x4d\x62\xda\x26\xd0\x1c\x3e\x34\x8c\x5d\xb0\x36\xc1\x85\x8d\xa6\xfe\xed\x8c\xf6\xa8\xb7\x6c\x27\x04\x0e\x8d\x66\x61\x34\x4e\x71\xec\x4d\xda\x63\x44\x2c\x32\xa3\x3f\x71\x0c\x77\x27\x2f\xdb\x50\x11\x44\x95\x62\x3f\xc2\x20\x1c\x4f\x4c\xe2\xfd\x34\x4d\x04\x4d\x12\x7f\x5c\x8c\x83\x1c\xf3\x67\x62\xa6\xcc\x8b\x88\xf2\x0a\x34\xf0\x33\xcb\xb6\xf8\xa7\x1d\xde\x06\x73\xec\x89\x21\x65\x91\x26\x8b\x0f\x37\x0b\x9c\x79\x53\x34\xc5\x39\x5b\x15\x82\x5e\xcf\xbc\x9a\x0a\xde\x32\x11\xe5\x96\x66\x95\x0d\xdb\x41\x5b\x11\xd9\x5a\xb4\x03\x84\xed\xa2\x28\x00\xf7\x80\xde\x87\x7c\x9a\x83\xb4\x75\x5c\xee\xc5\xbc\x78\x19\x45\xbc\x4d\xc8\xef\x45\x90\x06\x73\xf6\x7b\x94\xcc\x17\x49\x0c\xfd\x48\x9e\x8b\x02\xcd\x82\x78\x1c\x61\xda\x26\xb2\x50\xe1\xc4\x5a\xc9\x67\x61\x06\x5d\xb7\xc8\xda\xac\xd9\xec\x7c\x96\x26\x57\x06\xee\xd7\xb3\xa8\x7a\x43\x3a\xc8\xcc\x1a\x9f\xb6\x11\x55\x02\x05\x1e\x34\x27\xf7\x25\x14\xb9\xe1\xfb\xe1\xc4\xca\x6d\xd6\x2c\x79\x9b\x54\x26\x25\x66\x1f\xcb\x2c\x4c\x2d\xb8\x01\xd1\x52\xac\x59\x7b\x68\x5b\x31\x22\x74\xe4\xa3\x91\x21\x54\xe9\x28\x88\x83\x29\x4e\x25\xd1\x3e\x54\xf3\x4a\xf9\xa8\x62\xe3\xfb\xc4\xc3\x5c\x3c\x5c\x2f\xa4\x5f\xab\x1c\x93\x14\xc9\xe2\xa3\xc4\x8f\x69\xd5\x32\x94\xfa\x71\x9b\xeb\x34\xaf\x4e\xde\x9e\xe2\x7c\x77\x99\xa6\x38\xce\x0f\x59\xe7\xde\xdd\x45\x96\x6d\xad\xb8\xdc\x70\xad\x71\x3b\xb0\xad\x9c\x3f\x0d\xa1\xea\xc9\xdd\x5d\x6a\xdb\x45\xd9\xd5\x9f\xc3\x28\x3a\x4a\x96\x71\xae\xaf\x60\x5f\xdf\x52\x24\xaf\xdd\xd0\x54\x96\x4d\xdb\x97\x88\xaf\x90\xca\x1d\x6e\x91\xf4\x0c\x14\x96\xd1\x0f\x96\x71\x14\x66\x39\x8e\x7d\x7d\xa1\x6d\x9a\x6b\x09\x41\x89\x77\xce\x77\x70\x5b\x52\x53\x2b\xb7\x3d\x8b\x95\x30\x83\xfa\x63\x26\x07\x8a\x6d\x84\xdb\x19\xce\x89\x8d\x58\x31\xc2\xac\xf9\xb9\x91\xdb\x76\x51\x6d\x98\x13\x3c\xc2\xe1\x25\xae\x58\x2b\xb6\x6f\x2b\x74\x1f\xe3\x79\xb5\x09\xd5\x1a\xb5\x5a\xea\xb3\x65\x17\xdc\x1d\x69\x9b\x9d\xb6\x0b\xca\x7d\xdc\xe6\xa6\x8b\x62\x9f\x55\x05\x34\x02\xb7\xa9\xe1\xa2\xc8\xc7\x6d\x21\x4b\x86\x02\x59\x87\x42\xbf\xe2\x33\xd0\xc8\x0f\xda\xb4\x5c\x94\xf9\x89\x15\xa0\x33\x53\x21\x30\x91\x49\xb3\x4d\x69\xe8\x59\x46\x91\xef\xe7\x3b\xe0\x12\x78\xd3\xb6\x2f\xf0\x4d\x66\x4d\xed\xf6\x24\x49\xf7\x83\xd1\xcc\xd2\x78\xac\x31\x8e\x70\x8e\x8d\xec\x0c\x9f\x17\x36\x1a\x59\x73\xeb\xb6\x40\x19\xba\x25\x75\x48\x3d\x49\x45\x90\x70\x4f\xb9\xf0\x4d\xdc\x31\xa5\x65\x4b\x67\x5e\x84\x14\x69\xbd\xb0\xb0\x6d\xbb\x28\xec\x7e\xde\x0e\xfc\xc1\x53\x86\x6e\x21\x25\x2e\x44\x72\xca\xb9\xd4\x14\x3e\x90\x0d\x2a\xa3\x93\x95\xdb\x3b\x83\xb3\xfc\x9c\xf8\xca\xfe\x55\x3d\xb7\xd5\x0a\x2d\xf3\xdd\xa7\xfd\x93\x93\x83\xbd\xfd\xc1\xf3\x67\xa7\xfb\xa6\xef\xfb\x31\x04\xcb\xc1\x28\xdf\x8d\x82\x2c\x3b\x80\x95\xe4\x49\x30\xc2\x9e\xf1\x35\x59\x1a\x41\x8a\x0d\x08\xca\xe6\x8b\x3c\x8c\xa7\x46\x9e\x18\x70\x84\x28\x0d\xc7\xd8\xf8\xf3\xbf\xb3\x3f\x8d\x49\x9a\xcc\x8d\x9b\x64\x99\x1a\x23\xe0\x36\xb2\x05\x1e\x85\x93\x90\x36\x5e\xdb\xd8\x8f\xb3\x65\x8a\x8d\x7c\x16\xe4\x94\x6a\x8e\xf3\x59\x32\x36\xe2\x60\x8e\x33\x63\x9c\x18\x71\x92\x13\xc4\x28\x58\x18\x30\xb3\x30\x88\x24\x8c\x2c\x6b\x9b\x28\xb7\x11\x26\x62\xef\xed\xbf\x38\x78\xbb\x3f\x38\x7a\xf6\xf6\x2b\x11\xfa\xee\x4e\x4e\x1a\x1c\xed\x9f\xbc\xdc\xdf\x7b\x7a\x75\xe8\x2c\x8d\x56\x26\x89\x59\x55\x78\xff\x1a\xf3\x84\x4a\x1f\x1b\x49\x3c\xc2\x6d\xe3\xc3\x2c\xcc\x8c\x51\x12\x4f\xa2\x70\x94\x1b\xf3\xe0\xc6\x18\x62\x63\xbc\xc4\x80\x14\x18\xf3\xf0\x3a\x8c\x89\xcc\x65\x17\x66\x16\x86\x0e\x0c\x27\x16\xfc\x6b\x99\x3c\xc3\x5c\xf1\x21\xbe\x48\x26\x86\x22\x2f\x11\xf3\xe7\x9a\x94\xa0\x34\x81\x24\x17\x6d\xec\x24\x35\x44\x39\x41\x26\x04\x30\x0e\xe2\x2c\xc7\xc1\x18\x19\xb0\x91\xcb\x78\x53\x3c\x5d\x46\x10\xe7\x10\x47\xd4\x36\x6d\x14\x5a\x2b\xb9\x15\xdb\x7f\xa1\xf4\x27\x97\xc5\x66\xb8\xb8\x8c\xab\x50\xe4\x27\xed\xc1\x20\x85\x8a\x3f\x5b\xe6\xc9\xf3\x30\x1e\x1f\x07\x61\x9a\xf5\xe3\xaa\xda\x8e\xec\x56\xeb\xb2\x4d\xda\x96\x34\x26\xfb\x69\x8b\xd0\x2d\xa0\xa1\x1b\x34\x71\x95\x37\xb0\x5b\xad\x60\xc5\xf7\x47\xd4\x64\x32\x3f\x3e\x0b\xce\xd1\xd2\x4f\xea\x84\x30\x4a\xa6\xd6\x12\x05\x36\xba\xac\xe7\xda\x97\x67\xc1\xb9\x85\x51\x66\xf7\x71\x94\x61\x82\x36\xae\x1b\x60\x00\x91\x6b\xd9\xc7\x3e\xef\xe3\x0c\xcd\xfc\x61\xab\xb5\x32\x6e\xb5\x56\x96\xad\xd6\x8a\xbb\xe2\xfb\x71\x1b\xbe\x85\x3d\x0c\xe3\x31\x14\x3d\xb3\xa3\x36\x1c\x70\xb6\x02\x94\xd9\x28\x39\x0b\xce\xfd\x8c\x14\x65\x84\x13\x6b\x49\xc5\x9f\xfb\x83\xb3\xe0\xbc\x1f\x5a\xe3\x56\xcb\x6a\xd0\xfe\xb9\x6a\x17\x24\xa9\xd2\xc7\x1f\x63\x7c\xbd\xc0\xa3\x1c\x8f\x89\xb9\x1a\x8b\x24\x0a\x47\x37\xc6\x7f\x67\xc6\x24\x49\x8d\x0b\x4c\x7e\x5e\xcd\x70\x4c\xfb\x78\x0a\xcd\x5b\x76\x3e\xb0\x80\x61\xce\xa1\xa5\x9a\x84\xd8\x21\x15\x98\x58\xf0\x07\x65\xb6\x57\x13\xa9\xd5\x22\x79\xfe\x82\x93\xd8\x05\xa9\x2c\x3c\xf9\x59\x51\x90\xa7\x7e\x69\x46\x4b\xea\xf7\x48\xa0\xc5\xfb\x3d\x06\xc1\x98\x33\x4c\xfc\xfc\x2c\x3e\x87\x86\xcc\xab\x7d\x12\xdb\x94\x24\xf5\x09\xc3\x65\x3f\xb4\x56\x52\xf4\x73\x45\xeb\x9b\x5d\x03\xe8\x73\x86\xd3\x4b\x3c\x36\x16\x0c\x13\x11\x7f\x81\xc0\x33\xe4\x46\x36\x4b\x96\xd1\x38\xfe\xe3\xe7\x1c\x9c\x41\x12\x1b\xf9\x0c\x1b\x26\x0c\x97\xe1\x28\x33\xa1\x3d\xdb\xc6\x1e\x85\x0a\xa9\xed\x80\x1c\x59\x1e\xc4\x23\x2c\x10\x49\x0a\x0e\xc6\x7d\x23\xcc\x8d\xab\x30\x8a\x8c\x2c\x87\x7f\x87\xd8\x08\xc8\xfe\x28\x2c\x8b\x71\xf0\x51\x12\x67\x79\xba\x1c\xe5\x49\xda\xfe\x19\xc5\x62\x71\x07\xaa\x87\xa1\x7a\x11\x32\x1f\x5d\xbd\x7f\xaa\xe7\x83\x88\xe6\x2c\x3e\xf7\x93\xa2\x28\xfb\x6e\xcc\xfa\xce\xc2\xad\x56\xde\x6a\x99\xd4\x2d\x94\xc6\x81\x35\x69\x39\x32\xe7\x38\x9d\xe2\x83\x38\x4f\x3e\x87\xf9\xec\x6d\xb2\xb7\x5c\x44\x30\xae\xe0\x37\x30\xd0\xdb\x9e\xb1\x1b\xc4\x30\x7a\x10\x32\x23\x4e\x62\x87\xe2\x66\x6d\xb3\x74\x0d\x4c\x45\x34\x3a\xd1\x6a\x59\xa1\x75\x99\x84\x63\xa3\xe3\xfb\x3e\x3e\x8b\xcf\x1f\x2e\xf1\x43\x1a\xe2\x31\x34\x1d\x21\x34\xf2\xab\x84\xb9\xb8\x8c\x8e\x5d\xd0\x37\x59\x30\xc7\xd0\xe7\x1e\x51\x91\x47\xb5\x5b\x1f\x84\x5c\x04\x70\x86\x0c\x5c\x34\xa8\x55\x98\x71\xd2\x51\xb0\xcc\xf0\xd8\x18\xde\x90\xe2\x2a\xf3\x2a\xcb\x36\x92\xd4\xa8\x4c\xe2\x2c\x9b\x0f\x9f\x06\x8d\x28\x40\x9d\x15\x39\x61\xf4\x98\x41\x2a\xc4\x4c\x52\xaf\x81\x09\xd9\xfd\x7a\x18\x32\xa1\x1d\xc8\x32\x2a\xe1\x21\x04\x81\x64\x0d\x86\x84\xcf\x48\x4c\xae\x6d\x94\xf8\xb9\x3e\x07\xcc\x14\x42\x14\xdf\x8f\xf9\xb4\x24\x29\xd3\x12\x9e\x16\xb3\x55\xce\xdb\x82\xcb\x34\xb6\x52\x10\x16\xfe\x24\x36\x4a\x25\x1d\x5b\x34\x89\xd8\x24\x5c\x83\x68\x12\xe4\x50\x0e\xb5\xf2\x36\x38\x6a\x0b\x97\x31\x68\x49\x38\x53\x97\x1f\xb0\x76\x5c\x43\xb1\xdf\xe9\xc7\xbf\xe6\x7c\x3d\x22\x5e\xf5\xbb\xb2\xeb\x42\x29\x78\xb0\x55\xf7\xbc\x4f\x96\x15\xa0\xf0\x54\x96\x66\x5e\xce\x42\x13\x29\xb6\x45\x09\x4a\x79\x68\xaf\x29\x95\x95\xd6\x6a\xcd\x48\x1f\xb0\x69\x0d\x38\x9d\xcc\xc7\xf4\x81\x1c\xbe\xba\xce\xfd\x84\x4d\x91\xf0\x24\xf3\x03\xfa\x7b\x49\x96\x1a\x52\x3f\xbd\xbb\x63\x13\x54\xf0\x6a\xd8\x87\x7e\x62\x3e\x87\xa4\x56\xb4\x72\x47\x97\x68\xd9\x34\x2a\x0d\xad\x9a\xad\x47\xad\xd6\xca\xb3\x34\x0d\x6e\xda\x61\x46\xfe\x5a\x91\x8d\xcc\xff\xd6\x41\x18\x73\x88\x32\x58\x17\x80\x6f\x22\x50\x60\x04\x00\x6e\xa2\xbc\x2d\x2d\x79\xdc\xdd\x31\x2f\x08\x4e\x2d\x0b\x73\xbc\xcb\xbd\x9b\xc9\x1a\x82\x56\x27\x22\xe1\xba\x08\x4d\xfc\x18\x5f\x19\x17\x48\x4a\x69\x4b\x0e\xd7\xcf\x95\x1c\x5d\xa3\xfb\x67\xe7\x68\x2a\xe6\x21\x19\xd5\x1c\x90\x0f\xe5\xb6\x8d\x32\x2b\x47\x37\xf4\x0f\xa6\x7f\xae\x41\x1b\x2b\x46\xdc\x6a\x59\x64\x07\x41\x24\xf8\x35\x12\xcb\x86\x18\x4e\x16\x86\xcd\xa3\xd8\x04\x8a\x8c\xf6\x56\xbb\xdd\x06\x5f\x59\x0f\xce\x69\x53\x86\xf3\x05\x9d\xb9\x18\x81\xf1\x27\xe5\xff\x93\xb9\x0f\xd9\x8f\xa6\xe0\xa2\x06\xb6\x54\xd8\x59\x7a\x7e\x77\x27\x97\x7e\x96\x9e\x13\xc5\x10\x16\x92\x17\xc0\x39\x85\xd6\x18\xf8\xb7\xc4\xd3\x65\x6a\x20\x80\xd8\x20\x59\x49\x2d\x17\xa8\xd4\x74\xa6\xa9\xda\x2c\x58\x8c\xd8\x6d\xce\xaf\x34\x9d\xa7\x8b\x5b\x6a\x8b\x56\x4d\x44\xbb\x52\x61\x7a\x24\x36\x91\xe6\x79\xef\xde\xee\xee\x9b\xea\xfc\x9c\x2e\x70\xc8\xcc\x12\xc1\x5e\x38\xbe\x37\xbf\xb6\x10\xa0\xd2\xd1\x80\x44\x28\x3b\x5b\x30\xbc\x47\x98\x0a\x45\x5d\x9a\xfb\x09\xe4\xf5\x06\x95\x84\x3a\x10\x21\x89\x57\x99\x7b\x16\xe8\xd2\x57\xd6\x28\x25\xbf\x96\x83\xeb\x96\xf2\xfc\xbc\x40\x4c\x87\x54\x2a\x35\x24\xac\x3a\xd9\x55\x1b\xe6\x0c\xe0\x60\xed\x42\xa3\x25\xd5\x02\x6b\x14\x7e\x04\x6b\x04\x9a\x0c\x98\xe4\xa1\xd1\xbd\x58\x3a\x18\x29\x8d\x20\x54\x15\xb3\x0a\x52\xc9\xdf\xa9\xa5\xf8\x13\xab\x96\x86\x72\xdb\xab\x13\xe6\x85\x64\x59\xd5\x72\x44\x0e\x97\x54\x24\x10\x31\xb9\xa1\xaa\x6c\x34\x28\x2f\x10\x9f\xc7\x94\xd9\xf6\x6d\x51\xa0\x1b\xff\x56\x56\xa2\xda\x9a\x1e\x1b\xb5\xc2\x8c\xe4\xe0\xb1\xbf\xd2\x29\x0a\x74\xed\xdf\x6a\x35\xeb\x5e\x46\xb7\x28\xd0\x95\x7f\x9b\xe2\x45\x14\x8c\x70\x65\xcd\x19\x84\xbc\x95\x07\xb4\x36\x8e\xbf\x2f\xf1\x12\x9f\x48\xe4\x6c\x25\x98\xd4\x56\x00\xcb\xd2\x52\xaf\xb6\xb2\x52\x2d\xbc\x28\xd0\x85\x2f\xd1\x89\x60\x25\xb2\x2e\x4a\xef\x88\xe4\xb9\xef\x95\x8d\xe6\x05\xdf\x90\x75\x7b\x6c\xd7\x64\x8b\x6c\x9b\x58\xdd\x2d\xd8\x35\x31\xa9\xae\x9b\x7d\xdc\xc6\xd7\xf0\x45\xe6\xcc\x4f\x9f\xb2\x9a\x44\x97\x8d\xb8\xcc\xb0\x36\x97\xcf\x5a\x2d\xb6\x0a\x19\xc1\xba\x2b\x4d\xb3\xdb\x59\x32\xc7\xba\xc5\xb2\x9c\xad\xe7\x9d\xe1\x73\x32\x59\x15\x4f\x85\x5d\xe8\x97\xa3\x70\xab\x25\xad\x07\xb2\x75\xf0\x0c\x45\x3e\x0d\xb3\x51\xc0\x7f\x84\xec\x07\x89\xf9\x68\x54\x33\xf2\x57\xdc\x3e\xec\x22\x4d\xc2\x28\xc7\x69\x29\x10\xac\x9a\x4c\xac\x11\x8b\x09\x57\x3a\x2c\xee\x70\x5c\xdf\xf7\x53\xb1\xa3\x15\xdb\x77\x77\x09\xac\xa0\xa2\x5c\x8c\x3f\x51\xab\x65\x8d\xfc\x95\x8e\x8d\xa2\xc2\x46\x51\x9b\x7d\x3e\xd1\x82\x66\x3e\x3b\x47\x01\x0c\x4c\xa9\x76\xb9\x10\x8a\xc8\x69\x11\xb1\x28\x02\x43\x40\xeb\xc0\xc4\x3d\x92\xd2\xfa\xf9\xdd\x5d\xb2\x13\xd2\xa9\x3b\xb6\xbd\x80\xff\x2a\xd8\x84\x36\x12\x65\x85\x7e\xca\x44\xbb\x8d\x70\x70\xc9\x36\x8d\x61\x05\x71\x06\xe7\x45\xd9\x63\x80\xc8\xf9\x4e\xf6\x14\x16\xa5\x9e\x74\x20\x4a\x09\x9e\xaa\x06\xa4\xf9\x56\x2c\x2c\xa6\x9c\x30\xb9\xb2\xd9\xbe\x08\x44\x3a\x60\xe4\x74\x9d\xda\x64\x33\x2a\xd8\x18\x81\xf5\x1e\x12\x33\xc0\x7c\x55\xc4\xd3\xa6\xd2\xf5\xd2\xb2\x24\x86\xa2\x10\xec\x8b\xb1\xa9\x36\x66\x2e\xf8\xd7\x18\x45\xb2\x75\x94\x8e\x5a\xc4\xdc\x8c\x12\x25\x3e\x0d\xfe\x62\xd8\x4e\xec\xf4\xa3\x5f\xe3\x7e\xb4\xba\x6a\x27\x67\xd1\xb9\xb4\x65\x17\x91\x49\xbe\x88\xea\xc9\x3c\xc0\xbe\xb5\x3a\x28\x39\x4b\x18\x92\xe3\x9e\xdb\x96\x5d\x08\x43\x4c\xda\xc1\x78\x0c\x31\x65\x54\x4a\x9f\x5b\xb9\xa4\xe9\x29\x1e\x2f\x47\x92\x15\x48\xc6\x63\xc4\xb0\x61\x04\x5d\xd2\x6a\xe5\xac\x73\x2d\x91\x86\x62\xd4\x43\x99\x6d\xa3\xbc\x40\x67\xe7\x52\xeb\xc4\x4f\xc2\xdf\x25\x1a\x50\x29\x80\x26\xa2\x18\xad\xa1\xa5\xa6\x04\x61\xe4\x72\x8a\x7d\x9b\xfa\xb8\x80\x1e\x17\x1b\x3e\xc4\xd4\x62\x8b\x1f\x19\x61\x96\x27\x3c\x01\xec\x47\x94\x3b\xcf\x18\xc5\x28\xb2\x6f\x73\xd8\xf6\x95\x52\xed\x5b\x7c\x77\x97\xee\xc0\x6e\x70\x6a\x7b\xb1\x45\xf6\x25\x62\x49\x18\xf0\x03\x31\x74\x44\xd6\x26\xc7\x99\x59\x71\x91\x9f\x4b\x53\xa6\xc4\x8a\x78\x5f\x97\xd0\x28\x2f\x95\x46\xa4\x02\xce\x2c\xc8\xac\xe8\x0c\x9f\xdb\xb0\x54\xc4\xba\x9b\x3b\x12\xae\x0d\x36\x82\x09\xcb\x3c\xb9\xc4\x94\xd4\x2e\xfa\xf0\x17\xb6\xd3\x50\x0a\x1b\x1a\x92\x88\x21\x29\x2b\x85\xea\x2d\x15\x21\xc1\xf9\xca\x42\x06\x1a\x21\x85\x66\x2b\xca\xbc\x84\x65\x05\x2b\x78\x50\xc8\x25\x17\x32\x60\x42\xc2\x5f\x2b\x47\x29\x69\xed\x02\xfe\xcf\x51\x8d\x11\x88\x29\xdb\x49\x07\x25\xc2\x9a\xfa\xf1\xaf\x49\x7f\x75\x35\xb6\x61\xba\xde\x4e\xe2\x43\xf0\x23\xad\x96\xfc\x44\xb7\x35\x21\x05\x86\x32\xb6\xe8\x89\xaf\x8c\x00\xb6\xde\xe1\x2f\xab\xe8\x6d\xba\xa4\x6a\xfd\x2a\x49\x2e\x32\x2f\x45\xe9\x92\x69\x1c\x4d\x08\x21\x81\xe0\xd3\xe7\x51\x51\x10\xef\xc3\xfa\x35\xb6\xba\x1d\x1b\x05\xa2\x35\x0c\xcc\x96\x03\xe8\x26\x69\x3f\xe1\x3b\xab\x08\xfe\xb6\x67\x80\x01\x9e\x90\x3c\x05\xe3\xb1\xaf\x1d\x71\x08\x19\xb3\x01\xbb\xe0\x13\x52\xe8\xdf\x7b\xe8\x7d\xce\x57\x1d\x3a\x24\x0b\x5c\xf1\x7d\x5c\x70\xc4\x59\x90\x69\xe0\x88\x6b\xe7\x50\xa5\x83\x67\x3c\x44\x61\x4a\xae\x9a\x0c\x67\xe7\x45\xf1\x17\x1c\x33\xf6\x7d\xbe\x71\xbc\xd2\x29\x97\x40\xf0\xdd\x1d\xfd\x21\x32\x5d\xc8\x54\x27\xc9\xd8\x66\x99\x86\x9a\x0e\xdb\x3c\x5c\x61\x7c\xdf\xe7\xc1\x30\x24\xc2\x08\x78\x53\x36\x10\x96\xdc\x50\x22\xc2\x64\x1b\x8a\x2a\x27\xe9\xbe\xb4\x4a\xb6\x63\x2e\x63\xba\x6c\x38\x36\xbd\xcc\xc2\xb6\x2d\x69\x2a\x59\x7d\xb4\xc3\x09\xdf\x90\x13\xf1\x4e\x65\xf1\x8d\x69\x28\x8a\x6d\x20\x56\x96\xe0\x48\x9b\xd0\x14\xe8\x0c\x48\xe1\xf5\x27\xa3\x29\x64\xaf\x3c\x0a\x1e\x5a\x5f\xf0\x42\x85\x56\x12\x66\x14\x00\x5a\xa2\xb2\x1f\x1d\x7e\x82\x03\x5e\xbe\x8f\xa7\x16\xb6\x7d\xdf\x67\xbf\xf3\x7a\xbc\x43\x89\xcd\x5f\x4c\x90\xb2\x3d\x9a\x05\xe9\xb3\xdc\xea\x80\xa7\xca\x7d\xf3\x17\x73\x35\xb7\x11\xcd\xc4\x3c\x93\x77\x88\xe3\x02\x15\x5e\x05\x32\x1b\x55\x10\x78\x57\x51\xa2\x9c\x11\xe5\xd0\x38\xa5\x08\x91\xf0\xfb\xac\xe1\x13\x1f\xa3\x14\x6c\x8b\x85\x1a\x10\x5f\x71\xa4\x7e\xf0\x6b\xd8\x5f\x5d\x0d\xf8\xf6\x47\x4e\xb7\x3f\x32\x12\xfb\xdd\xdd\x99\x26\x34\x8d\xf9\x0b\x2c\xc9\x2f\xb9\x14\xa4\x1e\x0a\xa8\x8d\x40\x1b\x57\x7c\x3f\x69\xb5\xd8\x56\xc4\xd8\x67\x23\xc8\xa8\x3d\xb2\xad\x25\x4a\x88\xd6\x8c\x77\xac\xc4\x1f\x83\xb7\x0b\x42\x78\xb1\xe6\x38\xc8\x67\xb0\xd5\x47\xe4\x83\xc9\xcf\x28\xc8\xad\x14\x8d\x69\x68\x09\x53\xbe\x0c\x06\xfb\x32\x2f\xe2\x79\x9f\xe0\x74\x64\x66\xdb\x5e\x42\x16\x15\x90\x69\xfa\xd2\xca\x60\x5a\xd7\x64\xd1\x2b\xbc\x0b\xa3\xb3\xfc\x5c\xea\xc5\x18\x5c\x6f\x61\x17\xbc\xc7\xdd\xb2\x45\x03\x85\x9d\x19\xde\x0e\xb3\x44\x4f\x58\x24\xb1\x10\x49\x17\x42\x1e\xf3\xa0\x90\xc7\xb0\x34\xa6\x26\x35\xce\x7c\xdc\xfe\xbe\xc4\xe5\x71\x0f\xc0\x59\xf1\x63\xd8\xb9\x21\xaa\x31\x52\xda\x7b\x04\x7d\xbd\x3a\xb2\xd1\xca\x8a\x95\x5a\x23\x14\x0b\x24\xfb\xee\x6e\x25\x6f\xb5\x22\x6b\x84\x12\x14\xda\xb0\xa1\x65\x65\x28\xa6\xe0\xb6\x4d\xbc\x72\x28\xce\x4d\xba\x1d\x38\x6c\xa5\xd9\x81\x3a\xbd\x99\x0f\x93\xa8\xd5\x32\x33\xf2\xa3\x9a\xd1\x0e\x73\x9c\x06\x79\x92\xee\xd4\x3d\xa3\xc1\x28\x71\xe1\x69\x32\x61\xf5\xbe\xb9\x38\x75\xed\xcc\xf7\x45\xfa\x0a\xff\x5d\x1a\xf2\x0e\x97\xcd\x13\x05\x3e\xcd\xa5\xb2\x8d\xd6\xbc\x3c\x7e\x70\x77\x27\x3d\x64\x95\x90\x08\x7a\x04\x35\x13\xb3\x4d\x4b\xb2\xee\x26\x96\x30\xee\xee\xd4\xe7\x0c\xd4\x5e\x04\x30\x09\x77\x3e\xe0\x59\xd9\x39\x60\x2b\x80\x33\x1f\xa9\xdd\x6a\xa5\xed\x7c\x26\x1f\x16\x29\xdb\x90\xc9\x02\xa3\x4d\xcc\xe6\x0d\x10\x64\x55\xdd\x8e\x3a\x7d\x63\xd3\x2b\x01\x07\xba\x98\xda\xb7\x44\x4d\x21\xf2\xe1\xe3\x7f\x39\x58\xc7\x56\xaf\xfb\x97\x26\x11\x10\xd9\x43\x74\x07\x23\x16\x5d\x0a\xa1\xf3\x13\xd6\xa0\x67\x54\x7a\x39\x87\x44\xe9\x2b\x64\x11\x62\xb7\x4e\x7f\xce\xa2\x88\x95\x72\x2e\x88\x46\x7e\x79\x26\x2c\xe7\x67\x2d\x02\x0b\x22\xaf\x02\x65\x7e\x15\xca\x1a\x29\x41\x24\x91\x2d\xf7\x57\xb0\x98\xe4\x4e\xa0\x95\x72\x1b\x45\xac\x50\x03\xca\xb1\x42\xff\x0c\xa3\x1c\x8e\x92\x40\xb5\x61\xb0\x63\xb9\x8c\x6b\x09\x9d\x95\xd9\xad\x56\xd6\xd8\x59\x29\xed\x2c\xb9\x18\x0c\x07\x98\x52\x70\x65\x2b\x2e\x0a\x2b\xfd\x06\x41\x1e\xb8\x08\xd2\x78\xa1\x38\xcc\x6c\x87\xac\xd3\xe5\x34\xb1\xe3\x4b\xaa\x5b\x9e\x7c\xe6\x0e\xa6\xa1\x89\x22\xbb\x40\x63\xbf\xc2\x53\x6f\x21\x0b\xa3\x7a\x03\x9d\x75\xc0\x2f\x72\x65\x25\xf5\x1f\xdb\xad\xd6\xb8\xb1\xfe\x4c\x6e\x19\x05\x53\x14\x14\x32\xe5\xad\xea\xc9\xdd\x5d\x4d\x13\x48\x85\x16\x52\x1c\x06\x11\xb6\x7c\x50\x0d\xba\xcb\x08\x61\xfa\x00\x94\x81\x9f\xd4\xe2\x3b\x21\x12\x5b\xdf\x28\x6b\x91\x41\x2d\x74\x71\x3c\x78\xd0\xdb\x94\x84\xec\xac\x63\x44\xa6\xf0\x1e\x77\x77\x31\xb7\xe7\x33\x20\x14\x83\x93\x1a\x66\xc5\xf6\x4e\xec\x41\x30\xd1\x0f\x21\x94\x67\x35\x4f\xe8\xdc\x48\x2a\x92\x34\x3c\x5b\xfd\x87\xac\xa1\xcf\x4d\x2a\x42\x0b\xbb\x3f\x6c\xb5\x16\xea\xc4\x61\xc8\x1b\xd1\xb2\x35\xa3\x7e\xe3\x9c\x52\x99\x49\xc5\xad\x16\x1c\x4c\xed\x6b\x03\x44\x0c\x23\xe3\x0e\xfc\x4b\x43\xed\xd4\xf6\xe0\xc1\xcf\x49\x04\xb7\x73\x06\x0f\x28\x3d\xf7\x52\x84\x0b\x84\x25\x21\xd4\x81\x92\x2e\x14\x6a\x87\xc4\x08\x85\x88\x75\xf1\xd2\xc7\x0f\x06\x1b\xb0\xbd\x26\x06\x4e\x08\x07\x50\x28\x47\x1d\x31\x89\x3a\xf2\xf4\x86\x00\x4e\x78\xe4\x31\xa6\x91\x47\x6c\xf7\x27\x3b\x56\xec\x4f\x34\x61\x87\x1a\x5a\x4c\x94\xb0\x23\x94\xc2\x8e\x10\x4d\x2a\x61\x47\x4c\xc2\x8e\x62\x04\xa7\x3c\x41\xf7\x21\xd8\x85\x79\x35\x89\x43\x98\x7e\x2c\xfc\x5b\x76\x36\x0c\xd4\x49\x98\x63\x04\x66\xc0\x5b\x9e\x68\x31\x75\x03\xd0\x28\x15\xbd\x00\x75\x07\xe4\x3e\x0f\x6e\xd5\x8e\xca\xd9\xc9\x85\xb8\x6f\xc5\xfe\x82\x39\x7c\x9b\x74\x1a\x53\x99\x18\x9a\x1f\x98\x8d\x1c\xf4\x88\x52\x10\x02\x2b\xb7\xfb\x19\xb5\xd4\x85\x5d\x40\xe0\xc3\x66\x17\x2b\x7e\x7c\x77\xa7\x58\x27\x2d\x64\x58\xda\x22\xf1\xf5\xc9\x4e\x66\x25\xb6\x97\xee\x8c\xac\x14\xe5\xa8\x7a\xc4\x31\x83\x63\x8d\xf1\x8e\xc5\xcf\x84\xb6\x97\x71\x36\x0b\x27\x30\xdf\x47\xac\xdc\xd8\xb6\xbd\x0c\xce\x15\x82\xaa\x87\xec\xf7\x8c\xa9\x3f\xb4\xc6\xd0\xee\xcf\x5a\xad\xa1\xaa\xfe\x33\x56\xa1\x4c\x56\xff\x11\x55\xff\x52\xc1\xc5\xec\x9a\x19\xf9\x6f\x6b\xad\x96\x98\x38\x88\xcc\xb3\xb5\xf3\x1d\xf9\xc1\x23\x01\x6d\x8d\x77\x5d\xcb\xbb\x2e\xf3\xae\x9f\x7b\x67\xe7\x7d\x31\x59\x49\x44\x18\x57\x6a\xae\xa2\xd2\xb9\xbf\x00\xf3\xc8\xd1\x2d\xcf\xf6\x20\xc0\x2b\xa9\x0b\x1b\xd6\x13\xcb\x67\x71\x44\x96\xf8\x2e\x3e\x5d\x28\x9b\x3d\x46\x01\x1a\x81\x2b\xa1\xb3\x18\x18\x8f\x65\xef\x45\x0c\x13\xdf\xdd\xe5\x3b\xa4\xa9\x6c\x2f\x10\x8b\x34\x30\xde\x8f\xfa\x6c\x25\x80\x4c\xdb\xe1\x10\x7e\xaf\x0b\xa7\xf0\xc9\x3b\x18\x68\xc2\x4e\xd7\xc7\xd6\xba\x0d\x47\xf0\xd9\x0c\xeb\xef\x7b\xca\xfe\x69\xd1\x0b\x97\xa8\x3c\x47\x40\x5d\xd2\x0e\x9b\xa9\x5a\xa9\xbc\x1e\xae\x5b\x10\x7e\x94\xbc\x39\xc2\x20\x2f\x4c\x34\xfc\x9c\xce\x36\x50\x6c\x7b\xe5\x7b\x12\x7c\x05\x37\x79\x48\x7c\xfe\x3e\x98\x6b\x93\x93\xd6\xb1\x95\x40\x68\x41\x5e\xa4\x08\xc8\x73\x04\x6b\xd8\xe4\xe5\x2f\x34\xfa\xfb\xf7\x16\xca\xfc\xa0\xf6\x36\x82\xf4\xf2\x59\xfd\xd8\x31\x63\x4d\x6b\x6f\x18\x84\xed\x00\x8d\x88\x61\x95\x67\x8a\x6f\x93\x38\xba\x79\x46\xee\xb0\x7b\x17\x13\x58\xf2\x56\x9d\x38\x85\x9b\x3d\x45\x5b\x44\xd9\xca\xe6\x23\x38\x4c\x30\xd2\xbb\x3b\x53\x44\xfe\x66\x2d\x3c\x67\x27\x45\x60\x19\x17\x8e\xd9\x9c\xe0\x09\x4a\xfd\x65\xad\xe6\x9f\xa5\x17\xe8\xd8\x8e\xe6\x19\x53\xc4\x05\x28\xa2\x49\x1c\x6a\x6a\xda\xe7\xea\xfe\x22\x3f\xa6\x3c\x6c\x0f\xe5\x9d\x3c\x25\x79\x8a\xf3\xcf\x69\xb0\x58\xe0\xf1\x01\x5b\xee\xd7\x34\x6b\x7c\x77\x17\xb0\x63\xf9\x64\x6d\xeb\x4a\xe5\x68\x3a\x07\x4e\x57\xf6\xc0\x69\x89\xb6\x67\xa7\xa5\xef\xee\xe4\x93\x24\x2c\x91\x4c\x00\xc4\x8c\x7d\x54\xeb\x4a\x2c\xf5\x21\x5f\x9c\x4e\xca\x33\xe4\x49\x79\xcc\x1c\x62\x3f\x36\xd9\x09\xfd\x59\xb5\xf7\x59\xf5\x13\x3e\x00\x4b\x67\xb7\xc5\x7b\x25\x41\x19\xe7\xc3\x04\x3c\x6c\xa7\x78\x22\x85\x9e\xf6\x6d\x5e\x6d\x04\x1f\xc3\x09\x71\x8d\xd0\xa1\x5d\x94\x60\xa9\xb2\x43\x2d\xbd\x1a\x69\x99\xab\x89\x85\xed\x55\xd3\x36\x51\xda\x66\x5d\x22\x54\xc7\xc7\x68\x62\xd9\x16\x79\xbb\xa3\xb2\xa8\x5a\xb1\x70\x17\xf6\x02\xe1\x39\x84\x69\x3d\xf1\x00\x4b\xe2\x01\x32\xea\xbd\x37\x37\xc9\xab\x53\xed\xd8\x1a\xb3\x77\xa7\xba\xf4\xdd\xa9\x6e\x0f\x5e\x9e\xfa\xdb\xbb\x86\xc7\x79\xc2\xdb\xda\xee\xbb\xb7\xd2\x51\x8d\x63\xa5\x83\xe4\xd3\x32\x90\x2f\x1b\xdd\x4a\xa7\xf6\xaa\xd3\x4a\x87\x5b\xdf\x4a\x47\x32\xa7\x95\x0e\x02\x1f\x07\x2e\x04\xa5\xfe\x6d\xcc\xb8\xa9\xc7\x64\xa4\xd4\x0d\xc2\x03\xb8\x3e\x9c\xc2\x2f\xd1\x4a\xf4\x21\xcc\x6f\x08\x44\xa4\x5b\x8b\x61\xad\x36\xc5\xb9\xd4\x56\x74\x35\x24\x93\x36\x7c\xab\x2d\x13\x4e\x2c\x13\x1a\x26\x9e\x96\x87\xc7\x99\xdb\x09\x7c\x2d\x26\xa8\x25\x04\x6a\x7d\xd8\x0b\x0d\xe0\xdd\x0b\x1a\xef\xde\x27\x80\x95\xdb\x76\x79\xf0\x27\xf4\x3b\xfd\xf0\x57\x3e\x95\xea\xaf\xae\x86\xb0\x68\xbb\x02\xa7\x76\xcf\xc2\xf3\xf3\xbb\xbb\x94\xff\x20\x53\x0e\xf2\xdb\xb6\x21\x56\xc7\x34\xc3\xcf\xe9\xdf\x32\x94\x2e\x9e\xda\xfd\x44\xed\x95\x81\x90\x6c\x3d\x58\xcc\x4e\x58\xe8\xd2\xb5\xab\xaf\x1d\xae\x53\x9b\x81\xd7\x0a\x97\x7e\xaa\x1f\x81\xca\xb7\x84\xf9\x11\x87\x5b\xe9\xc5\xa0\x17\x69\x32\x27\x47\xc8\x98\xfd\x97\x2e\x91\x38\xfc\x1c\x02\x3d\x69\xfa\xee\x97\x0e\x43\xcb\x0e\x0b\xe6\x85\xe2\xbb\xf3\xc4\x8b\xda\xb4\x4f\xdb\x61\x76\x82\xbf\x2f\xc3\x14\x8f\x11\x59\xdb\xf3\x22\xfe\xfa\x20\x88\x86\xcb\x47\xb6\xf7\xe8\x65\xed\x51\xf9\x66\x61\xd6\x1e\xe9\x1c\x77\x48\x1d\x3d\x1f\x0b\x97\xff\xd2\x16\x7f\x54\x83\x43\xc3\xfc\x73\x5a\x9b\x0d\x9a\x23\x12\x30\x57\x5b\x16\x82\x39\xda\x42\xdc\xeb\x7a\x59\x3b\x28\x9f\x32\x2f\x6b\x0f\x91\xbc\x94\xe7\x45\xf4\x95\x4a\x65\x79\x8f\x25\xfe\x1f\x36\xed\x53\x5b\xf5\x29\x0d\x0a\x2f\xcb\xd7\x1a\x89\x2b\xe0\xdf\xa7\xa5\x1a\xc3\xf7\x7f\xf5\x1b\xcd\xfa\x88\x0e\x8b\xf7\x21\xd3\xf2\x85\xb7\x40\x7e\x11\x6e\x41\x26\xb5\x67\x26\xa3\x33\xd9\x6b\xf7\x70\xd3\x14\xa7\x81\xd7\xd7\x20\xf0\xba\xbb\x63\xad\x81\x62\x98\x88\xcb\x3a\xbc\xe0\x2f\xe2\xb3\x44\x32\x13\x8c\xf9\xbc\x70\x09\xb1\x61\x6a\xdb\xfd\xc0\x0f\x76\x62\xe6\x66\xf8\x8b\x96\x56\x60\x7b\xb1\xe6\x05\x4c\xcb\x46\x43\xfa\x2e\xa8\x15\xc8\x93\x45\x32\xc3\x87\xc2\xf8\x99\x1c\x88\xd6\x58\x52\xc8\xcb\x1f\x43\x89\x31\x1a\xa2\x14\x0a\x9d\x40\xe0\x95\x8a\x78\x2b\x54\x5f\x3d\xbe\xad\xbd\x1b\xe9\x0d\x11\x27\x85\xe5\x07\x72\xa4\xa0\xd5\xaa\x09\x9e\xa0\xa8\x7d\xb2\x7f\x7c\xf8\x6c\x77\xdf\x46\x81\x5d\x94\x6b\xd6\xee\xc6\x03\x86\xd1\xdb\x60\x41\x91\x0b\x96\x01\x93\x57\x12\x13\xf5\x7a\x10\x13\xfd\xed\x43\x9f\xa7\xaf\xc3\xdb\xb7\xd9\x55\x08\x5d\x89\xdb\x01\xac\x13\xbd\x20\x77\x26\xd8\xb7\xa3\x20\xc3\x26\x19\x85\x4c\x8f\xc1\x0b\x4c\xb6\x68\xc2\x92\x61\x59\x06\xd6\xaf\x76\xce\xc8\x26\x85\x8d\xcc\x33\x13\x25\xc8\x3c\x37\xcf\xdb\xdf\x92\x30\xb6\x4c\xd3\xf6\xe4\xbc\xc8\x4a\xc8\xcf\x73\x1f\x7e\xc7\x08\xdb\x25\x61\xd1\x27\x05\x0f\x53\xb8\x6f\x34\xd7\x16\x5d\x2f\x98\x62\x4b\x65\xe8\x91\x59\x2c\xf7\xd7\x30\x75\x88\xd2\x49\xfa\x54\x1c\xf1\xea\x3f\xbd\x3d\xd9\x51\x10\xb2\xd9\xf0\xcb\x1f\x67\xd6\x1f\xe3\xff\xb1\xff\x38\xff\xc7\x2f\x6d\x7c\x8d\x47\xb0\xf6\x85\xc1\x45\xd0\x73\x85\xd6\x2f\x7f\x9c\xfd\x31\xfe\x1f\xc8\x46\xa6\x69\xa3\x15\xbe\x9b\x4f\xb7\x22\x12\x58\x28\x88\x6d\x69\x51\xe9\x0c\x9f\xc3\xd6\x2b\xa4\xdf\x16\xf0\x12\x18\x3e\x3f\xcb\xcf\xdc\xf3\x73\x3f\x7e\xa8\xb1\x99\x5c\x2c\x39\xf7\x7f\xb1\xfe\x38\xfb\xe3\xdc\xbe\x47\x30\x2e\x55\xbe\xa3\x08\xb0\x53\xca\x06\x8b\xdb\x9e\xf4\x28\x96\x4b\x21\x17\xf6\xd7\xa5\xcc\xf8\x9e\x4e\x63\x7a\x2d\x6f\xf6\xc3\x22\x89\xd2\x18\x90\xe0\xe7\x76\x3f\x56\x4b\x82\x47\x94\x2b\xdd\x17\x29\x8b\xd0\x79\x1b\xc7\xa3\x64\x8c\x77\x72\x32\x8a\x8d\xf2\x1d\xd8\x32\xf0\x68\xe2\xc7\x93\x03\x31\x3e\x91\xd4\x12\x25\x90\xa6\xfa\x95\xd5\x71\x7b\x07\xb7\xb3\x24\xcd\x2d\xdb\xab\xbd\xaa\x80\x77\x02\x1e\x3c\xc3\xbb\x33\x10\xd0\x51\x5a\x0e\xac\x08\xf7\x76\x39\x1f\x62\x50\x37\x87\xfd\xca\xed\xc2\x6e\xcf\x83\x45\x49\x5e\x12\xc3\xaa\x7b\x01\x32\xf2\xcb\x73\xb6\x7a\x34\x8e\x75\x7b\x10\xbb\xe1\xeb\x3c\x0d\x46\xb9\x32\x6b\xe5\x9c\xed\x6c\x11\x85\xb9\x65\xee\x98\xf6\x99\x7b\x0e\xdb\xfa\x05\xbc\xa4\x10\xa4\x99\x7c\x6e\x06\x04\xcb\xfd\x91\x75\x2b\x29\xbb\x67\xc6\x49\x8c\xcd\x02\x8e\x4d\x42\xb9\xb1\x9f\x5a\x39\x2c\x4c\xb2\x4a\x52\x67\x6d\xc9\xe7\xfa\x6b\x53\x10\xbc\x93\x78\x16\xa8\x7d\x9e\x86\x73\xcb\x2e\x95\xec\xff\x67\xfd\xb1\x73\xf7\x5f\x77\x2d\x9b\x28\x9a\xbd\x63\x09\x41\x5b\xa6\x7e\x9d\x8d\xbf\x40\x53\xea\xe9\xea\x2f\x53\x64\x1a\xa6\xcd\x39\x7d\x13\xd6\xc4\xf2\x36\x5d\x6b\x86\x00\x8a\x1f\x75\xf8\xad\xb3\x93\x33\x7b\xf7\x4d\xdb\x63\xc3\x1a\x3f\x7c\xea\xfb\x7e\x44\xb6\xf4\xbd\x31\xae\xa9\x06\x89\xbb\x34\xe9\xb0\xa3\x07\xdb\x8f\x7c\x6d\x96\xf6\x79\xc2\xba\xdc\xd6\x6d\xc1\xf0\x91\x23\x81\x11\x83\xf5\xcf\xf3\x24\x89\x70\x00\x47\x59\x35\x2f\xba\xc5\xb5\x97\x5f\x62\xba\x37\xe3\x07\x56\xcc\x36\x66\x62\xd8\x88\xd1\xf4\x08\x1c\x8f\x80\x9e\xa6\x3d\x12\x4e\x6e\x74\xbd\x4d\x4d\x01\x66\xa5\x40\x36\xca\xe1\xd7\xbd\x1a\x90\x58\xe5\x19\x5a\xbc\x23\xd7\x1c\x8b\x9a\x2b\x3a\x2c\x16\xe5\x61\xf1\xaf\x2f\x9b\x79\xca\x6c\xdc\x34\xcb\xb3\x4d\x22\xd1\x80\xc1\x25\xb7\xeb\x07\x9b\x52\xb6\xed\x01\xa7\x66\xb9\x20\x69\x3b\x8b\xc2\x11\xb6\x1a\xf4\x86\xaf\xde\xe3\x56\x8b\x9d\xc0\x8d\x61\xe4\x42\x7c\xe2\x0a\x37\x29\xa0\x80\xa9\x47\xcb\x14\xc3\x30\x15\x61\xd5\xf4\xcd\xd5\x08\xb6\x39\xc0\x3e\x1b\xb7\x19\x0d\xbe\x2a\xff\x5b\xa7\xb0\x4b\x2c\xcf\x34\x1f\x9c\xc8\xea\x26\xf5\x25\xac\xce\x59\x95\x36\x70\xb6\xf2\xb3\x65\xff\xcf\xf9\x2f\x53\x54\xe7\x35\xff\xdb\x5c\x85\xbd\x9c\x20\xdd\x4d\xc6\x98\x1c\x42\x6a\xe7\x09\x3b\xf2\xe2\x6e\xd8\xed\x3c\xf9\xb8\x58\xe0\x74\x37\xc8\x30\xd9\x10\x78\x5a\xbc\xc1\x05\x84\x73\x1c\xe5\xe5\x65\x3b\xd8\xbb\xe5\xbe\x1e\xc3\xb1\xc0\x32\xcb\x67\x07\xb4\xd3\xbf\x7f\xfc\x45\x16\x12\xd6\x48\x88\x99\xc8\x01\x66\xc2\xc3\x4b\xd7\xa5\xe1\xe5\x06\x8d\x2e\xbb\x24\xbc\x4c\xac\x25\xbb\xac\x68\x03\x16\xdc\xe4\x6a\x71\xe4\x7b\x15\x08\xe6\xbd\xb8\x68\x70\x1d\x9c\xea\x8c\x8c\x2e\xe7\x3e\x58\x76\x90\x63\xb0\xfe\x5b\xd8\xcc\x19\xfa\xb5\xab\x39\xaa\xad\xf7\x5b\x47\xbb\x97\xd5\x91\xf7\xb2\x3a\xe7\xde\x6d\xd1\x57\x6d\x0e\xdb\x3b\xd8\xbf\xc5\x71\x9e\x86\x38\xf3\x70\xe1\x71\x37\x5f\x0e\x7d\x10\xc7\x96\x24\xe4\x45\x00\x76\x73\x4c\x4d\xaa\xd9\xd9\xfc\x1c\xe5\xbe\xd5\x41\xfc\x9e\x15\xd8\x92\x85\x59\x3a\x8a\x99\x43\x46\x09\xfb\xd1\xc7\x50\x59\xd8\x38\xf1\xc9\x2f\xc8\xb0\x62\x9b\x1f\x20\x26\x18\x64\x28\xa3\x10\xc2\x37\x59\x1d\x34\xaa\x4c\x26\x6c\x2b\x85\x49\x4a\x84\x6e\x49\xbb\x79\x49\x61\x23\x56\x5a\x6c\x17\x28\x56\xba\x0b\xe0\x73\x7f\xbe\x8a\xb9\x8b\xc9\x7f\xf3\x3b\xad\x56\xfe\xeb\x8c\x35\x65\x81\x12\x85\x21\x9c\xc0\x0b\xcc\xf0\xd3\xbe\x9d\xaf\xfa\x98\x4d\xe8\x73\xcb\xee\x2f\xa5\xab\x61\x3e\x24\x54\x8a\x04\xdd\xc2\x87\x75\x12\xb8\xf4\xe9\xf8\xdd\x71\x41\x16\x28\xa4\xe3\xc3\x98\xa0\xb8\x68\x2e\x0a\x6c\xb5\x66\x64\x98\x1b\x61\x6b\x6e\xa3\x19\x3f\x07\x8b\x06\x16\x6d\x18\xa6\x3d\x76\x21\x9d\xbb\x05\xe5\x82\xd6\xf6\xb1\x86\x6c\x09\x3d\x30\xe6\xaf\x11\xb2\xd6\xc1\xe8\xb6\x3e\x4f\xf4\x72\x04\xa5\x89\xc7\x08\x31\xf7\x23\x52\x02\x34\x4d\xa0\x41\x61\x79\x19\xa3\x99\x3f\x6c\x33\x4d\x40\x73\x7f\xd8\x1e\x51\xb8\x7e\x4d\x6b\x66\x3b\x33\xff\x6c\x76\xee\xa9\xda\x36\xb3\xef\xee\xac\x99\x7f\x66\xfe\x62\x9e\xc3\x3a\xf5\x4c\x0d\x8a\x84\xc1\x68\xfb\x18\xde\xad\x20\x47\x03\x7c\x7f\xbe\x33\xf7\x79\xeb\x39\xae\x37\x27\x3d\x58\x36\xe8\xdd\x9d\xd5\x41\x61\x59\xff\x15\x97\xdf\x20\xb7\xb0\x66\x36\x1a\xf8\x5a\xf3\x9b\x42\x08\x9a\xc3\xbb\x69\x75\x81\x48\xa6\xd8\x46\x24\xed\xb9\x44\xb7\xa3\x20\x7e\x99\x78\x71\x61\x17\x7d\xf1\xd2\xa6\x3f\x7c\xc8\xd5\x56\xfd\x66\xde\x8e\x92\x64\xf1\x2c\xbb\x89\x47\x8a\x60\x88\x1d\x37\x48\xfc\x0e\x4a\xfd\x15\x97\x9e\x32\x0a\xe0\x1f\xe9\xf0\x94\xe0\x28\xfd\x6b\xdd\x3b\xa0\x9c\xbd\x63\x41\x5e\x66\xe9\xf4\x93\x5f\x71\x3f\x59\x5d\xb5\x73\x98\x9f\x96\x0e\x82\x8e\xe2\xa9\xbf\xd2\xa9\x9d\x9e\x82\x10\x5d\xdd\xaf\xcf\xed\xa2\xbf\xc2\x4b\x37\x32\x8b\xac\x53\xaf\xa4\x64\xc1\x79\xa5\x83\x56\x22\x76\x46\x38\x82\x3a\x42\x7a\xf2\x2b\x8c\xd2\x7d\x9b\xd4\x20\xb7\x92\xd5\x55\x94\xa1\x11\x89\x03\x48\xcd\x44\x7c\x00\x45\x1a\x95\xd2\x42\xbb\x9f\xfc\xe6\x03\x40\xab\x45\x25\x8c\x2d\xb0\x2c\xcb\x2e\x1e\xbb\xfe\x56\xdd\x55\xed\xba\x64\x10\xf8\x7f\xe4\x7e\x39\xb2\x6c\xf0\xe4\xce\xa6\x2f\x9f\x63\xf2\x42\x24\x9c\xf2\x96\x64\x8c\xcf\xd9\x75\x9d\x79\x83\x11\x1a\xfc\xc5\x62\xe5\xca\xca\x32\x50\x62\xe1\x2d\x0c\x9a\x8f\x83\x10\x61\x8e\x06\x23\xab\x0f\x26\x7f\x71\x88\x4b\x98\xe3\x60\x6b\x9f\xdc\x66\xb5\x4b\x09\x23\x36\x1e\x9f\x84\xd3\x59\xc3\x5c\x0e\xd6\xaf\x60\x10\xc1\x84\x51\x7a\xbb\xa8\xa4\x16\xb4\xa1\x1e\x4e\x59\xbc\x20\xeb\x20\x05\xaa\x6f\x71\x47\xed\x00\x05\xcc\x51\x2b\x39\x70\xe0\x5e\xa5\xb5\xe9\x65\x53\x8f\x55\xfc\xad\xad\x8a\xe6\xaf\xb9\x30\xb3\xe4\x7b\x47\x56\x04\xeb\x8d\x69\x3b\x78\xd2\x8d\x93\x7c\x5c\x94\x03\x44\x6e\xc0\x98\xc5\x07\xb7\x05\x0f\xff\x57\x7c\x5c\xbe\xab\x0b\x16\x81\x1f\x67\x11\xd0\xf6\xb0\xfc\x0f\xaa\x8b\xe5\x5b\x2a\x4a\x7f\x8b\x51\x5e\x4e\xef\xd3\xff\x55\xf4\x1a\xfd\x3f\x10\xbd\xc2\x69\xde\x2e\xc4\xab\x29\x8f\x57\xbb\x6c\x39\xb4\xbb\x09\x01\x6b\xc2\xb7\x88\xb7\xb6\x21\x60\x4d\xf8\x06\xb1\xbb\xc9\x36\x88\xc9\xed\x9a\xa9\x35\x84\xcb\x35\xff\x59\x36\x77\x5b\xf4\x47\xed\x51\x10\x7f\xcc\xf0\xde\xbb\xa3\xa6\xe1\x17\x66\xf8\x93\x24\x1d\xe1\x13\x3c\x49\x71\x36\xbb\xbb\x5b\xb1\x3a\x68\xd1\xce\x96\x0b\x32\x47\x62\x17\xa2\xda\x96\x0d\x17\x10\xee\x4c\xbc\x25\xb9\x7b\x70\x8a\xf3\x8f\x19\xf1\x3f\x93\x10\x16\xea\x60\xe1\x3d\xf5\x75\xab\xde\x64\x0f\x5d\x8e\x67\xa0\x5d\xf8\x84\x4a\xa4\x8d\x81\x37\x41\x43\x08\x92\x66\xa5\x94\x91\x75\xab\x29\xc9\x4b\x9a\x22\xa7\x54\x8d\x9c\x82\x5a\xe4\x94\x41\xe4\x34\x86\xc8\x69\xee\x77\xd0\x94\x0f\xd9\xd5\xe8\xc3\xf5\xfd\xd5\xd5\x79\xab\x65\x4d\xfd\x25\x44\x70\x69\x7e\x48\x2e\x17\xc4\xa9\x35\x54\xc2\x4b\x16\x1a\x43\xd3\x0c\xd9\x05\x84\xcf\xf1\x24\x49\x31\x2c\x71\xf1\x14\xe9\x8d\x36\xa9\x73\x63\xcb\x46\x1d\xdf\x77\x9c\x79\xab\x35\x95\x5f\x91\x24\x67\x39\x87\xe8\x56\x86\xf3\x34\x9e\x6d\x00\x47\x88\x3b\x76\x81\x28\x61\x23\x09\xd9\x71\x94\xa3\xa1\xf9\x13\xa3\x21\x94\x57\xfb\xcb\xcf\x95\x2e\xf5\x75\xf7\x4d\x42\x22\x3b\xb1\x5f\xed\x40\xd6\xec\xc2\x19\x76\x37\xed\xe6\x8b\xba\x35\xfc\x26\xba\xc5\xf1\x72\x8e\x53\xf8\xb8\x24\xac\xa1\x4c\xb1\xf2\x4e\x3a\xab\x7d\xa2\x2b\xbb\x28\x57\x90\x34\x65\x25\x8f\x87\x4e\x0a\x36\xe7\x4a\xd9\xfc\x34\x22\xf3\xd3\xbe\xb6\x2d\x6a\x08\x56\x07\xa5\x6c\x00\xe1\x44\xb6\x75\x15\xc6\xe3\xe4\x4a\x6c\x65\xd9\x05\xaa\xb4\xb3\xa6\x8b\x2b\x3c\xed\x19\x1c\xa7\xb1\x3a\x28\xaa\x4e\xf6\x56\xdc\x42\xd3\x91\x8f\x40\x64\x2c\x96\x06\x94\xa0\x3e\x76\xc8\xdb\x76\xff\x25\x43\xde\x5f\x1e\x59\xfe\xbf\xb0\x2e\xc2\xc7\x19\x97\x8c\x33\xb1\xb5\x4d\x4e\x92\xca\x15\xf9\x1b\x45\x04\x7c\xb9\x06\x56\x6f\x60\x97\x76\x62\xa3\xa1\x46\x01\xe9\x2b\x70\xb8\x7c\x05\x6e\x87\x9e\xd7\xc5\x05\x9a\xf9\xb7\x70\xcb\xf7\x30\x88\xa7\x1e\x5b\x4c\x85\x95\x08\x4f\x03\xb2\xa2\x01\x59\x31\x57\x71\x81\xc6\xf8\x69\x7c\xed\x6c\x39\xcc\xd8\x2a\x1e\xec\x09\x14\x28\x4e\x32\xb8\xb5\xeb\x21\x11\x7e\x79\x10\x4a\x96\x65\x58\xa0\x3a\xea\x50\xa5\x28\xfe\x2d\xf1\x01\x39\x01\xf3\x06\xdf\x90\x3b\x66\xa1\xfd\xe1\xc0\x4c\xbf\xb6\xf7\x40\x4e\xe0\xf8\xe6\xe0\xc2\xe4\x4b\x02\x70\xbe\x2f\xf6\x4d\x52\x2d\x48\x84\x70\x6b\x76\x77\x27\xa5\x31\xe7\x30\x83\x73\xd4\x91\x3f\xd6\x39\x6c\x79\x81\x85\x37\xad\x31\xd6\x38\x59\xb2\x7c\x5c\xa0\xa5\x5f\xef\x0a\x63\xac\xf8\x51\xd8\xfe\x26\xb4\x13\x3d\x6d\xc5\x49\x0a\x72\x12\x9e\x2c\xca\x46\x4a\xf5\xe1\x49\xd4\x14\x9e\x04\x6a\x78\xb2\xac\x85\x27\x13\x12\x9e\xc0\xd8\x72\x6f\x84\x12\x2b\x11\xca\xf8\xde\x08\x85\x88\x2e\x06\xaa\x27\x47\x29\x69\x35\x4a\xd1\x2f\xc5\x88\x58\xe4\xa6\x31\xd7\xb5\x8b\x3e\x59\x40\xe4\xa1\xe5\x4b\x72\xb5\x5f\xb2\xcc\x4f\x70\x94\x04\xe3\x8f\x59\x18\x4f\x5f\x05\xd9\xcc\xb6\x84\x20\xa9\x2e\x14\xba\xe4\x01\xcf\x0d\xb4\x96\x5c\xdc\xb0\x3d\x85\x59\x50\xc1\xee\x01\x7e\x95\xe2\x89\xce\x2e\xff\xcb\x5c\x4d\xd8\x56\x25\x98\xae\x35\x64\xa3\x1a\xd0\xc3\xa0\xf6\x7f\x10\x2e\x29\x3d\xf6\x9f\xf0\x69\x0d\x02\x02\x69\xae\x6f\xdf\x56\xc6\xac\x02\xbe\x67\x40\xc2\x2c\x76\x41\xcf\x26\xbd\xa0\x67\xad\x43\xc7\xc1\x0d\xed\x6a\x85\x2e\x36\x22\xb7\x66\xf3\xa3\x4a\xe6\x7f\x99\x5c\xd7\xc8\x0d\x33\xf9\x8e\x69\x7a\xb2\x97\xce\x57\xdd\x26\x97\x52\x03\x0f\xb2\x99\x0f\x2e\x5d\x21\x06\x41\x72\xad\x20\xe2\xbc\x14\x11\xa2\x4a\xc1\x34\xa8\x1a\x12\x52\x4e\xba\xfd\xd6\x41\xb0\x4a\xbe\x93\x7b\x1d\x7b\xd5\xfc\x2f\x73\x15\x14\x7f\xa2\xd7\x25\xdd\xae\x28\x6e\x97\x43\x8b\x45\x2e\xb0\x4b\xc0\xbd\x8d\x80\xff\x3d\x78\x7c\xba\x73\x45\xde\xc2\x82\xd3\x80\x40\x07\x47\x90\x72\x88\xe2\x98\x06\xc2\xab\x37\x31\xe5\x02\x63\x58\x3c\xc8\x47\x26\xa1\x29\x0e\xc6\xe4\x9e\x27\xdb\xe2\x13\xa8\x80\x82\x48\x7b\x0b\xb1\x70\x02\x01\xf8\xb7\x1c\xfb\x29\x92\x22\x50\x5e\x35\xdb\x0a\xf8\xb6\x02\xbc\x9b\xba\x60\x92\xc1\x4c\xb2\x6a\x65\xfa\x75\x5c\x91\xca\x13\x32\x0b\x2a\xc8\xcf\x32\x80\xf4\x16\x7d\xa1\x3e\x59\xf1\xfd\xd4\x1e\x5b\xa9\x74\xed\x70\xe4\x4f\xc8\x1a\x14\xe4\x2f\x5a\xad\x08\x16\xfd\xe1\xe5\xaf\x0b\x7c\x03\xbb\xdc\xf0\xc8\xc2\xad\xfe\xc2\x8f\x10\xb6\x22\x58\x1b\x4d\xe1\x8b\x10\x28\x52\x0b\x49\x45\x85\x53\xb8\xd7\xa8\xd5\x22\xd7\xe4\x58\x1d\x14\xc0\x95\x39\xfb\x97\x38\x16\x55\xe1\x33\x05\x64\xc2\x68\x3c\x22\x37\x85\x98\x70\xfb\x8e\x6e\x9a\x11\xb0\x5b\x3b\x1e\x85\x50\xa8\xaf\x4b\xaa\xb7\x08\x91\x6e\x20\x57\xca\xc3\x0e\x48\xa0\x8a\x2f\x6d\x0f\x88\xd9\x01\x3b\x4e\x03\xed\x46\x96\xa0\x09\x4d\x30\x1e\x57\xd5\xe4\x43\x42\x59\x02\x78\x5d\xd1\x86\x6e\x0e\xdb\x59\x70\x89\x99\x92\x44\x28\x25\xaf\x42\x61\x04\xfb\x81\xe4\xe0\xa2\xe2\x4e\x55\x89\x85\x81\x0e\x59\x0d\xca\x6c\xfb\x36\xb3\x6c\xb8\x7e\xa4\xd5\x5a\xc2\x38\x61\xdf\x3f\x23\x7a\x3c\xda\x98\xa2\xc9\x03\xc5\x9c\xf8\x2d\x64\xb0\xbd\x66\x64\x0c\x06\x57\x78\xb8\x08\x46\x17\x83\x94\x1e\x2d\x1e\x0c\x6c\xe3\xf6\x27\x65\x24\xf9\x49\xef\x5a\x05\x86\xf2\xd5\x2a\x83\x7d\xb6\xca\xc8\xd3\x25\x2c\x46\xff\x04\xfd\x34\x18\xcc\x3b\x86\xaf\x2b\xcc\x72\xed\xbe\x01\x24\xe4\x58\x2f\xa1\x99\x77\x8c\x56\x8b\xfc\x95\x5c\xad\xb1\x43\x53\xd8\xf0\x67\x78\xe4\x51\xa0\xbb\x0d\xe8\x1d\x86\x7e\xcc\x4f\xb4\x12\xba\xb9\xcb\x4a\x70\x6b\x25\xb8\x6a\x09\xae\x28\xa1\xdb\x50\xc2\xf6\x26\x2f\x22\x98\x62\x88\x76\x08\xdd\xbc\xcb\x4a\xe8\xd6\x4a\xe8\xaa\x25\x74\x45\x09\xbd\x86\x12\xdc\x4e\x8f\x15\xf1\x31\x0e\xaf\x3f\x84\x73\x4c\x08\xe7\x3d\x56\x44\xaf\x56\x44\x4f\x2d\xa2\x27\x8a\x58\x6b\x28\x82\xd7\x21\xcb\x6f\x22\xde\x46\x6b\x0c\x7e\xad\x06\xbf\xa6\xc2\xaf\x09\xf8\xf5\xc6\x1a\xac\xb1\x02\x46\xd7\x84\x64\xbe\xce\xc0\xd7\x6b\xe0\xeb\x2a\xf8\x7a\xff\xa7\x9f\xe0\x32\xe2\xcc\x78\x0e\x87\x49\x71\x66\xe0\xeb\x1c\xc7\xe3\x8c\x7e\x06\xa0\x2d\x76\x0a\x8c\xdb\x9f\x0c\x03\x7c\x41\x38\x32\xc4\xf9\x65\xc3\x27\xc9\x86\xb1\x4c\x23\xaf\x54\x02\x7e\x9c\xf9\x27\xc3\x28\x7e\x62\x6c\x58\xd0\x2e\x82\x29\xf6\x0c\x17\x11\xc6\x11\xb9\x59\xd0\xe8\xd0\xa7\x21\x95\xc1\x33\xce\xce\x39\xef\x04\xc3\x79\x4b\x9b\xf1\x86\x13\x43\xf9\xc8\xcc\x32\x8d\x78\x96\xc1\x2e\xa5\xed\x13\xa4\x82\xfc\x4b\x99\xff\xfc\xc7\xad\xca\x52\xec\x80\x0c\x3e\x4b\x86\x4a\xc1\xab\x87\x53\x5c\xfc\x69\xb7\x19\x16\x79\xf5\xde\x4a\x71\xb6\xb0\x0d\xff\x37\xb8\x7f\x7c\xd1\xfe\x96\x81\x8f\xad\x90\x8c\x83\x3c\x20\x24\x5c\x0a\x83\x5c\x1e\x5d\x7e\x54\xa4\x4c\x17\xb5\x05\x9e\x36\xf9\x8d\xa4\x4c\x51\x79\x92\xcd\x9e\x44\x3e\x98\xba\xfc\x8b\x34\x6c\xfd\x42\x53\xd1\x52\x44\x08\xd6\x78\x82\x9e\xde\xbe\x78\x1c\x4c\xb1\x05\xf5\x55\x68\x4b\x81\x21\xcb\x33\xe0\xdf\x02\x49\x38\x65\xb1\x53\x9c\x1b\x0b\x1c\xc3\xa7\xca\x77\x95\x42\x23\x9c\xd3\x3a\x1a\xbe\xd1\xe9\x4b\xd8\x00\xcc\xab\x44\x76\xa8\x2c\xf2\xa0\x34\x1c\xf4\x2d\x49\x6d\x33\x6c\xe3\x37\xa3\xc3\x91\x45\xeb\xad\xae\x8a\x76\xf8\x49\x6e\x17\xe6\xb0\x09\x8d\x10\x94\xee\x9a\x09\xf1\x18\x8d\xc5\x00\xa8\x86\x2b\xfb\x39\x96\x39\x0e\x2f\x4d\x64\xdc\x12\x9b\x20\xc7\xfb\x8d\xd1\xb5\x45\xed\xb6\x4d\x3e\x23\x8d\x98\x15\xb7\xe5\x2f\x7a\xdb\x05\x7a\x2c\x9c\xcc\xcd\xbe\x02\x5f\x20\xc3\x64\xe6\x67\xda\x7f\x09\x08\xbe\x49\xdf\x24\xc2\xc2\x44\xe4\xa4\x2e\xeb\x4b\xaa\xee\xa4\x9d\x90\x61\x1a\xa4\xc5\x2d\x9c\xd9\xc8\x30\x19\x85\xdc\xb5\x40\x02\xf7\x15\xce\xf0\x9c\xde\x7d\x1f\x66\x44\x33\xd8\xe5\xf8\xe9\x32\x86\xd7\xfd\xdb\x4d\x62\x73\xa7\x8d\x0c\xa6\x55\x92\x08\x90\x80\x8c\x3c\xc9\x83\x88\x68\x91\xa7\x91\x6f\x81\x53\x80\xf0\x8c\x6e\x07\xbe\xa5\x31\x5f\x7c\x48\x3c\x83\xe9\xae\xff\x9b\x61\x11\x05\x92\x2e\x16\x2d\x55\xbb\xb0\x1b\x44\x6a\x6e\xc9\x1c\xa6\x2f\x27\x38\x5b\x24\x71\x16\x5e\xe2\xa6\xf6\x24\x64\xf7\x20\x34\xf2\x0d\x93\xf1\x8d\xe8\x0b\x3d\x49\xfa\x40\xfe\x4c\xe4\x9b\x07\x7b\x8d\xba\x22\x93\xed\xe1\x6c\x94\x86\x0b\xb2\x80\xfe\x18\xfa\x0f\xd0\x1f\x8f\xa2\x3c\xa6\x6a\xf2\x28\x5a\xf2\x89\x79\x3c\xc6\xe3\x47\x51\xbf\x08\xc2\xe8\x91\xa4\x7b\x38\x78\x1c\xe1\x2e\xc9\x1a\x1b\xcf\xf2\xc7\x91\x27\x70\x2d\x37\x67\xb0\x91\xc1\x7c\x46\xf9\xdf\xe3\x1d\x9b\xfc\xbf\x8a\x0b\x52\xff\xd3\x4b\x05\x3a\x71\x4b\xbe\x67\x40\xa0\xdb\xe1\xb8\x51\xc3\xc6\xa2\x02\x9c\xd4\x7e\x2c\xe9\xb8\xd4\x93\x47\xf3\x10\xdb\x7d\x34\x35\x73\x2b\x8f\xa6\xcf\xb8\xce\x3c\x9a\x63\x42\xf4\xe6\xd1\xe4\x63\x1c\x3c\x06\x5b\x97\xcf\x23\x45\x64\xdc\xe6\x19\xef\x18\x4a\x32\x1e\x04\x79\x61\x3f\x02\x97\x31\x71\x45\x1b\x04\xb9\xb1\xf3\x84\xc2\x24\xbe\xc2\x36\x3c\x02\x6a\xdb\x1a\xbd\x62\x03\xa4\xfc\x5f\x51\xa5\xb3\x6d\xc6\xca\x06\xf9\xe2\xa7\x9f\xd8\xf4\x43\x84\x8b\x3e\x8f\x10\xfb\x3f\xfd\x67\xc6\xf3\x9f\x19\xcf\x03\x33\x9e\xed\x75\x56\xc2\xeb\x64\xf8\x82\x9c\x30\x82\x77\x7b\x9e\x3c\xf3\x21\x10\xf4\x34\x2f\x59\x3e\x60\x00\xec\xd0\x12\x49\x7a\x70\x76\xa4\x9b\x7c\x3d\x65\x7e\xc4\xe0\x37\x1e\x3d\xf9\xda\x60\xe0\x1b\x35\xf0\x0d\x15\x7c\x43\x4c\xbe\x60\x18\x7b\x9d\x0c\xff\xe2\xec\x8b\x84\xe7\x1f\x4f\x0e\xf5\x53\x30\x83\x7d\x75\xef\x41\x82\x67\x51\x74\x0f\x4d\x8a\xf3\xf4\xe6\xa1\xfc\xfb\x21\xa8\x1c\x47\xe0\x46\xc2\x78\xfa\x10\xd6\x83\x74\x9a\xb9\x65\x86\x23\xf2\x59\x2d\x98\x41\xd2\x32\xa9\xa6\x78\x06\x7c\x33\xfc\x81\xd9\xe7\xb7\x64\xf8\x84\xa9\x27\x6f\x74\x9e\xff\xd8\xf9\x27\xe7\x6b\x9c\x84\xfe\xe3\x56\xd2\x6e\x4b\xca\xa5\xc9\xf6\xbf\x7c\x96\x5a\x6f\xc4\x47\xcc\x5e\x69\xe3\x41\x39\x6d\xf8\xf9\xb7\x9c\xb7\x52\x98\x17\xec\xe5\x00\xf2\xa7\x01\x8a\x6b\x0d\xfd\x8b\xb8\xd6\x34\xe0\xaa\x7a\xdd\xa8\x31\x35\xf5\x7f\xaa\xea\xd4\x00\x8a\x9d\x87\x94\x85\xed\x1a\xb8\x76\xf1\x27\x32\x6e\xe9\x67\x65\x3c\xe3\xe7\x45\x92\xe5\x3f\x17\x36\xbd\x61\xd0\x52\x34\xa3\x3a\x9b\x72\x59\x07\xca\xdd\x37\xc3\xa3\x0b\x3c\xb6\xbe\x25\xc3\xca\xd4\x5a\x12\x80\x6b\x51\x3b\x8c\x47\xd1\x72\x8c\x33\x42\xae\x62\xc8\x08\xe0\x66\xc9\xe6\x88\xe1\x37\xc0\xd0\xdd\x1b\x8e\x42\x17\x83\x28\xc7\x6f\xbe\xbc\x52\xa0\xe3\x66\xa7\xfa\x09\x39\x32\x44\x9d\x0c\x58\xd3\xbf\x97\x11\xd6\x9c\xa5\x32\x0b\x9d\xb6\x30\x76\xce\xe3\xe9\x80\xb4\x6d\xf8\x2c\x8a\x14\x6d\xd1\x09\x40\xb7\xf7\xd5\xb5\x90\x4a\xf9\x9c\x16\x0c\x96\xdb\x5b\x73\xd5\xc0\x3c\xe9\x54\x05\xaa\xa5\x73\x0a\xf7\xb6\x00\xaf\x85\x46\x8e\x9f\xea\x1e\x44\x03\x58\x41\x29\x78\x8b\x88\x41\xe8\x01\x03\xa2\x63\xcc\xc3\xb6\xd3\xc0\xf8\xcf\x33\x03\x2a\xf0\x29\xab\x96\x90\x1a\x16\xc1\x16\x86\x6f\x9c\x9d\xd7\x56\xc0\x78\x13\xe8\x9b\x5f\x5f\x5d\xa5\xae\x6a\x6d\x79\x7d\x0d\x63\x41\x4e\x3a\x58\xcd\x4e\x03\x9c\xc5\x2f\xff\xb8\xfd\x96\x0c\xdb\xe3\x90\x4e\x1a\xd8\x63\x38\xd6\xba\x06\xa9\xba\xe4\xc7\x71\x9a\xcc\xc3\x0c\xb7\xe1\xe4\xcf\xa2\xb9\xc5\x24\xcf\xad\xb4\x15\x8f\x0e\x1a\xfb\x56\x0a\x1f\x9e\xd4\xb5\x12\xdf\x3f\xaf\x67\x95\xf8\xe3\x7e\x91\x25\x6f\xfc\x54\x77\x5e\xe5\xff\x37\x79\x73\x22\xc6\xbf\x4e\x8b\x79\xdc\x58\xb6\x8e\xda\x3e\x8f\x52\x62\x0e\xf2\x6f\xd6\xe1\xbf\xb8\x9a\x7c\xcf\x8a\xde\xbf\x6b\xb9\x19\x26\x1c\x06\xcc\x38\x4c\xfb\x2f\x41\xdd\xb3\xe0\xac\xcc\xf8\x60\x81\x24\x9c\xe3\xc3\x60\x88\x23\xcf\x30\xf7\x42\x0c\x0b\x2b\x49\xfc\x82\x05\x58\x22\x0c\x53\xd7\x71\xd5\x18\xad\xb0\xff\xd2\xd2\xf6\xb7\x64\x68\x65\x36\xf9\xee\x2a\x2c\xf5\xfc\x67\x91\xfa\xff\x7c\x91\x5a\x9b\x1f\xc6\x8b\x65\x0e\xa2\xc1\x5b\x17\x9e\x61\x92\x48\x68\x98\x5c\x9b\x88\x07\x96\x9e\xd6\xdb\x94\x81\x10\x28\x10\xfd\x52\x8a\x27\xb7\x6e\x19\x52\x15\xf6\x63\xd6\x78\xc1\xde\xcc\xc7\x10\x3e\xe3\x27\x28\x1f\x45\x4d\x3e\xe5\xf4\x28\x4a\xb0\x86\xc6\xf5\xe5\x47\xc7\x6c\xf2\x7f\x15\x9f\x54\xfd\xef\xa1\x05\x66\xe6\x4b\x1f\x5e\xbb\xfc\xdf\x75\x2c\x7b\x22\x75\xb9\xaf\x2f\x09\xc1\xa3\xd6\x52\x41\x70\xb8\x28\xf0\x11\xa4\xaf\x4f\xdf\xbd\x65\x8b\x08\xe1\xe4\x06\x8a\x68\x07\xe9\x34\x7b\x6c\x29\x38\x4d\x1f\x41\xf9\x88\x65\x5c\x28\x18\x16\x8a\x75\x4b\xb6\xda\x45\x5b\xcd\xb2\x2d\x5d\xb8\x7d\xaa\x0b\x19\xe6\xf1\xcb\x34\x59\x2e\x90\x91\x26\x11\xf6\x0c\x73\x0a\x4f\x66\x93\x4b\x18\x2e\xf3\x3c\x89\xa5\x7e\x15\x09\xda\x51\x6b\x98\xc7\x62\xcc\x1a\xe6\x31\x1f\xb1\x48\x3f\x47\xe1\xe8\x42\xe9\xe6\x6a\x08\x4d\x87\x26\x48\x33\x78\xe2\xfd\xa3\xd4\xbf\x52\xb8\x4a\x60\x04\xb2\x9d\x40\xd2\xdf\x40\x34\x31\xa1\x51\x9a\xec\x59\x14\xfd\x9b\x5b\x4b\x48\x44\x1b\xea\xdf\x2a\x90\xba\x5a\xa2\x34\x14\x4f\xfc\x37\xb7\x96\x2a\x1b\x6d\xb2\x8a\x68\x8f\xd8\x96\xe1\x6b\xc7\xff\xd9\x97\xf9\xd7\xee\xcb\xe8\xf6\x11\xfe\xa9\x9b\x32\xd5\x7d\x84\xa7\x6c\xc7\xfc\xf4\xcb\x2f\x6c\xb1\x92\xcc\x1d\x59\x20\x90\xc1\x0a\xb1\x41\xde\xe4\x20\x7d\x1c\x4f\x0d\x7a\x47\xb3\x31\x49\x52\x92\xf7\x2d\x19\x8a\x45\xce\x3c\x31\xe0\x96\xe4\x98\x7c\x60\x3f\x30\x3e\x9e\x1c\x1a\xf9\x2c\xc8\x8d\x59\x90\x71\xb6\x20\x82\x63\xbc\x37\x6d\xa1\x67\x72\xa1\x22\x96\x87\xd9\x14\xac\x82\x7c\x67\x5b\x36\x3f\xff\x0c\xea\xcb\x74\x8b\xdc\xe0\xc4\x28\xe9\x0c\x12\x8e\xcb\x96\x21\x0d\xcc\x1f\x69\xf6\x19\x5c\xc1\x52\x4e\x1b\x29\xda\xaa\x6f\xfc\xd9\xfa\x07\x84\x2b\x85\xff\x8f\x5b\xcd\xc5\x3d\x32\x6f\xf1\x27\x9b\xc4\xfd\xc4\x27\x72\x2c\x40\x22\x58\xfd\x9f\x0a\xd2\x6e\x79\xb2\xbf\x48\x46\x33\xa3\x6c\x31\xa2\xd8\x46\x32\x31\x02\x58\xed\xc6\x79\x38\xc7\x0e\x1c\xc6\x8e\x0c\x12\xbc\x1a\x61\x9c\x27\x06\x26\x4c\x19\x1e\x25\xf1\x38\x93\x5a\x84\xc1\x59\x04\x84\x8a\x0f\x75\x5a\x91\x9e\x85\x1c\xb4\x65\x8a\x52\xb0\xa3\x20\x9f\xb5\x27\x51\x92\xa4\x16\x7c\x1a\x74\x0f\xd6\xd5\x28\x23\x9c\xd0\x86\xf0\xc1\xb2\x8d\x5f\x0c\xb7\xd3\xe9\xd8\x44\x7e\xe2\x8a\x2a\xbb\x6c\x7f\x69\x13\xa9\x9c\x93\x95\x7b\x2d\x50\x27\xba\x03\x20\x4d\xe2\x1e\xb9\x15\x03\xe1\x98\x67\xfc\xfc\x33\x64\x1b\x06\x4e\xd3\xf2\x61\x92\x26\xf3\xf2\x29\x4f\xca\xdf\x41\x3a\x1d\x90\xa3\x0e\x72\x02\x73\x33\x3f\xff\xcc\x0b\x82\xf3\x67\xec\xda\x82\x4a\x7b\x72\x5d\xa1\x85\x4b\x01\x34\x24\xf0\xed\x0a\x22\x8b\x94\x87\xd3\x94\x67\x51\xc9\x78\x0f\x4a\x34\x90\x61\x73\xaa\x3c\xd1\xd2\xe4\x89\xa0\x10\xf5\x90\xb2\x59\x9a\x4c\xc2\x1d\x68\x8d\xc8\xd8\xa9\x26\x12\x52\x83\xb5\x82\x61\x14\x62\x55\x22\x5b\x0e\xe7\x21\xdc\x57\xc5\x5a\x02\x3e\x73\x8c\xe1\xd4\x37\x1b\x80\xf8\x42\x86\x58\x5f\xa6\xcb\x32\xbc\xbb\x6d\x75\xed\x43\xcd\xa4\xbb\x4f\xcc\x58\x25\x53\x82\x4e\x20\x96\x60\x5d\xe0\x1b\x64\x2c\xa2\xff\x3f\x7b\xc7\xda\xdc\xb6\x71\xfc\xce\x5f\x71\xc6\x78\x02\xa2\x86\x49\xd1\x76\x6c\x89\x0a\xc7\x23\x27\xf5\xc4\x99\xb8\x49\xcd\x74\xfa\xa1\x4d\x69\x90\x3c\x8b\x50\x40\x1c\x8b\x87\x2d\x95\xe1\x7f\xef\xec\xbd\x01\x1c\x80\x23\x05\xd9\x51\x86\x43\x8f\x45\x02\x7b\xb7\x7b\x7b\xaf\xdd\xbd\xdd\xbd\x60\x81\x57\x24\x5a\xc2\x51\x09\xec\x95\x68\x82\x5c\xc8\x0c\xee\x96\xba\xc7\x46\x6d\x80\xff\x7d\x44\xe3\x20\x58\x95\x63\xfd\x87\x8f\x2a\x3c\xa3\x53\xbd\xa0\x46\x60\xb5\xcf\x2a\xe3\x34\x05\x1b\x23\x3c\xc8\x82\xe4\x12\x67\x03\x5a\xcf\xce\xbb\x9d\x7d\xe7\x03\x49\xd6\x40\x3a\x89\xa7\xb4\x1b\x4a\xc8\x45\xd7\x70\xb9\x60\x8c\xb6\xeb\x20\xb9\x0c\xe3\x57\x24\xcb\x60\xa0\x8d\xe0\xba\x6d\x06\xcb\xd8\xe9\xc2\x38\x75\x7d\xe4\x82\xe4\xe3\x7a\x3e\x72\x91\x5b\x04\xc0\x49\x02\xef\xa9\x92\x89\x16\x24\xce\x82\x30\x4e\x8d\x90\x7c\x28\x01\xf4\x45\x72\xc9\x40\x26\x26\x20\xca\x09\x0a\x16\xdf\x30\xf6\x16\xeb\x63\x63\x42\xae\x00\xe8\xf7\xdf\x91\x7b\x91\xb9\x60\x62\x81\x69\x81\x9c\x62\x95\xf0\x0c\x6a\x7b\xcd\xff\x16\x57\x50\x59\xb5\x93\x91\x72\xc9\x8c\x00\xfc\x2f\xa4\xa1\x94\x9d\xa0\xc6\xe6\xc4\x7e\x82\x9a\xfe\x68\xba\xa6\xd2\x22\x9b\x04\x8e\x85\x0c\x56\x58\x7f\xcf\x25\x80\xbe\x23\x4f\xf4\xad\xf2\x28\xab\xdd\xad\xac\x56\xe7\xe1\xd2\xa1\xbc\x66\x12\x07\x3b\x74\xa0\xa9\x8a\x83\xfb\x04\x0c\x70\xb7\x92\x1f\xc8\x7c\x8a\x83\x64\xb1\xba\x7b\xaf\xfe\x2b\x32\x7f\xf3\x9d\xda\xb7\xaf\xc8\x9c\xb9\xa4\xb1\x9f\x31\xc9\x5e\x93\x3c\x5e\x8e\xd1\x87\x20\x4a\xb1\xac\x82\x12\x67\xb7\x77\xe9\x67\x0a\x79\x42\x57\xa1\x07\xda\x1e\x49\xf1\x8b\x7a\xc4\xe2\xdd\x76\x02\x03\xb1\x00\x43\xa3\x14\x59\xa9\xb9\xde\xeb\x42\xa0\x64\x64\x52\x1f\x0c\x20\x29\x4f\xd1\x64\x82\x9e\x9d\x3c\x53\x44\x19\x8e\x4c\x15\x9f\x34\x1e\x89\x49\x2a\xca\x14\x9b\xa3\x9a\xa4\xde\xe8\xae\x1f\xfc\x5c\xc3\x64\x28\x34\x21\xbf\x22\x73\x1d\x37\xed\x1f\x1d\xb9\xfa\xae\x6d\x95\x20\x81\x05\x19\xdd\x0e\xe4\x7e\xc9\x52\x39\x17\x0e\xb1\xc1\xc6\x25\x82\x18\x05\xd8\x22\x48\x31\x72\xd3\xc5\x0a\xc3\x00\x5e\xba\xe3\x5e\xa1\x21\xee\xbb\x3c\x46\x17\x99\x7b\xae\x01\x53\x65\xb9\x0a\x08\x4f\xcb\xa0\x60\xe8\xaf\x40\x72\x3b\x2b\x07\xdc\xe9\xbb\x3b\x0c\xd1\x3a\x01\x00\x54\x18\xd0\x8f\x0a\xfe\x08\x57\x64\x7e\x6e\x2d\x1e\x54\x4d\x70\x9f\xe7\x74\xe7\x75\x18\x53\xe3\x94\xe3\x1d\x54\x53\x53\x34\x41\x8b\xc4\x23\x26\xf4\xce\xb7\x12\xf6\x1c\x90\x12\x9d\x92\xb8\xe7\xfc\x40\xe6\xe8\xcd\x77\x8e\x8f\xd2\xf0\x7f\x78\x8c\x9e\x9d\x18\xa4\x3e\x36\x2d\xdb\xc5\x3e\xbe\x2e\x55\xc5\xbe\x2f\x23\x50\xc4\x4b\xea\x4d\xae\xb5\x43\x4c\x3d\x58\xdd\x5b\x0e\xb9\x9c\xbf\x11\xb0\x41\x23\xb8\xe5\x88\xb6\x13\xbd\xf9\x6e\x50\x3e\x3d\x00\x80\xaf\xbe\xd2\x1e\x58\x8f\xcd\xfb\x78\xc2\xd4\x18\x06\x51\xb4\xdc\x87\x4b\xcf\xeb\x00\x61\xf3\xa9\x51\x11\x25\x48\xf3\x9d\x20\x15\x71\xaa\xb6\x88\xc5\xa2\xcb\x7e\x6d\x08\x89\x66\x21\x1d\x61\xef\x51\xff\xe1\x56\x7b\xb6\xf3\xde\x33\x98\x05\x89\x59\x0a\xd1\xc5\x0d\x68\xe0\x05\xd8\xd2\xbb\x9d\xf7\x5e\x8e\x61\xbe\x09\xd4\x0e\xde\x3d\x1a\xa9\xd7\x67\xd1\x4c\xcb\x23\x16\xea\x8c\xef\xf9\xb7\xa6\xce\xf9\x6b\xfc\xdf\x1c\xe7\xfc\xc0\xae\x33\xf2\xba\xa2\x0e\x42\x66\x52\xdb\xd1\x01\x71\x12\x29\x08\x50\x27\x9e\x27\x4f\xb5\xba\xe8\xc2\xb6\xb3\xcf\x22\x19\x70\x94\xe6\xf9\xb7\x47\x7a\x91\x5c\x5a\x35\xdd\xf8\x7e\x93\xe0\xb6\xa3\x41\xf1\xfa\x09\x1c\xb6\xe9\x07\x76\x56\x7a\x21\x13\xc0\x8f\xc6\xf9\x3f\xb6\x71\x9e\x2b\x4c\x32\xe4\xe2\x20\x7d\x09\x7c\x3a\x74\x85\x29\xce\xd7\x73\x9c\xe8\xd7\x8a\x31\x30\xe1\x2f\xd2\x0a\xa9\xfb\x9d\xb4\x02\x0b\xcf\x13\x05\x08\xe6\xdb\x12\x98\x94\xde\x69\xd5\x40\x86\x14\x77\xb9\x40\x4b\x2d\xd0\x0b\x1c\x46\xba\xb5\x50\x11\x82\x86\xba\x51\x88\x37\x45\x29\x06\xe9\x8a\xe4\xd1\x72\xba\x22\x9f\xfa\xa1\xa8\x18\xd4\xa2\x10\x94\xa1\x11\xac\x39\xf4\x1b\xad\x42\x92\x20\x00\x25\x0d\xa0\x00\x19\x84\x75\x4a\x5a\x30\x4f\x75\xca\x80\xe7\xe8\x31\x0a\x3d\xf4\xcd\x04\x8d\xea\x44\x79\x69\xfc\x94\x38\xd1\xa4\xe0\x33\x5c\x52\x07\x04\x62\x50\x01\x00\x43\xaa\x79\xc2\xc1\xd1\x49\x1f\x5e\x84\x08\x30\xa2\x10\x7d\x53\x6e\xd0\x39\x0a\x1f\x3d\x52\x95\x4b\x06\x94\xe8\x56\x10\x2c\x2e\x81\xdf\x1b\x6d\x5c\xa7\xa2\x10\x26\x35\xb5\x29\x87\x3e\xaa\xca\x5c\x90\xe9\xbc\x5e\x58\x4b\x37\x41\x2c\x97\xb9\xd0\x13\x9e\x71\xd2\x4d\x58\xf2\xa7\xd0\x7f\x87\xd2\x57\x47\x45\x00\x20\xf2\x34\x54\x63\x06\x1b\xba\xfd\xd0\xdb\xd9\x53\xf7\x78\xe4\x75\xc9\xbf\x65\x98\x82\xd4\xba\xb4\xe2\xa0\x33\x18\x38\x3a\x99\xd5\xa1\xda\xa8\x16\xe6\x91\x59\x6e\xde\x04\x97\x61\x4c\x73\xf7\xec\x58\xc8\x41\xda\xbe\xc3\x88\x15\xeb\x68\x51\xbc\xf7\x16\xc5\xd1\xc9\x13\x8e\x62\xba\x22\x2c\xb5\x5b\xe7\x66\x45\xd3\x3e\xb9\x8f\x61\xb1\x25\xd6\xae\x9b\x4c\x24\x79\x7a\xf3\x4f\x92\xfc\x86\x93\x03\xe3\xe1\x3e\xd1\xc2\xfa\x4e\x48\xaf\xdb\xf9\xe9\x43\x5f\x3d\x61\xf7\x4a\x79\xa6\xed\xb1\xb4\x77\xd8\xcc\xe8\x3f\x89\x32\x7d\x47\x1e\x91\x53\xc8\x7b\xd7\xa2\x39\x69\xe0\xdf\x82\xef\xdf\xe3\x30\xde\x17\xde\xe4\x47\xa9\x6d\x32\x6c\x54\x30\x97\x03\xf6\xbd\x62\x1f\x45\xc8\xd0\xe1\xfa\xa7\xcd\x81\x92\xe3\x60\x7f\x66\x56\xae\x94\xbc\xc8\x15\x99\xcf\x2c\x3d\x18\x79\x09\xf0\x5a\x9c\x81\xc9\xd7\xa2\x88\x85\x36\xca\x6b\xa5\xd9\xd3\xec\x83\xd8\xed\x2b\xa6\x4e\x9f\x61\x6c\x5b\x71\xb1\x94\xa6\x74\x89\x8f\xdc\x7d\x6b\xbc\x23\x3d\xaf\xbc\x79\x72\x11\x3f\x21\x0b\x9c\xa6\x87\x66\x3a\x9a\xcb\xc5\xa9\x21\x7e\x95\x11\xff\x33\x21\xf5\xd1\xb0\x86\x23\x14\x55\xb5\x8a\xbc\x54\x35\xe9\x91\xa9\x0d\xf1\x93\x52\x56\x02\xd2\xd3\x41\x81\x5c\x01\x23\x0e\x43\x6a\xe1\xc4\x49\x87\x55\x84\x69\x43\x8c\x29\x13\x7d\xd9\x8b\x6d\xaf\x32\x2f\x95\x95\xb6\xf0\xae\xc8\x09\x28\x5d\x78\xad\x1f\x4e\x08\xa9\x4b\x7f\xbe\x33\xf1\xa1\xd0\x23\x0d\x7c\x28\xc2\x75\xc7\x07\xd0\x17\x3e\xf1\x3d\x4d\xa9\x12\xec\x03\x2d\x6c\x59\x96\xa0\x35\x7c\x46\xac\x48\x9a\xa1\x07\xe0\x54\x55\xe6\xa9\x18\x2d\x5c\x0c\xe6\x95\xe9\x98\x74\x76\x95\x19\xd9\xd4\x23\x8a\x2b\x62\x3a\xa7\x35\xf5\xec\xca\x6e\x22\x97\xb2\xe1\xfb\xa4\x8a\x52\x08\x19\x63\xc0\x5a\x59\x60\x0b\x2b\xfa\x68\x82\xe0\x0d\x07\x9f\x85\x4b\x99\xaa\x5b\x8b\xdd\xa9\xc9\x0d\x75\x89\xb3\x57\x79\x7a\x03\x38\xd8\x50\xe3\x48\x14\x79\xd5\xfe\xd2\x28\x54\x43\xb4\xb6\xeb\xa0\xd3\xca\xe4\xc9\x60\x58\xde\x9b\xf2\x55\x41\x99\x69\xea\xc7\x9d\xa9\x69\xbc\x40\x9d\xfa\x5b\xda\xd1\x8c\x6b\x6f\x8a\xa9\xea\xd0\xb8\xb2\x3b\x2b\x1c\x2c\xb1\x12\x28\x1c\xb9\x98\x3a\x7b\x85\xe8\x68\xdd\xcb\x6f\xca\x41\x0e\x62\xdc\x04\x71\x0e\xaa\x84\x94\x54\x03\xe9\x20\x52\xe1\xb9\x2a\xc6\x14\x5f\xde\x59\x10\xef\x43\xf2\x0c\x5c\xf7\x44\x51\x6d\xf0\xf9\xc8\xa1\x41\x40\x9c\x0d\xfb\x8e\x39\x35\x93\x15\x21\xe2\x70\xb0\x66\x34\xa9\x89\x61\xe8\x06\xf1\x69\x92\x28\xa9\xc2\xaa\x0f\x22\x6e\xb7\xaf\x39\x89\xea\xf2\x50\xf1\x1e\x88\xae\x4a\x5c\x00\xb6\xd0\xa5\xd1\x47\xce\x18\x86\x0d\x7d\xb0\xb1\x4a\x80\x24\xe5\x53\xc7\x4a\x9e\xa1\x35\xef\x29\x26\x39\x3f\x06\x69\x86\xbe\xc7\x41\x92\xcd\x71\x90\xed\x83\x69\x25\x0a\x59\xe3\xfa\x56\x1d\xd7\x48\x4e\x68\x47\x38\x9e\x77\x1b\x76\x6f\x17\x24\x9a\x6e\x82\x78\x8c\x9c\x67\x10\x32\xe2\x4c\x71\xf2\x31\x5c\x40\xba\xbe\x9a\x46\x49\x65\xd6\x47\xdb\x30\xc3\x6b\xde\x2e\x21\xf0\xa6\x70\x14\x0b\xf3\xb2\x53\xb2\xec\x96\x8a\x20\x5e\x4a\x0e\x55\xf6\x10\xf4\xd8\x5c\x4b\xb8\x8c\x70\xd7\xe4\x5a\xce\xcc\xdb\x4c\x74\x4d\xab\xf6\xd1\x56\xa8\xc8\xaa\x85\x3b\x7e\xba\x51\x92\xa2\xcf\x7b\x46\x09\xdb\xc2\x30\x25\xb6\x86\xa3\x65\xea\xde\x5b\xa6\xee\x83\xaf\xdb\xdf\xe1\x74\x36\xed\xd8\xd1\x0d\xec\xca\x79\xda\x94\x3a\x29\x8f\xdb\x20\x52\x9c\xbd\x0d\xae\xb5\x45\xb9\x01\x36\xc1\xf6\xd0\x06\x0d\x92\x9e\x50\x77\x9d\x3e\xb7\x04\x3f\xe8\x59\x6b\x44\xb5\xfa\x50\x49\xd7\x10\x64\x03\xa4\x10\x6c\x85\x88\x7b\x50\xbe\x20\x50\x3a\x68\xa5\xcb\x7d\x94\x0e\x5a\x82\x87\xd9\xd2\xef\x66\x8d\x83\xbe\x1a\x48\x65\xa2\x5d\xcd\x60\x63\xa8\x44\x0a\x07\xae\xa2\xe7\x81\x0d\x1a\x05\xf4\xdb\x80\x55\xe2\xf1\x8d\x48\xd6\x0f\x89\x2e\xfa\x5a\x3f\x82\xd2\xf1\xc0\xaa\x5f\xf3\x24\xda\x27\x89\x84\xc6\xe3\x42\xdf\x50\xba\x18\xb5\x3e\xca\x32\x89\x18\x78\x0d\x7e\x9a\x13\x54\xf4\xb9\x14\xd3\x65\x37\x7c\xb8\xa5\xa5\xa4\x24\x20\xa2\x75\xa0\x09\x5a\x45\xd4\x0f\x15\x18\xff\xfe\x65\x96\x45\x93\x87\xdb\x2c\x8b\xf4\xc0\x1e\x4e\x1f\xd0\xdf\x37\xe0\x81\x95\x04\xf8\x21\x09\xe6\x33\x56\x30\x78\x5b\x5f\x85\x9a\xdb\x50\x49\xc9\x75\x34\x6e\x6a\x88\xc2\x56\x99\xcf\x45\xbc\x0b\x12\xa7\x19\x5a\x07\xb0\xee\xf1\x5b\x1f\x36\x09\x59\x6f\xb2\xfe\xfb\xb7\xc1\x35\xd2\xc4\x36\xd0\x69\x2a\x88\x50\xff\x04\xad\x71\x10\xa7\x28\x26\x28\x0a\xd7\x61\x06\xfe\x3c\x0c\x68\x1d\x5c\xcf\x74\xb1\x4f\x31\x97\xa2\x9b\x4c\xa8\x50\x05\x47\xb2\x0f\x86\xff\xf9\xf7\xf2\xd1\xc3\xe1\x20\xc3\x69\xd6\x5f\x07\xd7\x5e\xd3\xe8\x31\xb2\xca\xb4\x6c\x55\x99\x66\x82\x32\xb0\xef\xe5\x3a\xb8\x9e\x3c\xdc\xae\x83\x6b\x9d\x93\x09\x6e\xe1\xa5\x91\xb0\x04\xdb\x91\x96\x60\x4b\xe2\xde\x7b\xb7\xd1\xb2\xbb\x95\xe9\x0c\xd5\xd5\x78\x84\xd2\x46\xd4\x6b\xe9\x87\xfb\x83\x1a\xf5\x7b\xbe\x94\x29\x99\x99\x3e\x00\x89\x9b\xba\x2e\x06\x2c\xa1\x86\xae\xa5\x6b\xab\x35\xc0\x83\x9e\x00\xe0\xec\xb1\xb2\x03\x68\x2b\x69\xa1\x5a\xf6\xbc\x41\x2a\xbf\xcf\x5a\xee\x21\x07\x34\x94\x45\x56\x90\x3f\x06\x19\x5d\x5e\xfa\x3c\x92\xd1\xb3\x2a\xf5\x33\x65\xb8\x15\x28\xac\x63\xda\xac\x6a\x2f\x63\x38\xc6\x41\x86\xd1\x55\xb3\x4f\xeb\x9f\xd2\x8c\x2c\x7e\xda\xce\x72\x4a\xf3\xbe\x5d\xf1\x2e\x16\xf0\x6c\x0b\x50\x49\xc2\x1a\x3a\x62\xdd\x65\x0d\xcf\x66\x06\x7a\x89\xfa\xfa\xef\x59\x1e\x67\x61\x84\x5e\xda\xf8\x3a\x30\x50\x3b\x8b\x45\x15\xc7\xce\x83\xe4\xc4\xee\x0d\x4e\x5d\xfa\x25\x26\xae\x35\xed\xa5\x1d\x0c\xb6\x2a\x57\xec\x73\x6e\x0d\xd0\x8c\x7c\xc4\x49\x12\x2e\x41\x5d\x70\x51\x5f\xfc\xf2\x5c\x40\x6e\x83\x7a\xbf\xe5\xa3\x3e\x97\x86\x71\xcc\x89\x8f\xce\x27\xf4\xb2\x11\xb4\x66\xa0\x56\x5c\xd4\xe5\x03\xe3\xde\xb2\x57\x72\x82\xa2\x84\x04\x46\x9e\x7f\xb0\x27\x8e\x87\xc6\x3d\x03\x85\xda\xe7\x5f\x2d\xef\x5b\x9b\x43\x2d\x9e\x0e\x43\xe7\xa3\xee\x1b\x57\x6e\x1a\x5d\xc9\x1c\x19\xb9\xdb\x05\xdd\xb3\x15\xc9\x93\xbb\x26\xde\x47\x4f\x9f\x9f\x9c\xa8\x26\xa0\xd1\xca\xf1\x7a\xb5\xd4\xd3\x7f\xbf\x36\xbc\xf7\xdb\x1a\xd8\x7d\x6b\x2a\x12\x97\xd6\x2d\x53\x9c\x21\xc3\xce\xd1\xd8\xc0\x96\x05\xa1\x14\x15\xb1\x77\x0f\x77\xcf\x80\x04\x37\xb1\xe0\x1d\x4e\x4d\x4c\x68\x68\x44\xd9\x7a\x67\xb0\xe1\x55\x2c\x79\xb2\x64\xbb\x49\x8f\x99\x58\x8e\xf9\xdf\x8f\xf9\xdf\x8f\xf9\xdf\xbb\xcd\xff\x4e\x63\x19\x0f\x4f\x00\xdf\x60\xbf\xac\xe4\x8c\xb6\x36\x26\xde\x59\xe6\x74\x2b\xeb\x94\xfd\xa5\x5d\x5f\x3c\x5f\xfa\x31\x2f\xfa\x31\x2f\x3a\xcd\x8b\x7e\xb0\x3d\x68\x2f\xcd\xe3\xf3\x18\x8c\xe8\x8a\xd4\x9c\x1f\xed\x60\xa3\x51\x53\x86\x58\x8a\xf7\x73\xa7\x88\x95\xd1\xe8\x90\xf9\x6a\x8e\xa1\xe7\x92\x10\x1f\x33\xc6\x7e\xfe\x8c\xb1\x77\xe5\x82\x6c\x9f\x94\x55\xa4\x15\x30\x79\x13\xab\x15\x51\x84\xa0\xff\xa1\xb2\xb2\x1e\xb3\xa0\xde\xe7\x2c\xa8\x36\x99\x2a\x39\x9f\x9a\x94\x34\x29\x4a\x1e\xf5\xb4\xa3\x9e\x76\xd4\xd3\xba\xd5\xd3\xa6\x42\x50\x38\xea\x6a\x47\x5d\xed\xa8\xab\x1d\x75\xb5\x3f\x84\xae\x26\x57\xa5\x2f\xa0\xaf\x49\xdc\x5f\x4c\x67\x3b\x6a\x69\x7f\x1a\x2d\x4d\x0d\xe4\xd7\x24\x39\x2a\x60\x35\x0a\x98\xa5\x5a\x95\xe4\x3c\xd8\xf2\xa8\x5b\x75\xa5\x5b\xc9\xf1\x79\xd4\xaf\xee\x5e\xbf\xfa\xfa\xd4\xa0\x10\xec\xa3\x5d\x09\x99\x5d\x26\x53\x38\x48\x5e\x07\x57\xb4\xe6\x54\x02\x4c\x32\xef\x2a\x95\x40\x5d\x72\x90\x3c\x2a\x3a\x95\x68\x32\x15\x90\xc8\xec\x50\xf0\x0d\x52\xa8\x54\x97\x42\x10\xe4\x42\xf4\x0d\x7a\xea\x95\x5e\x28\xaa\x0e\xca\x9b\x12\x85\x90\xb3\x25\xc3\x6b\x95\x0e\xa5\x92\xbb\x85\xe6\xbe\xb9\x13\xc4\x65\x26\xc8\xc8\x9d\xa7\xe0\x1e\xb8\x26\x09\x76\x2a\x74\xf5\x8c\xcb\x9d\xc5\xe4\x17\xe3\xe8\x38\xf1\x6f\x39\xf1\xf9\xc4\x94\x46\x8d\x83\xe6\x25\xb8\x9b\xa9\x39\x68\xcc\x89\x65\x9a\x82\xe0\x96\x0e\x4c\x91\xb7\x2b\x68\x43\x28\x4b\xd1\x5f\xd0\x08\x3f\xe5\x23\x46\x0c\x4f\x3e\x46\x8c\xa3\x14\x8e\x2e\xa0\x87\x40\xba\x85\xb6\x8c\x51\x36\xc8\xc8\x9b\xe9\x4f\x53\xba\x28\x50\x5b\x5e\xe9\x09\x57\x67\x4e\x7c\x34\x3a\xf3\x06\x09\xa6\xb9\x4f\xfb\xc3\xc7\xc3\x4b\x1f\xb9\x43\x57\x3d\x72\x7f\x81\x44\xe8\xc8\xb5\xd8\x98\x04\x2b\xbf\xf8\xd0\x84\xfb\x34\x12\x60\x14\x22\x71\x74\x83\xd2\x7c\x43\x8b\xa9\xf9\x8b\x82\x14\x05\xfc\x32\x92\x01\x40\x4f\x31\x46\xab\x2c\xdb\xa4\xe3\xe1\xf0\x32\xcc\x56\x39\xe4\xa2\x5c\x0f\x3f\x04\x0b\x3c\x27\xe4\xb7\x21\xad\x6d\xb8\xc9\xa3\x68\x38\x1a\x9d\x9d\x0e\x0c\xad\xef\x0f\x06\x03\x89\x20\x65\x72\xa0\x76\xd9\x87\x7a\x35\xb8\x22\x61\xdc\x77\x91\x0b\x77\x67\x9c\xf7\x7a\xc7\x89\x7c\xbb\x89\xdc\xb2\x83\x3f\x7b\xce\x51\xb0\x83\x51\xbe\x83\x0f\xd8\xaf\x56\xeb\xe7\x99\xd8\xff\x65\x90\x79\xf7\xd6\x4f\x61\x12\x94\xf7\xef\xef\x6b\xf8\x6c\x33\x6a\x9e\x9d\x71\x0c\x3c\x3c\xad\x7b\xab\xa6\xe8\x46\xe5\x42\xb2\xb7\x71\x93\xe3\x78\x51\x8b\x43\x0c\xc4\x82\xfc\x4b\xa1\xd7\x2f\x38\x9e\x17\x15\x3c\x2f\x8a\x78\x5e\x48\x3c\xa7\x35\x78\xce\x84\xb5\xfb\x15\x48\xeb\x82\x59\xa7\x1c\xc1\x69\x05\xc1\x69\x11\xc1\xa9\x44\x70\x56\x87\x40\x8c\x47\x95\x17\x1f\x00\xd7\x67\x1c\xc5\x59\x05\xc5\x59\x11\xc5\x99\x44\x31\xaa\x9b\xb3\xcf\x05\x8e\x77\x24\xcf\xc4\x98\x1f\x9d\x0c\xd8\x4f\xed\x55\xf1\x0d\x7b\xf1\x63\x18\xff\x26\x9f\xc3\x0f\xf6\xf8\x4d\xbc\xc4\xd7\xef\xf0\x32\x4c\xb0\x58\x07\x46\x27\x83\xc2\x53\x06\xb8\x0a\xd2\xd5\xf7\x61\x9a\x11\x61\x81\x1f\x9d\x0c\xb4\x67\x8a\xfa\xd1\x1e\x36\xf8\x91\x5c\x11\x0c\x4b\x42\x79\x4d\x50\x8b\xc2\xe8\x89\xb5\x21\x7e\x24\xa4\xfa\x51\x55\xac\x1f\x95\xe4\xfa\x91\x12\xec\x2f\x36\x9b\xc3\x4c\xf0\x8b\x55\x18\x2d\x13\x1c\xeb\x02\x04\x66\x72\x67\x47\x42\x7c\xad\x66\xcc\x6f\x6c\xc1\x49\xf9\x4a\x98\x5f\xc8\x66\x8c\x9e\x9e\xf8\xa8\x78\x43\xcc\x73\x7a\x43\x8c\x4d\x2e\x0e\x33\xcc\x48\xbe\x77\x2e\xc9\x22\x09\x3e\x64\x43\x08\xfd\xae\x0f\x94\x59\x89\x1a\xf7\x56\xfa\x13\xf2\xa9\x8e\xd4\x75\x10\xc6\x75\xfc\x88\xde\x2e\x47\x27\x45\x51\x5e\xf4\x4f\x1d\x09\x41\x1a\x2e\x6b\x8c\x60\x0b\x12\xbd\x5d\x3e\xa9\xa3\x23\x0e\x5a\x2c\xb8\x79\x54\x6b\xc0\x8d\x83\x8f\xd2\xea\x10\x07\x1f\x7f\x0e\xa3\x28\xd5\x1f\x4c\xb3\x00\xee\xae\xad\xb5\xe6\x46\x61\x23\x6a\x98\xed\x60\x00\x21\x63\xe4\x0c\x79\x0e\x14\x9c\x82\xc9\x44\x4f\xb2\xe2\x75\x50\x39\x0f\x05\x83\x9a\xd9\xa6\xd4\x4d\xb5\xe0\x33\x73\x33\x83\x73\x13\xa7\xec\x3f\xd4\x45\xf5\xd2\xdc\xab\x50\xc8\xdd\xa8\x43\x34\x70\x7f\x84\xc2\xa0\xdd\x92\xdd\x45\xe5\x73\xb6\xaf\x51\xfe\xf0\x3d\xae\x9b\x8a\xaf\xc8\xdc\x29\xde\xfb\xa0\xa5\x72\x50\x7a\x83\x90\xcd\x1f\x27\xb0\xe5\x24\x6e\x8a\xe8\x17\xb4\x08\xe2\x98\x64\x68\x8e\x11\x8d\x47\xc9\x08\x4a\x37\x78\x11\x7e\xb8\xa1\x6a\x57\x0a\x4e\x51\x62\x4a\xaa\x63\xaf\x26\xe1\x9d\x62\xb9\x4a\x87\x3a\xb6\x61\x98\xa6\x39\x4e\x87\xa3\xd3\xaf\x5f\x0c\x7a\x7c\x4d\xed\x99\xd7\x4f\xba\x41\x26\x3e\xda\xae\xd8\x66\x36\xd6\x77\xb6\x9a\x09\x46\xcb\x50\x53\x7f\xb6\x02\x9e\x38\xbe\xa2\x75\x8c\x2e\x36\x1b\xcb\x72\x6a\xee\x15\x2a\xe0\x36\x45\x53\x0d\x72\x7e\xfa\x68\xab\xf2\x69\x50\x57\x5b\x67\x08\x0f\x66\x3c\x3d\x93\xe3\xa3\x42\x7e\x31\x20\x53\x4b\xeb\x93\x3a\x3b\x0f\xed\x3c\x3b\x32\xf9\x2c\x36\xd0\xc8\xf7\x25\x33\x67\xd9\x8c\xf7\xd1\x96\x1e\x43\x6b\xd5\x88\x68\x66\xa8\x9b\x7e\x87\x59\xe0\xf8\x85\xfc\x06\xce\x30\x8f\xf5\x77\x95\xe0\x05\x0e\x95\xe2\x6c\x56\x8a\xc2\x70\x7c\x53\xfc\x2e\x87\x4f\xb0\xa9\x84\x34\xce\xd8\xb2\x44\x5b\x81\x6c\xbb\x4e\x0a\xcd\x8a\x23\x85\x5a\x2a\xa7\x95\x00\xc1\x1e\xce\x34\xc0\x3d\xfa\xad\xb4\x8e\xd9\x12\x5a\x90\xbc\x15\xb1\x95\xda\x9a\x08\x2e\x01\xef\x41\xb4\x5a\x15\xf7\x1d\x6f\x42\xb1\xf2\xd1\x96\x1e\x38\x4b\x8a\x54\x85\x94\x8f\xfc\x39\xfd\x3e\x13\x6f\xc5\xcb\x8b\x48\xcc\x16\xf6\x3e\x88\x22\x09\xa3\x1a\x5d\x6c\xac\x56\x87\xbc\x2c\xb9\x08\x51\xae\xa5\x70\x25\xaf\x91\x9e\x36\x06\x2b\xb8\xbd\xc7\xae\xd8\x1a\x6c\xc7\x03\xdf\x3e\xd4\x48\x90\x7b\x8b\x7d\xaf\xb2\x49\x6c\x87\x4f\x6a\x4c\x0a\x23\x14\x6f\x18\x43\x05\xf5\x04\xba\x9f\x5e\xad\xe9\x40\x58\x5c\x59\xc6\x01\xef\x3d\x70\x41\x20\x0b\x7a\x56\x08\x37\x9d\xf2\x5a\x5e\xdd\xbc\x59\xf6\xdd\x60\xb3\x71\xbd\x9e\x77\xde\xeb\xf5\x76\xbf\x7a\xe7\xff\x1f\x00\x74\x00\x74\x6e\x69\x72\x04\x00")
 
 func workJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +104,7 @@ func workJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "work.js", size: 277855, mode: os.FileMode(420), modTime: time.Unix(1513067922, 0)}
+	info := bindataFileInfo{name: "work.js", size: 291433, mode: os.FileMode(420), modTime: time.Unix(1792284852, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
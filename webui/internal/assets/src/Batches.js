@@ -0,0 +1,96 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import PageList from './PageList';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class Batches extends React.Component {
+  static propTypes = {
+    url: PropTypes.string,
+  }
+
+  state = {
+    page: 1,
+    count: 0,
+    batches: []
+  }
+
+  fetch() {
+    if (!this.props.url) {
+      return;
+    }
+    fetch(`${this.props.url}?page=${this.state.page}`).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({
+          count: data.count,
+          batches: data.batches
+        });
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  updatePage(page) {
+    this.setState({page: page}, this.fetch);
+  }
+
+  get pendingCount() {
+    let count = 0;
+    this.state.batches.map((batch) => {
+      if (batch.pending > 0) {
+        count++;
+      }
+    });
+    return count;
+  }
+
+  render() {
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>Batches</div>
+        <div className={styles.panelBody}>
+          <p>{this.state.count} batch(es), {this.pendingCount} of them on this page still running.</p>
+          <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
+        </div>
+        <div className={styles.tableResponsive}>
+          <table className={styles.table}>
+            <tbody>
+              <tr>
+                <th>ID</th>
+                <th>Description</th>
+                <th>Total</th>
+                <th>Pending</th>
+                <th>Succeeded</th>
+                <th>Failed</th>
+                <th>Dead</th>
+                <th>Created At</th>
+                <th>Completed At</th>
+              </tr>
+              {
+                this.state.batches.map((batch) => {
+                  return (
+                    <tr key={batch.id}>
+                      <td>{batch.id}</td>
+                      <td>{batch.description}</td>
+                      <td>{batch.total}</td>
+                      <td>{batch.pending}</td>
+                      <td>{batch.succeeded}</td>
+                      <td>{batch.failed}</td>
+                      <td>{batch.dead}</td>
+                      <td><UnixTime ts={batch.created_at} /></td>
+                      <td>{batch.completed_at ? <UnixTime ts={batch.completed_at} /> : null}</td>
+                    </tr>
+                  );
+                })
+              }
+            </tbody>
+          </table>
+        </div>
+      </div>
+    );
+  }
+}
@@ -0,0 +1,39 @@
+import './TestSetup';
+import expect from 'expect';
+import Batches from './Batches';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('Batches', () => {
+  it('shows batches', () => {
+    let batches = mount(<Batches />);
+
+    expect(batches.state().batches.length).toEqual(0);
+
+    batches.setState({
+      count: 2,
+      batches: [
+        {id: '1', description: 'import', total: 3, pending: 1, succeeded: 2, failed: 0, dead: 0, created_at: 1467760821},
+        {id: '2', description: 'export', total: 1, pending: 0, succeeded: 1, failed: 1, dead: 0, created_at: 1467760821, completed_at: 1467760822}
+      ]
+    });
+
+    expect(batches.state().batches.length).toEqual(2);
+    expect(batches.instance().pendingCount).toEqual(1);
+  });
+
+  it('has pages', () => {
+    let batches = mount(<Batches />);
+
+    batches.setState({
+      count: 21,
+      batches: []
+    });
+
+    let pageList = batches.find('PageList');
+    expect(pageList.length).toEqual(1);
+
+    pageList.at(0).props().jumpTo(2)();
+    expect(batches.state().page).toEqual(2);
+  });
+});
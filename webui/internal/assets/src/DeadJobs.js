@@ -2,6 +2,7 @@ import React from 'react';
 import PropTypes from 'prop-types';
 import PageList from './PageList';
 import UnixTime from './UnixTime';
+import JobFilterForm, { filterQuery } from './JobFilterForm';
 import styles from './bootstrap.min.css';
 import cx from './cx';
 
@@ -12,10 +13,13 @@ export default class DeadJobs extends React.Component {
     deleteAllURL: PropTypes.string,
     retryURL: PropTypes.string,
     retryAllURL: PropTypes.string,
+    deleteMatchingURL: PropTypes.string,
+    retryMatchingURL: PropTypes.string,
   }
 
   state = {
     selected: [],
+    filter: {},
     page: 1,
     count: 0,
     jobs: []
@@ -25,7 +29,7 @@ export default class DeadJobs extends React.Component {
     if (!this.props.fetchURL) {
       return;
     }
-    fetch(`${this.props.fetchURL}?page=${this.state.page}`).
+    fetch(`${this.props.fetchURL}?page=${this.state.page}${filterQuery(this.state.filter)}`).
       then((resp) => resp.json()).
       then((data) => {
         this.setState({
@@ -44,6 +48,19 @@ export default class DeadJobs extends React.Component {
     this.setState({page: page}, this.fetch);
   }
 
+  updateFilter(filter) {
+    this.setState({filter: filter, page: 1}, this.fetch);
+  }
+
+  deleteMatching() {
+    if (!this.props.deleteMatchingURL) {
+      return;
+    }
+    fetch(`${this.props.deleteMatchingURL}?${filterQuery(this.state.filter).slice(1)}`, {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
   checked(job) {
     return this.state.selected.includes(job);
   }
@@ -105,6 +122,15 @@ export default class DeadJobs extends React.Component {
     });
   }
 
+  retryMatching() {
+    if (!this.props.retryMatchingURL) {
+      return;
+    }
+    fetch(`${this.props.retryMatchingURL}?${filterQuery(this.state.filter).slice(1)}`, {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
   retrySelected() {
     let p = [];
     this.state.selected.map((job) => {
@@ -125,6 +151,7 @@ export default class DeadJobs extends React.Component {
         <div className={cx(styles.panel, styles.panelDefault)}>
           <div className={styles.panelHeading}>Dead Jobs</div>
           <div className={styles.panelBody}>
+            <JobFilterForm timeLabel="Died" onFilter={(filter) => this.updateFilter(filter)} />
             <p>{this.state.count} job(s) are dead.</p>
             <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
           </div>
@@ -160,6 +187,8 @@ export default class DeadJobs extends React.Component {
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retrySelected()}>Retry Selected Jobs</button>
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteAll()}>Delete All Jobs</button>
           <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retryAll()}>Retry All Jobs</button>
+          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteMatching()}>Delete Matching Jobs</button>
+          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.retryMatching()}>Retry Matching Jobs</button>
         </div>
       </div>
     );
@@ -22,49 +22,55 @@ describe('DeadJobs', () => {
     expect(deadJobs.state().selected.length).toEqual(0);
     expect(deadJobs.state().jobs.length).toEqual(2);
 
-    let checkbox = deadJobs.find('input');
+    let checkbox = deadJobs.find('input[type="checkbox"]');
     expect(checkbox.length).toEqual(3);
     expect(checkbox.at(0).props().checked).toEqual(false);
     expect(checkbox.at(1).props().checked).toEqual(false);
     expect(checkbox.at(2).props().checked).toEqual(false);
 
     checkbox.at(0).simulate('change');
-    checkbox = deadJobs.find('input');
+    checkbox = deadJobs.find('input[type="checkbox"]');
     expect(checkbox.length).toEqual(3);
     expect(checkbox.at(0).props().checked).toEqual(true);
     expect(checkbox.at(1).props().checked).toEqual(true);
     expect(checkbox.at(2).props().checked).toEqual(true);
 
     checkbox.at(1).simulate('change');
-    checkbox = deadJobs.find('input');
+    checkbox = deadJobs.find('input[type="checkbox"]');
     expect(checkbox.length).toEqual(3);
     expect(checkbox.at(0).props().checked).toEqual(true);
     expect(checkbox.at(1).props().checked).toEqual(false);
     expect(checkbox.at(2).props().checked).toEqual(true);
 
     checkbox.at(1).simulate('change');
-    checkbox = deadJobs.find('input');
+    checkbox = deadJobs.find('input[type="checkbox"]');
     expect(checkbox.length).toEqual(3);
     expect(checkbox.at(0).props().checked).toEqual(true);
     expect(checkbox.at(1).props().checked).toEqual(true);
     expect(checkbox.at(2).props().checked).toEqual(true);
 
-    let button = deadJobs.find('button');
-    expect(button.length).toEqual(4);
-    button.at(0).simulate('click');
-    button.at(1).simulate('click');
-    button.at(2).simulate('click');
-    button.at(3).simulate('click');
+    let button = deadJobs.find('button[type="button"]');
+    expect(button.length).toEqual(6);
+    button.forEach((b) => b.simulate('click'));
 
     checkbox.at(0).simulate('change');
 
-    checkbox = deadJobs.find('input');
+    checkbox = deadJobs.find('input[type="checkbox"]');
     expect(checkbox.length).toEqual(3);
     expect(checkbox.at(0).props().checked).toEqual(false);
     expect(checkbox.at(1).props().checked).toEqual(false);
     expect(checkbox.at(2).props().checked).toEqual(false);
   });
 
+  it('filters jobs', () => {
+    let deadJobs = mount(<DeadJobs />);
+
+    deadJobs.setState({page: 2});
+    deadJobs.find('JobFilterForm').props().onFilter({name: 'test'});
+    expect(deadJobs.state().filter).toEqual({name: 'test'});
+    expect(deadJobs.state().page).toEqual(1);
+  });
+
   it('has pages', () => {
     let deadJobs = mount(<DeadJobs />);
 
@@ -0,0 +1,75 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+// filterQuery returns the query string params for the job filter, to append to a URL that has params already.
+export function filterQuery(filter) {
+  let query = '';
+  Object.keys(filter).map((key) => {
+    if (filter[key]) {
+      query += `&${key}=${encodeURIComponent(filter[key])}`;
+    }
+  });
+  return query;
+}
+
+// toEpoch turns the value of a datetime-local input into epoch seconds.
+function toEpoch(value) {
+  if (!value) {
+    return '';
+  }
+  return Math.floor(new Date(value).getTime() / 1000);
+}
+
+export default class JobFilterForm extends React.Component {
+  static propTypes = {
+    onFilter: PropTypes.func,
+    timeLabel: PropTypes.string,
+  }
+
+  state = {
+    name: '',
+    err: '',
+    from: '',
+    to: '',
+    arg_key: '',
+    arg_value: ''
+  }
+
+  get filter() {
+    return {
+      name: this.state.name,
+      err: this.state.err,
+      from: toEpoch(this.state.from),
+      to: toEpoch(this.state.to),
+      arg_key: this.state.arg_key,
+      arg_value: this.state.arg_key ? this.state.arg_value : ''
+    };
+  }
+
+  submit(e) {
+    e.preventDefault();
+    if (this.props.onFilter) {
+      this.props.onFilter(this.filter);
+    }
+  }
+
+  input(key, placeholder, type = 'text') {
+    return <input type={type} placeholder={placeholder} value={this.state[key]} onChange={(e) => this.setState({[key]: e.target.value})} />;
+  }
+
+  render() {
+    return (
+      <form onSubmit={(e) => this.submit(e)} style={{marginBottom: 10}}>
+        {this.input('name', 'Name')}{' '}
+        {this.input('err', 'Error contains')}{' '}
+        {this.input('arg_key', 'Arg')}{' = '}
+        {this.input('arg_value', 'Any value')}{' '}
+        {this.props.timeLabel || 'At'} from {this.input('from', 'From', 'datetime-local')}{' '}
+        to {this.input('to', 'To', 'datetime-local')}{' '}
+        <button type="submit" className={cx(styles.btn, styles.btnDefault, styles.btnSm)}>Filter</button>
+      </form>
+    );
+  }
+}
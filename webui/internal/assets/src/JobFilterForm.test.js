@@ -0,0 +1,31 @@
+import './TestSetup';
+import expect from 'expect';
+import JobFilterForm, { filterQuery } from './JobFilterForm';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('JobFilterForm', () => {
+  it('filters jobs', () => {
+    let filters = [];
+    let form = mount(<JobFilterForm onFilter={(filter) => filters.push(filter)} />);
+
+    let input = form.find('input');
+    expect(input.length).toEqual(6);
+    input.at(0).simulate('change', {target: {value: 'send_email'}});
+    input.at(1).simulate('change', {target: {value: 'refused'}});
+    input.at(3).simulate('change', {target: {value: 'ignored without a key'}});
+    form.find('form').simulate('submit');
+
+    expect(filters.length).toEqual(1);
+    expect(filters[0].name).toEqual('send_email');
+    expect(filters[0].err).toEqual('refused');
+    expect(filters[0].arg_value).toEqual('');
+    expect(filters[0].from).toEqual('');
+  });
+
+  it('makes query strings', () => {
+    expect(filterQuery({name: 'wat', err: 'no such host', from: 1467760821, to: ''})).
+      toEqual('&name=wat&err=no%20such%20host&from=1467760821');
+    expect(filterQuery({})).toEqual('');
+  });
+});
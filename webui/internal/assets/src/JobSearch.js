@@ -0,0 +1,80 @@
+import React from 'react';
+import PropTypes from 'prop-types';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class JobSearch extends React.Component {
+  static propTypes = {
+    url: PropTypes.string,
+  }
+
+  state = {
+    jobID: '',
+    job: null,
+    notFound: false
+  }
+
+  search(e) {
+    e.preventDefault();
+    if (!this.props.url || !this.state.jobID) {
+      return;
+    }
+    fetch(`${this.props.url}/${encodeURIComponent(this.state.jobID)}`).
+      then((resp) => {
+        if (resp.status == 404) {
+          this.setState({job: null, notFound: true});
+          return;
+        }
+        return resp.json().then((job) => {
+          this.setState({job: job, notFound: false});
+        });
+      });
+  }
+
+  get atLabel() {
+    switch (this.state.job.location) {
+    case 'scheduled':
+      return 'Run At';
+    case 'retry':
+      return 'Retry At';
+    case 'dead':
+      return 'Died At';
+    }
+    return null;
+  }
+
+  render() {
+    let job = this.state.job;
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>Find Job</div>
+        <div className={styles.panelBody}>
+          <form onSubmit={(e) => this.search(e)}>
+            <input type="text" placeholder="Job ID" size={40} value={this.state.jobID} onChange={(e) => this.setState({jobID: e.target.value})} />
+            {' '}
+            <button type="submit" className={cx(styles.btn, styles.btnDefault, styles.btnSm)}>Find</button>
+          </form>
+          {this.state.notFound && <p>No job with this ID.</p>}
+        </div>
+        {
+          job &&
+            <div className={styles.tableResponsive}>
+              <table className={styles.table}>
+                <tbody>
+                  <tr><th>ID</th><td>{job.id}</td></tr>
+                  <tr><th>Name</th><td>{job.name}</td></tr>
+                  <tr><th>Location</th><td>{job.location}{job.pool_id && ` (${job.pool_id})`}{job.concurrency_key && ` (${job.concurrency_key})`}</td></tr>
+                  {this.atLabel && <tr><th>{this.atLabel}</th><td><UnixTime ts={job.at} /></td></tr>}
+                  <tr><th>Enqueued At</th><td><UnixTime ts={job.t} /></td></tr>
+                  <tr><th>Fails</th><td>{job.fails || 0}</td></tr>
+                  {job.err && <tr><th>Error</th><td>{job.err}</td></tr>}
+                  <tr><th>Args</th><td><pre>{JSON.stringify(job.args, null, 2)}</pre></td></tr>
+                </tbody>
+              </table>
+            </div>
+        }
+      </div>
+    );
+  }
+}
@@ -0,0 +1,32 @@
+import './TestSetup';
+import expect from 'expect';
+import JobSearch from './JobSearch';
+import React from 'react';
+import { mount } from 'enzyme';
+
+describe('JobSearch', () => {
+  it('shows the job found', () => {
+    let search = mount(<JobSearch />);
+
+    expect(search.find('table').length).toEqual(0);
+
+    search.find('input').simulate('change', {target: {value: 'a1'}});
+    expect(search.state().jobID).toEqual('a1');
+
+    search.setState({
+      job: {location: 'dead', id: 'a1', name: 'wat', t: 1467760821, at: 1467760822, fails: 3, err: 'ohno', args: {a: 1}}
+    });
+
+    expect(search.find('table').length).toEqual(1);
+    expect(search.instance().atLabel).toEqual('Died At');
+    expect(search.find('pre').text()).toContain('"a": 1');
+  });
+
+  it('says when there is no job', () => {
+    let search = mount(<JobSearch />);
+
+    search.setState({notFound: true});
+
+    expect(search.text()).toContain('No job with this ID.');
+  });
+});
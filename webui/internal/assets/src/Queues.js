@@ -1,18 +1,23 @@
 import React from 'react';
 import PropTypes from 'prop-types';
+import UnixTime from './UnixTime';
 import styles from './bootstrap.min.css';
 import cx from './cx';
 
 export default class Queues extends React.Component {
   static propTypes = {
     url: PropTypes.string,
+    pauseURL: PropTypes.string,
+    unpauseURL: PropTypes.string,
+    setMaxConcurrencyURL: PropTypes.string,
+    resetMaxConcurrencyURL: PropTypes.string,
   }
 
   state = {
     queues: []
   }
 
-  componentWillMount() {
+  fetch() {
     if (!this.props.url) {
       return;
     }
@@ -23,6 +28,10 @@ export default class Queues extends React.Component {
       });
   }
 
+  componentWillMount() {
+    this.fetch();
+  }
+
   get queuedCount() {
     let count = 0;
     this.state.queues.map((queue) => {
@@ -31,12 +40,49 @@ export default class Queues extends React.Component {
     return count;
   }
 
+  get pausedCount() {
+    return this.state.queues.filter((queue) => queue.paused).length;
+  }
+
+  post(url) {
+    if (!url) {
+      return;
+    }
+    fetch(url, {method: 'post'}).then(() => {
+      this.fetch();
+    });
+  }
+
+  pause(queue, ttl) {
+    let url = `${this.props.pauseURL}/${queue.job_name}`;
+    if (ttl) {
+      url += `?ttl=${ttl}`;
+    }
+    this.post(this.props.pauseURL && url);
+  }
+
+  unpause(queue) {
+    this.post(this.props.unpauseURL && `${this.props.unpauseURL}/${queue.job_name}`);
+  }
+
+  setMaxConcurrency(queue) {
+    const max = window.prompt(`Max concurrency of ${queue.job_name} (0 means no limit)`, queue.max_concurrency);
+    if (max === null || !/^\d+$/.test(max)) {
+      return;
+    }
+    this.post(this.props.setMaxConcurrencyURL && `${this.props.setMaxConcurrencyURL}/${queue.job_name}?max=${max}`);
+  }
+
+  resetMaxConcurrency(queue) {
+    this.post(this.props.resetMaxConcurrencyURL && `${this.props.resetMaxConcurrencyURL}/${queue.job_name}`);
+  }
+
   render() {
     return (
       <div className={cx(styles.panel, styles.panelDefault)}>
         <div className={styles.panelHeading}>queues</div>
         <div className={styles.panelBody}>
-          <p>{this.state.queues.length} queue(s) with a total of {this.queuedCount} item(s) queued.</p>
+          <p>{this.state.queues.length} queue(s) with a total of {this.queuedCount} item(s) queued. {this.pausedCount} queue(s) paused.</p>
         </div>
         <div className={styles.tableResponsive}>
           <table className={styles.table}>
@@ -45,6 +91,9 @@ export default class Queues extends React.Component {
                 <th>Name</th>
                 <th>Count</th>
                 <th>Latency (seconds)</th>
+                <th>Paused</th>
+                <th>Max Concurrency</th>
+                <th></th>
               </tr>
               {
                 this.state.queues.map((queue) => {
@@ -53,6 +102,25 @@ export default class Queues extends React.Component {
                       <td>{queue.job_name}</td>
                       <td>{queue.count}</td>
                       <td>{queue.latency}</td>
+                      <td>{queue.paused ? (queue.paused_until ? <span>until <UnixTime ts={queue.paused_until} /></span> : 'yes') : 'no'}</td>
+                      <td>{queue.max_concurrency || 'no limit'}{queue.max_concurrency_override ? ' (override)' : ''}</td>
+                      <td>
+                        <div className={styles.btnGroup} role="group">
+                          {
+                            queue.paused ?
+                              <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.unpause(queue)}>Unpause</button> :
+                              [
+                                <button key="pause" type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.pause(queue)}>Pause</button>,
+                                <button key="pause_hour" type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.pause(queue, 3600)}>Pause 1h</button>
+                              ]
+                          }
+                          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.setMaxConcurrency(queue)}>Set Max Concurrency</button>
+                          {
+                            queue.max_concurrency_override &&
+                              <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.resetMaxConcurrency(queue)}>Reset Max Concurrency</button>
+                          }
+                        </div>
+                      </td>
                     </tr>
                   );
                 })
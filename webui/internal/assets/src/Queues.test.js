@@ -19,4 +19,24 @@ describe('Queues', () => {
     expect(queues.state().queues.length).toEqual(2);
     expect(queues.instance().queuedCount).toEqual(3);
   });
+
+  it('shows queue controls', () => {
+    let queues = mount(<Queues />);
+
+    queues.setState({
+      queues: [
+        {job_name: 'test', count: 1, latency: 0, paused: true, paused_until: 0, max_concurrency: 0},
+        {job_name: 'test2', count: 2, latency: 0, paused: false, max_concurrency: 3, max_concurrency_override: true}
+      ]
+    });
+
+    expect(queues.instance().pausedCount).toEqual(1);
+    let rows = queues.find('tr');
+    expect(rows.at(1).text()).toContain('Unpause');
+    expect(rows.at(1).text()).toContain('no limit');
+    expect(rows.at(1).text().includes('Reset Max Concurrency')).toEqual(false);
+    expect(rows.at(2).text()).toContain('Pause 1h');
+    expect(rows.at(2).text()).toContain('3 (override)');
+    expect(rows.at(2).text()).toContain('Reset Max Concurrency');
+  });
 });
@@ -2,15 +2,18 @@ import React from 'react';
 import PropTypes from 'prop-types';
 import PageList from './PageList';
 import UnixTime from './UnixTime';
+import JobFilterForm, { filterQuery } from './JobFilterForm';
 import styles from './bootstrap.min.css';
 import cx from './cx';
 
 export default class RetryJobs extends React.Component {
   static propTypes = {
     url: PropTypes.string,
+    deleteMatchingURL: PropTypes.string,
   }
 
   state = {
+    filter: {},
     page: 1,
     count: 0,
     jobs: []
@@ -20,7 +23,7 @@ export default class RetryJobs extends React.Component {
     if (!this.props.url) {
       return;
     }
-    fetch(`${this.props.url}?page=${this.state.page}`).
+    fetch(`${this.props.url}?page=${this.state.page}${filterQuery(this.state.filter)}`).
       then((resp) => resp.json()).
       then((data) => {
         this.setState({
@@ -38,37 +41,56 @@ export default class RetryJobs extends React.Component {
     this.setState({page: page}, this.fetch);
   }
 
+  updateFilter(filter) {
+    this.setState({filter: filter, page: 1}, this.fetch);
+  }
+
+  deleteMatching() {
+    if (!this.props.deleteMatchingURL) {
+      return;
+    }
+    fetch(`${this.props.deleteMatchingURL}?${filterQuery(this.state.filter).slice(1)}`, {method: 'post'}).then(() => {
+      this.updatePage(1);
+    });
+  }
+
   render() {
     return (
-      <div className={cx(styles.panel, styles.panelDefault)}>
-        <div className={styles.panelHeading}>Retry Jobs</div>
-        <div className={styles.panelBody}>
-          <p>{this.state.count} job(s) scheduled to be retried.</p>
-          <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
+      <div>
+        <div className={cx(styles.panel, styles.panelDefault)}>
+          <div className={styles.panelHeading}>Retry Jobs</div>
+          <div className={styles.panelBody}>
+            <JobFilterForm timeLabel="Retry" onFilter={(filter) => this.updateFilter(filter)} />
+            <p>{this.state.count} job(s) scheduled to be retried.</p>
+            <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
+          </div>
+          <div className={styles.tableResponsive}>
+            <table className={styles.table}>
+              <tbody>
+                <tr>
+                  <th>Name</th>
+                  <th>Arguments</th>
+                  <th>Error</th>
+                  <th>Retry At</th>
+                </tr>
+                {
+                  this.state.jobs.map((job) => {
+                    return (
+                      <tr key={job.id}>
+                        <td>{job.name}</td>
+                        <td>{JSON.stringify(job.args)}</td>
+                        <td>{job.err}</td>
+                        <td><UnixTime ts={job.t} /></td>
+                      </tr>
+                    );
+                  })
+                }
+              </tbody>
+            </table>
+          </div>
         </div>
-        <div className={styles.tableResponsive}>
-          <table className={styles.table}>
-            <tbody>
-              <tr>
-                <th>Name</th>
-                <th>Arguments</th>
-                <th>Error</th>
-                <th>Retry At</th>
-              </tr>
-              {
-                this.state.jobs.map((job) => {
-                  return (
-                    <tr key={job.id}>
-                      <td>{job.name}</td>
-                      <td>{JSON.stringify(job.args)}</td>
-                      <td>{job.err}</td>
-                      <td><UnixTime ts={job.t} /></td>
-                    </tr>
-                  );
-                })
-              }
-            </tbody>
-          </table>
+        <div className={styles.btnGroup} role="group">
+          <button type="button" className={cx(styles.btn, styles.btnDefault)} onClick={() => this.deleteMatching()}>Delete Matching Jobs</button>
         </div>
       </div>
     );
@@ -6,6 +6,8 @@ import DeadJobs from './DeadJobs';
 import Queues from './Queues';
 import RetryJobs from './RetryJobs';
 import ScheduledJobs from './ScheduledJobs';
+import Batches from './Batches';
+import JobSearch from './JobSearch';
 import { Router, Route, Link, IndexRedirect, hashHistory } from 'react-router';
 import styles from './bootstrap.min.css';
 import cx from './cx';
@@ -32,6 +34,8 @@ class App extends React.Component {
                 <li><Link to="/retry_jobs">Retry Jobs</Link></li>
                 <li><Link to="/scheduled_jobs">Scheduled Jobs</Link></li>
                 <li><Link to="/dead_jobs">Dead Jobs</Link></li>
+                <li><Link to="/batches">Batches</Link></li>
+                <li><Link to="/job">Find Job</Link></li>
               </ul>
             </nav>
           </aside>
@@ -47,9 +51,17 @@ render(
   <Router history={hashHistory}>
     <Route path="/" component={App}>
       <Route path="/processes" component={ () => <Processes busyWorkerURL="/busy_workers" workerPoolURL="/worker_pools" /> } />
-      <Route path="/queues" component={ () => <Queues url="/queues" /> } />
-      <Route path="/retry_jobs" component={ () => <RetryJobs url="/retry_jobs" /> } />
-      <Route path="/scheduled_jobs" component={ () => <ScheduledJobs url="/scheduled_jobs" /> } />
+      <Route path="/queues" component={ () =>
+        <Queues
+          url="/queues"
+          pauseURL="/pause_job"
+          unpauseURL="/unpause_job"
+          setMaxConcurrencyURL="/set_max_concurrency"
+          resetMaxConcurrencyURL="/reset_max_concurrency"
+        />
+      } />
+      <Route path="/retry_jobs" component={ () => <RetryJobs url="/retry_jobs" deleteMatchingURL="/delete_retry_jobs" /> } />
+      <Route path="/scheduled_jobs" component={ () => <ScheduledJobs url="/scheduled_jobs" deleteMatchingURL="/delete_scheduled_jobs" /> } />
       <Route path="/dead_jobs" component={ () =>
         <DeadJobs
           fetchURL="/dead_jobs"
@@ -57,8 +69,12 @@ render(
           retryAllURL="/retry_all_dead_jobs"
           deleteURL="/delete_dead_job"
           deleteAllURL="/delete_all_dead_jobs"
+          retryMatchingURL="/retry_dead_jobs"
+          deleteMatchingURL="/delete_dead_jobs"
         />
       } />
+      <Route path="/batches" component={ () => <Batches url="/batches" /> } />
+      <Route path="/job" component={ () => <JobSearch url="/job" /> } />
       <IndexRedirect from="" to="/processes" />
     </Route>
   </Router>,
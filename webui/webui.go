@@ -6,11 +6,12 @@ import (
 	"net/http"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/braintree/manners"
 	"github.com/gocraft/web"
-	"github.com/gocraft/work"
 	"github.com/gomodule/redigo/redis"
+	"github.com/wallester/work"
 	"github.com/wallester/work/webui/internal/assets"
 )
 
@@ -32,10 +33,13 @@ type context struct {
 // NewServer creates and returns a new server. The 'namespace' param is the redis namespace to use. The hostPort param is the address to bind on to expose the API.
 func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 	router := web.New(context{})
+	// What's done from the UI is rare, and should take effect right away
+	client := work.NewClient(namespace, pool)
+	client.SetWakeup(true)
 	server := &Server{
 		namespace: namespace,
 		pool:      pool,
-		client:    work.NewClient(namespace, pool),
+		client:    client,
 		hostPort:  hostPort,
 		server:    manners.NewWithServer(&http.Server{Addr: hostPort, Handler: router}),
 		router:    router,
@@ -50,6 +54,10 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 		next(rw, r)
 	})
 	router.Get("/queues", (*context).queues)
+	router.Post("/pause_job/:job_name", (*context).pauseJob)
+	router.Post("/unpause_job/:job_name", (*context).unpauseJob)
+	router.Post("/set_max_concurrency/:job_name", (*context).setMaxConcurrency)
+	router.Post("/reset_max_concurrency/:job_name", (*context).resetMaxConcurrency)
 	router.Get("/worker_pools", (*context).workerPools)
 	router.Get("/busy_workers", (*context).busyWorkers)
 	router.Get("/retry_jobs", (*context).retryJobs)
@@ -59,6 +67,12 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 	router.Post("/retry_dead_job/:died_at:\\d.*/:job_id", (*context).retryDeadJob)
 	router.Post("/delete_all_dead_jobs", (*context).deleteAllDeadJobs)
 	router.Post("/retry_all_dead_jobs", (*context).retryAllDeadJobs)
+	router.Post("/retry_dead_jobs", (*context).retryDeadJobsMatching)
+	router.Post("/delete_dead_jobs", (*context).deleteDeadJobsMatching)
+	router.Post("/delete_retry_jobs", (*context).deleteRetryJobsMatching)
+	router.Post("/delete_scheduled_jobs", (*context).deleteScheduledJobsMatching)
+	router.Get("/batches", (*context).batches)
+	router.Get("/job/:job_id", (*context).findJob)
 
 	//
 	// Build the HTML page:
@@ -76,6 +90,12 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 	return server
 }
 
+// SetLogger makes the server log the errors it runs into with logger, instead of slog.Default(). It must be called
+// before Start.
+func (w *Server) SetLogger(logger work.Logger) {
+	w.client.SetLogger(logger)
+}
+
 // Start starts the server listening for requests on the hostPort specified in NewServer.
 func (w *Server) Start() {
 	w.wg.Add(1)
@@ -96,6 +116,45 @@ func (c *context) queues(rw web.ResponseWriter, r *web.Request) {
 	render(rw, response, err)
 }
 
+// pauseJob pauses a job until it's unpaused, or for ttl seconds if that query param is set.
+func (c *context) pauseJob(rw web.ResponseWriter, r *web.Request) {
+	var ttl int64
+	if ttlStr := r.URL.Query().Get("ttl"); ttlStr != "" {
+		var err error
+		ttl, err = strconv.ParseInt(ttlStr, 10, 64)
+		if err != nil {
+			renderError(rw, err)
+			return
+		}
+	}
+
+	err := c.client.PauseJobFor(r.PathParams["job_name"], time.Duration(ttl)*time.Second)
+
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) unpauseJob(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.UnpauseJob(r.PathParams["job_name"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) setMaxConcurrency(rw web.ResponseWriter, r *web.Request) {
+	max, err := strconv.ParseUint(r.URL.Query().Get("max"), 10, 0)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	err = c.client.SetMaxConcurrency(r.PathParams["job_name"], uint(max))
+
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) resetMaxConcurrency(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.ResetMaxConcurrency(r.PathParams["job_name"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
 func (c *context) workerPools(rw web.ResponseWriter, r *web.Request) {
 	response, err := c.client.WorkerPoolHeartbeats()
 	render(rw, response, err)
@@ -125,7 +184,13 @@ func (c *context) retryJobs(rw web.ResponseWriter, r *web.Request) {
 		return
 	}
 
-	jobs, count, err := c.client.RetryJobs(page)
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	jobs, count, err := c.client.RetryJobsMatching(filter, page)
 	if err != nil {
 		renderError(rw, err)
 		return
@@ -146,7 +211,13 @@ func (c *context) scheduledJobs(rw web.ResponseWriter, r *web.Request) {
 		return
 	}
 
-	jobs, count, err := c.client.ScheduledJobs(page)
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	jobs, count, err := c.client.ScheduledJobsMatching(filter, page)
 	if err != nil {
 		renderError(rw, err)
 		return
@@ -167,7 +238,13 @@ func (c *context) deadJobs(rw web.ResponseWriter, r *web.Request) {
 		return
 	}
 
-	jobs, count, err := c.client.DeadJobs(page)
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	jobs, count, err := c.client.DeadJobsMatching(filter, page)
 	if err != nil {
 		renderError(rw, err)
 		return
@@ -181,6 +258,27 @@ func (c *context) deadJobs(rw web.ResponseWriter, r *web.Request) {
 	render(rw, response, err)
 }
 
+func (c *context) batches(rw web.ResponseWriter, r *web.Request) {
+	page, err := parsePage(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	batches, count, err := c.client.Batches(page)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	response := struct {
+		Count   int64               `json:"count"`
+		Batches []*work.BatchStatus `json:"batches"`
+	}{Count: count, Batches: batches}
+
+	render(rw, response, err)
+}
+
 func (c *context) deleteDeadJob(rw web.ResponseWriter, r *web.Request) {
 	diedAt, err := strconv.ParseInt(r.PathParams["died_at"], 10, 64)
 	if err != nil {
@@ -215,6 +313,16 @@ func (c *context) retryAllDeadJobs(rw web.ResponseWriter, r *web.Request) {
 	render(rw, map[string]string{"status": "ok"}, err)
 }
 
+func (c *context) findJob(rw web.ResponseWriter, r *web.Request) {
+	job, err := c.client.FindJob(r.PathParams["job_id"])
+	if err == work.ErrJobNotFound {
+		rw.WriteHeader(404)
+		fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
+		return
+	}
+	render(rw, job, err)
+}
+
 func render(rw web.ResponseWriter, jsonable interface{}, err error) {
 	if err != nil {
 		renderError(rw, err)
@@ -234,6 +342,34 @@ func renderError(rw http.ResponseWriter, err error) {
 	fmt.Fprintf(rw, `{"error": "%s"}`, err.Error())
 }
 
+func (c *context) retryDeadJobsMatching(rw web.ResponseWriter, r *web.Request) {
+	c.bulkAction(rw, r, c.client.RetryDeadJobsMatching)
+}
+
+func (c *context) deleteDeadJobsMatching(rw web.ResponseWriter, r *web.Request) {
+	c.bulkAction(rw, r, c.client.DeleteDeadJobsMatching)
+}
+
+func (c *context) deleteRetryJobsMatching(rw web.ResponseWriter, r *web.Request) {
+	c.bulkAction(rw, r, c.client.DeleteRetryJobsMatching)
+}
+
+func (c *context) deleteScheduledJobsMatching(rw web.ResponseWriter, r *web.Request) {
+	c.bulkAction(rw, r, c.client.DeleteScheduledJobsMatching)
+}
+
+// bulkAction applies action to the jobs selected by the filter in the request, and renders how many jobs it changed.
+func (c *context) bulkAction(rw web.ResponseWriter, r *web.Request, action func(work.JobFilter) (int64, error)) {
+	filter, err := parseJobFilter(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	count, err := action(filter)
+	render(rw, map[string]int64{"count": count}, err)
+}
+
 func parsePage(r *web.Request) (uint, error) {
 	err := r.ParseForm()
 	if err != nil {
@@ -248,3 +384,26 @@ func parsePage(r *web.Request) (uint, error) {
 	page, err := strconv.ParseUint(pageStr, 10, 0)
 	return uint(page), err
 }
+
+func parseJobFilter(r *web.Request) (work.JobFilter, error) {
+	query := r.URL.Query()
+	filter := work.JobFilter{
+		Name:        query.Get("name"),
+		ErrContains: query.Get("err"),
+		ArgKey:      query.Get("arg_key"),
+		ArgValue:    query.Get("arg_value"),
+	}
+
+	var err error
+	if from := query.Get("from"); from != "" {
+		if filter.From, err = strconv.ParseInt(from, 10, 64); err != nil {
+			return work.JobFilter{}, err
+		}
+	}
+	if to := query.Get("to"); to != "" {
+		if filter.To, err = strconv.ParseInt(to, 10, 64); err != nil {
+			return work.JobFilter{}, err
+		}
+	}
+	return filter, nil
+}
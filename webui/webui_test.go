@@ -9,9 +9,9 @@ import (
 	"testing"
 	"time"
 
-	"github.com/gocraft/work"
 	"github.com/gomodule/redigo/redis"
 	"github.com/stretchr/testify/assert"
+	"github.com/wallester/work"
 )
 
 func TestWebUIStartStop(t *testing.T) {
@@ -82,6 +82,55 @@ func TestWebUIQueues(t *testing.T) {
 	assert.EqualValues(t, 0, foomap["latency"])
 }
 
+func TestWebUIQueueControls(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+	queue := func() map[string]interface{} {
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", "/queues", nil)
+		s.router.ServeHTTP(recorder, request)
+		assert.Equal(t, 200, recorder.Code)
+
+		var res []map[string]interface{}
+		err := json.Unmarshal(recorder.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(res))
+		return res[0]
+	}
+	post := func(url string) int {
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest("POST", url, nil)
+		s.router.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	assert.Equal(t, 200, post("/pause_job/wat?ttl=60"))
+	q := queue()
+	assert.Equal(t, true, q["paused"])
+	assert.InDelta(t, time.Now().Unix()+60, q["paused_until"], 2)
+
+	assert.Equal(t, 200, post("/unpause_job/wat"))
+	assert.Equal(t, false, queue()["paused"])
+
+	assert.Equal(t, 200, post("/set_max_concurrency/wat?max=3"))
+	q = queue()
+	assert.EqualValues(t, 3, q["max_concurrency"])
+	assert.Equal(t, true, q["max_concurrency_override"])
+
+	assert.Equal(t, 200, post("/reset_max_concurrency/wat"))
+	assert.Equal(t, false, queue()["max_concurrency_override"])
+
+	assert.Equal(t, 500, post("/set_max_concurrency/wat?max=lots"))
+	assert.Equal(t, 500, post("/pause_job/wat?ttl=soon"))
+}
+
 func TestWebUIWorkerPools(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -447,6 +496,54 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	assert.EqualValues(t, 0, res.Count)
 }
 
+func TestWebUIBatches(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	b := enqueuer.NewBatch(work.BatchOptions{Description: "wats"})
+	b.Add("wat", nil)
+	b.Add("wat", nil)
+	assert.NoError(t, b.Enqueue())
+
+	wp := work.NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("wat", work.JobOptions{Priority: 1, MaxFails: 1}, func(job *work.Job) error {
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/batches", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Count   int64 `json:"count"`
+		Batches []struct {
+			ID          string `json:"id"`
+			Description string `json:"description"`
+			Total       int64  `json:"total"`
+			Pending     int64  `json:"pending"`
+			Succeeded   int64  `json:"succeeded"`
+		} `json:"batches"`
+	}
+	err := json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+
+	assert.EqualValues(t, 1, res.Count)
+	if assert.Len(t, res.Batches, 1) {
+		assert.Equal(t, b.ID, res.Batches[0].ID)
+		assert.Equal(t, "wats", res.Batches[0].Description)
+		assert.EqualValues(t, 2, res.Batches[0].Total)
+		assert.EqualValues(t, 0, res.Batches[0].Pending)
+		assert.EqualValues(t, 2, res.Batches[0].Succeeded)
+	}
+}
+
 func TestWebUIAssets(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
@@ -479,7 +576,7 @@ func cleanKeyspace(namespace string, pool *redis.Pool) {
 	conn := pool.Get()
 	defer conn.Close()
 
-	keys, err := redis.Strings(conn.Do("KEYS", namespace+"*"))
+	keys, err := redis.Strings(conn.Do("KEYS", "{"+namespace+"}:*"))
 	if err != nil {
 		panic("could not get keys: " + err.Error())
 	}
@@ -489,3 +586,107 @@ func cleanKeyspace(namespace string, pool *redis.Pool) {
 		}
 	}
 }
+
+func TestWebUIFindJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	job, err := enqueuer.EnqueueIn("wat", 300, work.Q{"a": 1})
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/job/"+job.ID, nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Location string                 `json:"location"`
+		At       int64                  `json:"at"`
+		Name     string                 `json:"name"`
+		ID       string                 `json:"id"`
+		Args     map[string]interface{} `json:"args"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.Equal(t, "scheduled", res.Location)
+	assert.Equal(t, job.RunAt, res.At)
+	assert.Equal(t, "wat", res.Name)
+	assert.Equal(t, job.ID, res.ID)
+	assert.EqualValues(t, 1, res.Args["a"])
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/job/nope", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 404, recorder.Code)
+}
+
+func TestWebUIDeadJobsMatching(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", work.Q{"user_id": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", work.Q{"user_id": 2})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	wp := work.NewWorkerPool(TestContext{}, 2, ns, pool)
+	fail := func(job *work.Job) error {
+		return fmt.Errorf("ohno %s", job.Name)
+	}
+	wp.JobWithOptions("wat", work.JobOptions{Priority: 1, MaxFails: 1}, fail)
+	wp.JobWithOptions("foo", work.JobOptions{Priority: 1, MaxFails: 1}, fail)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/dead_jobs?name=wat&arg_key=user_id&arg_value=2", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Count int64 `json:"count"`
+		Jobs  []struct {
+			Name string                 `json:"name"`
+			Args map[string]interface{} `json:"args"`
+		} `json:"jobs"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+	if assert.Len(t, res.Jobs, 1) {
+		assert.EqualValues(t, 2, res.Jobs[0].Args["user_id"])
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/dead_jobs?from=nope", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/delete_dead_jobs?err=ohno+wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var bulkRes struct {
+		Count int64 `json:"count"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &bulkRes)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, bulkRes.Count)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/retry_dead_jobs", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	err = json.Unmarshal(recorder.Body.Bytes(), &bulkRes)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, bulkRes.Count)
+}
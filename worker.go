@@ -1,35 +1,87 @@
 package work
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gomodule/redigo/redis"
+	"go.opentelemetry.io/otel/trace"
 )
 
-const fetchKeysPerJobType = 6
+const fetchKeysPerJobType = 9
+
+// ErrJobTimeout is the error a job is failed with when it runs longer than its JobOptions.Timeout.
+// The job is then retried or sent to the dead queue like any other failed job.
+var ErrJobTimeout = fmt.Errorf("job timed out")
 
 type worker struct {
 	workerID      string
 	poolID        string
 	namespace     string
 	pool          *redis.Pool
-	jobTypes      map[string]*jobType
 	sleepBackoffs []int64
-	middleware    []*middlewareHandler
 	contextType   reflect.Type
 
+	// jobTypesMtx guards the fields below, which WorkerPool.AddJob and RemoveJob change while the worker runs.
+	// removedJobNames are the job types the worker handled before, so that a job of one of them it fetched just
+	// before the change is given back rather than treated as a stray job.
+	jobTypesMtx      sync.Mutex
+	jobTypes         map[string]*jobType
+	removedJobNames  map[string]bool
+	middleware       []*middlewareHandler
+	queues           []QueueSubscription
+	strictQueues     bool
+	sampler          fetchSampler
 	redisFetchScript *redis.Script
-	sampler          prioritySampler
+
+	workflowScript   *redis.Script
+	batchScript      *redis.Script
+	acquireKeyScript *redis.Script
+	releaseKeyScript *redis.Script
+	requeueScript    *redis.Script
+	recorder         JobRecorder
+	tracer           trace.Tracer
+	logger           Logger
+	hooks            *jobHooks
 	*observer
 
+	// ctx is handed to context-aware handlers and middleware. It's cancelled as soon as stop is called.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// abandoned is set by WorkerPool.StopWithTimeout when it gave up waiting for the current job and requeued it
+	// itself. The job's outcome must then not touch the in progress queue or the locks anymore.
+	abandonMtx sync.Mutex
+	abandoned  bool
+
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
 
 	drainChan        chan struct{}
 	doneDrainingChan chan struct{}
+
+	// loopDone is closed once the worker stopped, so that a drain doesn't wait for a retired worker.
+	loopDone chan struct{}
+
+	// wakeChan is set with WorkerPoolOptions.BlockingFetch. Idle workers then wait for a wakeup on it instead of polling.
+	wakeChan *wakeChannel
+	// wakeup is set with WorkerPoolOptions.BlockingFetch: the worker then publishes wakeups for the jobs it requeues
+	// or makes runnable.
+	wakeup bool
+
+	// fetcher is set with WorkerPoolOptions.Prefetch. Workers then run the jobs it fetches instead of fetching their own.
+	fetcher *fetcher
+
+	// busy is 1 while the worker processes a job, and lastBusyAt the time in unix nanoseconds it last stopped being
+	// busy (or was started). The pool's autoscaler reads them from its own goroutine.
+	busy       int32
+	lastBusyAt int64
 }
 
 func newWorker(namespace string, poolID string, pool *redis.Pool, contextType reflect.Type, middleware []*middlewareHandler, jobTypes map[string]*jobType, sleepBackoffs []int64) *worker {
@@ -50,6 +102,13 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 
 		observer: ob,
 
+		workflowScript: redis.NewScript(5, redisLuaWorkflowJobFinished),
+		batchScript:    redis.NewScript(3, redisLuaBatchJobDone),
+
+		acquireKeyScript: redis.NewScript(7, redisLuaAcquireKeyLock),
+		releaseKeyScript: redis.NewScript(4, redisLuaReleaseKeyLock),
+		requeueScript:    redis.NewScript(4, redisLuaRequeuePrefetchedJob),
+
 		stopChan:         make(chan struct{}),
 		doneStoppingChan: make(chan struct{}),
 
@@ -58,49 +117,173 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 	}
 
 	w.updateMiddlewareAndJobTypes(middleware, jobTypes)
+	w.setLogger(defaultLogger)
 
 	return w
 }
 
-// note: can't be called while the thing is started
+// setLogger makes the worker and its observer log with logger.
+func (w *worker) setLogger(logger Logger) {
+	w.logger = withFields(logger, "namespace", w.namespace, "pool_id", w.poolID, "worker_id", w.workerID)
+	w.observer.logger = w.logger
+}
+
+// updateMiddlewareAndJobTypes can be called while the worker runs. jobTypes mustn't be modified afterwards.
 func (w *worker) updateMiddlewareAndJobTypes(middleware []*middlewareHandler, jobTypes map[string]*jobType) {
+	w.jobTypesMtx.Lock()
+	defer w.jobTypesMtx.Unlock()
+
+	removed := make(map[string]bool, len(w.removedJobNames))
+	for jobName := range w.removedJobNames {
+		removed[jobName] = true
+	}
+	for jobName := range w.jobTypes {
+		removed[jobName] = true
+	}
+	for jobName := range jobTypes {
+		delete(removed, jobName)
+	}
+
 	w.middleware = middleware
-	sampler := prioritySampler{}
-	for _, jt := range jobTypes {
-		sampler.add(jt.Priority,
-			redisKeyJobs(w.namespace, jt.Name),
-			redisKeyJobsInProgress(w.namespace, w.poolID, jt.Name),
-			redisKeyJobsPaused(w.namespace, jt.Name),
-			redisKeyJobsLock(w.namespace, jt.Name),
-			redisKeyJobsLockInfo(w.namespace, jt.Name),
-			redisKeyJobsConcurrency(w.namespace, jt.Name))
-	}
-	w.sampler = sampler
 	w.jobTypes = jobTypes
-	w.redisFetchScript = redis.NewScript(len(jobTypes)*fetchKeysPerJobType, redisLuaFetchJob)
+	w.removedJobNames = removed
+	w.sampler, w.redisFetchScript = newFetchSampler(w.namespace, w.poolID, jobTypes, w.queues, w.strictQueues)
+}
+
+// subscribe makes the worker fetch the jobs of queues, see WorkerPoolOptions.Queues. It must be called before start.
+func (w *worker) subscribe(queues []QueueSubscription, strict bool) {
+	w.jobTypesMtx.Lock()
+	defer w.jobTypesMtx.Unlock()
+
+	w.queues = queues
+	w.strictQueues = strict
+	w.sampler, w.redisFetchScript = newFetchSampler(w.namespace, w.poolID, w.jobTypes, queues, strict)
+}
+
+// lookupJobType returns the job type of jobName jobs and the middleware to run them with. jt is nil if the worker
+// doesn't handle them, and removed is true if it did before.
+func (w *worker) lookupJobType(jobName string) (jt *jobType, middleware []*middlewareHandler, removed bool) {
+	w.jobTypesMtx.Lock()
+	defer w.jobTypesMtx.Unlock()
+
+	return w.jobTypes[jobName], w.middleware, w.removedJobNames[jobName]
+}
+
+// fetchSampler orders the job queues a pool fetches from. Its tiers are tried one after the other, and the queues of a
+// tier are shuffled by weight.
+type fetchSampler struct {
+	tiers   []prioritySampler
+	samples []sampleItem
+}
+
+func (s *fetchSampler) sample() []sampleItem {
+	if len(s.tiers) == 1 {
+		return s.tiers[0].sample()
+	}
+	s.samples = s.samples[:0]
+	for i := range s.tiers {
+		s.samples = append(s.samples, s.tiers[i].sample()...)
+	}
+	return s.samples
+}
+
+// newFetchSampler returns the sampler that orders the job queues of jobTypes on queues for the fetches of pool poolID,
+// and the fetch script for them. A queue's jobs are weighted by the queue's weight times their job type's priority,
+// unless strict is set: then each queue is a tier of its own, and only its job types' priorities matter. No queues
+// means DefaultQueue.
+func newFetchSampler(namespace, poolID string, jobTypes map[string]*jobType, queues []QueueSubscription, strict bool) (fetchSampler, *redis.Script) {
+	if len(queues) == 0 {
+		queues = []QueueSubscription{{Name: DefaultQueue, Weight: 1}}
+	}
+
+	sampler := fetchSampler{}
+	tier := -1
+	numSamples := 0
+	for i, q := range queues {
+		if i == 0 || strict {
+			sampler.tiers = append(sampler.tiers, prioritySampler{})
+			tier++
+		}
+		weight := q.Weight
+		if strict || weight == 0 {
+			weight = 1
+		}
+		for _, jt := range jobTypes {
+			sampler.tiers[tier].add(jt.Priority*weight,
+				redisKeyJobsQueue(namespace, jt.Name, q.Name),
+				redisKeyJobsInProgress(namespace, poolID, jt.Name),
+				redisKeyJobsPaused(namespace, jt.Name),
+				redisKeyJobsLock(namespace, jt.Name),
+				redisKeyJobsLockInfo(namespace, jt.Name),
+				redisKeyJobsConcurrency(namespace, jt.Name),
+				redisKeyJobsConcurrencyOverride(namespace, jt.Name),
+				redisKeyJobsRateLimit(namespace, jt.Name),
+				redisKeyJobsRateWindow(namespace, jt.Name))
+			numSamples++
+		}
+	}
+	return sampler, redis.NewScript(numSamples*fetchKeysPerJobType, redisLuaFetchJob)
 }
 
 func (w *worker) start() {
-	go w.loop()
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+	w.abandoned = false
+	atomic.StoreInt64(&w.lastBusyAt, time.Now().UnixNano())
+	w.loopDone = make(chan struct{})
+	if w.fetcher != nil {
+		go w.consumeLoop()
+	} else {
+		go w.loop()
+	}
 	go w.observer.start()
 }
 
 func (w *worker) stop() {
+	// Cancel first so that a running job learns about the stop while we wait for it to return.
+	w.cancel()
 	w.stopChan <- struct{}{}
 	<-w.doneStoppingChan
 	w.observer.drain()
 	w.observer.stop()
 }
 
+// retire stops the worker like stop does, but lets the current job finish with its context intact.
+func (w *worker) retire() {
+	w.stopChan <- struct{}{}
+	<-w.doneStoppingChan
+	w.cancel()
+	w.observer.drain()
+	w.observer.stop()
+}
+
+// idleSince returns the time the worker last stopped being busy, or the zero time if it's busy.
+func (w *worker) idleSince() time.Time {
+	if atomic.LoadInt32(&w.busy) == 1 {
+		return time.Time{}
+	}
+	return time.Unix(0, atomic.LoadInt64(&w.lastBusyAt))
+}
+
+// drain returns once the worker found no more jobs, or it stopped.
 func (w *worker) drain() {
-	w.drainChan <- struct{}{}
-	<-w.doneDrainingChan
+	select {
+	case w.drainChan <- struct{}{}:
+	case <-w.loopDone:
+		return
+	}
+	select {
+	case <-w.doneDrainingChan:
+	case <-w.loopDone:
+		return
+	}
 	w.observer.drain()
 }
 
 var sleepBackoffsInMilliseconds = []int64{0, 10, 100, 1000, 5000}
 
 func (w *worker) loop() {
+	defer close(w.loopDone)
+
 	var drained bool
 	var consequtiveNoJobs int64
 
@@ -116,12 +299,20 @@ func (w *worker) loop() {
 		case <-w.drainChan:
 			drained = true
 			timer.Reset(0)
+		case <-w.wakeChan.get():
+			timer.Reset(0)
 		case <-timer.C:
+			if w.ctx.Err() != nil {
+				// We're stopping: don't pick up new jobs, just wait for stopChan.
+				continue
+			}
 			job, err := w.fetchJob()
 			if err != nil {
-				logError("worker.fetch", err)
+				w.logger.Error("worker.fetch", err)
 				timer.Reset(10 * time.Millisecond)
 			} else if job != nil {
+				// There may be more jobs: let another idle worker look
+				w.wakeChan.wakeOne()
 				w.processJob(job)
 				consequtiveNoJobs = 0
 				timer.Reset(0)
@@ -130,6 +321,10 @@ func (w *worker) loop() {
 					w.doneDrainingChan <- struct{}{}
 					drained = false
 				}
+				if w.wakeChan != nil {
+					// Wait to be woken up
+					continue
+				}
 				consequtiveNoJobs++
 				idx := consequtiveNoJobs
 				if idx >= int64(len(w.sleepBackoffs)) {
@@ -142,56 +337,121 @@ func (w *worker) loop() {
 }
 
 func (w *worker) fetchJob() (*Job, error) {
+	w.jobTypesMtx.Lock()
+	jobs, err := fetchJobs(w.pool, w.redisFetchScript, &w.sampler, w.poolID, 1)
+	w.jobTypesMtx.Unlock()
+	if err != nil || len(jobs) == 0 {
+		return nil, err
+	}
+	return jobs[0], nil
+}
+
+// consumeLoop is the loop of the workers of a pool with WorkerPoolOptions.Prefetch: they run the jobs the pool's
+// fetcher buffers instead of fetching their own.
+func (w *worker) consumeLoop() {
+	defer close(w.loopDone)
+
+	for {
+		jobs := w.fetcher.jobs
+		if w.ctx.Err() != nil {
+			// We're stopping: leave the buffered jobs to the fetcher, which gives them back in order.
+			jobs = nil
+		}
+
+		select {
+		case <-w.stopChan:
+			w.doneStoppingChan <- struct{}{}
+			return
+		case <-w.drainChan:
+			// The pool drains its fetcher first, so once we're between jobs there's nothing left for us
+			w.doneDrainingChan <- struct{}{}
+		case job := <-jobs:
+			w.fetcher.taken()
+			if w.ctx.Err() != nil {
+				// We started stopping in the meantime: give the job back.
+				w.fetcher.requeue(job)
+				continue
+			}
+			w.processJob(job)
+		}
+	}
+}
+
+// fetchJobs moves up to max jobs from their queues to the in progress queues of pool poolID, with script, which runs
+// redisLuaFetchJob. The queues are tried in the order sampler picks for this call.
+func fetchJobs(pool *redis.Pool, script *redis.Script, sampler *fetchSampler, poolID string, max int) ([]*Job, error) {
 	// resort queues
 	// NOTE: we could optimize this to only resort every second, or something.
-	w.sampler.sample()
-	numKeys := len(w.sampler.samples) * fetchKeysPerJobType
-	var scriptArgs = make([]interface{}, 0, numKeys+1)
+	samples := sampler.sample()
+	numKeys := len(samples) * fetchKeysPerJobType
+	var scriptArgs = make([]interface{}, 0, numKeys+2)
 
-	for _, s := range w.sampler.samples {
-		scriptArgs = append(scriptArgs, s.redisJobs, s.redisJobsInProg, s.redisJobsPaused, s.redisJobsLock, s.redisJobsLockInfo, s.redisJobsMaxConcurrency) // KEYS[1-6 * N]
+	for _, s := range samples {
+		scriptArgs = append(scriptArgs, s.redisJobs, s.redisJobsInProg, s.redisJobsPaused, s.redisJobsLock, s.redisJobsLockInfo, s.redisJobsMaxConcurrency, s.redisJobsMaxConcOverride, s.redisJobsRateLimit, s.redisJobsRateWindow) // KEYS[1-9 * N]
 	}
-	scriptArgs = append(scriptArgs, w.poolID) // ARGV[1]
-	conn := w.pool.Get()
+	scriptArgs = append(scriptArgs, poolID) // ARGV[1]
+	scriptArgs = append(scriptArgs, max)    // ARGV[2]
+	conn := pool.Get()
 	defer conn.Close()
 
-	values, err := redis.Values(w.redisFetchScript.Do(conn, scriptArgs...))
+	values, err := redis.Values(script.Do(conn, scriptArgs...))
 	if err == redis.ErrNil {
 		return nil, nil
 	} else if err != nil {
 		return nil, err
 	}
 
-	if len(values) != 3 {
-		return nil, fmt.Errorf("need 3 elements back")
+	if len(values) == 0 || len(values)%3 != 0 {
+		return nil, fmt.Errorf("need 3 elements back per job")
 	}
 
-	rawJSON, ok := values[0].([]byte)
-	if !ok {
-		return nil, fmt.Errorf("response msg not bytes")
-	}
+	jobs := make([]*Job, 0, len(values)/3)
+	for i := 0; i < len(values); i += 3 {
+		rawJSON, ok := values[i].([]byte)
+		if !ok {
+			return jobs, fmt.Errorf("response msg not bytes")
+		}
 
-	dequeuedFrom, ok := values[1].([]byte)
-	if !ok {
-		return nil, fmt.Errorf("response queue not bytes")
-	}
+		dequeuedFrom, ok := values[i+1].([]byte)
+		if !ok {
+			return jobs, fmt.Errorf("response queue not bytes")
+		}
 
-	inProgQueue, ok := values[2].([]byte)
-	if !ok {
-		return nil, fmt.Errorf("response in prog not bytes")
-	}
+		inProgQueue, ok := values[i+2].([]byte)
+		if !ok {
+			return jobs, fmt.Errorf("response in prog not bytes")
+		}
 
-	job, err := newJob(rawJSON, dequeuedFrom, inProgQueue)
-	if err != nil {
-		return nil, err
+		job, err := newJob(rawJSON, dequeuedFrom, inProgQueue)
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
 	}
 
-	return job, nil
+	return jobs, nil
 }
 
 func (w *worker) processJob(job *Job) {
+	atomic.StoreInt32(&w.busy, 1)
+	defer func() {
+		atomic.StoreInt64(&w.lastBusyAt, time.Now().UnixNano())
+		atomic.StoreInt32(&w.busy, 0)
+	}()
+
+	jt, middleware, removed := w.lookupJobType(job.Name)
+	if jt == nil && removed {
+		// Its job type was removed after it was fetched: leave it to the pools that still handle it
+		if err := w.requeueJob(job); err != nil {
+			w.logger.Error("process_job.requeue", err, jobFields(job)...)
+		}
+		return
+	}
+	fetched := job
+	var uniqueKey string
 	if job.Unique {
-		updatedJob := w.getAndDeleteUniqueJob(job)
+		var updatedJob *Job
+		updatedJob, uniqueKey = w.getUniqueJob(job)
 		// This is to support the old way of doing it, where we used the job off the queue and just deleted the unique key
 		// Going forward the job on the queue will always be just a placeholder, and we will be replacing it with the
 		// updated job extracted here
@@ -199,27 +459,128 @@ func (w *worker) processJob(job *Job) {
 			job = updatedJob
 		}
 	}
+	if jt != nil && len(jt.ConcurrencyKeys) > 0 && !w.acquireConcurrencyKey(fetched, job, jt) {
+		// Parked until a job with the same concurrency key is done. The unique key is kept, so that the job runs with
+		// the args it's enqueued with meanwhile.
+		return
+	}
+	if uniqueKey != "" {
+		w.deleteUniqueJob(job, uniqueKey)
+	}
 	var runErr error
-	jt := w.jobTypes[job.Name]
 	if jt == nil {
 		runErr = fmt.Errorf("stray job: no handler")
-		logError("process_job.stray", runErr)
+		w.logger.Error("process_job.stray", runErr, jobFields(job)...)
 	} else {
 		w.observeStarted(job.Name, job.ID, job.Args)
 		job.observer = w.observer // for Checkin
-		_, runErr = runJob(job, w.contextType, w.middleware, jt)
+		w.hooks.started(w.logger, job)
+		startedAt := time.Now()
+		runErr = w.runJob(job, jt, middleware)
 		w.observeDone(job.Name, job.ID, runErr)
+		if w.recorder != nil {
+			w.recorder.RecordJob(job.Name, time.Since(startedAt), runErr)
+		}
 	}
 
-	fate := terminateOnly
+	terminate, outcome, fate := terminateOnly, jobSucceeded, JobFate{}
 	if runErr != nil {
 		job.failed(runErr)
-		fate = w.jobFate(jt, job)
+		terminate, outcome, fate = w.jobFate(jt, job, runErr)
+	}
+
+	if !w.finishJob(job, terminate, outcome) {
+		return
+	}
+	w.hooks.finished(w.logger, job, runErr, fate)
+}
+
+// requeueJob gives back a job the worker fetched but won't run.
+func (w *worker) requeueJob(job *Job) error {
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	if err := requeueFetchedJob(conn, w.requeueScript, w.namespace, w.poolID, job); err != nil {
+		return err
+	}
+	return publishWakeup(conn, w.wakeup, w.namespace, job.Name)
+}
+
+// finishJob removes job from the in progress queue and applies its fate, unless the worker abandoned it. It reports
+// whether it did.
+func (w *worker) finishJob(job *Job, fate terminateOp, outcome jobOutcome) bool {
+	w.abandonMtx.Lock()
+	defer w.abandonMtx.Unlock()
+	if w.abandoned {
+		return false
+	}
+	return w.removeJobFromInProgress(job, fate, outcome) == nil
+}
+
+// abandon makes the worker forget about the job it's currently running, see WorkerPool.StopWithTimeout.
+func (w *worker) abandon() {
+	w.abandonMtx.Lock()
+	w.abandoned = true
+	w.abandonMtx.Unlock()
+}
+
+// runJob runs the job, enforcing jt.Timeout if it is set. A job that overruns gets its context cancelled and
+// is abandoned: the worker moves on even if the handler doesn't honour the cancellation.
+func (w *worker) runJob(job *Job, jt *jobType, middleware []*middlewareHandler) (err error) {
+	ctx := w.ctx
+	if w.tracer != nil {
+		var span trace.Span
+		ctx, span = w.startJobSpan(ctx, job)
+		defer func() {
+			endJobSpan(span, err)
+		}()
+	}
+
+	if jt.Timeout <= 0 {
+		_, err := runJob(ctx, job, w.contextType, middleware, jt, w.logger)
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, jt.Timeout)
+	defer cancel()
+
+	// The handler may outlive the timeout, so it gets its own copy of the job. That way it can't race with the
+	// retry/dead bookkeeping that happens once we give up on it.
+	handlerJob := *job
+	handlerJob.Args = make(map[string]interface{}, len(job.Args))
+	for k, v := range job.Args {
+		handlerJob.Args[k] = v
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := runJob(ctx, &handlerJob, w.contextType, middleware, jt, w.logger)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		// The handler returned, so its copy of the job is ours again
+		job.result = handlerJob.result
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			// The handler gave up because it ran out of time.
+			return fmt.Errorf("%w after %v", ErrJobTimeout, jt.Timeout)
+		}
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return fmt.Errorf("%w after %v", ErrJobTimeout, jt.Timeout)
+		}
+		// The pool is stopping, which waits for running jobs to return.
+		err := <-done
+		job.result = handlerJob.result
+		return err
 	}
-	w.removeJobFromInProgress(job, fate)
 }
 
-func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
+// getUniqueJob returns the unique job with the args it was last enqueued with, or nil if they're the fetched job's,
+// and its unique key, or "" if there's none. The key is deleted with deleteUniqueJob once the job runs.
+func (w *worker) getUniqueJob(job *Job) (*Job, string) {
 	var uniqueKey string
 	var err error
 
@@ -228,8 +589,8 @@ func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
 	} else { // For jobs put in queue prior to this change. In the future this can be deleted as there will always be a UniqueKey
 		uniqueKey, err = redisKeyUniqueJob(w.namespace, job.Name, job.Args)
 		if err != nil {
-			logError("worker.delete_unique_job.key", err)
-			return nil
+			w.logger.Error("worker.delete_unique_job.key", err, jobFields(job)...)
+			return nil, ""
 		}
 	}
 
@@ -238,33 +599,37 @@ func (w *worker) getAndDeleteUniqueJob(job *Job) *Job {
 
 	rawJSON, err := redis.Bytes(conn.Do("GET", uniqueKey))
 	if err != nil {
-		logError("worker.delete_unique_job.get", err)
-		return nil
-	}
-
-	_, err = conn.Do("DEL", uniqueKey)
-	if err != nil {
-		logError("worker.delete_unique_job.del", err)
-		return nil
+		w.logger.Error("worker.delete_unique_job.get", err, jobFields(job)...)
+		return nil, ""
 	}
 
 	// Previous versions did not support updated arguments and just set key to 1, so in these cases we should do nothing.
 	// In the future this can be deleted, as we will always be getting arguments from here
 	if string(rawJSON) == "1" {
-		return nil
+		return nil, uniqueKey
 	}
 
 	// The job pulled off the queue was just a placeholder with no args, so replace it
 	jobWithArgs, err := newJob(rawJSON, job.dequeuedFrom, job.inProgQueue)
 	if err != nil {
-		logError("worker.delete_unique_job.updated_job", err)
-		return nil
+		w.logger.Error("worker.delete_unique_job.updated_job", err, jobFields(job)...)
+		return nil, uniqueKey
 	}
 
-	return jobWithArgs
+	return jobWithArgs, uniqueKey
+}
+
+// deleteUniqueJob deletes the unique key of a job that's about to run, so that it can be enqueued again.
+func (w *worker) deleteUniqueJob(job *Job, uniqueKey string) {
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("DEL", uniqueKey); err != nil {
+		w.logger.Error("worker.delete_unique_job.del", err, jobFields(job)...)
+	}
 }
 
-func (w *worker) removeJobFromInProgress(job *Job, fate terminateOp) {
+func (w *worker) removeJobFromInProgress(job *Job, fate terminateOp, outcome jobOutcome) error {
 	conn := w.pool.Get()
 	defer conn.Close()
 
@@ -273,29 +638,63 @@ func (w *worker) removeJobFromInProgress(job *Job, fate terminateOp) {
 	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
 	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
 	fate(conn)
-	if _, err := conn.Do("EXEC"); err != nil {
-		logError("worker.remove_job_from_in_progress.lrem", err)
+	if job.concurrencyKey != "" {
+		w.releaseConcurrencyKey(conn, job)
+	}
+	if job.WorkflowID != "" {
+		// Release or cancel the jobs depending on this one. A retry doesn't change anything for them.
+		switch outcome {
+		case jobSucceeded:
+			w.finishWorkflowJob(conn, job, WorkflowJobDone)
+		case jobDied:
+			w.finishWorkflowJob(conn, job, WorkflowJobDead)
+		}
 	}
+	if job.BatchID != "" {
+		w.countBatchJob(conn, job, outcome)
+	}
+	jt, _, _ := w.lookupJobType(job.Name)
+	if jt != nil && jt.ResultTTL > 0 && outcome != jobRetried {
+		w.storeJobResult(conn, job, outcome, jt.ResultTTL)
+	}
+	if w.wakeup && (job.concurrencyKey != "" || job.WorkflowID != "" || job.BatchID != "" || (jt != nil && jt.MaxConcurrency > 0)) {
+		// Jobs may have been queued, or be allowed to run now
+		conn.Send("PUBLISH", redisKeyWakeup(w.namespace), "")
+	}
+	_, err := conn.Do("EXEC")
+	if err != nil {
+		w.logger.Error("worker.remove_job_from_in_progress.lrem", err, jobFields(job)...)
+	}
+	return err
 }
 
 type terminateOp func(conn redis.Conn)
 
+// jobOutcome is what became of a job once it ran.
+type jobOutcome int
+
+const (
+	jobSucceeded jobOutcome = iota
+	jobRetried
+	jobDied // put on the dead queue, or dropped because of SkipDead
+)
+
 func terminateOnly(_ redis.Conn) { return }
-func terminateAndRetry(w *worker, jt *jobType, job *Job) terminateOp {
+func terminateAndRetry(w *worker, job *Job, retryAt int64) (terminateOp, error) {
 	rawJSON, err := job.serialize()
 	if err != nil {
-		logError("worker.terminate_and_retry.serialize", err)
-		return terminateOnly
+		w.logger.Error("worker.terminate_and_retry.serialize", err, jobFields(job)...)
+		return terminateOnly, err
 	}
 	return func(conn redis.Conn) {
-		conn.Send("ZADD", redisKeyRetry(w.namespace), nowEpochSeconds()+jt.calcBackoff(job), rawJSON)
-	}
+		conn.Send("ZADD", redisKeyRetry(w.namespace), retryAt, rawJSON)
+	}, nil
 }
-func terminateAndDead(w *worker, job *Job) terminateOp {
+func terminateAndDead(w *worker, job *Job) (terminateOp, error) {
 	rawJSON, err := job.serialize()
 	if err != nil {
-		logError("worker.terminate_and_dead.serialize", err)
-		return terminateOnly
+		w.logger.Error("worker.terminate_and_dead.serialize", err, jobFields(job)...)
+		return terminateOnly, err
 	}
 	return func(conn redis.Conn) {
 		// NOTE: sidekiq limits the # of jobs: only keep jobs for 6 months, and only keep a max # of jobs
@@ -304,20 +703,29 @@ func terminateAndDead(w *worker, job *Job) terminateOp {
 		// conn.Send("ZREMRANGEBYRANK", redisKeyDead(w.namespace), 0, -maxJobs)
 
 		conn.Send("ZADD", redisKeyDead(w.namespace), nowEpochSeconds(), rawJSON)
-	}
+	}, nil
 }
 
-func (w *worker) jobFate(jt *jobType, job *Job) terminateOp {
+// jobFate decides what becomes of a job that failed with runErr, and what the hooks are told about it. A job that
+// can't be serialized can't be put in the retry or dead set, so it's dropped.
+func (w *worker) jobFate(jt *jobType, job *Job, runErr error) (terminateOp, jobOutcome, JobFate) {
 	if jt != nil {
 		failsRemaining := int64(jt.MaxFails) - job.Fails
-		if failsRemaining > 0 {
-			return terminateAndRetry(w, jt, job)
+		if failsRemaining > 0 && jt.shouldRetry(runErr) {
+			retryAtEpoch := nowEpochSeconds() + jt.calcBackoff(job)
+			if terminate, err := terminateAndRetry(w, job, retryAtEpoch); err == nil {
+				return terminate, jobRetried, JobFate{Retry: true, RetryAt: time.Unix(retryAtEpoch, 0)}
+			}
+			return terminateOnly, jobDied, JobFate{Dropped: true}
 		}
 		if jt.SkipDead {
-			return terminateOnly
+			return terminateOnly, jobDied, JobFate{Dropped: true}
 		}
 	}
-	return terminateAndDead(w, job)
+	if terminate, err := terminateAndDead(w, job); err == nil {
+		return terminate, jobDied, JobFate{Dead: true}
+	}
+	return terminateOnly, jobDied, JobFate{Dropped: true}
 }
 
 // Default algorithm returns an fastly increasing backoff counter which grows in an unbounded fashion
@@ -1,13 +1,16 @@
 package work
 
 import (
+	"context"
 	"reflect"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // WorkerPool represents a pool of workers. It forms the primary API of gocraft/work. WorkerPools provide the public API of gocraft/work. You can attach jobs and middlware to them. You can start and stop them. Based on their concurrency setting, they'll spin up N worker goroutines.
@@ -17,31 +20,61 @@ type WorkerPool struct {
 	namespace     string // eg, "myapp-work"
 	pool          *redis.Pool
 	sleepBackoffs []int64
+	opts          WorkerPoolOptions
+	queues        []QueueSubscription
 
 	contextType  reflect.Type
-	jobTypes     map[string]*jobType
-	middleware   []*middlewareHandler
 	started      bool
 	periodicJobs []*periodicJob
+	recorder     JobRecorder
+	tracer       trace.Tracer
+	logger       Logger
+	hooks        jobHooks
+
+	// mtx guards workers, retiring and concurrency, which SetConcurrency changes while the pool is started, the job
+	// types and middleware, which AddJob, RemoveJob and Middleware change while the pool is started, and started.
+	// jobTypes and middleware are replaced rather than modified, since the workers share them.
+	mtx             sync.Mutex
+	jobTypes        map[string]*jobType
+	removedJobNames map[string]bool
+	middleware      []*middlewareHandler
+	workers         []*worker
+	retiring        map[*worker]bool
+	retiringWG      sync.WaitGroup
+	workerLogger    Logger
 
-	workers          []*worker
 	heartbeater      *workerPoolHeartbeater
 	retrier          *requeuer
 	scheduler        *requeuer
 	deadPoolReaper   *deadPoolReaper
+	deadJobPruner    *deadJobPruner
 	periodicEnqueuer *periodicEnqueuer
+	wakeupListener   *wakeupListener
+	wakeChan         *wakeChannel
+	fetcher          *fetcher
+	autoscaler       *autoscaler
 }
 
 type jobType struct {
 	Name string
 	JobOptions
 
-	IsGeneric      bool
-	GenericHandler GenericHandler
-	DynamicHandler reflect.Value
+	IsGeneric             bool
+	GenericHandler        GenericHandler
+	GenericContextHandler GenericContextHandler
+	DynamicHandler        reflect.Value
+	DynamicTakesContext   bool
 }
 
 func (jt *jobType) calcBackoff(j *Job) int64 {
+	if jt.RetryPolicy.Delay != nil {
+		// Retries are scheduled to the second: round up, so that a sub-second delay doesn't become no delay at all
+		delay := jt.RetryPolicy.Delay(j)
+		if delay <= 0 {
+			return 0
+		}
+		return int64((delay + time.Second - 1) / time.Second)
+	}
 	if jt.Backoff == nil {
 		return defaultBackoffCalculator(j)
 	}
@@ -61,26 +94,104 @@ type JobOptions struct {
 	SkipDead       bool              // If true, don't send failed jobs to the dead queue when retries are exhausted.
 	MaxConcurrency uint              // Max number of jobs to keep in flight (default is 0, meaning no max)
 	Backoff        BackoffCalculator // If not set, uses the default backoff algorithm
+	RetryPolicy    RetryPolicy       // Which failed jobs are retried, and when (default retries all errors but permanent ones, with Backoff). Its Delay takes precedence over Backoff.
+	Timeout        time.Duration     // Max time a single run may take (default is 0, meaning no max). Overrunning jobs fail with ErrJobTimeout. Handlers must return once their ctx is done: one that doesn't keeps running while its retry runs, and Stop doesn't wait for it.
+	RateLimit      RateLimit         // Max number of jobs to start per period across all worker pools (default is no limit). Can be overridden with Client.SetRateLimit.
+	ResultTTL      time.Duration     // How long to keep the result of a job once it succeeded or died, at least a millisecond, see Client.JobResult (default is 0, meaning results aren't kept)
+
+	// ConcurrencyKeys names the args whose values form a job's concurrency key. Jobs with the same concurrency key run
+	// at most MaxConcurrencyPerKey (default 1) at a time across all worker pools, while jobs with other keys proceed.
+	ConcurrencyKeys      []string
+	MaxConcurrencyPerKey uint
+}
+
+// RateLimit allows at most Count jobs to start per Period. The zero value means no limit.
+type RateLimit struct {
+	Count  uint
+	Period time.Duration
 }
 
 // WorkerPoolOptions can be passed to NewWorkerPoolWithOptions.
 type WorkerPoolOptions struct {
 	SleepBackoffs []int64 // Sleep backoffs in milliseconds
+
+	// Dead jobs that died more than DeadJobMaxAge ago, then the oldest ones beyond DeadJobMaxCount, are pruned from
+	// the dead queue about every minute by one of the worker pools (default is 0 for both, meaning dead jobs are kept
+	// until they're deleted or retried). If DeadJobArchiver is set, pruned jobs are passed to it before they're deleted.
+	DeadJobMaxAge   time.Duration
+	DeadJobMaxCount uint
+	DeadJobArchiver DeadJobArchiver
+
+	// If BlockingFetch is set, idle workers wait to be woken up when jobs they handle are queued, instead of polling
+	// Redis as per SleepBackoffs. Only enqueuers with Enqueuer.SetWakeup, and worker pools with BlockingFetch,
+	// announce their jobs. So that jobs nobody announces aren't missed (eg, once a pause expires or a rate limit window
+	// passes), one idle worker also looks for jobs every BlockingFetchPoll (default 5s).
+	BlockingFetch     bool
+	BlockingFetchPoll time.Duration
+
+	// If Prefetch is set, a single fetcher per pool fetches the jobs for all of its workers, up to Prefetch jobs per
+	// round trip to Redis, and buffers up to Prefetch jobs that no worker took yet. Buffered jobs count towards
+	// MaxConcurrency and are requeued by the reaper if the process dies, like running ones. The default of 0 means
+	// every worker fetches its own jobs, one at a time.
+	Prefetch uint
+
+	// If AutoscaleMax is set, the pool resizes itself between AutoscaleMin and AutoscaleMax workers, as per
+	// SetConcurrency. About every second, it adds workers if they're all busy while the oldest job of one of its queues
+	// has waited AutoscaleLatency (default 1s), and retires the workers that have been idle for AutoscaleIdle (default
+	// 1m). The concurrency passed to NewWorkerPoolWithOptions is the initial number of workers.
+	AutoscaleMin     uint
+	AutoscaleMax     uint
+	AutoscaleLatency time.Duration
+	AutoscaleIdle    time.Duration
+
+	// Queues are the queues the pool consumes, for all of its job types (default is DefaultQueue only). The jobs of a
+	// queue are fetched in proportion to its Weight times their job type's Priority. If StrictQueueOrder is set, the
+	// weights are ignored: the pool only fetches from a queue once the ones before it have no job it can run.
+	Queues           []QueueSubscription
+	StrictQueueOrder bool
+}
+
+// DefaultQueue is the queue jobs are put on unless they're routed to a named queue with Enqueuer.RouteJob. Its jobs are
+// on the same Redis lists as before named queues existed.
+const DefaultQueue = "default"
+
+// QueueSubscription is a queue a worker pool consumes, see WorkerPoolOptions.Queues.
+type QueueSubscription struct {
+	Name   string
+	Weight uint // Weight of the queue against the pool's other queues (default 1)
+}
+
+// normalizeQueue returns queue as it's stored in Job.Queue: empty for DefaultQueue.
+func normalizeQueue(queue string) string {
+	if queue == DefaultQueue {
+		return ""
+	}
+	return queue
 }
 
 // GenericHandler is a job handler without any custom context.
 type GenericHandler func(*Job) error
 
+// GenericContextHandler is a job handler without any custom context that receives a context.Context.
+// The context is cancelled when the worker pool is stopped.
+type GenericContextHandler func(context.Context, *Job) error
+
 // GenericMiddlewareHandler is a middleware without any custom context.
 type GenericMiddlewareHandler func(*Job, NextMiddlewareFunc) error
 
+// GenericContextMiddlewareHandler is a middleware without any custom context that receives a context.Context.
+// The context is cancelled when the worker pool is stopped.
+type GenericContextMiddlewareHandler func(context.Context, *Job, NextMiddlewareFunc) error
+
 // NextMiddlewareFunc is a function type (whose instances are named 'next') that you call to advance to the next middleware.
 type NextMiddlewareFunc func() error
 
 type middlewareHandler struct {
-	IsGeneric                bool
-	DynamicMiddleware        reflect.Value
-	GenericMiddlewareHandler GenericMiddlewareHandler
+	IsGeneric                       bool
+	DynamicMiddleware               reflect.Value
+	DynamicTakesContext             bool
+	GenericMiddlewareHandler        GenericMiddlewareHandler
+	GenericContextMiddlewareHandler GenericContextMiddlewareHandler
 }
 
 // NewWorkerPool creates a new worker pool. ctx should be a struct literal whose type will be used for middleware and handlers.
@@ -95,30 +206,161 @@ func NewWorkerPoolWithOptions(ctx interface{}, concurrency uint, namespace strin
 	if pool == nil {
 		panic("NewWorkerPool needs a non-nil *redis.Pool")
 	}
+	if workerPoolOpts.AutoscaleMax > 0 {
+		if workerPoolOpts.AutoscaleMin > workerPoolOpts.AutoscaleMax {
+			panic("NewWorkerPool needs AutoscaleMin to be at most AutoscaleMax")
+		}
+		if concurrency < workerPoolOpts.AutoscaleMin {
+			concurrency = workerPoolOpts.AutoscaleMin
+		} else if concurrency > workerPoolOpts.AutoscaleMax {
+			concurrency = workerPoolOpts.AutoscaleMax
+		}
+	}
+
+	queues := []QueueSubscription{{Name: DefaultQueue, Weight: 1}}
+	if len(workerPoolOpts.Queues) > 0 {
+		queues = make([]QueueSubscription, 0, len(workerPoolOpts.Queues))
+		seen := map[string]bool{}
+		for _, q := range workerPoolOpts.Queues {
+			if q.Name == "" || seen[q.Name] {
+				panic("NewWorkerPool needs distinct, non-empty queue names")
+			}
+			seen[q.Name] = true
+			if q.Weight == 0 {
+				q.Weight = 1
+			}
+			queues = append(queues, q)
+		}
+	}
 
 	ctxType := reflect.TypeOf(ctx)
 	validateContextType(ctxType)
 	wp := &WorkerPool{
-		workerPoolID:  makeIdentifier(),
-		concurrency:   concurrency,
-		namespace:     namespace,
-		pool:          pool,
-		sleepBackoffs: workerPoolOpts.SleepBackoffs,
-		contextType:   ctxType,
-		jobTypes:      make(map[string]*jobType),
+		workerPoolID:    makeIdentifier(),
+		concurrency:     concurrency,
+		namespace:       namespace,
+		pool:            pool,
+		sleepBackoffs:   workerPoolOpts.SleepBackoffs,
+		opts:            workerPoolOpts,
+		queues:          queues,
+		contextType:     ctxType,
+		jobTypes:        make(map[string]*jobType),
+		removedJobNames: make(map[string]bool),
+		retiring:        make(map[*worker]bool),
+		workerLogger:    defaultLogger,
+	}
+	wp.logger = withFields(defaultLogger, "namespace", wp.namespace, "pool_id", wp.workerPoolID)
+	if workerPoolOpts.BlockingFetch {
+		wp.wakeChan = newWakeChannel(concurrency)
+	}
+	if workerPoolOpts.Prefetch > 0 {
+		wp.fetcher = newFetcher(wp.namespace, wp.workerPoolID, wp.pool, wp.jobTypes, workerPoolOpts.Prefetch, wp.sleepBackoffs)
+		wp.fetcher.wakeChan = wp.wakeChan
+		wp.fetcher.subscribe(wp.queues, workerPoolOpts.StrictQueueOrder)
 	}
 
 	for i := uint(0); i < wp.concurrency; i++ {
-		w := newWorker(wp.namespace, wp.workerPoolID, wp.pool, wp.contextType, nil, wp.jobTypes, wp.sleepBackoffs)
-		wp.workers = append(wp.workers, w)
+		wp.workers = append(wp.workers, wp.newWorker())
 	}
 
 	return wp
 }
 
+// newWorker returns a worker set up like the pool's others.
+func (wp *WorkerPool) newWorker() *worker {
+	w := newWorker(wp.namespace, wp.workerPoolID, wp.pool, wp.contextType, wp.middleware, wp.jobTypes, wp.sleepBackoffs)
+	w.hooks = &wp.hooks
+	w.recorder = wp.recorder
+	w.tracer = wp.tracer
+	w.setLogger(wp.workerLogger)
+	w.subscribe(wp.queues, wp.opts.StrictQueueOrder)
+	w.wakeup = wp.opts.BlockingFetch
+	if wp.fetcher != nil {
+		w.fetcher = wp.fetcher
+	} else {
+		w.wakeChan = wp.wakeChan
+	}
+	for jobName := range wp.removedJobNames {
+		// Jobs of removed types may still be buffered by the fetcher
+		w.removedJobNames[jobName] = true
+	}
+	return w
+}
+
+// Concurrency returns the number of workers of the pool.
+func (wp *WorkerPool) Concurrency() uint {
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
+	return wp.concurrency
+}
+
+// SetConcurrency changes the number of workers of the pool to n. It can be called while the pool is started: the
+// added workers start fetching jobs right away, and the heartbeat shows the new concurrency at once. The idlest workers
+// are retired first. A retired worker that is running a job stops once the job returns, in the background; Stop and
+// StopWithTimeout still wait for it. With WorkerPoolOptions.AutoscaleMax, the autoscaler goes on resizing the pool
+// from n.
+func (wp *WorkerPool) SetConcurrency(n uint) {
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
+	wp.resize(n)
+}
+
+// resize sets the number of workers to n. The caller holds mtx.
+func (wp *WorkerPool) resize(n uint) {
+	for uint(len(wp.workers)) < n {
+		w := wp.newWorker()
+		if wp.started {
+			w.start()
+		}
+		wp.workers = append(wp.workers, w)
+	}
+
+	if uint(len(wp.workers)) > n {
+		// Busy workers last, then the ones that have been idle the shortest
+		workers := append([]*worker(nil), wp.workers...)
+		sort.SliceStable(workers, func(i, j int) bool {
+			si, sj := workers[i].idleSince(), workers[j].idleSince()
+			if si.IsZero() || sj.IsZero() {
+				return !si.IsZero() && sj.IsZero()
+			}
+			return si.Before(sj)
+		})
+		retired := workers[:uint(len(workers))-n]
+		wp.workers = workers[uint(len(workers))-n:]
+
+		if wp.started {
+			for _, w := range retired {
+				wp.retiring[w] = true
+				wp.retiringWG.Add(1)
+				go func(w *worker) {
+					w.retire()
+					wp.mtx.Lock()
+					delete(wp.retiring, w)
+					wp.mtx.Unlock()
+					wp.retiringWG.Done()
+				}(w)
+			}
+		}
+	}
+
+	wp.concurrency = n
+	if wp.wakeChan != nil {
+		wp.wakeChan.resize(n)
+	}
+	if wp.started {
+		wp.heartbeater.update(n, wp.workerIDs())
+	}
+}
+
 // Middleware appends the specified function to the middleware chain. The fn can take one of these forms:
 // (*ContextType).func(*Job, NextMiddlewareFunc) error, (ContextType matches the type of ctx specified when creating a pool)
+// (*ContextType).func(context.Context, *Job, NextMiddlewareFunc) error,
 // func(*Job, NextMiddlewareFunc) error, for the generic middleware format.
+// func(context.Context, *Job, NextMiddlewareFunc) error, for the generic middleware format with a context.Context.
+// The context.Context is cancelled when the pool is stopped.
+// It can be called while the pool is started: jobs that start afterwards run through fn.
 func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 	vfn := reflect.ValueOf(fn)
 	validateMiddlewareType(wp.contextType, vfn)
@@ -127,13 +369,39 @@ func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 		DynamicMiddleware: vfn,
 	}
 
-	if gmh, ok := fn.(func(*Job, NextMiddlewareFunc) error); ok {
+	switch gmh := fn.(type) {
+	case func(*Job, NextMiddlewareFunc) error:
+		mw.IsGeneric = true
+		mw.GenericMiddlewareHandler = gmh
+	case GenericMiddlewareHandler:
 		mw.IsGeneric = true
 		mw.GenericMiddlewareHandler = gmh
+	case func(context.Context, *Job, NextMiddlewareFunc) error:
+		mw.IsGeneric = true
+		mw.GenericContextMiddlewareHandler = gmh
+	case GenericContextMiddlewareHandler:
+		mw.IsGeneric = true
+		mw.GenericContextMiddlewareHandler = gmh
+	default:
+		// A func type of its own, or a function taking the pool's context type
+		switch fnType := vfn.Type(); {
+		case fnType.ConvertibleTo(genericMiddlewareHandlerType):
+			mw.IsGeneric = true
+			mw.GenericMiddlewareHandler = vfn.Convert(genericMiddlewareHandlerType).Interface().(GenericMiddlewareHandler)
+		case fnType.ConvertibleTo(genericContextMiddlewareHandlerType):
+			mw.IsGeneric = true
+			mw.GenericContextMiddlewareHandler = vfn.Convert(genericContextMiddlewareHandlerType).Interface().(GenericContextMiddlewareHandler)
+		case fnType.In(0) == reflect.PtrTo(wp.contextType):
+			mw.DynamicTakesContext = fnType.NumIn() == 4 && fnType.In(1) == contextInterfaceType
+		default:
+			panic(instructiveMessage(vfn, "middleware", "middleware", "job *work.Job, next NextMiddlewareFunc", wp.contextType))
+		}
 	}
 
-	wp.middleware = append(wp.middleware, mw)
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
 
+	wp.middleware = append(append([]*middlewareHandler(nil), wp.middleware...), mw)
 	for _, w := range wp.workers {
 		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
 	}
@@ -141,16 +409,72 @@ func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 	return wp
 }
 
+// JobRecorder is told about every job the workers of a pool ran, see WorkerPool.RecordJobs. The metrics package has
+// one that exports them to Prometheus.
+type JobRecorder interface {
+	// RecordJob is called once a job ran for duration. err is the error it returned, if any. It's called from the
+	// workers' goroutines, so it must be safe for concurrent use and shouldn't block.
+	RecordJob(jobName string, duration time.Duration, err error)
+}
+
+// RecordJobs makes the pool tell r about every job it runs. It must be called before Start.
+func (wp *WorkerPool) RecordJobs(r JobRecorder) *WorkerPool {
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
+	wp.recorder = r
+	for _, w := range wp.workers {
+		w.recorder = r
+	}
+
+	return wp
+}
+
+// TraceJobs makes the pool start a span with tp for every run of a job. Its context is the one handed to context-aware
+// handlers and middleware. Jobs enqueued with Enqueuer.EnqueueContext are traced as part of the trace they were
+// enqueued in, retries included. It must be called before Start.
+func (wp *WorkerPool) TraceJobs(tp trace.TracerProvider) *WorkerPool {
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
+	wp.tracer = tp.Tracer(tracerName)
+	for _, w := range wp.workers {
+		w.tracer = wp.tracer
+	}
+
+	return wp
+}
+
+// SetLogger makes the pool, its workers and its background processes log their errors with logger, instead of
+// slog.Default(). The errors carry the namespace and pool ID, and the worker ID, job name and job ID where they're
+// known. It must be called before Start.
+func (wp *WorkerPool) SetLogger(logger Logger) *WorkerPool {
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
+	wp.logger = withFields(logger, "namespace", wp.namespace, "pool_id", wp.workerPoolID)
+	wp.workerLogger = logger
+	for _, w := range wp.workers {
+		w.setLogger(logger)
+	}
+
+	return wp
+}
+
 // Job registers the job name to the specified handler fn. For instance, when workers pull jobs from the name queue they'll be processed by the specified handler function.
 // fn can take one of these forms:
 // (*ContextType).func(*Job) error, (ContextType matches the type of ctx specified when creating a pool)
+// (*ContextType).func(context.Context, *Job) error,
 // func(*Job) error, for the generic handler format.
+// func(context.Context, *Job) error, for the generic handler format with a context.Context.
+// The context.Context is cancelled when the pool is stopped, so long running jobs can abort cleanly.
 func (wp *WorkerPool) Job(name string, fn interface{}) *WorkerPool {
 	return wp.JobWithOptions(name, JobOptions{}, fn)
 }
 
 // JobWithOptions adds a handler for 'name' jobs as per the Job function, but permits you specify additional options
 // such as a job's priority, retry count, and whether to send dead jobs to the dead job queue or trash them.
+// Like Job, it can be called while the pool is started, see AddJob.
 func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interface{}) *WorkerPool {
 	jobOpts = applyDefaultsAndValidate(jobOpts)
 
@@ -161,20 +485,117 @@ func (wp *WorkerPool) JobWithOptions(name string, jobOpts JobOptions, fn interfa
 		DynamicHandler: vfn,
 		JobOptions:     jobOpts,
 	}
-	if gh, ok := fn.(func(*Job) error); ok {
+	switch gh := fn.(type) {
+	case func(*Job) error:
 		jt.IsGeneric = true
 		jt.GenericHandler = gh
+	case GenericHandler:
+		jt.IsGeneric = true
+		jt.GenericHandler = gh
+	case func(context.Context, *Job) error:
+		jt.IsGeneric = true
+		jt.GenericContextHandler = gh
+	case GenericContextHandler:
+		jt.IsGeneric = true
+		jt.GenericContextHandler = gh
+	default:
+		// A func type of its own, or a function taking the pool's context type
+		switch fnType := vfn.Type(); {
+		case fnType.ConvertibleTo(genericHandlerType):
+			jt.IsGeneric = true
+			jt.GenericHandler = vfn.Convert(genericHandlerType).Interface().(GenericHandler)
+		case fnType.ConvertibleTo(genericContextHandlerType):
+			jt.IsGeneric = true
+			jt.GenericContextHandler = vfn.Convert(genericContextHandlerType).Interface().(GenericContextHandler)
+		case fnType.In(0) == reflect.PtrTo(wp.contextType):
+			jt.DynamicTakesContext = fnType.NumIn() == 3 && fnType.In(1) == contextInterfaceType
+		default:
+			panic(instructiveMessage(vfn, "a handler", "handler", "job *work.Job", wp.contextType))
+		}
 	}
 
-	wp.jobTypes[name] = jt
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
 
-	for _, w := range wp.workers {
-		w.updateMiddlewareAndJobTypes(wp.middleware, wp.jobTypes)
+	jobTypes := make(map[string]*jobType, len(wp.jobTypes)+1)
+	for jobName, jt := range wp.jobTypes {
+		jobTypes[jobName] = jt
+	}
+	jobTypes[name] = jt
+	wp.setJobTypes(jobTypes)
+
+	if wp.started {
+		added := map[string]*jobType{name: jt}
+		wp.writeConcurrencyControlsToRedis(added)
+		wp.writeKnownJobsToRedis(added)
+	}
+
+	return wp
+}
+
+// AddJob adds a handler for 'name' jobs as per JobWithOptions, or replaces the existing one. It's meant for job types
+// that are only known once the pool is started, like those of plugins or behind feature flags: the workers fetch its
+// jobs right away, and its queue is served by the pool's retrier, scheduler and heartbeat like the others'.
+func (wp *WorkerPool) AddJob(name string, jobOpts JobOptions, fn interface{}) *WorkerPool {
+	return wp.JobWithOptions(name, jobOpts, fn)
+}
+
+// RemoveJob removes the handler for 'name' jobs. It can be called while the pool is started: the workers stop fetching
+// its jobs, and give back those they fetched but didn't start, so that they're left to the pools that still handle
+// them. The jobs that are running finish normally, and its due scheduled and retry jobs are still put back on its
+// queue. The job name stays in the namespace's known jobs.
+func (wp *WorkerPool) RemoveJob(name string) *WorkerPool {
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
+	if _, ok := wp.jobTypes[name]; !ok {
+		return wp
+	}
+
+	jobTypes := make(map[string]*jobType, len(wp.jobTypes))
+	for jobName, jt := range wp.jobTypes {
+		if jobName != name {
+			jobTypes[jobName] = jt
+		}
 	}
+	wp.setJobTypes(jobTypes)
 
 	return wp
 }
 
+// setJobTypes replaces the job types of the pool with jobTypes, and updates its workers and background processes. The
+// caller holds mtx.
+func (wp *WorkerPool) setJobTypes(jobTypes map[string]*jobType) {
+	for jobName := range wp.jobTypes {
+		if jobTypes[jobName] == nil {
+			wp.removedJobNames[jobName] = true
+		}
+	}
+	for jobName := range jobTypes {
+		delete(wp.removedJobNames, jobName)
+	}
+	wp.jobTypes = jobTypes
+
+	for _, w := range wp.workers {
+		w.updateMiddlewareAndJobTypes(wp.middleware, jobTypes)
+	}
+	if wp.fetcher != nil {
+		wp.fetcher.updateJobTypes(jobTypes)
+	}
+	if !wp.started {
+		return
+	}
+
+	jobNames := wp.jobNames()
+	wp.retrier.updateJobNames(wp.requeuedJobNames())
+	wp.scheduler.updateJobNames(wp.requeuedJobNames())
+	wp.deadPoolReaper.updateJobTypes(jobNames)
+	if wp.wakeupListener != nil {
+		wp.wakeupListener.updateJobNames(jobNames)
+	}
+	wp.heartbeater.updateJobNames(jobNames, wp.removedJobNamesList())
+}
+
 // PeriodicallyEnqueue will periodically enqueue jobName according to the cron-based spec.
 // The spec format is based on https://godoc.org/github.com/robfig/cron, which is a relatively standard cron format.
 // Note that the first value is the seconds!
@@ -194,53 +615,207 @@ func (wp *WorkerPool) PeriodicallyEnqueue(spec string, jobName string) *WorkerPo
 
 // Start starts the workers and associated processes.
 func (wp *WorkerPool) Start() {
+	wp.mtx.Lock()
+	defer wp.mtx.Unlock()
+
 	if wp.started {
 		return
 	}
 	wp.started = true
 
 	// TODO: we should cleanup stale keys on startup from previously registered jobs
-	wp.writeConcurrencyControlsToRedis()
-	go wp.writeKnownJobsToRedis()
+	wp.writeConcurrencyControlsToRedis(wp.jobTypes)
+	go wp.writeKnownJobsToRedis(wp.jobTypes)
+	go wp.writeKnownQueuesToRedis()
 
 	for _, w := range wp.workers {
-		go w.start()
+		w.start()
+	}
+	if wp.fetcher != nil {
+		wp.fetcher.logger = wp.logger
+		wp.fetcher.start()
 	}
 
 	wp.heartbeater = newWorkerPoolHeartbeater(wp.namespace, wp.pool, wp.workerPoolID, wp.jobTypes, wp.concurrency, wp.workerIDs())
+	wp.heartbeater.removedJobNames = wp.removedJobNamesList()
+	wp.heartbeater.logger = wp.logger
 	wp.heartbeater.start()
 	wp.startRequeuers()
 	wp.periodicEnqueuer = newPeriodicEnqueuer(wp.namespace, wp.pool, wp.periodicJobs)
+	wp.periodicEnqueuer.logger = wp.logger
 	wp.periodicEnqueuer.start()
+	if wp.wakeChan != nil {
+		wp.wakeupListener = newWakeupListener(wp.namespace, wp.pool, wp.jobNames(), wp.wakeChan, wp.opts.BlockingFetchPoll)
+		wp.wakeupListener.logger = wp.logger
+		wp.wakeupListener.start()
+	}
+	if wp.opts.DeadJobMaxAge > 0 || wp.opts.DeadJobMaxCount > 0 {
+		wp.deadJobPruner = newDeadJobPruner(wp.namespace, wp.pool, wp.workerPoolID, wp.opts.DeadJobMaxAge, wp.opts.DeadJobMaxCount, wp.opts.DeadJobArchiver)
+		wp.deadJobPruner.logger = wp.logger
+		wp.deadJobPruner.start()
+	}
+	if wp.opts.AutoscaleMax > 0 {
+		wp.autoscaler = newAutoscaler(wp, wp.opts.AutoscaleMin, wp.opts.AutoscaleMax, wp.opts.AutoscaleLatency, wp.opts.AutoscaleIdle)
+		wp.autoscaler.logger = wp.logger
+		wp.autoscaler.start()
+	}
 }
 
-// Stop stops the workers and associated processes.
+// Stop stops the workers and associated processes. It blocks until the jobs being processed have finished.
 func (wp *WorkerPool) Stop() {
+	wp.stop(0)
+}
+
+// StopReport describes the outcome of StopWithTimeout.
+type StopReport struct {
+	TimedOut     bool   // true if some jobs were still running when the timeout expired
+	RequeuedJobs []*Job // unfinished jobs that were pushed back onto their queues
+}
+
+// StopWithTimeout stops the workers and associated processes like Stop does, but waits at most timeout for the jobs
+// being processed to finish. Workers stop fetching new jobs right away and their context.Context is cancelled. With
+// Prefetch, the buffered jobs are put back on their queues right away too.
+// Jobs still running when the timeout expires are abandoned: they're moved from this pool's in progress queues back
+// onto their job queues, so another pool can pick them up, and their outcome is ignored. Since such a job may still be
+// running in the background, it can end up being processed twice.
+// A pool whose StopWithTimeout timed out shouldn't be started again.
+func (wp *WorkerPool) StopWithTimeout(timeout time.Duration) (*StopReport, error) {
+	return wp.stop(timeout)
+}
+
+// stop stops the pool. If timeout is 0 it waits for running jobs no matter how long they take.
+func (wp *WorkerPool) stop(timeout time.Duration) (*StopReport, error) {
+	report := &StopReport{}
+	wp.mtx.Lock()
 	if !wp.started {
-		return
+		wp.mtx.Unlock()
+		return report, nil
+	}
+	// The autoscaler resizes the pool with mtx held
+	autoscaler := wp.autoscaler
+	wp.autoscaler = nil
+	wp.mtx.Unlock()
+	if autoscaler != nil {
+		autoscaler.stop()
 	}
+
+	wp.mtx.Lock()
 	wp.started = false
+	// Jobs of removed types may still be in progress
+	inProgressJobNames := append(wp.jobNames(), wp.removedJobNamesList()...)
+	active := wp.workers
+	workers := append([]*worker(nil), active...)
+	for w := range wp.retiring {
+		workers = append(workers, w)
+		// Let the job of the retiring worker learn about the stop too
+		w.cancel()
+	}
+	wp.mtx.Unlock()
 
 	wg := sync.WaitGroup{}
-	for _, w := range wp.workers {
+	for _, w := range active {
 		wg.Add(1)
 		go func(w *worker) {
 			w.stop()
 			wg.Done()
 		}(w)
 	}
-	wg.Wait()
+	wg.Add(1)
+	go func() {
+		wp.retiringWG.Wait()
+		wg.Done()
+	}()
+
+	// The workers don't take buffered jobs anymore: give them back right away rather than once the running jobs are done
+	if wp.fetcher != nil {
+		wp.fetcher.stop()
+	}
+
+	var err error
+	if !waitTimeout(&wg, timeout) {
+		report.TimedOut = true
+		for _, w := range workers {
+			w.abandon()
+		}
+		report.RequeuedJobs, err = wp.requeueInProgressJobs(inProgressJobNames)
+	}
+
 	wp.heartbeater.stop()
 	wp.retrier.stop()
 	wp.scheduler.stop()
 	wp.deadPoolReaper.stop()
 	wp.periodicEnqueuer.stop()
+	if wp.deadJobPruner != nil {
+		wp.deadJobPruner.stop()
+		wp.deadJobPruner = nil
+	}
+	if wp.wakeupListener != nil {
+		wp.wakeupListener.stop()
+		wp.wakeupListener = nil
+	}
+
+	return report, err
+}
+
+func (wp *WorkerPool) requeueInProgressJobs(jobNames []string) ([]*Job, error) {
+	rawJobs, err := requeueInProgressJobs(wp.namespace, wp.pool, wp.workerPoolID, jobNames, wp.opts.BlockingFetch)
+	if err == nil {
+		// The abandoned jobs don't release their concurrency keys themselves
+		err = reapStaleKeyLocks(wp.namespace, wp.pool, wp.workerPoolID, jobNames)
+	}
+
+	jobs := make([]*Job, 0, len(rawJobs))
+	for _, rawJSON := range rawJobs {
+		job, err := newJob(rawJSON, nil, nil)
+		if err != nil {
+			wp.logger.Error("worker_pool.requeue_in_progress_jobs.new_job", err)
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, err
+}
+
+// waitTimeout waits for wg and reports whether it finished before the timeout. A timeout of 0 means no timeout.
+func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	if timeout <= 0 {
+		wg.Wait()
+		return true
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return true
+	case <-timer.C:
+		return false
+	}
 }
 
 // Drain drains all jobs in the queue before returning. Note that if jobs are added faster than we can process them, this function wouldn't return.
 func (wp *WorkerPool) Drain() {
+	// Handlers may add or remove job types, or resize the pool, while we wait: only hold mtx to see what to wait for.
+	// Workers retired meanwhile aren't waited for.
+	wp.mtx.Lock()
+	fetcher := wp.fetcher
+	workers := append([]*worker(nil), wp.workers...)
+	wp.mtx.Unlock()
+
+	if fetcher != nil {
+		fetcher.drain()
+	}
+
 	wg := sync.WaitGroup{}
-	for _, w := range wp.workers {
+	for _, w := range workers {
 		wg.Add(1)
 		go func(w *worker) {
 			w.drain()
@@ -251,18 +826,43 @@ func (wp *WorkerPool) Drain() {
 }
 
 func (wp *WorkerPool) startRequeuers() {
-	jobNames := make([]string, 0, len(wp.jobTypes))
-	for k := range wp.jobTypes {
-		jobNames = append(jobNames, k)
-	}
-	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), jobNames)
-	wp.scheduler = newRequeuer(wp.namespace, wp.pool, redisKeyScheduled(wp.namespace), jobNames)
+	jobNames := wp.jobNames()
+	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), wp.requeuedJobNames())
+	wp.scheduler = newRequeuer(wp.namespace, wp.pool, redisKeyScheduled(wp.namespace), wp.requeuedJobNames())
 	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, jobNames)
+	wp.retrier.logger = wp.logger
+	wp.scheduler.logger = wp.logger
+	wp.deadPoolReaper.logger = wp.logger
+	wp.retrier.wakeup = wp.opts.BlockingFetch
+	wp.scheduler.wakeup = wp.opts.BlockingFetch
+	wp.deadPoolReaper.wakeup = wp.opts.BlockingFetch
 	wp.retrier.start()
 	wp.scheduler.start()
 	wp.deadPoolReaper.start()
 }
 
+func (wp *WorkerPool) jobNames() []string {
+	jobNames := make([]string, 0, len(wp.jobTypes))
+	for k := range wp.jobTypes {
+		jobNames = append(jobNames, k)
+	}
+	return jobNames
+}
+
+// requeuedJobNames are the jobs the retrier and the scheduler put back on their queues once due. Removed job types are
+// included: their jobs wait on their queues for another pool, or for the type to be added back, instead of dying.
+func (wp *WorkerPool) requeuedJobNames() []string {
+	return append(wp.jobNames(), wp.removedJobNamesList()...)
+}
+
+func (wp *WorkerPool) removedJobNamesList() []string {
+	jobNames := make([]string, 0, len(wp.removedJobNames))
+	for k := range wp.removedJobNames {
+		jobNames = append(jobNames, k)
+	}
+	return jobNames
+}
+
 func (wp *WorkerPool) workerIDs() []string {
 	wids := make([]string, 0, len(wp.workers))
 	for _, w := range wp.workers {
@@ -272,35 +872,68 @@ func (wp *WorkerPool) workerIDs() []string {
 	return wids
 }
 
-func (wp *WorkerPool) writeKnownJobsToRedis() {
-	if len(wp.jobTypes) == 0 {
+func (wp *WorkerPool) writeKnownJobsToRedis(jobTypes map[string]*jobType) {
+	if len(jobTypes) == 0 {
 		return
 	}
 
 	conn := wp.pool.Get()
 	defer conn.Close()
 	key := redisKeyKnownJobs(wp.namespace)
-	jobNames := make([]interface{}, 0, len(wp.jobTypes)+1)
+	jobNames := make([]interface{}, 0, len(jobTypes)+1)
 	jobNames = append(jobNames, key)
-	for k := range wp.jobTypes {
+	for k := range jobTypes {
 		jobNames = append(jobNames, k)
 	}
 
 	if _, err := conn.Do("SADD", jobNames...); err != nil {
-		logError("write_known_jobs", err)
+		wp.logger.Error("write_known_jobs", err)
+	}
+}
+
+// writeKnownQueuesToRedis records the named queues the pool consumes, so that Client.Queues counts their jobs.
+func (wp *WorkerPool) writeKnownQueuesToRedis() {
+	args := []interface{}{redisKeyKnownQueues(wp.namespace)}
+	for _, q := range wp.queues {
+		if q.Name != DefaultQueue {
+			args = append(args, q.Name)
+		}
+	}
+	if len(args) == 1 {
+		return
+	}
+
+	conn := wp.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("SADD", args...); err != nil {
+		wp.logger.Error("write_known_queues", err)
 	}
 }
 
-func (wp *WorkerPool) writeConcurrencyControlsToRedis() {
-	if len(wp.jobTypes) == 0 {
+func (wp *WorkerPool) writeConcurrencyControlsToRedis(jobTypes map[string]*jobType) {
+	if len(jobTypes) == 0 {
 		return
 	}
 
 	conn := wp.pool.Get()
 	defer conn.Close()
-	for jobName, jobType := range wp.jobTypes {
+	for jobName, jobType := range jobTypes {
+		// Only the configured max is written: an override set with Client.SetMaxConcurrency is kept.
 		if _, err := conn.Do("SET", redisKeyJobsConcurrency(wp.namespace, jobName), jobType.MaxConcurrency); err != nil {
-			logError("write_concurrency_controls_max_concurrency", err)
+			wp.logger.Error("write_concurrency_controls_max_concurrency", err, "job_name", jobName)
+		}
+
+		// Only the configured limit is written: an override set with Client.SetRateLimit is kept.
+		rateLimitKey := redisKeyJobsRateLimit(wp.namespace, jobName)
+		var err error
+		if jobType.RateLimit.Count > 0 {
+			_, err = conn.Do("HMSET", rateLimitKey, "count", jobType.RateLimit.Count, "period", jobType.RateLimit.Period.Milliseconds())
+		} else {
+			_, err = conn.Do("HDEL", rateLimitKey, "count", "period")
+		}
+		if err != nil {
+			wp.logger.Error("write_concurrency_controls_rate_limit", err, "job_name", jobName)
 		}
 	}
 }
@@ -354,6 +987,10 @@ func instructiveMessage(vfn reflect.Value, addingType string, yourType string, a
 	str += "* func (c *" + ctxString + ") YourFunctionName(" + args + ") error  // or,\n"
 	str += "* func YourFunctionName(c *" + ctxString + ", " + args + ") error\n"
 	str += "*\n"
+	str += "* // If you want your " + yourType + " to be told when the worker pool stops:\n"
+	str += "* func YourFunctionName(ctx context.Context, " + args + ") error  // or,\n"
+	str += "* func (c *" + ctxString + ") YourFunctionName(ctx context.Context, " + args + ") error\n"
+	str += "*\n"
 	str += "* Unfortunately, your function has this signature: " + vfn.Type().String() + "\n"
 	str += "*\n"
 	str += strings.Repeat("*", 120) + "\n"
@@ -361,6 +998,14 @@ func instructiveMessage(vfn reflect.Value, addingType string, yourType string, a
 	return str
 }
 
+var (
+	contextInterfaceType                = reflect.TypeOf((*context.Context)(nil)).Elem()
+	genericHandlerType                  = reflect.TypeOf(GenericHandler(nil))
+	genericContextHandlerType           = reflect.TypeOf(GenericContextHandler(nil))
+	genericMiddlewareHandlerType        = reflect.TypeOf(GenericMiddlewareHandler(nil))
+	genericContextMiddlewareHandlerType = reflect.TypeOf(GenericContextMiddlewareHandler(nil))
+)
+
 func isValidHandlerType(ctxType reflect.Type, vfn reflect.Value) bool {
 	fnType := vfn.Type()
 
@@ -388,12 +1033,22 @@ func isValidHandlerType(ctxType reflect.Type, vfn reflect.Value) bool {
 			return false
 		}
 	} else if numIn == 2 {
-		if fnType.In(0) != reflect.PtrTo(ctxType) {
+		if fnType.In(0) != reflect.PtrTo(ctxType) && fnType.In(0) != contextInterfaceType {
 			return false
 		}
 		if fnType.In(1) != reflect.TypeOf(j) {
 			return false
 		}
+	} else if numIn == 3 {
+		if fnType.In(0) != reflect.PtrTo(ctxType) {
+			return false
+		}
+		if fnType.In(1) != contextInterfaceType {
+			return false
+		}
+		if fnType.In(2) != reflect.TypeOf(j) {
+			return false
+		}
 	} else {
 		return false
 	}
@@ -432,7 +1087,7 @@ func isValidMiddlewareType(ctxType reflect.Type, vfn reflect.Value) bool {
 			return false
 		}
 	} else if numIn == 3 {
-		if fnType.In(0) != reflect.PtrTo(ctxType) {
+		if fnType.In(0) != reflect.PtrTo(ctxType) && fnType.In(0) != contextInterfaceType {
 			return false
 		}
 		if fnType.In(1) != reflect.TypeOf(j) {
@@ -441,6 +1096,19 @@ func isValidMiddlewareType(ctxType reflect.Type, vfn reflect.Value) bool {
 		if fnType.In(2) != reflect.TypeOf(nfn) {
 			return false
 		}
+	} else if numIn == 4 {
+		if fnType.In(0) != reflect.PtrTo(ctxType) {
+			return false
+		}
+		if fnType.In(1) != contextInterfaceType {
+			return false
+		}
+		if fnType.In(2) != reflect.TypeOf(j) {
+			return false
+		}
+		if fnType.In(3) != reflect.TypeOf(nfn) {
+			return false
+		}
 	} else {
 		return false
 	}
@@ -457,9 +1125,21 @@ func applyDefaultsAndValidate(jobOpts JobOptions) JobOptions {
 		jobOpts.MaxFails = 4
 	}
 
+	if len(jobOpts.ConcurrencyKeys) > 0 && jobOpts.MaxConcurrencyPerKey == 0 {
+		jobOpts.MaxConcurrencyPerKey = 1
+	}
+
 	if jobOpts.Priority > 100000 {
 		panic("work: JobOptions.Priority must be between 1 and 100000")
 	}
 
+	if jobOpts.RateLimit.Count > 0 && jobOpts.RateLimit.Period < time.Millisecond {
+		panic("work: JobOptions.RateLimit.Period must be at least a millisecond")
+	}
+
+	if jobOpts.ResultTTL > 0 && jobOpts.ResultTTL < time.Millisecond {
+		panic("work: JobOptions.ResultTTL must be at least a millisecond")
+	}
+
 	return jobOpts
 }
@@ -2,8 +2,12 @@ package work
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -29,6 +33,10 @@ func TestWorkerPoolHandlerValidations(t *testing.T) {
 	}{
 		{func(j *Job) error { return nil }, true},
 		{func(c *tstCtx, j *Job) error { return nil }, true},
+		{func(ctx context.Context, j *Job) error { return nil }, true},
+		{func(c *tstCtx, ctx context.Context, j *Job) error { return nil }, true},
+		{func(ctx context.Context, c *tstCtx, j *Job) error { return nil }, false},
+		{func(j *Job, ctx context.Context) error { return nil }, false},
 		{func(c *tstCtx, j *Job) {}, false},
 		{func(c *tstCtx, j *Job) string { return "" }, false},
 		{func(c *tstCtx, j *Job) (error, string) { return nil, "" }, false},
@@ -53,6 +61,9 @@ func TestWorkerPoolMiddlewareValidations(t *testing.T) {
 	}{
 		{func(j *Job, n NextMiddlewareFunc) error { return nil }, true},
 		{func(c *tstCtx, j *Job, n NextMiddlewareFunc) error { return nil }, true},
+		{func(ctx context.Context, j *Job, n NextMiddlewareFunc) error { return nil }, true},
+		{func(c *tstCtx, ctx context.Context, j *Job, n NextMiddlewareFunc) error { return nil }, true},
+		{func(ctx context.Context, c *tstCtx, j *Job, n NextMiddlewareFunc) error { return nil }, false},
 		{func(c *tstCtx, j *Job) error { return nil }, false},
 		{func(c *tstCtx, j *Job, n NextMiddlewareFunc) {}, false},
 		{func(c *tstCtx, j *Job, n NextMiddlewareFunc) string { return "" }, false},
@@ -112,6 +123,180 @@ func TestWorkerPoolValidations(t *testing.T) {
 
 		wp.Job("wat", TestWorkerPoolValidations)
 	}()
+
+	func() {
+		defer func() {
+			if panicErr := recover(); panicErr != nil {
+				assert.Regexp(t, "RateLimit.Period", fmt.Sprintf("%v", panicErr))
+			} else {
+				t.Errorf("expected a panic when using a rate limit without a period")
+			}
+		}()
+
+		wp.JobWithOptions("wat", JobOptions{RateLimit: RateLimit{Count: 1}}, func(*Job) error { return nil })
+	}()
+}
+
+// Func types of their own are called like the func types they're based on
+type (
+	testHandler                  func(*Job) error
+	testContextHandler           func(context.Context, *Job) error
+	testContextMiddlewareHandler func(context.Context, *Job, NextMiddlewareFunc) error
+)
+
+func TestWorkerPoolNamedHandlerTypes(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	var mtx sync.Mutex
+	var calls []string
+	record := func(call string, ctx context.Context) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if ctx != nil && ctx.Err() == nil {
+			call += " with ctx"
+		}
+		calls = append(calls, call)
+	}
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Middleware(testContextMiddlewareHandler(func(ctx context.Context, job *Job, next NextMiddlewareFunc) error {
+		record("middleware", ctx)
+		return next()
+	}))
+	wp.Job("plain", testHandler(func(job *Job) error {
+		record("plain", nil)
+		return nil
+	}))
+	wp.Job("ctx", testContextHandler(func(ctx context.Context, job *Job) error {
+		record("ctx", ctx)
+		return nil
+	}))
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for _, jobName := range []string{"plain", "ctx"} {
+		_, err := enqueuer.Enqueue(jobName, nil)
+		assert.NoError(t, err)
+	}
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.ElementsMatch(t, []string{"middleware with ctx", "plain", "middleware with ctx", "ctx with ctx"}, calls)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+}
+
+func TestWorkerPoolStopCancelsContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	started := make(chan struct{})
+	var ctxErr error
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job(job1, func(ctx context.Context, job *Job) error {
+		close(started)
+		select {
+		case <-ctx.Done():
+			ctxErr = ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+		return ctxErr
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+	start := time.Now()
+	wp.Stop()
+
+	assert.Equal(t, context.Canceled, ctxErr)
+	assert.True(t, time.Since(start) < 5*time.Second)
+}
+
+func TestWorkerPoolStopWithTimeout(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	finished := make(chan struct{})
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions(job1, JobOptions{MaxConcurrency: 1}, func(job *Job) error {
+		started <- struct{}{}
+		<-release
+		defer close(finished)
+		return fmt.Errorf("too late")
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueued, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.NoError(t, err)
+
+	wp.Start()
+	<-started
+
+	report, err := wp.StopWithTimeout(50 * time.Millisecond)
+	assert.NoError(t, err)
+	assert.True(t, report.TimedOut)
+	if assert.Len(t, report.RequeuedJobs, 1) {
+		assert.Equal(t, enqueued.ID, report.RequeuedJobs[0].ID)
+		assert.Equal(t, job1, report.RequeuedJobs[0].Name)
+	}
+
+	// The job is back on its queue and the locks are released
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, job1), wp.workerPoolID))
+
+	// When the abandoned job eventually finishes, its outcome is ignored
+	close(release)
+	<-finished
+	time.Sleep(10 * time.Millisecond)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+}
+
+func TestWorkerPoolStopWithTimeoutInTime(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job(job1, func(ctx context.Context, job *Job) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	time.Sleep(20 * time.Millisecond)
+
+	report, err := wp.StopWithTimeout(time.Second)
+	assert.NoError(t, err)
+	assert.False(t, report.TimedOut)
+	assert.Empty(t, report.RequeuedJobs)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, wp.workerPoolID, job1)))
 }
 
 func TestWorkersPoolRunSingleThreaded(t *testing.T) {
@@ -206,6 +391,318 @@ func TestWorkerPoolPauseSingleThreadedJobs(t *testing.T) {
 	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, job1), wp.workerPoolID))
 }
 
+func TestWorkerPoolRateLimit(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+	wp := setupTestWorkerPool(pool, ns, job1, 3, JobOptions{Priority: 1, RateLimit: RateLimit{Count: 2, Period: time.Hour}})
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 5; i++ {
+		_, err := enqueuer.Enqueue(job1, Q{"sleep": 0})
+		assert.NoError(t, err)
+	}
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// Only 2 jobs may start this hour
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 2, getInt64(pool, redisKeyJobsRateWindow(ns, job1)))
+
+	// An override outlives restarts and takes precedence over the JobOptions
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.SetRateLimit(job1, RateLimit{Count: 3, Period: time.Hour}))
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, job1)))
+
+	// A zero override lifts the limit
+	assert.NoError(t, client.SetRateLimit(job1, RateLimit{}))
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+
+	// Without the override the JobOptions apply again
+	assert.NoError(t, client.ResetRateLimit(job1))
+	_, err := enqueuer.Enqueue(job1, Q{"sleep": 0})
+	assert.NoError(t, err)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, job1)))
+
+	assert.Error(t, client.SetRateLimit(job1, RateLimit{Count: 1}))
+}
+
+func TestWorkerPoolRateLimitWindow(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1 := "work", "job1"
+	cleanKeyspace(ns, pool)
+	wp := setupTestWorkerPool(pool, ns, job1, 3, JobOptions{Priority: 1, RateLimit: RateLimit{Count: 1, Period: 100 * time.Millisecond}})
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 3; i++ {
+		_, err := enqueuer.Enqueue(job1, Q{"sleep": 0})
+		assert.NoError(t, err)
+	}
+
+	start := time.Now()
+	wp.Start()
+	for listSize(pool, redisKeyJobs(ns, job1)) > 0 && time.Since(start) < 5*time.Second {
+		time.Sleep(10 * time.Millisecond)
+	}
+	wp.Drain()
+	wp.Stop()
+
+	// One job per window, so the third one can't start before the third window
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.True(t, time.Since(start) >= 200*time.Millisecond)
+}
+
+func TestWorkerPoolSetConcurrency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var jobErr error
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(ctx context.Context, job *Job) error {
+		if job.ArgBool("block") {
+			started <- struct{}{}
+			<-release
+			jobErr = ctx.Err()
+		}
+		return nil
+	})
+	wp.Start()
+
+	// Added workers start right away and show up in the heartbeat
+	wp.SetConcurrency(5)
+	assert.EqualValues(t, 5, wp.Concurrency())
+	h := readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))
+	assert.Equal(t, "5", h["concurrency"])
+	assert.Len(t, strings.Split(h["worker_ids"], ","), 5)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 20; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+	wp.Drain()
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Idle workers are retired first, and a busy one finishes its job undisturbed
+	_, err := enqueuer.Enqueue("wat", Q{"block": true})
+	assert.NoError(t, err)
+	<-started
+	wp.SetConcurrency(1)
+	assert.True(t, wp.workers[0].idleSince().IsZero())
+	h = readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))
+	assert.Equal(t, "1", h["concurrency"])
+	assert.Equal(t, wp.workers[0].workerID, h["worker_ids"])
+
+	// Retired workers are gone once idle, and Stop waits for retiring ones
+	wp.SetConcurrency(0)
+	stopped := make(chan struct{})
+	go func() {
+		wp.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("Stop didn't wait for the retiring worker")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(release)
+	<-stopped
+	assert.Equal(t, context.Canceled, jobErr)
+	assert.Empty(t, wp.retiring)
+}
+
+func TestWorkerPoolDrainWhileResizing(t *testing.T) {
+	for _, prefetch := range []uint{0, 2} {
+		pool := newTestPool(":6379")
+		ns := "work"
+		cleanKeyspace(ns, pool)
+
+		var wp *WorkerPool
+		var ran int32
+		wp = NewWorkerPoolWithOptions(TestContext{}, 4, ns, pool, WorkerPoolOptions{Prefetch: prefetch})
+		wp.Job("wat", func(job *Job) error {
+			// Handlers can resize the pool and change its job types while it drains
+			switch atomic.AddInt32(&ran, 1) {
+			case 1:
+				wp.SetConcurrency(1)
+			case 2:
+				wp.AddJob("foo", JobOptions{}, func(job *Job) error { return nil })
+			case 3:
+				wp.SetConcurrency(3)
+			}
+			return nil
+		})
+
+		enqueuer := NewEnqueuer(ns, pool)
+		for i := 0; i < 20; i++ {
+			_, err := enqueuer.Enqueue("wat", Q{"i": i})
+			assert.NoError(t, err)
+		}
+
+		wp.Start()
+		drained := make(chan struct{})
+		go func() {
+			wp.Drain()
+			close(drained)
+		}()
+		select {
+		case <-drained:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Drain deadlocked")
+		}
+		wp.Stop()
+
+		assert.EqualValues(t, 20, atomic.LoadInt32(&ran), "prefetch %d", prefetch)
+		assert.EqualValues(t, 3, wp.Concurrency())
+	}
+}
+
+func TestWorkerPoolAddRemoveJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	done := make(chan string, 10)
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("wat", func(job *Job) error {
+		done <- job.ID
+		return nil
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	// An added job type is fetched right away, and shows up in the heartbeat and the known jobs
+	wp.AddJob("foo", JobOptions{MaxConcurrency: 3}, func(job *Job) error {
+		done <- job.ID
+		return nil
+	})
+	h := readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))
+	assert.Equal(t, "foo,wat", h["job_names"])
+	assert.Contains(t, knownJobs(pool, redisKeyKnownJobs(ns)), "foo")
+	assert.EqualValues(t, 3, getInt64(pool, redisKeyJobsConcurrency(ns, "foo")))
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{job.ID}, receiveJobIDs(t, done, 1))
+
+	// Its scheduled jobs are requeued by the pool's scheduler
+	scheduled, err := enqueuer.EnqueueIn("foo", 0, nil)
+	assert.NoError(t, err)
+	wp.scheduler.requeueAll()
+	assert.Equal(t, []string{scheduled.ID}, receiveJobIDs(t, done, 1))
+
+	// A removed job type isn't fetched anymore, and leaves the heartbeat
+	wp.RemoveJob("wat")
+	h = readHash(pool, redisKeyHeartbeat(ns, wp.workerPoolID))
+	assert.Equal(t, "foo", h["job_names"])
+	_, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	select {
+	case <-done:
+		t.Fatal("removed job ran")
+	case <-time.After(200 * time.Millisecond):
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.ElementsMatch(t, []string{"foo", "wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	// Its due retries go back on its queue instead of dying
+	rawJSON, err := (&Job{Name: "wat", ID: "retrying", Fails: 1}).serialize()
+	assert.NoError(t, err)
+	conn := pool.Get()
+	_, err = conn.Do("ZADD", redisKeyRetry(ns), nowEpochSeconds()-1, rawJSON)
+	conn.Close()
+	assert.NoError(t, err)
+	wp.retrier.requeueAll()
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Removing it again does nothing, and adding it back picks up its jobs
+	wp.RemoveJob("wat")
+	wp.Job("wat", func(job *Job) error {
+		done <- job.ID
+		return nil
+	})
+	receiveJobIDs(t, done, 2)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestWorkerPoolQueues(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	// The same job type is registered by a pool dedicated to the critical queue and by a default pool
+	type run struct{ pool, queue string }
+	done := make(chan run, 10)
+	var fails int64
+	newPool := func(name string, opts WorkerPoolOptions) *WorkerPool {
+		wp := NewWorkerPoolWithOptions(TestContext{}, 2, ns, pool, opts)
+		wp.JobWithOptions("charge", JobOptions{MaxFails: 3, Backoff: func(*Job) int64 { return 0 }}, func(job *Job) error {
+			if job.ArgBool("fail") && atomic.AddInt64(&fails, 1) == 1 {
+				return fmt.Errorf("declined")
+			}
+			done <- run{name, job.Queue}
+			return nil
+		})
+		return wp
+	}
+	critical := newPool("critical", WorkerPoolOptions{Queues: []QueueSubscription{{Name: "critical"}}})
+	critical.Start()
+	defer critical.Stop()
+	standard := newPool("default", WorkerPoolOptions{})
+	standard.Start()
+	defer standard.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.RouteJob("charge", "critical")
+	_, err := enqueuer.Enqueue("charge", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, run{"critical", "critical"}, <-done)
+	_, err = enqueuer.EnqueueOnQueue(DefaultQueue, "charge", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, run{"default", ""}, <-done)
+
+	// A retried job stays on its queue
+	_, err = enqueuer.Enqueue("charge", Q{"fail": true})
+	assert.NoError(t, err)
+	deadline := time.After(2 * time.Second)
+	for {
+		standard.retrier.requeueAll()
+		select {
+		case r := <-done:
+			assert.Equal(t, run{"critical", "critical"}, r)
+			assert.EqualValues(t, 2, atomic.LoadInt64(&fails))
+			assert.ElementsMatch(t, []string{"critical"}, knownJobs(pool, redisKeyKnownQueues(ns)))
+			return
+		case <-deadline:
+			t.Fatal("retried job didn't run")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestWorkerPoolQueuesValidation(t *testing.T) {
+	pool := newTestPool(":6379")
+	for _, queues := range [][]QueueSubscription{{{Name: ""}}, {{Name: "critical"}, {Name: "critical"}}} {
+		assert.PanicsWithValue(t, "NewWorkerPool needs distinct, non-empty queue names", func() {
+			NewWorkerPoolWithOptions(TestContext{}, 1, "work", pool, WorkerPoolOptions{Queues: queues})
+		})
+	}
+}
+
 // Test Helpers
 func (t *TestContext) SleepyJob(job *Job) error {
 	sleepTime := time.Duration(job.ArgInt64("sleep"))
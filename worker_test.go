@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"sync/atomic"
@@ -238,6 +239,69 @@ func TestWorkerRetryWithCustomBackoff(t *testing.T) {
 	assert.Equal(t, 1, calledCustom)
 }
 
+func TestWorkerTimeout(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	job2 := "job2"
+	deleteQueue(pool, ns, job1)
+	deleteQueue(pool, ns, job2)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+	deletePausedAndLockedKeys(ns, job2, pool)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3, Timeout: 20 * time.Millisecond},
+		IsGeneric:  true,
+		GenericContextHandler: func(ctx context.Context, job *Job) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+	jobTypes[job2] = &jobType{
+		Name:       job2,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1, Timeout: 20 * time.Millisecond},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			// ignores the timeout completely
+			<-release
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue(job2, nil)
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	for _, jobName := range []string{job1, job2} {
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, jobName)))
+		assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", jobName)))
+		assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, jobName)))
+	}
+
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, job1, job.Name)
+	assert.Equal(t, "job timed out after 20ms", job.LastErr)
+
+	_, job = jobOnZset(pool, redisKeyDead(ns))
+	assert.Equal(t, job2, job.Name)
+	assert.Equal(t, "job timed out after 20ms", job.LastErr)
+}
+
 func TestWorkerDead(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -363,6 +427,50 @@ func TestWorkersPaused(t *testing.T) {
 	assert.EqualValues(t, 0, len(h))
 }
 
+func TestWorkerRemovedJobType(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	for i := 0; i < 2; i++ {
+		_, err := enqueuer.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+
+	var ran bool
+	jobTypes := map[string]*jobType{
+		"wat": {
+			Name:       "wat",
+			JobOptions: JobOptions{Priority: 1},
+			IsGeneric:  true,
+			GenericHandler: func(job *Job) error {
+				ran = true
+				return nil
+			},
+		},
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes, nil)
+	job, err := w.fetchJob()
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// The job type is removed between the fetch and the run: the job goes back to the front of its queue
+	w.updateMiddlewareAndJobTypes(nil, map[string]*jobType{})
+	w.processJob(job)
+	assert.False(t, ran)
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", "wat")))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, "wat")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 0, jobOnQueue(pool, redisKeyJobs(ns, "wat")).ArgInt64("i"))
+
+	// Nor is it fetched anymore
+	job, err = w.fetchJob()
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
 // Test that in the case of an unavailable Redis server,
 // the worker loop exits in the case of a WorkerPool.Stop
 func TestStop(t *testing.T) {
@@ -463,6 +571,17 @@ func listSize(pool *redis.Pool, key string) int64 {
 	return v
 }
 
+func hashSize(pool *redis.Pool, key string) int64 {
+	conn := pool.Get()
+	defer conn.Close()
+
+	v, err := redis.Int64(conn.Do("HLEN", key))
+	if err != nil {
+		panic("could not get hash length: " + err.Error())
+	}
+	return v
+}
+
 func getInt64(pool *redis.Pool, key string) int64 {
 	conn := pool.Get()
 	defer conn.Close()
@@ -474,6 +593,17 @@ func getInt64(pool *redis.Pool, key string) int64 {
 	return v
 }
 
+func ttl(pool *redis.Pool, key string) int64 {
+	conn := pool.Get()
+	defer conn.Close()
+
+	v, err := redis.Int64(conn.Do("TTL", key))
+	if err != nil {
+		panic("could not TTL: " + err.Error())
+	}
+	return v
+}
+
 func hgetInt64(pool *redis.Pool, redisKey, hashKey string) int64 {
 	conn := pool.Get()
 	defer conn.Close()
@@ -542,7 +672,7 @@ func cleanKeyspace(namespace string, pool *redis.Pool) {
 	conn := pool.Get()
 	defer conn.Close()
 
-	keys, err := redis.Strings(conn.Do("KEYS", namespace+"*"))
+	keys, err := redis.Strings(conn.Do("KEYS", redisNamespacePrefix(namespace)+"*"))
 	if err != nil {
 		panic("could not get keys: " + err.Error())
 	}
@@ -0,0 +1,322 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// ErrWorkflowNotFound is returned by Client.Workflow when there is no workflow with the given ID, or it expired.
+var ErrWorkflowNotFound = fmt.Errorf("workflow not found")
+
+// workflowRetentionSeconds is how long a workflow's state is kept once all of its jobs either succeeded, died or were
+// cancelled.
+const workflowRetentionSeconds = 7 * 24 * 60 * 60
+
+// WorkflowJobState is the state of a job within a workflow.
+type WorkflowJobState string
+
+const (
+	// WorkflowJobPending means the job waits for some of its dependencies to succeed.
+	WorkflowJobPending WorkflowJobState = "pending"
+	// WorkflowJobQueued means the job is runnable: it's queued, running or waiting to be retried.
+	WorkflowJobQueued WorkflowJobState = "queued"
+	// WorkflowJobDone means the job succeeded.
+	WorkflowJobDone WorkflowJobState = "done"
+	// WorkflowJobDead means the job failed and won't be retried anymore.
+	WorkflowJobDead WorkflowJobState = "dead"
+	// WorkflowJobCancelled means one of the job's dependencies died, so the job was put on the dead queue without
+	// running.
+	WorkflowJobCancelled WorkflowJobState = "cancelled"
+)
+
+// Workflow is a set of jobs with dependencies between them. Jobs only become runnable once all of the jobs they depend
+// on succeeded. If one of them dies instead, the jobs depending on it (directly or not) are cancelled: they are put on
+// the dead queue without running. Retries don't affect the workflow, a job only counts as finished once it succeeds or
+// dies.
+//
+// Build it with Enqueuer.NewWorkflow and Add, then enqueue all of its jobs at once with Enqueue:
+//
+//	wf := enqueuer.NewWorkflow()
+//	a1 := wf.Add("resize_image", work.Q{"size": "small"})
+//	a2 := wf.Add("resize_image", work.Q{"size": "large"})
+//	wf.Add("publish_album", work.Q{"album_id": 42}, a1, a2)
+//	err := wf.Enqueue()
+type Workflow struct {
+	ID string
+
+	enqueuer    *Enqueuer
+	traceParent string
+	jobs        []*Job
+	added       map[string]bool
+	dependsOn   map[string][]string
+	err         error
+}
+
+// NewWorkflow creates a new, empty workflow.
+func (e *Enqueuer) NewWorkflow() *Workflow {
+	return e.NewWorkflowContext(context.Background())
+}
+
+// NewWorkflowContext is like NewWorkflow, but the jobs of the workflow carry the trace of the span of ctx, if any, see
+// EnqueueContext.
+func (e *Enqueuer) NewWorkflowContext(ctx context.Context) *Workflow {
+	return &Workflow{
+		ID:          makeIdentifier(),
+		enqueuer:    e,
+		traceParent: traceParent(ctx),
+		added:       make(map[string]bool),
+		dependsOn:   make(map[string][]string),
+	}
+}
+
+// Add adds a job to the workflow, which only becomes runnable once all of the dependsOn jobs succeeded. The
+// dependencies must have been added to this workflow before. If they weren't, Enqueue returns an error.
+// The returned job is the one that will be enqueued. Nothing is enqueued until Enqueue is called.
+func (wf *Workflow) Add(jobName string, args map[string]interface{}, dependsOn ...*Job) *Job {
+	job := &Job{
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		TraceParent: wf.traceParent,
+		Args:        args,
+		WorkflowID:  wf.ID,
+		Queue:       wf.enqueuer.queueOf(jobName),
+	}
+
+	seen := make(map[string]bool, len(dependsOn))
+	for _, dep := range dependsOn {
+		if dep == nil || !wf.added[dep.ID] {
+			if wf.err == nil {
+				wf.err = fmt.Errorf("workflow: job %s depends on a job that wasn't added to the workflow before it", jobName)
+			}
+			continue
+		}
+		if !seen[dep.ID] {
+			seen[dep.ID] = true
+			wf.dependsOn[job.ID] = append(wf.dependsOn[job.ID], dep.ID)
+		}
+	}
+
+	wf.jobs = append(wf.jobs, job)
+	wf.added[job.ID] = true
+
+	return job
+}
+
+// Enqueue atomically stores the workflow and enqueues the jobs that don't depend on anything.
+func (wf *Workflow) Enqueue() error {
+	if wf.err != nil {
+		return wf.err
+	}
+	if len(wf.jobs) == 0 {
+		return fmt.Errorf("workflow: no jobs")
+	}
+
+	e := wf.enqueuer
+	statesKey := redisKeyWorkflowStates(e.Namespace, wf.ID)
+	jobsKey := redisKeyWorkflowJobs(e.Namespace, wf.ID)
+	waitingKey := redisKeyWorkflowWaiting(e.Namespace, wf.ID)
+	dependentsKey := redisKeyWorkflowDependents(e.Namespace, wf.ID)
+
+	dependents := make(map[string][]string)
+	var jobNames []string
+	seenNames := make(map[string]bool)
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	for _, job := range wf.jobs {
+		rawJSON, err := job.serialize()
+		if err != nil {
+			conn.Do("DISCARD")
+			return err
+		}
+
+		conn.Send("HSET", jobsKey, job.ID, rawJSON)
+
+		deps := wf.dependsOn[job.ID]
+		if len(deps) == 0 {
+			conn.Send("HSET", statesKey, job.ID, string(WorkflowJobQueued))
+			conn.Send("LPUSH", e.queueKey(job), rawJSON)
+			if e.wakeup {
+				conn.Send("PUBLISH", redisKeyWakeup(e.Namespace), job.Name)
+			}
+		} else {
+			conn.Send("HSET", statesKey, job.ID, string(WorkflowJobPending))
+			conn.Send("HSET", waitingKey, job.ID, len(deps))
+			for _, dep := range deps {
+				dependents[dep] = append(dependents[dep], job.ID)
+			}
+		}
+
+		if !seenNames[job.Name] {
+			seenNames[job.Name] = true
+			jobNames = append(jobNames, job.Name)
+		}
+	}
+
+	for jobID, ids := range dependents {
+		idsJSON, err := json.Marshal(ids)
+		if err != nil {
+			conn.Do("DISCARD")
+			return err
+		}
+		conn.Send("HSET", dependentsKey, jobID, idsJSON)
+	}
+
+	for _, jobName := range jobNames {
+		e.sendAddToKnownJobs(conn, jobName)
+	}
+
+	if _, err := conn.Do("EXEC"); err != nil {
+		return err
+	}
+
+	e.markKnownJobs(jobNames...)
+
+	return nil
+}
+
+// WorkflowJob is a job within a workflow, as returned by Client.Workflow.
+type WorkflowJob struct {
+	*Job
+	State     WorkflowJobState `json:"state"`
+	DependsOn []string         `json:"depends_on"`
+}
+
+// WorkflowStatus is the state of a workflow, as returned by Client.Workflow.
+type WorkflowStatus struct {
+	ID string `json:"id"`
+	// Done is true once all of the jobs succeeded.
+	Done bool `json:"done"`
+	// Failed is true if any of the jobs died or was cancelled.
+	Failed bool `json:"failed"`
+	// Jobs are ordered so that each job comes after the jobs it depends on.
+	Jobs []*WorkflowJob `json:"jobs"`
+}
+
+// Workflow returns the state of the workflow with the specified ID, or ErrWorkflowNotFound.
+// Workflows are kept for a week after their last job finished.
+func (c *Client) Workflow(workflowID string) (*WorkflowStatus, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Send("HGETALL", redisKeyWorkflowStates(c.namespace, workflowID))
+	conn.Send("HGETALL", redisKeyWorkflowJobs(c.namespace, workflowID))
+	conn.Send("HGETALL", redisKeyWorkflowDependents(c.namespace, workflowID))
+	if err := conn.Flush(); err != nil {
+		c.logger.Error("client.workflow.flush", err, "workflow_id", workflowID)
+		return nil, err
+	}
+
+	states, err := redis.StringMap(conn.Receive())
+	if err != nil {
+		c.logger.Error("client.workflow.states", err, "workflow_id", workflowID)
+		return nil, err
+	}
+	rawJobs, err := redis.StringMap(conn.Receive())
+	if err != nil {
+		c.logger.Error("client.workflow.jobs", err, "workflow_id", workflowID)
+		return nil, err
+	}
+	rawDependents, err := redis.StringMap(conn.Receive())
+	if err != nil {
+		c.logger.Error("client.workflow.dependents", err, "workflow_id", workflowID)
+		return nil, err
+	}
+
+	if len(states) == 0 {
+		return nil, ErrWorkflowNotFound
+	}
+
+	status := &WorkflowStatus{
+		ID:   workflowID,
+		Done: true,
+	}
+	jobs := make(map[string]*WorkflowJob, len(states))
+	for jobID, state := range states {
+		job, err := newJob([]byte(rawJobs[jobID]), nil, nil)
+		if err != nil {
+			c.logger.Error("client.workflow.job", err, "workflow_id", workflowID, "job_id", jobID)
+			return nil, err
+		}
+
+		wfJob := &WorkflowJob{Job: job, State: WorkflowJobState(state)}
+		jobs[jobID] = wfJob
+		status.Jobs = append(status.Jobs, wfJob)
+
+		switch wfJob.State {
+		case WorkflowJobDone:
+		case WorkflowJobDead, WorkflowJobCancelled:
+			status.Done = false
+			status.Failed = true
+		default:
+			status.Done = false
+		}
+	}
+
+	for jobID, idsJSON := range rawDependents {
+		var ids []string
+		if err := json.Unmarshal([]byte(idsJSON), &ids); err != nil {
+			c.logger.Error("client.workflow.dependents_json", err, "workflow_id", workflowID)
+			return nil, err
+		}
+		for _, id := range ids {
+			if child := jobs[id]; child != nil {
+				child.DependsOn = append(child.DependsOn, jobID)
+			}
+		}
+	}
+
+	depths := make(map[string]int, len(jobs))
+	var depth func(jobID string) int
+	depth = func(jobID string) int {
+		if d, ok := depths[jobID]; ok {
+			return d
+		}
+		d := 0
+		if job := jobs[jobID]; job != nil {
+			sort.Strings(job.DependsOn)
+			for _, dep := range job.DependsOn {
+				if dd := depth(dep) + 1; dd > d {
+					d = dd
+				}
+			}
+		}
+		depths[jobID] = d
+		return d
+	}
+	sort.Slice(status.Jobs, func(i, j int) bool {
+		a, b := status.Jobs[i], status.Jobs[j]
+		if da, db := depth(a.ID), depth(b.ID); da != db {
+			return da < db
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+
+	return status, nil
+}
+
+// finishWorkflowJob queues the update of the workflow of job on conn, once the job succeeded or died.
+func (w *worker) finishWorkflowJob(conn redis.Conn, job *Job, state WorkflowJobState) {
+	w.workflowScript.Send(conn,
+		redisKeyWorkflowStates(w.namespace, job.WorkflowID),     // KEYS[1]
+		redisKeyWorkflowJobs(w.namespace, job.WorkflowID),       // KEYS[2]
+		redisKeyWorkflowWaiting(w.namespace, job.WorkflowID),    // KEYS[3]
+		redisKeyWorkflowDependents(w.namespace, job.WorkflowID), // KEYS[4]
+		redisKeyDead(w.namespace),                               // KEYS[5]
+		job.ID,                                                  // ARGV[1]
+		string(state),                                           // ARGV[2]
+		redisKeyJobsPrefix(w.namespace),                         // ARGV[3]
+		nowEpochSeconds(),                                       // ARGV[4]
+		workflowRetentionSeconds,                                // ARGV[5]
+	)
+}
@@ -0,0 +1,251 @@
+package work
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowRunsDependentsAfterSuccess(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	wf := enqueuer.NewWorkflow()
+	a1 := wf.Add("a", Q{"n": 1})
+	a2 := wf.Add("a", Q{"n": 2})
+	b := wf.Add("b", nil, a1, a2, a1)
+	assert.NoError(t, wf.Enqueue())
+
+	// Only the jobs without dependencies are queued
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "a")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "b")))
+	assert.ElementsMatch(t, []string{"a", "b"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	client := NewClient(ns, pool)
+	status, err := client.Workflow(wf.ID)
+	assert.NoError(t, err)
+	assert.False(t, status.Done)
+	if assert.Len(t, status.Jobs, 3) {
+		assert.Equal(t, WorkflowJobQueued, status.Jobs[0].State)
+		assert.Equal(t, WorkflowJobQueued, status.Jobs[1].State)
+		assert.Equal(t, b.ID, status.Jobs[2].ID)
+		assert.Equal(t, WorkflowJobPending, status.Jobs[2].State)
+		assert.ElementsMatch(t, []string{a1.ID, a2.ID}, status.Jobs[2].DependsOn)
+	}
+
+	var mtx sync.Mutex
+	var order []string
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.Job("a", func(job *Job) error {
+		mtx.Lock()
+		order = append(order, "a")
+		mtx.Unlock()
+		return nil
+	})
+	wp.Job("b", func(job *Job) error {
+		mtx.Lock()
+		order = append(order, "b")
+		mtx.Unlock()
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, []string{"a", "a", "b"}, order)
+
+	status, err = client.Workflow(wf.ID)
+	assert.NoError(t, err)
+	assert.True(t, status.Done)
+	assert.False(t, status.Failed)
+	for _, job := range status.Jobs {
+		assert.Equal(t, WorkflowJobDone, job.State)
+	}
+
+	_, err = client.Workflow("nope")
+	assert.Equal(t, ErrWorkflowNotFound, err)
+}
+
+func TestWorkflowCancelsDependentsWhenJobDies(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	wf := enqueuer.NewWorkflow()
+	a := wf.Add("a", nil)
+	other := wf.Add("other", nil)
+	b := wf.Add("b", nil, a, other)
+	c := wf.Add("c", nil, b)
+	assert.NoError(t, wf.Enqueue())
+
+	var ran []string
+	var mtx sync.Mutex
+	handler := func(job *Job) error {
+		mtx.Lock()
+		ran = append(ran, job.Name)
+		mtx.Unlock()
+		if job.Name == "a" {
+			return fmt.Errorf("ohno")
+		}
+		return nil
+	}
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.JobWithOptions("a", JobOptions{MaxFails: 1}, handler)
+	wp.Job("other", handler)
+	wp.Job("b", handler)
+	wp.Job("c", handler)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.ElementsMatch(t, []string{"a", "other"}, ran)
+	assert.EqualValues(t, 3, zsetSize(pool, redisKeyDead(ns)))
+
+	client := NewClient(ns, pool)
+	deadJobs, _, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	errs := map[string]string{}
+	for _, j := range deadJobs {
+		errs[j.ID] = j.LastErr
+	}
+	assert.Equal(t, "ohno", errs[a.ID])
+	assert.Equal(t, "workflow dependency "+a.ID+" died", errs[b.ID])
+	assert.Equal(t, "workflow dependency "+a.ID+" died", errs[c.ID])
+
+	status, err := client.Workflow(wf.ID)
+	assert.NoError(t, err)
+	assert.False(t, status.Done)
+	assert.True(t, status.Failed)
+	states := map[string]WorkflowJobState{}
+	for _, j := range status.Jobs {
+		states[j.ID] = j.State
+	}
+	assert.Equal(t, WorkflowJobDead, states[a.ID])
+	assert.Equal(t, WorkflowJobDone, states[other.ID])
+	assert.Equal(t, WorkflowJobCancelled, states[b.ID])
+	assert.Equal(t, WorkflowJobCancelled, states[c.ID])
+
+	// Nothing is left to run, so the workflow expires eventually
+	assert.True(t, ttl(pool, redisKeyWorkflowStates(ns, wf.ID)) > 0)
+}
+
+func TestWorkflowJobFinishedTwice(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	wf := enqueuer.NewWorkflow()
+	a1 := wf.Add("a", nil)
+	a2 := wf.Add("a", nil)
+	b := wf.Add("b", nil, a1, a2)
+	c := wf.Add("c", nil, a1, a2)
+	assert.NoError(t, wf.Enqueue())
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, nil)
+	conn := pool.Get()
+	defer conn.Close()
+	finish := func(job *Job, state WorkflowJobState) {
+		w.finishWorkflowJob(conn, job, state)
+		assert.NoError(t, conn.Flush())
+		_, err := conn.Receive()
+		assert.NoError(t, err)
+	}
+
+	// a1 finishing again, eg after the reaper requeued it, doesn't count for its dependents twice
+	finish(a1, WorkflowJobDone)
+	finish(a1, WorkflowJobDone)
+	finish(a1, WorkflowJobDead)
+	client := NewClient(ns, pool)
+	status, err := client.Workflow(wf.ID)
+	assert.NoError(t, err)
+	states := map[string]WorkflowJobState{}
+	for _, j := range status.Jobs {
+		states[j.ID] = j.State
+	}
+	assert.Equal(t, WorkflowJobDone, states[a1.ID])
+	assert.Equal(t, WorkflowJobPending, states[b.ID])
+	assert.Equal(t, WorkflowJobPending, states[c.ID])
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "b")))
+
+	finish(a2, WorkflowJobDone)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "b")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "c")))
+}
+
+func TestWorkflowIgnoresRetries(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	wf := enqueuer.NewWorkflow()
+	a := wf.Add("a", nil)
+	b := wf.Add("b", nil, a)
+	assert.NoError(t, wf.Enqueue())
+
+	var mtx sync.Mutex
+	var fails, bRuns int
+	wp := NewWorkerPool(TestContext{}, 3, ns, pool)
+	wp.JobWithOptions("a", JobOptions{MaxFails: 3, Backoff: func(job *Job) int64 { return 0 }}, func(job *Job) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		if fails < 1 {
+			fails++
+			return fmt.Errorf("ohno")
+		}
+		return nil
+	})
+	wp.Job("b", func(job *Job) error {
+		mtx.Lock()
+		bRuns++
+		mtx.Unlock()
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+
+	client := NewClient(ns, pool)
+	status, err := client.Workflow(wf.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, status.Jobs, 2) {
+		assert.Equal(t, a.ID, status.Jobs[0].ID)
+		assert.Equal(t, WorkflowJobQueued, status.Jobs[0].State)
+		assert.Equal(t, b.ID, status.Jobs[1].ID)
+		assert.Equal(t, WorkflowJobPending, status.Jobs[1].State)
+	}
+
+	// The requeuer picks the retry up
+	deadline := time.Now().Add(5 * time.Second)
+	for !status.Done && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+		status, err = client.Workflow(wf.ID)
+		assert.NoError(t, err)
+	}
+	wp.Stop()
+
+	assert.True(t, status.Done)
+	assert.Equal(t, 1, bRuns)
+}
+
+func TestWorkflowRejectsUnknownDependency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	other := enqueuer.NewWorkflow()
+	a := other.Add("a", nil)
+
+	wf := enqueuer.NewWorkflow()
+	wf.Add("b", nil, a)
+	assert.Error(t, wf.Enqueue())
+	assert.Error(t, enqueuer.NewWorkflow().Enqueue())
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "b")))
+}